@@ -30,6 +30,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core"
 	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/localapi"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service"
@@ -243,6 +244,8 @@ func main() {
 	// do not change this, js client relies on this msg to ensure that server is up and parse address
 	fmt.Println(grpcWebStartedMessagePrefix + webaddr)
 
+	localAPI := startLocalAPI(mw)
+
 	for {
 		sig := <-signalChan
 		if shouldSaveStack(sig) {
@@ -253,11 +256,45 @@ func main() {
 		}
 		server.Stop()
 		proxy.Close()
+		if localAPI != nil {
+			localAPI.Close()
+		}
 		mw.AppShutdown(context.Background(), &pb.RpcAppShutdownRequest{})
 		return
 	}
 }
 
+// startLocalAPI starts HTTP gateway to the commands, if its address is set. Gateway requires token and loopback
+// address, so it isn't started without them
+func startLocalAPI(mw *core.Middleware) *http.Server {
+	addr := os.Getenv("ANYTYPE_LOCAL_API_ADDR")
+	if addr == "" {
+		return nil
+	}
+	if err := localapi.CheckAddr(addr); err != nil {
+		log.Errorf("local api is not started: %s", err)
+		return nil
+	}
+	token := os.Getenv("ANYTYPE_LOCAL_API_TOKEN")
+	if token == "" {
+		log.Errorf("local api is not started: ANYTYPE_LOCAL_API_TOKEN is not set")
+		return nil
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Errorf("local api is not started: %s", err)
+		return nil
+	}
+	srv := &http.Server{Handler: localapi.New(mw, token)}
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("local api error: %s", err)
+		}
+	}()
+	fmt.Println("Local API started at: " + lis.Addr().String())
+	return srv
+}
+
 func appendInterceptor(
 	unaryInterceptors []grpc.UnaryServerInterceptor,
 	mw *core.Middleware,
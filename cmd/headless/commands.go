@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/block/backup"
+	"github.com/anyproto/anytype-heart/core/debug"
+	"github.com/anyproto/anytype-heart/core/indexer"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/space"
+)
+
+func runImport(ctx context.Context, s *session, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	spaceID := fs.String("space", s.personalSpaceID, "id of the space")
+	format := fs.String("format", "", "name of import type, e.g. Markdown, which is used instead of detection")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return errors.New("paths to import are not specified")
+	}
+	paths := make([]string, 0, fs.NArg())
+	for _, p := range fs.Args() {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, abs)
+	}
+	resp := s.mw.ObjectImport(ctx, &pb.RpcObjectImportRequest{
+		SpaceId:    *spaceID,
+		Type:       pb.RpcObjectImportRequest_Auto,
+		Mode:       pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		NoProgress: true,
+		Params: &pb.RpcObjectImportRequestParamsOfAutoParams{AutoParams: &pb.RpcObjectImportRequestAutoParams{
+			Path:       paths,
+			FormatHint: *format,
+		}},
+	})
+	if resp.Error.Code != pb.RpcObjectImportResponseError_NULL {
+		return errors.New(resp.Error.Description)
+	}
+	fmt.Println("import run:", resp.ImportRunId)
+	return nil
+}
+
+func runExport(ctx context.Context, s *session, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	spaceID := fs.String("space", s.personalSpaceID, "id of the space")
+	format := fs.String("format", "Markdown", "export format: Markdown, Protobuf, JSON, ...")
+	path := fs.String("path", ".", "directory, where export is written")
+	zip := fs.Bool("zip", false, "write export to zip archive")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	formatValue, ok := pb.RpcObjectListExportFormat_value[*format]
+	if !ok {
+		return fmt.Errorf("unknown format %s", *format)
+	}
+	abs, err := filepath.Abs(*path)
+	if err != nil {
+		return err
+	}
+	resp := s.mw.ObjectListExport(ctx, &pb.RpcObjectListExportRequest{
+		SpaceId:       *spaceID,
+		Path:          abs,
+		ObjectIds:     fs.Args(),
+		Format:        pb.RpcObjectListExportFormat(formatValue),
+		Zip:           *zip,
+		IncludeNested: true,
+		IncludeFiles:  true,
+	})
+	if resp.Error.Code != pb.RpcObjectListExportResponseError_NULL {
+		return errors.New(resp.Error.Description)
+	}
+	fmt.Printf("exported %d objects to %s\n", resp.Succeed, resp.Path)
+	return nil
+}
+
+func runReindex(ctx context.Context, s *session, args []string) error {
+	fs := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	spaceID := fs.String("space", "", "id of the space, all spaces are reindexed by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	a := s.mw.GetApp()
+	spaceIDs, err := s.spaceIDs(*spaceID)
+	if err != nil {
+		return err
+	}
+	spaceService := app.MustComponent[space.Service](a)
+	idx := a.MustComponent(indexer.CName).(indexer.Indexer)
+	for _, id := range spaceIDs {
+		spc, err := spaceService.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get space %s: %w", id, err)
+		}
+		if err = idx.ForceReindexSpace(spc); err != nil {
+			return fmt.Errorf("reindex space %s: %w", id, err)
+		}
+		fmt.Println("reindexed space", id)
+	}
+	return nil
+}
+
+func runGC(ctx context.Context, s *session, _ []string) error {
+	resp := s.mw.FileRunGC(ctx, &pb.RpcFileRunGCRequest{})
+	if resp.Error.Code != pb.RpcFileRunGCResponseError_NULL {
+		return errors.New(resp.Error.Description)
+	}
+	fmt.Printf("removed %d blocks, freed %d bytes\n", resp.BlocksRemoved, resp.BytesFreed)
+	return nil
+}
+
+func runCheck(ctx context.Context, s *session, args []string) error {
+	fs := flag.NewFlagSet("check", flag.ContinueOnError)
+	spaceID := fs.String("space", "", "id of the space, all spaces are checked by default")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	spaceIDs, err := s.spaceIDs(*spaceID)
+	if err != nil {
+		return err
+	}
+	dbg := s.mw.GetApp().MustComponent(debug.CName).(debug.Debug)
+	var brokenCount int
+	for _, id := range spaceIDs {
+		broken, err := dbg.CheckSpace(ctx, id)
+		if err != nil {
+			return fmt.Errorf("check space %s: %w", id, err)
+		}
+		for _, t := range broken {
+			fmt.Printf("space %s: tree %s: %v\n", id, t.Id, t.Err)
+		}
+		brokenCount += len(broken)
+	}
+	if brokenCount > 0 {
+		return fmt.Errorf("%d broken trees", brokenCount)
+	}
+	fmt.Println("no broken trees")
+	return nil
+}
+
+// spaceIDs returns the space, if it's set, or all spaces of the account
+func (s *session) spaceIDs(spaceID string) ([]string, error) {
+	if spaceID != "" {
+		return []string{spaceID}, nil
+	}
+	spaceIDs, err := backup.SpaceIDs(app.MustComponent[objectstore.ObjectStore](s.mw.GetApp()))
+	if err != nil {
+		return nil, fmt.Errorf("get spaces: %w", err)
+	}
+	return spaceIDs, nil
+}
@@ -0,0 +1,115 @@
+// headless starts the middleware without a client and runs a single operation over the account data,
+// so the data can be processed by scripts and on a server.
+//
+// Usage: ANYTYPE_MNEMONIC="..." headless -repo <path> <command> [flags] [args]
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/anyproto/anytype-heart/core"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	corelib "github.com/anyproto/anytype-heart/pkg/lib/core"
+)
+
+const mnemonicEnv = "ANYTYPE_MNEMONIC"
+
+type command struct {
+	description string
+	// readOnly opens the account in read-only mode, when the command doesn't change the data
+	readOnly bool
+	run      func(ctx context.Context, s *session, args []string) error
+}
+
+var commands = map[string]command{
+	"import":  {description: "import files to the space", run: runImport},
+	"export":  {description: "export objects of the space", readOnly: true, run: runExport},
+	"reindex": {description: "rebuild indexes of spaces", run: runReindex},
+	"gc":      {description: "remove file blocks, which are not used by any file", run: runGC},
+	"check":   {description: "check that trees of spaces can be built from the local storage", readOnly: true, run: runCheck},
+}
+
+// session is the account, which is started by the middleware
+type session struct {
+	mw              *core.Middleware
+	personalSpaceID string
+}
+
+func main() {
+	repo := flag.String("repo", os.Getenv("ANYTYPE_REPO"), "path to the root directory of the accounts")
+	flag.Usage = usage
+	flag.Parse()
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok || *repo == "" {
+		usage()
+		os.Exit(2)
+	}
+	mnemonic := os.Getenv(mnemonicEnv)
+	if mnemonic == "" {
+		fmt.Fprintf(os.Stderr, "%s env variable is not set\n", mnemonicEnv)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := run(ctx, *repo, mnemonic, cmd, flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s=<mnemonic> %s -repo <path> <command> [flags] [args]\n\ncommands:\n", mnemonicEnv, os.Args[0])
+	for _, name := range []string{"import", "export", "reindex", "gc", "check"} {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", name, commands[name].description)
+	}
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	flag.PrintDefaults()
+}
+
+func run(ctx context.Context, rootPath, mnemonic string, cmd command, args []string) error {
+	s, err := startSession(ctx, rootPath, mnemonic, cmd.readOnly)
+	if err != nil {
+		return err
+	}
+	defer s.stop()
+	return cmd.run(ctx, s, args)
+}
+
+func startSession(ctx context.Context, rootPath, mnemonic string, readOnly bool) (*session, error) {
+	mw := core.New()
+	mw.SetEventSender(event.NewCallbackSender(func(*pb.Event) {}))
+
+	recoverResp := mw.WalletRecover(ctx, &pb.RpcWalletRecoverRequest{RootPath: rootPath, Mnemonic: mnemonic})
+	if recoverResp.Error.Code != pb.RpcWalletRecoverResponseError_NULL {
+		return nil, fmt.Errorf("recover wallet: %s", recoverResp.Error.Description)
+	}
+	res, err := corelib.WalletAccountAt(mnemonic, 0)
+	if err != nil {
+		return nil, fmt.Errorf("derive account: %w", err)
+	}
+	selectResp := mw.AccountSelect(ctx, &pb.RpcAccountSelectRequest{
+		Id:                      res.Identity.GetPublic().Account(),
+		RootPath:                rootPath,
+		DisableLocalNetworkSync: true,
+		ReadOnly:                readOnly,
+	})
+	if selectResp.Error.Code != pb.RpcAccountSelectResponseError_NULL {
+		return nil, fmt.Errorf("select account: %s", selectResp.Error.Description)
+	}
+	return &session{mw: mw, personalSpaceID: selectResp.Account.Info.AccountSpaceId}, nil
+}
+
+func (s *session) stop() {
+	resp := s.mw.AccountStop(context.Background(), &pb.RpcAccountStopRequest{})
+	if resp.Error.Code != pb.RpcAccountStopResponseError_NULL {
+		fmt.Fprintf(os.Stderr, "stop account: %s\n", resp.Error.Description)
+	}
+}
@@ -156,6 +156,7 @@ func (s *service) GetInfo(ctx context.Context, spaceID string) (*model.AccountIn
 		GatewayUrl:             gwAddr,
 		LocalStoragePath:       cfg.CustomFileStorePath,
 		TimeZone:               cfg.TimeZone,
+		Locale:                 cfg.Locale,
 		AnalyticsId:            analyticsId,
 		NetworkId:              s.getNetworkID(),
 	}, nil
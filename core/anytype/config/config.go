@@ -21,9 +21,11 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/anyproto/anytype-heart/core/anytype/config/loadenv"
+	"github.com/anyproto/anytype-heart/core/semantic/embedding"
 	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore/clientds"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 )
 
@@ -42,7 +44,10 @@ type ConfigRequired struct {
 	HostAddr            string `json:",omitempty"`
 	CustomFileStorePath string `json:",omitempty"`
 	TimeZone            string `json:",omitempty"`
+	Locale              string `json:",omitempty"` // locale of the account, it selects language analyzer of full-text search
 	LegacyFileStorePath string `json:",omitempty"`
+	// LocalCacheLimit is the maximum size in bytes of file blocks stored locally. Zero means no limit
+	LocalCacheLimit uint64 `json:",omitempty"`
 }
 
 type Config struct {
@@ -50,6 +55,8 @@ type Config struct {
 	NewAccount                             bool `ignored:"true"` // set to true if a new account is creating. This option controls whether mw should wait for the existing data to arrive before creating the new log
 	DisableThreadsSyncEvents               bool
 	DontStartLocalNetworkSyncAutomatically bool
+	// ReadOnly disables sync, indexing and changes of objects, so data of the account isn't modified
+	ReadOnly bool `ignored:"true"`
 
 	RepoPath    string
 	AnalyticsId string
@@ -59,11 +66,15 @@ type Config struct {
 
 	DS                clientds.Config
 	FS                FSConfig
-	DisableFileConfig bool `ignored:"true"` // set in order to skip reading/writing config from/to file
+	FullTextFuzzy     ftsearch.FuzzyConfig   // typo-tolerant matching of full-text search
+	SearchRanking     ftsearch.RankingConfig // weights of relevance, recency and backlinks in search results
+	SemanticSearch    embedding.Config       // embeddings API of semantic search
+	DisableFileConfig bool                   `ignored:"true"` // set in order to skip reading/writing config from/to file
 }
 
 type FSConfig struct {
 	IPFSStorageAddr string
+	LocalCacheLimit uint64
 }
 
 type DebugAPIConfig struct {
@@ -229,7 +240,7 @@ func (c *Config) FSConfig() (FSConfig, error) {
 		return FSConfig{}, err
 	}
 
-	return FSConfig{IPFSStorageAddr: res.CustomFileStorePath}, nil
+	return FSConfig{IPFSStorageAddr: res.CustomFileStorePath, LocalCacheLimit: res.LocalCacheLimit}, nil
 }
 
 func (c *Config) GetConfigPath() string {
@@ -281,6 +292,22 @@ func (c *Config) GetDebugAPIConfig() DebugAPIConfig {
 	}
 }
 
+func (c *Config) GetFullTextFuzzy() ftsearch.FuzzyConfig {
+	return c.FullTextFuzzy
+}
+
+func (c *Config) GetSearchRanking() ftsearch.RankingConfig {
+	return c.SearchRanking
+}
+
+func (c *Config) GetSemanticSearch() embedding.Config {
+	return c.SemanticSearch
+}
+
+func (c *Config) GetLocale() string {
+	return c.Locale
+}
+
 func (c *Config) GetDebugServer() debugserver.Config {
 	return debugserver.Config{ListenAddr: c.DebugAddr}
 }
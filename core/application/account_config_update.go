@@ -18,6 +18,7 @@ func (s *Service) AccountConfigUpdate(req *pb.RpcAccountConfigUpdateRequest) err
 	conf := s.app.MustComponent(config.CName).(*config.Config)
 	cfg := config.ConfigRequired{}
 	cfg.TimeZone = req.TimeZone
+	cfg.Locale = req.Locale
 	cfg.CustomFileStorePath = req.IPFSStorageAddr
 	err := config.WriteJsonConfig(conf.GetConfigPath(), cfg)
 	if err != nil {
@@ -52,7 +52,7 @@ func (s *Service) AccountCreate(ctx context.Context, req *pb.RpcAccountCreateReq
 	comps := []app.Component{
 		cfg,
 		anytype.BootstrapWallet(s.rootPath, derivationResult),
-		s.eventSender,
+		s.newAccountSender(),
 	}
 
 	newAcc := &model.Account{Id: accountID}
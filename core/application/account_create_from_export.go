@@ -131,7 +131,7 @@ func (s *Service) startApp(cfg *config.Config, derivationResult crypto.Derivatio
 	comps := []app.Component{
 		cfg,
 		anytype.BootstrapWallet(s.rootPath, derivationResult),
-		s.eventSender,
+		s.newAccountSender(),
 	}
 
 	ctxWithValue := context.WithValue(context.Background(), metrics.CtxKeyEntrypoint, "account_create")
@@ -13,7 +13,6 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/anytype"
 	"github.com/anyproto/anytype-heart/core/anytype/account"
-	walletComp "github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
@@ -44,26 +43,19 @@ func (s *Service) AccountSelect(ctx context.Context, req *pb.RpcAccountSelectReq
 	s.requireClientWithVersion()
 
 	// we already have this account running, lets just stop events
-	if s.app != nil && req.Id == s.app.MustComponent(walletComp.CName).(walletComp.Wallet).GetAccountPrivkey().GetPublic().Account() {
+	if s.app != nil && req.Id == appAccountID(s.app) && req.ReadOnly == appReadOnly(s.app) {
 		// TODO What should we do?
 		// objectCache := app.MustComponent[objectcache.Cache](s.app)
 		// objectCache.CloseBlocks()
-
-		spaceID := app.MustComponent[account.Service](s.app).PersonalSpaceID()
-		acc := &model.Account{Id: req.Id}
-		var err error
-		acc.Info, err = app.MustComponent[account.Service](s.app).GetInfo(ctx, spaceID)
-		if err != nil {
-			return nil, err
-		}
-		go s.refreshRemoteAccountState()
-		return acc, nil
+		return s.runningAccount(ctx, req.Id)
 	}
 
 	// in case user selected account other than the first one(used to perform search)
-	// or this is the first time in this session we run the Anytype node
-	if err := s.stop(); err != nil {
-		return nil, errors.Join(ErrFailedToStopApplication, err)
+	// or this is the first time in this session we run the Anytype node.
+	// The running account is parked, so switching back to it doesn't start it again
+	s.park()
+	if s.unpark(req.Id, req.ReadOnly) {
+		return s.runningAccount(ctx, req.Id)
 	}
 	if req.RootPath != "" {
 		s.rootPath = req.RootPath
@@ -87,10 +79,14 @@ func (s *Service) AccountSelect(ctx context.Context, req *pb.RpcAccountSelectReq
 	if req.DisableLocalNetworkSync {
 		cfg.DontStartLocalNetworkSyncAutomatically = true
 	}
+	if req.ReadOnly {
+		cfg.ReadOnly = true
+		cfg.DontStartLocalNetworkSyncAutomatically = true
+	}
 	comps := []app.Component{
 		cfg,
 		anytype.BootstrapWallet(s.rootPath, res),
-		s.eventSender,
+		s.newAccountSender(),
 	}
 
 	request := "account_select"
@@ -125,3 +121,15 @@ func (s *Service) AccountSelect(ctx context.Context, req *pb.RpcAccountSelectReq
 	acc.Info, err = app.MustComponent[account.Service](s.app).GetInfo(ctx, spaceID)
 	return acc, nil
 }
+
+func (s *Service) runningAccount(ctx context.Context, accountID string) (*model.Account, error) {
+	spaceID := app.MustComponent[account.Service](s.app).PersonalSpaceID()
+	acc := &model.Account{Id: accountID}
+	var err error
+	acc.Info, err = app.MustComponent[account.Service](s.app).GetInfo(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	go s.refreshRemoteAccountState()
+	return acc, nil
+}
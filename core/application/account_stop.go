@@ -20,7 +20,13 @@ func (s *Service) AccountStop(req *pb.RpcAccountStopRequest) error {
 	defer s.lock.Unlock()
 
 	if s.app == nil {
-		return ErrApplicationIsNotRunning
+		// selected account could fail to start, while accounts selected before it are still parked
+		parked := len(s.parked) > 0
+		s.closeParked()
+		if !parked || req.RemoveData {
+			return ErrApplicationIsNotRunning
+		}
+		return nil
 	}
 
 	if req.RemoveData {
@@ -1,7 +1,6 @@
 package application
 
 import (
-	"context"
 	"errors"
 	"sync"
 
@@ -18,6 +17,10 @@ type Service struct {
 	lock sync.RWMutex
 
 	app *app.App
+	// sender is event sender of the running application
+	sender *accountSender
+	// parked are applications of previously selected accounts, which are kept running for fast switching
+	parked []*parkedApp
 
 	mnemonic string
 
@@ -55,15 +58,16 @@ func (s *Service) Stop() error {
 	return s.stop()
 }
 
+// stop closes the running application and the parked ones
 func (s *Service) stop() error {
-	if s != nil && s.app != nil {
-		err := s.app.Close(context.Background())
-		if err != nil {
-			log.Warnf("error while stop anytype: %v", err)
-		}
-
-		s.app = nil
+	if s == nil {
+		return nil
+	}
+	if s.app != nil {
+		s.closeApp(s.app)
+		s.app, s.sender = nil, nil
 	}
+	s.closeParked()
 	return nil
 }
 
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/event"
+	walletComp "github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// maxParkedApps limits number of accounts, which are kept running after switching to other account
+const maxParkedApps = 2
+
+// parkedApp is application of the account, which is kept running after switching to other account,
+// so switching back to it doesn't start the whole component graph again
+type parkedApp struct {
+	accountID string
+	app       *app.App
+	sender    *accountSender
+	mnemonic  string
+	rootPath  string
+}
+
+// accountSender sends events of the application to the client only while its account is selected, so parked
+// application doesn't mix its events with events of the selected account
+type accountSender struct {
+	event.Sender
+	active atomic.Bool
+}
+
+func (s *accountSender) Broadcast(e *pb.Event) {
+	if s.active.Load() {
+		s.Sender.Broadcast(e)
+	}
+}
+
+func (s *accountSender) SendToSession(token string, e *pb.Event) {
+	if s.active.Load() {
+		s.Sender.SendToSession(token, e)
+	}
+}
+
+func (s *accountSender) BroadcastToOtherSessions(token string, e *pb.Event) {
+	if s.active.Load() {
+		s.Sender.BroadcastToOtherSessions(token, e)
+	}
+}
+
+// newAccountSender returns event sender for the application, which is started
+func (s *Service) newAccountSender() *accountSender {
+	sender := &accountSender{Sender: s.eventSender}
+	sender.active.Store(true)
+	s.sender = sender
+	return sender
+}
+
+func appAccountID(a *app.App) string {
+	return a.MustComponent(walletComp.CName).(walletComp.Wallet).GetAccountPrivkey().GetPublic().Account()
+}
+
+func appReadOnly(a *app.App) bool {
+	return a.MustComponent(config.CName).(*config.Config).ReadOnly
+}
+
+// park keeps the running application in background. The oldest parked application is closed,
+// if there are too many of them
+func (s *Service) park() {
+	if s.app == nil {
+		return
+	}
+	if s.sender == nil {
+		// application without account sender can't be muted
+		s.closeApp(s.app)
+		s.app = nil
+		return
+	}
+	s.sender.active.Store(false)
+	s.parked = append(s.parked, &parkedApp{
+		accountID: appAccountID(s.app),
+		app:       s.app,
+		sender:    s.sender,
+		mnemonic:  s.mnemonic,
+		rootPath:  s.rootPath,
+	})
+	s.app, s.sender = nil, nil
+	if len(s.parked) > maxParkedApps {
+		s.closeApp(s.parked[0].app)
+		s.parked = s.parked[1:]
+	}
+}
+
+// unpark makes the parked application of the account running one. False is returned, if the account isn't parked.
+// Application, which is opened in other mode, is closed, so the account is started again
+func (s *Service) unpark(accountID string, readOnly bool) bool {
+	for i, p := range s.parked {
+		if p.accountID != accountID {
+			continue
+		}
+		s.parked = append(s.parked[:i], s.parked[i+1:]...)
+		if appReadOnly(p.app) != readOnly {
+			s.closeApp(p.app)
+			return false
+		}
+		s.app, s.sender = p.app, p.sender
+		s.mnemonic, s.rootPath = p.mnemonic, p.rootPath
+		s.sender.active.Store(true)
+		return true
+	}
+	return false
+}
+
+func (s *Service) closeParked() {
+	for _, p := range s.parked {
+		s.closeApp(p.app)
+	}
+	s.parked = nil
+}
+
+func (s *Service) closeApp(a *app.App) {
+	if err := a.Close(context.Background()); err != nil {
+		log.Warnf("error while stop anytype: %v", err)
+	}
+}
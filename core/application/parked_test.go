@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/event"
+	walletComp "github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// closeRecorder is the component, which remembers that application is closed
+type closeRecorder struct {
+	closed bool
+}
+
+func (c *closeRecorder) Init(*app.App) error         { return nil }
+func (c *closeRecorder) Name() string                { return "closeRecorder" }
+func (c *closeRecorder) Run(context.Context) error   { return nil }
+func (c *closeRecorder) Close(context.Context) error { c.closed = true; return nil }
+
+type testApp struct {
+	app       *app.App
+	sender    *accountSender
+	recorder  *closeRecorder
+	accountID string
+}
+
+type fixture struct {
+	*Service
+	events []*pb.Event
+}
+
+func newFixture() *fixture {
+	fx := &fixture{Service: New()}
+	fx.eventSender = event.NewCallbackSender(func(e *pb.Event) {
+		fx.events = append(fx.events, e)
+	})
+	return fx
+}
+
+// start makes new application of the account running one, like AccountSelect does
+func (fx *fixture) start(t *testing.T, readOnly bool) *testApp {
+	a := &testApp{app: new(app.App), recorder: &closeRecorder{}, sender: fx.newAccountSender()}
+	a.app.Register(&config.Config{ReadOnly: readOnly}).
+		Register(walletComp.NewWithRepoDirAndRandomKeys(t.TempDir())).
+		Register(a.recorder)
+	a.accountID = appAccountID(a.app)
+	fx.app = a.app
+	return a
+}
+
+// selectParked makes parked application of the account running one, like AccountSelect does
+func (fx *fixture) selectParked(accountID string, readOnly bool) bool {
+	fx.park()
+	return fx.unpark(accountID, readOnly)
+}
+
+func TestService_Park(t *testing.T) {
+	t.Run("select, park, reselect and stop", func(t *testing.T) {
+		// given
+		fx := newFixture()
+		first := fx.start(t, false)
+		require.False(t, fx.selectParked("other", false))
+		second := fx.start(t, false)
+
+		// when
+		ok := fx.selectParked(first.accountID, false)
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, first.app, fx.app)
+		assert.False(t, first.recorder.closed)
+		assert.False(t, second.recorder.closed)
+
+		// when
+		err := fx.AccountStop(&pb.RpcAccountStopRequest{})
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, fx.app)
+		assert.Empty(t, fx.parked)
+		assert.True(t, first.recorder.closed)
+		assert.True(t, second.recorder.closed)
+	})
+	t.Run("events of parked application aren't sent", func(t *testing.T) {
+		// given
+		fx := newFixture()
+		first := fx.start(t, false)
+		fx.park()
+		second := fx.start(t, false)
+
+		// when
+		first.sender.Broadcast(&pb.Event{ContextId: "first"})
+		second.sender.Broadcast(&pb.Event{ContextId: "second"})
+
+		// then
+		require.Len(t, fx.events, 1)
+		assert.Equal(t, "second", fx.events[0].ContextId)
+	})
+	t.Run("application opened in other mode is closed on reselect", func(t *testing.T) {
+		// given
+		fx := newFixture()
+		first := fx.start(t, false)
+
+		// when
+		ok := fx.selectParked(first.accountID, true)
+
+		// then
+		assert.False(t, ok)
+		assert.True(t, first.recorder.closed)
+		assert.Empty(t, fx.parked)
+	})
+	t.Run("the oldest application is closed, if too many are parked", func(t *testing.T) {
+		// given
+		fx := newFixture()
+		apps := make([]*testApp, 0, maxParkedApps+1)
+		for i := 0; i < maxParkedApps; i++ {
+			apps = append(apps, fx.start(t, false))
+			fx.park()
+		}
+		apps = append(apps, fx.start(t, false))
+
+		// when
+		fx.park()
+
+		// then
+		assert.True(t, apps[0].recorder.closed)
+		for _, a := range apps[1:] {
+			assert.False(t, a.recorder.closed)
+		}
+		assert.Len(t, fx.parked, maxParkedApps)
+	})
+}
+
+func TestService_AccountStop(t *testing.T) {
+	t.Run("parked applications are stopped, if selected account failed to start", func(t *testing.T) {
+		// given
+		fx := newFixture()
+		first := fx.start(t, false)
+		fx.park()
+
+		// when
+		err := fx.AccountStop(&pb.RpcAccountStopRequest{})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, first.recorder.closed)
+		assert.Empty(t, fx.parked)
+	})
+	t.Run("nothing is running - error", func(t *testing.T) {
+		// given
+		fx := newFixture()
+
+		// when
+		err := fx.AccountStop(&pb.RpcAccountStopRequest{})
+
+		// then
+		assert.ErrorIs(t, err, ErrApplicationIsNotRunning)
+	})
+}
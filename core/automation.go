@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/automation"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) AutomationCreate(cctx context.Context, req *pb.RpcAutomationCreateRequest) *pb.RpcAutomationCreateResponse {
+	response := func(code pb.RpcAutomationCreateResponseErrorCode, err error, id string) *pb.RpcAutomationCreateResponse {
+		m := &pb.RpcAutomationCreateResponse{Error: &pb.RpcAutomationCreateResponseError{Code: code}, Id: id}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	id, err := getService[automation.Service](mw).Create(cctx, req.Rule)
+	if errors.Is(err, automation.ErrBadInput) {
+		return response(pb.RpcAutomationCreateResponseError_BAD_INPUT, err, "")
+	}
+	if err != nil {
+		return response(pb.RpcAutomationCreateResponseError_UNKNOWN_ERROR, err, "")
+	}
+	return response(pb.RpcAutomationCreateResponseError_NULL, nil, id)
+}
+
+func (mw *Middleware) AutomationUpdate(cctx context.Context, req *pb.RpcAutomationUpdateRequest) *pb.RpcAutomationUpdateResponse {
+	response := func(code pb.RpcAutomationUpdateResponseErrorCode, err error) *pb.RpcAutomationUpdateResponse {
+		m := &pb.RpcAutomationUpdateResponse{Error: &pb.RpcAutomationUpdateResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[automation.Service](mw).Update(req.Rule)
+	switch {
+	case errors.Is(err, automation.ErrBadInput):
+		return response(pb.RpcAutomationUpdateResponseError_BAD_INPUT, err)
+	case errors.Is(err, automation.ErrNotFound):
+		return response(pb.RpcAutomationUpdateResponseError_NOT_FOUND, err)
+	case err != nil:
+		return response(pb.RpcAutomationUpdateResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcAutomationUpdateResponseError_NULL, nil)
+}
+
+func (mw *Middleware) AutomationList(cctx context.Context, req *pb.RpcAutomationListRequest) *pb.RpcAutomationListResponse {
+	response := func(code pb.RpcAutomationListResponseErrorCode, err error, rules []*pb.RpcAutomationRule) *pb.RpcAutomationListResponse {
+		m := &pb.RpcAutomationListResponse{Error: &pb.RpcAutomationListResponseError{Code: code}, Rules: rules}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	rules, err := getService[automation.Service](mw).List(req.SpaceId)
+	if errors.Is(err, automation.ErrBadInput) {
+		return response(pb.RpcAutomationListResponseError_BAD_INPUT, err, nil)
+	}
+	if err != nil {
+		return response(pb.RpcAutomationListResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcAutomationListResponseError_NULL, nil, rules)
+}
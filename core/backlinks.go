@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func (mw *Middleware) ObjectBacklinksList(cctx context.Context, req *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse {
+	response := func(code pb.RpcObjectBacklinksListResponseErrorCode, backlinks []*model.Backlink, details []*types.Struct, err error) *pb.RpcObjectBacklinksListResponse {
+		m := &pb.RpcObjectBacklinksListResponse{
+			Error:     &pb.RpcObjectBacklinksListResponseError{Code: code},
+			Backlinks: backlinks,
+			Details:   details,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.ObjectId == "" {
+		return response(pb.RpcObjectBacklinksListResponseError_BAD_INPUT, nil, nil, fmt.Errorf("object id is required"))
+	}
+	store := getService[objectstore.ObjectStore](mw)
+	backlinks, err := store.GetBacklinks(req.ObjectId)
+	if err != nil {
+		return response(pb.RpcObjectBacklinksListResponseError_UNKNOWN_ERROR, nil, nil, err)
+	}
+	ids := lo.Uniq(lo.Map(backlinks, func(b *model.Backlink, _ int) string { return b.ObjectId }))
+	records, err := store.QueryByID(ids)
+	if err != nil {
+		return response(pb.RpcObjectBacklinksListResponseError_UNKNOWN_ERROR, nil, nil, err)
+	}
+	visible := make(map[string]*types.Struct, len(records))
+	for _, rec := range records {
+		if pbtypes.GetBool(rec.Details, bundle.RelationKeyIsDeleted.String()) || pbtypes.GetBool(rec.Details, bundle.RelationKeyIsArchived.String()) {
+			continue
+		}
+		visible[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = rec.Details
+	}
+
+	var (
+		result  []*model.Backlink
+		details []*types.Struct
+	)
+	for _, b := range backlinks {
+		d, ok := visible[b.ObjectId]
+		if !ok {
+			continue
+		}
+		if len(result) == 0 || result[len(result)-1].ObjectId != b.ObjectId {
+			details = append(details, pbtypes.Map(d, req.Keys...))
+		}
+		result = append(result, b)
+	}
+	return response(pb.RpcObjectBacklinksListResponseError_NULL, result, details, nil)
+}
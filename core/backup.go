@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/backup"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) BackupScheduleSet(cctx context.Context, req *pb.RpcBackupScheduleSetRequest) *pb.RpcBackupScheduleSetResponse {
+	response := func(code pb.RpcBackupScheduleSetResponseErrorCode, err error) *pb.RpcBackupScheduleSetResponse {
+		m := &pb.RpcBackupScheduleSetResponse{Error: &pb.RpcBackupScheduleSetResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[backup.Service](mw).SetSchedule(req.Schedule)
+	if errors.Is(err, backup.ErrInvalidSchedule) {
+		return response(pb.RpcBackupScheduleSetResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcBackupScheduleSetResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBackupScheduleSetResponseError_NULL, nil)
+}
+
+func (mw *Middleware) BackupScheduleGet(cctx context.Context, req *pb.RpcBackupScheduleGetRequest) *pb.RpcBackupScheduleGetResponse {
+	response := func(code pb.RpcBackupScheduleGetResponseErrorCode, err error, schedule *pb.RpcBackupSchedule) *pb.RpcBackupScheduleGetResponse {
+		m := &pb.RpcBackupScheduleGetResponse{
+			Error:    &pb.RpcBackupScheduleGetResponseError{Code: code},
+			Schedule: schedule,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	schedule, err := getService[backup.Service](mw).GetSchedule()
+	if err != nil {
+		return response(pb.RpcBackupScheduleGetResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcBackupScheduleGetResponseError_NULL, nil, schedule)
+}
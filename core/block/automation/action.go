@@ -0,0 +1,113 @@
+package automation
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// apply runs actions of the rule for the object. Failed action doesn't stop the next ones
+func (s *service) apply(rule *pb.RpcAutomationRule, objectID string) {
+	for _, action := range rule.Actions {
+		if err := s.applyAction(rule, action, objectID); err != nil {
+			log.With("ruleId", rule.Id, "objectId", objectID).Errorf("apply %s action: %s", action.Type, err)
+		}
+	}
+}
+
+func (s *service) applyAction(rule *pb.RpcAutomationRule, action *pb.RpcAutomationAction, objectID string) error {
+	switch action.Type {
+	case pb.RpcAutomationAction_SetRelation:
+		return s.setRelation(action, objectID)
+	case pb.RpcAutomationAction_AddToCollection:
+		return s.collections.Add(nil, &pb.RpcObjectCollectionAddRequest{
+			ContextId: action.CollectionId,
+			ObjectIds: []string{objectID},
+		})
+	case pb.RpcAutomationAction_CreateLinkedObject:
+		return s.createLinkedObject(rule.SpaceId, action, objectID)
+	case pb.RpcAutomationAction_SendEvent:
+		s.eventSender.Broadcast(&pb.Event{Messages: []*pb.EventMessage{{
+			Value: &pb.EventMessageValueOfAutomationExecuted{AutomationExecuted: &pb.EventAutomationExecuted{
+				RuleId:   rule.Id,
+				SpaceId:  rule.SpaceId,
+				ObjectId: objectID,
+				Message:  action.Message,
+			}},
+		}}})
+		return nil
+	default:
+		return fmt.Errorf("unknown action")
+	}
+}
+
+func (s *service) setRelation(action *pb.RpcAutomationAction, objectID string) error {
+	value := action.Value
+	if action.CurrentDate {
+		value = pbtypes.Int64(s.now().Unix())
+	}
+	var changed bool
+	err := s.detailsModifier.ModifyDetails(objectID, func(current *types.Struct) (*types.Struct, error) {
+		if current.GetFields()[action.RelationKey].Equal(value) {
+			return current, nil
+		}
+		details := pbtypes.CopyStruct(current)
+		if details.Fields == nil {
+			details.Fields = map[string]*types.Value{}
+		}
+		details.Fields[action.RelationKey] = pbtypes.CopyVal(value)
+		changed = true
+		return details, nil
+	})
+	// the change made by the action is skipped by the loop, so the rule isn't fired by its own action
+	if err == nil && changed {
+		s.changedByRules[objectID] = struct{}{}
+	}
+	return err
+}
+
+// createLinkedObject creates object, which relation links to the object. The object isn't created, if the linked one
+// already exists, e.g. created before the rule is moved to this device
+func (s *service) createLinkedObject(spaceID string, action *pb.RpcAutomationAction, objectID string) error {
+	records, _, err := s.objectStore.Query(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeySpaceId.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(spaceID),
+			},
+			{
+				RelationKey: action.RelationKey,
+				Condition:   model.BlockContentDataviewFilter_In,
+				Value:       pbtypes.StringList([]string{objectID}),
+			},
+		},
+		Limit: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("find linked object: %w", err)
+	}
+	if len(records) > 0 {
+		return nil
+	}
+	id, _, err := s.objectCreator.CreateObject(s.ctx, spaceID, objectcreator.CreateObjectRequest{
+		ObjectTypeKey: domain.TypeKey(action.ObjectTypeKey),
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			action.RelationKey: pbtypes.StringList([]string{objectID}),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("create object: %w", err)
+	}
+	// the created object may fit trigger of the same rule, so it's skipped to avoid endless creation
+	s.createdByRules[id] = struct{}{}
+	return nil
+}
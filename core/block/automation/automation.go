@@ -0,0 +1,425 @@
+package automation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "automation"
+
+var log = logging.Logger("anytype-mw-automation")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("rule is not found")
+)
+
+const (
+	lastRunKeyPrefix      = "/automation/lastRun/"
+	changesQueueSize      = 1024
+	scheduleCheckInterval = time.Minute
+)
+
+// Service executes automation rules. Rules are hidden objects with automationRule relation, so they are synced
+// between devices. Every rule is executed only by its device, which is the last device, that created or updated
+// the rule, so actions aren't applied concurrently by several devices of the account
+type Service interface {
+	Create(ctx context.Context, rule *pb.RpcAutomationRule) (id string, err error)
+	Update(rule *pb.RpcAutomationRule) error
+	List(spaceID string) ([]*pb.RpcAutomationRule, error)
+	app.ComponentRunnable
+}
+
+type detailsModifier interface {
+	ModifyDetails(objectId string, modifier func(current *types.Struct) (*types.Struct, error)) error
+}
+
+type collectionAdder interface {
+	Add(ctx session.Context, req *pb.RpcObjectCollectionAddRequest) error
+}
+
+type objectCreator interface {
+	CreateObject(ctx context.Context, spaceID string, req objectcreator.CreateObjectRequest) (id string, details *types.Struct, err error)
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+type service struct {
+	objectStore     objectstore.ObjectStore
+	detailsModifier detailsModifier
+	collections     collectionAdder
+	objectCreator   objectCreator
+	eventSender     event.Sender
+	wallet          wallet.Wallet
+	db              *badger.DB
+	readOnly        bool
+	now             func() time.Time
+	// deviceID is the peer id of this device, only rules of this device are executed
+	deviceID string
+
+	// changes, rules, createdByRules and changedByRules are used only by the loop
+	changes        chan objectChange
+	rules          map[string]*pb.RpcAutomationRule
+	createdByRules map[string]struct{}
+	changedByRules map[string]struct{}
+	started        time.Time
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.detailsModifier = app.MustComponent[detailsModifier](a)
+	s.collections = app.MustComponent[collectionAdder](a)
+	s.objectCreator = app.MustComponent[objectCreator](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	s.wallet = app.MustComponent[wallet.Wallet](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
+	s.changes = make(chan objectChange, changesQueueSize)
+	s.createdByRules = map[string]struct{}{}
+	s.changedByRules = map[string]struct{}{}
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(context.Context) (err error) {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.deviceID = s.wallet.GetDevicePrivkey().GetPublic().PeerId()
+	// objects aren't changed in read-only mode, so rules aren't executed
+	if s.readOnly {
+		return nil
+	}
+	if s.rules, err = s.loadRules(); err != nil {
+		return fmt.Errorf("load rules: %w", err)
+	}
+	s.started = s.now()
+	s.objectStore.SubscribeForChanges(s.onChange)
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// Create saves the rule as new object in the space of the rule. The rule is executed by this device
+func (s *service) Create(ctx context.Context, rule *pb.RpcAutomationRule) (string, error) {
+	if err := validateRule(rule); err != nil {
+		return "", err
+	}
+	if rule.SpaceId == "" {
+		return "", fmt.Errorf("%w: space is required", ErrBadInput)
+	}
+	raw, err := encodeRule(rule, s.deviceID)
+	if err != nil {
+		return "", err
+	}
+	id, _, err := s.objectCreator.CreateObject(ctx, rule.SpaceId, objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyPage,
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():           pbtypes.String(rule.Name),
+			bundle.RelationKeyIsHidden.String():       pbtypes.Bool(true),
+			bundle.RelationKeyAutomationRule.String(): pbtypes.String(raw),
+		}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create object of rule: %w", err)
+	}
+	return id, nil
+}
+
+// Update replaces definition of the existing rule, so the rule is executed by this device from now on.
+// Rule is removed as any other object
+func (s *service) Update(rule *pb.RpcAutomationRule) error {
+	if err := validateRule(rule); err != nil {
+		return err
+	}
+	details, err := s.objectStore.GetDetails(rule.Id)
+	if err != nil {
+		return fmt.Errorf("get details of rule: %w", err)
+	}
+	if rule.Id == "" || !isRule(details.GetDetails()) || isRemoved(details.GetDetails()) {
+		return ErrNotFound
+	}
+	raw, err := encodeRule(rule, s.deviceID)
+	if err != nil {
+		return err
+	}
+	return s.detailsModifier.ModifyDetails(rule.Id, func(current *types.Struct) (*types.Struct, error) {
+		current = pbtypes.CopyStruct(current)
+		current.Fields[bundle.RelationKeyName.String()] = pbtypes.String(rule.Name)
+		current.Fields[bundle.RelationKeyAutomationRule.String()] = pbtypes.String(raw)
+		return current, nil
+	})
+}
+
+// List returns rules of the space sorted by id
+func (s *service) List(spaceID string) ([]*pb.RpcAutomationRule, error) {
+	if spaceID == "" {
+		return nil, fmt.Errorf("%w: space is required", ErrBadInput)
+	}
+	records, _, err := s.objectStore.Query(database.Query{Filters: rulesFilters(spaceID)})
+	if err != nil {
+		return nil, fmt.Errorf("query rules: %w", err)
+	}
+	rules := make([]*pb.RpcAutomationRule, 0, len(records))
+	for _, record := range records {
+		rule, err := decodeRule(pbtypes.GetString(record.Details, bundle.RelationKeyId.String()), record.Details)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Id < rules[j].Id
+	})
+	return rules, nil
+}
+
+// rulesFilters returns filters of objects of rules, rules of all spaces are returned for empty space
+func rulesFilters(spaceID string) []*model.BlockContentDataviewFilter {
+	filters := []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeyAutomationRule.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEmpty,
+		},
+	}
+	if spaceID != "" {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeySpaceId.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(spaceID),
+		})
+	}
+	return filters
+}
+
+func (s *service) loadRules() (map[string]*pb.RpcAutomationRule, error) {
+	records, _, err := s.objectStore.Query(database.Query{Filters: rulesFilters("")})
+	if err != nil {
+		return nil, err
+	}
+	rules := make(map[string]*pb.RpcAutomationRule, len(records))
+	for _, record := range records {
+		id := pbtypes.GetString(record.Details, bundle.RelationKeyId.String())
+		rule, err := decodeRule(id, record.Details)
+		if err != nil {
+			log.With("ruleId", id).Errorf("skip invalid rule: %s", err)
+			continue
+		}
+		rules[id] = rule
+	}
+	return rules, nil
+}
+
+// onChange is called by the object store, so the change is only queued and handled by the loop
+func (s *service) onChange(id string, oldDetails, newDetails *types.Struct) {
+	select {
+	case s.changes <- objectChange{id: id, oldDetails: oldDetails, newDetails: newDetails}:
+	default:
+		log.With("objectId", id).Warnf("automation queue is full, change is skipped")
+	}
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case change := <-s.changes:
+			s.handleChange(change)
+		case <-ticker.C:
+			s.runScheduled()
+		}
+	}
+}
+
+func (s *service) handleChange(change objectChange) {
+	if isRule(change.oldDetails) || isRule(change.newDetails) {
+		s.updateRule(change)
+		return
+	}
+	if change.oldDetails == nil {
+		if _, ok := s.createdByRules[change.id]; ok {
+			delete(s.createdByRules, change.id)
+			return
+		}
+	}
+	// changes made by actions don't fire rules, so rules can't trigger each other endlessly
+	if _, ok := s.changedByRules[change.id]; ok && change.oldDetails != nil {
+		delete(s.changedByRules, change.id)
+		return
+	}
+	if isRemoved(change.newDetails) {
+		return
+	}
+	for _, rule := range s.sortedRules() {
+		if s.isOwnRule(rule) && triggered(rule, change, s.started) && s.meetsConditions(rule, change.newDetails) {
+			s.apply(rule, change.id)
+		}
+	}
+}
+
+func (s *service) updateRule(change objectChange) {
+	if !isRule(change.newDetails) || isRemoved(change.newDetails) {
+		delete(s.rules, change.id)
+		return
+	}
+	rule, err := decodeRule(change.id, change.newDetails)
+	if err != nil {
+		log.With("ruleId", change.id).Errorf("skip invalid rule: %s", err)
+		delete(s.rules, change.id)
+		return
+	}
+	s.rules[change.id] = rule
+}
+
+// isOwnRule checks that the rule is executed by this device. Rules are executed by one device, because
+// results of actions, e.g. current date or created objects, differ between devices
+func (s *service) isOwnRule(rule *pb.RpcAutomationRule) bool {
+	return rule.DeviceId != "" && rule.DeviceId == s.deviceID
+}
+
+// sortedRules returns rules in the stable order, so rules are applied in the same order on every device
+func (s *service) sortedRules() []*pb.RpcAutomationRule {
+	rules := make([]*pb.RpcAutomationRule, 0, len(s.rules))
+	for _, rule := range s.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Id < rules[j].Id
+	})
+	return rules
+}
+
+func (s *service) meetsConditions(rule *pb.RpcAutomationRule, details *types.Struct) bool {
+	if len(rule.Conditions) == 0 {
+		return true
+	}
+	filters, err := database.MakeFiltersAnd(rule.Conditions, s.objectStore)
+	if err != nil {
+		log.With("ruleId", rule.Id).Errorf("make conditions: %s", err)
+		return false
+	}
+	return filters.FilterObject(pbtypes.ValueGetter(details))
+}
+
+// runScheduled applies scheduled rules, which interval is passed, to all objects meeting their conditions
+func (s *service) runScheduled() {
+	now := s.now()
+	for _, rule := range s.sortedRules() {
+		if !rule.Enabled || rule.Trigger.GetType() != pb.RpcAutomationTrigger_Schedule || !s.isOwnRule(rule) {
+			continue
+		}
+		lastRun, err := s.lastRun(rule.Id)
+		if err != nil {
+			log.With("ruleId", rule.Id).Errorf("get last run: %s", err)
+			continue
+		}
+		// the first run is made after the interval, not on creation of the rule
+		if lastRun.IsZero() {
+			s.saveLastRun(rule.Id, now)
+			continue
+		}
+		if now.Sub(lastRun) < time.Duration(rule.Trigger.IntervalSeconds)*time.Second {
+			continue
+		}
+		s.saveLastRun(rule.Id, now)
+		if err = s.runRule(rule); err != nil {
+			log.With("ruleId", rule.Id).Errorf("run scheduled rule: %s", err)
+		}
+	}
+}
+
+func (s *service) runRule(rule *pb.RpcAutomationRule) error {
+	filters := append([]*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeySpaceId.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(rule.SpaceId),
+		},
+		{
+			RelationKey: bundle.RelationKeyAutomationRule.String(),
+			Condition:   model.BlockContentDataviewFilter_Empty,
+		},
+	}, rule.Conditions...)
+	if typeID := rule.Trigger.ObjectTypeId; typeID != "" {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyType.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(typeID),
+		})
+	}
+	ids, _, err := s.objectStore.QueryObjectIDs(database.Query{Filters: filters})
+	if err != nil {
+		return fmt.Errorf("query objects: %w", err)
+	}
+	for _, id := range ids {
+		if s.ctx.Err() != nil {
+			return s.ctx.Err()
+		}
+		s.apply(rule, id)
+	}
+	return nil
+}
+
+func (s *service) lastRun(ruleID string) (time.Time, error) {
+	lastRun, err := badgerhelper.GetValue(s.db, []byte(lastRunKeyPrefix+ruleID), badgerhelper.UnmarshalInt)
+	if badgerhelper.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(lastRun), 0), nil
+}
+
+func (s *service) saveLastRun(ruleID string, t time.Time) {
+	if err := badgerhelper.SetValue(s.db, []byte(lastRunKeyPrefix+ruleID), int(t.Unix())); err != nil {
+		log.With("ruleId", ruleID).Errorf("save last run: %s", err)
+	}
+}
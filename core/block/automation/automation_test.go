@@ -0,0 +1,218 @@
+package automation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type fakeObjects struct {
+	details     map[string]*types.Struct
+	collections map[string][]string
+}
+
+func (f *fakeObjects) ModifyDetails(objectId string, modifier func(current *types.Struct) (*types.Struct, error)) error {
+	details, err := modifier(pbtypes.CopyStruct(f.details[objectId]))
+	if err != nil {
+		return err
+	}
+	f.details[objectId] = details
+	return nil
+}
+
+func (f *fakeObjects) Add(_ session.Context, req *pb.RpcObjectCollectionAddRequest) error {
+	f.collections[req.ContextId] = append(f.collections[req.ContextId], req.ObjectIds...)
+	return nil
+}
+
+type fixture struct {
+	*service
+	objects *fakeObjects
+	events  []*pb.Event
+}
+
+func newFixture(t *testing.T, rules ...*pb.RpcAutomationRule) *fixture {
+	now := time.Unix(2000, 0)
+	fx := &fixture{
+		objects: &fakeObjects{details: map[string]*types.Struct{}, collections: map[string][]string{}},
+	}
+	fx.service = &service{
+		now:            func() time.Time { return now },
+		rules:          map[string]*pb.RpcAutomationRule{},
+		deviceID:       "device1",
+		createdByRules: map[string]struct{}{},
+		changedByRules: map[string]struct{}{},
+		started:        time.Unix(1000, 0),
+	}
+	fx.detailsModifier = fx.objects
+	fx.collections = fx.objects
+	fx.eventSender = event.NewCallbackSender(func(e *pb.Event) {
+		fx.events = append(fx.events, e)
+	})
+	for _, rule := range rules {
+		fx.rules[rule.Id] = rule
+	}
+	return fx
+}
+
+func taskDetails(status string) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeySpaceId.String(): pbtypes.String("space1"),
+		"status":                           pbtypes.String(status),
+	}}
+}
+
+func TestHandleChange(t *testing.T) {
+	rule := &pb.RpcAutomationRule{
+		Id:       "rule1",
+		SpaceId:  "space1",
+		Enabled:  true,
+		DeviceId: "device1",
+		Trigger:  &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_RelationChanged, RelationKey: "status"},
+		Actions: []*pb.RpcAutomationAction{
+			{Type: pb.RpcAutomationAction_SetRelation, RelationKey: "completedDate", CurrentDate: true},
+			{Type: pb.RpcAutomationAction_AddToCollection, CollectionId: "done"},
+			{Type: pb.RpcAutomationAction_SendEvent, Message: "task is done"},
+		},
+	}
+
+	t.Run("actions are applied", func(t *testing.T) {
+		// given
+		fx := newFixture(t, rule)
+		fx.objects.details["task1"] = taskDetails("done")
+
+		// when
+		fx.handleChange(objectChange{id: "task1", oldDetails: taskDetails("todo"), newDetails: taskDetails("done")})
+
+		// then
+		assert.Equal(t, int64(2000), pbtypes.GetInt64(fx.objects.details["task1"], "completedDate"))
+		assert.Equal(t, []string{"task1"}, fx.objects.collections["done"])
+		require.Len(t, fx.events, 1)
+		assert.Equal(t, &pb.EventAutomationExecuted{
+			RuleId:   "rule1",
+			SpaceId:  "space1",
+			ObjectId: "task1",
+			Message:  "task is done",
+		}, fx.events[0].Messages[0].GetAutomationExecuted())
+	})
+	t.Run("removed object is skipped", func(t *testing.T) {
+		// given
+		fx := newFixture(t, rule)
+		details := taskDetails("done")
+		details.Fields[bundle.RelationKeyIsArchived.String()] = pbtypes.Bool(true)
+
+		// when
+		fx.handleChange(objectChange{id: "task1", oldDetails: taskDetails("todo"), newDetails: details})
+
+		// then
+		assert.Empty(t, fx.objects.collections)
+		assert.Empty(t, fx.events)
+	})
+	t.Run("object created by rule is skipped", func(t *testing.T) {
+		// given
+		created := &pb.RpcAutomationRule{
+			Id:       "rule2",
+			SpaceId:  "space1",
+			Enabled:  true,
+			DeviceId: "device1",
+			Trigger:  &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_ObjectCreated},
+			Actions:  []*pb.RpcAutomationAction{{Type: pb.RpcAutomationAction_SendEvent}},
+		}
+		fx := newFixture(t, created)
+		fx.createdByRules["task2"] = struct{}{}
+		details := taskDetails("")
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(1500)
+
+		// when
+		fx.handleChange(objectChange{id: "task2", newDetails: details})
+		fx.handleChange(objectChange{id: "task3", newDetails: details})
+
+		// then
+		require.Len(t, fx.events, 1)
+		assert.Equal(t, "task3", fx.events[0].Messages[0].GetAutomationExecuted().ObjectId)
+		assert.Empty(t, fx.createdByRules)
+	})
+	t.Run("change made by action doesn't fire rules", func(t *testing.T) {
+		// given
+		completed := &pb.RpcAutomationRule{
+			Id:       "rule2",
+			SpaceId:  "space1",
+			Enabled:  true,
+			DeviceId: "device1",
+			Trigger:  &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_RelationChanged, RelationKey: "completedDate"},
+			Actions:  []*pb.RpcAutomationAction{{Type: pb.RpcAutomationAction_SendEvent, Message: "completed"}},
+		}
+		fx := newFixture(t, rule, completed)
+		fx.objects.details["task1"] = taskDetails("done")
+		fx.handleChange(objectChange{id: "task1", oldDetails: taskDetails("todo"), newDetails: taskDetails("done")})
+		fx.events = nil
+
+		// when
+		fx.handleChange(objectChange{id: "task1", oldDetails: taskDetails("done"), newDetails: fx.objects.details["task1"]})
+
+		// then
+		assert.Empty(t, fx.events)
+		assert.Empty(t, fx.changedByRules)
+
+		// when
+		changed := pbtypes.CopyStruct(fx.objects.details["task1"])
+		changed.Fields["completedDate"] = pbtypes.Int64(3000)
+		fx.handleChange(objectChange{id: "task1", oldDetails: fx.objects.details["task1"], newDetails: changed})
+
+		// then
+		require.Len(t, fx.events, 1)
+		assert.Equal(t, "completed", fx.events[0].Messages[0].GetAutomationExecuted().Message)
+	})
+	t.Run("rule of other device is skipped", func(t *testing.T) {
+		// given
+		other := &pb.RpcAutomationRule{
+			Id:       "rule2",
+			SpaceId:  "space1",
+			Enabled:  true,
+			DeviceId: "device2",
+			Trigger:  &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_RelationChanged, RelationKey: "status"},
+			Actions:  []*pb.RpcAutomationAction{{Type: pb.RpcAutomationAction_SendEvent}},
+		}
+		fx := newFixture(t, other)
+
+		// when
+		fx.handleChange(objectChange{id: "task1", oldDetails: taskDetails("todo"), newDetails: taskDetails("done")})
+
+		// then
+		assert.Empty(t, fx.events)
+	})
+	t.Run("rules are updated by changes of their objects", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		raw, err := encodeRule(rule, "device1")
+		require.NoError(t, err)
+		details := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeySpaceId.String():        pbtypes.String("space1"),
+			bundle.RelationKeyAutomationRule.String(): pbtypes.String(raw),
+		}}
+		removed := pbtypes.CopyStruct(details)
+		removed.Fields[bundle.RelationKeyIsDeleted.String()] = pbtypes.Bool(true)
+
+		// when
+		fx.handleChange(objectChange{id: "rule1", newDetails: details})
+
+		// then
+		require.Contains(t, fx.rules, "rule1")
+		assert.Equal(t, rule.Actions, fx.rules["rule1"].Actions)
+
+		// when
+		fx.handleChange(objectChange{id: "rule1", oldDetails: details, newDetails: removed})
+
+		// then
+		assert.Empty(t, fx.rules)
+	})
+}
@@ -0,0 +1,142 @@
+package automation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// minScheduleInterval limits how often scheduled rule is applied to all objects of the space
+const minScheduleInterval = time.Minute
+
+// objectChange is the change of details of the object taken from the object store
+type objectChange struct {
+	id         string
+	oldDetails *types.Struct
+	newDetails *types.Struct
+}
+
+// encodeRule returns value of automationRule relation, which is executed by the device. Id and space
+// of the rule are taken from the object
+func encodeRule(rule *pb.RpcAutomationRule, deviceID string) (string, error) {
+	stored := &pb.RpcAutomationRule{
+		Name:       rule.Name,
+		Enabled:    rule.Enabled,
+		Trigger:    rule.Trigger,
+		Conditions: rule.Conditions,
+		Actions:    rule.Actions,
+		DeviceId:   deviceID,
+	}
+	return (&jsonpb.Marshaler{}).MarshalToString(stored)
+}
+
+func decodeRule(id string, details *types.Struct) (*pb.RpcAutomationRule, error) {
+	rule := &pb.RpcAutomationRule{}
+	err := jsonpb.UnmarshalString(pbtypes.GetString(details, bundle.RelationKeyAutomationRule.String()), rule)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal rule: %w", err)
+	}
+	rule.Id = id
+	rule.SpaceId = pbtypes.GetString(details, bundle.RelationKeySpaceId.String())
+	return rule, nil
+}
+
+func validateRule(rule *pb.RpcAutomationRule) error {
+	if rule == nil {
+		return fmt.Errorf("%w: rule is empty", ErrBadInput)
+	}
+	trigger := rule.Trigger
+	if trigger == nil {
+		return fmt.Errorf("%w: trigger is required", ErrBadInput)
+	}
+	switch trigger.Type {
+	case pb.RpcAutomationTrigger_ObjectCreated:
+	case pb.RpcAutomationTrigger_RelationChanged:
+		if trigger.RelationKey == "" {
+			return fmt.Errorf("%w: relation of trigger is required", ErrBadInput)
+		}
+	case pb.RpcAutomationTrigger_Schedule:
+		if time.Duration(trigger.IntervalSeconds)*time.Second < minScheduleInterval {
+			return fmt.Errorf("%w: interval of schedule should be at least %s", ErrBadInput, minScheduleInterval)
+		}
+	default:
+		return fmt.Errorf("%w: unknown trigger %s", ErrBadInput, trigger.Type)
+	}
+	if len(rule.Actions) == 0 {
+		return fmt.Errorf("%w: at least one action is required", ErrBadInput)
+	}
+	for _, action := range rule.Actions {
+		if err := validateAction(trigger, action); err != nil {
+			return fmt.Errorf("%w: %s action: %w", ErrBadInput, action.GetType(), err)
+		}
+	}
+	return nil
+}
+
+func validateAction(trigger *pb.RpcAutomationTrigger, action *pb.RpcAutomationAction) error {
+	if action == nil {
+		return fmt.Errorf("action is empty")
+	}
+	switch action.Type {
+	case pb.RpcAutomationAction_SetRelation:
+		if action.RelationKey == "" {
+			return fmt.Errorf("relation is required")
+		}
+		if action.Value == nil && !action.CurrentDate {
+			return fmt.Errorf("value is required")
+		}
+		// otherwise every execution of the rule fires it again
+		if trigger.Type == pb.RpcAutomationTrigger_RelationChanged && trigger.RelationKey == action.RelationKey {
+			return fmt.Errorf("relation of trigger can't be changed by the rule")
+		}
+	case pb.RpcAutomationAction_AddToCollection:
+		if action.CollectionId == "" {
+			return fmt.Errorf("collection is required")
+		}
+	case pb.RpcAutomationAction_CreateLinkedObject:
+		if action.ObjectTypeKey == "" || action.RelationKey == "" {
+			return fmt.Errorf("type of object and relation are required")
+		}
+	case pb.RpcAutomationAction_SendEvent:
+	default:
+		return fmt.Errorf("unknown action")
+	}
+	return nil
+}
+
+// triggered checks if the change of the object fires trigger of the rule. Objects, which are created before
+// the start of the service, aren't treated as created, so rules aren't applied again when objects are reindexed
+func triggered(rule *pb.RpcAutomationRule, change objectChange, started time.Time) bool {
+	details := change.newDetails
+	trigger := rule.Trigger
+	if !rule.Enabled || trigger == nil || pbtypes.GetString(details, bundle.RelationKeySpaceId.String()) != rule.SpaceId {
+		return false
+	}
+	if typeID := trigger.ObjectTypeId; typeID != "" && pbtypes.GetString(details, bundle.RelationKeyType.String()) != typeID {
+		return false
+	}
+	switch trigger.Type {
+	case pb.RpcAutomationTrigger_ObjectCreated:
+		return change.oldDetails == nil && pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()) >= started.Unix()
+	case pb.RpcAutomationTrigger_RelationChanged:
+		key := trigger.RelationKey
+		return change.oldDetails != nil && !pbtypes.Get(change.oldDetails, key).Equal(pbtypes.Get(details, key))
+	default:
+		return false
+	}
+}
+
+func isRule(details *types.Struct) bool {
+	return pbtypes.GetString(details, bundle.RelationKeyAutomationRule.String()) != ""
+}
+
+func isRemoved(details *types.Struct) bool {
+	return pbtypes.GetBool(details, bundle.RelationKeyIsDeleted.String()) ||
+		pbtypes.GetBool(details, bundle.RelationKeyIsArchived.String())
+}
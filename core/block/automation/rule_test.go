@@ -0,0 +1,150 @@
+package automation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func statusRule() *pb.RpcAutomationRule {
+	return &pb.RpcAutomationRule{
+		Id:      "rule1",
+		SpaceId: "space1",
+		Name:    "Completed date",
+		Enabled: true,
+		Trigger: &pb.RpcAutomationTrigger{
+			Type:        pb.RpcAutomationTrigger_RelationChanged,
+			RelationKey: "status",
+		},
+		Conditions: []*model.BlockContentDataviewFilter{
+			{RelationKey: "status", Condition: model.BlockContentDataviewFilter_Equal, Value: pbtypes.String("done")},
+		},
+		Actions: []*pb.RpcAutomationAction{
+			{Type: pb.RpcAutomationAction_SetRelation, RelationKey: "completedDate", CurrentDate: true},
+		},
+	}
+}
+
+func TestEncodeRule(t *testing.T) {
+	// given
+	rule := statusRule()
+	rule.DeviceId = "device1"
+
+	// when
+	raw, err := encodeRule(rule, "device1")
+	require.NoError(t, err)
+	decoded, err := decodeRule("rule1", &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeySpaceId.String():        pbtypes.String("space1"),
+		bundle.RelationKeyAutomationRule.String(): pbtypes.String(raw),
+	}})
+
+	// then
+	require.NoError(t, err)
+	assert.NotContains(t, raw, "rule1")
+	assert.Equal(t, rule, decoded)
+}
+
+func TestValidateRule(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(rule *pb.RpcAutomationRule)
+		valid  bool
+	}{
+		{name: "valid", modify: func(*pb.RpcAutomationRule) {}, valid: true},
+		{name: "no trigger", modify: func(rule *pb.RpcAutomationRule) { rule.Trigger = nil }},
+		{name: "no actions", modify: func(rule *pb.RpcAutomationRule) { rule.Actions = nil }},
+		{name: "no relation of trigger", modify: func(rule *pb.RpcAutomationRule) { rule.Trigger.RelationKey = "" }},
+		{
+			name: "short interval of schedule",
+			modify: func(rule *pb.RpcAutomationRule) {
+				rule.Trigger = &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_Schedule, IntervalSeconds: 10}
+			},
+		},
+		{
+			name:   "action changes relation of trigger",
+			modify: func(rule *pb.RpcAutomationRule) { rule.Actions[0].RelationKey = "status" },
+		},
+		{
+			name: "linked object without relation",
+			modify: func(rule *pb.RpcAutomationRule) {
+				rule.Actions = append(rule.Actions, &pb.RpcAutomationAction{
+					Type:          pb.RpcAutomationAction_CreateLinkedObject,
+					ObjectTypeKey: bundle.TypeKeyTask.String(),
+				})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rule := statusRule()
+			tc.modify(rule)
+
+			// when
+			err := validateRule(rule)
+
+			// then
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrBadInput)
+			}
+		})
+	}
+}
+
+func TestTriggered(t *testing.T) {
+	started := time.Unix(1000, 0)
+	details := func(status string, created int64) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeySpaceId.String():     pbtypes.String("space1"),
+			bundle.RelationKeyType.String():        pbtypes.String("task"),
+			bundle.RelationKeyCreatedDate.String(): pbtypes.Int64(created),
+			"status":                               pbtypes.String(status),
+		}}
+	}
+
+	t.Run("relation is changed", func(t *testing.T) {
+		change := objectChange{id: "obj", oldDetails: details("todo", 1), newDetails: details("done", 1)}
+		assert.True(t, triggered(statusRule(), change, started))
+	})
+	t.Run("other relation is changed", func(t *testing.T) {
+		change := objectChange{id: "obj", oldDetails: details("done", 1), newDetails: details("done", 2)}
+		assert.False(t, triggered(statusRule(), change, started))
+	})
+	t.Run("object of other space", func(t *testing.T) {
+		rule := statusRule()
+		rule.SpaceId = "space2"
+		change := objectChange{id: "obj", oldDetails: details("todo", 1), newDetails: details("done", 1)}
+		assert.False(t, triggered(rule, change, started))
+	})
+	t.Run("object of other type", func(t *testing.T) {
+		rule := statusRule()
+		rule.Trigger.ObjectTypeId = "note"
+		change := objectChange{id: "obj", oldDetails: details("todo", 1), newDetails: details("done", 1)}
+		assert.False(t, triggered(rule, change, started))
+	})
+	t.Run("disabled rule", func(t *testing.T) {
+		rule := statusRule()
+		rule.Enabled = false
+		change := objectChange{id: "obj", oldDetails: details("todo", 1), newDetails: details("done", 1)}
+		assert.False(t, triggered(rule, change, started))
+	})
+	t.Run("object is created", func(t *testing.T) {
+		rule := statusRule()
+		rule.Trigger = &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_ObjectCreated}
+		assert.True(t, triggered(rule, objectChange{id: "obj", newDetails: details("", 1001)}, started))
+	})
+	t.Run("object created before start is indexed", func(t *testing.T) {
+		rule := statusRule()
+		rule.Trigger = &pb.RpcAutomationTrigger{Type: pb.RpcAutomationTrigger_ObjectCreated}
+		assert.False(t, triggered(rule, objectChange{id: "obj", newDetails: details("", 999)}, started))
+	})
+}
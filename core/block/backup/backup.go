@@ -0,0 +1,333 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "backup"
+
+var log = logging.Logger("anytype-mw-backup")
+
+var ErrInvalidSchedule = errors.New("invalid backup schedule")
+
+const (
+	scheduleKey = "/backup/schedule"
+	// dirPrefix marks directories of backups, so only they are removed by rotation
+	dirPrefix   = "anytype-backup-"
+	timeLayout  = "20060102-150405"
+	minInterval = time.Hour
+)
+
+// Service periodically exports all spaces in protobuf format to the directory of schedule
+// and removes old backups, so only the given number of the newest ones are kept
+type Service interface {
+	GetSchedule() (*pb.RpcBackupSchedule, error)
+	SetSchedule(schedule *pb.RpcBackupSchedule) error
+	app.ComponentRunnable
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+type service struct {
+	exporter    export.Export
+	objectStore objectstore.ObjectStore
+	eventSender event.Sender
+	db          *badger.DB
+
+	// changed wakes up the loop to reschedule the next backup
+	changed chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	now     func() time.Time
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.exporter = app.MustComponent[export.Export](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	s.changed = make(chan struct{}, 1)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *service) GetSchedule() (*pb.RpcBackupSchedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getSchedule()
+}
+
+func (s *service) getSchedule() (*pb.RpcBackupSchedule, error) {
+	schedule, err := badgerhelper.GetValue(s.db, []byte(scheduleKey), func(raw []byte) (*pb.RpcBackupSchedule, error) {
+		schedule := &pb.RpcBackupSchedule{}
+		return schedule, schedule.Unmarshal(raw)
+	})
+	if badgerhelper.IsNotFound(err) {
+		return &pb.RpcBackupSchedule{}, nil
+	}
+	return schedule, err
+}
+
+// SetSchedule saves the schedule and starts the first backup after its interval
+func (s *service) SetSchedule(schedule *pb.RpcBackupSchedule) error {
+	if err := validateSchedule(schedule); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current, err := s.getSchedule()
+	if err != nil {
+		return fmt.Errorf("get schedule: %w", err)
+	}
+	schedule = &pb.RpcBackupSchedule{
+		Enabled:         schedule.Enabled,
+		Path:            schedule.Path,
+		IntervalSeconds: schedule.IntervalSeconds,
+		KeepCount:       schedule.KeepCount,
+		LastBackupAt:    current.LastBackupAt,
+	}
+	if schedule.LastBackupAt == 0 {
+		// the first backup is made after the interval, not on enabling
+		schedule.LastBackupAt = s.now().Unix()
+	}
+	if err = badgerhelper.SetValue(s.db, []byte(scheduleKey), schedule); err != nil {
+		return fmt.Errorf("save schedule: %w", err)
+	}
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func validateSchedule(schedule *pb.RpcBackupSchedule) error {
+	if schedule == nil {
+		return fmt.Errorf("%w: schedule is empty", ErrInvalidSchedule)
+	}
+	if !schedule.Enabled {
+		return nil
+	}
+	if !filepath.IsAbs(schedule.Path) {
+		return fmt.Errorf("%w: path should be absolute", ErrInvalidSchedule)
+	}
+	if time.Duration(schedule.IntervalSeconds)*time.Second < minInterval {
+		return fmt.Errorf("%w: interval should be at least %s", ErrInvalidSchedule, minInterval)
+	}
+	if schedule.KeepCount < 0 {
+		return fmt.Errorf("%w: keep count should not be negative", ErrInvalidSchedule)
+	}
+	return nil
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		wait, ok := s.nextBackupIn()
+		var (
+			timer *time.Timer
+			fire  <-chan time.Time
+		)
+		if ok {
+			timer = time.NewTimer(wait)
+			fire = timer.C
+		}
+		select {
+		case <-s.ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-s.changed:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-fire:
+			s.runBackup()
+		}
+	}
+}
+
+// nextBackupIn returns time left to the next backup, ok is false if backups are disabled
+func (s *service) nextBackupIn() (wait time.Duration, ok bool) {
+	schedule, err := s.GetSchedule()
+	if err != nil {
+		log.Errorf("get backup schedule: %v", err)
+		return 0, false
+	}
+	if !schedule.Enabled {
+		return 0, false
+	}
+	next := time.Unix(schedule.LastBackupAt, 0).Add(time.Duration(schedule.IntervalSeconds) * time.Second)
+	if wait = next.Sub(s.now()); wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+func (s *service) runBackup() {
+	schedule, err := s.GetSchedule()
+	if err != nil || !schedule.Enabled {
+		return
+	}
+	started := s.now()
+	path := filepath.Join(schedule.Path, dirPrefix+started.Format(timeLayout))
+	count, err := s.backup(path)
+	if err != nil {
+		log.Errorf("backup failed: %v", err)
+		s.sendEvent(&pb.EventMessage{Value: &pb.EventMessageValueOfBackupError{
+			BackupError: &pb.EventBackupError{Path: path, Error: err.Error()},
+		}})
+	} else {
+		s.sendEvent(&pb.EventMessage{Value: &pb.EventMessageValueOfBackupDone{
+			BackupDone: &pb.EventBackupDone{Path: path, SpacesCount: int32(count)},
+		}})
+		if err = rotate(schedule.Path, int(schedule.KeepCount)); err != nil {
+			log.Errorf("remove old backups: %v", err)
+		}
+	}
+	// failed backup is tried again after the interval too, so broken path doesn't make backups in loop
+	if err = s.updateLastBackupAt(started); err != nil {
+		log.Errorf("save backup time: %v", err)
+	}
+}
+
+// backup exports every space to its own folder of path and returns the number of exported spaces
+func (s *service) backup(path string) (int, error) {
+	spaceIDs, err := SpaceIDs(s.objectStore)
+	if err != nil {
+		return 0, fmt.Errorf("get spaces: %w", err)
+	}
+	for _, spaceID := range spaceIDs {
+		spacePath := filepath.Join(path, spaceID)
+		if err = os.MkdirAll(spacePath, 0700); err != nil {
+			return 0, fmt.Errorf("create backup directory: %w", err)
+		}
+		_, _, err = s.exporter.Export(s.ctx, pb.RpcObjectListExportRequest{
+			SpaceId:         spaceID,
+			Path:            spacePath,
+			Format:          pb.RpcObjectListExport_Protobuf,
+			Zip:             true,
+			IncludeNested:   true,
+			IncludeFiles:    true,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("export space %s: %w", spaceID, err)
+		}
+	}
+	return len(spaceIDs), nil
+}
+
+// SpaceIDs returns ids of all spaces of the account
+func SpaceIDs(objectStore objectstore.ObjectStore) ([]string, error) {
+	records, _, err := objectStore.Query(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_spaceView)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	spaceIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		if spaceID := pbtypes.GetString(record.Details, bundle.RelationKeyTargetSpaceId.String()); spaceID != "" {
+			spaceIDs = append(spaceIDs, spaceID)
+		}
+	}
+	return spaceIDs, nil
+}
+
+func (s *service) updateLastBackupAt(t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	schedule, err := s.getSchedule()
+	if err != nil {
+		return err
+	}
+	schedule.LastBackupAt = t.Unix()
+	return badgerhelper.SetValue(s.db, []byte(scheduleKey), schedule)
+}
+
+func (s *service) sendEvent(msg *pb.EventMessage) {
+	s.eventSender.Broadcast(&pb.Event{Messages: []*pb.EventMessage{msg}})
+}
+
+// rotate removes the oldest backups of path, so only keepCount of them are left. Names of backups contain time,
+// so they are sorted by it
+func rotate(path string, keepCount int) error {
+	if keepCount <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), dirPrefix) {
+			backups = append(backups, entry.Name())
+		}
+	}
+	if len(backups) <= keepCount {
+		return nil
+	}
+	sort.Strings(backups)
+	for _, name := range backups[:len(backups)-keepCount] {
+		if err = os.RemoveAll(filepath.Join(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
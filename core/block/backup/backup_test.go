@@ -0,0 +1,80 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestRotate(t *testing.T) {
+	t.Run("oldest backups are removed", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		for _, name := range []string{
+			dirPrefix + "20230102-100000",
+			dirPrefix + "20230101-100000",
+			dirPrefix + "20230103-100000",
+			"other",
+		} {
+			require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0700))
+		}
+
+		// when
+		err := rotate(dir, 2)
+
+		// then
+		require.NoError(t, err)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		assert.Equal(t, []string{dirPrefix + "20230102-100000", dirPrefix + "20230103-100000", "other"}, names)
+	})
+
+	t.Run("all backups are kept if keep count is zero", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(dir, dirPrefix+"20230101-100000"), 0700))
+
+		// when
+		err := rotate(dir, 0)
+
+		// then
+		require.NoError(t, err)
+		assert.DirExists(t, filepath.Join(dir, dirPrefix+"20230101-100000"))
+	})
+}
+
+func TestValidateSchedule(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		schedule *pb.RpcBackupSchedule
+		valid    bool
+	}{
+		{"disabled", &pb.RpcBackupSchedule{}, true},
+		{"valid", &pb.RpcBackupSchedule{Enabled: true, Path: "/backups", IntervalSeconds: 86400, KeepCount: 3}, true},
+		{"relative path", &pb.RpcBackupSchedule{Enabled: true, Path: "backups", IntervalSeconds: 86400}, false},
+		{"short interval", &pb.RpcBackupSchedule{Enabled: true, Path: "/backups", IntervalSeconds: 60}, false},
+		{"negative keep count", &pb.RpcBackupSchedule{Enabled: true, Path: "/backups", IntervalSeconds: 86400, KeepCount: -1}, false},
+		{"empty", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := validateSchedule(tc.schedule)
+
+			// then
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidSchedule)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package blocktransform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "blocktransform"
+
+var log = logging.Logger("anytype-mw-blocktransform")
+
+var ErrBadInput = errors.New("bad input")
+
+// Result contains the number of objects and blocks changed by the transform
+type Result struct {
+	ChangedObjects int64
+	ChangedBlocks  int64
+}
+
+// Service applies the same transformation to blocks of many objects, e.g. to clean up objects after import.
+// Transform is shown to the client as process, which can be canceled. Objects changed before cancellation
+// keep their changes
+type Service interface {
+	Transform(ctx context.Context, req *pb.RpcObjectListTransformBlocksRequest) (Result, error)
+	app.Component
+}
+
+func New() Service {
+	return &service{}
+}
+
+type service struct {
+	objectStore    objectstore.ObjectStore
+	picker         getblock.ObjectGetter
+	processService process.Service
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.picker = app.MustComponent[getblock.ObjectGetter](a)
+	s.processService = app.MustComponent[process.Service](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+// Transform changes objects one by one, every object is changed in its own transaction. Objects, which fail to
+// change, are skipped
+func (s *service) Transform(ctx context.Context, req *pb.RpcObjectListTransformBlocksRequest) (result Result, err error) {
+	transform, err := newTransformer(req.Transform)
+	if err != nil {
+		return result, err
+	}
+	ids, err := s.objectIDs(req)
+	if err != nil {
+		return result, err
+	}
+
+	progress := process.NewProgress(pb.ModelProcess_BlockTransform)
+	if err = s.processService.Add(progress); err != nil {
+		return result, fmt.Errorf("add process: %w", err)
+	}
+	defer func() {
+		progress.Finish(err)
+	}()
+	progress.SetProgressMessage("Transform blocks")
+	progress.SetTotal(int64(len(ids)))
+
+	for i, id := range ids {
+		select {
+		case <-progress.Canceled():
+			return result, context.Canceled
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+		changed, err := s.transformObject(id, transform)
+		if err != nil {
+			log.With("objectId", id).Errorf("failed to transform blocks: %s", err)
+		} else if changed > 0 {
+			result.ChangedObjects++
+			result.ChangedBlocks += int64(changed)
+		}
+		progress.SetDone(int64(i + 1))
+	}
+	return result, nil
+}
+
+func (s *service) objectIDs(req *pb.RpcObjectListTransformBlocksRequest) ([]string, error) {
+	if len(req.ObjectIds) > 0 {
+		return req.ObjectIds, nil
+	}
+	if req.SpaceId == "" || len(req.Filters) == 0 {
+		return nil, fmt.Errorf("%w: object ids or space id with filters are required", ErrBadInput)
+	}
+	filters := append([]*model.BlockContentDataviewFilter{{
+		RelationKey: bundle.RelationKeySpaceId.String(),
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.String(req.SpaceId),
+	}}, req.Filters...)
+	ids, _, err := s.objectStore.QueryObjectIDs(database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *service) transformObject(id string, transform transformer) (changed int, err error) {
+	err = getblock.Do(s.picker, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		if changed = transform(st); changed == 0 {
+			return nil
+		}
+		return sb.Apply(st)
+	})
+	return changed, err
+}
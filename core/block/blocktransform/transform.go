@@ -0,0 +1,166 @@
+package blocktransform
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const codeLanguageField = "lang"
+
+// headerBlocks are blocks of the object header, their content is kept in details, so they aren't transformed
+var headerBlocks = []string{
+	template.HeaderLayoutId,
+	template.TitleBlockId,
+	template.DescriptionBlockId,
+	template.FeaturedRelationsId,
+}
+
+// transformer changes blocks of the state and returns the number of changed blocks
+type transformer func(s *state.State) (changedBlocks int)
+
+func newTransformer(transform *pb.RpcObjectListTransformBlocksTransform) (transformer, error) {
+	if transform == nil {
+		return nil, fmt.Errorf("%w: transform is empty", ErrBadInput)
+	}
+	switch transform.Type {
+	case pb.RpcObjectListTransformBlocksTransform_ReplaceText:
+		if transform.Pattern == "" {
+			return nil, fmt.Errorf("%w: pattern is empty", ErrBadInput)
+		}
+		re, err := CompilePattern(transform.Pattern, transform.IsRegex, transform.CaseSensitive)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBadInput, err)
+		}
+		return func(s *state.State) int {
+			return replaceText(s, re, transform.Replacement, transform.IsRegex)
+		}, nil
+	case pb.RpcObjectListTransformBlocksTransform_SetCodeLanguage:
+		if transform.NewLanguage == "" {
+			return nil, fmt.Errorf("%w: new language is empty", ErrBadInput)
+		}
+		return func(s *state.State) int {
+			return setCodeLanguage(s, transform.Language, transform.NewLanguage)
+		}, nil
+	case pb.RpcObjectListTransformBlocksTransform_RemoveEmptyBlocks:
+		return removeEmptyBlocks, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown transform type %d", ErrBadInput, transform.Type)
+	}
+}
+
+// CompilePattern returns regular expression matching the pattern. Pattern is matched literally, if it isn't regex
+func CompilePattern(pattern string, isRegex, caseSensitive bool) (*regexp.Regexp, error) {
+	expr := pattern
+	if !isRegex {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if !caseSensitive {
+		expr = "(?i)" + expr
+	}
+	return regexp.Compile(expr)
+}
+
+// textBlockIDs returns ids of text blocks of the body matching the predicate
+func textBlockIDs(s *state.State, match func(b text.Block) bool) []string {
+	var ids []string
+	s.Iterate(func(b simple.Block) bool {
+		if tb, ok := b.(text.Block); ok && !slices.Contains(headerBlocks, b.Model().Id) && match(tb) {
+			ids = append(ids, b.Model().Id)
+		}
+		return true
+	})
+	return ids
+}
+
+// replaceText replaces all matches of the pattern in text blocks of the body
+func replaceText(s *state.State, re *regexp.Regexp, replacement string, expand bool) int {
+	ids := textBlockIDs(s, func(b text.Block) bool {
+		return re.MatchString(b.GetText())
+	})
+	var changed int
+	for _, id := range ids {
+		if tb, ok := s.Get(id).(text.Block); ok && ReplaceText(tb, re, replacement, expand) {
+			changed++
+		}
+	}
+	return changed
+}
+
+// ReplaceText replaces all matches of the pattern in the text block. Replacement is expanded with groups of
+// the match, if expand is set. Marks of the replaced text are kept on the rest of the text. False is returned,
+// if nothing is replaced
+func ReplaceText(tb text.Block, re *regexp.Regexp, replacement string, expand bool) bool {
+	source := tb.GetText()
+	matches := re.FindAllStringSubmatchIndex(source, -1)
+	var replaced bool
+	// matches are replaced from the end, so positions of previous matches are still valid
+	for i := len(matches) - 1; i >= 0; i-- {
+		match := matches[i]
+		if match[0] == match[1] {
+			continue
+		}
+		newText := replacement
+		if expand {
+			newText = string(re.ExpandString(nil, replacement, source, match))
+		}
+		from := int32(textutil.UTF16RuneCountString(source[:match[0]]))
+		to := int32(textutil.UTF16RuneCountString(source[:match[1]]))
+		if _, err := tb.RangeTextPaste(from, to, plainTextBlock(newText), false); err != nil {
+			log.Errorf("replace text of block %s: %s", tb.Model().Id, err)
+			continue
+		}
+		replaced = true
+	}
+	return replaced
+}
+
+func plainTextBlock(value string) *model.Block {
+	return &model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  value,
+		Marks: &model.BlockContentTextMarks{},
+	}}}
+}
+
+// setCodeLanguage changes language of code blocks. All code blocks are changed, if language is empty
+func setCodeLanguage(s *state.State, language, newLanguage string) int {
+	ids := textBlockIDs(s, func(b text.Block) bool {
+		if b.Model().GetText().GetStyle() != model.BlockContentText_Code {
+			return false
+		}
+		current := pbtypes.GetString(b.Model().Fields, codeLanguageField)
+		return current != newLanguage && (language == "" || current == language)
+	})
+	for _, id := range ids {
+		b := s.Get(id).Model()
+		fields := pbtypes.CopyStruct(b.Fields)
+		if fields == nil || fields.Fields == nil {
+			fields = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		fields.Fields[codeLanguageField] = pbtypes.String(newLanguage)
+		b.Fields = fields
+	}
+	return len(ids)
+}
+
+// removeEmptyBlocks removes text blocks without text, style and children
+func removeEmptyBlocks(s *state.State) int {
+	ids := textBlockIDs(s, func(b text.Block) bool {
+		return b.IsEmpty() && len(b.Model().ChildrenIds) == 0
+	})
+	for _, id := range ids {
+		s.Unlink(id)
+	}
+	return len(ids)
+}
@@ -0,0 +1,140 @@
+package blocktransform
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newState(blocks ...*model.Block) *state.State {
+	root := &model.Block{Id: "root", Content: &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}}
+	doc := map[string]simple.Block{"root": simple.New(root)}
+	for _, b := range blocks {
+		root.ChildrenIds = append(root.ChildrenIds, b.Id)
+		doc[b.Id] = simple.New(b)
+	}
+	return state.NewDoc("root", doc).(*state.State).NewState()
+}
+
+func textBlock(id, text string, style model.BlockContentTextStyle, marks ...*model.BlockContentTextMark) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  text,
+		Style: style,
+		Marks: &model.BlockContentTextMarks{Marks: marks},
+	}}}
+}
+
+func codeBlock(id, language string) *model.Block {
+	b := textBlock(id, "fmt.Println()", model.BlockContentText_Code)
+	b.Fields = &types.Struct{Fields: map[string]*types.Value{codeLanguageField: pbtypes.String(language)}}
+	return b
+}
+
+func TestReplaceText(t *testing.T) {
+	t.Run("literal pattern is replaced ignoring case", func(t *testing.T) {
+		// given
+		s := newState(textBlock("1", "Foo and foo", 0), textBlock("2", "bar", 0), textBlock("title", "foo", model.BlockContentText_Title))
+		transform, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{Pattern: "foo", Replacement: "baz"})
+		require.NoError(t, err)
+
+		// when
+		changed := transform(s)
+
+		// then
+		assert.Equal(t, 1, changed)
+		assert.Equal(t, "baz and baz", s.Pick("1").Model().GetText().Text)
+		assert.Equal(t, "bar", s.Pick("2").Model().GetText().Text)
+		assert.Equal(t, "foo", s.Pick("title").Model().GetText().Text)
+	})
+	t.Run("regular expression with groups", func(t *testing.T) {
+		// given
+		s := newState(textBlock("1", "2023-05-01", 0))
+		transform, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{
+			Pattern:       `(\d+)-(\d+)-(\d+)`,
+			IsRegex:       true,
+			CaseSensitive: true,
+			Replacement:   "$3.$2.$1",
+		})
+		require.NoError(t, err)
+
+		// when
+		changed := transform(s)
+
+		// then
+		assert.Equal(t, 1, changed)
+		assert.Equal(t, "01.05.2023", s.Pick("1").Model().GetText().Text)
+	})
+	t.Run("marks after replaced text are shifted", func(t *testing.T) {
+		// given
+		mark := &model.BlockContentTextMark{Range: &model.Range{From: 8, To: 12}, Type: model.BlockContentTextMark_Bold}
+		s := newState(textBlock("1", "old and bold", 0, mark))
+		transform, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{Pattern: "old ", CaseSensitive: true, Replacement: "new new "})
+		require.NoError(t, err)
+
+		// when
+		transform(s)
+
+		// then
+		text := s.Pick("1").Model().GetText()
+		assert.Equal(t, "new new and bold", text.Text)
+		require.Len(t, text.Marks.Marks, 1)
+		assert.Equal(t, &model.Range{From: 12, To: 16}, text.Marks.Marks[0].Range)
+	})
+	t.Run("invalid regular expression", func(t *testing.T) {
+		// when
+		_, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{Pattern: "(", IsRegex: true})
+
+		// then
+		assert.ErrorIs(t, err, ErrBadInput)
+	})
+}
+
+func TestSetCodeLanguage(t *testing.T) {
+	t.Run("only code blocks of the language are changed", func(t *testing.T) {
+		// given
+		s := newState(codeBlock("1", "js"), codeBlock("2", "go"), textBlock("3", "text", 0))
+		transform, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{
+			Type:        pb.RpcObjectListTransformBlocksTransform_SetCodeLanguage,
+			Language:    "js",
+			NewLanguage: "typescript",
+		})
+		require.NoError(t, err)
+
+		// when
+		changed := transform(s)
+
+		// then
+		assert.Equal(t, 1, changed)
+		assert.Equal(t, "typescript", pbtypes.GetString(s.Pick("1").Model().Fields, codeLanguageField))
+		assert.Equal(t, "go", pbtypes.GetString(s.Pick("2").Model().Fields, codeLanguageField))
+	})
+	t.Run("new language is required", func(t *testing.T) {
+		// when
+		_, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{Type: pb.RpcObjectListTransformBlocksTransform_SetCodeLanguage})
+
+		// then
+		assert.ErrorIs(t, err, ErrBadInput)
+	})
+}
+
+func TestRemoveEmptyBlocks(t *testing.T) {
+	// given
+	s := newState(textBlock("1", "", 0), textBlock("2", "text", 0), textBlock("3", "", model.BlockContentText_Header1), textBlock("description", "", 0))
+	transform, err := newTransformer(&pb.RpcObjectListTransformBlocksTransform{Type: pb.RpcObjectListTransformBlocksTransform_RemoveEmptyBlocks})
+	require.NoError(t, err)
+
+	// when
+	changed := transform(s)
+
+	// then
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, []string{"2", "3", "description"}, s.Pick("root").Model().ChildrenIds)
+}
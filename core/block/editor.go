@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/basic"
 	"github.com/anyproto/anytype-heart/core/block/editor/bookmark"
@@ -24,6 +25,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -157,6 +160,53 @@ func (s *Service) SetDetails(ctx session.Context, req pb.RpcObjectSetDetailsRequ
 	})
 }
 
+// SetDetailsList sets the same details to every object from the list or, if the list is empty, to objects of the space
+// matching the filters. Every object is changed in its own transaction, so it gets a single history entry. Events of
+// all changed objects are collected to the context. Ids of objects, which aren't changed, are returned,
+// and error is returned only if no object is changed
+func (s *Service) SetDetailsList(ctx session.Context, req *pb.RpcObjectListSetDetailsRequest) (failedObjectIds []string, err error) {
+	objectIds := req.ObjectIds
+	if len(objectIds) == 0 {
+		filters := append([]*model.BlockContentDataviewFilter{{
+			RelationKey: bundle.RelationKeySpaceId.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(req.SpaceId),
+		}}, req.Filters...)
+		ids, _, err := s.objectStore.QueryObjectIDs(database.Query{Filters: filters})
+		if err != nil {
+			return nil, fmt.Errorf("query objects: %w", err)
+		}
+		objectIds = ids
+	}
+
+	var (
+		mErr       multierror.Error
+		anySucceed bool
+		msgs       []*pb.EventMessage
+	)
+	for _, objectId := range objectIds {
+		objectCtx := session.NewChildContext(ctx)
+		err := Do(s, objectId, func(b basic.DetailsSettable) error {
+			return b.SetDetails(objectCtx, req.Details, true)
+		})
+		if err != nil {
+			log.With("objectID", objectId).Errorf("failed to set details: %v", err)
+			mErr.Errors = append(mErr.Errors, err)
+			failedObjectIds = append(failedObjectIds, objectId)
+			continue
+		}
+		anySucceed = true
+		msgs = append(msgs, objectCtx.GetMessages()...)
+	}
+	if len(msgs) > 0 {
+		ctx.SetMessages(ctx.ObjectID(), msgs)
+	}
+	if anySucceed || len(objectIds) == 0 {
+		return failedObjectIds, nil
+	}
+	return failedObjectIds, mErr.ErrorOrNil()
+}
+
 func (s *Service) SetFieldsList(ctx session.Context, req pb.RpcBlockListSetFieldsRequest) (err error) {
 	return Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.SetFields(ctx, req.BlockFields...)
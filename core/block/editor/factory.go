@@ -110,7 +110,7 @@ func (f *ObjectFactory) InitObject(space smartblock.Space, id string, initCtx *s
 	}
 
 	migration.RunMigrations(sb, initCtx)
-	return sb, sb.Apply(initCtx.State, smartblock.NoHistory, smartblock.NoEvent, smartblock.NoRestrictions, smartblock.SkipIfNoChanges, smartblock.KeepInternalFlags)
+	return sb, sb.Apply(initCtx.State, smartblock.NoHistory, smartblock.NoEvent, smartblock.NoRestrictions, smartblock.SkipIfNoChanges, smartblock.KeepInternalFlags, smartblock.NoPushIfReadOnly)
 }
 
 func (f *ObjectFactory) produceSmartblock(space smartblock.Space) smartblock.SmartBlock {
@@ -0,0 +1,61 @@
+package smartblock
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+// backlinkSnippetLength limits the text of the block with the link in UTF-16 code units
+const backlinkSnippetLength = 200
+
+// linkSources returns blocks and relations of the state linking to the targets, by target id. Targets are
+// navigational links of the object, so links from system and hidden relations are skipped
+func linkSources(s *state.State, targets []string) map[string][]*model.Backlink {
+	isTarget := make(map[string]struct{}, len(targets))
+	for _, id := range targets {
+		isTarget[id] = struct{}{}
+	}
+	sources := make(map[string][]*model.Backlink)
+	add := func(targetID string, backlink *model.Backlink) {
+		if _, ok := isTarget[targetID]; ok {
+			sources[targetID] = append(sources[targetID], backlink)
+		}
+	}
+
+	_ = s.Iterate(func(b simple.Block) (isContinue bool) {
+		var ids []string
+		if f := b.Model().GetFile(); f != nil {
+			ids = append(ids, f.Hash)
+		} else if dv := b.Model().GetDataview(); dv != nil {
+			ids = append(ids, dv.TargetObjectId)
+		} else if ls, ok := b.(linkSource); ok {
+			ids = ls.FillSmartIds(ids)
+		}
+		if len(ids) == 0 {
+			return true
+		}
+		snippet := text.Truncate(b.Model().GetText().GetText(), backlinkSnippetLength)
+		for _, id := range lo.Uniq(ids) {
+			add(id, &model.Backlink{ObjectId: s.RootId(), BlockId: b.Model().Id, Snippet: snippet})
+		}
+		return true
+	})
+
+	details := s.CombinedDetails()
+	for _, rel := range s.GetRelationLinks() {
+		if rel.Format != model.RelationFormat_object || bundle.IsSystemRelation(domain.RelationKey(rel.Key)) {
+			continue
+		}
+		for _, id := range lo.Uniq(pbtypes.GetStringList(details, rel.Key)) {
+			add(id, &model.Backlink{ObjectId: s.RootId(), RelationKey: rel.Key})
+		}
+	}
+	return sources
+}
@@ -60,6 +60,9 @@ const (
 	DoSnapshot
 	SkipIfNoChanges
 	KeepInternalFlags
+	// NoPushIfReadOnly keeps changes only in memory, if object is opened in read-only mode,
+	// instead of failing with domain.ErrReadOnlyMode. It is used by migrations on open
+	NoPushIfReadOnly
 )
 
 type Hook int
@@ -177,6 +180,8 @@ type DocInfo struct {
 	Creator    string
 	Type       domain.TypeKey
 	Details    *types.Struct
+	// LinkSources are blocks and relations with links, by target id
+	LinkSources map[string][]*model.Backlink
 
 	SmartblockType smartblock.SmartBlockType
 }
@@ -640,6 +645,7 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		hooks             = true
 		skipIfNoChanges   = false
 		keepInternalFlags = false
+		noPushIfReadOnly  = false
 	)
 	for _, f := range flags {
 		switch f {
@@ -657,6 +663,8 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 			skipIfNoChanges = true
 		case KeepInternalFlags:
 			keepInternalFlags = true
+		case NoPushIfReadOnly:
+			noPushIfReadOnly = true
 		}
 	}
 
@@ -683,8 +691,13 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		if lastModifiedFromState > 0 {
 			lastModified = time.Unix(lastModifiedFromState, 0)
 		}
+		created := lastModified.Unix()
+		// imported objects keep their original creation date, if it precedes the last modification
+		if createdFromState := pbtypes.GetInt64(s.LocalDetails(), bundle.RelationKeyCreatedDate.String()); createdFromState > 0 && createdFromState < created {
+			created = createdFromState
+		}
 		s.SetLocalDetail(bundle.RelationKeyLastModifiedDate.String(), pbtypes.Int64(lastModified.Unix()))
-		s.SetLocalDetail(bundle.RelationKeyCreatedDate.String(), pbtypes.Int64(lastModified.Unix()))
+		s.SetLocalDetail(bundle.RelationKeyCreatedDate.String(), pbtypes.Int64(created))
 	}
 
 	s.SetLocalDetail(bundle.RelationKeyLastModifiedBy.String(), pbtypes.String(sb.currentProfileId))
@@ -728,6 +741,9 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 		return nil
 	}
 	pushChange := func() error {
+		if noPushIfReadOnly && sb.source.ReadOnly() {
+			return nil
+		}
 		fileDetailsKeys := sb.FileRelationKeys(st)
 		var fileDetailsKeysFiltered []string
 		for _, ch := range changes {
@@ -1328,6 +1344,7 @@ func (sb *smartBlock) getDocInfo(st *state.State) DocInfo {
 		FileHashes:     fileHashes,
 		Creator:        creator,
 		Details:        sb.CombinedDetails(),
+		LinkSources:    linkSources(st, links),
 		Type:           sb.ObjectTypeKey(),
 		SmartblockType: sb.Type(),
 	}
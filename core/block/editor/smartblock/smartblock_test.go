@@ -19,6 +19,7 @@ import (
 	_ "github.com/anyproto/anytype-heart/core/block/simple/link"
 	_ "github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/event/mock_event"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
@@ -81,7 +82,54 @@ func TestSmartBlock_Apply(t *testing.T) {
 		assert.Equal(t, 1, fx.History().Len())
 		assert.NotNil(t, event)
 	})
+	t.Run("read-only source - error", func(t *testing.T) {
+		// given
+		fx := newFixture("", t)
+		defer fx.tearDown()
+		fx.store.EXPECT().GetDetails(gomock.Any()).AnyTimes().Return(&model.ObjectDetails{
+			Details: &types.Struct{Fields: map[string]*types.Value{}},
+		}, nil)
+		fx.store.EXPECT().GetInboundLinksByID(gomock.Any()).AnyTimes()
+		fx.store.EXPECT().UpdatePendingLocalDetails(gomock.Any(), gomock.Any()).AnyTimes()
+
+		fx.init(t, []*model.Block{{Id: "1"}})
+		fx.source.readOnly = true
+		s := fx.NewState()
+		s.Add(simple.New(&model.Block{Id: "2"}))
+		require.NoError(t, s.InsertTo("1", model.Block_Inner, "2"))
+
+		// when
+		err := fx.Apply(s)
+
+		// then
+		assert.ErrorIs(t, err, domain.ErrReadOnlyMode)
+		assert.Equal(t, 0, fx.History().Len())
+	})
+	t.Run("read-only source, changes are kept in memory", func(t *testing.T) {
+		// given
+		fx := newFixture("", t)
+		defer fx.tearDown()
+		fx.store.EXPECT().GetDetails(gomock.Any()).AnyTimes().Return(&model.ObjectDetails{
+			Details: &types.Struct{Fields: map[string]*types.Value{}},
+		}, nil)
+		fx.store.EXPECT().GetInboundLinksByID(gomock.Any()).AnyTimes()
+		fx.store.EXPECT().UpdatePendingLocalDetails(gomock.Any(), gomock.Any()).AnyTimes()
+		fx.indexer.EXPECT().Index(gomock.Any(), gomock.Any())
+
+		fx.init(t, []*model.Block{{Id: "1"}})
+		fx.source.readOnly = true
+		s := fx.NewState()
+		s.Add(simple.New(&model.Block{Id: "2"}))
+		require.NoError(t, s.InsertTo("1", model.Block_Inner, "2"))
+
+		// when
+		err := fx.Apply(s, NoEvent, NoPushIfReadOnly)
 
+		// then
+		require.NoError(t, err)
+		assert.NotNil(t, fx.Pick("2"))
+		assert.Equal(t, 0, fx.History().Len())
+	})
 }
 
 func TestBasic_SetAlign(t *testing.T) {
@@ -434,6 +482,39 @@ func Test_removeInternalFlags(t *testing.T) {
 	})
 }
 
+func Test_linkSources(t *testing.T) {
+	// given
+	st := state.NewDoc("test", map[string]simple.Block{
+		"test": simple.New(&model.Block{Id: "test", ChildrenIds: []string{"text", "link"}}),
+		"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: "meeting with Alice", Marks: &model.BlockContentTextMarks{
+				Marks: []*model.BlockContentTextMark{
+					{Type: model.BlockContentTextMark_Mention, Param: "alice", Range: &model.Range{From: 13, To: 18}},
+				},
+			}},
+		}}),
+		"link": simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{
+			Link: &model.BlockContentLink{TargetBlockId: "notes"},
+		}}),
+	}).(*state.State)
+	st.AddRelationLinks(&model.RelationLink{Key: bundle.RelationKeyAssignee.String(), Format: model.RelationFormat_object})
+	st.SetDetail(bundle.RelationKeyAssignee.String(), pbtypes.StringList([]string{"alice", "bob"}))
+
+	// when
+	sources := linkSources(st, []string{"alice", "notes"})
+
+	// then
+	assert.Equal(t, map[string][]*model.Backlink{
+		"alice": {
+			{ObjectId: "test", BlockId: "text", Snippet: "meeting with Alice"},
+			{ObjectId: "test", RelationKey: bundle.RelationKeyAssignee.String()},
+		},
+		"notes": {
+			{ObjectId: "test", BlockId: "link"},
+		},
+	}, sources)
+}
+
 type fixture struct {
 	ctrl               *gomock.Controller
 	store              *testMock.MockObjectStore
@@ -507,6 +588,7 @@ type sourceStub struct {
 	err         error
 	doc         state.Doc
 	id          string
+	readOnly    bool
 }
 
 func (s *sourceStub) GetCreationInfo() (creator string, createdDate int64, err error) {
@@ -518,12 +600,15 @@ func (s *sourceStub) SpaceID() string                           { return s.space
 func (s *sourceStub) Type() smartblock.SmartBlockType           { return s.sbType }
 func (s *sourceStub) Heads() []string                           { return nil }
 func (s *sourceStub) GetFileKeysSnapshot() []*pb.ChangeFileKeys { return nil }
-func (s *sourceStub) ReadOnly() bool                            { return false }
+func (s *sourceStub) ReadOnly() bool                            { return s.readOnly }
 func (s *sourceStub) Close() (err error)                        { return nil }
 func (s *sourceStub) ReadDoc(_ context.Context, _ source.ChangeReceiver, _ bool) (doc state.Doc, err error) {
 	return s.doc, nil
 }
 func (s *sourceStub) PushChange(_ source.PushChangeParams) (id string, err error) {
+	if s.readOnly {
+		return "", domain.ErrReadOnlyMode
+	}
 	return "", nil
 }
 
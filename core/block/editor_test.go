@@ -0,0 +1,139 @@
+package block
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type spaceResolver struct{}
+
+func (r *spaceResolver) Init(*app.App) error                   { return nil }
+func (r *spaceResolver) Name() string                          { return "resolver" }
+func (r *spaceResolver) ResolveSpaceID(string) (string, error) { return "space1", nil }
+
+// detailsObject sends event on change of details like editor does, or fails, if err is set
+type detailsObject struct {
+	*smarttest.SmartTest
+	err error
+}
+
+func (o *detailsObject) SetDetails(ctx session.Context, details []*pb.RpcObjectSetDetailsDetail, showEvent bool) error {
+	if o.err != nil {
+		return o.err
+	}
+	if err := o.SmartTest.SetDetails(ctx, details, showEvent); err != nil {
+		return err
+	}
+	ctx.SetMessages(o.Id(), []*pb.EventMessage{{
+		Value: &pb.EventMessageValueOfObjectDetailsAmend{ObjectDetailsAmend: &pb.EventObjectDetailsAmend{Id: o.Id()}},
+	}})
+	return nil
+}
+
+func newDetailsListFixture(t *testing.T, store objectstore.ObjectStore, objects ...*detailsObject) *Service {
+	spc := mock_space.NewMockSpace(t)
+	for _, o := range objects {
+		spc.EXPECT().GetObject(mock.Anything, o.Id()).Return(o, nil).Maybe()
+	}
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, "space1").Return(spc, nil).Maybe()
+	return &Service{resolver: &spaceResolver{}, spaceService: spaceService, objectStore: store}
+}
+
+func TestService_SetDetailsList(t *testing.T) {
+	details := []*pb.RpcObjectSetDetailsDetail{{Key: bundle.RelationKeyDone.String(), Value: pbtypes.Bool(true)}}
+
+	t.Run("objects matching filters are changed", func(t *testing.T) {
+		// given
+		store := objectstore.NewStoreFixture(t)
+		store.AddObjects(t, []objectstore.TestObject{
+			{bundle.RelationKeyId: pbtypes.String("task1"), bundle.RelationKeySpaceId: pbtypes.String("space1"), bundle.RelationKeyName: pbtypes.String("task")},
+			{bundle.RelationKeyId: pbtypes.String("task2"), bundle.RelationKeySpaceId: pbtypes.String("space2"), bundle.RelationKeyName: pbtypes.String("task")},
+			{bundle.RelationKeyId: pbtypes.String("note"), bundle.RelationKeySpaceId: pbtypes.String("space1"), bundle.RelationKeyName: pbtypes.String("note")},
+		})
+		task := &detailsObject{SmartTest: smarttest.New("task1")}
+		s := newDetailsListFixture(t, store, task)
+
+		// when
+		failed, err := s.SetDetailsList(session.NewContext(), &pb.RpcObjectListSetDetailsRequest{
+			SpaceId: "space1",
+			Filters: []*model.BlockContentDataviewFilter{{
+				RelationKey: bundle.RelationKeyName.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String("task"),
+			}},
+			Details: details,
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, failed)
+		assert.True(t, pbtypes.GetBool(task.Details(), bundle.RelationKeyDone.String()))
+	})
+	t.Run("failed objects are returned", func(t *testing.T) {
+		// given
+		task := &detailsObject{SmartTest: smarttest.New("task1")}
+		locked := &detailsObject{SmartTest: smarttest.New("locked"), err: errors.New("object is read only")}
+		s := newDetailsListFixture(t, nil, task, locked)
+
+		// when
+		failed, err := s.SetDetailsList(session.NewContext(), &pb.RpcObjectListSetDetailsRequest{
+			ObjectIds: []string{"task1", "locked"},
+			Details:   details,
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"locked"}, failed)
+		assert.True(t, pbtypes.GetBool(task.Details(), bundle.RelationKeyDone.String()))
+	})
+	t.Run("error, if no object is changed", func(t *testing.T) {
+		// given
+		locked := &detailsObject{SmartTest: smarttest.New("locked"), err: errors.New("object is read only")}
+		s := newDetailsListFixture(t, nil, locked)
+
+		// when
+		failed, err := s.SetDetailsList(session.NewContext(), &pb.RpcObjectListSetDetailsRequest{
+			ObjectIds: []string{"locked"},
+			Details:   details,
+		})
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, []string{"locked"}, failed)
+	})
+	t.Run("events of all objects are sent in one response", func(t *testing.T) {
+		// given
+		task1 := &detailsObject{SmartTest: smarttest.New("task1")}
+		task2 := &detailsObject{SmartTest: smarttest.New("task2")}
+		s := newDetailsListFixture(t, nil, task1, task2)
+		ctx := session.NewContext()
+
+		// when
+		_, err := s.SetDetailsList(ctx, &pb.RpcObjectListSetDetailsRequest{
+			ObjectIds: []string{"task1", "task2"},
+			Details:   details,
+		})
+
+		// then
+		require.NoError(t, err)
+		msgs := ctx.GetMessages()
+		require.Len(t, msgs, 2)
+		assert.Equal(t, "task1", msgs[0].GetObjectDetailsAmend().Id)
+		assert.Equal(t, "task2", msgs[1].GetObjectDetailsAmend().Id)
+	})
+}
@@ -3,6 +3,7 @@ package export
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -20,14 +21,18 @@ import (
 	"github.com/anyproto/anytype-heart/core/block"
 	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/converter"
 	"github.com/anyproto/anytype-heart/core/converter/dot"
 	"github.com/anyproto/anytype-heart/core/converter/graphjson"
+	"github.com/anyproto/anytype-heart/core/converter/html"
 	"github.com/anyproto/anytype-heart/core/converter/md"
+	"github.com/anyproto/anytype-heart/core/converter/objectjson"
 	"github.com/anyproto/anytype-heart/core/converter/pbc"
 	"github.com/anyproto/anytype-heart/core/converter/pbjson"
+	"github.com/anyproto/anytype-heart/core/converter/pdf"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/pb"
@@ -50,6 +55,12 @@ const CName = "export"
 
 const tempFileName = "temp_anytype_backup"
 
+// ErrPasswordWithoutZip is returned, when the password is set for export to directory
+var ErrPasswordWithoutZip = errors.New("password is supported only for zip archive")
+
+// filesDir is the folder of exported files for formats except markdown
+const filesDir = "files"
+
 var log = logging.Logger("anytype-mw-export")
 
 type Export interface {
@@ -89,6 +100,9 @@ func (e *export) Name() (name string) {
 }
 
 func (e *export) Export(ctx context.Context, req pb.RpcObjectListExportRequest) (path string, succeed int, err error) {
+	if req.Password != "" && !req.Zip {
+		return "", 0, ErrPasswordWithoutZip
+	}
 	queue := e.blockService.Process().NewQueue(pb.ModelProcess{
 		Id:    bson.NewObjectId().Hex(),
 		Type:  pb.ModelProcess_Export,
@@ -146,7 +160,7 @@ func (e *export) Export(ctx context.Context, req pb.RpcObjectListExportRequest)
 				}
 			}
 		}
-		for docId := range docs {
+		for _, docId := range docsToWrite(docs, req.ModifiedSince) {
 			did := docId
 			if err = queue.Wait(func() {
 				log.With("objectID", did).Debugf("write doc")
@@ -175,6 +189,13 @@ func (e *export) Export(ctx context.Context, req pb.RpcObjectListExportRequest)
 
 func (e *export) renameZipArchive(req pb.RpcObjectListExportRequest, wr writer, succeed int) (string, int, error) {
 	zipName := getZipName(req.Path)
+	if req.Password != "" {
+		defer os.Remove(wr.Path())
+		if err := source.EncryptZip(wr.Path(), zipName, req.Password); err != nil {
+			return "", 0, fmt.Errorf("encrypt archive: %w", err)
+		}
+		return zipName, succeed, nil
+	}
 	err := os.Rename(wr.Path(), zipName)
 	if err != nil {
 		os.Remove(wr.Path())
@@ -183,6 +204,19 @@ func (e *export) renameZipArchive(req pb.RpcObjectListExportRequest, wr writer,
 	return zipName, succeed, nil
 }
 
+// docsToWrite returns ids of docs modified since the given unix time. All docs are still known to converters,
+// so links to unchanged objects are kept in incremental export
+func docsToWrite(docs map[string]*types.Struct, modifiedSince int64) []string {
+	ids := make([]string, 0, len(docs))
+	for id, details := range docs {
+		if modifiedSince > 0 && pbtypes.GetInt64(details, bundle.RelationKeyLastModifiedDate.String()) < modifiedSince {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func isAnyblockExport(format pb.RpcObjectListExportFormat) bool {
 	return format == pb.RpcObjectListExport_Protobuf || format == pb.RpcObjectListExport_JSON
 }
@@ -318,7 +352,7 @@ func (e *export) writeMultiDoc(ctx context.Context, mw converter.MultiConverter,
 				}
 				fileHashes := b.GetAndUnsetFileKeys()
 				for _, fh := range fileHashes {
-					if saveFileErr := e.saveFile(ctx, wr, fh.Hash); saveFileErr != nil {
+					if saveFileErr := e.saveFile(ctx, wr, filesDir, fh.Hash); saveFileErr != nil {
 						log.With("hash", fh.Hash).Warnf("can't save file: %v", saveFileErr)
 					}
 				}
@@ -355,11 +389,21 @@ func (e *export) writeDoc(ctx context.Context, format pb.RpcObjectListExportForm
 			conv = pbc.NewConverter(b, isJSON)
 		case pb.RpcObjectListExport_JSON:
 			conv = pbjson.NewConverter(b)
+		case pb.RpcObjectListExport_HTML:
+			var dir string
+			if exportFiles {
+				dir = filesDir
+			}
+			conv = html.NewSiteConverter(b.SpaceID(), e.fileService, b.NewState(), wr.Namer(), dir)
+		case pb.RpcObjectListExport_PDF:
+			conv = pdf.NewConverter(b.SpaceID(), e.fileService, b.NewState())
+		case pb.RpcObjectListExport_STRUCTURED_JSON:
+			conv = objectjson.NewConverter(b.NewState())
 		}
 		conv.SetKnownDocs(docInfo)
 		result := conv.Convert(b.Type().ToProto())
 		filename := docID + conv.Ext()
-		if format == pb.RpcObjectListExport_Markdown {
+		if format == pb.RpcObjectListExport_Markdown || format == pb.RpcObjectListExport_HTML || format == pb.RpcObjectListExport_PDF {
 			s := b.NewState()
 			name := pbtypes.GetString(s.Details(), bundle.RelationKeyName.String())
 			if name == "" {
@@ -376,17 +420,21 @@ func (e *export) writeDoc(ctx context.Context, format pb.RpcObjectListExportForm
 		if !exportFiles {
 			return nil
 		}
-		e.saveFiles(ctx, b, queue, wr, docID)
+		dir := filesDir
+		if format == pb.RpcObjectListExport_Markdown {
+			dir = md.AssetsDir
+		}
+		e.saveFiles(ctx, b, queue, wr, dir, docID)
 		return nil
 	})
 }
 
-func (e *export) saveFiles(ctx context.Context, b sb.SmartBlock, queue process.Queue, wr writer, docID string) {
+func (e *export) saveFiles(ctx context.Context, b sb.SmartBlock, queue process.Queue, wr writer, dir, docID string) {
 	fileHashes := b.GetAndUnsetFileKeys()
 	for _, fh := range fileHashes {
 		fh := fh
 		if err := queue.Add(func() {
-			if werr := e.saveFile(ctx, wr, fh.Hash); werr != nil {
+			if werr := e.saveFile(ctx, wr, dir, fh.Hash); werr != nil {
 				log.With("hash", fh.Hash).Warnf("can't save file: %v", werr)
 			}
 		}); err != nil {
@@ -395,7 +443,9 @@ func (e *export) saveFiles(ctx context.Context, b sb.SmartBlock, queue process.Q
 	}
 }
 
-func (e *export) saveFile(ctx context.Context, wr writer, hash string) (err error) {
+// saveFile writes the file to the dir. Markdown documents link to their files in md.AssetsDir, while
+// other formats keep them in filesDir
+func (e *export) saveFile(ctx context.Context, wr writer, dir, hash string) (err error) {
 	spaceID, err := e.resolver.ResolveSpaceID(hash)
 	if err != nil {
 		return fmt.Errorf("resolve spaceID: %w", err)
@@ -419,7 +469,7 @@ func (e *export) saveFile(ctx context.Context, wr writer, hash string) (err erro
 		}
 	}
 	origName := file.Meta().Name
-	filename := wr.Namer().Get("files", hash, filepath.Base(origName), filepath.Ext(origName))
+	filename := wr.Namer().Get(dir, hash, filepath.Base(origName), filepath.Ext(origName))
 	rd, err := file.Reader(context.Background())
 	if err != nil {
 		return
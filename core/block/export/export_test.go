@@ -1,11 +1,21 @@
 package export
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestFileNamer_Get(t *testing.T) {
@@ -30,3 +40,51 @@ func TestFileNamer_Get(t *testing.T) {
 	}
 	assert.Equal(t, len(names), len(nl))
 }
+
+func TestDocsToWrite(t *testing.T) {
+	docs := map[string]*types.Struct{
+		"old":     {Fields: map[string]*types.Value{bundle.RelationKeyLastModifiedDate.String(): pbtypes.Int64(100)}},
+		"changed": {Fields: map[string]*types.Value{bundle.RelationKeyLastModifiedDate.String(): pbtypes.Int64(200)}},
+	}
+
+	t.Run("all docs are written without modifiedSince", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"old", "changed"}, docsToWrite(docs, 0))
+	})
+
+	t.Run("only docs modified since the time are written", func(t *testing.T) {
+		assert.Equal(t, []string{"changed"}, docsToWrite(docs, 150))
+	})
+}
+
+func TestExport_renameZipArchive(t *testing.T) {
+	t.Run("archive is encrypted with password", func(t *testing.T) {
+		// given
+		path := t.TempDir()
+		wr, err := newZipWriter(path, tempFileName)
+		require.NoError(t, err)
+		require.NoError(t, wr.WriteFile("page.pb", strings.NewReader("page")))
+		require.NoError(t, wr.Close())
+		e := &export{}
+
+		// when
+		zipName, succeed, err := e.renameZipArchive(pb.RpcObjectListExportRequest{Path: path, Password: "secret"}, wr, 1)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, succeed)
+		assert.NoFileExists(t, wr.Path())
+		encrypted, err := source.IsEncryptedZip(zipName)
+		require.NoError(t, err)
+		assert.True(t, encrypted)
+		decrypted, err := source.DecryptZip(zipName, "secret")
+		require.NoError(t, err)
+		assert.NoError(t, os.Remove(decrypted))
+	})
+	t.Run("password requires zip", func(t *testing.T) {
+		// when
+		_, _, err := (&export{}).Export(context.Background(), pb.RpcObjectListExportRequest{Path: t.TempDir(), Password: "secret"})
+
+		// then
+		assert.ErrorIs(t, err, ErrPasswordWithoutZip)
+	})
+}
@@ -0,0 +1,337 @@
+package findreplace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/block/blocktransform"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+const CName = "findreplace"
+
+var log = logging.Logger("anytype-mw-findreplace")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("replacement is not found")
+)
+
+const (
+	defaultLimit = 100
+	// maxRuns is the number of the latest replacements, which can be undone
+	maxRuns = 10
+)
+
+// ApplyResult describes the replacement
+type ApplyResult struct {
+	RunID           string
+	ChangedObjects  int64
+	ChangedBlocks   int64
+	FailedObjectIds []string
+}
+
+// UndoResult describes the revert of the replacement
+type UndoResult struct {
+	RestoredBlocks  int64
+	FailedObjectIds []string
+}
+
+// Service searches text in objects of the space and replaces it. Search and replacement are shown to the client
+// as processes, which can be canceled. Every object is replaced in its own transaction, so replacement is in undo
+// history of the object. Also, the whole replacement can be undone, but replacements are kept only in memory:
+// only the latest maxRuns replacements made since the start of the app can be undone by Undo
+type Service interface {
+	Search(ctx context.Context, req *pb.RpcFindReplaceSearchRequest) (matches []*pb.RpcFindReplaceMatch, limitReached bool, err error)
+	Apply(ctx context.Context, req *pb.RpcFindReplaceApplyRequest) (ApplyResult, error)
+	Undo(ctx context.Context, runID string) (UndoResult, error)
+	app.Component
+}
+
+func New() Service {
+	return &service{runs: map[string]*run{}}
+}
+
+// run keeps changes of the replacement by object ids
+type run struct {
+	id      string
+	objects map[string][]blockChange
+}
+
+type service struct {
+	objectStore    objectstore.ObjectStore
+	picker         getblock.ObjectGetter
+	processService process.Service
+
+	mu       sync.Mutex
+	runs     map[string]*run
+	runOrder []string
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.picker = app.MustComponent[getblock.ObjectGetter](a)
+	s.processService = app.MustComponent[process.Service](a)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Search(ctx context.Context, req *pb.RpcFindReplaceSearchRequest) ([]*pb.RpcFindReplaceMatch, bool, error) {
+	re, err := compileQuery(req.Query)
+	if err != nil {
+		return nil, false, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	ids, err := s.scopeObjectIDs(req.Scope)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var (
+		matches      []*pb.RpcFindReplaceMatch
+		limitReached bool
+	)
+	_, err = s.forEachObject(ctx, "Search", ids, func(sb smartblock.SmartBlock) error {
+		// one more match is requested to know if there are matches over the limit
+		found := findMatches(sb.NewState(), re, limit-len(matches)+1)
+		name := pbtypes.GetString(sb.Details(), bundle.RelationKeyName.String())
+		for _, match := range found {
+			if len(matches) == limit {
+				limitReached = true
+				return errStop
+			}
+			match.ObjectName = name
+			matches = append(matches, match)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return matches, limitReached, nil
+}
+
+// Apply replaces matches in objects of the scope and keeps changes, so replacement can be undone
+func (s *service) Apply(ctx context.Context, req *pb.RpcFindReplaceApplyRequest) (result ApplyResult, err error) {
+	re, err := compileQuery(req.Query)
+	if err != nil {
+		return result, err
+	}
+	ids, err := s.scopeObjectIDs(req.Scope)
+	if err != nil {
+		return result, err
+	}
+	if len(req.ObjectIds) > 0 {
+		ids = slice.Filter(ids, func(id string) bool {
+			return slices.Contains(req.ObjectIds, id)
+		})
+	}
+
+	r := &run{id: uuid.New().String(), objects: map[string][]blockChange{}}
+	// changes made before cancellation are kept, so they can be undone too
+	defer func() {
+		if len(r.objects) > 0 {
+			s.saveRun(r)
+			result.RunID = r.id
+		}
+	}()
+	result.FailedObjectIds, err = s.forEachObject(ctx, "Replace", ids, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		changes := replaceInState(st, re, req.Replacement, req.Query.IsRegex)
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := sb.Apply(st); err != nil {
+			return err
+		}
+		r.objects[sb.Id()] = changes
+		result.ChangedObjects++
+		result.ChangedBlocks += int64(len(changes))
+		return nil
+	})
+	return result, err
+}
+
+// Undo reverts the replacement. Blocks changed after the replacement are kept as is. Restored objects are removed
+// from the replacement, so if some objects are failed, undo can be repeated for them
+func (s *service) Undo(ctx context.Context, runID string) (result UndoResult, err error) {
+	s.mu.Lock()
+	r, ok := s.runs[runID]
+	var ids []string
+	if ok {
+		ids = lo.Keys(r.objects)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return result, ErrNotFound
+	}
+	var restoredIDs []string
+	result.FailedObjectIds, err = s.forEachObject(ctx, "Undo replace", ids, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		n := revertInState(st, r.objects[sb.Id()])
+		if n > 0 {
+			if err := sb.Apply(st); err != nil {
+				return err
+			}
+		}
+		restoredIDs = append(restoredIDs, sb.Id())
+		result.RestoredBlocks += int64(n)
+		return nil
+	})
+	s.removeObjects(runID, restoredIDs)
+	return result, err
+}
+
+// errStop stops iteration over objects without error
+var errStop = errors.New("stop")
+
+// forEachObject calls the function for every object under the lock of the object. Progress of the iteration is
+// reported to the client. Errors of objects are logged and the iteration continues, ids of failed objects are returned
+func (s *service) forEachObject(ctx context.Context, message string, ids []string, f func(sb smartblock.SmartBlock) error) (failedIDs []string, err error) {
+	progress := process.NewProgress(pb.ModelProcess_FindReplace)
+	if err = s.processService.Add(progress); err != nil {
+		return nil, fmt.Errorf("add process: %w", err)
+	}
+	defer func() {
+		progress.Finish(err)
+	}()
+	progress.SetProgressMessage(message)
+	progress.SetTotal(int64(len(ids)))
+
+	for i, id := range ids {
+		select {
+		case <-progress.Canceled():
+			return failedIDs, context.Canceled
+		case <-ctx.Done():
+			return failedIDs, ctx.Err()
+		default:
+		}
+		err := getblock.Do(s.picker, id, f)
+		if errors.Is(err, errStop) {
+			return failedIDs, nil
+		}
+		if err != nil {
+			log.With("objectId", id).Errorf("%s: %s", message, err)
+			failedIDs = append(failedIDs, id)
+		}
+		progress.SetDone(int64(i + 1))
+	}
+	return failedIDs, nil
+}
+
+func compileQuery(query *pb.RpcFindReplaceQuery) (*regexp.Regexp, error) {
+	if query == nil || query.Pattern == "" {
+		return nil, fmt.Errorf("%w: pattern is empty", ErrBadInput)
+	}
+	re, err := blocktransform.CompilePattern(query.Pattern, query.IsRegex, query.CaseSensitive)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadInput, err)
+	}
+	return re, nil
+}
+
+// scopeObjectIDs returns ids of not archived objects of the space with the given types. Objects of collection
+// are returned in order of the collection
+func (s *service) scopeObjectIDs(scope *pb.RpcFindReplaceScope) ([]string, error) {
+	if scope == nil || scope.SpaceId == "" {
+		return nil, fmt.Errorf("%w: space id is required", ErrBadInput)
+	}
+	filters := []*model.BlockContentDataviewFilter{
+		{
+			RelationKey: bundle.RelationKeySpaceId.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(scope.SpaceId),
+		},
+		{
+			RelationKey: bundle.RelationKeyIsArchived.String(),
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       pbtypes.Bool(true),
+		},
+	}
+	if len(scope.ObjectTypeIds) > 0 {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeyType.String(),
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       pbtypes.StringList(scope.ObjectTypeIds),
+		})
+	}
+	ids, _, err := s.objectStore.QueryObjectIDs(database.Query{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	if scope.CollectionId == "" {
+		return ids, nil
+	}
+	var collectionIDs []string
+	err = getblock.Do(s.picker, scope.CollectionId, func(sb smartblock.SmartBlock) error {
+		collectionIDs = sb.NewState().GetStoreSlice(template.CollectionStoreKey)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	inScope := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		inScope[id] = struct{}{}
+	}
+	return slice.Filter(collectionIDs, func(id string) bool {
+		_, ok := inScope[id]
+		return ok
+	}), nil
+}
+
+func (s *service) saveRun(r *run) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[r.id] = r
+	s.runOrder = append(s.runOrder, r.id)
+	if len(s.runOrder) > maxRuns {
+		delete(s.runs, s.runOrder[0])
+		s.runOrder = s.runOrder[1:]
+	}
+}
+
+// removeObjects removes restored objects from the run. The run is removed, when all its objects are restored
+func (s *service) removeObjects(id string, objectIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.runs[id]
+	if !ok {
+		return
+	}
+	for _, objectID := range objectIDs {
+		delete(r.objects, objectID)
+	}
+	if len(r.objects) > 0 {
+		return
+	}
+	delete(s.runs, id)
+	s.runOrder = slice.Filter(s.runOrder, func(runID string) bool {
+		return runID != id
+	})
+}
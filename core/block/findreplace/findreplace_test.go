@@ -0,0 +1,228 @@
+package findreplace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type processService struct {
+	process.Service
+}
+
+func (s *processService) Add(process.Process) error { return nil }
+
+type picker struct {
+	objects map[string]smartblock.SmartBlock
+}
+
+func (p *picker) GetObject(_ context.Context, id string) (smartblock.SmartBlock, error) {
+	sb, ok := p.objects[id]
+	if !ok {
+		return nil, fmt.Errorf("object %s is not found", id)
+	}
+	return sb, nil
+}
+
+// lockedObject fails on apply, if err is set
+type lockedObject struct {
+	*smarttest.SmartTest
+	err error
+}
+
+func (o *lockedObject) Apply(s *state.State, flags ...smartblock.ApplyFlag) error {
+	if o.err != nil {
+		return o.err
+	}
+	return o.SmartTest.Apply(s, flags...)
+}
+
+func newObject(id, text string) *smarttest.SmartTest {
+	sb := smarttest.New(id)
+	sb.AddBlock(simple.New(&model.Block{Id: id, ChildrenIds: []string{"text"}}))
+	sb.AddBlock(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  text,
+		Marks: &model.BlockContentTextMarks{},
+	}}}))
+	return sb
+}
+
+func getText(sb smartblock.SmartBlock) string {
+	return sb.NewState().Pick("text").Model().GetText().Text
+}
+
+func newFixture(t *testing.T, objects ...smartblock.SmartBlock) *service {
+	store := objectstore.NewStoreFixture(t)
+	p := &picker{objects: map[string]smartblock.SmartBlock{}}
+	var testObjects []objectstore.TestObject
+	for _, sb := range objects {
+		p.objects[sb.Id()] = sb
+		testObjects = append(testObjects, objectstore.TestObject{
+			bundle.RelationKeyId:      pbtypes.String(sb.Id()),
+			bundle.RelationKeySpaceId: pbtypes.String("space1"),
+		})
+	}
+	store.AddObjects(t, testObjects)
+	return &service{
+		objectStore:    store,
+		picker:         p,
+		processService: &processService{},
+		runs:           map[string]*run{},
+	}
+}
+
+func newApplyRequest(pattern, replacement string) *pb.RpcFindReplaceApplyRequest {
+	return &pb.RpcFindReplaceApplyRequest{
+		Scope:       &pb.RpcFindReplaceScope{SpaceId: "space1"},
+		Query:       &pb.RpcFindReplaceQuery{Pattern: pattern},
+		Replacement: replacement,
+	}
+}
+
+func TestService_Apply(t *testing.T) {
+	t.Run("matches are replaced", func(t *testing.T) {
+		// given
+		obj1 := newObject("obj1", "cat and cat")
+		obj2 := newObject("obj2", "dog")
+		s := newFixture(t, obj1, obj2)
+
+		// when
+		result, err := s.Apply(context.Background(), newApplyRequest("cat", "dog"))
+
+		// then
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.RunID)
+		assert.Equal(t, int64(1), result.ChangedObjects)
+		assert.Equal(t, int64(1), result.ChangedBlocks)
+		assert.Empty(t, result.FailedObjectIds)
+		assert.Equal(t, "dog and dog", getText(obj1))
+		assert.Equal(t, "dog", getText(obj2))
+	})
+	t.Run("failed objects are returned", func(t *testing.T) {
+		// given
+		obj := newObject("obj", "cat")
+		locked := &lockedObject{SmartTest: newObject("locked", "cat"), err: errors.New("object is read only")}
+		s := newFixture(t, obj, locked)
+
+		// when
+		result, err := s.Apply(context.Background(), newApplyRequest("cat", "dog"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.ChangedObjects)
+		assert.Equal(t, []string{"locked"}, result.FailedObjectIds)
+		assert.Equal(t, "dog", getText(obj))
+		assert.Equal(t, "cat", getText(locked))
+	})
+	t.Run("nothing is replaced - no run", func(t *testing.T) {
+		// given
+		s := newFixture(t, newObject("obj", "dog"))
+
+		// when
+		result, err := s.Apply(context.Background(), newApplyRequest("cat", "dog"))
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, result.RunID)
+		assert.Empty(t, s.runs)
+	})
+	t.Run("empty pattern - error", func(t *testing.T) {
+		// given
+		s := newFixture(t, newObject("obj", "cat"))
+
+		// when
+		_, err := s.Apply(context.Background(), newApplyRequest("", "dog"))
+
+		// then
+		assert.ErrorIs(t, err, ErrBadInput)
+	})
+}
+
+func TestService_Undo(t *testing.T) {
+	t.Run("replacement is reverted", func(t *testing.T) {
+		// given
+		obj1 := newObject("obj1", "cat")
+		obj2 := newObject("obj2", "cat and cat")
+		s := newFixture(t, obj1, obj2)
+		applied, err := s.Apply(context.Background(), newApplyRequest("cat", "dog"))
+		require.NoError(t, err)
+
+		// when
+		result, err := s.Undo(context.Background(), applied.RunID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.RestoredBlocks)
+		assert.Empty(t, result.FailedObjectIds)
+		assert.Equal(t, "cat", getText(obj1))
+		assert.Equal(t, "cat and cat", getText(obj2))
+		_, err = s.Undo(context.Background(), applied.RunID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+	t.Run("failed objects are kept, so undo can be repeated", func(t *testing.T) {
+		// given
+		obj := newObject("obj", "cat")
+		locked := &lockedObject{SmartTest: newObject("locked", "cat")}
+		s := newFixture(t, obj, locked)
+		applied, err := s.Apply(context.Background(), newApplyRequest("cat", "dog"))
+		require.NoError(t, err)
+		locked.err = errors.New("object is read only")
+
+		// when
+		result, err := s.Undo(context.Background(), applied.RunID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.RestoredBlocks)
+		assert.Equal(t, []string{"locked"}, result.FailedObjectIds)
+		assert.Equal(t, "cat", getText(obj))
+		assert.Equal(t, "dog", getText(locked))
+
+		// when
+		locked.err = nil
+		result, err = s.Undo(context.Background(), applied.RunID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.RestoredBlocks)
+		assert.Empty(t, result.FailedObjectIds)
+		assert.Equal(t, "cat", getText(locked))
+		assert.Empty(t, s.runs)
+	})
+	t.Run("only the latest replacements can be undone", func(t *testing.T) {
+		// given
+		obj := newObject("obj", "a")
+		s := newFixture(t, obj)
+		first, err := s.Apply(context.Background(), newApplyRequest("a", "b"))
+		require.NoError(t, err)
+		for i := 0; i < maxRuns; i++ {
+			req := newApplyRequest("b", "a")
+			if i%2 == 1 {
+				req = newApplyRequest("a", "b")
+			}
+			_, err = s.Apply(context.Background(), req)
+			require.NoError(t, err)
+		}
+
+		// when
+		_, err = s.Undo(context.Background(), first.RunID)
+
+		// then
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
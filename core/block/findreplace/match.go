@@ -0,0 +1,107 @@
+package findreplace
+
+import (
+	"regexp"
+
+	"github.com/anyproto/anytype-heart/core/block/blocktransform"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+// previewContext is the number of characters before and after the match shown in preview
+const previewContext = 40
+
+// blockChange keeps text of the block before and after replacement, so replacement can be reverted
+type blockChange struct {
+	blockID string
+	before  *model.BlockContentText
+	after   string
+}
+
+// matchingBlocks returns text blocks of the state containing the pattern, in order of the document
+func matchingBlocks(s *state.State, re *regexp.Regexp) []text.Block {
+	var blocks []text.Block
+	s.Iterate(func(b simple.Block) bool {
+		if tb, ok := b.(text.Block); ok && re.MatchString(tb.GetText()) {
+			blocks = append(blocks, tb)
+		}
+		return true
+	})
+	return blocks
+}
+
+// findMatches returns up to limit matches of the pattern in the state
+func findMatches(s *state.State, re *regexp.Regexp, limit int) []*pb.RpcFindReplaceMatch {
+	var matches []*pb.RpcFindReplaceMatch
+	for _, tb := range matchingBlocks(s, re) {
+		source := tb.GetText()
+		for _, loc := range re.FindAllStringIndex(source, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			if len(matches) == limit {
+				return matches
+			}
+			preview, previewRange := makePreview(source, loc[0], loc[1])
+			matches = append(matches, &pb.RpcFindReplaceMatch{
+				ObjectId: s.RootId(),
+				BlockId:  tb.Model().Id,
+				Preview:  preview,
+				Range:    previewRange,
+			})
+		}
+	}
+	return matches
+}
+
+// makePreview cuts the text around the match. Range of the match in preview is in UTF-16 code units,
+// like ranges of marks
+func makePreview(source string, from, to int) (string, *model.Range) {
+	before := []rune(source[:from])
+	if len(before) > previewContext {
+		before = before[len(before)-previewContext:]
+	}
+	after := []rune(source[to:])
+	if len(after) > previewContext {
+		after = after[:previewContext]
+	}
+	match := source[from:to]
+	start := int32(textutil.UTF16RuneCountString(string(before)))
+	end := start + int32(textutil.UTF16RuneCountString(match))
+	return string(before) + match + string(after), &model.Range{From: start, To: end}
+}
+
+// replaceInState replaces matches of the pattern in text blocks and returns changes of blocks
+func replaceInState(s *state.State, re *regexp.Regexp, replacement string, expand bool) []blockChange {
+	var changes []blockChange
+	for _, matched := range matchingBlocks(s, re) {
+		tb, ok := s.Get(matched.Model().Id).(text.Block)
+		if !ok {
+			continue
+		}
+		before := pbtypes.CopyBlock(tb.Model()).GetText()
+		if blocktransform.ReplaceText(tb, re, replacement, expand) {
+			changes = append(changes, blockChange{blockID: tb.Model().Id, before: before, after: tb.GetText()})
+		}
+	}
+	return changes
+}
+
+// revertInState restores text of the changed blocks. Block is skipped, if it's removed or its text is changed
+// after replacement
+func revertInState(s *state.State, changes []blockChange) (restored int) {
+	for _, change := range changes {
+		tb, ok := s.Get(change.blockID).(text.Block)
+		if !ok || tb.GetText() != change.after {
+			continue
+		}
+		tb.SetText(change.before.Text, change.before.Marks)
+		restored++
+	}
+	return restored
+}
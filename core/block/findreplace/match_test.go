@@ -0,0 +1,97 @@
+package findreplace
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newState(texts map[string]string, order ...string) *state.State {
+	root := &model.Block{Id: "root", ChildrenIds: order, Content: &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}}
+	doc := map[string]simple.Block{"root": simple.New(root)}
+	for _, id := range order {
+		doc[id] = simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  texts[id],
+			Marks: &model.BlockContentTextMarks{},
+		}}})
+	}
+	return state.NewDoc("root", doc).(*state.State).NewState()
+}
+
+func TestFindMatches(t *testing.T) {
+	t.Run("matches are found in order of blocks", func(t *testing.T) {
+		// given
+		s := newState(map[string]string{"1": "cat and dog", "2": "no", "3": "dog"}, "3", "2", "1")
+
+		// when
+		matches := findMatches(s, regexp.MustCompile("dog"), 10)
+
+		// then
+		require.Len(t, matches, 2)
+		assert.Equal(t, "3", matches[0].BlockId)
+		assert.Equal(t, "1", matches[1].BlockId)
+		assert.Equal(t, "root", matches[1].ObjectId)
+		assert.Equal(t, "cat and dog", matches[1].Preview)
+		assert.Equal(t, &model.Range{From: 8, To: 11}, matches[1].Range)
+	})
+	t.Run("limit", func(t *testing.T) {
+		// given
+		s := newState(map[string]string{"1": "a a a"}, "1")
+
+		// when
+		matches := findMatches(s, regexp.MustCompile("a"), 2)
+
+		// then
+		assert.Len(t, matches, 2)
+	})
+}
+
+func TestMakePreview(t *testing.T) {
+	// given
+	before := "😀" + strings.Repeat("a", previewContext)
+	source := before + "match" + " tail"
+
+	// when
+	preview, previewRange := makePreview(source, len(before), len(before)+len("match"))
+
+	// then
+	assert.Equal(t, strings.Repeat("a", previewContext)+"match tail", preview)
+	assert.Equal(t, &model.Range{From: previewContext, To: previewContext + 5}, previewRange)
+}
+
+func TestReplaceAndRevert(t *testing.T) {
+	t.Run("replaced text is reverted", func(t *testing.T) {
+		// given
+		s := newState(map[string]string{"1": "old text", "2": "other"}, "1", "2")
+		changes := replaceInState(s, regexp.MustCompile("old"), "new", false)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "new text", s.Pick("1").Model().GetText().Text)
+
+		// when
+		restored := revertInState(s, changes)
+
+		// then
+		assert.Equal(t, 1, restored)
+		assert.Equal(t, "old text", s.Pick("1").Model().GetText().Text)
+	})
+	t.Run("block changed after replacement is kept", func(t *testing.T) {
+		// given
+		s := newState(map[string]string{"1": "old text"}, "1")
+		changes := replaceInState(s, regexp.MustCompile("old"), "new", false)
+		s.Get("1").Model().GetText().Text = "edited"
+
+		// when
+		restored := revertInState(s, changes)
+
+		// then
+		assert.Equal(t, 0, restored)
+		assert.Equal(t, "edited", s.Pick("1").Model().GetText().Text)
+	})
+}
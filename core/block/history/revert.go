@@ -0,0 +1,120 @@
+package history
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var ErrNotFound = errors.New("not found in the object and its version")
+
+// Scope is the part of the object, which is reverted. Empty scope means the whole object
+type Scope struct {
+	BlockIDs     []string
+	RelationKeys []string
+}
+
+func (s Scope) IsEmpty() bool {
+	return len(s.BlockIDs) == 0 && len(s.RelationKeys) == 0
+}
+
+// Revert changes the state, so blocks and relations of the scope are the same as in the version. Reverted block
+// gets its content and children of the version, block missing in the version is removed. The state is applied
+// as a new change, so newer versions are kept in the history
+func Revert(st, version *state.State, scope Scope) error {
+	if scope.IsEmpty() {
+		scope = wholeScope(st, version)
+	}
+	for _, id := range scope.BlockIDs {
+		if !st.Exists(id) && version.Pick(id) == nil {
+			return fmt.Errorf("%w: block %s", ErrNotFound, id)
+		}
+	}
+	for _, key := range scope.RelationKeys {
+		if !hasRelation(st, key) && !hasRelation(version, key) {
+			return fmt.Errorf("%w: relation %s", ErrNotFound, key)
+		}
+	}
+
+	for _, id := range scope.BlockIDs {
+		if err := revertBlock(st, version, id); err != nil {
+			return fmt.Errorf("revert block %s: %w", id, err)
+		}
+	}
+	for _, key := range scope.RelationKeys {
+		revertRelation(st, version, key)
+	}
+	return nil
+}
+
+// wholeScope returns the root block and relations of both states, except local and derived ones
+func wholeScope(st, version *state.State) Scope {
+	keys := append(lo.Keys(version.Details().GetFields()), lo.Keys(st.Details().GetFields())...)
+	keys = lo.Filter(lo.Uniq(keys), func(key string, _ int) bool {
+		return !lo.Contains(bundle.LocalRelationsKeys, key) && !lo.Contains(bundle.DerivedRelationsKeys, key)
+	})
+	sort.Strings(keys)
+	return Scope{BlockIDs: []string{st.RootId()}, RelationKeys: keys}
+}
+
+func hasRelation(st *state.State, key string) bool {
+	_, ok := st.Details().GetFields()[key]
+	return ok || st.HasRelation(key)
+}
+
+func revertBlock(st, version *state.State, id string) error {
+	versionBlock := version.Pick(id)
+	if versionBlock == nil {
+		st.Unlink(id)
+		return nil
+	}
+	existed := st.Exists(id)
+	subtree := append([]simple.Block{versionBlock}, version.Descendants(id)...)
+	// blocks of the subtree could be moved to other places after the version
+	for _, b := range subtree[1:] {
+		if st.Exists(b.Model().Id) {
+			st.Unlink(b.Model().Id)
+		}
+	}
+	for _, b := range subtree {
+		st.Set(b.Copy())
+	}
+	if existed {
+		return nil
+	}
+
+	// the block was removed after the version, so it's inserted after the nearest previous sibling, which exists now
+	parent := version.PickParentOf(id)
+	if parent == nil || !st.Exists(parent.Model().Id) {
+		return st.InsertTo("", model.Block_Inner, id)
+	}
+	parentID := parent.Model().Id
+	siblings := parent.Model().ChildrenIds
+	for i := slice.FindPos(siblings, id) - 1; i >= 0; i-- {
+		if st.IsParentOf(parentID, siblings[i]) {
+			return st.InsertTo(siblings[i], model.Block_Bottom, id)
+		}
+	}
+	return st.InsertTo(parentID, model.Block_InnerFirst, id)
+}
+
+func revertRelation(st, version *state.State, key string) {
+	value, ok := version.Details().GetFields()[key]
+	if !ok {
+		st.RemoveDetail(key)
+		return
+	}
+	if link := version.GetRelationLinks().Get(key); link != nil && !st.HasRelation(key) {
+		st.AddRelationLinks(link)
+	}
+	st.SetDetail(key, pbtypes.CopyVal(value))
+}
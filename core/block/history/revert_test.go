@@ -0,0 +1,150 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func textBlock(id, text string, childrenIDs ...string) simple.Block {
+	return simple.New(&model.Block{
+		Id:          id,
+		ChildrenIds: childrenIDs,
+		Content:     &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}},
+	})
+}
+
+// makeVersion returns the old version with blocks "first", "second" with the child and the name
+func makeVersion() *state.State {
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root":   simple.New(&model.Block{Id: "root", ChildrenIds: []string{"first", "second"}}),
+		"first":  textBlock("first", "first v1"),
+		"second": textBlock("second", "second v1", "child"),
+		"child":  textBlock("child", "child v1"),
+	}).(*state.State)
+	st.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Old name"))
+	st.AddRelationLinks(&model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag})
+	st.SetDetail(bundle.RelationKeyTag.String(), pbtypes.StringList([]string{"tag1"}))
+	return st
+}
+
+// makeCurrent returns the current version: "first" and "child" are changed, "second" is removed and "third" is added
+func makeCurrent() *state.State {
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root":  simple.New(&model.Block{Id: "root", ChildrenIds: []string{"first", "third"}}),
+		"first": textBlock("first", "first v2", "child"),
+		"child": textBlock("child", "child v2"),
+		"third": textBlock("third", "third v2"),
+	}).(*state.State)
+	st.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("New name"))
+	st.SetDetail(bundle.RelationKeyDescription.String(), pbtypes.String("New description"))
+	return st.NewState()
+}
+
+func texts(st *state.State, ids ...string) []string {
+	var result []string
+	for _, id := range ids {
+		result = append(result, st.Pick(id).Model().GetText().GetText())
+	}
+	return result
+}
+
+func TestRevert(t *testing.T) {
+	t.Run("selected block", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		err := Revert(st, makeVersion(), Scope{BlockIDs: []string{"first"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "third"}, st.Pick("root").Model().ChildrenIds)
+		assert.Empty(t, st.Pick("first").Model().ChildrenIds)
+		assert.Equal(t, []string{"first v1", "third v2"}, texts(st, "first", "third"))
+		assert.Equal(t, "New name", pbtypes.GetString(st.Details(), bundle.RelationKeyName.String()))
+	})
+
+	t.Run("removed block is restored with children after previous sibling", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		err := Revert(st, makeVersion(), Scope{BlockIDs: []string{"second"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "second", "third"}, st.Pick("root").Model().ChildrenIds)
+		assert.Empty(t, st.Pick("first").Model().ChildrenIds)
+		assert.Equal(t, []string{"child"}, st.Pick("second").Model().ChildrenIds)
+		assert.Equal(t, []string{"first v2", "second v1", "child v1"}, texts(st, "first", "second", "child"))
+	})
+
+	t.Run("block added after the version is removed", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		err := Revert(st, makeVersion(), Scope{BlockIDs: []string{"third"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first"}, st.Pick("root").Model().ChildrenIds)
+	})
+
+	t.Run("selected relations", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		err := Revert(st, makeVersion(), Scope{RelationKeys: []string{
+			bundle.RelationKeyTag.String(),
+			bundle.RelationKeyDescription.String(),
+		}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"tag1"}, pbtypes.GetStringList(st.Details(), bundle.RelationKeyTag.String()))
+		assert.True(t, st.HasRelation(bundle.RelationKeyTag.String()))
+		assert.Empty(t, pbtypes.GetString(st.Details(), bundle.RelationKeyDescription.String()))
+		assert.Equal(t, "New name", pbtypes.GetString(st.Details(), bundle.RelationKeyName.String()))
+		assert.Equal(t, []string{"first v2"}, texts(st, "first"))
+	})
+
+	t.Run("whole object", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		err := Revert(st, makeVersion(), Scope{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"first", "second"}, st.Pick("root").Model().ChildrenIds)
+		assert.Equal(t, []string{"child"}, st.Pick("second").Model().ChildrenIds)
+		assert.Equal(t, []string{"first v1", "second v1", "child v1"}, texts(st, "first", "second", "child"))
+		assert.Equal(t, "Old name", pbtypes.GetString(st.Details(), bundle.RelationKeyName.String()))
+		assert.Equal(t, []string{"tag1"}, pbtypes.GetStringList(st.Details(), bundle.RelationKeyTag.String()))
+		assert.Empty(t, pbtypes.GetString(st.Details(), bundle.RelationKeyDescription.String()))
+	})
+
+	t.Run("unknown block or relation", func(t *testing.T) {
+		// given
+		st := makeCurrent()
+
+		// when
+		errBlock := Revert(st, makeVersion(), Scope{BlockIDs: []string{"unknown"}})
+		errRelation := Revert(st, makeVersion(), Scope{RelationKeys: []string{bundle.RelationKeyDueDate.String()}})
+
+		// then
+		assert.ErrorIs(t, errBlock, ErrNotFound)
+		assert.ErrorIs(t, errRelation, ErrNotFound)
+	})
+}
@@ -0,0 +1,210 @@
+package applenotes
+
+import (
+	"strings"
+	"unicode/utf16"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// paragraph is a line of note text with marks and attachments, which are placed in the line
+type paragraph struct {
+	text        []uint16
+	style       int
+	checked     bool
+	styleSet    bool
+	marks       []*model.BlockContentTextMark
+	attachments []*attachmentInfo
+}
+
+func (p *paragraph) isEmpty() bool {
+	return len(strings.TrimSpace(p.String())) == 0 && len(p.attachments) == 0
+}
+
+func (p *paragraph) String() string {
+	return string(utf16.Decode(p.text))
+}
+
+// splitParagraphs splits note text by lines and applies attribute runs to them
+func splitParagraphs(body *noteBody) []*paragraph {
+	text := utf16.Encode([]rune(body.text))
+	var (
+		paragraphs []*paragraph
+		pos        int
+	)
+	current := &paragraph{style: styleBody}
+	appendSegment := func(segment []uint16, run *attributeRun) {
+		for {
+			lineEnd := indexOfNewLine(segment)
+			part := segment
+			if lineEnd >= 0 {
+				part = segment[:lineEnd]
+			}
+			if !current.styleSet && (len(part) > 0 || lineEnd >= 0) {
+				current.style, current.checked, current.styleSet = run.style, run.checked, true
+			}
+			current.addPart(part, run)
+			if lineEnd < 0 {
+				return
+			}
+			paragraphs = append(paragraphs, current)
+			current = &paragraph{style: styleBody}
+			segment = segment[lineEnd+1:]
+		}
+	}
+	for _, run := range body.runs {
+		end := pos + run.length
+		if end > len(text) {
+			end = len(text)
+		}
+		appendSegment(text[pos:end], run)
+		pos = end
+	}
+	if pos < len(text) {
+		appendSegment(text[pos:], &attributeRun{style: styleBody})
+	}
+	if !current.isEmpty() {
+		paragraphs = append(paragraphs, current)
+	}
+	return paragraphs
+}
+
+func indexOfNewLine(text []uint16) int {
+	for i, c := range text {
+		if c == '\n' {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *paragraph) addPart(part []uint16, run *attributeRun) {
+	if run.attachment != nil {
+		if len(part) > 0 {
+			p.attachments = append(p.attachments, run.attachment)
+		}
+		return
+	}
+	part = removeAttachmentSymbols(part)
+	if len(part) == 0 {
+		return
+	}
+	from := int32(len(p.text))
+	p.text = append(p.text, part...)
+	to := int32(len(p.text))
+	for _, markType := range getMarkTypes(run) {
+		p.marks = append(p.marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: from, To: to},
+			Type:  markType,
+		})
+	}
+	if run.link != "" {
+		p.marks = append(p.marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: from, To: to},
+			Type:  model.BlockContentTextMark_Link,
+			Param: run.link,
+		})
+	}
+}
+
+func removeAttachmentSymbols(text []uint16) []uint16 {
+	result := text[:0:0]
+	for _, c := range text {
+		if c != attachmentSymbol {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func getMarkTypes(run *attributeRun) []model.BlockContentTextMarkType {
+	var types []model.BlockContentTextMarkType
+	if run.fontWeight == fontWeightBold || run.fontWeight == fontWeightBoldItalic {
+		types = append(types, model.BlockContentTextMark_Bold)
+	}
+	if run.fontWeight == fontWeightItalic || run.fontWeight == fontWeightBoldItalic {
+		types = append(types, model.BlockContentTextMark_Italic)
+	}
+	if run.underlined {
+		types = append(types, model.BlockContentTextMark_Underscored)
+	}
+	if run.strikethrough {
+		types = append(types, model.BlockContentTextMark_Strikethrough)
+	}
+	return types
+}
+
+// getBlocks converts paragraphs to text blocks, joining consecutive monospaced lines into one code block.
+// Attachments are added after the paragraph, where they are placed
+func getBlocks(paragraphs []*paragraph, attachments attachmentResolver) []*model.Block {
+	var (
+		blocks   []*model.Block
+		lastCode *model.BlockContentText
+	)
+	for _, p := range paragraphs {
+		if p.isEmpty() {
+			lastCode = nil
+			continue
+		}
+		if text := p.String(); strings.TrimSpace(text) != "" {
+			if p.style == styleMonospaced && lastCode != nil {
+				lastCode.Text += "\n" + text
+			} else {
+				block := getTextBlock(p)
+				blocks = append(blocks, block)
+				lastCode = nil
+				if p.style == styleMonospaced {
+					lastCode = block.GetText()
+				}
+			}
+		}
+		for _, info := range p.attachments {
+			if block := attachments.getBlock(info); block != nil {
+				blocks = append(blocks, block)
+				lastCode = nil
+			}
+		}
+	}
+	return blocks
+}
+
+func getTextBlock(p *paragraph) *model.Block {
+	text := &model.BlockContentText{
+		Text:    p.String(),
+		Style:   getTextStyle(p.style),
+		Checked: p.checked,
+	}
+	if p.style == styleMonospaced {
+		// marks don't make sense in code
+		text.Marks = &model.BlockContentTextMarks{}
+	} else {
+		text.Marks = &model.BlockContentTextMarks{Marks: p.marks}
+	}
+	return &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: text},
+	}
+}
+
+func getTextStyle(style int) model.BlockContentTextStyle {
+	switch style {
+	case styleTitle:
+		return model.BlockContentText_Header1
+	case styleHeading:
+		return model.BlockContentText_Header2
+	case styleSubheading:
+		return model.BlockContentText_Header3
+	case styleMonospaced:
+		return model.BlockContentText_Code
+	case styleDottedList, styleDashedList:
+		return model.BlockContentText_Marked
+	case styleNumbered:
+		return model.BlockContentText_Numbered
+	case styleChecklist:
+		return model.BlockContentText_Checkbox
+	default:
+		return model.BlockContentText_Paragraph
+	}
+}
@@ -0,0 +1,180 @@
+package applenotes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyNote
+const (
+	Name               = "AppleNotes"
+	rootCollectionName = "Apple Notes Import"
+	noteStoreFileName  = "NoteStore.sqlite"
+)
+
+var log = logging.Logger("import-apple-notes")
+
+// AppleNotes imports notes from NoteStore.sqlite database of Apple Notes.
+// Attachments are taken from Accounts directory, which is placed next to the database.
+// Folders of HTML notes exported from Apple Notes are imported as well
+type AppleNotes struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &AppleNotes{service: service, tempDirProvider: tempDirProvider, parseLimits: converter.DefaultParseLimits}
+}
+
+func (a *AppleNotes) Name() string {
+	return Name
+}
+
+func (a *AppleNotes) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetAppleNotesParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (a *AppleNotes) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := a.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from notes")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := a.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(a.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (a *AppleNotes) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, a.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := a.handleImportPath(p, converter.SourceFilter(req), converter.ObjectTypeKey(req, defaultObjectType), len(paths), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of notes and collections of folders and list of objects,
+// that should be added to the root collection: top level folders and notes outside of folders
+func (a *AppleNotes) handleImportPath(importPath string,
+	filter *source.Filter,
+	objectType string,
+	pathsCount int,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	if isHTMLExport(importPath) {
+		return a.handleHTMLExport(importPath, filter, objectType, pathsCount, limits, allErrors)
+	}
+	dbPath := getDatabasePath(importPath)
+	store, err := openNoteStore(dbPath)
+	if err != nil {
+		allErrors.Add(converter.NewFileError(dbPath, err))
+		return nil, nil
+	}
+	defer func() {
+		if err := store.close(); err != nil {
+			log.Warnf("failed to close Apple Notes database: %v", err)
+		}
+	}()
+	notes, err := store.listNotes()
+	if err != nil {
+		allErrors.Add(converter.NewFileError(dbPath, err))
+		return nil, nil
+	}
+	folders, err := store.listFolders()
+	if err != nil {
+		allErrors.Add(converter.NewFileError(dbPath, err))
+		return nil, nil
+	}
+	attachments, err := store.listAttachments()
+	if err != nil {
+		allErrors.Add(converter.NewFileError(dbPath, err))
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_AppleNotes) {
+			return nil, nil
+		}
+	}
+	b := newSnapshotBuilder(dbPath, objectType, a.service, limits, folders, attachmentResolver{
+		rootDir:     store.rootDir,
+		attachments: attachments,
+	})
+	for _, n := range notes {
+		if err = b.addNote(n); err != nil {
+			allErrors.Add(converter.NewFileError(dbPath, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_AppleNotes) {
+				return nil, nil
+			}
+		}
+	}
+	snapshots, rootObjects, err := b.build()
+	if err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	if len(snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return snapshots, rootObjects
+}
+
+// getDatabasePath returns path to the database, directory of Apple Notes group container can be imported as well
+func getDatabasePath(importPath string) string {
+	if info, err := os.Stat(importPath); err == nil && info.IsDir() {
+		return filepath.Join(importPath, noteStoreFileName)
+	}
+	return importPath
+}
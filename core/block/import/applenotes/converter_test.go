@@ -0,0 +1,162 @@
+package applenotes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestAppleNotes_GetSnapshots(t *testing.T) {
+	t.Run("folder with two notes is converted to collection", func(t *testing.T) {
+		// given
+		a := &AppleNotes{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_AppleNotes, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		pancakes := convertertest.FindSnapshot(sn.Snapshots, "Pancakes")
+		shopping := convertertest.FindSnapshot(sn.Snapshots, "Shopping")
+		recipes := convertertest.FindSnapshot(sn.Snapshots, "Recipes")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{pancakes, shopping, recipes, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{pancakes.Id, shopping.Id}, convertertest.CollectionObjects(recipes))
+		assert.Equal(t, []string{recipes.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+		assert.Equal(t, []string{bundle.TypeKeyNote.String()}, pancakes.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, int64(1695307200), pbtypes.GetInt64(pancakes.Snapshot.Data.Details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1695310800), pbtypes.GetInt64(pancakes.Snapshot.Data.Details, bundle.RelationKeyLastModifiedDate.String()))
+	})
+	t.Run("note body is converted to blocks with styles, marks and attachments", func(t *testing.T) {
+		// given
+		a := &AppleNotes{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_AppleNotes, filepath.Join("testdata", noteStoreFileName)), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		pancakes := convertertest.FindSnapshot(sn.Snapshots, "Pancakes")
+		require.NotNil(t, pancakes)
+		blocks := pancakes.Snapshot.Data.Blocks
+		require.Len(t, blocks, 5)
+
+		assert.Equal(t, "Ingredients", blocks[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Header2, blocks[0].GetText().Style)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks[1].GetText().Style)
+		assert.True(t, blocks[1].GetText().Checked)
+		assert.False(t, blocks[2].GetText().Checked)
+
+		text := blocks[3].GetText()
+		assert.Equal(t, "Mix gently and see recipe", text.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 4, To: 10}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 19, To: 25}, Type: model.BlockContentTextMark_Link, Param: "https://example.com/pancakes"},
+		}, text.Marks.Marks)
+
+		file := blocks[4].GetFile()
+		require.NotNil(t, file)
+		assert.Equal(t, model.BlockContentFile_Image, file.Type)
+		assert.Equal(t, "pancakes.png", filepath.Base(file.Name))
+		_, statErr := os.Stat(file.Name)
+		assert.NoError(t, statErr)
+
+		shopping := convertertest.FindSnapshot(sn.Snapshots, "Shopping")
+		require.NotNil(t, shopping)
+		var items []string
+		for _, b := range shopping.Snapshot.Data.Blocks {
+			assert.Equal(t, model.BlockContentText_Marked, b.GetText().Style)
+			items = append(items, b.GetText().Text)
+		}
+		assert.Equal(t, []string{"Eggs 🥚", "Butter"}, items)
+	})
+	t.Run("HTML export - folders are converted to nested collections", func(t *testing.T) {
+		// given
+		a := &AppleNotes{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_AppleNotes, filepath.Join("testdata", "htmlexport")), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		pancakes := convertertest.FindSnapshot(sn.Snapshots, "Pancakes")
+		cake := convertertest.FindSnapshot(sn.Snapshots, "Cake")
+		ideas := convertertest.FindSnapshot(sn.Snapshots, "Ideas")
+		recipes := convertertest.FindSnapshot(sn.Snapshots, "Recipes")
+		desserts := convertertest.FindSnapshot(sn.Snapshots, "Desserts")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{pancakes, cake, ideas, recipes, desserts, root} {
+			require.NotNil(t, s)
+		}
+		assert.Len(t, sn.Snapshots, 6)
+		assert.Equal(t, []string{cake.Id}, convertertest.CollectionObjects(desserts))
+		assert.Equal(t, []string{desserts.Id, pancakes.Id}, convertertest.CollectionObjects(recipes))
+		assert.Equal(t, []string{recipes.Id, ideas.Id}, convertertest.CollectionObjects(root))
+	})
+	t.Run("HTML export - links to notes and attachments are converted", func(t *testing.T) {
+		// given
+		a := &AppleNotes{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_AppleNotes, filepath.Join("testdata", "htmlexport")), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		pancakes := convertertest.FindSnapshot(sn.Snapshots, "Pancakes")
+		cake := convertertest.FindSnapshot(sn.Snapshots, "Cake")
+		require.NotNil(t, pancakes)
+		require.NotNil(t, cake)
+		var (
+			files []string
+			marks []*model.BlockContentTextMark
+		)
+		for _, b := range pancakes.Snapshot.Data.Blocks {
+			if file := b.GetFile(); file != nil {
+				files = append(files, file.Name)
+			}
+			marks = append(marks, b.GetText().GetMarks().GetMarks()...)
+		}
+		require.Len(t, files, 1)
+		assert.Equal(t, "pancakes.png", filepath.Base(files[0]))
+		require.Len(t, marks, 1)
+		assert.Equal(t, model.BlockContentTextMark_Object, marks[0].Type)
+		assert.Equal(t, cake.Id, marks[0].Param)
+	})
+	t.Run("file is not a database - return error", func(t *testing.T) {
+		// given
+		a := &AppleNotes{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		path := filepath.Join(t.TempDir(), noteStoreFileName)
+		require.NoError(t, os.WriteFile(path, []byte("not a database"), 0600))
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_AppleNotes, path), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,286 @@
+package applenotes
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+var htmlExtensions = []string{".html", ".htm"}
+
+// isHTMLExport reports whether the import path is the folder of HTML notes exported from Apple Notes
+// or zip archive with it, instead of the database of Apple Notes
+func isHTMLExport(importPath string) bool {
+	if strings.EqualFold(filepath.Ext(importPath), ".zip") {
+		return true
+	}
+	info, err := os.Stat(importPath)
+	if err != nil || !info.IsDir() {
+		return false
+	}
+	_, err = os.Stat(filepath.Join(importPath, noteStoreFileName))
+	return os.IsNotExist(err)
+}
+
+// htmlExportBuilder creates snapshots of notes of HTML export. Folders of the export are converted to collections
+type htmlExportBuilder struct {
+	importPath      string
+	importSource    source.Source
+	objectType      string
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	limits          converter.ParseLimits
+
+	snapshots []*converter.Snapshot
+	// noteIDs maps file name of note to its object id, so links between notes are resolved
+	noteIDs map[string]string
+	// folderNotes maps folder relative to the root of export to ids of notes in it
+	folderNotes map[string][]string
+	// subfolders maps folder to folders inside it
+	subfolders map[string][]string
+}
+
+// handleHTMLExport returns snapshots of notes and collections of folders and list of objects,
+// that should be added to the root collection: top level folders and notes outside of folders
+func (a *AppleNotes) handleHTMLExport(importPath string,
+	filter *source.Filter,
+	objectType string,
+	pathsCount int,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	var noteFiles []string
+	if err := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+		if isHTMLFile(fileName) {
+			noteFiles = append(noteFiles, fileName)
+		}
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	if len(noteFiles) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	sort.Strings(noteFiles)
+	b := &htmlExportBuilder{
+		importPath:      importPath,
+		importSource:    importSource,
+		objectType:      objectType,
+		service:         a.service,
+		tempDirProvider: a.tempDirProvider,
+		limits:          limits,
+		noteIDs:         make(map[string]string, len(noteFiles)),
+		folderNotes:     map[string][]string{},
+		subfolders:      map[string][]string{},
+	}
+	for _, fileName := range noteFiles {
+		b.noteIDs[fileName] = uuid.New().String()
+	}
+	for _, fileName := range noteFiles {
+		if err := b.addNote(fileName); err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_AppleNotes) {
+				return nil, nil
+			}
+		}
+	}
+	rootObjects, err := b.build()
+	if err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	if len(b.snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return b.snapshots, rootObjects
+}
+
+func (b *htmlExportBuilder) addNote(fileName string) error {
+	var blocks []*model.Block
+	err := b.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		data, err := b.limits.ReadAll(fileReader)
+		if err != nil {
+			return err
+		}
+		blocks, _, err = anymark.HTMLToBlocks(data)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read note: %w", err)
+	}
+	for _, block := range blocks {
+		if file := block.GetFile(); file != nil {
+			file.Name = b.provideAttachmentName(file.Name, fileName)
+		}
+		if marks := block.GetText().GetMarks().GetMarks(); len(marks) > 0 {
+			b.updateLinks(block, fileName)
+		}
+	}
+	id := b.noteIDs[fileName]
+	title := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:      blocks,
+			Details:     converter.GetCommonDetails(fileName, title, "", model.ObjectType_basic),
+			ObjectTypes: []string{b.objectType},
+		}},
+	})
+	folder := b.relativeFolder(fileName)
+	b.folderNotes[folder] = append(b.folderNotes[folder], id)
+	return nil
+}
+
+// updateLinks replaces links to other notes with links to objects and imports linked attachments as files
+func (b *htmlExportBuilder) updateLinks(block *model.Block, noteFileName string) {
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link {
+			continue
+		}
+		linkedFile, ok := resolveLink(mark.Param, noteFileName)
+		if !ok {
+			continue
+		}
+		if id, ok := b.noteIDs[linkedFile]; ok {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = id
+			continue
+		}
+		newFileName, createFileBlock, err := converter.ProvideFileName(linkedFile, b.importSource, b.importPath, b.tempDirProvider)
+		if err != nil {
+			log.Errorf("failed to update link with new file name: %v", oserror.TransformError(err))
+			continue
+		}
+		if createFileBlock {
+			mark.Param = newFileName
+			anymark.ConvertTextToFile(block)
+			return
+		}
+	}
+}
+
+func (b *htmlExportBuilder) provideAttachmentName(link, noteFileName string) string {
+	linkedFile, ok := resolveLink(link, noteFileName)
+	if !ok {
+		return link
+	}
+	newFileName, _, err := converter.ProvideFileName(linkedFile, b.importSource, b.importPath, b.tempDirProvider)
+	if err != nil {
+		log.Errorf("failed to update file block with new file name: %v", oserror.TransformError(err))
+		return link
+	}
+	return newFileName
+}
+
+// relativeFolder returns the folder of the note relative to the root of export with slash separators.
+// Files of directory have absolute paths, while files of archive have paths relative to its root
+func (b *htmlExportBuilder) relativeFolder(fileName string) string {
+	if relPath, err := filepath.Rel(b.importPath, fileName); err == nil && !strings.HasPrefix(relPath, "..") {
+		fileName = relPath
+	}
+	return path.Dir(filepath.ToSlash(fileName))
+}
+
+// build creates collections of folders, which contain notes, and returns ids of objects,
+// that should be added to the root collection
+func (b *htmlExportBuilder) build() ([]string, error) {
+	for folder := range b.folderNotes {
+		for folder != "." && folder != "/" {
+			parent := path.Dir(folder)
+			if !lo.Contains(b.subfolders[parent], folder) {
+				b.subfolders[parent] = append(b.subfolders[parent], folder)
+			}
+			folder = parent
+		}
+	}
+	rootObjects := make([]string, 0)
+	for _, folder := range sortedStrings(b.subfolders["."]) {
+		id, err := b.addFolder(folder)
+		if err != nil {
+			return nil, err
+		}
+		rootObjects = append(rootObjects, id)
+	}
+	return append(rootObjects, b.folderNotes["."]...), nil
+}
+
+func (b *htmlExportBuilder) addFolder(folder string) (string, error) {
+	var objects []string
+	for _, subfolder := range sortedStrings(b.subfolders[folder]) {
+		id, err := b.addFolder(subfolder)
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	objects = append(objects, b.folderNotes[folder]...)
+	title := path.Base(folder)
+	col, err := converter.NewRootCollection(b.service).MakeCollection(title, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for %s: %w", title, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+func isHTMLFile(fileName string) bool {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for _, e := range htmlExtensions {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLink returns the name of file in import source for the link relative to the note.
+// Links to web pages, anchors and absolute paths are not resolved
+func resolveLink(link, noteFileName string) (string, bool) {
+	if i := strings.IndexAny(link, "?#"); i >= 0 {
+		link = link[:i]
+	}
+	if link == "" || filepath.IsAbs(link) {
+		return "", false
+	}
+	if u, err := url.Parse(link); err != nil || u.Scheme != "" {
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(link); err == nil {
+		link = unescaped
+	}
+	return filepath.Join(filepath.Dir(noteFileName), filepath.FromSlash(link)), true
+}
+
+func sortedStrings(values []string) []string {
+	sort.Strings(values)
+	return values
+}
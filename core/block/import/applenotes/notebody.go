@@ -0,0 +1,223 @@
+package applenotes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+// Paragraph styles of Apple Notes
+const (
+	styleBody       = -1
+	styleTitle      = 0
+	styleHeading    = 1
+	styleSubheading = 2
+	styleMonospaced = 4
+	styleDottedList = 100
+	styleDashedList = 101
+	styleNumbered   = 102
+	styleChecklist  = 103
+)
+
+// attachmentSymbol is a placeholder of attachment in the text of note
+const attachmentSymbol = '\uFFFC'
+
+// Font weights of Apple Notes
+const (
+	fontWeightBold       = 1
+	fontWeightItalic     = 2
+	fontWeightBoldItalic = 3
+)
+
+// noteBody is a text of the note with attribute runs. Length of run is measured in UTF-16 code units
+type noteBody struct {
+	text string
+	runs []*attributeRun
+}
+
+type attributeRun struct {
+	length        int
+	style         int
+	checked       bool
+	fontWeight    int
+	underlined    bool
+	strikethrough bool
+	link          string
+	attachment    *attachmentInfo
+}
+
+type attachmentInfo struct {
+	id      string
+	typeUTI string
+}
+
+// parseNoteBody decompresses note data and parses NoteStoreProto -> Document -> Note message.
+// Only fields, which are used for conversion, are parsed, so unknown fields of newer versions are skipped.
+// Size of decompressed data is bounded by limits
+func parseNoteBody(data []byte, limits converter.ParseLimits) (*noteBody, error) {
+	if isGzip(data) {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("open gzip: %w", err)
+		}
+		defer r.Close()
+		data, err = limits.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompress note: %w", err)
+		}
+	}
+	document, err := findMessage(data, 2)
+	if err != nil {
+		return nil, fmt.Errorf("read document: %w", err)
+	}
+	note, err := findMessage(document, 3)
+	if err != nil {
+		return nil, fmt.Errorf("read note: %w", err)
+	}
+	body := &noteBody{}
+	err = iterateFields(note, func(number int, value uint64, raw []byte) error {
+		switch number {
+		case 2:
+			body.text = string(raw)
+		case 5:
+			run, err := parseAttributeRun(raw)
+			if err != nil {
+				return fmt.Errorf("read attribute run: %w", err)
+			}
+			body.runs = append(body.runs, run)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func isGzip(data []byte) bool {
+	return len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+func parseAttributeRun(data []byte) (*attributeRun, error) {
+	run := &attributeRun{style: styleBody}
+	err := iterateFields(data, func(number int, value uint64, raw []byte) error {
+		switch number {
+		case 1:
+			run.length = int(value)
+		case 2:
+			return parseParagraphStyle(raw, run)
+		case 5:
+			run.fontWeight = int(value)
+		case 6:
+			run.underlined = value != 0
+		case 7:
+			run.strikethrough = value != 0
+		case 9:
+			run.link = string(raw)
+		case 12:
+			info := &attachmentInfo{}
+			err := iterateFields(raw, func(number int, _ uint64, raw []byte) error {
+				switch number {
+				case 1:
+					info.id = string(raw)
+				case 2:
+					info.typeUTI = string(raw)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("read attachment info: %w", err)
+			}
+			run.attachment = info
+		}
+		return nil
+	})
+	return run, err
+}
+
+func parseParagraphStyle(data []byte, run *attributeRun) error {
+	return iterateFields(data, func(number int, value uint64, raw []byte) error {
+		switch number {
+		case 1:
+			run.style = int(int32(value))
+		case 5:
+			return iterateFields(raw, func(number int, value uint64, _ []byte) error {
+				if number == 2 {
+					run.checked = value != 0
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+// findMessage returns the first length-delimited field with given number
+func findMessage(data []byte, fieldNumber int) ([]byte, error) {
+	var message []byte
+	err := iterateFields(data, func(number int, _ uint64, raw []byte) error {
+		if number == fieldNumber && message == nil {
+			message = raw
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if message == nil {
+		return nil, fmt.Errorf("field %d is not found", fieldNumber)
+	}
+	return message, nil
+}
+
+// iterateFields reads protobuf wire format. Value is set for varint and fixed fields, raw is set for length-delimited ones
+func iterateFields(data []byte, proc func(number int, value uint64, raw []byte) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("invalid field key")
+		}
+		data = data[n:]
+		var (
+			value uint64
+			raw   []byte
+		)
+		switch wireType := key & 7; wireType {
+		case 0:
+			value, n = binary.Uvarint(data)
+			if n <= 0 {
+				return fmt.Errorf("invalid varint")
+			}
+			data = data[n:]
+		case 1:
+			if len(data) < 8 {
+				return io.ErrUnexpectedEOF
+			}
+			value = binary.LittleEndian.Uint64(data)
+			data = data[8:]
+		case 2:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return io.ErrUnexpectedEOF
+			}
+			raw = data[n : n+int(length)]
+			data = data[n+int(length):]
+		case 5:
+			if len(data) < 4 {
+				return io.ErrUnexpectedEOF
+			}
+			value = uint64(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		default:
+			return fmt.Errorf("unsupported wire type %d", wireType)
+		}
+		if err := proc(int(key>>3), value, raw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package applenotes
+
+import (
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+
+	// pure Go driver, so the import works on all platforms
+	_ "modernc.org/sqlite"
+)
+
+const (
+	objectTable   = "ZICCLOUDSYNCINGOBJECT"
+	noteDataTable = "ZICNOTEDATA"
+
+	// coreDataEpoch is the Unix time of 2001-01-01, dates in the database are seconds since it
+	coreDataEpoch = 978307200
+	// folderTypeTrash is the type of "Recently Deleted" folder
+	folderTypeTrash = 1
+)
+
+// Columns of objects table have been renamed across macOS versions, so candidates are listed from the newest
+var (
+	noteTitleColumns    = []string{"ZTITLE1", "ZTITLE"}
+	folderTitleColumns  = []string{"ZTITLE2", "ZTITLE"}
+	noteFolderColumns   = []string{"ZFOLDER", "ZFOLDER2"}
+	creationDateColumns = []string{"ZCREATIONDATE3", "ZCREATIONDATE1", "ZCREATIONDATE"}
+	modifiedDateColumns = []string{"ZMODIFICATIONDATE1", "ZMODIFICATIONDATE"}
+	deletedColumns      = []string{"ZMARKEDFORDELETION"}
+	protectedColumns    = []string{"ZISPASSWORDPROTECTED"}
+	folderParentColumns = []string{"ZPARENT"}
+	folderTypeColumns   = []string{"ZFOLDERTYPE"}
+	identifierColumns   = []string{"ZIDENTIFIER"}
+	typeUTIColumns      = []string{"ZTYPEUTI"}
+	mediaColumns        = []string{"ZMEDIA"}
+	fileNameColumns     = []string{"ZFILENAME"}
+)
+
+type note struct {
+	id        int64
+	title     string
+	folderID  int64
+	created   int64
+	modified  int64
+	protected bool
+	data      []byte
+}
+
+type folder struct {
+	id       int64
+	title    string
+	parentID int64
+	trash    bool
+}
+
+type attachment struct {
+	typeUTI  string
+	mediaID  string
+	fileName string
+}
+
+// noteStore reads notes, folders and attachments from NoteStore.sqlite
+type noteStore struct {
+	db      *sql.DB
+	rootDir string
+	columns map[string]bool
+}
+
+func openNoteStore(path string) (*noteStore, error) {
+	dsn := (&url.URL{Scheme: "file", Path: filepath.ToSlash(path), RawQuery: "mode=ro"}).String()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	s := &noteStore{db: db, rootDir: filepath.Dir(path)}
+	if s.columns, err = s.getColumns(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if len(s.columns) == 0 {
+		db.Close()
+		return nil, fmt.Errorf("table %s is not found, file is not Apple Notes database", objectTable)
+	}
+	return s, nil
+}
+
+func (s *noteStore) close() error {
+	return s.db.Close()
+}
+
+func (s *noteStore) getColumns() (map[string]bool, error) {
+	rows, err := s.db.Query("SELECT name FROM pragma_table_info('" + objectTable + "')")
+	if err != nil {
+		return nil, fmt.Errorf("read schema: %w", err)
+	}
+	defer rows.Close()
+	columns := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("read schema: %w", err)
+		}
+		columns[strings.ToUpper(name)] = true
+	}
+	return columns, rows.Err()
+}
+
+// column returns the first existing column of table alias or NULL, if none of candidates exists in this version of schema
+func (s *noteStore) column(alias string, candidates []string) string {
+	for _, c := range candidates {
+		if s.columns[c] {
+			return alias + "." + c
+		}
+	}
+	return "NULL"
+}
+
+func (s *noteStore) listNotes() ([]*note, error) {
+	query := fmt.Sprintf(`SELECT o.Z_PK, %s, %s, %s, %s, %s, d.ZDATA FROM %s o JOIN %s d ON d.ZNOTE = o.Z_PK
+		WHERE d.ZDATA IS NOT NULL AND IFNULL(%s, 0) = 0 ORDER BY o.Z_PK`,
+		s.column("o", noteTitleColumns),
+		s.column("o", noteFolderColumns),
+		s.column("o", creationDateColumns),
+		s.column("o", modifiedDateColumns),
+		s.column("o", protectedColumns),
+		objectTable, noteDataTable,
+		s.column("o", deletedColumns),
+	)
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("read notes: %w", err)
+	}
+	defer rows.Close()
+	var notes []*note
+	for rows.Next() {
+		var (
+			n                 note
+			title             sql.NullString
+			folderID          sql.NullInt64
+			created, modified sql.NullFloat64
+			protected         sql.NullBool
+		)
+		if err = rows.Scan(&n.id, &title, &folderID, &created, &modified, &protected, &n.data); err != nil {
+			return nil, fmt.Errorf("read note: %w", err)
+		}
+		n.title, n.folderID, n.protected = title.String, folderID.Int64, protected.Bool
+		n.created, n.modified = toUnixTime(created), toUnixTime(modified)
+		notes = append(notes, &n)
+	}
+	return notes, rows.Err()
+}
+
+func (s *noteStore) listFolders() (map[int64]*folder, error) {
+	query := fmt.Sprintf(`SELECT o.Z_PK, %s, %s, %s FROM %s o
+		WHERE o.Z_PK IN (SELECT %s FROM %s n) OR o.Z_PK IN (SELECT %s FROM %s n)`,
+		s.column("o", folderTitleColumns),
+		s.column("o", folderParentColumns),
+		s.column("o", folderTypeColumns),
+		objectTable,
+		s.column("n", noteFolderColumns), objectTable,
+		s.column("n", folderParentColumns), objectTable,
+	)
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("read folders: %w", err)
+	}
+	defer rows.Close()
+	folders := map[int64]*folder{}
+	for rows.Next() {
+		var (
+			f          folder
+			title      sql.NullString
+			parentID   sql.NullInt64
+			folderType sql.NullInt64
+		)
+		if err = rows.Scan(&f.id, &title, &parentID, &folderType); err != nil {
+			return nil, fmt.Errorf("read folder: %w", err)
+		}
+		f.title, f.parentID, f.trash = title.String, parentID.Int64, folderType.Int64 == folderTypeTrash
+		folders[f.id] = &f
+	}
+	return folders, rows.Err()
+}
+
+// listAttachments returns attachments by their identifiers, which are referenced from note bodies
+func (s *noteStore) listAttachments() (map[string]*attachment, error) {
+	mediaJoin := "NULL, NULL"
+	join := ""
+	if s.columns["ZMEDIA"] {
+		mediaJoin = fmt.Sprintf("%s, %s", s.column("m", identifierColumns), s.column("m", fileNameColumns))
+		join = fmt.Sprintf("LEFT JOIN %s m ON m.Z_PK = %s", objectTable, s.column("a", mediaColumns))
+	}
+	query := fmt.Sprintf(`SELECT %s, %s, %s FROM %s a %s WHERE %s IS NOT NULL AND %s IS NOT NULL`,
+		s.column("a", identifierColumns),
+		s.column("a", typeUTIColumns),
+		mediaJoin,
+		objectTable, join,
+		s.column("a", identifierColumns),
+		s.column("a", typeUTIColumns),
+	)
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("read attachments: %w", err)
+	}
+	defer rows.Close()
+	attachments := map[string]*attachment{}
+	for rows.Next() {
+		var id, typeUTI, mediaID, fileName sql.NullString
+		if err = rows.Scan(&id, &typeUTI, &mediaID, &fileName); err != nil {
+			return nil, fmt.Errorf("read attachment: %w", err)
+		}
+		attachments[id.String] = &attachment{typeUTI: typeUTI.String, mediaID: mediaID.String, fileName: fileName.String}
+	}
+	return attachments, rows.Err()
+}
+
+func toUnixTime(date sql.NullFloat64) int64 {
+	if !date.Valid || date.Float64 == 0 {
+		return 0
+	}
+	return int64(date.Float64) + coreDataEpoch
+}
+
+// attachmentResolver finds files of attachments in the media folders of accounts
+type attachmentResolver struct {
+	rootDir     string
+	attachments map[string]*attachment
+}
+
+func (r attachmentResolver) getBlock(info *attachmentInfo) *model.Block {
+	a, ok := r.attachments[info.id]
+	if !ok || a.mediaID == "" {
+		return nil
+	}
+	path := r.findMediaFile(a.mediaID, a.fileName)
+	if path == "" {
+		log.Warnf("file of attachment %s is not found", info.id)
+		return nil
+	}
+	typeUTI := a.typeUTI
+	if typeUTI == "" {
+		typeUTI = info.typeUTI
+	}
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfFile{
+			File: &model.BlockContentFile{
+				Name:  path,
+				State: model.BlockContentFile_Empty,
+				Type:  getFileType(typeUTI),
+			},
+		},
+	}
+}
+
+// findMediaFile looks for the file in Accounts/<account>/Media/<media id>, where it can be placed in a subdirectory
+func (r attachmentResolver) findMediaFile(mediaID, fileName string) string {
+	dirs, err := filepath.Glob(filepath.Join(r.rootDir, "Accounts", "*", "Media", mediaID))
+	if err != nil {
+		return ""
+	}
+	dirs = append(dirs, filepath.Join(r.rootDir, "Media", mediaID))
+	for _, dir := range dirs {
+		var found string
+		_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			if fileName == "" || d.Name() == fileName {
+				found = path
+				return fs.SkipAll
+			}
+			return nil
+		})
+		if found != "" {
+			return found
+		}
+	}
+	return ""
+}
+
+func getFileType(typeUTI string) model.BlockContentFileType {
+	switch typeUTI {
+	case "public.jpeg", "public.png", "public.heic", "public.tiff", "com.compuserve.gif", "public.image":
+		return model.BlockContentFile_Image
+	case "public.mpeg-4", "com.apple.quicktime-movie", "public.movie":
+		return model.BlockContentFile_Video
+	case "public.mp3", "com.apple.m4a-audio", "public.audio", "com.microsoft.waveform-audio":
+		return model.BlockContentFile_Audio
+	case "com.adobe.pdf":
+		return model.BlockContentFile_PDF
+	default:
+		return model.BlockContentFile_File
+	}
+}
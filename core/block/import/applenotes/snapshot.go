@@ -0,0 +1,161 @@
+package applenotes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// snapshotBuilder creates snapshots of notes and collections of folders, keeping hierarchy of folders
+type snapshotBuilder struct {
+	dbPath      string
+	objectType  string
+	service     *collection.Service
+	limits      converter.ParseLimits
+	folders     map[int64]*folder
+	attachments attachmentResolver
+
+	snapshots   []*converter.Snapshot
+	folderNotes map[int64][]string
+	looseNotes  []string
+}
+
+func newSnapshotBuilder(dbPath, objectType string,
+	service *collection.Service,
+	limits converter.ParseLimits,
+	folders map[int64]*folder,
+	attachments attachmentResolver,
+) *snapshotBuilder {
+	return &snapshotBuilder{
+		dbPath:      dbPath,
+		objectType:  objectType,
+		service:     service,
+		limits:      limits,
+		folders:     folders,
+		attachments: attachments,
+		folderNotes: map[int64][]string{},
+	}
+}
+
+func (b *snapshotBuilder) addNote(n *note) error {
+	f, inFolder := b.folders[n.folderID]
+	if inFolder && f.trash {
+		return nil
+	}
+	if n.protected {
+		log.Warnf("skip password protected note %d", n.id)
+		return nil
+	}
+	body, err := parseNoteBody(n.data, b.limits)
+	if err != nil {
+		return fmt.Errorf("failed to read note %s: %w", n.title, err)
+	}
+	paragraphs := splitParagraphs(body)
+	title := n.title
+	if len(paragraphs) > 0 {
+		// the first line of Apple note is its title, so it is not repeated in the text
+		firstLine := strings.TrimSpace(paragraphs[0].String())
+		if title == "" {
+			title = firstLine
+		}
+		if firstLine == title && len(paragraphs[0].attachments) == 0 {
+			paragraphs = paragraphs[1:]
+		}
+	}
+
+	sourcePath := fmt.Sprintf("%s/%d", b.dbPath, n.id)
+	details := converter.GetCommonDetails(sourcePath, title, "", model.ObjectType_basic)
+	if n.created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(n.created)
+	}
+	if n.modified != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(n.modified)
+	}
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: sourcePath,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:      getBlocks(paragraphs, b.attachments),
+			Details:     details,
+			ObjectTypes: []string{b.objectType},
+		}},
+	})
+	if inFolder {
+		b.folderNotes[n.folderID] = append(b.folderNotes[n.folderID], id)
+	} else {
+		b.looseNotes = append(b.looseNotes, id)
+	}
+	return nil
+}
+
+// build creates collections of folders and returns all snapshots and ids of objects,
+// that should be added to the root collection
+func (b *snapshotBuilder) build() ([]*converter.Snapshot, []string, error) {
+	children := map[int64][]*folder{}
+	var topFolders []*folder
+	for _, f := range b.folders {
+		if _, ok := b.folders[f.parentID]; ok && f.parentID != f.id {
+			children[f.parentID] = append(children[f.parentID], f)
+		} else {
+			topFolders = append(topFolders, f)
+		}
+	}
+	rootObjects := make([]string, 0, len(topFolders)+len(b.looseNotes))
+	for _, f := range sortFolders(topFolders) {
+		id, err := b.addFolder(f, children)
+		if err != nil {
+			return nil, nil, err
+		}
+		if id != "" {
+			rootObjects = append(rootObjects, id)
+		}
+	}
+	rootObjects = append(rootObjects, b.looseNotes...)
+	return b.snapshots, rootObjects, nil
+}
+
+// addFolder returns id of collection of the folder or empty string, if the folder doesn't contain any notes
+func (b *snapshotBuilder) addFolder(f *folder, children map[int64][]*folder) (string, error) {
+	if f.trash {
+		return "", nil
+	}
+	var objects []string
+	for _, child := range sortFolders(children[f.id]) {
+		id, err := b.addFolder(child, children)
+		if err != nil {
+			return "", err
+		}
+		if id != "" {
+			objects = append(objects, id)
+		}
+	}
+	objects = append(objects, b.folderNotes[f.id]...)
+	if len(objects) == 0 {
+		return "", nil
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(f.title, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for %s: %w", f.title, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+func sortFolders(folders []*folder) []*folder {
+	sort.Slice(folders, func(i, j int) bool {
+		return folders[i].id < folders[j].id
+	})
+	return folders
+}
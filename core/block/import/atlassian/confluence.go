@@ -0,0 +1,318 @@
+package atlassian
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// confluenceIndexFile contains the tree of pages of Confluence space export
+const confluenceIndexFile = "index.html"
+
+// confluenceSpace is a space from Confluence HTML export. Each page of the space is placed in a separate file
+// in the space directory
+type confluenceSpace struct {
+	name  string
+	dir   string
+	pages []*confluencePage
+}
+
+type confluencePage struct {
+	fileName string
+	title    string
+	children []*confluencePage
+	// content is set for pages of XML export, which are not stored in separate files
+	content *pageContent
+}
+
+// findConfluenceSpaces returns directories of spaces, which contain index file
+func findConfluenceSpaces(fileNames []string) []string {
+	var dirs []string
+	for _, fileName := range fileNames {
+		if strings.EqualFold(filepath.Base(fileName), confluenceIndexFile) {
+			dirs = append(dirs, filepath.Dir(fileName))
+		}
+	}
+	return dirs
+}
+
+// readConfluenceSpace reads the tree of pages from the index file. Pages of the space directory,
+// which are not listed in the index, are added as top level pages
+func readConfluenceSpace(importSource source.Source, dir string, fileNames []string) (*confluenceSpace, error) {
+	space := &confluenceSpace{dir: dir}
+	err := importSource.ProcessFile(filepath.Join(dir, confluenceIndexFile), func(fileReader io.ReadCloser) error {
+		doc, err := goquery.NewDocumentFromReader(fileReader)
+		if err != nil {
+			return err
+		}
+		space.name = strings.TrimSpace(doc.Find("title").First().Text())
+		space.pages = parsePageList(findPageTree(doc), dir)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read index of Confluence space: %w", err)
+	}
+	if space.name == "" {
+		space.name = filepath.Base(dir)
+	}
+	listed := map[string]struct{}{}
+	walkPages(space.pages, func(p *confluencePage) {
+		listed[p.fileName] = struct{}{}
+	})
+	for _, fileName := range fileNames {
+		if filepath.Dir(fileName) != dir || !strings.EqualFold(filepath.Ext(fileName), ".html") ||
+			strings.EqualFold(filepath.Base(fileName), confluenceIndexFile) {
+			continue
+		}
+		if _, ok := listed[fileName]; !ok {
+			space.pages = append(space.pages, &confluencePage{fileName: fileName})
+		}
+	}
+	return space, nil
+}
+
+// findPageTree returns the top level list with the most links, as index contains also short lists, like home page
+func findPageTree(doc *goquery.Document) *goquery.Selection {
+	var (
+		tree     *goquery.Selection
+		maxLinks int
+	)
+	doc.Find("ul").Each(func(_ int, list *goquery.Selection) {
+		if list.ParentsFiltered("ul").Length() > 0 {
+			return
+		}
+		if links := list.Find("a").Length(); links > maxLinks {
+			tree, maxLinks = list, links
+		}
+	})
+	return tree
+}
+
+func parsePageList(list *goquery.Selection, dir string) []*confluencePage {
+	if list == nil {
+		return nil
+	}
+	var pages []*confluencePage
+	list.ChildrenFiltered("li").Each(func(_ int, item *goquery.Selection) {
+		link := item.ChildrenFiltered("a").First()
+		href, _ := link.Attr("href")
+		if isExternalLink(href) || isAnchor(href) {
+			return
+		}
+		pages = append(pages, &confluencePage{
+			fileName: localPath(href, dir),
+			title:    strings.TrimSpace(link.Text()),
+			children: parsePageList(item.ChildrenFiltered("ul").First(), dir),
+		})
+	})
+	return pages
+}
+
+func walkPages(pages []*confluencePage, visit func(p *confluencePage)) {
+	for _, p := range pages {
+		visit(p)
+		walkPages(p.children, visit)
+	}
+}
+
+// addConfluenceSpace creates snapshots of pages and returns id of the space collection
+func (b *snapshotBuilder) addConfluenceSpace(space *confluenceSpace) (string, error) {
+	walkPages(space.pages, func(p *confluencePage) {
+		b.pageIDs[p.fileName] = uuid.New().String()
+	})
+	objects := make([]string, 0, len(space.pages))
+	for _, p := range space.pages {
+		id, err := b.addPage(p)
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(space.name, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for space %s: %w", space.name, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+// addPage returns id of the page object, or id of collection with the page and its children,
+// so the hierarchy of pages is preserved
+func (b *snapshotBuilder) addPage(p *confluencePage) (string, error) {
+	var blocks []*model.Block
+	content, err := b.readPage(p)
+	if err != nil {
+		b.allErrors.Add(converter.NewFileError(p.fileName, err))
+	} else if blocks, err = b.getBlocks(content.html, filepath.Dir(p.fileName)); err != nil {
+		b.allErrors.Add(converter.NewFileError(p.fileName, err))
+	}
+	if p.title == "" {
+		p.title = content.title
+	}
+	id := b.pageIDs[p.fileName]
+	details := converter.GetCommonDetails(p.fileName, p.title, "", model.ObjectType_basic)
+	var relationLinks []*model.RelationLink
+	if tagIDs := b.relations.OptionIDs(bundle.RelationKeyTag.String(), content.labels); len(tagIDs) != 0 {
+		details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: p.fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	})
+	if len(p.children) == 0 {
+		return id, nil
+	}
+	targets := make([]string, 0, len(p.children)+1)
+	targets = append(targets, id)
+	for _, child := range p.children {
+		childID, err := b.addPage(child)
+		if err != nil {
+			return "", err
+		}
+		targets = append(targets, childID)
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(p.title, targets)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for page %s: %w", p.title, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+type pageContent struct {
+	title  string
+	html   string
+	labels []string
+}
+
+func (b *snapshotBuilder) readPage(p *confluencePage) (pageContent, error) {
+	if p.content != nil {
+		return *p.content, nil
+	}
+	var content pageContent
+	err := b.importSource.ProcessFile(p.fileName, func(fileReader io.ReadCloser) error {
+		raw, err := b.limits.ReadAll(fileReader)
+		if err != nil {
+			return err
+		}
+		content, err = parsePage(raw)
+		return err
+	})
+	return content, err
+}
+
+// parsePage returns title, labels of Confluence page and HTML of its content with converted macros
+func parsePage(raw []byte) (pageContent, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(replaceCDATA(string(raw))))
+	if err != nil {
+		return pageContent{}, err
+	}
+	title := strings.TrimSpace(doc.Find("#title-text").First().Text())
+	if title == "" {
+		title = strings.TrimSpace(doc.Find("title").First().Text())
+	}
+	// title of exported page is prefixed with the name of space
+	if _, pageTitle, ok := strings.Cut(title, " : "); ok {
+		title = strings.TrimSpace(pageTitle)
+	}
+
+	main := doc.Find("#main-content").First()
+	if main.Length() == 0 {
+		main = doc.Find("body").First()
+	}
+	body, err := convertContent(main, listAttachments(doc, main))
+	if err != nil {
+		return pageContent{}, err
+	}
+	return pageContent{title: title, html: body, labels: listLabels(doc)}, nil
+}
+
+// convertContent returns HTML of content with converted macros. Attachments, which are not embedded
+// in the content, are added to the end of content as links
+func convertContent(content *goquery.Selection, attachments []*attachment) (string, error) {
+	convertMacros(content, attachments)
+	body, err := content.Html()
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	sb.WriteString(body)
+	for _, a := range attachments {
+		if !a.embedded {
+			fmt.Fprintf(&sb, `<p><a href="%s">%s</a></p>`, html.EscapeString(a.href), html.EscapeString(a.name))
+		}
+	}
+	return sb.String(), nil
+}
+
+// listLabels returns labels from the labels section of the page
+func listLabels(doc *goquery.Document) []string {
+	var labels []string
+	seen := map[string]struct{}{}
+	doc.Find(`ul.label-list a, a[rel="tag"]`).Each(func(_ int, s *goquery.Selection) {
+		label := strings.TrimSpace(s.Text())
+		if _, ok := seen[label]; ok || label == "" {
+			return
+		}
+		seen[label] = struct{}{}
+		labels = append(labels, label)
+	})
+	return labels
+}
+
+type attachment struct {
+	name     string
+	href     string
+	embedded bool
+}
+
+// listAttachments returns attachments from the attachments section of the page. Attachment is embedded,
+// when the content of page refers to it
+func listAttachments(doc *goquery.Document, main *goquery.Selection) []*attachment {
+	used := map[string]struct{}{}
+	main.Find("img[src], a[href]").Each(func(_ int, s *goquery.Selection) {
+		for _, attr := range []string{"src", "href"} {
+			if v, ok := s.Attr(attr); ok {
+				used[localPath(v, "")] = struct{}{}
+			}
+		}
+	})
+	var attachments []*attachment
+	doc.Find(`a[href^="attachments/"]`).Each(func(_ int, s *goquery.Selection) {
+		if s.Closest("#main-content").Length() > 0 {
+			return
+		}
+		href, _ := s.Attr("href")
+		_, embedded := used[localPath(href, "")]
+		attachments = append(attachments, &attachment{
+			name:     strings.TrimSpace(s.Text()),
+			href:     href,
+			embedded: embedded,
+		})
+	})
+	return attachments
+}
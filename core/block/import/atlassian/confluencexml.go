@@ -0,0 +1,208 @@
+package atlassian
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// confluenceEntitiesFile contains all objects of Confluence XML space export. Attachments are stored
+// in attachments/<page id>/<attachment id>/<version> files next to it
+const confluenceEntitiesFile = "entities.xml"
+
+const (
+	confluenceClassSpace      = "Space"
+	confluenceClassPage       = "Page"
+	confluenceClassBody       = "BodyContent"
+	confluenceClassLabel      = "Label"
+	confluenceClassLabelling  = "Labelling"
+	confluenceClassAttachment = "Attachment"
+	confluenceCurrentStatus   = "current"
+	defaultConfluenceSpace    = "Confluence"
+)
+
+// confluenceEntities is Hibernate dump of Confluence objects. Objects refer to each other by ids,
+// and historical versions of pages are stored as separate objects
+type confluenceEntities struct {
+	Objects []*confluenceObject `xml:"object"`
+}
+
+type confluenceObject struct {
+	Class      string                `xml:"class,attr"`
+	ID         string                `xml:"id"`
+	Properties []*confluenceProperty `xml:"property"`
+}
+
+// confluenceProperty is either value or reference to other object by id
+type confluenceProperty struct {
+	Name  string `xml:"name,attr"`
+	ID    string `xml:"id"`
+	Value string `xml:",chardata"`
+}
+
+func (o *confluenceObject) property(name string) *confluenceProperty {
+	for _, p := range o.Properties {
+		if p.Name == name {
+			return p
+		}
+	}
+	return &confluenceProperty{}
+}
+
+func (o *confluenceObject) value(name string) string {
+	return strings.TrimSpace(o.property(name).Value)
+}
+
+func (o *confluenceObject) ref(name string) string {
+	return strings.TrimSpace(o.property(name).ID)
+}
+
+// isCurrent returns false for historical versions and deleted content
+func (o *confluenceObject) isCurrent() bool {
+	status := o.value("contentStatus")
+	return (status == "" || status == confluenceCurrentStatus) && o.ref("originalVersion") == ""
+}
+
+type xmlPage struct {
+	object      *confluenceObject
+	page        *confluencePage
+	body        string
+	labels      []string
+	attachments []*attachment
+}
+
+// readConfluenceXMLSpace reads pages of XML space export with their hierarchy, labels and attachments
+func (b *snapshotBuilder) readConfluenceXMLSpace(fileName string) (*confluenceSpace, error) {
+	var entities confluenceEntities
+	err := b.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		return b.limits.DecodeXML(fileReader, &entities)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read Confluence XML export: %w", err)
+	}
+	dir := filepath.Dir(fileName)
+	space := &confluenceSpace{name: defaultConfluenceSpace, dir: dir}
+	pages := map[string]*xmlPage{}
+	labels := map[string]string{}
+	for _, o := range entities.Objects {
+		switch o.Class {
+		case confluenceClassSpace:
+			if name := o.value("name"); name != "" {
+				space.name = name
+			}
+		case confluenceClassPage:
+			if o.isCurrent() {
+				// pages are not separate files, so their source path is the id in the export directory
+				pages[o.ID] = &xmlPage{object: o, page: &confluencePage{
+					fileName: filepath.Join(dir, o.ID),
+					title:    o.value("title"),
+				}}
+			}
+		case confluenceClassLabel:
+			labels[o.ID] = o.value("name")
+		}
+	}
+	for _, o := range entities.Objects {
+		switch o.Class {
+		case confluenceClassBody:
+			if p, ok := pages[o.ref("content")]; ok {
+				p.body = o.value("body")
+			}
+		case confluenceClassLabelling:
+			if p, ok := pages[o.ref("content")]; ok && labels[o.ref("label")] != "" {
+				p.labels = append(p.labels, labels[o.ref("label")])
+			}
+		case confluenceClassAttachment:
+			b.addXMLAttachment(o, pages)
+		}
+	}
+
+	var topPages []*xmlPage
+	for _, p := range pages {
+		if parent, ok := pages[p.object.ref("parent")]; ok && parent != p {
+			continue
+		}
+		topPages = append(topPages, p)
+	}
+	for _, p := range pages {
+		if parent, ok := pages[p.object.ref("parent")]; ok && parent != p {
+			parent.page.children = append(parent.page.children, p.page)
+		}
+		content, err := xmlPageContent(p)
+		if err != nil {
+			b.allErrors.Add(fmt.Errorf("convert Confluence page %s: %w", p.page.title, err))
+		}
+		p.page.content = &content
+	}
+	sortXMLPages(topPages, pages)
+	for _, p := range topPages {
+		space.pages = append(space.pages, p.page)
+	}
+	return space, nil
+}
+
+func (b *snapshotBuilder) addXMLAttachment(o *confluenceObject, pages map[string]*xmlPage) {
+	if !o.isCurrent() {
+		return
+	}
+	pageID := o.ref("containerContent")
+	if pageID == "" {
+		pageID = o.ref("content")
+	}
+	p, ok := pages[pageID]
+	if !ok {
+		return
+	}
+	version := o.value("version")
+	if version == "" {
+		version = "1"
+	}
+	href := strings.Join([]string{"attachments", pageID, o.ID, version}, "/")
+	name := o.value("title")
+	b.attachmentNames[localPath(href, filepath.Dir(p.page.fileName))] = name
+	p.attachments = append(p.attachments, &attachment{name: name, href: href})
+}
+
+// xmlPageContent converts storage format of the page to HTML
+func xmlPageContent(p *xmlPage) (pageContent, error) {
+	content := pageContent{title: p.page.title, labels: p.labels}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(replaceCDATA(p.body)))
+	if err != nil {
+		return content, err
+	}
+	content.html, err = convertContent(doc.Find("body").First(), p.attachments)
+	return content, err
+}
+
+// sortXMLPages sorts pages and their children by position, which is set for pages ordered manually, and title
+func sortXMLPages(topPages []*xmlPage, pages map[string]*xmlPage) {
+	byPage := make(map[*confluencePage]*confluenceObject, len(pages))
+	for _, p := range pages {
+		byPage[p.page] = p.object
+	}
+	less := func(a, b *confluencePage) bool {
+		posA, errA := strconv.Atoi(byPage[a].value("position"))
+		posB, errB := strconv.Atoi(byPage[b].value("position"))
+		if errA == nil && errB == nil && posA != posB {
+			return posA < posB
+		}
+		if (errA == nil) != (errB == nil) {
+			return errA == nil
+		}
+		return a.title < b.title
+	}
+	sort.Slice(topPages, func(i, j int) bool {
+		return less(topPages[i].page, topPages[j].page)
+	})
+	for _, p := range pages {
+		children := p.page.children
+		sort.Slice(children, func(i, j int) bool {
+			return less(children[i], children[j])
+		})
+	}
+}
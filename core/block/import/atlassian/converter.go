@@ -0,0 +1,206 @@
+package atlassian
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Atlassian"
+	rootCollectionName = "Atlassian Import"
+)
+
+var log = logging.Logger("import-atlassian")
+
+// Atlassian imports Confluence HTML and XML space exports and Jira XML and CSV issue exports. Confluence pages keep their
+// hierarchy as nested collections and labels as tags, Jira issues become tasks
+type Atlassian struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &Atlassian{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		parseLimits:     converter.DefaultParseLimits,
+	}
+}
+
+func (a *Atlassian) Name() string {
+	return Name
+}
+
+func (a *Atlassian) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetAtlassianParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (a *Atlassian) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := a.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from Atlassian exports")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := a.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(a.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (a *Atlassian) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, a.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := a.handleImportPath(p, converter.ObjectTypeKey(req, defaultObjectType), converter.SourceFilter(req), limits, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of pages, tasks, collections and relations and list of objects,
+// that should be added to the root collection: collections of Confluence spaces and Jira exports
+func (a *Atlassian) handleImportPath(importPath, objectType string,
+	filter *source.Filter,
+	limits converter.ParseLimits,
+	pathsCount int,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	var fileNames []string
+	if err := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+		fileNames = append(fileNames, fileName)
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	sort.Strings(fileNames)
+
+	b := newSnapshotBuilder(importSource, objectType, a.service, a.tempDirProvider, limits, allErrors)
+	rootObjects := make([]string, 0)
+	for _, fileName := range fileNames {
+		var (
+			id  string
+			err error
+		)
+		switch ext := filepath.Ext(fileName); {
+		case strings.EqualFold(filepath.Base(fileName), confluenceEntitiesFile):
+			continue
+		case strings.EqualFold(ext, ".xml"):
+			id, err = b.addJiraExport(fileName)
+		case strings.EqualFold(ext, ".csv"):
+			id, err = b.addJiraCSVExport(fileName)
+		default:
+			continue
+		}
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Atlassian) {
+				return nil, nil
+			}
+		}
+		if id != "" {
+			rootObjects = append(rootObjects, id)
+		}
+	}
+	for _, dir := range findConfluenceSpaces(fileNames) {
+		space, err := readConfluenceSpace(importSource, dir, fileNames)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(filepath.Join(dir, confluenceIndexFile), err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Atlassian) {
+				return nil, nil
+			}
+			continue
+		}
+		id, err := b.addConfluenceSpace(space)
+		if err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Atlassian) {
+				return nil, nil
+			}
+			continue
+		}
+		rootObjects = append(rootObjects, id)
+	}
+	for _, fileName := range fileNames {
+		if !strings.EqualFold(filepath.Base(fileName), confluenceEntitiesFile) {
+			continue
+		}
+		space, err := b.readConfluenceXMLSpace(fileName)
+		if err == nil {
+			var id string
+			if id, err = b.addConfluenceSpace(space); err == nil {
+				rootObjects = append(rootObjects, id)
+				continue
+			}
+		}
+		allErrors.Add(converter.NewFileError(fileName, err))
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Atlassian) {
+			return nil, nil
+		}
+	}
+	if len(b.snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return b.snapshots, rootObjects
+}
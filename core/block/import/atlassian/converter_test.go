@@ -0,0 +1,276 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestAtlassian_GetSnapshots(t *testing.T) {
+	t.Run("Confluence space is converted to collection with hierarchy of pages", func(t *testing.T) {
+		// given
+		a := &Atlassian{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, "testdata/confluence"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		parent := findPage(sn.Snapshots, "Parent Page")
+		child := findPage(sn.Snapshots, "Child Page")
+		parentCollection := findCollection(sn.Snapshots, "Parent Page")
+		space := findCollection(sn.Snapshots, "Team Space")
+		root := findCollection(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{parent, child, parentCollection, space, root} {
+			require.NotNil(t, s)
+		}
+		assert.Len(t, sn.Snapshots, 5)
+		assert.Equal(t, []string{parent.Id, child.Id}, convertertest.CollectionObjects(parentCollection))
+		assert.Equal(t, []string{parentCollection.Id}, convertertest.CollectionObjects(space))
+		assert.Equal(t, []string{space.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+	})
+	t.Run("links to pages, macros and attachments are converted", func(t *testing.T) {
+		// given
+		a := &Atlassian{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, "testdata/confluence"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		parent := findPage(sn.Snapshots, "Parent Page")
+		child := findPage(sn.Snapshots, "Child Page")
+		require.NotNil(t, parent)
+		require.NotNil(t, child)
+
+		link := findBlock(parent, func(b *model.Block) bool {
+			return strings.HasPrefix(b.GetText().GetText(), "Welcome to the team space")
+		})
+		require.NotNil(t, link)
+		marks := link.GetText().GetMarks().GetMarks()
+		require.Len(t, marks, 1)
+		assert.Equal(t, model.BlockContentTextMark_Object, marks[0].Type)
+		assert.Equal(t, child.Id, marks[0].Param)
+
+		callout := findBlock(parent, func(b *model.Block) bool {
+			return b.GetText().GetStyle() == model.BlockContentText_Callout
+		})
+		require.NotNil(t, callout)
+		assert.Equal(t, "Read first", callout.GetText().GetText())
+		require.Len(t, callout.ChildrenIds, 1)
+		body := findBlock(parent, func(b *model.Block) bool {
+			return b.Id == callout.ChildrenIds[0]
+		})
+		require.NotNil(t, body)
+		assert.Equal(t, "Meeting notes are kept here.", body.GetText().GetText())
+
+		file := findBlock(child, func(b *model.Block) bool {
+			return b.GetFile() != nil
+		})
+		require.NotNil(t, file)
+		assert.True(t, strings.HasSuffix(file.GetFile().GetName(), filepath.Join("TS", "attachments", "65539", "65540.png")))
+	})
+	t.Run("Confluence XML export is converted with labels and attachments", func(t *testing.T) {
+		// given
+		a := &Atlassian{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, "testdata/confluencexml"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		home := findPage(sn.Snapshots, "Home")
+		first := findPage(sn.Snapshots, "First")
+		second := findPage(sn.Snapshots, "Second")
+		homeCollection := findCollection(sn.Snapshots, "Home")
+		space := findCollection(sn.Snapshots, "XML Space")
+		for _, s := range []*converter.Snapshot{home, first, second, homeCollection, space} {
+			require.NotNil(t, s)
+		}
+		assert.Nil(t, findPage(sn.Snapshots, "Removed"))
+		assert.Equal(t, []string{home.Id, first.Id, second.Id}, convertertest.CollectionObjects(homeCollection))
+		assert.Equal(t, []string{homeCollection.Id}, convertertest.CollectionObjects(space))
+		assert.Equal(t, []string{"onboarding"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(home.Snapshot.Data.Details, bundle.RelationKeyTag.String())))
+		assert.Contains(t, getTexts(home), "Welcome home")
+
+		file := findBlock(home, func(b *model.Block) bool {
+			return b.GetFile() != nil
+		})
+		require.NotNil(t, file)
+		assert.Equal(t, "plan.png", filepath.Base(file.GetFile().GetName()))
+		content, readErr := os.ReadFile(file.GetFile().GetName())
+		require.NoError(t, readErr)
+		assert.Equal(t, "plan", string(content))
+	})
+	t.Run("Jira issues are converted to tasks", func(t *testing.T) {
+		// given
+		a := &Atlassian{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, "testdata/jira"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		ci := findPage(sn.Snapshots, "Set up CI")
+		notes := findPage(sn.Snapshots, "Write release notes")
+		export := findCollection(sn.Snapshots, "Demo Jira")
+		issueKey := findRelation(sn.Snapshots, issueKeyRelationName)
+		assignee := findRelation(sn.Snapshots, assigneeRelationName)
+		for _, s := range []*converter.Snapshot{ci, notes, export, issueKey, assignee} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{ci.Id, notes.Id}, convertertest.CollectionObjects(export))
+		assert.Equal(t, []string{bundle.TypeKeyTask.String()}, ci.Snapshot.Data.ObjectTypes)
+
+		details := ci.Snapshot.Data.Details
+		assert.Equal(t, "DEMO-1", pbtypes.GetString(details, issueKey.Id))
+		assert.Equal(t, "Jane Doe", pbtypes.GetString(details, assignee.Id))
+		assert.False(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, time.Date(2023, time.October, 13, 0, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()))
+		assert.Equal(t, []string{"infra", "ci"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyTag.String())))
+		assert.Equal(t, []string{"In Progress"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyStatus.String())))
+		assert.Contains(t, getTexts(ci), "Configure pipelines for the project")
+
+		details = notes.Snapshot.Data.Details
+		assert.True(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Empty(t, pbtypes.GetString(details, assignee.Id))
+		assert.Equal(t, []string{"infra"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyTag.String())))
+	})
+	t.Run("Jira CSV export is converted to tasks", func(t *testing.T) {
+		// given
+		a := &Atlassian{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, "testdata/jiracsv"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		ci := findPage(sn.Snapshots, "Set up CI")
+		notes := findPage(sn.Snapshots, "Write release notes")
+		export := findCollection(sn.Snapshots, "Jira")
+		issueKey := findRelation(sn.Snapshots, issueKeyRelationName)
+		for _, s := range []*converter.Snapshot{ci, notes, export, issueKey} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{ci.Id, notes.Id}, convertertest.CollectionObjects(export))
+
+		details := ci.Snapshot.Data.Details
+		assert.Equal(t, "DEMO-1", pbtypes.GetString(details, issueKey.Id))
+		assert.False(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, time.Date(2023, time.October, 2, 10, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, time.Date(2023, time.October, 13, 0, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()))
+		assert.Equal(t, []string{"infra", "ci"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyTag.String())))
+		assert.Equal(t, []string{"In Progress"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyStatus.String())))
+		assert.Subset(t, getTexts(ci), []string{"Configure pipelines", "for the <project>"})
+
+		details = notes.Snapshot.Data.Details
+		assert.True(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, []string{"infra"}, getOptionNames(sn.Snapshots, pbtypes.GetStringList(details, bundle.RelationKeyTag.String())))
+	})
+	t.Run("directory without exports - return error", func(t *testing.T) {
+		// given
+		a := &Atlassian{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "page.html"), []byte("<p>page</p>"), 0666))
+
+		// when
+		sn, err := a.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Atlassian, dir), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Atlassian), converter.ErrNoObjectsToImport))
+	})
+}
+
+func TestParsePage(t *testing.T) {
+	// given
+	raw := `<html><body><div id="main-content"><p>Text</p></div>
+<ul class="label-list"><li class="aui-label"><a class="aui-label-split-main" rel="tag">design</a></li>
+<li class="aui-label"><a class="aui-label-split-main" rel="tag">draft</a></li></ul></body></html>`
+
+	// when
+	content, err := parsePage([]byte(raw))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"design", "draft"}, content.labels)
+	assert.Equal(t, "<p>Text</p>", content.html)
+}
+
+func getTexts(sn *converter.Snapshot) []string {
+	var texts []string
+	for _, block := range sn.Snapshot.Data.Blocks {
+		if text := block.GetText(); text != nil {
+			texts = append(texts, text.Text)
+		}
+	}
+	return texts
+}
+
+func getOptionNames(snapshots []*converter.Snapshot, ids []string) []string {
+	var names []string
+	for _, id := range ids {
+		for _, sn := range snapshots {
+			if sn.Id == id && sn.SbType == smartblock.SmartBlockTypeRelationOption {
+				names = append(names, pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
+
+func findBlock(sn *converter.Snapshot, filter func(b *model.Block) bool) *model.Block {
+	for _, block := range sn.Snapshot.Data.Blocks {
+		if filter(block) {
+			return block
+		}
+	}
+	return nil
+}
+
+func findPage(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.SbType == smartblock.SmartBlockTypePage && sn.Snapshot.Data.Collections == nil
+	})
+}
+
+func findCollection(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.Snapshot.Data.Collections != nil
+	})
+}
+
+func findRelation(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.SbType == smartblock.SmartBlockTypeRelation
+	})
+}
@@ -0,0 +1,203 @@
+package atlassian
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	issueKeyRelationName = "Issue key"
+	assigneeRelationName = "Assignee"
+	unassigned           = "Unassigned"
+	doneStatusCategory   = "done"
+)
+
+// jiraDateLayouts are formats of dates of XML export and of CSV export with default and ISO date settings
+var jiraDateLayouts = []string{
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"2/Jan/06 3:04 PM",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// jiraExport is the RSS document, which Jira produces on export of issues to XML
+type jiraExport struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel struct {
+		Title  string       `xml:"title"`
+		Issues []*jiraIssue `xml:"item"`
+	} `xml:"channel"`
+}
+
+type jiraIssue struct {
+	Key            string   `xml:"key"`
+	Summary        string   `xml:"summary"`
+	Description    string   `xml:"description"`
+	Status         string   `xml:"status"`
+	StatusCategory jiraAttr `xml:"statusCategory"`
+	Assignee       string   `xml:"assignee"`
+	Labels         []string `xml:"labels>label"`
+	Created        string   `xml:"created"`
+	Due            string   `xml:"due"`
+}
+
+type jiraAttr struct {
+	Key string `xml:"key,attr"`
+}
+
+// parseJiraExport returns nil, if the file is not a Jira export
+func parseJiraExport(r io.Reader, limits converter.ParseLimits) (*jiraExport, error) {
+	var export jiraExport
+	if err := limits.DecodeXML(r, &export); err != nil {
+		if _, ok := err.(xml.UnmarshalError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var issues []*jiraIssue
+	for _, issue := range export.Channel.Issues {
+		if issue.Key != "" {
+			issues = append(issues, issue)
+		}
+	}
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	export.Channel.Issues = issues
+	return &export, nil
+}
+
+// addJiraExport creates tasks from issues of the file and returns id of their collection,
+// or empty string, if the file is not a Jira export
+func (b *snapshotBuilder) addJiraExport(fileName string) (string, error) {
+	var export *jiraExport
+	err := b.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		var err error
+		export, err = parseJiraExport(fileReader, b.limits)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read Jira export: %w", err)
+	}
+	if export == nil {
+		log.Warnf("file %s is not a Jira export", filepath.Base(fileName))
+		return "", nil
+	}
+	return b.addIssues(fileName, export.Channel.Title, export.Channel.Issues)
+}
+
+// addIssues creates tasks from issues and returns id of their collection. Collection is named after the file,
+// if name is empty
+func (b *snapshotBuilder) addIssues(fileName, name string, issues []*jiraIssue) (string, error) {
+	objects := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		objects = append(objects, b.addIssue(fileName, issue))
+	}
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(name, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for Jira export: %w", err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+// addIssue creates task with status, assignee and labels of the issue. Status is shared between tasks
+// as relation option, labels become tags
+func (b *snapshotBuilder) addIssue(fileName string, issue *jiraIssue) string {
+	blocks, err := b.getBlocks(issue.Description, "")
+	if err != nil {
+		b.allErrors.Add(converter.NewFileError(fileName, fmt.Errorf("failed to convert description of %s: %w", issue.Key, err)))
+	}
+	sourcePath := fileName + "/" + issue.Key
+	title := issue.Summary
+	if title == "" {
+		title = issue.Key
+	}
+	details := converter.GetCommonDetails(sourcePath, title, "", model.ObjectType_todo)
+	details.Fields[bundle.RelationKeyDone.String()] = pbtypes.Bool(issue.StatusCategory.Key == doneStatusCategory)
+	relationLinks := []*model.RelationLink{{
+		Key:    bundle.RelationKeyDone.String(),
+		Format: model.RelationFormat_checkbox,
+	}}
+
+	keyRelation := b.relations.Relation(issueKeyRelationName, model.RelationFormat_shorttext)
+	details.Fields[keyRelation.Key] = pbtypes.String(issue.Key)
+	relationLinks = append(relationLinks, keyRelation)
+	if status := strings.TrimSpace(issue.Status); status != "" {
+		if id := b.relations.OptionID(bundle.RelationKeyStatus.String(), status); id != "" {
+			details.Fields[bundle.RelationKeyStatus.String()] = pbtypes.StringList([]string{id})
+			relationLinks = append(relationLinks, &model.RelationLink{
+				Key:    bundle.RelationKeyStatus.String(),
+				Format: model.RelationFormat_status,
+			})
+		}
+	}
+	if assignee := strings.TrimSpace(issue.Assignee); assignee != "" && assignee != unassigned {
+		relation := b.relations.Relation(assigneeRelationName, model.RelationFormat_shorttext)
+		details.Fields[relation.Key] = pbtypes.String(assignee)
+		relationLinks = append(relationLinks, relation)
+	}
+	if tagIDs := b.relations.OptionIDs(bundle.RelationKeyTag.String(), issue.Labels); len(tagIDs) != 0 {
+		details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	if created := parseJiraDate(issue.Created); created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if due := parseJiraDate(issue.Due); due != 0 {
+		details.Fields[bundle.RelationKeyDueDate.String()] = pbtypes.Int64(due)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyDueDate.String(),
+			Format: model.RelationFormat_date,
+		})
+	}
+
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{bundle.TypeKeyTask.String()},
+		}},
+	})
+	return id
+}
+
+// parseJiraDate returns unix time of date in RSS format, e.g. Mon, 2 Oct 2023 10:00:00 +0000,
+// or in one of formats of CSV export
+func parseJiraDate(value string) int64 {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	for _, layout := range jiraDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Unix()
+		}
+	}
+	log.Debugf("unsupported date format: %s", value)
+	return 0
+}
@@ -0,0 +1,125 @@
+package atlassian
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Columns of Jira CSV export. Multi-value fields, like labels, are exported as several columns with the same name
+const (
+	jiraCSVKey            = "Issue key"
+	jiraCSVSummary        = "Summary"
+	jiraCSVDescription    = "Description"
+	jiraCSVStatus         = "Status"
+	jiraCSVStatusCategory = "Status Category"
+	jiraCSVAssignee       = "Assignee"
+	jiraCSVLabels         = "Labels"
+	jiraCSVCreated        = "Created"
+	jiraCSVDue            = "Due date"
+)
+
+// parseJiraCSV returns nil, if the file is not a Jira export
+func parseJiraCSV(data []byte) ([]*jiraIssue, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string][]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		columns[name] = append(columns[name], i)
+	}
+	if len(columns[strings.ToLower(jiraCSVKey)]) == 0 || len(columns[strings.ToLower(jiraCSVSummary)]) == 0 {
+		return nil, nil
+	}
+	var issues []*jiraIssue
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		values := func(column string) []string {
+			var result []string
+			for _, i := range columns[strings.ToLower(column)] {
+				if i < len(record) && strings.TrimSpace(record[i]) != "" {
+					result = append(result, strings.TrimSpace(record[i]))
+				}
+			}
+			return result
+		}
+		value := func(column string) string {
+			if v := values(column); len(v) != 0 {
+				return v[0]
+			}
+			return ""
+		}
+		issue := &jiraIssue{
+			Key:         value(jiraCSVKey),
+			Summary:     value(jiraCSVSummary),
+			Description: textToHTML(value(jiraCSVDescription)),
+			Status:      value(jiraCSVStatus),
+			Assignee:    value(jiraCSVAssignee),
+			Labels:      values(jiraCSVLabels),
+			Created:     value(jiraCSVCreated),
+			Due:         value(jiraCSVDue),
+		}
+		// CSV contains name of status category, while XML contains its key
+		if strings.EqualFold(value(jiraCSVStatusCategory), doneStatusCategory) {
+			issue.StatusCategory.Key = doneStatusCategory
+		}
+		if issue.Key != "" {
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// textToHTML converts description in plain text to HTML paragraphs, as CSV export doesn't render wiki markup
+func textToHTML(text string) string {
+	if text == "" {
+		return ""
+	}
+	var sb strings.Builder
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			sb.WriteString("<p>" + html.EscapeString(line) + "</p>")
+		}
+	}
+	return sb.String()
+}
+
+// addJiraCSVExport creates tasks from issues of CSV file and returns id of their collection,
+// or empty string, if the file is not a Jira export
+func (b *snapshotBuilder) addJiraCSVExport(fileName string) (string, error) {
+	var issues []*jiraIssue
+	err := b.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		data, err := b.limits.ReadAll(fileReader)
+		if err != nil {
+			return err
+		}
+		issues, err = parseJiraCSV(data)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to read Jira CSV export: %w", err)
+	}
+	if len(issues) == 0 {
+		log.Warnf("file %s is not a Jira export", filepath.Base(fileName))
+		return "", nil
+	}
+	return b.addIssues(fileName, "", issues)
+}
@@ -0,0 +1,216 @@
+package atlassian
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// cdataRegexp matches CDATA sections of storage format, which HTML parser treats as comments
+var cdataRegexp = regexp.MustCompile(`(?s)<!\[CDATA\[(.*?)\]\]>`)
+
+// calloutMacros maps Confluence macros to types of Markdown callouts
+var calloutMacros = map[string]string{
+	"info":        "INFO",
+	"information": "INFO",
+	"note":        "NOTE",
+	"tip":         "TIP",
+	"warning":     "WARNING",
+	"panel":       "NOTE",
+}
+
+func replaceCDATA(raw string) string {
+	return cdataRegexp.ReplaceAllStringFunc(raw, func(section string) string {
+		return html.EscapeString(cdataRegexp.FindStringSubmatch(section)[1])
+	})
+}
+
+// convertMacros replaces macros with HTML, which can be converted to blocks. Rendered macros of HTML export
+// and macros of storage format are supported. Unknown macros are replaced with their content
+func convertMacros(content *goquery.Selection, attachments []*attachment) {
+	// nested macros go after their parents in document order, so they are converted first
+	macros := content.Find("*").FilterFunction(func(_ int, s *goquery.Selection) bool {
+		return isMacro(s)
+	})
+	for i := macros.Length() - 1; i >= 0; i-- {
+		convertMacro(macros.Eq(i), attachments)
+	}
+}
+
+func isMacro(s *goquery.Selection) bool {
+	switch goquery.NodeName(s) {
+	case "ac:structured-macro", "ac:image", "ac:link", "ac:task-list", "ac:emoticon":
+		return true
+	}
+	return s.HasClass("confluence-information-macro") || s.HasClass("expand-container") ||
+		(s.HasClass("panel") && !s.HasClass("code"))
+}
+
+func convertMacro(s *goquery.Selection, attachments []*attachment) {
+	switch goquery.NodeName(s) {
+	case "ac:structured-macro":
+		convertStructuredMacro(s)
+	case "ac:image":
+		convertImage(s, attachments)
+	case "ac:link":
+		s.ReplaceWithHtml(html.EscapeString(linkText(s)))
+	case "ac:task-list":
+		convertTaskList(s)
+	case "ac:emoticon":
+		s.Remove()
+	default:
+		convertRenderedMacro(s)
+	}
+}
+
+// convertRenderedMacro converts information macros and panels of HTML export to callouts and expands to paragraphs
+func convertRenderedMacro(s *goquery.Selection) {
+	switch {
+	case s.HasClass("confluence-information-macro"):
+		macroType := "info"
+		for name := range calloutMacros {
+			if s.HasClass("confluence-information-macro-" + name) {
+				macroType = name
+			}
+		}
+		title := s.ChildrenFiltered(".title").First().Text()
+		body, _ := s.Find(".confluence-information-macro-body").First().Html()
+		s.ReplaceWithHtml(calloutHTML(macroType, title, body))
+	case s.HasClass("expand-container"):
+		title := s.Find(".expand-control-text").First().Text()
+		body, _ := s.Find(".expand-content").First().Html()
+		s.ReplaceWithHtml(fmt.Sprintf("<p><strong>%s</strong></p>%s", html.EscapeString(strings.TrimSpace(title)), body))
+	default:
+		title := s.Find(".panelHeader").First().Text()
+		body, _ := s.Find(".panelContent").First().Html()
+		s.ReplaceWithHtml(calloutHTML("panel", title, body))
+	}
+}
+
+func convertStructuredMacro(s *goquery.Selection) {
+	name, _ := s.Attr("ac:name")
+	switch name {
+	case "code", "noformat":
+		code := s.ChildrenFiltered("ac\\:plain-text-body").First().Text()
+		s.ReplaceWithHtml("<pre><code>" + html.EscapeString(code) + "</code></pre>")
+		return
+	}
+	body, _ := s.ChildrenFiltered("ac\\:rich-text-body").First().Html()
+	if _, ok := calloutMacros[name]; ok {
+		s.ReplaceWithHtml(calloutHTML(name, macroParameter(s, "title"), body))
+		return
+	}
+	if name == "expand" {
+		body = fmt.Sprintf("<p><strong>%s</strong></p>%s", html.EscapeString(macroParameter(s, "title")), body)
+	}
+	if strings.TrimSpace(body) == "" {
+		log.Debugf("skip Confluence macro %s without content", name)
+	}
+	s.ReplaceWithHtml(body)
+}
+
+// calloutHTML returns quote, which starts with the type of callout, so it is converted to callout block.
+// Quote is converted to one block, so paragraphs of the body are joined with line breaks
+func calloutHTML(macroType, title, body string) string {
+	return fmt.Sprintf("<blockquote><p>[!%s] %s<br>%s</p></blockquote>",
+		calloutMacros[macroType], html.EscapeString(strings.TrimSpace(title)), joinParagraphs(body))
+}
+
+func joinParagraphs(body string) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
+	if err != nil {
+		return body
+	}
+	var lines []string
+	addLine := func(s *goquery.Selection, inner bool) {
+		var (
+			line string
+			err  error
+		)
+		if inner {
+			line, err = s.Html()
+		} else {
+			line, err = goquery.OuterHtml(s)
+		}
+		if err == nil && strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	doc.Find("body").Contents().Each(func(_ int, s *goquery.Selection) {
+		switch goquery.NodeName(s) {
+		case "p", "div", "h1", "h2", "h3", "h4", "h5", "h6", "pre", "blockquote":
+			addLine(s, true)
+		case "ul", "ol":
+			s.ChildrenFiltered("li").Each(func(_ int, item *goquery.Selection) {
+				addLine(item, true)
+			})
+		default:
+			addLine(s, false)
+		}
+	})
+	return strings.Join(lines, "<br>")
+}
+
+func macroParameter(s *goquery.Selection, name string) string {
+	var value string
+	s.ChildrenFiltered("ac\\:parameter").EachWithBreak(func(_ int, param *goquery.Selection) bool {
+		if paramName, _ := param.Attr("ac:name"); paramName == name {
+			value = strings.TrimSpace(param.Text())
+			return false
+		}
+		return true
+	})
+	return value
+}
+
+// convertImage replaces image with img tag. Attachments are referenced by file name in storage format,
+// so they are resolved with the list of page attachments
+func convertImage(s *goquery.Selection, attachments []*attachment) {
+	var src string
+	if u, ok := s.Find("ri\\:url").Attr("ri:value"); ok {
+		src = u
+	} else if fileName, ok := s.Find("ri\\:attachment").Attr("ri:filename"); ok {
+		src = fileName
+		for _, a := range attachments {
+			if a.name == fileName {
+				src = a.href
+				a.embedded = true
+			}
+		}
+	}
+	if src == "" {
+		s.Remove()
+		return
+	}
+	s.ReplaceWithHtml(fmt.Sprintf(`<img src="%s">`, html.EscapeString(src)))
+}
+
+func linkText(s *goquery.Selection) string {
+	if text := strings.TrimSpace(s.ChildrenFiltered("ac\\:plain-text-link-body").Text()); text != "" {
+		return text
+	}
+	if text := strings.TrimSpace(s.ChildrenFiltered("ac\\:link-body").Text()); text != "" {
+		return text
+	}
+	title, _ := s.Find("ri\\:page").Attr("ri:content-title")
+	return title
+}
+
+// convertTaskList replaces tasks with list items, which are converted to checkboxes
+func convertTaskList(s *goquery.Selection) {
+	var sb strings.Builder
+	sb.WriteString("<ul>")
+	s.ChildrenFiltered("ac\\:task").Each(func(_ int, task *goquery.Selection) {
+		checkbox := "[ ]"
+		if strings.TrimSpace(task.ChildrenFiltered("ac\\:task-status").Text()) == "complete" {
+			checkbox = "[x]"
+		}
+		body, _ := task.ChildrenFiltered("ac\\:task-body").Html()
+		fmt.Fprintf(&sb, "<li>%s %s</li>", checkbox, body)
+	})
+	sb.WriteString("</ul>")
+	s.ReplaceWithHtml(sb.String())
+}
@@ -0,0 +1,174 @@
+package atlassian
+
+import (
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// snapshotBuilder creates snapshots of Confluence pages and Jira issues of one import path.
+// Relations and their options, like tags and statuses, are shared between all objects of the import path
+type snapshotBuilder struct {
+	importSource    source.Source
+	objectType      string
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	limits          converter.ParseLimits
+	allErrors       *converter.ConvertError
+
+	snapshots []*converter.Snapshot
+	// pageIDs maps file name of Confluence page to its object id, so links between pages are resolved
+	pageIDs   map[string]string
+	relations *converter.RelationCreator
+	// attachmentNames maps file of attachment of Confluence XML export to its name,
+	// as attachments are stored in files named by their versions
+	attachmentNames map[string]string
+}
+
+func newSnapshotBuilder(importSource source.Source,
+	objectType string,
+	service *collection.Service,
+	tempDirProvider core.TempDirProvider,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) *snapshotBuilder {
+	b := &snapshotBuilder{
+		importSource:    importSource,
+		objectType:      objectType,
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		limits:          limits,
+		allErrors:       allErrors,
+		pageIDs:         map[string]string{},
+		attachmentNames: map[string]string{},
+	}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// getBlocks converts HTML to blocks. Links and files are relative to dir: links to imported pages
+// are replaced with links to objects and attachments are imported as files
+func (b *snapshotBuilder) getBlocks(html, dir string) ([]*model.Block, error) {
+	blocks, _, err := anymark.HTMLToBlocks([]byte(html))
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		if file := block.GetFile(); file != nil {
+			file.Name = b.provideFileName(file.Name, dir)
+		}
+		if marks := block.GetText().GetMarks().GetMarks(); len(marks) > 0 {
+			b.updateLinks(block, dir)
+		}
+	}
+	return blocks, nil
+}
+
+func (b *snapshotBuilder) updateLinks(block *model.Block, dir string) {
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link || isExternalLink(mark.Param) || isAnchor(mark.Param) {
+			continue
+		}
+		if id, ok := b.pageIDs[localPath(mark.Param, dir)]; ok {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = id
+			continue
+		}
+		if fileName, ok := b.provideAttachment(localPath(mark.Param, dir)); ok {
+			mark.Param = fileName
+			anymark.ConvertTextToFile(block)
+			return
+		}
+		newFileName, createFileBlock, err := converter.ProvideFileName(localPath(mark.Param, dir), b.importSource, "", b.tempDirProvider)
+		if err != nil {
+			log.Errorf("failed to update link with new file name: %v", oserror.TransformError(err))
+			continue
+		}
+		if createFileBlock {
+			mark.Param = newFileName
+			anymark.ConvertTextToFile(block)
+			return
+		}
+	}
+}
+
+func (b *snapshotBuilder) provideFileName(fileName, dir string) string {
+	if isExternalLink(fileName) {
+		return fileName
+	}
+	if attachment, ok := b.provideAttachment(localPath(fileName, dir)); ok {
+		return attachment
+	}
+	newFileName, _, err := converter.ProvideFileName(localPath(fileName, dir), b.importSource, "", b.tempDirProvider)
+	if err != nil {
+		log.Errorf("failed to update file block with new file name: %v", oserror.TransformError(err))
+		return fileName
+	}
+	return newFileName
+}
+
+// provideAttachment extracts attachment of Confluence XML export to the temporary directory with its original name
+func (b *snapshotBuilder) provideAttachment(fileName string) (string, bool) {
+	name, ok := b.attachmentNames[fileName]
+	if !ok {
+		return "", false
+	}
+	dir := filepath.Join(b.tempDirProvider.TempDir(), filepath.Dir(fileName))
+	target := filepath.Join(dir, filepath.Base(name))
+	err := b.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			return err
+		}
+		f, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(f, fileReader)
+		return err
+	})
+	if err != nil {
+		log.Errorf("failed to extract attachment: %v", oserror.TransformError(err))
+		return "", false
+	}
+	return target, true
+}
+
+func isExternalLink(link string) bool {
+	u, err := url.Parse(link)
+	return err != nil || u.Scheme != ""
+}
+
+func isAnchor(link string) bool {
+	return link == "" || strings.HasPrefix(link, "#") || strings.HasPrefix(link, "?")
+}
+
+// localPath returns the name of file in import source for relative link without query and fragment,
+// e.g. attachments/1/2.png?version=1
+func localPath(link, dir string) string {
+	if i := strings.IndexAny(link, "?#"); i >= 0 {
+		link = link[:i]
+	}
+	if unescaped, err := url.PathUnescape(link); err == nil {
+		link = unescaped
+	}
+	return filepath.Join(dir, filepath.FromSlash(link))
+}
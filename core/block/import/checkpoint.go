@@ -0,0 +1,249 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const checkpointKeyPrefix = "/importcheckpoint/"
+
+// checkpointStore persists request of import run and objects, which are already created by it,
+// so the import interrupted by crash, cancel or error can be resumed without creating these objects again
+type checkpointStore struct {
+	db *badger.DB
+}
+
+func newCheckpointStore(db *badger.DB) *checkpointStore {
+	return &checkpointStore{db: db}
+}
+
+func requestKey(importRunID string) []byte {
+	return []byte(checkpointKeyPrefix + importRunID + "/request")
+}
+
+func objectsPrefix(importRunID string) []byte {
+	return []byte(checkpointKeyPrefix + importRunID + "/object/")
+}
+
+func (s *checkpointStore) saveRequest(importRunID string, req *pb.RpcObjectImportRequest) error {
+	return badgerhelper.SetValue(s.db, requestKey(importRunID), req)
+}
+
+func (s *checkpointStore) getRequest(importRunID string) (*pb.RpcObjectImportRequest, error) {
+	return badgerhelper.GetValue(s.db, requestKey(importRunID), func(raw []byte) (*pb.RpcObjectImportRequest, error) {
+		req := &pb.RpcObjectImportRequest{}
+		return req, req.Unmarshal(raw)
+	})
+}
+
+// saveObject stores id of object created from snapshot with given checkpoint key
+func (s *checkpointStore) saveObject(importRunID, key, id string) error {
+	return badgerhelper.RetryOnConflict(func() error {
+		return badgerhelper.SetValue(s.db, append(objectsPrefix(importRunID), key...), id)
+	})
+}
+
+// getObjects returns ids of objects created by import run by their checkpoint keys
+func (s *checkpointStore) getObjects(importRunID string) (map[string]string, error) {
+	prefix := objectsPrefix(importRunID)
+	objects := make(map[string]string)
+	err := s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: prefix, PrefetchValues: true})
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			objects[string(item.Key()[len(prefix):])] = string(value)
+		}
+		return nil
+	})
+	return objects, err
+}
+
+// remove deletes checkpoint of import run
+func (s *checkpointStore) remove(importRunID string) error {
+	prefix := []byte(checkpointKeyPrefix + importRunID + "/")
+	return badgerhelper.RetryOnConflict(func() error {
+		return s.db.Update(func(txn *badger.Txn) error {
+			iter := txn.NewIterator(badger.IteratorOptions{Prefix: prefix})
+			var keys [][]byte
+			for iter.Rewind(); iter.Valid(); iter.Next() {
+				keys = append(keys, iter.Item().KeyCopy(nil))
+			}
+			iter.Close()
+			for _, key := range keys {
+				if err := txn.Delete(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// isCheckpointed returns true for objects, which are tracked by checkpoint. Relations, types and options are
+// derived objects, so their ids are the same on the next run anyway
+func isCheckpointed(sn *converter.Snapshot) bool {
+	return sn.SbType == smartblock.SmartBlockTypePage || sn.SbType == smartblock.SmartBlockTypeTemplate
+}
+
+// ResumeImport continues import run, which was interrupted by crash, cancel or error. Converter processes
+// source files again, but objects, which were already created by the run, are not created again
+func (i *Import) ResumeImport(ctx context.Context, importRunID string, origin model.ObjectOrigin) (*ImportResponse, error) {
+	if importRunID == "" {
+		return nil, fmt.Errorf("import run id is empty")
+	}
+	if i.checkpoints == nil {
+		return nil, ErrNoCheckpoint
+	}
+	req, err := i.checkpoints.getRequest(importRunID)
+	if badgerhelper.IsNotFound(err) {
+		return nil, ErrNoCheckpoint
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get checkpoint: %w", err)
+	}
+	// password isn't stored in checkpoint, so the archive can't be decrypted again
+	if req.Password == "" && hasEncryptedArchive(req) {
+		return nil, fmt.Errorf("%w: import of encrypted archive can't be resumed, import the archive again with password",
+			source.ErrPasswordRequired)
+	}
+	return i.runImport(ctx, req, origin, importRunID)
+}
+
+// saveCheckpointRequest stores the request of import run, so it can be resumed. The request is stored before
+// its paths are replaced with temporary files, so resume reads the same sources
+func (i *Import) saveCheckpointRequest(importRunID string, req *pb.RpcObjectImportRequest) {
+	if i.checkpoints == nil || importRunID == "" {
+		return
+	}
+	// password of archive isn't kept in local storage
+	stored := proto.Clone(req).(*pb.RpcObjectImportRequest)
+	stored.Password = ""
+	if err := i.checkpoints.saveRequest(importRunID, stored); err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to save import checkpoint: %s", err)
+	}
+}
+
+// removeEmptyCheckpoint removes checkpoint of import run, which has failed before any object was created,
+// so there is nothing to resume
+func (i *Import) removeEmptyCheckpoint(importRunID string) {
+	if i.checkpoints == nil || importRunID == "" {
+		return
+	}
+	created, err := i.checkpoints.getObjects(importRunID)
+	if err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to get import checkpoint: %s", err)
+		return
+	}
+	if len(created) == 0 {
+		i.removeCheckpoint(importRunID)
+	}
+}
+
+// resolveCheckpoint replaces ids of snapshots, which objects were created by previous attempt of the import run,
+// with ids of these objects and removes them from the response. Collections are still updated, because
+// they contain objects, which are created after resume
+func (i *Import) resolveCheckpoint(
+	res *converter.Response,
+	importRunID string,
+	keys map[string]string,
+	oldIDToNew map[string]string,
+	createPayloads map[string]treestorage.TreeStorageCreatePayload,
+) {
+	if i.checkpoints == nil || importRunID == "" {
+		return
+	}
+	created, err := i.checkpoints.getObjects(importRunID)
+	if err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to get import checkpoint: %s", err)
+		return
+	}
+	if len(created) == 0 {
+		return
+	}
+	snapshots := make([]*converter.Snapshot, 0, len(res.Snapshots))
+	for _, sn := range res.Snapshots {
+		key, tracked := keys[sn.Id]
+		id, ok := created[key]
+		if !tracked || !ok {
+			snapshots = append(snapshots, sn)
+			continue
+		}
+		delete(createPayloads, oldIDToNew[sn.Id])
+		oldIDToNew[sn.Id] = id
+		if sn.Snapshot.GetData().GetCollections() != nil {
+			snapshots = append(snapshots, sn)
+		}
+	}
+	res.Snapshots = snapshots
+}
+
+// checkpointKeys returns checkpoint keys of snapshots, which are tracked by checkpoint, by ids of snapshots.
+// Key identifies snapshot across runs of converter: ids of snapshots are generated by some converters on each run,
+// so source path is used, if it is set. Several snapshots can share the source path, e.g. notes of one export file,
+// so keys of these snapshots also contain the index of snapshot among snapshots of the file
+func checkpointKeys(res *converter.Response) map[string]string {
+	sourceCount := make(map[string]int)
+	for _, sn := range res.Snapshots {
+		if isCheckpointed(sn) {
+			sourceCount[sourcePath(sn)]++
+		}
+	}
+	keys := make(map[string]string, len(res.Snapshots))
+	sourceIndex := make(map[string]int)
+	for _, sn := range res.Snapshots {
+		if !isCheckpointed(sn) {
+			continue
+		}
+		source := sourcePath(sn)
+		switch {
+		case source == "":
+			keys[sn.Id] = sn.Id
+		case sourceCount[source] == 1:
+			keys[sn.Id] = source
+		default:
+			keys[sn.Id] = fmt.Sprintf("%s#%d", source, sourceIndex[source])
+			sourceIndex[source]++
+		}
+	}
+	return keys
+}
+
+func sourcePath(sn *converter.Snapshot) string {
+	return pbtypes.GetString(sn.Snapshot.GetData().GetDetails(), bundle.RelationKeySourceFilePath.String())
+}
+
+func (i *Import) saveCheckpointObject(importRunID, key, id string) {
+	if i.checkpoints == nil || importRunID == "" || key == "" || id == "" {
+		return
+	}
+	if err := i.checkpoints.saveObject(importRunID, key, id); err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to save object to import checkpoint: %s", err)
+	}
+}
+
+func (i *Import) removeCheckpoint(importRunID string) {
+	if i.checkpoints == nil || importRunID == "" {
+		return
+	}
+	if err := i.checkpoints.remove(importRunID); err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to remove import checkpoint: %s", err)
+	}
+}
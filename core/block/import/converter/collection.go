@@ -25,7 +25,16 @@ func NewRootCollection(service *collection.Service) *RootCollection {
 }
 
 func (r *RootCollection) MakeRootCollection(collectionName string, targetObjects []string) (*Snapshot, error) {
-	detailsStruct := r.getCreateCollectionRequest(collectionName)
+	return r.makeCollection(collectionName, targetObjects, true)
+}
+
+// MakeCollection creates nested collection, which is not added to favorites, unlike root collection
+func (r *RootCollection) MakeCollection(collectionName string, targetObjects []string) (*Snapshot, error) {
+	return r.makeCollection(collectionName, targetObjects, false)
+}
+
+func (r *RootCollection) makeCollection(collectionName string, targetObjects []string, isFavorite bool) (*Snapshot, error) {
+	detailsStruct := r.getCreateCollectionRequest(collectionName, isFavorite)
 	_, _, st, err := r.service.CreateCollection(detailsStruct, []*model.InternalFlag{{
 		Value: model.InternalFlag_collectionDontIndexLinks,
 	}})
@@ -84,11 +93,11 @@ func (r *RootCollection) addRelations(st *state.State) error {
 	return nil
 }
 
-func (r *RootCollection) getCreateCollectionRequest(collectionName string) *types.Struct {
+func (r *RootCollection) getCreateCollectionRequest(collectionName string, isFavorite bool) *types.Struct {
 	details := make(map[string]*types.Value, 0)
 	details[bundle.RelationKeySourceFilePath.String()] = pbtypes.String(collectionName)
 	details[bundle.RelationKeyName.String()] = pbtypes.String(collectionName)
-	details[bundle.RelationKeyIsFavorite.String()] = pbtypes.Bool(true)
+	details[bundle.RelationKeyIsFavorite.String()] = pbtypes.Bool(isFavorite)
 	details[bundle.RelationKeyLayout.String()] = pbtypes.Float64(float64(model.ObjectType_collection))
 
 	detailsStruct := &types.Struct{Fields: details}
@@ -3,6 +3,7 @@ package converter
 import (
 	"bytes"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 	"time"
@@ -13,11 +14,14 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/widget"
 	"github.com/anyproto/anytype-heart/core/block/import/converter/filetime"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/bookmark"
 	"github.com/anyproto/anytype-heart/core/block/simple/dataview"
 	"github.com/anyproto/anytype-heart/core/block/simple/link"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
@@ -59,6 +63,40 @@ func GetCommonDetails(sourcePath, name, emoji string, layout model.ObjectTypeLay
 	return &types.Struct{Fields: fields}
 }
 
+// ObjectTypeKey returns type of imported objects: the type from request, if it is set, otherwise default type of converter
+func ObjectTypeKey(req *pb.RpcObjectImportRequest, defaultType domain.TypeKey) string {
+	if typeKey := req.GetObjectTypeKey(); typeKey != "" {
+		return typeKey
+	}
+	return defaultType.String()
+}
+
+// SetFileTimes sets creation and modification dates of the file of the source, e.g. from headers of archive entries,
+// to details. Unknown dates are not changed
+func SetFileTimes(details *types.Struct, s source.Source, fileName string) {
+	created, modified := source.FileTimes(s, fileName)
+	if created > 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if modified > 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(modified)
+	}
+}
+
+// SourceFilter returns filter of files selected by IncludePaths and ExcludePaths of request
+func SourceFilter(req *pb.RpcObjectImportRequest) *source.Filter {
+	return source.NewFilter(req.GetIncludePaths(), req.GetExcludePaths())
+}
+
+// Concurrency returns number of files, which converters read and convert in parallel: the number from request,
+// if it is set, otherwise number of CPUs
+func Concurrency(req *pb.RpcObjectImportRequest) int {
+	if concurrency := req.GetConcurrency(); concurrency > 0 {
+		return int(concurrency)
+	}
+	return runtime.NumCPU()
+}
+
 func UpdateLinksToObjects(st *state.State, oldIDtoNew map[string]string, filesIDs []string) error {
 	return st.Iterate(func(bl simple.Block) (isContinue bool) {
 		switch block := bl.(type) {
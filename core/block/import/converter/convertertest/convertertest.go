@@ -0,0 +1,96 @@
+// Package convertertest contains helpers shared by tests of converters
+package convertertest
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// TempDirProvider returns the given directory as temp directory of the app
+type TempDirProvider struct {
+	Dir string
+}
+
+func (p *TempDirProvider) TempDir() string {
+	return p.Dir
+}
+
+// NewRequest returns request, which imports paths by the converter of the given type in IGNORE_ERRORS mode
+func NewRequest(importType pb.RpcObjectImportRequestType, paths ...string) *pb.RpcObjectImportRequest {
+	var params pb.IsRpcObjectImportRequestParams
+	switch importType {
+	case pb.RpcObjectImportRequest_Markdown:
+		params = &pb.RpcObjectImportRequestParamsOfMarkdownParams{MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Enex:
+		params = &pb.RpcObjectImportRequestParamsOfEnexParams{EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Roam:
+		params = &pb.RpcObjectImportRequestParamsOfRoamParams{RoamParams: &pb.RpcObjectImportRequestRoamParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Org:
+		params = &pb.RpcObjectImportRequestParamsOfOrgParams{OrgParams: &pb.RpcObjectImportRequestOrgParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Opml:
+		params = &pb.RpcObjectImportRequestParamsOfOpmlParams{OpmlParams: &pb.RpcObjectImportRequestOpmlParams{Path: paths}}
+	case pb.RpcObjectImportRequest_OneNote:
+		params = &pb.RpcObjectImportRequestParamsOfOneNoteParams{OneNoteParams: &pb.RpcObjectImportRequestOneNoteParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Atlassian:
+		params = &pb.RpcObjectImportRequestParamsOfAtlassianParams{AtlassianParams: &pb.RpcObjectImportRequestAtlassianParams{Path: paths}}
+	case pb.RpcObjectImportRequest_AppleNotes:
+		params = &pb.RpcObjectImportRequestParamsOfAppleNotesParams{AppleNotesParams: &pb.RpcObjectImportRequestAppleNotesParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Nextcloud:
+		params = &pb.RpcObjectImportRequestParamsOfNextcloudParams{NextcloudParams: &pb.RpcObjectImportRequestNextcloudParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Quiver:
+		params = &pb.RpcObjectImportRequestParamsOfQuiverParams{QuiverParams: &pb.RpcObjectImportRequestQuiverParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Jsonl:
+		params = &pb.RpcObjectImportRequestParamsOfJsonlParams{JsonlParams: &pb.RpcObjectImportRequestJsonlParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Gtd:
+		params = &pb.RpcObjectImportRequestParamsOfGtdParams{GtdParams: &pb.RpcObjectImportRequestGtdParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Joplin:
+		params = &pb.RpcObjectImportRequestParamsOfJoplinParams{JoplinParams: &pb.RpcObjectImportRequestJoplinParams{Path: paths}}
+	case pb.RpcObjectImportRequest_ICalendar:
+		params = &pb.RpcObjectImportRequestParamsOfICalendarParams{ICalendarParams: &pb.RpcObjectImportRequestICalendarParams{Path: paths}}
+	case pb.RpcObjectImportRequest_VCard:
+		params = &pb.RpcObjectImportRequestParamsOfVCardParams{VCardParams: &pb.RpcObjectImportRequestVCardParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Trilium:
+		params = &pb.RpcObjectImportRequestParamsOfTriliumParams{TriliumParams: &pb.RpcObjectImportRequestTriliumParams{Path: paths}}
+	case pb.RpcObjectImportRequest_Epub:
+		params = &pb.RpcObjectImportRequestParamsOfEpubParams{EpubParams: &pb.RpcObjectImportRequestEpubParams{Path: paths}}
+	default:
+		panic(fmt.Errorf("request of %s import is not supported", importType))
+	}
+	return &pb.RpcObjectImportRequest{
+		Params: params,
+		Type:   importType,
+		Mode:   pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}
+}
+
+// CollectionObjects returns ids of objects of the collection snapshot
+func CollectionObjects(sn *converter.Snapshot) []string {
+	return pbtypes.GetStringList(sn.Snapshot.Data.Collections, template.CollectionStoreKey)
+}
+
+// FindSnapshot returns the first snapshot with the given name, which matches all filters
+func FindSnapshot(snapshots []*converter.Snapshot, name string, filters ...func(sn *converter.Snapshot) bool) *converter.Snapshot {
+	for _, sn := range snapshots {
+		if pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String()) != name {
+			continue
+		}
+		if matchesAll(sn, filters) {
+			return sn
+		}
+	}
+	return nil
+}
+
+func matchesAll(sn *converter.Snapshot, filters []func(sn *converter.Snapshot) bool) bool {
+	for _, filter := range filters {
+		if !filter(sn) {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -12,10 +13,12 @@ var ErrCancel = fmt.Errorf("import is canceled")
 var ErrFailedToReceiveListOfObjects = fmt.Errorf("failed to receive the list of objects")
 var ErrNoObjectsToImport = fmt.Errorf("source path doesn't contain objects to import")
 var ErrLimitExceeded = fmt.Errorf("Limit of relations or objects are exceeded ")
+var ErrOverwriteNotConfirmed = fmt.Errorf("import overwrites existing objects, but it is not confirmed")
 
 type ConvertError struct {
-	errors []error
-	mode   pb.RpcObjectImportRequestMode
+	errors   []error
+	mode     pb.RpcObjectImportRequestMode
+	progress process.Progress
 }
 
 func NewError(mode pb.RpcObjectImportRequestMode) *ConvertError {
@@ -25,6 +28,14 @@ func NewError(mode pb.RpcObjectImportRequestMode) *ConvertError {
 	}
 }
 
+// NewErrorWithProgress creates ConvertError, which sends every added error to the client through progress as soon as
+// it occurs, so user can see errors and cancel import before it is finished
+func NewErrorWithProgress(mode pb.RpcObjectImportRequestMode, progress process.Progress) *ConvertError {
+	ce := NewError(mode)
+	ce.progress = progress
+	return ce
+}
+
 func NewFromError(initialError error, mode pb.RpcObjectImportRequestMode) *ConvertError {
 	ce := &ConvertError{mode: mode}
 
@@ -37,12 +48,66 @@ func NewCancelError(err error) *ConvertError {
 	return NewFromError(fmt.Errorf("%w: %w", ErrCancel, err), pb.RpcObjectImportRequest_ALL_OR_NOTHING)
 }
 
+// FileError is an error, that occurred during conversion of the file. File name is sent to the client along with error
+type FileError struct {
+	FileName string
+	Err      error
+}
+
+func NewFileError(fileName string, err error) error {
+	return &FileError{FileName: fileName, Err: err}
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%s: %s", e.FileName, e.Err)
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
 func (ce *ConvertError) Add(err error) {
 	ce.errors = append(ce.errors, err)
+	ce.report(err)
 }
 
 func (ce *ConvertError) Merge(err *ConvertError) {
 	ce.errors = append(ce.errors, err.errors...)
+	if err.progress != nil {
+		// errors are already sent to the client
+		return
+	}
+	for _, e := range err.errors {
+		ce.report(e)
+	}
+}
+
+func (ce *ConvertError) report(err error) {
+	if ce.progress == nil || err == nil {
+		return
+	}
+	var fileErr *FileError
+	if errors.As(err, &fileErr) {
+		ce.progress.ReportError(fileErr.FileName, fileErr.Err, ce.severity(err))
+		return
+	}
+	ce.progress.ReportError("", err, ce.severity(err))
+}
+
+// severity returns Warning for errors, which are skipped during import
+func (ce *ConvertError) severity(err error) pb.EventProcessErrorSeverity {
+	if ce.mode == pb.RpcObjectImportRequest_ALL_OR_NOTHING || errors.Is(err, ErrCancel) || errors.Is(err, ErrLimitExceeded) {
+		return pb.EventProcessError_Error
+	}
+	return pb.EventProcessError_Warning
+}
+
+// Errors returns errors in order of their addition
+func (ce *ConvertError) Errors() []error {
+	if ce == nil {
+		return nil
+	}
+	return ce.errors
 }
 
 func (ce *ConvertError) IsEmpty() bool {
@@ -0,0 +1,61 @@
+package converter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+type errorsTaker interface {
+	TakeErrors() []*pb.EventProcessError
+}
+
+func TestConvertError_Add(t *testing.T) {
+	t.Run("errors are reported to progress as soon as they are added", func(t *testing.T) {
+		// given
+		progress := process.NewProgress(pb.ModelProcess_Import)
+		ce := NewErrorWithProgress(pb.RpcObjectImportRequest_IGNORE_ERRORS, progress)
+
+		// when
+		ce.Add(NewFileError("first.csv", errors.New("wrong delimiter")))
+		reported := progress.(errorsTaker).TakeErrors()
+		ce.Add(ErrLimitExceeded)
+
+		// then
+		assert.Len(t, reported, 1)
+		assert.Equal(t, "first.csv", reported[0].FileName)
+		assert.Equal(t, "wrong delimiter", reported[0].Description)
+		assert.Equal(t, pb.EventProcessError_Warning, reported[0].Severity)
+
+		reported = progress.(errorsTaker).TakeErrors()
+		assert.Len(t, reported, 1)
+		assert.Equal(t, "", reported[0].FileName)
+		assert.Equal(t, pb.EventProcessError_Error, reported[0].Severity)
+		assert.Len(t, ce.errors, 2)
+	})
+	t.Run("merged errors are reported once", func(t *testing.T) {
+		// given
+		progress := process.NewProgress(pb.ModelProcess_Import)
+		ce := NewErrorWithProgress(pb.RpcObjectImportRequest_ALL_OR_NOTHING, progress)
+		reportedErr := NewErrorWithProgress(pb.RpcObjectImportRequest_ALL_OR_NOTHING, progress)
+		reportedErr.Add(errors.New("reported"))
+		notReportedErr := NewError(pb.RpcObjectImportRequest_ALL_OR_NOTHING)
+		notReportedErr.Add(errors.New("not reported"))
+
+		// when
+		ce.Merge(reportedErr)
+		ce.Merge(notReportedErr)
+
+		// then
+		reported := progress.(errorsTaker).TakeErrors()
+		assert.Len(t, reported, 2)
+		assert.Equal(t, "reported", reported[0].Description)
+		assert.Equal(t, "not reported", reported[1].Description)
+		assert.Equal(t, pb.EventProcessError_Error, reported[1].Severity)
+		assert.Len(t, ce.errors, 2)
+	})
+}
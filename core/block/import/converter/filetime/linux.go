@@ -4,8 +4,8 @@ package filetime
 
 import (
 	"os"
-	"syscall"
-	"time"
+
+	"golang.org/x/sys/unix"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	oserror "github.com/anyproto/anytype-heart/util/os"
@@ -13,17 +13,19 @@ import (
 
 var log = logging.Logger("import")
 
+// ExtractFileTimes returns birth time of the file, if the file system supports it. Change time of inode isn't
+// creation time, it is updated on every change of the file, so modification time is returned instead
 func ExtractFileTimes(fileName string) (int64, int64) {
 	fileInfo, err := os.Stat(fileName)
 	if err != nil {
 		log.Warnf("failed to get file info from path: %s", oserror.TransformError(err))
 		return 0, 0
 	}
-
-	if stat, ok := fileInfo.Sys().(*syscall.Stat_t); ok {
-		creationTime := time.Unix(stat.Ctim.Sec, stat.Ctim.Nsec)
-		modTime := fileInfo.ModTime().Unix()
-		return creationTime.Unix(), modTime
+	modTime := fileInfo.ModTime().Unix()
+	var stat unix.Statx_t
+	err = unix.Statx(unix.AT_FDCWD, fileName, unix.AT_STATX_SYNC_AS_STAT, unix.STATX_BTIME, &stat)
+	if err != nil || stat.Mask&unix.STATX_BTIME == 0 || stat.Btime.Sec == 0 {
+		return modTime, modTime
 	}
-	return 0, 0
+	return stat.Btime.Sec, modTime
 }
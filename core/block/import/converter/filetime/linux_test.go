@@ -0,0 +1,38 @@
+//go:build linux && !android
+
+package filetime
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFileTimes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// given
+		filePath := filepath.Join(t.TempDir(), "testfile")
+		require.NoError(t, os.WriteFile(filePath, []byte("text"), 0600))
+		modificationTime := time.Date(2023, 9, 21, 1, 0, 0, 0, time.UTC)
+		require.NoError(t, os.Chtimes(filePath, modificationTime, modificationTime))
+
+		// when
+		creation, modification := ExtractFileTimes(filePath)
+
+		// then
+		assert.Equal(t, modificationTime.Unix(), modification)
+		assert.NotZero(t, creation)
+	})
+	t.Run("error", func(t *testing.T) {
+		// when
+		creation, modification := ExtractFileTimes("non_existent_file")
+
+		// then
+		assert.Equal(t, int64(0), creation)
+		assert.Equal(t, int64(0), modification)
+	})
+}
@@ -0,0 +1,178 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+var ErrParseLimitExceeded = errors.New("parse limit is exceeded")
+
+// ParseLimits bound memory, which is used to parse one file, so hostile or pathological files,
+// like deeply nested JSON or XML with entity expansion, fail with error instead of exhausting memory
+type ParseLimits struct {
+	// MaxDepth is the max nesting depth of JSON values or XML elements
+	MaxDepth int
+	// MaxBytes is the max size of parsed file
+	MaxBytes int64
+	// MaxElements is the max number of JSON values or XML elements
+	MaxElements int64
+}
+
+// DefaultParseLimits are used by converters, which don't need specific limits
+var DefaultParseLimits = ParseLimits{
+	MaxDepth:    128,
+	MaxBytes:    256 << 20,
+	MaxElements: 10_000_000,
+}
+
+// GetParseLimits returns default limits of the converter overridden by non-zero limits from request
+func GetParseLimits(req *pb.RpcObjectImportRequest, defaults ParseLimits) ParseLimits {
+	limits := defaults
+	override := req.GetParseLimits()
+	if override.GetMaxDepth() > 0 {
+		limits.MaxDepth = int(override.GetMaxDepth())
+	}
+	if override.GetMaxBytes() > 0 {
+		limits.MaxBytes = override.GetMaxBytes()
+	}
+	if override.GetMaxElements() > 0 {
+		limits.MaxElements = override.GetMaxElements()
+	}
+	return limits
+}
+
+// ReadAll reads the whole reader, failing as soon as MaxBytes is exceeded
+func (l ParseLimits) ReadAll(r io.Reader) ([]byte, error) {
+	if l.MaxBytes <= 0 {
+		return io.ReadAll(r)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, l.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > l.MaxBytes {
+		return nil, fmt.Errorf("%w: file is larger than %d bytes", ErrParseLimitExceeded, l.MaxBytes)
+	}
+	return data, nil
+}
+
+// DecodeJSON checks JSON against limits before decoding it to v
+func (l ParseLimits) DecodeJSON(r io.Reader, v interface{}) error {
+	data, err := l.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err = l.CheckJSON(data); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// CheckJSON walks tokens of JSON without building values, so depth and number of values are checked
+// before any memory is allocated for them
+func (l ParseLimits) CheckJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	counter := l.newCounter()
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			counter.close()
+			continue
+		}
+		if err = counter.add(); err != nil {
+			return err
+		}
+		if delim, ok := token.(json.Delim); ok && (delim == '{' || delim == '[') {
+			if err = counter.open(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// DecodeXML checks XML against limits before decoding it to v. Errors of decoding are returned as is,
+// so xml.UnmarshalError can be checked by caller
+func (l ParseLimits) DecodeXML(r io.Reader, v interface{}) error {
+	data, err := l.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if err = l.CheckXML(data); err != nil {
+		return err
+	}
+	return xml.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// CheckXML walks tokens of XML and checks depth and number of elements. Entity declarations are rejected,
+// because their expansion is the way to turn small file into huge document
+func (l ParseLimits) CheckXML(data []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	counter := l.newCounter()
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.Directive:
+			if strings.Contains(string(t), "<!ENTITY") {
+				return fmt.Errorf("%w: entity declarations are not supported", ErrParseLimitExceeded)
+			}
+		case xml.StartElement:
+			if err = counter.add(); err != nil {
+				return err
+			}
+			if err = counter.open(); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			counter.close()
+		}
+	}
+}
+
+type limitCounter struct {
+	limits   ParseLimits
+	depth    int
+	elements int64
+}
+
+func (l ParseLimits) newCounter() *limitCounter {
+	return &limitCounter{limits: l}
+}
+
+func (c *limitCounter) add() error {
+	c.elements++
+	if c.limits.MaxElements > 0 && c.elements > c.limits.MaxElements {
+		return fmt.Errorf("%w: more than %d elements", ErrParseLimitExceeded, c.limits.MaxElements)
+	}
+	return nil
+}
+
+func (c *limitCounter) open() error {
+	c.depth++
+	if c.limits.MaxDepth > 0 && c.depth > c.limits.MaxDepth {
+		return fmt.Errorf("%w: nesting depth is more than %d", ErrParseLimitExceeded, c.limits.MaxDepth)
+	}
+	return nil
+}
+
+func (c *limitCounter) close() {
+	c.depth--
+}
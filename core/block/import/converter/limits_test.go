@@ -0,0 +1,124 @@
+package converter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestParseLimits_DecodeJSON(t *testing.T) {
+	t.Run("deeply nested JSON is rejected", func(t *testing.T) {
+		// given
+		depth := 1_000_000
+		data := strings.Repeat("[", depth) + strings.Repeat("]", depth)
+		var v interface{}
+
+		// when
+		err := DefaultParseLimits.DecodeJSON(strings.NewReader(data), &v)
+
+		// then
+		assert.ErrorIs(t, err, ErrParseLimitExceeded)
+		assert.Nil(t, v)
+	})
+	t.Run("too many values are rejected", func(t *testing.T) {
+		// given
+		limits := ParseLimits{MaxElements: 100}
+		data := "[" + strings.TrimSuffix(strings.Repeat("1,", 200), ",") + "]"
+		var v []int
+
+		// when
+		err := limits.DecodeJSON(strings.NewReader(data), &v)
+
+		// then
+		assert.ErrorIs(t, err, ErrParseLimitExceeded)
+		assert.Nil(t, v)
+	})
+	t.Run("too large file is rejected", func(t *testing.T) {
+		// given
+		limits := ParseLimits{MaxBytes: 16}
+		var v string
+
+		// when
+		err := limits.DecodeJSON(strings.NewReader(`"`+strings.Repeat("a", 100)+`"`), &v)
+
+		// then
+		assert.ErrorIs(t, err, ErrParseLimitExceeded)
+	})
+	t.Run("JSON within limits is decoded", func(t *testing.T) {
+		// given
+		var v map[string][]int
+
+		// when
+		err := DefaultParseLimits.DecodeJSON(strings.NewReader(`{"a": [1, 2], "b": [3]}`), &v)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]int{"a": {1, 2}, "b": {3}}, v)
+	})
+}
+
+func TestParseLimits_DecodeXML(t *testing.T) {
+	t.Run("entity expansion is rejected", func(t *testing.T) {
+		// given
+		var doctype bytes.Buffer
+		doctype.WriteString(`<?xml version="1.0"?><!DOCTYPE lolz [<!ENTITY lol "lol">`)
+		for i := 1; i <= 9; i++ {
+			doctype.WriteString(fmt.Sprintf(`<!ENTITY lol%d "%s">`, i, strings.Repeat(fmt.Sprintf("&lol%d;", i-1), 10)))
+		}
+		doctype.WriteString(`]><lolz>&lol9;</lolz>`)
+		var v struct {
+			Text string `xml:",chardata"`
+		}
+
+		// when
+		err := DefaultParseLimits.DecodeXML(&doctype, &v)
+
+		// then
+		assert.ErrorIs(t, err, ErrParseLimitExceeded)
+		assert.Empty(t, v.Text)
+	})
+	t.Run("deeply nested XML is rejected", func(t *testing.T) {
+		// given
+		depth := 100_000
+		data := strings.Repeat("<a>", depth) + strings.Repeat("</a>", depth)
+		var v struct{}
+
+		// when
+		err := DefaultParseLimits.DecodeXML(strings.NewReader(data), &v)
+
+		// then
+		assert.ErrorIs(t, err, ErrParseLimitExceeded)
+	})
+	t.Run("XML within limits is decoded", func(t *testing.T) {
+		// given
+		var v struct {
+			Items []string `xml:"item"`
+		}
+
+		// when
+		err := DefaultParseLimits.DecodeXML(strings.NewReader(`<list><item>a</item><item>b</item></list>`), &v)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, v.Items)
+	})
+}
+
+func TestGetParseLimits(t *testing.T) {
+	// given
+	defaults := ParseLimits{MaxDepth: 10, MaxBytes: 100, MaxElements: 1000}
+	req := &pb.RpcObjectImportRequest{ParseLimits: &pb.RpcObjectImportRequestParseLimits{MaxBytes: 50}}
+
+	// when
+	limits := GetParseLimits(req, defaults)
+
+	// then
+	assert.Equal(t, ParseLimits{MaxDepth: 10, MaxBytes: 50, MaxElements: 1000}, limits)
+	assert.Equal(t, defaults, GetParseLimits(&pb.RpcObjectImportRequest{}, defaults))
+}
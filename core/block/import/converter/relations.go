@@ -0,0 +1,119 @@
+package converter
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// RelationCreator creates snapshots of relations and relation options, which are absent in bundle.
+// Relations and options with the same names are created once, so they are shared between all objects of the import
+type RelationCreator struct {
+	relations map[string]*model.RelationLink
+	// options maps relation key and option name to the id of relation option
+	options map[string]string
+	// snapshots receives snapshots of created relations and options
+	snapshots *[]*Snapshot
+}
+
+// NewRelationCreator returns creator, which appends snapshots of relations and options to snapshots
+func NewRelationCreator(snapshots *[]*Snapshot) *RelationCreator {
+	return &RelationCreator{
+		relations: map[string]*model.RelationLink{},
+		options:   map[string]string{},
+		snapshots: snapshots,
+	}
+}
+
+// Relation returns relation with given name, creating relation snapshot on the first call
+func (c *RelationCreator) Relation(name string, format model.RelationFormat) *model.RelationLink {
+	return c.RelationByID(name, name, format)
+}
+
+// RelationByID is like Relation, but relations are shared by id instead of name, e.g. to ignore case of names
+// or to create relations of different formats with the same name
+func (c *RelationCreator) RelationByID(id, name string, format model.RelationFormat) *model.RelationLink {
+	if relation, ok := c.relations[id]; ok {
+		return relation
+	}
+	key := bson.NewObjectId().Hex()
+	relation := &model.RelationLink{Key: key, Format: format}
+	c.relations[id] = relation
+	*c.snapshots = append(*c.snapshots, &Snapshot{
+		Id:     key,
+		SbType: smartblock.SmartBlockTypeRelation,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     RelationDetails(name, key, format),
+			ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+			Key:         key,
+		}},
+	})
+	return relation
+}
+
+// RelationDetails returns details of the relation snapshot
+func RelationDetails(name, key string, format model.RelationFormat) *types.Struct {
+	details := &types.Struct{Fields: map[string]*types.Value{}}
+	details.Fields[bundle.RelationKeyRelationFormat.String()] = pbtypes.Float64(float64(format))
+	details.Fields[bundle.RelationKeyName.String()] = pbtypes.String(name)
+	details.Fields[bundle.RelationKeyRelationKey.String()] = pbtypes.String(key)
+	details.Fields[bundle.RelationKeyLayout.String()] = pbtypes.Float64(float64(model.ObjectType_relation))
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for imported relation: %v", err)
+		return details
+	}
+	details.Fields[bundle.RelationKeyId.String()] = pbtypes.String(uniqueKey.Marshal())
+	return details
+}
+
+// OptionIDs returns ids of relation options with given names, empty names are skipped
+func (c *RelationCreator) OptionIDs(relationKey string, names []string) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		if id := c.OptionID(relationKey, name); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// OptionID returns id of relation option with given name, so the same tag or status is shared between objects
+func (c *RelationCreator) OptionID(relationKey, name string) string {
+	optionKey := relationKey + "/" + name
+	if id, ok := c.options[optionKey]; ok {
+		return id
+	}
+	key := bson.NewObjectId().Hex()
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for imported relation option: %v", err)
+		return ""
+	}
+	id := uniqueKey.Marshal()
+	details := &types.Struct{Fields: map[string]*types.Value{}}
+	details.Fields[bundle.RelationKeyName.String()] = pbtypes.String(name)
+	details.Fields[bundle.RelationKeyRelationKey.String()] = pbtypes.String(relationKey)
+	details.Fields[bundle.RelationKeyLayout.String()] = pbtypes.Float64(float64(model.ObjectType_relationOption))
+	details.Fields[bundle.RelationKeyId.String()] = pbtypes.String(id)
+	c.options[optionKey] = id
+	*c.snapshots = append(*c.snapshots, &Snapshot{
+		Id:     id,
+		SbType: smartblock.SmartBlockTypeRelationOption,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     details,
+			ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+			Key:         key,
+		}},
+	})
+	return id
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestRelationCreator(t *testing.T) {
+	t.Run("relations with the same name are shared", func(t *testing.T) {
+		// given
+		var snapshots []*Snapshot
+		c := NewRelationCreator(&snapshots)
+
+		// when
+		first := c.Relation("Estimate", model.RelationFormat_number)
+		second := c.Relation("Estimate", model.RelationFormat_number)
+		other := c.RelationByID("estimate/text", "Estimate", model.RelationFormat_shorttext)
+
+		// then
+		assert.Same(t, first, second)
+		assert.NotEqual(t, first.Key, other.Key)
+		assert.Len(t, snapshots, 2)
+		assert.Equal(t, smartblock.SmartBlockTypeRelation, snapshots[0].SbType)
+		details := snapshots[0].Snapshot.Data.Details
+		assert.Equal(t, "Estimate", pbtypes.GetString(details, bundle.RelationKeyName.String()))
+		assert.Equal(t, first.Key, pbtypes.GetString(details, bundle.RelationKeyRelationKey.String()))
+		assert.Equal(t, int64(model.RelationFormat_number), pbtypes.GetInt64(details, bundle.RelationKeyRelationFormat.String()))
+	})
+
+	t.Run("options with the same name are shared", func(t *testing.T) {
+		// given
+		var snapshots []*Snapshot
+		c := NewRelationCreator(&snapshots)
+
+		// when
+		ids := c.OptionIDs(bundle.RelationKeyTag.String(), []string{"work", "", "home", "work"})
+		statusID := c.OptionID(bundle.RelationKeyStatus.String(), "work")
+
+		// then
+		assert.Len(t, ids, 3)
+		assert.Equal(t, ids[0], ids[2])
+		assert.NotEqual(t, ids[0], ids[1])
+		assert.NotEqual(t, ids[0], statusID)
+		assert.Len(t, snapshots, 3)
+		assert.Equal(t, smartblock.SmartBlockTypeRelationOption, snapshots[0].SbType)
+		assert.Equal(t, bundle.RelationKeyTag.String(), pbtypes.GetString(snapshots[0].Snapshot.Data.Details, bundle.RelationKeyRelationKey.String()))
+	})
+}
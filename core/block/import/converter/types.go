@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
@@ -20,6 +22,27 @@ type Converter interface {
 	Name() string
 }
 
+// BatchSize is number of converted snapshots, which StreamConverter passes to SnapshotStream at once
+const BatchSize = 500
+
+// StreamConverter is implemented by converters, which pass converted snapshots to importer in batches, so objects
+// are created during conversion and the whole import isn't kept in memory
+type StreamConverter interface {
+	Converter
+	// GetSnapshotStream passes all snapshots to the stream and returns response with root collection id.
+	// Snapshots in response are empty
+	GetSnapshotStream(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress, stream SnapshotStream) (*Response, *ConvertError)
+}
+
+// SnapshotStream receives snapshots from StreamConverter
+type SnapshotStream interface {
+	// Prepare resolves ids of objects before their content is converted, so links to them can be set in any batch.
+	// Only ids, types and details of snapshots are used. It can be called several times
+	Prepare(snapshots []*Snapshot) error
+	// Send creates objects from batch of converted snapshots. Error means that import should be aborted
+	Send(snapshots []*Snapshot) error
+}
+
 // ImageGetter returns image for given converter in frontend
 type ImageGetter interface {
 	GetImage() ([]byte, int64, int64, error)
@@ -39,6 +62,15 @@ type Snapshot struct {
 	Snapshot *pb.ChangeSnapshot
 }
 
+// Copy returns deep copy of the snapshot, so it can be changed without affecting the original one
+func (s *Snapshot) Copy() *Snapshot {
+	sn := *s
+	if s.Snapshot != nil {
+		sn.Snapshot = proto.Clone(s.Snapshot).(*pb.ChangeSnapshot)
+	}
+	return &sn
+}
+
 // Response expected response of each converter, incapsulate blocks snapshots and converting errors
 type Response struct {
 	Snapshots        []*Snapshot
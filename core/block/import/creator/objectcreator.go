@@ -87,6 +87,7 @@ func (oc *ObjectCreator) Create(dataObject *DataObject, sn *converter.Snapshot)
 
 	st := state.NewDocFromSnapshot(newID, sn.Snapshot, state.WithUniqueKeyMigration(sn.SbType)).(*state.State)
 	oc.injectImportDetails(sn, st, origin, spaceID)
+	oc.injectImportRunID(dataObject, st, newID)
 	var filesToDelete []string
 	defer func() {
 		// delete file in ipfs if there is error after creation
@@ -149,20 +150,39 @@ func (oc *ObjectCreator) injectImportDetails(sn *converter.Snapshot, st *state.S
 	lastModifiedDate := pbtypes.GetInt64(sn.Snapshot.Data.Details, bundle.RelationKeyLastModifiedDate.String())
 	createdDate := pbtypes.GetInt64(sn.Snapshot.Data.Details, bundle.RelationKeyCreatedDate.String())
 	if lastModifiedDate == 0 {
-		if createdDate != 0 {
-			lastModifiedDate = createdDate
-		} else {
-			// we can't fallback to time.Now() because it will be inconsistent with the time used in object tree header.
-			// So instead we should EXPLICITLY set creation date to the snapshot in all importers
-			log.With("objectID", sn.Id).Warnf("both lastModifiedDate and createdDate are not set in the imported snapshot")
-		}
-		if lastModifiedDate > 0 {
-			st.SetLocalDetail(bundle.RelationKeyLastModifiedDate.String(), pbtypes.Int64(lastModifiedDate))
-		}
+		lastModifiedDate = createdDate
+	}
+	if createdDate == 0 || createdDate > lastModifiedDate {
+		createdDate = lastModifiedDate
+	}
+	if lastModifiedDate == 0 {
+		// we can't fallback to time.Now() because it will be inconsistent with the time used in object tree header.
+		// So instead we should EXPLICITLY set creation date to the snapshot in all importers
+		log.With("objectID", sn.Id).Warnf("both lastModifiedDate and createdDate are not set in the imported snapshot")
+	}
+	// both dates are derived relations, which are cut from details of the snapshot, so they are kept
+	// in local details, which are used for the first change of the object
+	if lastModifiedDate > 0 {
+		st.SetLocalDetail(bundle.RelationKeyLastModifiedDate.String(), pbtypes.Int64(lastModifiedDate))
+	}
+	if createdDate > 0 {
+		st.SetLocalDetail(bundle.RelationKeyCreatedDate.String(), pbtypes.Int64(createdDate))
 	}
 	st.SetDetailAndBundledRelation(bundle.RelationKeyOrigin, pbtypes.Int64(int64(origin)))
 }
 
+// injectImportRunID marks objects, which are created by import, so they can be deleted on undo of the import.
+// Existing objects, which are updated by import, are not marked
+func (oc *ObjectCreator) injectImportRunID(dataObject *DataObject, st *state.State, newID string) {
+	if dataObject.importRunID == "" {
+		return
+	}
+	if payload := dataObject.createPayloads[newID]; payload.RootRawChange == nil {
+		return
+	}
+	st.SetDetailAndBundledRelation(bundle.RelationKeyImportRunId, pbtypes.String(dataObject.importRunID))
+}
+
 func (oc *ObjectCreator) updateExistingObject(st *state.State, oldIDtoNew map[string]string, newID string) *types.Struct {
 	if st.Store() != nil {
 		oc.updateLinksInCollections(st, oldIDtoNew, false)
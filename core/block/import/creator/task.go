@@ -17,12 +17,14 @@ type DataObject struct {
 	ctx            context.Context
 	origin         model.ObjectOrigin
 	spaceID        string
+	importRunID    string
 }
 
 type Result struct {
-	Details *types.Struct
-	NewID   string
-	Err     error
+	Details    *types.Struct
+	NewID      string
+	SnapshotID string
+	Err        error
 }
 
 func NewDataObject(ctx context.Context,
@@ -31,6 +33,7 @@ func NewDataObject(ctx context.Context,
 	filesIDs []string,
 	origin model.ObjectOrigin,
 	spaceID string,
+	importRunID string,
 ) *DataObject {
 	return &DataObject{
 		oldIDtoNew:     oldIDtoNew,
@@ -39,9 +42,15 @@ func NewDataObject(ctx context.Context,
 		ctx:            ctx,
 		origin:         origin,
 		spaceID:        spaceID,
+		importRunID:    importRunID,
 	}
 }
 
+// ImportRunID returns id of the import run, which is set to created objects
+func (d *DataObject) ImportRunID() string {
+	return d.importRunID
+}
+
 type Task struct {
 	spaceID string
 	sn      *converter.Snapshot
@@ -56,8 +65,9 @@ func (t *Task) Execute(data interface{}) interface{} {
 	dataObject := data.(*DataObject)
 	details, newID, err := t.oc.Create(dataObject, t.sn)
 	return &Result{
-		Details: details,
-		NewID:   newID,
-		Err:     err,
+		Details:    details,
+		NewID:      newID,
+		SnapshotID: t.sn.Id,
+		Err:        err,
 	}
 }
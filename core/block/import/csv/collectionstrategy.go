@@ -37,10 +37,11 @@ const (
 
 type CollectionStrategy struct {
 	collectionService *collection.Service
+	objectType        string
 }
 
-func NewCollectionStrategy(collectionService *collection.Service) *CollectionStrategy {
-	return &CollectionStrategy{collectionService: collectionService}
+func NewCollectionStrategy(collectionService *collection.Service, objectType string) *CollectionStrategy {
+	return &CollectionStrategy{collectionService: collectionService, objectType: objectType}
 }
 
 func (c *CollectionStrategy) CreateObjects(path string, csvTable [][]string, params *pb.RpcObjectImportRequestCsvParams, progress process.Progress) (string, []*converter.Snapshot, error) {
@@ -53,7 +54,7 @@ func (c *CollectionStrategy) CreateObjects(path string, csvTable [][]string, par
 		return "", nil, err
 	}
 	relations, relationsSnapshots, errRelationLimit := getDetailsFromCSVTable(csvTable, params.UseFirstRowForRelations)
-	objectsSnapshots, errRowLimit := getObjectsFromCSVRows(path, csvTable, relations, params)
+	objectsSnapshots, errRowLimit := getObjectsFromCSVRows(path, csvTable, relations, params, c.objectType)
 	targetIDs := make([]string, 0, len(objectsSnapshots))
 	for _, objectsSnapshot := range objectsSnapshots {
 		targetIDs = append(targetIDs, objectsSnapshot.Id)
@@ -112,8 +113,9 @@ func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool) (
 			relationName = getDefaultRelationName(i)
 		}
 		id := bson.NewObjectId().Hex()
+		format := inferRelationFormat(csvTable, i, useFirstRowForRelations)
 		relations = append(relations, &model.Relation{
-			Format: model.RelationFormat_longtext,
+			Format: format,
 			Name:   relationName,
 			Key:    id,
 		})
@@ -121,7 +123,7 @@ func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool) (
 			Id:     id,
 			SbType: smartblock.SmartBlockTypeRelation,
 			Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
-				Details:     getRelationDetails(relationName, id, float64(model.RelationFormat_longtext)),
+				Details:     getRelationDetails(relationName, id, float64(format)),
 				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
 				Key:         id,
 			}},
@@ -186,7 +188,7 @@ func getRelationDetails(name, key string, format float64) *types.Struct {
 	return details
 }
 
-func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.Relation, params *pb.RpcObjectImportRequestCsvParams) ([]*converter.Snapshot, error) {
+func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.Relation, params *pb.RpcObjectImportRequestCsvParams, objectType string) ([]*converter.Snapshot, error) {
 	snapshots := make([]*converter.Snapshot, 0, len(csvTable))
 	numberOfObjectsLimit := len(csvTable)
 	var err error
@@ -213,7 +215,7 @@ func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.
 		st.SetDetails(details)
 		st.AddRelationLinks(relationLinks...)
 		template.InitTemplate(st, template.WithTitle)
-		sn := provideObjectSnapshot(st, details)
+		sn := provideObjectSnapshot(st, details, objectType)
 		snapshots = append(snapshots, sn)
 	}
 	return snapshots, err
@@ -241,7 +243,9 @@ func getDetailsForObject(relationsValues []string, relations []*model.Relation,
 			break
 		}
 		relation := relations[j]
-		details.Fields[relation.Key] = pbtypes.String(value)
+		if v := getDetailValue(value, relation.Format); v != nil {
+			details.Fields[relation.Key] = v
+		}
 		relationLinks = append(relationLinks, &model.RelationLink{
 			Key:    relation.Key,
 			Format: relation.Format,
@@ -252,7 +256,7 @@ func getDetailsForObject(relationsValues []string, relations []*model.Relation,
 	return details, relationLinks
 }
 
-func provideObjectSnapshot(st *state.State, details *types.Struct) *converter.Snapshot {
+func provideObjectSnapshot(st *state.State, details *types.Struct, objectType string) *converter.Snapshot {
 	snapshot := &converter.Snapshot{
 		Id:     uuid.New().String(),
 		SbType: smartblock.SmartBlockTypePage,
@@ -261,7 +265,7 @@ func provideObjectSnapshot(st *state.State, details *types.Struct) *converter.Sn
 				Blocks:        st.Blocks(),
 				Details:       details,
 				RelationLinks: st.GetRelationLinks(),
-				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+				ObjectTypes:   []string{objectType},
 			},
 		},
 	}
@@ -1,10 +1,15 @@
 package csv
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/samber/lo"
 
 	"github.com/anyproto/anytype-heart/core/block/collection"
 	te "github.com/anyproto/anytype-heart/core/block/editor/table"
@@ -12,6 +17,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 )
 
 const (
@@ -22,6 +28,12 @@ const (
 	limitForRows          = 1000
 )
 
+// rows of CSV are records, so they are imported as documents, while table is just a page with table block
+const (
+	defaultRowObjectType   = bundle.TypeKeyDocument
+	defaultTableObjectType = bundle.TypeKeyPage
+)
+
 type Result struct {
 	objectIDs []string
 	snapshots []*converter.Snapshot
@@ -37,10 +49,11 @@ func (r *Result) Merge(r2 *Result) {
 
 type CSV struct {
 	collectionService *collection.Service
+	parseLimits       converter.ParseLimits
 }
 
 func New(collectionService *collection.Service) converter.Converter {
-	return &CSV{collectionService: collectionService}
+	return &CSV{collectionService: collectionService, parseLimits: converter.DefaultParseLimits}
 }
 
 func (c *CSV) Name() string {
@@ -60,7 +73,7 @@ func (c *CSV) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest,
 	if params == nil {
 		return nil, nil
 	}
-	allErrors := converter.NewError(req.Mode)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	result := c.createObjectsFromCSVFiles(req, progress, params, allErrors)
 	if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
 		return nil, allErrors
@@ -91,8 +104,7 @@ func (c *CSV) createObjectsFromCSVFiles(req *pb.RpcObjectImportRequest,
 	params *pb.RpcObjectImportRequestCsvParams,
 	allErrors *converter.ConvertError,
 ) *Result {
-	csvMode := params.GetMode()
-	str := c.chooseStrategy(csvMode)
+	str := c.chooseStrategy(req)
 	result := &Result{}
 	for _, p := range params.GetPath() {
 		pathResult := c.getSnapshotsFromFiles(req, p, allErrors, str, progress)
@@ -111,7 +123,7 @@ func (c *CSV) getSnapshotsFromFiles(req *pb.RpcObjectImportRequest,
 	progress process.Progress,
 ) *Result {
 	params := req.GetCsvParams()
-	importSource := source.GetSource(importPath)
+	importSource := converter.SourceFilter(req).Wrap(source.GetSource(importPath))
 	defer importSource.Close()
 	err := importSource.Initialize(importPath)
 	if err != nil {
@@ -121,17 +133,19 @@ func (c *CSV) getSnapshotsFromFiles(req *pb.RpcObjectImportRequest,
 		}
 	}
 	var numberOfFiles int
-	if numberOfFiles = importSource.CountFilesWithGivenExtensions([]string{".csv"}); numberOfFiles == 0 {
+	if numberOfFiles = importSource.CountFilesWithGivenExtensions(supportedExtensions(params)); numberOfFiles == 0 {
 		allErrors.Add(converter.ErrNoObjectsToImport)
 		return nil
 	}
 	progress.SetProgressMessage("Start creating snapshots from files")
 	progress.SetTotal(int64(numberOfFiles) * numberOfProgressSteps)
-	return c.getSnapshotsAndObjectsIDs(importSource, params, str, allErrors, progress)
+	limits := converter.GetParseLimits(req, c.parseLimits)
+	return c.getSnapshotsAndObjectsIDs(importSource, params, limits, str, allErrors, progress)
 }
 
 func (c *CSV) getSnapshotsAndObjectsIDs(importSource source.Source,
 	params *pb.RpcObjectImportRequestCsvParams,
+	limits converter.ParseLimits,
 	str Strategy,
 	allErrors *converter.ConvertError,
 	progress process.Progress,
@@ -143,9 +157,9 @@ func (c *CSV) getSnapshotsAndObjectsIDs(importSource source.Source,
 			allErrors.Add(converter.ErrCancel)
 			return false
 		}
-		csvTable, err := c.getCSVTable(fileReader, params.GetDelimiter())
+		csvTable, err := c.getTable(fileName, fileReader, params, limits)
 		if err != nil {
-			allErrors.Add(err)
+			allErrors.Add(converter.NewFileError(fileName, err))
 			return !allErrors.ShouldAbortImport(len(params.GetPath()), pb.RpcObjectImportRequest_Csv)
 		}
 		if params.TransposeRowsAndColumns && len(csvTable) != 0 {
@@ -153,7 +167,7 @@ func (c *CSV) getSnapshotsAndObjectsIDs(importSource source.Source,
 		}
 		collectionID, snapshots, err := str.CreateObjects(fileName, csvTable, params, progress)
 		if err != nil {
-			allErrors.Add(err)
+			allErrors.Add(converter.NewFileError(fileName, err))
 			return !allErrors.ShouldAbortImport(len(params.GetPath()), pb.RpcObjectImportRequest_Csv)
 		}
 		allObjectsIDs = append(allObjectsIDs, collectionID)
@@ -165,9 +179,27 @@ func (c *CSV) getSnapshotsAndObjectsIDs(importSource source.Source,
 	return &Result{allObjectsIDs, allSnapshots}
 }
 
-func (c *CSV) getCSVTable(rc io.ReadCloser, delimiter string) ([][]string, error) {
+// getTable reads table from the file: TSV files are read with tab delimiter and fixed-width files
+// are split into columns by their widths
+func (c *CSV) getTable(fileName string, rc io.ReadCloser, params *pb.RpcObjectImportRequestCsvParams, limits converter.ParseLimits) ([][]string, error) {
 	defer rc.Close()
-	csvReader := csv.NewReader(rc)
+	data, err := limits.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(fileName))
+	switch {
+	case ext == tsvExtension:
+		return c.getCSVTable(bytes.NewReader(data), "\t")
+	case isFixedWidthMode(params) && lo.Contains(fixedWidthExtensions, ext):
+		return readFixedWidthTable(bytes.NewReader(data), params.GetColumnWidths())
+	default:
+		return c.getCSVTable(bytes.NewReader(data), params.GetDelimiter())
+	}
+}
+
+func (c *CSV) getCSVTable(r io.Reader, delimiter string) ([][]string, error) {
+	csvReader := csv.NewReader(r)
 	csvReader.LazyQuotes = true
 	csvReader.ReuseRecord = true
 	csvReader.FieldsPerRecord = -1
@@ -182,11 +214,11 @@ func (c *CSV) getCSVTable(rc io.ReadCloser, delimiter string) ([][]string, error
 	return csvTable, nil
 }
 
-func (c *CSV) chooseStrategy(mode pb.RpcObjectImportRequestCsvParamsMode) Strategy {
-	if mode == pb.RpcObjectImportRequestCsvParams_COLLECTION {
-		return NewCollectionStrategy(c.collectionService)
+func (c *CSV) chooseStrategy(req *pb.RpcObjectImportRequest) Strategy {
+	if req.GetCsvParams().GetMode() == pb.RpcObjectImportRequestCsvParams_COLLECTION {
+		return NewCollectionStrategy(c.collectionService, converter.ObjectTypeKey(req, defaultRowObjectType))
 	}
-	return NewTableStrategy(te.NewEditor(nil))
+	return NewTableStrategy(te.NewEditor(nil), converter.ObjectTypeKey(req, defaultTableObjectType))
 }
 
 func transpose(csvTable [][]string) [][]string {
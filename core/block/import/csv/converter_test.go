@@ -3,12 +3,15 @@ package csv
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/template"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
@@ -278,6 +281,10 @@ func assertSnapshotsHaveDetails(t *testing.T, want []string, objects *converter.
 		if key == bundle.RelationKeySourceFilePath.String() || key == bundle.RelationKeyLayout.String() {
 			continue
 		}
+		if _, ok := value.Kind.(*types.Value_NumberValue); ok {
+			assert.Contains(t, want, strconv.FormatFloat(value.GetNumberValue(), 'f', -1, 64))
+			continue
+		}
 		assert.Contains(t, want, value.GetStringValue())
 	}
 }
@@ -667,6 +674,126 @@ func TestCsv_GetSnapshots10Relations(t *testing.T) {
 	}
 }
 
+func TestCsv_GetSnapshotsTSV(t *testing.T) {
+	csv := CSV{}
+	p := process.NewProgress(pb.ModelProcess_Import)
+	sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+			CsvParams: &pb.RpcObjectImportRequestCsvParams{
+				Path:                    []string{"testdata/tasks.tsv"},
+				UseFirstRowForRelations: true,
+			},
+		},
+		Type: pb.RpcObjectImportRequest_Csv,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, p)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, sn)
+	assert.Len(t, sn.Snapshots, 6) // tasks.tsv collection, root collection + 2 objects + 2 relations (Status, Owner)
+
+	rowsObjects := getRowsObjects(sn.Snapshots)
+	assert.Len(t, rowsObjects, 2)
+	assertSnapshotsHaveDetails(t, []string{"Write docs", "In progress", "Alice, Bob"}, rowsObjects[0])
+	assertSnapshotsHaveDetails(t, []string{"Release", "Done", "Carol"}, rowsObjects[1])
+}
+
+func TestCsv_GetSnapshotsFixedWidth(t *testing.T) {
+	want := [][]string{
+		{"Blue pen", "40", "Shelf A"},
+		{"Notebook", "12", "Shelf B"},
+	}
+	t.Run("columns with given widths", func(t *testing.T) {
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{
+					Path:                    []string{"testdata/inventory.txt"},
+					UseFirstRowForRelations: true,
+					ColumnWidths:            []int32{12, 8, 10},
+				},
+			},
+			Type: pb.RpcObjectImportRequest_Csv,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		assert.Len(t, sn.Snapshots, 6) // inventory.txt collection, root collection + 2 objects + 2 relations (Count, Location)
+
+		rowsObjects := getRowsObjects(sn.Snapshots)
+		assert.Len(t, rowsObjects, 2)
+		assertSnapshotsHaveDetails(t, want[0], rowsObjects[0])
+		assertSnapshotsHaveDetails(t, want[1], rowsObjects[1])
+	})
+	t.Run("columns are inferred from header", func(t *testing.T) {
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{
+					Path:                    []string{"testdata/inventory.txt"},
+					UseFirstRowForRelations: true,
+					FixedWidth:              true,
+				},
+			},
+			Type: pb.RpcObjectImportRequest_Csv,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		assert.Len(t, sn.Snapshots, 6)
+
+		rowsObjects := getRowsObjects(sn.Snapshots)
+		assert.Len(t, rowsObjects, 2)
+		assertSnapshotsHaveDetails(t, want[0], rowsObjects[0])
+		assertSnapshotsHaveDetails(t, want[1], rowsObjects[1])
+	})
+	t.Run("text file without fixed-width mode - no objects to import", func(t *testing.T) {
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{Path: []string{"testdata/inventory.txt"}},
+			},
+			Type: pb.RpcObjectImportRequest_Csv,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Csv), converter.ErrNoObjectsToImport))
+	})
+}
+
+func Test_readFixedWidthTable(t *testing.T) {
+	t.Run("multi-word column names are not split", func(t *testing.T) {
+		table, err := readFixedWidthTable(strings.NewReader("First name  Age\nJohn Smith  42\n"), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, [][]string{{"First name", "Age"}, {"John Smith", "42"}}, table)
+	})
+	t.Run("non-positive width - return error", func(t *testing.T) {
+		_, err := readFixedWidthTable(strings.NewReader("Name Age\n"), []int32{4, 0})
+
+		assert.NotNil(t, err)
+	})
+}
+
+func getRowsObjects(snapshots []*converter.Snapshot) []*converter.Snapshot {
+	var rowsObjects []*converter.Snapshot
+	for _, snapshot := range snapshots {
+		// only objects created from rows
+		if snapshot.SbType != sb.SmartBlockTypeRelation &&
+			!lo.Contains(snapshot.Snapshot.Data.ObjectTypes, bundle.TypeKeyCollection.String()) {
+			rowsObjects = append(rowsObjects, snapshot)
+		}
+	}
+	return rowsObjects
+}
+
 func TestCsv_GetSnapshotsTableModeDifferentColumnsNumber(t *testing.T) {
 	t.Run("test different columns number in file - table mode", func(t *testing.T) {
 		// given
@@ -752,3 +879,136 @@ func getRelationsNumber(keys []string) int {
 		return item != bundle.RelationKeySourceFilePath.String() && item != bundle.RelationKeyLayout.String()
 	})
 }
+
+func TestCsv_GetSnapshotsObjectType(t *testing.T) {
+	getRequest := func(objectTypeKey string) *pb.RpcObjectImportRequest {
+		return &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{
+					Path:                    []string{"testdata/Journal.csv"},
+					UseFirstRowForRelations: true,
+				},
+			},
+			Type:          pb.RpcObjectImportRequest_Csv,
+			Mode:          pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			ObjectTypeKey: objectTypeKey,
+		}
+	}
+	getRowTypes := func(sn *converter.Response) []string {
+		rows := pbtypes.GetStringList(sn.Snapshots[0].Snapshot.Data.Collections, template.CollectionStoreKey)
+		var objectTypes []string
+		for _, snapshot := range sn.Snapshots {
+			if lo.Contains(rows, snapshot.Id) {
+				objectTypes = append(objectTypes, snapshot.Snapshot.Data.ObjectTypes...)
+			}
+		}
+		return objectTypes
+	}
+	t.Run("rows are imported with default object type of converter", func(t *testing.T) {
+		// given
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := csv.GetSnapshots(context.Background(), getRequest(""), p)
+
+		// then
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		assert.Equal(t, []string{defaultRowObjectType.String(), defaultRowObjectType.String()}, getRowTypes(sn))
+	})
+	t.Run("object type from request overrides default object type", func(t *testing.T) {
+		// given
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := csv.GetSnapshots(context.Background(), getRequest(bundle.TypeKeyTask.String()), p)
+
+		// then
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		assert.Equal(t, []string{bundle.TypeKeyTask.String(), bundle.TypeKeyTask.String()}, getRowTypes(sn))
+		assert.Equal(t, bundle.TypeKeyCollection.String(), sn.Snapshots[0].Snapshot.Data.ObjectTypes[0])
+	})
+}
+
+func TestCsv_GetSnapshotsTypedColumns(t *testing.T) {
+	t.Run("formats of relations are inferred from values", func(t *testing.T) {
+		// given
+		csv := CSV{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{
+					Path:                    []string{"testdata/typedcolumns.csv"},
+					Delimiter:               ",",
+					UseFirstRowForRelations: true,
+				},
+			},
+			Type: pb.RpcObjectImportRequest_Csv,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		// then
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		keys := map[string]string{}
+		formats := map[string]model.RelationFormat{}
+		for _, snapshot := range sn.Snapshots {
+			if snapshot.SbType == sb.SmartBlockTypeRelation {
+				details := snapshot.Snapshot.Data.Details
+				name := pbtypes.GetString(details, bundle.RelationKeyName.String())
+				keys[name] = pbtypes.GetString(details, bundle.RelationKeyRelationKey.String())
+				formats[name] = model.RelationFormat(pbtypes.GetInt64(details, bundle.RelationKeyRelationFormat.String()))
+			}
+		}
+		assert.Equal(t, map[string]model.RelationFormat{
+			"Price": model.RelationFormat_number,
+			"Done":  model.RelationFormat_checkbox,
+			"Due":   model.RelationFormat_date,
+			"Notes": model.RelationFormat_longtext,
+		}, formats)
+
+		rowsObjects := getRowsObjects(sn.Snapshots)
+		require.Len(t, rowsObjects, 3)
+		apple, pear := rowsObjects[0].Snapshot.Data.Details, rowsObjects[1].Snapshot.Data.Details
+		assert.Equal(t, 1.5, pbtypes.GetFloat64(apple, keys["Price"]))
+		assert.True(t, pbtypes.GetBool(apple, keys["Done"]))
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(apple, keys["Due"]))
+		assert.Equal(t, "fresh", pbtypes.GetString(apple, keys["Notes"]))
+		assert.NotContains(t, pear.Fields, keys["Price"])
+		assert.False(t, pbtypes.GetBool(pear, keys["Done"]))
+	})
+}
+
+func Test_inferRelationFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		values []string
+		want   model.RelationFormat
+	}{
+		{"numbers", []string{"1", "-2.5", " 3e2 "}, model.RelationFormat_number},
+		{"checkboxes", []string{"Yes", "no", "false"}, model.RelationFormat_checkbox},
+		{"dates", []string{"2024-03-01", "2024-03-01T10:00:00Z"}, model.RelationFormat_date},
+		{"mixed values", []string{"1", "yes"}, model.RelationFormat_longtext},
+		{"not a number", []string{"NaN"}, model.RelationFormat_longtext},
+		{"empty column", []string{"", " "}, model.RelationFormat_longtext},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			table := [][]string{{"Name", "Column"}}
+			for _, v := range tc.values {
+				table = append(table, []string{"object", v})
+			}
+
+			// when
+			format := inferRelationFormat(table, 1, true)
+
+			// then
+			assert.Equal(t, tc.want, format)
+		})
+	}
+}
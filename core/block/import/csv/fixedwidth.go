@@ -0,0 +1,104 @@
+package csv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const tsvExtension = ".tsv"
+
+// fixedWidthExtensions are extensions of files, which are imported as fixed-width tables in fixed-width mode
+var fixedWidthExtensions = []string{".txt", ".prn", ".dat"}
+
+func isFixedWidthMode(params *pb.RpcObjectImportRequestCsvParams) bool {
+	return params.GetFixedWidth() || len(params.GetColumnWidths()) > 0
+}
+
+func supportedExtensions(params *pb.RpcObjectImportRequestCsvParams) []string {
+	extensions := []string{".csv", tsvExtension}
+	if isFixedWidthMode(params) {
+		extensions = append(extensions, fixedWidthExtensions...)
+	}
+	return extensions
+}
+
+// readFixedWidthTable splits lines of the file into columns of given widths. The last column contains the rest
+// of the line. If widths are not set, columns are inferred from the header
+func readFixedWidthTable(r io.Reader, widths []int32) ([][]string, error) {
+	var lines [][]rune
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRightFunc(scanner.Text(), unicode.IsSpace)
+		if line != "" {
+			lines = append(lines, []rune(line))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	starts, err := columnStarts(widths)
+	if err != nil {
+		return nil, err
+	}
+	if len(starts) == 0 && len(lines) != 0 {
+		starts = inferColumnStarts(lines)
+	}
+	table := make([][]string, 0, len(lines))
+	for _, line := range lines {
+		row := make([]string, len(starts))
+		for i, start := range starts {
+			end := len(line)
+			if i+1 < len(starts) && starts[i+1] < end {
+				end = starts[i+1]
+			}
+			if start < end {
+				row[i] = strings.TrimSpace(string(line[start:end]))
+			}
+		}
+		table = append(table, row)
+	}
+	return table, nil
+}
+
+func columnStarts(widths []int32) ([]int, error) {
+	starts := make([]int, 0, len(widths))
+	var start int
+	for _, width := range widths {
+		if width <= 0 {
+			return nil, fmt.Errorf("width of column should be positive, got %d", width)
+		}
+		starts = append(starts, start)
+		start += int(width)
+	}
+	return starts, nil
+}
+
+// inferColumnStarts returns positions of header words, which are preceded by space in all lines,
+// so words of multi-word column names are not split into different columns
+func inferColumnStarts(lines [][]rune) []int {
+	header := lines[0]
+	starts := []int{0}
+	for i := 1; i < len(header); i++ {
+		if unicode.IsSpace(header[i]) || !unicode.IsSpace(header[i-1]) {
+			continue
+		}
+		if isSpaceInAllLines(lines, i-1) {
+			starts = append(starts, i)
+		}
+	}
+	return starts
+}
+
+func isSpaceInAllLines(lines [][]rune, pos int) bool {
+	for _, line := range lines {
+		if pos < len(line) && !unicode.IsSpace(line[pos]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package csv
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var dateLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// inferRelationFormat returns the format, which all non-empty values of the column can be converted to.
+// Columns without values or with values of different kinds are imported as text
+func inferRelationFormat(csvTable [][]string, column int, skipFirstRow bool) model.RelationFormat {
+	candidates := map[model.RelationFormat]bool{
+		model.RelationFormat_checkbox: true,
+		model.RelationFormat_number:   true,
+		model.RelationFormat_date:     true,
+	}
+	var hasValues bool
+	for i, row := range csvTable {
+		if (i == 0 && skipFirstRow) || len(row) <= column {
+			continue
+		}
+		value := strings.TrimSpace(row[column])
+		if value == "" {
+			continue
+		}
+		hasValues = true
+		for format := range candidates {
+			if _, ok := parseValue(value, format); !ok {
+				delete(candidates, format)
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+	}
+	if !hasValues {
+		return model.RelationFormat_longtext
+	}
+	// checkbox and number values don't intersect, dates never look like them
+	for _, format := range []model.RelationFormat{model.RelationFormat_checkbox, model.RelationFormat_number, model.RelationFormat_date} {
+		if candidates[format] {
+			return format
+		}
+	}
+	return model.RelationFormat_longtext
+}
+
+// getDetailValue converts value of the cell to the value of relation with given format.
+// It returns nil for empty cells of not text relations
+func getDetailValue(value string, format model.RelationFormat) *types.Value {
+	switch format {
+	case model.RelationFormat_checkbox, model.RelationFormat_number, model.RelationFormat_date:
+		v, ok := parseValue(strings.TrimSpace(value), format)
+		if !ok {
+			return nil
+		}
+		return v
+	default:
+		return pbtypes.String(value)
+	}
+}
+
+func parseValue(value string, format model.RelationFormat) (*types.Value, bool) {
+	switch format {
+	case model.RelationFormat_checkbox:
+		switch strings.ToLower(value) {
+		case "true", "yes":
+			return pbtypes.Bool(true), true
+		case "false", "no":
+			return pbtypes.Bool(false), true
+		}
+	case model.RelationFormat_number:
+		if f, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return pbtypes.Float64(f), true
+		}
+	case model.RelationFormat_date:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return pbtypes.Int64(t.Unix()), true
+			}
+		}
+	}
+	return nil, false
+}
@@ -9,17 +9,17 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/pb"
-	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
 type TableStrategy struct {
 	tableEditor te.TableEditor
+	objectType  string
 }
 
-func NewTableStrategy(tableEditor te.TableEditor) *TableStrategy {
-	return &TableStrategy{tableEditor: tableEditor}
+func NewTableStrategy(tableEditor te.TableEditor, objectType string) *TableStrategy {
+	return &TableStrategy{tableEditor: tableEditor, objectType: objectType}
 }
 
 func (c *TableStrategy) CreateObjects(path string, csvTable [][]string, params *pb.RpcObjectImportRequestCsvParams, progress process.Progress) (string, []*converter.Snapshot, error) {
@@ -42,7 +42,7 @@ func (c *TableStrategy) CreateObjects(path string, csvTable [][]string, params *
 	sn := &model.SmartBlockSnapshotBase{
 		Blocks:        st.Blocks(),
 		Details:       details,
-		ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+		ObjectTypes:   []string{c.objectType},
 		Collections:   st.Store(),
 		RelationLinks: st.GetRelationLinks(),
 	}
@@ -0,0 +1,123 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// setContentHashes stores hash of content of imported pages in their details, so the next import of the same
+// files can find objects, which were already imported
+func setContentHashes(res *converter.Response) {
+	for _, sn := range res.Snapshots {
+		data := sn.Snapshot.GetData()
+		if sn.SbType != smartblock.SmartBlockTypePage || data == nil {
+			continue
+		}
+		if pbtypes.GetString(data.Details, bundle.RelationKeySourceFilePath.String()) == "" {
+			continue
+		}
+		if data.Details == nil || data.Details.Fields == nil {
+			data.Details = &types.Struct{Fields: map[string]*types.Value{}}
+		}
+		data.Details.Fields[bundle.RelationKeyImportContentHash.String()] = pbtypes.String(contentHash(data))
+	}
+}
+
+// contentHash returns hash of name and blocks of the object. Ids of blocks and links are generated by converters
+// on each import, so they are not taken into account
+func contentHash(data *model.SmartBlockSnapshotBase) string {
+	h := sha256.New()
+	h.Write([]byte(pbtypes.GetString(data.Details, bundle.RelationKeyName.String())))
+	for _, b := range data.Blocks {
+		h.Write([]byte{0})
+		switch content := b.Content.(type) {
+		case *model.BlockContentOfText:
+			fmt.Fprintf(h, "text:%d:%t:%s", content.Text.Style, content.Text.Checked, content.Text.Text)
+		case *model.BlockContentOfFile:
+			fmt.Fprintf(h, "file:%s", filepath.Base(content.File.Name))
+		case *model.BlockContentOfBookmark:
+			fmt.Fprintf(h, "bookmark:%s", content.Bookmark.Url)
+		case *model.BlockContentOfLatex:
+			fmt.Fprintf(h, "latex:%s", content.Latex.Text)
+		default:
+			fmt.Fprintf(h, "%T", b.Content)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// resolveDuplicates replaces ids of imported objects, which have the same source and content hash as existing objects,
+// with ids of existing objects. With Skip strategy such objects are removed from the response, with Merge strategy
+// existing objects are updated with imported content
+func (i *Import) resolveDuplicates(
+	res *converter.Response,
+	req *pb.RpcObjectImportRequest,
+	oldIDToNew map[string]string,
+	createPayloads map[string]treestorage.TreeStorageCreatePayload,
+) {
+	if req.DuplicateStrategy == pb.RpcObjectImportRequest_DUPLICATE || i.objectStore == nil {
+		return
+	}
+	snapshots := make([]*converter.Snapshot, 0, len(res.Snapshots))
+	for _, sn := range res.Snapshots {
+		existingID := i.getDuplicateObject(req.SpaceId, sn)
+		if existingID == "" {
+			snapshots = append(snapshots, sn)
+			continue
+		}
+		delete(createPayloads, oldIDToNew[sn.Id])
+		oldIDToNew[sn.Id] = existingID
+		if req.DuplicateStrategy == pb.RpcObjectImportRequest_MERGE {
+			snapshots = append(snapshots, sn)
+		}
+	}
+	res.Snapshots = snapshots
+}
+
+func (i *Import) getDuplicateObject(spaceID string, sn *converter.Snapshot) string {
+	details := sn.Snapshot.GetData().GetDetails()
+	hash := pbtypes.GetString(details, bundle.RelationKeyImportContentHash.String())
+	if hash == "" {
+		return ""
+	}
+	ids, _, err := i.objectStore.QueryObjectIDs(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeySourceFilePath.String(),
+				Value:       pbtypes.String(pbtypes.GetString(details, bundle.RelationKeySourceFilePath.String())),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyImportContentHash.String(),
+				Value:       pbtypes.String(hash),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeySpaceId.String(),
+				Value:       pbtypes.String(spaceID),
+			},
+		},
+	})
+	if err != nil {
+		log.Errorf("failed to query duplicates of imported object: %s", err)
+		return ""
+	}
+	if len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
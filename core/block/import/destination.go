@@ -0,0 +1,165 @@
+package importer
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// templateDetailsToSkip are details of the template, which describe the template itself and aren't copied to pages
+var templateDetailsToSkip = []string{
+	bundle.RelationKeyId.String(),
+	bundle.RelationKeyName.String(),
+	bundle.RelationKeyType.String(),
+	bundle.RelationKeyLayout.String(),
+	bundle.RelationKeyTargetObjectType.String(),
+	bundle.RelationKeyTemplateIsBundled.String(),
+	bundle.RelationKeySourceObject.String(),
+	bundle.RelationKeyInternalFlags.String(),
+}
+
+// templateStateProvider returns state of new object, created from the template
+type templateStateProvider interface {
+	StateFromTemplate(templateID, name string) (*state.State, error)
+}
+
+// collectionAdder adds objects to existing collection
+type collectionAdder interface {
+	Add(ctx session.Context, req *pb.RpcObjectCollectionAddRequest) error
+}
+
+// takeRootCollection removes root collection from snapshots, if objects are imported to existing collection,
+// and returns objects of the root collection
+func takeRootCollection(req *pb.RpcObjectImportRequest, res *converter.Response) []string {
+	if req.GetCollectionId() == "" || res.RootCollectionID == "" {
+		return nil
+	}
+	var objects []string
+	snapshots := make([]*converter.Snapshot, 0, len(res.Snapshots))
+	for _, sn := range res.Snapshots {
+		if sn.Id == res.RootCollectionID {
+			objects = pbtypes.GetStringList(sn.Snapshot.Data.Collections, template.CollectionStoreKey)
+			continue
+		}
+		snapshots = append(snapshots, sn)
+	}
+	res.Snapshots = snapshots
+	res.RootCollectionID = ""
+	return objects
+}
+
+// addToCollection adds created objects to the collection from request
+func (i *Import) addToCollection(req *pb.RpcObjectImportRequest, objects []string, oldIDToNew map[string]string) error {
+	ids := make([]string, 0, len(objects))
+	for _, id := range objects {
+		if newID, ok := oldIDToNew[id]; ok {
+			ids = append(ids, newID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	err := i.collections.Add(nil, &pb.RpcObjectCollectionAddRequest{ContextId: req.GetCollectionId(), ObjectIds: ids})
+	if err != nil {
+		return fmt.Errorf("add objects to collection %s: %w", req.GetCollectionId(), err)
+	}
+	return nil
+}
+
+// applyTemplate adds blocks and details of the template from request to imported pages. Details, which are already
+// set by converter, are kept
+func (i *Import) applyTemplate(req *pb.RpcObjectImportRequest, res *converter.Response) error {
+	if req.GetTemplateId() == "" {
+		return nil
+	}
+	st, err := i.templates.StateFromTemplate(req.GetTemplateId(), "")
+	if err != nil {
+		return fmt.Errorf("get template %s: %w", req.GetTemplateId(), err)
+	}
+	blocks, topIDs := templateBlocks(st)
+	for _, sn := range res.Snapshots {
+		if !isTemplateTarget(sn, res.RootCollectionID) {
+			continue
+		}
+		addTemplateBlocks(sn.Snapshot.Data, blocks, topIDs)
+		addTemplateDetails(sn.Snapshot.Data, st)
+	}
+	return nil
+}
+
+func isTemplateTarget(sn *converter.Snapshot, rootCollectionID string) bool {
+	if sn.Id == rootCollectionID || sn.SbType != smartblock.SmartBlockTypePage {
+		return false
+	}
+	layout := model.ObjectTypeLayout(pbtypes.GetInt64(sn.Snapshot.Data.Details, bundle.RelationKeyLayout.String()))
+	return layout != model.ObjectType_collection && layout != model.ObjectType_set
+}
+
+// templateBlocks returns blocks of the template body, header with title and description is skipped,
+// because pages have their own
+func templateBlocks(st *state.State) (blocks []*model.Block, topIDs []string) {
+	var collect func(id string)
+	collect = func(id string) {
+		b := st.Pick(id)
+		if b == nil {
+			return
+		}
+		blocks = append(blocks, b.Model())
+		for _, childID := range b.Model().ChildrenIds {
+			collect(childID)
+		}
+	}
+	for _, id := range st.Pick(st.RootId()).Model().ChildrenIds {
+		if id == template.HeaderLayoutId {
+			continue
+		}
+		topIDs = append(topIDs, id)
+		collect(id)
+	}
+	return blocks, topIDs
+}
+
+// addTemplateBlocks puts blocks of the template before the content of the page
+func addTemplateBlocks(data *model.SmartBlockSnapshotBase, blocks []*model.Block, topIDs []string) {
+	if len(blocks) == 0 {
+		return
+	}
+	copied := make([]*model.Block, 0, len(blocks)+len(data.Blocks))
+	for _, b := range blocks {
+		copied = append(copied, pbtypes.CopyBlock(b))
+	}
+	for _, b := range data.Blocks {
+		if _, ok := b.Content.(*model.BlockContentOfSmartblock); ok {
+			b.ChildrenIds = append(slice.Copy(topIDs), b.ChildrenIds...)
+		}
+	}
+	data.Blocks = append(copied, data.Blocks...)
+}
+
+func addTemplateDetails(data *model.SmartBlockSnapshotBase, st *state.State) {
+	if data.Details == nil || data.Details.Fields == nil {
+		data.Details = &types.Struct{Fields: map[string]*types.Value{}}
+	}
+	relationLinks := pbtypes.RelationLinks(data.RelationLinks)
+	for key, value := range st.Details().GetFields() {
+		if _, exists := data.Details.Fields[key]; exists || slice.FindPos(templateDetailsToSkip, key) >= 0 {
+			continue
+		}
+		data.Details.Fields[key] = pbtypes.CopyVal(value)
+		if link := st.GetRelationLinks().Get(key); link != nil && !relationLinks.Has(key) {
+			relationLinks = relationLinks.Append(link)
+		}
+	}
+	data.RelationLinks = relationLinks
+}
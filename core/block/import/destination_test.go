@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type templateStateProviderFixture struct {
+	st *state.State
+}
+
+func (f *templateStateProviderFixture) StateFromTemplate(string, string) (*state.State, error) {
+	return f.st, nil
+}
+
+type collectionAdderFixture struct {
+	req *pb.RpcObjectCollectionAddRequest
+}
+
+func (f *collectionAdderFixture) Add(_ session.Context, req *pb.RpcObjectCollectionAddRequest) error {
+	f.req = req
+	return nil
+}
+
+func TestTakeRootCollection(t *testing.T) {
+	t.Run("root collection is removed, if objects are imported to existing collection", func(t *testing.T) {
+		// given
+		req := &pb.RpcObjectImportRequest{CollectionId: "collection"}
+		res := &converter.Response{
+			RootCollectionID: "root",
+			Snapshots: []*converter.Snapshot{
+				{Id: "page", Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}}},
+				{Id: "root", Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+					Collections: &types.Struct{Fields: map[string]*types.Value{
+						template.CollectionStoreKey: pbtypes.StringList([]string{"page"}),
+					}},
+				}}},
+			},
+		}
+
+		// when
+		objects := takeRootCollection(req, res)
+
+		// then
+		assert.Equal(t, []string{"page"}, objects)
+		assert.Empty(t, res.RootCollectionID)
+		require.Len(t, res.Snapshots, 1)
+		assert.Equal(t, "page", res.Snapshots[0].Id)
+	})
+	t.Run("root collection is kept without collection in request", func(t *testing.T) {
+		// given
+		res := &converter.Response{
+			RootCollectionID: "root",
+			Snapshots:        []*converter.Snapshot{{Id: "root"}},
+		}
+
+		// when
+		objects := takeRootCollection(&pb.RpcObjectImportRequest{}, res)
+
+		// then
+		assert.Empty(t, objects)
+		assert.Equal(t, "root", res.RootCollectionID)
+		assert.Len(t, res.Snapshots, 1)
+	})
+}
+
+func TestImport_addToCollection(t *testing.T) {
+	// given
+	adder := &collectionAdderFixture{}
+	i := &Import{collections: adder}
+	req := &pb.RpcObjectImportRequest{CollectionId: "collection"}
+
+	// when
+	err := i.addToCollection(req, []string{"page1", "page2", "failed"}, map[string]string{"page1": "new1", "page2": "new2"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "collection", adder.req.ContextId)
+	assert.Equal(t, []string{"new1", "new2"}, adder.req.ObjectIds)
+}
+
+func TestImport_applyTemplate(t *testing.T) {
+	// given
+	st := state.NewDoc("template", map[string]simple.Block{
+		"template":              simple.New(&model.Block{Id: "template", ChildrenIds: []string{template.HeaderLayoutId, "checklist"}}),
+		template.HeaderLayoutId: simple.New(&model.Block{Id: template.HeaderLayoutId, ChildrenIds: []string{"title"}}),
+		"title": simple.New(&model.Block{Id: "title", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Style: model.BlockContentText_Title,
+		}}}),
+		"checklist": simple.New(&model.Block{Id: "checklist", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "review",
+			Style: model.BlockContentText_Checkbox,
+		}}}),
+	}).(*state.State)
+	st.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Meeting template"))
+	st.SetDetail(bundle.RelationKeyIconEmoji.String(), pbtypes.String("📅"))
+	st.SetDetail(bundle.RelationKeyCoverId.String(), pbtypes.String("blue"))
+	st.AddRelationLinks(&model.RelationLink{Key: bundle.RelationKeyCoverId.String(), Format: model.RelationFormat_shorttext})
+	i := &Import{templates: &templateStateProviderFixture{st: st}}
+	page := &converter.Snapshot{
+		Id:     "page",
+		SbType: smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks: []*model.Block{{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "notes"}}}},
+			Details: &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():      pbtypes.String("notes"),
+				bundle.RelationKeyIconEmoji.String(): pbtypes.String("📄"),
+			}},
+		}},
+	}
+	collection := &converter.Snapshot{
+		Id:     "root",
+		SbType: smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details: &types.Struct{Fields: map[string]*types.Value{}},
+		}},
+	}
+	res := &converter.Response{RootCollectionID: "root", Snapshots: []*converter.Snapshot{page, collection}}
+
+	// when
+	err := i.applyTemplate(&pb.RpcObjectImportRequest{TemplateId: "template"}, res)
+
+	// then
+	require.NoError(t, err)
+	data := page.Snapshot.Data
+	require.Len(t, data.Blocks, 2)
+	assert.Equal(t, "checklist", data.Blocks[0].Id)
+	assert.Equal(t, "text", data.Blocks[1].Id)
+	assert.Equal(t, "notes", pbtypes.GetString(data.Details, bundle.RelationKeyName.String()))
+	assert.Equal(t, "📄", pbtypes.GetString(data.Details, bundle.RelationKeyIconEmoji.String()))
+	assert.Equal(t, "blue", pbtypes.GetString(data.Details, bundle.RelationKeyCoverId.String()))
+	assert.True(t, pbtypes.RelationLinks(data.RelationLinks).Has(bundle.RelationKeyCoverId.String()))
+	assert.Empty(t, collection.Snapshot.Data.Blocks)
+}
@@ -0,0 +1,285 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// sniffLen is the number of bytes from the beginning of file, which are used to detect format
+const sniffLen = 512
+
+// formatSignature describes how files of import type are recognized. Content matcher is checked before extension,
+// and the content match is considered as clear evidence of format
+type formatSignature struct {
+	importType pb.RpcObjectImportRequestType
+	extensions []string
+	content    func(head []byte) bool
+}
+
+var formatSignatures = []formatSignature{
+	{
+		importType: pb.RpcObjectImportRequest_AppleNotes,
+		extensions: []string{".sqlite"},
+		content: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte("SQLite format 3\x00"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_Html,
+		extensions: []string{".html", ".htm"},
+		content: func(head []byte) bool {
+			head = bytes.ToLower(bytes.TrimSpace(head))
+			return bytes.HasPrefix(head, []byte("<!doctype html")) || bytes.HasPrefix(head, []byte("<html"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_Opml,
+		extensions: []string{".opml"},
+		content: func(head []byte) bool {
+			return bytes.Contains(bytes.ToLower(head), []byte("<opml"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_Epub,
+		extensions: []string{".epub"},
+		content: func(head []byte) bool {
+			// the first file of the book archive is uncompressed mimetype
+			return bytes.HasPrefix(head, []byte("PK\x03\x04")) && bytes.Contains(head, []byte("application/epub+zip"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_Enex,
+		extensions: []string{".enex"},
+		content: func(head []byte) bool {
+			return bytes.Contains(head, []byte("<en-export"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_OneNote,
+		extensions: []string{".docx"},
+		content: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte("PK\x03\x04")) && bytes.Contains(head, []byte("word/"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_VCard,
+		extensions: []string{".vcf", ".vcard"},
+		content: func(head []byte) bool {
+			return bytes.HasPrefix(bytes.ToUpper(bytes.TrimSpace(head)), []byte("BEGIN:VCARD"))
+		},
+	},
+	{
+		importType: pb.RpcObjectImportRequest_ICalendar,
+		extensions: []string{".ics"},
+		content: func(head []byte) bool {
+			return bytes.HasPrefix(bytes.ToUpper(bytes.TrimSpace(head)), []byte("BEGIN:VCALENDAR"))
+		},
+	},
+	{importType: pb.RpcObjectImportRequest_Markdown, extensions: []string{".md", ".markdown"}},
+	{importType: pb.RpcObjectImportRequest_Csv, extensions: []string{".csv", ".tsv"}},
+	{importType: pb.RpcObjectImportRequest_Txt, extensions: []string{".txt"}},
+	{importType: pb.RpcObjectImportRequest_Pb, extensions: []string{".pb"}},
+	{importType: pb.RpcObjectImportRequest_Jsonl, extensions: []string{".jsonl", ".ndjson"}},
+	{importType: pb.RpcObjectImportRequest_Org, extensions: []string{".org"}},
+	{importType: pb.RpcObjectImportRequest_Joplin, extensions: []string{".jex"}},
+}
+
+// exportExtensions are extensions of archives, which are made by one application. All files inside
+// such archive have format of the application, e.g. Markdown notes and images of Joplin export
+var exportExtensions = map[string]pb.RpcObjectImportRequestType{
+	".jex": pb.RpcObjectImportRequest_Joplin,
+}
+
+// resolveAutoImport replaces Auto type of request with the type from format hint or with the detected type
+// and moves paths to params of this type
+func resolveAutoImport(req *pb.RpcObjectImportRequest) error {
+	params := req.GetAutoParams()
+	if params == nil || len(params.Path) == 0 {
+		return fmt.Errorf("paths for import with format detection are not set")
+	}
+	var (
+		importType pb.RpcObjectImportRequestType
+		err        error
+	)
+	if params.FormatHint != "" {
+		importType, err = checkFormatHint(params.FormatHint, params.Path)
+	} else {
+		importType, err = detectFormat(params.Path)
+	}
+	if err != nil {
+		return err
+	}
+	typeParams, err := getPathParams(importType, params.Path)
+	if err != nil {
+		return err
+	}
+	req.Type = importType
+	req.Params = typeParams
+	return nil
+}
+
+// checkFormatHint returns import type from the hint without detection. It only fails, if some file clearly
+// has another format, e.g. database is imported as Markdown
+func checkFormatHint(hint string, paths []string) (pb.RpcObjectImportRequestType, error) {
+	value, ok := pb.RpcObjectImportRequestType_value[hint]
+	if !ok || pb.RpcObjectImportRequestType(value) == pb.RpcObjectImportRequest_Auto {
+		return 0, fmt.Errorf("unknown format hint %s", hint)
+	}
+	importType := pb.RpcObjectImportRequestType(value)
+	paths = lo.Filter(paths, func(path string, _ int) bool {
+		exportType, ok := exportExtensions[strings.ToLower(filepath.Ext(path))]
+		return !ok || exportType != importType
+	})
+	var mismatchErr error
+	err := iterateHeads(paths, func(fileName string, head []byte) bool {
+		for _, s := range formatSignatures {
+			if s.importType != importType && s.content != nil && s.content(head) {
+				mismatchErr = fmt.Errorf("file %s looks like %s, not %s", fileName, s.importType, importType)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	return importType, mismatchErr
+}
+
+// detectFormat returns the type, which matches the most files. Files of unknown format, like images, are ignored
+func detectFormat(paths []string) (pb.RpcObjectImportRequestType, error) {
+	votes := make(map[pb.RpcObjectImportRequestType]int)
+	for _, path := range paths {
+		exportType, isExport := exportExtensions[strings.ToLower(filepath.Ext(path))]
+		err := iterateHeads([]string{path}, func(fileName string, head []byte) bool {
+			if isExport {
+				votes[exportType]++
+			} else if s := matchSignature(fileName, head); s != nil {
+				votes[s.importType]++
+			}
+			return true
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+	var (
+		detected pb.RpcObjectImportRequestType
+		maxVotes int
+	)
+	// signatures order breaks ties
+	for _, s := range formatSignatures {
+		if votes[s.importType] > maxVotes {
+			detected, maxVotes = s.importType, votes[s.importType]
+		}
+	}
+	if maxVotes == 0 {
+		return 0, fmt.Errorf("failed to detect format of files to import")
+	}
+	return detected, nil
+}
+
+func matchSignature(fileName string, head []byte) *formatSignature {
+	for i := range formatSignatures {
+		if s := &formatSignatures[i]; s.content != nil && s.content(head) {
+			return s
+		}
+	}
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for i := range formatSignatures {
+		for _, e := range formatSignatures[i].extensions {
+			if e == ext {
+				return &formatSignatures[i]
+			}
+		}
+	}
+	return nil
+}
+
+func iterateHeads(paths []string, callback func(fileName string, head []byte) bool) error {
+	for _, path := range paths {
+		isContinue := true
+		err := iterateSource(path, func(fileName string, fileReader io.ReadCloser) bool {
+			head := make([]byte, sniffLen)
+			n, err := io.ReadFull(fileReader, head)
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				return true
+			}
+			isContinue = callback(fileName, head[:n])
+			return isContinue
+		})
+		if err != nil {
+			return fmt.Errorf("read files of %s: %w", path, err)
+		}
+		if !isContinue {
+			return nil
+		}
+	}
+	return nil
+}
+
+func iterateSource(path string, callback func(fileName string, fileReader io.ReadCloser) bool) error {
+	s := source.GetSource(path)
+	defer s.Close()
+	if err := s.Initialize(path); err != nil {
+		return err
+	}
+	return s.Iterate(callback)
+}
+
+func getPathParams(importType pb.RpcObjectImportRequestType, paths []string) (pb.IsRpcObjectImportRequestParams, error) {
+	switch importType {
+	case pb.RpcObjectImportRequest_Markdown:
+		return &pb.RpcObjectImportRequestParamsOfMarkdownParams{MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Html:
+		return &pb.RpcObjectImportRequestParamsOfHtmlParams{HtmlParams: &pb.RpcObjectImportRequestHtmlParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Txt:
+		return &pb.RpcObjectImportRequestParamsOfTxtParams{TxtParams: &pb.RpcObjectImportRequestTxtParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Pb:
+		return &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Csv:
+		return &pb.RpcObjectImportRequestParamsOfCsvParams{CsvParams: &pb.RpcObjectImportRequestCsvParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Nextcloud:
+		return &pb.RpcObjectImportRequestParamsOfNextcloudParams{NextcloudParams: &pb.RpcObjectImportRequestNextcloudParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Trilium:
+		return &pb.RpcObjectImportRequestParamsOfTriliumParams{TriliumParams: &pb.RpcObjectImportRequestTriliumParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Quiver:
+		return &pb.RpcObjectImportRequestParamsOfQuiverParams{QuiverParams: &pb.RpcObjectImportRequestQuiverParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Gtd:
+		return &pb.RpcObjectImportRequestParamsOfGtdParams{GtdParams: &pb.RpcObjectImportRequestGtdParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_AppleNotes:
+		return &pb.RpcObjectImportRequestParamsOfAppleNotesParams{AppleNotesParams: &pb.RpcObjectImportRequestAppleNotesParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Atlassian:
+		return &pb.RpcObjectImportRequestParamsOfAtlassianParams{AtlassianParams: &pb.RpcObjectImportRequestAtlassianParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Jsonl:
+		return &pb.RpcObjectImportRequestParamsOfJsonlParams{JsonlParams: &pb.RpcObjectImportRequestJsonlParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Opml:
+		return &pb.RpcObjectImportRequestParamsOfOpmlParams{OpmlParams: &pb.RpcObjectImportRequestOpmlParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Epub:
+		return &pb.RpcObjectImportRequestParamsOfEpubParams{EpubParams: &pb.RpcObjectImportRequestEpubParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Enex:
+		return &pb.RpcObjectImportRequestParamsOfEnexParams{EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Roam:
+		return &pb.RpcObjectImportRequestParamsOfRoamParams{RoamParams: &pb.RpcObjectImportRequestRoamParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_OneNote:
+		return &pb.RpcObjectImportRequestParamsOfOneNoteParams{OneNoteParams: &pb.RpcObjectImportRequestOneNoteParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Org:
+		return &pb.RpcObjectImportRequestParamsOfOrgParams{OrgParams: &pb.RpcObjectImportRequestOrgParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_VCard:
+		return &pb.RpcObjectImportRequestParamsOfVCardParams{VCardParams: &pb.RpcObjectImportRequestVCardParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_ICalendar:
+		return &pb.RpcObjectImportRequestParamsOfICalendarParams{ICalendarParams: &pb.RpcObjectImportRequestICalendarParams{Path: paths}}, nil
+	case pb.RpcObjectImportRequest_Joplin:
+		return &pb.RpcObjectImportRequestParamsOfJoplinParams{JoplinParams: &pb.RpcObjectImportRequestJoplinParams{Path: paths}}, nil
+	default:
+		return nil, fmt.Errorf("import type %s doesn't support import from files", importType)
+	}
+}
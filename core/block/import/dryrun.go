@@ -0,0 +1,93 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// dryRun returns summary of snapshots of converter without creating objects
+func (i *Import) dryRun(ctx context.Context, res *converter.Response, req *pb.RpcObjectImportRequest) (*ImportResponse, error) {
+	objectsToOverwrite, err := i.getObjectsToOverwrite(ctx, res, req)
+	if err != nil {
+		return nil, err
+	}
+	summary := getDryRunSummary(res.Snapshots)
+	summary.Conflicts = objectsToOverwrite
+	return &ImportResponse{ObjectsToOverwrite: objectsToOverwrite, DryRunSummary: summary}, nil
+}
+
+// getDryRunSummary counts objects per object type, collects names of new relations and sums sizes of local files,
+// which are referenced from file blocks and file relations
+func getDryRunSummary(snapshots []*converter.Snapshot) *pb.RpcObjectImportResponseDryRunSummary {
+	summary := &pb.RpcObjectImportResponseDryRunSummary{}
+	typeCounts := make(map[string]int64)
+	files := make(map[string]struct{})
+	for _, sn := range snapshots {
+		data := sn.Snapshot.GetData()
+		if data == nil {
+			continue
+		}
+		typeCounts[snapshotObjectType(sn)]++
+		if sn.SbType == smartblock.SmartBlockTypeRelation {
+			summary.Relations = append(summary.Relations, pbtypes.GetString(data.Details, bundle.RelationKeyName.String()))
+		}
+		for _, b := range data.Blocks {
+			if file := b.GetFile(); file != nil && file.Hash == "" {
+				files[file.Name] = struct{}{}
+			}
+		}
+		for _, link := range data.RelationLinks {
+			if link.Format != model.RelationFormat_file {
+				continue
+			}
+			for _, name := range pbtypes.GetStringListValue(pbtypes.Get(data.Details, link.Key)) {
+				files[name] = struct{}{}
+			}
+		}
+	}
+	for objectType, count := range typeCounts {
+		summary.ObjectTypes = append(summary.ObjectTypes, &pb.RpcObjectImportResponseDryRunSummaryObjectTypeCount{
+			ObjectType: objectType,
+			Count:      count,
+		})
+	}
+	sort.Slice(summary.ObjectTypes, func(i, j int) bool {
+		return summary.ObjectTypes[i].ObjectType < summary.ObjectTypes[j].ObjectType
+	})
+	sort.Strings(summary.Relations)
+	summary.FilesSize = getFilesSize(files)
+	return summary
+}
+
+// snapshotObjectType returns key of object type of the snapshot. Snapshots without type, like root collection,
+// are counted by type of smartblock
+func snapshotObjectType(sn *converter.Snapshot) string {
+	if objectTypes := sn.Snapshot.Data.ObjectTypes; len(objectTypes) > 0 {
+		return objectTypes[0]
+	}
+	return sn.SbType.String()
+}
+
+// getFilesSize returns total size of local files. Remote files are downloaded only during import,
+// so their size is unknown
+func getFilesSize(files map[string]struct{}) int64 {
+	var size int64
+	for name := range files {
+		if name == "" || strings.HasPrefix(strings.ToLower(name), "http://") || strings.HasPrefix(strings.ToLower(name), "https://") {
+			continue
+		}
+		if info, err := os.Stat(name); err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+	}
+	return size
+}
@@ -0,0 +1,55 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// decryptArchivePaths replaces encrypted zip archives in paths of request with decrypted copies,
+// so converters import them like any other archive. Returned function removes decrypted copies
+func decryptArchivePaths(req *pb.RpcObjectImportRequest) (func(), error) {
+	var decrypted []string
+	cleanup := func() {
+		for _, path := range decrypted {
+			if err := os.Remove(path); err != nil {
+				log.Errorf("failed to remove decrypted archive: %s", err)
+			}
+		}
+	}
+	paths := requestPaths(req)
+	for idx, path := range paths {
+		if !strings.EqualFold(filepath.Ext(path), ".zip") {
+			continue
+		}
+		encrypted, err := source.IsEncryptedZip(path)
+		if err != nil || !encrypted {
+			// errors of broken archives are reported by converters
+			continue
+		}
+		decryptedPath, err := source.DecryptZip(path, req.GetPassword())
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		decrypted = append(decrypted, decryptedPath)
+		paths[idx] = decryptedPath
+	}
+	return cleanup, nil
+}
+
+// hasEncryptedArchive returns true, if paths of request contain encrypted zip archive
+func hasEncryptedArchive(req *pb.RpcObjectImportRequest) bool {
+	for _, path := range requestPaths(req) {
+		if !strings.EqualFold(filepath.Ext(path), ".zip") {
+			continue
+		}
+		if encrypted, err := source.IsEncryptedZip(path); err == nil && encrypted {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package enex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Enex"
+	rootCollectionName = "Evernote Import"
+	exportExtension    = ".enex"
+)
+
+var log = logging.Logger("import-enex")
+
+// ENEX imports Evernote export files. Notes become pages with dates, source URL and tags of the note,
+// resources of notes are imported as files
+type ENEX struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &ENEX{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		parseLimits:     converter.DefaultParseLimits,
+	}
+}
+
+func (e *ENEX) Name() string {
+	return Name
+}
+
+func (e *ENEX) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEnexParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (e *ENEX) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from Evernote notes")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := e.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(e.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (e *ENEX) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, e.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := e.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of notes and tags and list of notes, that should be added to the root collection
+func (e *ENEX) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Enex) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{exportExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	var tagSnapshots []*converter.Snapshot
+	relations := converter.NewRelationCreator(&tagSnapshots)
+	snapshots := make([]*converter.Snapshot, 0)
+	notes := make([]string, 0)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), exportExtension) {
+			return true
+		}
+		sn, err := e.getExportSnapshots(fileName, fileReader, objectType, limits, relations, allErrors)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Enex)
+		}
+		for _, s := range sn {
+			snapshots = append(snapshots, s)
+			notes = append(notes, s.Id)
+		}
+		return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Enex)
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	if len(notes) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return append(snapshots, tagSnapshots...), notes
+}
+
+// getExportSnapshots returns snapshots of notes of the export file. Notes, which can't be converted,
+// are reported to allErrors and skipped
+func (e *ENEX) getExportSnapshots(fileName string,
+	fileReader io.Reader,
+	objectType string,
+	limits converter.ParseLimits,
+	relations *converter.RelationCreator,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, error) {
+	exp, err := parseExport(fileReader, limits)
+	if err != nil {
+		return nil, err
+	}
+	assetsDir, err := os.MkdirTemp(e.tempDirProvider.TempDir(), "enex")
+	if err != nil {
+		return nil, oserror.TransformError(err)
+	}
+	snapshots := make([]*converter.Snapshot, 0, len(exp.Notes))
+	for i, n := range exp.Notes {
+		sn, err := getNoteSnapshot(n, fileName, i, objectType, assetsDir, relations)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			continue
+		}
+		snapshots = append(snapshots, sn)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,131 @@
+package enex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestENEX_GetSnapshots(t *testing.T) {
+	t.Run("notes are pages with dates, source and tags", func(t *testing.T) {
+		// given
+		e := &ENEX{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Enex, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		trip := convertertest.FindSnapshot(sn.Snapshots, "Trip notes", isNotOption)
+		groceries := convertertest.FindSnapshot(sn.Snapshots, "Groceries", isNotOption)
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName, isNotOption)
+		for _, s := range []*converter.Snapshot{trip, groceries, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{trip.Id, groceries.Id}, convertertest.CollectionObjects(root))
+
+		details := trip.Snapshot.Data.Details
+		assert.Equal(t, int64(1705311000), pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1705428000), pbtypes.GetInt64(details, bundle.RelationKeyLastModifiedDate.String()))
+		assert.Equal(t, "https://example.com/trip", pbtypes.GetString(details, bundle.RelationKeySource.String()))
+
+		tags := getTagNames(sn.Snapshots)
+		assert.Len(t, tags, 2)
+		tripTags := pbtypes.GetStringList(details, bundle.RelationKeyTag.String())
+		groceriesTags := pbtypes.GetStringList(groceries.Snapshot.Data.Details, bundle.RelationKeyTag.String())
+		require.Len(t, tripTags, 2)
+		assert.Equal(t, "travel", tags[tripTags[0]])
+		assert.Equal(t, "family", tags[tripTags[1]])
+		assert.Equal(t, []string{tripTags[0]}, groceriesTags)
+	})
+	t.Run("every note of the file has its own source path", func(t *testing.T) {
+		// given
+		e := &ENEX{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Enex, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		trip := convertertest.FindSnapshot(sn.Snapshots, "Trip notes", isNotOption)
+		groceries := convertertest.FindSnapshot(sn.Snapshots, "Groceries", isNotOption)
+		require.NotNil(t, trip)
+		require.NotNil(t, groceries)
+		tripPath := pbtypes.GetString(trip.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		groceriesPath := pbtypes.GetString(groceries.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		assert.NotEqual(t, tripPath, groceriesPath)
+		assert.Equal(t, filepath.Dir(tripPath), filepath.Dir(groceriesPath))
+	})
+	t.Run("resources are files in place of media and at the end of note", func(t *testing.T) {
+		// given
+		e := &ENEX{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Enex, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		trip := convertertest.FindSnapshot(sn.Snapshots, "Trip notes", isNotOption)
+		require.NotNil(t, trip)
+		var (
+			texts []string
+			files []*model.BlockContentFile
+		)
+		for _, block := range trip.Snapshot.Data.Blocks {
+			if block.GetText() != nil {
+				texts = append(texts, block.GetText().GetText())
+			}
+			if block.GetFile() != nil {
+				files = append(files, block.GetFile())
+			}
+		}
+		assert.Equal(t, []string{"Packing list", "See you there"}, texts)
+		require.Len(t, files, 2)
+		assert.Equal(t, model.BlockContentFile_Image, files[0].Type)
+		assert.Equal(t, "map.png", filepath.Base(files[0].Name))
+		assert.Equal(t, model.BlockContentFile_PDF, files[1].Type)
+		content, readErr := os.ReadFile(files[1].Name)
+		require.NoError(t, readErr)
+		assert.Equal(t, "%PDF-1.4 fake", string(content))
+	})
+}
+
+func TestParseTime(t *testing.T) {
+	assert.Equal(t, int64(1705311000), parseTime("20240115T093000Z"))
+	assert.Zero(t, parseTime(""))
+	assert.Zero(t, parseTime("2024-01-15"))
+}
+
+func getTagNames(snapshots []*converter.Snapshot) map[string]string {
+	tags := map[string]string{}
+	for _, sn := range snapshots {
+		if sn.SbType == smartblock.SmartBlockTypeRelationOption {
+			tags[sn.Id] = pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String())
+		}
+	}
+	return tags
+}
+
+// isNotOption skips tag options, which can have the same name as notes
+func isNotOption(sn *converter.Snapshot) bool {
+	return sn.SbType != smartblock.SmartBlockTypeRelationOption
+}
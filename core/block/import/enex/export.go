@@ -0,0 +1,68 @@
+package enex
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+const timeLayout = "20060102T150405Z"
+
+type export struct {
+	Notes []*note `xml:"note"`
+}
+
+type note struct {
+	Title     string      `xml:"title"`
+	Content   string      `xml:"content"`
+	Created   string      `xml:"created"`
+	Updated   string      `xml:"updated"`
+	Tags      []string    `xml:"tag"`
+	SourceURL string      `xml:"note-attributes>source-url"`
+	Resources []*resource `xml:"resource"`
+}
+
+type resource struct {
+	Data struct {
+		Encoding string `xml:"encoding,attr"`
+		Value    string `xml:",chardata"`
+	} `xml:"data"`
+	Mime     string `xml:"mime"`
+	FileName string `xml:"resource-attributes>file-name"`
+}
+
+func parseExport(r io.Reader, limits converter.ParseLimits) (*export, error) {
+	e := &export{}
+	if err := limits.DecodeXML(r, e); err != nil {
+		return nil, fmt.Errorf("parse enex: %w", err)
+	}
+	return e, nil
+}
+
+// parseTime returns unix time of the note's date or 0, if date is not set
+func parseTime(value string) int64 {
+	t, err := time.Parse(timeLayout, strings.TrimSpace(value))
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+// decode returns content of the resource and its hash, which is used in en-media tags to refer to the resource
+func (r *resource) decode() ([]byte, string, error) {
+	if r.Data.Encoding != "" && !strings.EqualFold(r.Data.Encoding, "base64") {
+		return nil, "", fmt.Errorf("unsupported encoding of resource: %s", r.Data.Encoding)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(r.Data.Value), ""))
+	if err != nil {
+		return nil, "", fmt.Errorf("decode resource: %w", err)
+	}
+	hash := md5.Sum(data)
+	return data, hex.EncodeToString(hash[:]), nil
+}
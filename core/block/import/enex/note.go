@@ -0,0 +1,196 @@
+package enex
+
+import (
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const mediaPlaceholderPrefix = "ENEXMEDIA"
+
+var (
+	enMediaRegexp = regexp.MustCompile(`(?is)<en-media\b([^>]*?)/?>(\s*</en-media>)?`)
+	hashRegexp    = regexp.MustCompile(`(?i)\bhash\s*=\s*"([0-9a-f]+)"`)
+	enNoteRegexp  = regexp.MustCompile(`(?i)<(/?)en-note\b`)
+)
+
+// getTagIDs returns ids of relation options of tags, so the same tag is shared between notes
+func getTagIDs(names []string, relations *converter.RelationCreator) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if id := relations.OptionID(bundle.RelationKeyTag.String(), name); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// getNoteSnapshot converts the note of export file with given index. Resources are extracted to assetsDir
+// and imported as files
+func getNoteSnapshot(n *note, fileName string, index int, objectType, assetsDir string, relations *converter.RelationCreator) (*converter.Snapshot, error) {
+	files, err := extractResources(n.Resources, assetsDir)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := getBlocks(n.Content, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert note %s: %w", n.Title, err)
+	}
+	// every note has its own source path, so notes are matched to their objects, when the file is imported again
+	sourcePath := fmt.Sprintf("%s/%d", fileName, index+1)
+	details := converter.GetCommonDetails(sourcePath, strings.TrimSpace(n.Title), "", model.ObjectType_basic)
+	var relationLinks []*model.RelationLink
+	if created := parseTime(n.Created); created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if updated := parseTime(n.Updated); updated != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(updated)
+	}
+	if sourceURL := strings.TrimSpace(n.SourceURL); sourceURL != "" {
+		details.Fields[bundle.RelationKeySource.String()] = pbtypes.String(sourceURL)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeySource.String(),
+			Format: model.RelationFormat_url,
+		})
+	}
+	if tagIDs := getTagIDs(n.Tags, relations); len(tagIDs) != 0 {
+		details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	return &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{objectType},
+		}},
+	}, nil
+}
+
+type resourceFile struct {
+	hash     string
+	path     string
+	mimeType string
+	// referenced is set, when the resource is shown in the content of the note
+	referenced bool
+}
+
+// extractResources writes resources of the note to files. Files are ordered as resources of the note
+func extractResources(resources []*resource, assetsDir string) ([]*resourceFile, error) {
+	files := make([]*resourceFile, 0, len(resources))
+	for i, r := range resources {
+		data, hash, err := r.decode()
+		if err != nil {
+			return nil, err
+		}
+		name := filepath.Base(strings.TrimSpace(r.FileName))
+		if name == "." || name == string(filepath.Separator) {
+			name = hash
+			if extensions, _ := mime.ExtensionsByType(r.Mime); len(extensions) > 0 {
+				name += extensions[0]
+			}
+		}
+		// resources of the note may have the same names, so every resource has its own directory
+		path := filepath.Join(assetsDir, fmt.Sprintf("%d_%s", i, hash), name)
+		if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			return nil, oserror.TransformError(err)
+		}
+		if err = os.WriteFile(path, data, 0600); err != nil {
+			return nil, oserror.TransformError(err)
+		}
+		files = append(files, &resourceFile{hash: hash, path: path, mimeType: r.Mime})
+	}
+	return files, nil
+}
+
+// getBlocks converts ENML content of the note to blocks. Every en-media tag is replaced with the placeholder
+// paragraph, which is turned into file block after conversion. Resources, which are not shown in the content,
+// are added to the end of the note
+func getBlocks(content string, files []*resourceFile) ([]*model.Block, error) {
+	// XML declaration and doctype of ENML are not HTML
+	if loc := enNoteRegexp.FindStringIndex(content); loc != nil {
+		content = content[loc[0]:]
+	}
+	html := enNoteRegexp.ReplaceAllString(content, "<${1}div")
+	html = enMediaRegexp.ReplaceAllStringFunc(html, func(tag string) string {
+		match := hashRegexp.FindStringSubmatch(tag)
+		if match == nil {
+			return ""
+		}
+		return "<div>" + mediaPlaceholderPrefix + strings.ToLower(match[1]) + "</div>"
+	})
+	blocks, _, err := anymark.HTMLToBlocks([]byte(html))
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		text := strings.TrimSpace(block.GetText().GetText())
+		if !strings.HasPrefix(text, mediaPlaceholderPrefix) {
+			continue
+		}
+		hash := strings.TrimPrefix(text, mediaPlaceholderPrefix)
+		for _, f := range files {
+			if f.hash == hash {
+				block.Content = newFileContent(f)
+				f.referenced = true
+				break
+			}
+		}
+	}
+	for _, f := range files {
+		if !f.referenced {
+			blocks = append(blocks, &model.Block{Id: bson.NewObjectId().Hex(), Content: newFileContent(f)})
+		}
+	}
+	return blocks, nil
+}
+
+func newFileContent(f *resourceFile) *model.BlockContentOfFile {
+	return &model.BlockContentOfFile{
+		File: &model.BlockContentFile{
+			Name:  f.path,
+			State: model.BlockContentFile_Empty,
+			Type:  fileType(f.mimeType),
+		},
+	}
+}
+
+func fileType(mimeType string) model.BlockContentFileType {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return model.BlockContentFile_Image
+	case strings.HasPrefix(mimeType, "video/"):
+		return model.BlockContentFile_Video
+	case strings.HasPrefix(mimeType, "audio/"):
+		return model.BlockContentFile_Audio
+	case mimeType == "application/pdf":
+		return model.BlockContentFile_PDF
+	default:
+		return model.BlockContentFile_File
+	}
+}
@@ -0,0 +1,128 @@
+package epub
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+)
+
+const containerFile = "META-INF/container.xml"
+
+var chapterMediaTypes = []string{"application/xhtml+xml", "text/html"}
+
+type container struct {
+	Rootfiles []struct {
+		FullPath string `xml:"full-path,attr"`
+	} `xml:"rootfiles>rootfile"`
+}
+
+// packageDocument is the OPF file of the book, which lists files of the book and order of chapters
+type packageDocument struct {
+	Titles   []string `xml:"metadata>title"`
+	Manifest []struct {
+		ID        string `xml:"id,attr"`
+		Href      string `xml:"href,attr"`
+		MediaType string `xml:"media-type,attr"`
+	} `xml:"manifest>item"`
+	Spine []struct {
+		IDRef string `xml:"idref,attr"`
+	} `xml:"spine>itemref"`
+}
+
+type book struct {
+	title string
+	// chapters are names of XHTML files in the archive in reading order
+	chapters []string
+}
+
+// readBook reads the container and the package document of unpacked EPUB
+func readBook(bookSource source.Source, limits converter.ParseLimits) (*book, error) {
+	c := &container{}
+	if err := decodeFile(bookSource, containerFile, limits, c); err != nil {
+		return nil, err
+	}
+	if len(c.Rootfiles) == 0 || c.Rootfiles[0].FullPath == "" {
+		return nil, fmt.Errorf("package document is not set in %s", containerFile)
+	}
+	opfPath := c.Rootfiles[0].FullPath
+	opf := &packageDocument{}
+	if err := decodeFile(bookSource, opfPath, limits, opf); err != nil {
+		return nil, err
+	}
+	hrefs := make(map[string]string, len(opf.Manifest))
+	for _, item := range opf.Manifest {
+		if isChapterMediaType(item.MediaType) {
+			hrefs[item.ID] = item.Href
+		}
+	}
+	b := &book{}
+	if len(opf.Titles) > 0 {
+		b.title = strings.TrimSpace(opf.Titles[0])
+	}
+	opfDir := path.Dir(opfPath)
+	added := make(map[string]struct{}, len(opf.Spine))
+	for _, itemRef := range opf.Spine {
+		href, ok := hrefs[itemRef.IDRef]
+		if !ok {
+			continue
+		}
+		name, ok := resolveHref(href, opfDir)
+		if _, isAdded := added[name]; !ok || isAdded {
+			continue
+		}
+		added[name] = struct{}{}
+		b.chapters = append(b.chapters, name)
+	}
+	return b, nil
+}
+
+func decodeFile(bookSource source.Source, name string, limits converter.ParseLimits, v interface{}) error {
+	var found bool
+	err := bookSource.ProcessFile(name, func(fileReader io.ReadCloser) error {
+		found = true
+		return limits.DecodeXML(fileReader, v)
+	})
+	if err != nil {
+		return fmt.Errorf("read %s: %w", name, err)
+	}
+	if !found {
+		return fmt.Errorf("%s is not found", name)
+	}
+	return nil
+}
+
+func isChapterMediaType(mediaType string) bool {
+	for _, t := range chapterMediaTypes {
+		if strings.EqualFold(mediaType, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveHref returns the name of file in the archive for the link relative to dir, without query and fragment.
+// Links to web pages and files outside the archive are not resolved
+func resolveHref(href, dir string) (string, bool) {
+	if i := strings.IndexAny(href, "?#"); i >= 0 {
+		href = href[:i]
+	}
+	if href == "" {
+		return "", false
+	}
+	if u, err := url.Parse(href); err != nil || u.Scheme != "" {
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(href); err == nil {
+		href = unescaped
+	}
+	name := path.Join(dir, href)
+	if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,184 @@
+package epub
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Epub"
+	rootCollectionName = "EPUB Import"
+	bookExtension      = ".epub"
+)
+
+var log = logging.Logger("import-epub")
+
+// EPUB imports books. Every chapter of the book becomes a page and chapters are put to the collection of the book
+// in reading order
+type EPUB struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &EPUB{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		parseLimits:     converter.DefaultParseLimits,
+	}
+}
+
+func (e *EPUB) Name() string {
+	return Name
+}
+
+func (e *EPUB) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEpubParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (e *EPUB) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from books")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := e.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(e.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (e *EPUB) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, e.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := e.handleImportPath(p, len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of chapters and books and list of book collections,
+// that should be added to the root collection
+func (e *EPUB) handleImportPath(importPath string,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	bookPaths, err := findBooks(importPath)
+	if err != nil {
+		allErrors.Add(oserror.TransformError(err))
+		return nil, nil
+	}
+	if len(bookPaths) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	snapshots := make([]*converter.Snapshot, 0)
+	books := make([]string, 0, len(bookPaths))
+	for _, bookPath := range bookPaths {
+		sn, err := e.handleBook(bookPath, objectType, limits, allErrors)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(bookPath, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Epub) {
+				return nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn...)
+		// the collection of the book is the last snapshot
+		books = append(books, sn[len(sn)-1].Id)
+	}
+	return snapshots, books
+}
+
+func (e *EPUB) handleBook(bookPath, objectType string, limits converter.ParseLimits, allErrors *converter.ConvertError) ([]*converter.Snapshot, error) {
+	bookSource := source.NewZip()
+	defer bookSource.Close()
+	if err := bookSource.Initialize(bookPath); err != nil {
+		return nil, err
+	}
+	bk, err := readBook(bookSource, limits)
+	if err != nil {
+		return nil, err
+	}
+	assetsDir, err := os.MkdirTemp(e.tempDirProvider.TempDir(), "epub")
+	if err != nil {
+		return nil, oserror.TransformError(err)
+	}
+	return newBookBuilder(bookPath, bookSource, objectType, limits, assetsDir).build(bk, e.service, allErrors)
+}
+
+// findBooks returns the path, if it is the book, or books in the directory
+func findBooks(importPath string) ([]string, error) {
+	if isBook(importPath) {
+		return []string{importPath}, nil
+	}
+	var books []string
+	err := filepath.WalkDir(importPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && isBook(path) {
+			books = append(books, path)
+		}
+		return nil
+	})
+	return books, err
+}
+
+func isBook(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), bookExtension)
+}
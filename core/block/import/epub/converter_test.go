@@ -0,0 +1,187 @@
+package epub
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var bookFiles = map[string]string{
+	"mimetype": "application/epub+zip",
+	"META-INF/container.xml": `<?xml version="1.0"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>`,
+	"OEBPS/content.opf": `<?xml version="1.0"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:title>Reference Book</dc:title>
+  </metadata>
+  <manifest>
+    <item id="ch2" href="text/chapter2.xhtml" media-type="application/xhtml+xml"/>
+    <item id="ch1" href="text/chapter1.xhtml" media-type="application/xhtml+xml"/>
+    <item id="img" href="images/figure.png" media-type="image/png"/>
+  </manifest>
+  <spine>
+    <itemref idref="ch1"/>
+    <itemref idref="ch2"/>
+  </spine>
+</package>`,
+	"OEBPS/text/chapter1.xhtml": `<html xmlns="http://www.w3.org/1999/xhtml"><body>
+<h1>Introduction</h1>
+<p>Continue to <a href="chapter2.xhtml#start">the next chapter</a>.</p>
+</body></html>`,
+	"OEBPS/text/chapter2.xhtml": `<html xmlns="http://www.w3.org/1999/xhtml"><body>
+<h2>Details</h2>
+<p><img src="../images/figure.png" alt="figure"/></p>
+</body></html>`,
+	"OEBPS/images/figure.png": "png",
+}
+
+func writeBook(t *testing.T, dir string, files map[string]string) string {
+	bookPath := filepath.Join(dir, "book.epub")
+	f, err := os.Create(bookPath)
+	require.NoError(t, err)
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return bookPath
+}
+
+func TestEPUB_GetSnapshots(t *testing.T) {
+	t.Run("chapters are pages in collection of the book in reading order", func(t *testing.T) {
+		// given
+		e := &EPUB{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		bookPath := writeBook(t, t.TempDir(), bookFiles)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Epub, bookPath), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		require.Len(t, sn.Snapshots, 4)
+		intro := convertertest.FindSnapshot(sn.Snapshots, "Introduction")
+		details := convertertest.FindSnapshot(sn.Snapshots, "Details")
+		book := convertertest.FindSnapshot(sn.Snapshots, "Reference Book")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{intro, details, book, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{intro.Id, details.Id}, convertertest.CollectionObjects(book))
+		assert.Equal(t, []string{book.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+	})
+	t.Run("every chapter has its own source path", func(t *testing.T) {
+		// given
+		e := &EPUB{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		bookPath := writeBook(t, t.TempDir(), bookFiles)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Epub, bookPath), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		intro := convertertest.FindSnapshot(sn.Snapshots, "Introduction")
+		details := convertertest.FindSnapshot(sn.Snapshots, "Details")
+		require.NotNil(t, intro)
+		require.NotNil(t, details)
+		introPath := pbtypes.GetString(intro.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		detailsPath := pbtypes.GetString(details.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		assert.Equal(t, bookPath+"/OEBPS/text/chapter1.xhtml", introPath)
+		assert.Equal(t, bookPath+"/OEBPS/text/chapter2.xhtml", detailsPath)
+	})
+	t.Run("links to chapters are links to objects, images are extracted", func(t *testing.T) {
+		// given
+		assetsDir := t.TempDir()
+		e := &EPUB{tempDirProvider: &convertertest.TempDirProvider{Dir: assetsDir}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		bookPath := writeBook(t, t.TempDir(), bookFiles)
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Epub, bookPath), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		intro := convertertest.FindSnapshot(sn.Snapshots, "Introduction")
+		details := convertertest.FindSnapshot(sn.Snapshots, "Details")
+		require.NotNil(t, intro)
+		require.NotNil(t, details)
+		var linkMarks []*model.BlockContentTextMark
+		for _, block := range intro.Snapshot.Data.Blocks {
+			linkMarks = append(linkMarks, block.GetText().GetMarks().GetMarks()...)
+		}
+		require.Len(t, linkMarks, 1)
+		assert.Equal(t, model.BlockContentTextMark_Object, linkMarks[0].Type)
+		assert.Equal(t, details.Id, linkMarks[0].Param)
+
+		var images []string
+		for _, block := range details.Snapshot.Data.Blocks {
+			if block.GetFile() != nil {
+				images = append(images, block.GetFile().Name)
+			}
+		}
+		require.Len(t, images, 1)
+		assert.True(t, filepath.IsAbs(images[0]))
+		assert.Equal(t, assetsDir, filepath.Dir(filepath.Dir(filepath.Dir(filepath.Dir(images[0])))))
+		content, readErr := os.ReadFile(images[0])
+		require.NoError(t, readErr)
+		assert.Equal(t, "png", string(content))
+	})
+	t.Run("directory with book without package document - error", func(t *testing.T) {
+		// given
+		e := &EPUB{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		dir := t.TempDir()
+		writeBook(t, dir, map[string]string{"mimetype": "application/epub+zip"})
+
+		// when
+		_, err := e.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Epub, dir), p)
+
+		// then
+		require.NotNil(t, err)
+		assert.False(t, err.IsEmpty())
+	})
+}
+
+func TestResolveHref(t *testing.T) {
+	for _, tc := range []struct {
+		href, dir, expected string
+		ok                  bool
+	}{
+		{href: "chapter2.xhtml#start", dir: "OEBPS/text", expected: "OEBPS/text/chapter2.xhtml", ok: true},
+		{href: "../images/pic%20one.png", dir: "OEBPS/text", expected: "OEBPS/images/pic one.png", ok: true},
+		{href: "#note", dir: "OEBPS/text"},
+		{href: "https://example.com/page.html", dir: "OEBPS"},
+		{href: "../../../etc/passwd", dir: "OEBPS"},
+	} {
+		name, ok := resolveHref(tc.href, tc.dir)
+		assert.Equal(t, tc.ok, ok, tc.href)
+		assert.Equal(t, tc.expected, name, tc.href)
+	}
+}
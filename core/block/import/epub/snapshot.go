@@ -0,0 +1,193 @@
+package epub
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+// bookBuilder creates snapshots of chapters of one book and the collection of the book
+type bookBuilder struct {
+	bookPath   string
+	bookSource source.Source
+	objectType string
+	limits     converter.ParseLimits
+	// assetsDir is the directory, where images of the book are extracted to. Every book has its own directory,
+	// because books often have images with the same names
+	assetsDir string
+
+	// chapterIDs maps file name of chapter to its object id, so links between chapters are resolved
+	chapterIDs map[string]string
+	// assets maps file name of image in the archive to the extracted file
+	assets map[string]string
+}
+
+func newBookBuilder(bookPath string, bookSource source.Source, objectType string, limits converter.ParseLimits, assetsDir string) *bookBuilder {
+	return &bookBuilder{
+		bookPath:   bookPath,
+		bookSource: bookSource,
+		objectType: objectType,
+		limits:     limits,
+		assetsDir:  assetsDir,
+		chapterIDs: map[string]string{},
+		assets:     map[string]string{},
+	}
+}
+
+// build returns snapshots of chapters and the collection of the book. Chapters, which can't be converted,
+// are reported to allErrors and skipped
+func (b *bookBuilder) build(bk *book, service *collection.Service, allErrors *converter.ConvertError) ([]*converter.Snapshot, error) {
+	for _, chapter := range bk.chapters {
+		b.chapterIDs[chapter] = uuid.New().String()
+	}
+	snapshots := make([]*converter.Snapshot, 0, len(bk.chapters)+1)
+	chapterIDs := make([]string, 0, len(bk.chapters))
+	for _, chapter := range bk.chapters {
+		id := b.chapterIDs[chapter]
+		sn, err := b.getChapterSnapshot(chapter, id)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(chapter, err))
+			continue
+		}
+		snapshots = append(snapshots, sn)
+		chapterIDs = append(chapterIDs, id)
+	}
+	if len(snapshots) == 0 {
+		return nil, converter.ErrNoObjectsToImport
+	}
+	title := bk.title
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(b.bookPath), filepath.Ext(b.bookPath))
+	}
+	bookCollection, err := converter.NewRootCollection(service).MakeCollection(title, chapterIDs)
+	if err != nil {
+		return nil, err
+	}
+	return append(snapshots, bookCollection), nil
+}
+
+func (b *bookBuilder) getChapterSnapshot(chapter, id string) (*converter.Snapshot, error) {
+	var blocks []*model.Block
+	err := b.bookSource.ProcessFile(chapter, func(fileReader io.ReadCloser) error {
+		data, err := b.limits.ReadAll(fileReader)
+		if err != nil {
+			return err
+		}
+		blocks, err = b.getBlocks(data, path.Dir(chapter))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      blocks,
+		Details:     converter.GetCommonDetails(chapterSourcePath(b.bookPath, chapter), chapterTitle(blocks, chapter), "", model.ObjectType_basic),
+		ObjectTypes: []string{b.objectType},
+	}
+	return &converter.Snapshot{
+		Id:       id,
+		FileName: chapter,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}, nil
+}
+
+// getBlocks converts XHTML of the chapter to blocks. Links to other chapters are replaced with links to objects
+// and images are extracted from the archive
+func (b *bookBuilder) getBlocks(data []byte, dir string) ([]*model.Block, error) {
+	blocks, _, err := anymark.HTMLToBlocks(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		if file := block.GetFile(); file != nil {
+			if name, ok := resolveHref(file.Name, dir); ok {
+				file.Name = b.extractAsset(name, file.Name)
+			}
+		}
+		for _, mark := range block.GetText().GetMarks().GetMarks() {
+			if mark.Type != model.BlockContentTextMark_Link {
+				continue
+			}
+			name, ok := resolveHref(mark.Param, dir)
+			if !ok {
+				continue
+			}
+			if id, ok := b.chapterIDs[name]; ok {
+				mark.Type = model.BlockContentTextMark_Object
+				mark.Param = id
+			}
+		}
+	}
+	return blocks, nil
+}
+
+// extractAsset extracts the file from the archive and returns its path. The original link is returned,
+// if the file can't be extracted
+func (b *bookBuilder) extractAsset(name, link string) string {
+	if extracted, ok := b.assets[name]; ok {
+		return extracted
+	}
+	extracted := filepath.Join(b.assetsDir, filepath.FromSlash(name))
+	var found bool
+	err := b.bookSource.ProcessFile(name, func(fileReader io.ReadCloser) error {
+		found = true
+		return writeFile(extracted, fileReader)
+	})
+	if err != nil {
+		log.Errorf("failed to extract image %s of book: %v", name, oserror.TransformError(err))
+		return link
+	}
+	if !found {
+		return link
+	}
+	b.assets[name] = extracted
+	return extracted
+}
+
+func writeFile(name string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0777); err != nil {
+		return err
+	}
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = io.Copy(f, r); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+// chapterSourcePath returns source path of the chapter, so every chapter is matched separately on re-import
+func chapterSourcePath(bookPath, chapter string) string {
+	return bookPath + "/" + chapter
+}
+
+// chapterTitle returns the text of the first heading of the chapter or the name of its file
+func chapterTitle(blocks []*model.Block, chapter string) string {
+	for _, block := range blocks {
+		switch block.GetText().GetStyle() {
+		case model.BlockContentText_Header1, model.BlockContentText_Header2, model.BlockContentText_Header3:
+			if title := strings.TrimSpace(block.GetText().GetText()); title != "" {
+				return title
+			}
+		}
+	}
+	return strings.TrimSuffix(path.Base(chapter), path.Ext(chapter))
+}
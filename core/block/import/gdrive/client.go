@@ -0,0 +1,161 @@
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	apiURL         = "https://www.googleapis.com/drive/v3"
+	requestTimeout = time.Minute
+
+	mimeTypeFolder   = "application/vnd.google-apps.folder"
+	mimeTypeDocument = "application/vnd.google-apps.document"
+	exportMimeType   = "text/html"
+
+	// rootFolderID is alias of My Drive folder in Drive API
+	rootFolderID = "root"
+)
+
+// driveFile is file or folder of Google Drive
+type driveFile struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	MimeType     string `json:"mimeType"`
+	CreatedTime  string `json:"createdTime"`
+	ModifiedTime string `json:"modifiedTime"`
+	WebViewLink  string `json:"webViewLink"`
+}
+
+type comment struct {
+	Author struct {
+		DisplayName string `json:"displayName"`
+	} `json:"author"`
+	Content           string `json:"content"`
+	Resolved          bool   `json:"resolved"`
+	QuotedFileContent struct {
+		Value string `json:"value"`
+	} `json:"quotedFileContent"`
+	Replies []struct {
+		Author struct {
+			DisplayName string `json:"displayName"`
+		} `json:"author"`
+		Content string `json:"content"`
+	} `json:"replies"`
+}
+
+type listFilesResponse struct {
+	Files         []driveFile `json:"files"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+type listCommentsResponse struct {
+	Comments      []comment `json:"comments"`
+	NextPageToken string    `json:"nextPageToken"`
+}
+
+// client calls Drive API v3 with OAuth access token
+type client struct {
+	httpClient *http.Client
+	basePath   string
+	token      string
+}
+
+func newClient(token string) *client {
+	return &client{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		basePath:   apiURL,
+		token:      token,
+	}
+}
+
+// listFolder returns all files and folders of the folder, which are not in trash
+func (c *client) listFolder(ctx context.Context, folderID string) ([]driveFile, error) {
+	var files []driveFile
+	pageToken := ""
+	for {
+		query := url.Values{
+			"q":        {fmt.Sprintf("'%s' in parents and trashed = false", folderID)},
+			"fields":   {"nextPageToken,files(id,name,mimeType,createdTime,modifiedTime,webViewLink)"},
+			"orderBy":  {"folder,name"},
+			"pageSize": {"1000"},
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var resp listFilesResponse
+		if err := c.getJSON(ctx, "/files", query, &resp); err != nil {
+			return nil, fmt.Errorf("list folder %s: %w", folderID, err)
+		}
+		files = append(files, resp.Files...)
+		if resp.NextPageToken == "" {
+			return files, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+// exportDocument returns Google Doc converted to HTML
+func (c *client) exportDocument(ctx context.Context, fileID string) ([]byte, error) {
+	body, err := c.get(ctx, "/files/"+url.PathEscape(fileID)+"/export", url.Values{"mimeType": {exportMimeType}})
+	if err != nil {
+		return nil, fmt.Errorf("export document %s: %w", fileID, err)
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
+
+func (c *client) listComments(ctx context.Context, fileID string) ([]comment, error) {
+	var comments []comment
+	pageToken := ""
+	for {
+		query := url.Values{
+			"fields":   {"nextPageToken,comments(author/displayName,content,resolved,quotedFileContent/value,replies(author/displayName,content))"},
+			"pageSize": {"100"},
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		var resp listCommentsResponse
+		if err := c.getJSON(ctx, "/files/"+url.PathEscape(fileID)+"/comments", query, &resp); err != nil {
+			return nil, fmt.Errorf("list comments of %s: %w", fileID, err)
+		}
+		comments = append(comments, resp.Comments...)
+		if resp.NextPageToken == "" {
+			return comments, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (c *client) getJSON(ctx context.Context, path string, query url.Values, result interface{}) error {
+	body, err := c.get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return json.NewDecoder(body).Decode(result)
+}
+
+func (c *client) get(ctx context.Context, path string, query url.Values) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.basePath+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("failed http request, %d code: %s", res.StatusCode, message)
+	}
+	return res.Body, nil
+}
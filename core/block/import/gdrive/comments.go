@@ -0,0 +1,60 @@
+package gdrive
+
+import (
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+const commentsHeader = "Comments"
+
+// commentBlocks returns section with comments of the document, which is appended to its end. Every comment
+// is quote of commented text followed by the comment, replies are nested blocks of the comment
+func commentBlocks(comments []comment) []*model.Block {
+	if len(comments) == 0 {
+		return nil
+	}
+	blocks := []*model.Block{newTextBlock(commentsHeader, model.BlockContentText_Header3)}
+	for _, c := range comments {
+		if quoted := c.QuotedFileContent.Value; quoted != "" {
+			blocks = append(blocks, newTextBlock(quoted, model.BlockContentText_Quote))
+		}
+		commentBlock := newAuthorBlock(c.Author.DisplayName, c.Content)
+		if c.Resolved {
+			commentBlock.GetText().Checked = true
+			commentBlock.GetText().Style = model.BlockContentText_Checkbox
+		}
+		blocks = append(blocks, commentBlock)
+		for _, reply := range c.Replies {
+			replyBlock := newAuthorBlock(reply.Author.DisplayName, reply.Content)
+			commentBlock.ChildrenIds = append(commentBlock.ChildrenIds, replyBlock.Id)
+			blocks = append(blocks, replyBlock)
+		}
+	}
+	return blocks
+}
+
+func newTextBlock(value string, style model.BlockContentTextStyle) *model.Block {
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  value,
+			Style: style,
+		}},
+	}
+}
+
+// newAuthorBlock returns paragraph "<author>: <content>" with bold name of author
+func newAuthorBlock(author, content string) *model.Block {
+	if author == "" {
+		return newTextBlock(content, model.BlockContentText_Paragraph)
+	}
+	prefix := author + ": "
+	block := newTextBlock(prefix+content, model.BlockContentText_Paragraph)
+	block.GetText().Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+		Range: &model.Range{From: 0, To: int32(text.UTF16RuneCountString(author))},
+		Type:  model.BlockContentTextMark_Bold,
+	}}}
+	return block
+}
@@ -0,0 +1,263 @@
+package gdrive
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "GoogleDrive"
+	rootCollectionName = "Google Drive Import"
+	// maxFolderDepth protects from cycles of folders, as file of Google Drive can have several parents
+	maxFolderDepth = 32
+)
+
+var log = logging.Logger("import-gdrive")
+
+// documentLinkRegexp matches links to Google Docs, e.g. https://docs.google.com/document/d/<id>/edit
+var documentLinkRegexp = regexp.MustCompile(`^https://docs\.google\.com/document/d/([\w-]+)`)
+
+// GoogleDrive imports Google Docs of Drive folder with Drive API. Documents are exported as HTML and become pages
+// with their comments in the end, folders become collections
+type GoogleDrive struct {
+	service *collection.Service
+	// apiURL is overridden in tests
+	apiURL string
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &GoogleDrive{service: service, apiURL: apiURL}
+}
+
+func (g *GoogleDrive) Name() string {
+	return Name
+}
+
+func (g *GoogleDrive) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	params := req.GetGoogleDriveParams()
+	if params == nil {
+		return nil, nil
+	}
+	if params.Token == "" {
+		return nil, converter.NewFromError(fmt.Errorf("google drive token is empty"), req.Mode)
+	}
+	progress.SetProgressMessage("Start fetching documents from Google Drive")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	c := newClient(params.Token)
+	c.basePath = g.apiURL
+	folderID := params.FolderId
+	if folderID == "" {
+		folderID = rootFolderID
+	}
+	b := &driveBuilder{
+		client:     c,
+		service:    g.service,
+		progress:   progress,
+		allErrors:  allErrors,
+		mode:       req.Mode,
+		objectType: converter.ObjectTypeKey(req, defaultObjectType),
+		documents:  map[string]string{},
+	}
+	rootObjects := b.importFolder(ctx, folderID, 0)
+	if allErrors.ShouldAbortImport(0, req.Type) {
+		return nil, allErrors
+	}
+	if len(b.snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, allErrors
+	}
+	b.updateLinks()
+	snapshots := b.snapshots
+	rootCollection := converter.NewRootCollection(g.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, rootObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(0, req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+// driveBuilder creates snapshots of documents and collections of folders of one import
+type driveBuilder struct {
+	client     *client
+	service    *collection.Service
+	progress   process.Progress
+	allErrors  *converter.ConvertError
+	mode       pb.RpcObjectImportRequestMode
+	objectType string
+
+	// documents maps id of Google Doc to id of its object
+	documents map[string]string
+	snapshots []*converter.Snapshot
+}
+
+// importFolder creates snapshots of documents and nested folders of the folder and returns their ids.
+// Folders without documents are skipped
+func (b *driveBuilder) importFolder(ctx context.Context, folderID string, depth int) []string {
+	if depth > maxFolderDepth {
+		return nil
+	}
+	files, err := b.client.listFolder(ctx, folderID)
+	if err != nil {
+		b.allErrors.Add(err)
+		return nil
+	}
+	var objects []string
+	for _, file := range files {
+		if b.shouldStop() {
+			return nil
+		}
+		switch file.MimeType {
+		case mimeTypeFolder:
+			children := b.importFolder(ctx, file.ID, depth+1)
+			if len(children) == 0 {
+				continue
+			}
+			col, err := converter.NewRootCollection(b.service).MakeCollection(file.Name, children)
+			if err != nil {
+				b.allErrors.Add(fmt.Errorf("failed to create collection for folder %s: %w", file.Name, err))
+				continue
+			}
+			b.snapshots = append(b.snapshots, col)
+			objects = append(objects, col.Id)
+		case mimeTypeDocument:
+			if err = b.progress.TryStep(1); err != nil {
+				b.allErrors.Add(converter.ErrCancel)
+				return nil
+			}
+			if id, ok := b.documents[file.ID]; ok {
+				objects = append(objects, id)
+				continue
+			}
+			sn, err := b.getDocumentSnapshot(ctx, file)
+			if err != nil {
+				b.allErrors.Add(converter.NewFileError(file.Name, err))
+				continue
+			}
+			b.snapshots = append(b.snapshots, sn)
+			objects = append(objects, sn.Id)
+		default:
+			log.Debugf("file %s of type %s is skipped", file.ID, file.MimeType)
+		}
+	}
+	return objects
+}
+
+func (b *driveBuilder) shouldStop() bool {
+	return b.allErrors.ShouldAbortImport(0, pb.RpcObjectImportRequest_GoogleDrive)
+}
+
+func (b *driveBuilder) getDocumentSnapshot(ctx context.Context, file driveFile) (*converter.Snapshot, error) {
+	html, err := b.client.exportDocument(ctx, file.ID)
+	if err != nil {
+		return nil, err
+	}
+	blocks, _, err := anymark.HTMLToBlocks(html)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := b.client.listComments(ctx, file.ID)
+	if err != nil {
+		// document is still useful without comments
+		b.allErrors.Add(converter.NewFileError(file.Name, err))
+	}
+	blocks = append(blocks, commentBlocks(comments)...)
+
+	details := converter.GetCommonDetails(file.WebViewLink, file.Name, "", model.ObjectType_basic)
+	if created := parseTime(file.CreatedTime); created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if modified := parseTime(file.ModifiedTime); modified != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(modified)
+	}
+	var relationLinks []*model.RelationLink
+	if file.WebViewLink != "" {
+		details.Fields[bundle.RelationKeySource.String()] = pbtypes.String(file.WebViewLink)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeySource.String(),
+			Format: model.RelationFormat_url,
+		})
+	}
+	id := uuid.New().String()
+	b.documents[file.ID] = id
+	return &converter.Snapshot{
+		Id:       id,
+		FileName: file.WebViewLink,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	}, nil
+}
+
+// updateLinks replaces links to imported Google Docs with links to their objects
+func (b *driveBuilder) updateLinks() {
+	for _, sn := range b.snapshots {
+		for _, block := range sn.Snapshot.Data.Blocks {
+			for _, mark := range block.GetText().GetMarks().GetMarks() {
+				if mark.Type != model.BlockContentTextMark_Link {
+					continue
+				}
+				if id, ok := b.documents[documentID(mark.Param)]; ok {
+					mark.Type = model.BlockContentTextMark_Object
+					mark.Param = id
+				}
+			}
+		}
+	}
+}
+
+// documentID returns id of Google Doc from its link. Links of exported HTML are wrapped into redirects,
+// e.g. https://www.google.com/url?q=https://docs.google.com/document/d/<id>/edit
+func documentID(link string) string {
+	if u, err := url.Parse(link); err == nil && u.Host == "www.google.com" && u.Path == "/url" {
+		link = u.Query().Get("q")
+	}
+	if match := documentLinkRegexp.FindStringSubmatch(link); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+func parseTime(value string) int64 {
+	if value == "" {
+		return 0
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,159 @@
+package gdrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newDriveServer(t *testing.T) *httptest.Server {
+	responses := map[string]string{
+		"/files?root": `{"files": [
+			{"id": "folder", "name": "Projects", "mimeType": "application/vnd.google-apps.folder"},
+			{"id": "sheet", "name": "Budget", "mimeType": "application/vnd.google-apps.spreadsheet"},
+			{"id": "notes", "name": "Notes", "mimeType": "application/vnd.google-apps.document",
+			 "createdTime": "2024-03-04T10:00:00.000Z", "webViewLink": "https://docs.google.com/document/d/notes/edit"}
+		]}`,
+		"/files?folder": `{"files": [
+			{"id": "plan", "name": "Plan", "mimeType": "application/vnd.google-apps.document"}
+		], "nextPageToken": "next"}`,
+		"/files?folder&next": `{"files": [
+			{"id": "empty", "name": "Empty", "mimeType": "application/vnd.google-apps.folder"}
+		]}`,
+		"/files?empty":         `{"files": []}`,
+		"/files/notes/export":  `<html><body><p>See <a href="https://www.google.com/url?q=https://docs.google.com/document/d/plan/edit&amp;sa=D">plan</a></p></body></html>`,
+		"/files/plan/export":   `<html><body><p>Plan text</p></body></html>`,
+		"/files/plan/comments": `{"comments": []}`,
+		"/files/notes/comments": `{"comments": [{"author": {"displayName": "Ann"}, "content": "Check it",
+			"quotedFileContent": {"value": "plan"}, "replies": [{"author": {"displayName": "Bob"}, "content": "Done"}]}]}`,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		key := r.URL.Path
+		if q := r.URL.Query().Get("q"); q != "" {
+			key += "?" + strings.Split(q, "'")[1]
+			if pageToken := r.URL.Query().Get("pageToken"); pageToken != "" {
+				key += "&" + pageToken
+			}
+		}
+		response, ok := responses[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+}
+
+func getRequest() *pb.RpcObjectImportRequest {
+	return &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfGoogleDriveParams{
+			GoogleDriveParams: &pb.RpcObjectImportRequestGoogleDriveParams{Token: "token"},
+		},
+		Type: pb.RpcObjectImportRequest_GoogleDrive,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}
+}
+
+func TestGoogleDrive_GetSnapshots(t *testing.T) {
+	t.Run("folders are collections of documents", func(t *testing.T) {
+		// given
+		s := newDriveServer(t)
+		defer s.Close()
+		g := &GoogleDrive{apiURL: s.URL}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), getRequest(), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		projects := convertertest.FindSnapshot(sn.Snapshots, "Projects")
+		plan := convertertest.FindSnapshot(sn.Snapshots, "Plan")
+		notes := convertertest.FindSnapshot(sn.Snapshots, "Notes")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{projects, plan, notes, root} {
+			require.NotNil(t, s)
+		}
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Empty"))
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Budget"))
+		assert.Equal(t, []string{projects.Id, notes.Id}, pbtypes.GetStringList(root.Snapshot.Data.Collections, template.CollectionStoreKey))
+		assert.Equal(t, []string{plan.Id}, pbtypes.GetStringList(projects.Snapshot.Data.Collections, template.CollectionStoreKey))
+	})
+
+	t.Run("links to documents are resolved and comments are appended", func(t *testing.T) {
+		// given
+		s := newDriveServer(t)
+		defer s.Close()
+		g := &GoogleDrive{apiURL: s.URL}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), getRequest(), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		plan := convertertest.FindSnapshot(sn.Snapshots, "Plan")
+		notes := convertertest.FindSnapshot(sn.Snapshots, "Notes")
+		require.NotNil(t, plan)
+		require.NotNil(t, notes)
+
+		details := notes.Snapshot.Data.Details
+		assert.Equal(t, "https://docs.google.com/document/d/notes/edit", pbtypes.GetString(details, bundle.RelationKeySource.String()))
+		assert.Equal(t, int64(1709546400), pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()))
+
+		var (
+			objectLinks []string
+			texts       []string
+		)
+		for _, b := range notes.Snapshot.Data.Blocks {
+			if b.GetText() == nil {
+				continue
+			}
+			texts = append(texts, b.GetText().Text)
+			for _, mark := range b.GetText().GetMarks().GetMarks() {
+				if mark.Type == model.BlockContentTextMark_Object {
+					objectLinks = append(objectLinks, mark.Param)
+				}
+			}
+		}
+		assert.Equal(t, []string{plan.Id}, objectLinks)
+		assert.Subset(t, texts, []string{commentsHeader, "plan", "Ann: Check it", "Bob: Done"})
+	})
+
+	t.Run("empty token is error", func(t *testing.T) {
+		// given
+		g := &GoogleDrive{}
+		req := getRequest()
+		req.GetGoogleDriveParams().Token = ""
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), req, process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestDocumentID(t *testing.T) {
+	assert.Equal(t, "abc-1_2", documentID("https://docs.google.com/document/d/abc-1_2/edit"))
+	assert.Equal(t, "abc", documentID("https://www.google.com/url?q=https://docs.google.com/document/d/abc/edit&sa=D"))
+	assert.Equal(t, "", documentID("https://example.com"))
+}
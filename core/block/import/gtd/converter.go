@@ -0,0 +1,188 @@
+package gtd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyTask
+const (
+	Name               = "Gtd"
+	rootCollectionName = "Tasks Import"
+)
+
+const (
+	thingsExt    = ".json"
+	omniFocusExt = ".xml"
+)
+
+var log = logging.Logger("import-gtd")
+
+// Gtd imports tasks from Things 3 JSON exports, Trello board JSON exports and OmniFocus databases
+// (.ofocus directories and archives)
+type Gtd struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &Gtd{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (g *Gtd) Name() string {
+	return Name
+}
+
+func (g *Gtd) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetGtdParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (g *Gtd) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := g.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from tasks")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := g.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(g.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (g *Gtd) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, g.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := g.handleImportPath(p, converter.ObjectTypeKey(req, defaultObjectType), converter.SourceFilter(req), limits, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of tasks, collections and tags and list of objects,
+// that should be added to the root collection: top level projects, areas and folders and tasks without project
+func (g *Gtd) handleImportPath(importPath, objectType string,
+	filter *source.Filter,
+	limits converter.ParseLimits,
+	pathsCount int,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(getSource(importPath))
+	defer importSource.Close()
+	err := importSource.Initialize(importPath)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Gtd) {
+			return nil, nil
+		}
+	}
+	snapshots := make([]*converter.Snapshot, 0)
+	rootObjects := make([]string, 0)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		e, err := parseExport(fileName, fileReader, limits)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Gtd)
+		}
+		if e == nil || e.isEmpty() {
+			return true
+		}
+		sn, ro, err := newSnapshotBuilder(fileName, objectType, g.service).build(e)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Gtd)
+		}
+		snapshots = append(snapshots, sn...)
+		rootObjects = append(rootObjects, ro...)
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	if len(snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return snapshots, rootObjects
+}
+
+// getSource returns source for a single export file, or for directory and archive, like OmniFocus database
+func getSource(importPath string) source.Source {
+	switch strings.ToLower(filepath.Ext(importPath)) {
+	case thingsExt, omniFocusExt:
+		return source.NewFile()
+	default:
+		return source.GetSource(importPath)
+	}
+}
+
+// parseExport parses file depending on its extension, other files are skipped. JSON files are Things exports,
+// which are arrays of items, or Trello board exports, which are objects
+func parseExport(fileName string, fileReader io.Reader, limits converter.ParseLimits) (*export, error) {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case thingsExt:
+		data, err := limits.ReadAll(fileReader)
+		if err != nil {
+			return nil, err
+		}
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+			return parseTrello(data, limits)
+		}
+		return parseThings(bytes.NewReader(data), limits)
+	case omniFocusExt:
+		return parseOmniFocus(fileReader, limits)
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,173 @@
+package gtd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestGtd_GetSnapshots(t *testing.T) {
+	for _, path := range []string{"testdata/things.json", "testdata/omnifocus.ofocus"} {
+		t.Run("project with two tasks is converted to collection - "+path, func(t *testing.T) {
+			// given
+			g := &Gtd{}
+			p := process.NewProgress(pb.ModelProcess_Import)
+
+			// when
+			sn, err := g.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, path), p)
+
+			// then
+			assert.Nil(t, err)
+			require.NotNil(t, sn)
+			changelog := convertertest.FindSnapshot(sn.Snapshots, "Write changelog")
+			tagVersion := convertertest.FindSnapshot(sn.Snapshots, "Tag version")
+			milk := convertertest.FindSnapshot(sn.Snapshots, "Buy milk")
+			project := convertertest.FindSnapshot(sn.Snapshots, "Release")
+			area := convertertest.FindSnapshot(sn.Snapshots, "Work")
+			root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+			for _, s := range []*converter.Snapshot{changelog, tagVersion, milk, project, area, root} {
+				require.NotNil(t, s)
+			}
+			assert.Equal(t, []string{changelog.Id, tagVersion.Id}, convertertest.CollectionObjects(project))
+			assert.Equal(t, []string{project.Id}, convertertest.CollectionObjects(area))
+			assert.Equal(t, []string{area.Id, milk.Id}, convertertest.CollectionObjects(root))
+			assert.Equal(t, root.Id, sn.RootCollectionID)
+		})
+		t.Run("completed state, dates and tags are preserved - "+path, func(t *testing.T) {
+			// given
+			g := &Gtd{}
+			p := process.NewProgress(pb.ModelProcess_Import)
+
+			// when
+			sn, err := g.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, path), p)
+
+			// then
+			assert.Nil(t, err)
+			require.NotNil(t, sn)
+			changelog := convertertest.FindSnapshot(sn.Snapshots, "Write changelog")
+			tagVersion := convertertest.FindSnapshot(sn.Snapshots, "Tag version")
+			writingTag := convertertest.FindSnapshot(sn.Snapshots, "writing")
+			urgentTag := convertertest.FindSnapshot(sn.Snapshots, "urgent")
+			deferDate := convertertest.FindSnapshot(sn.Snapshots, deferDateRelationName)
+			recurrence := convertertest.FindSnapshot(sn.Snapshots, recurrenceRelationName)
+			for _, s := range []*converter.Snapshot{changelog, tagVersion, writingTag, urgentTag, deferDate, recurrence} {
+				require.NotNil(t, s)
+			}
+
+			details := changelog.Snapshot.Data.Details
+			assert.Equal(t, []string{bundle.TypeKeyTask.String()}, changelog.Snapshot.Data.ObjectTypes)
+			assert.False(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+			assert.True(t, pbtypes.GetBool(tagVersion.Snapshot.Data.Details, bundle.RelationKeyDone.String()))
+			assert.Equal(t, "2023-09-20", time.Unix(pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()), 0).UTC().Format(dateLayout))
+			assert.Equal(t, "2023-09-18", time.Unix(pbtypes.GetInt64(details, deferDate.Snapshot.Data.Key), 0).UTC().Format(dateLayout))
+			assert.NotEmpty(t, pbtypes.GetString(details, recurrence.Snapshot.Data.Key))
+			assert.Equal(t, smartblock.SmartBlockTypeRelationOption, urgentTag.SbType)
+			assert.ElementsMatch(t, []string{writingTag.Id, urgentTag.Id}, pbtypes.GetStringList(details, bundle.RelationKeyTag.String()))
+			assert.NotEmpty(t, changelog.Snapshot.Data.Blocks)
+		})
+	}
+	t.Run("checklist of Things to-do is converted to checkboxes", func(t *testing.T) {
+		// given
+		g := &Gtd{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, "testdata/things.json"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		changelog := convertertest.FindSnapshot(sn.Snapshots, "Write changelog")
+		require.NotNil(t, changelog)
+		var checkboxes []string
+		for _, block := range changelog.Snapshot.Data.Blocks {
+			if block.GetText().GetStyle() == model.BlockContentText_Checkbox {
+				checkboxes = append(checkboxes, block.GetText().Text)
+			}
+		}
+		assert.Equal(t, []string{"Features", "Fixes"}, checkboxes)
+	})
+	t.Run("Trello board is converted to collection of cards with status", func(t *testing.T) {
+		// given
+		g := &Gtd{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, "testdata/trello.json"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		board := convertertest.FindSnapshot(sn.Snapshots, "Roadmap")
+		api := convertertest.FindSnapshot(sn.Snapshots, "Design API")
+		spec := convertertest.FindSnapshot(sn.Snapshots, "Write spec")
+		ci := convertertest.FindSnapshot(sn.Snapshots, "Set up CI")
+		todo := convertertest.FindSnapshot(sn.Snapshots, "To Do")
+		backend := convertertest.FindSnapshot(sn.Snapshots, "backend")
+		red := convertertest.FindSnapshot(sn.Snapshots, "red")
+		for _, s := range []*converter.Snapshot{board, api, spec, ci, todo, backend, red} {
+			require.NotNil(t, s)
+		}
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Archived card"))
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Forgotten idea"))
+		assert.Equal(t, []string{spec.Id, api.Id, ci.Id}, convertertest.CollectionObjects(board))
+
+		details := api.Snapshot.Data.Details
+		assert.Equal(t, bundle.RelationKeyStatus.String(), pbtypes.GetString(todo.Snapshot.Data.Details, bundle.RelationKeyRelationKey.String()))
+		assert.Equal(t, []string{todo.Id}, pbtypes.GetStringList(details, bundle.RelationKeyStatus.String()))
+		assert.Equal(t, []string{todo.Id}, pbtypes.GetStringList(spec.Snapshot.Data.Details, bundle.RelationKeyStatus.String()))
+		assert.Equal(t, []string{backend.Id, red.Id}, pbtypes.GetStringList(details, bundle.RelationKeyTag.String()))
+		assert.Equal(t, "https://trello.com/c/abc", pbtypes.GetString(details, bundle.RelationKeySource.String()))
+		assert.Equal(t, "2024-05-01", time.Unix(pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()), 0).UTC().Format(dateLayout))
+		assert.True(t, pbtypes.GetBool(ci.Snapshot.Data.Details, bundle.RelationKeyDone.String()))
+
+		var checkboxes []string
+		for _, block := range api.Snapshot.Data.Blocks {
+			if block.GetText().GetStyle() == model.BlockContentText_Checkbox {
+				checkboxes = append(checkboxes, block.GetText().Text)
+			}
+		}
+		assert.Equal(t, []string{"Resources", "Errors"}, checkboxes)
+	})
+	t.Run("export exceeding parse limits of request - return error", func(t *testing.T) {
+		// given
+		g := &Gtd{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		req := convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, "testdata/things.json")
+		req.ParseLimits = &pb.RpcObjectImportRequestParseLimits{MaxDepth: 2}
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), req, p)
+
+		// then
+		assert.Nil(t, sn)
+		require.NotNil(t, err)
+		assert.ErrorContains(t, err.GetResultError(pb.RpcObjectImportRequest_Gtd), converter.ErrParseLimitExceeded.Error())
+	})
+	t.Run("directory without exports - return error", func(t *testing.T) {
+		// given
+		g := &Gtd{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := g.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Gtd, t.TempDir()), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Gtd), converter.ErrNoObjectsToImport))
+	})
+}
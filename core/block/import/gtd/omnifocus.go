@@ -0,0 +1,184 @@
+package gtd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+// omniFocusDocument is contents.xml of OmniFocus database. Projects are tasks with project element,
+// tags are contexts, linked to tasks directly or via task-to-tag elements
+type omniFocusDocument struct {
+	Contexts   []*omniFocusContext   `xml:"context"`
+	Tags       []*omniFocusContext   `xml:"tag"`
+	Folders    []*omniFocusFolder    `xml:"folder"`
+	Tasks      []*omniFocusTask      `xml:"task"`
+	TaskToTags []*omniFocusTaskToTag `xml:"task-to-tag"`
+}
+
+type omniFocusRef struct {
+	IDRef string `xml:"idref,attr"`
+}
+
+type omniFocusContext struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name"`
+}
+
+type omniFocusFolder struct {
+	ID     string        `xml:"id,attr"`
+	Name   string        `xml:"name"`
+	Parent *omniFocusRef `xml:"folder"`
+}
+
+type omniFocusTask struct {
+	ID             string            `xml:"id,attr"`
+	Parent         *omniFocusRef     `xml:"task"`
+	Project        *omniFocusProject `xml:"project"`
+	Context        *omniFocusRef     `xml:"context"`
+	Name           string            `xml:"name"`
+	Note           omniFocusNote     `xml:"note"`
+	Start          string            `xml:"start"`
+	Due            string            `xml:"due"`
+	Completed      string            `xml:"completed"`
+	RepetitionRule string            `xml:"repetition-rule"`
+}
+
+type omniFocusProject struct {
+	Folder *omniFocusRef `xml:"folder"`
+}
+
+type omniFocusNote struct {
+	Paragraphs []*omniFocusParagraph `xml:"text>p"`
+}
+
+type omniFocusParagraph struct {
+	Runs []string `xml:"run>lit"`
+}
+
+type omniFocusTaskToTag struct {
+	Task    omniFocusRef `xml:"task"`
+	Context omniFocusRef `xml:"context"`
+}
+
+func parseOmniFocus(r io.Reader, limits converter.ParseLimits) (*export, error) {
+	doc := &omniFocusDocument{}
+	if err := limits.DecodeXML(r, doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OmniFocus database: %w", err)
+	}
+	return doc.toExport(), nil
+}
+
+func (d *omniFocusDocument) toExport() *export {
+	tagNames := map[string]string{}
+	for _, c := range append(d.Contexts, d.Tags...) {
+		tagNames[c.ID] = c.Name
+	}
+	taskTags := map[string][]string{}
+	for _, t := range d.Tasks {
+		if t.Context != nil && tagNames[t.Context.IDRef] != "" {
+			taskTags[t.ID] = append(taskTags[t.ID], tagNames[t.Context.IDRef])
+		}
+	}
+	for _, link := range d.TaskToTags {
+		name := tagNames[link.Context.IDRef]
+		if name != "" && !lo.Contains(taskTags[link.Task.IDRef], name) {
+			taskTags[link.Task.IDRef] = append(taskTags[link.Task.IDRef], name)
+		}
+	}
+
+	result := &export{}
+	folders := make(map[string]*container, len(d.Folders))
+	for _, f := range d.Folders {
+		folders[f.ID] = &container{name: f.Name}
+	}
+	for _, f := range d.Folders {
+		if parent, ok := folderParent(f, folders); ok {
+			parent.containers = append(parent.containers, folders[f.ID])
+			continue
+		}
+		result.containers = append(result.containers, folders[f.ID])
+	}
+
+	tasksByID := make(map[string]*omniFocusTask, len(d.Tasks))
+	projects := map[string]*container{}
+	for _, t := range d.Tasks {
+		tasksByID[t.ID] = t
+		if t.Project == nil {
+			continue
+		}
+		project := &container{name: t.Name}
+		projects[t.ID] = project
+		if t.Project.Folder != nil && folders[t.Project.Folder.IDRef] != nil {
+			folder := folders[t.Project.Folder.IDRef]
+			folder.containers = append(folder.containers, project)
+			continue
+		}
+		result.containers = append(result.containers, project)
+	}
+	for _, t := range d.Tasks {
+		if t.Project != nil {
+			continue
+		}
+		converted := t.toTask(taskTags[t.ID])
+		// action groups are flattened, so their actions are added to the project
+		if project := projects[rootProjectID(t, tasksByID)]; project != nil {
+			project.tasks = append(project.tasks, converted)
+			continue
+		}
+		result.tasks = append(result.tasks, converted)
+	}
+	return result
+}
+
+func folderParent(f *omniFocusFolder, folders map[string]*container) (*container, bool) {
+	if f.Parent == nil || f.Parent.IDRef == f.ID {
+		return nil, false
+	}
+	parent, ok := folders[f.Parent.IDRef]
+	return parent, ok
+}
+
+// rootProjectID returns id of project, which contains the task, or empty string for tasks from inbox
+func rootProjectID(t *omniFocusTask, tasksByID map[string]*omniFocusTask) string {
+	visited := map[string]struct{}{}
+	for t.Parent != nil {
+		if _, ok := visited[t.ID]; ok {
+			return ""
+		}
+		visited[t.ID] = struct{}{}
+		parent, ok := tasksByID[t.Parent.IDRef]
+		if !ok {
+			return ""
+		}
+		if parent.Project != nil {
+			return parent.ID
+		}
+		t = parent
+	}
+	return ""
+}
+
+func (t *omniFocusTask) toTask(tags []string) *task {
+	return &task{
+		title:      t.Name,
+		notes:      t.Note.text(),
+		due:        parseDate(t.Due),
+		deferDate:  parseDate(t.Start),
+		completed:  t.Completed != "",
+		tags:       tags,
+		recurrence: t.RepetitionRule,
+	}
+}
+
+func (n omniFocusNote) text() string {
+	lines := make([]string, 0, len(n.Paragraphs))
+	for _, p := range n.Paragraphs {
+		lines = append(lines, strings.Join(p.Runs, ""))
+	}
+	return strings.Join(lines, "\n\n")
+}
@@ -0,0 +1,181 @@
+package gtd
+
+import (
+	"fmt"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	deferDateRelationName  = "Defer date"
+	recurrenceRelationName = "Recurrence"
+)
+
+// snapshotBuilder creates snapshots of tasks and collections of projects, areas and folders.
+// Tags, statuses and relations, which are absent in bundle, are shared between all tasks of the import path
+type snapshotBuilder struct {
+	fileName   string
+	objectType string
+	service    *collection.Service
+
+	relations *converter.RelationCreator
+	snapshots []*converter.Snapshot
+	taskCount int
+}
+
+func newSnapshotBuilder(fileName, objectType string, service *collection.Service) *snapshotBuilder {
+	b := &snapshotBuilder{
+		fileName:   fileName,
+		objectType: objectType,
+		service:    service,
+	}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// build returns all snapshots and ids of objects, that should be added to the root collection
+func (b *snapshotBuilder) build(e *export) ([]*converter.Snapshot, []string, error) {
+	rootObjects := make([]string, 0, len(e.containers)+len(e.tasks))
+	for _, c := range e.containers {
+		id, err := b.addContainer(c)
+		if err != nil {
+			return nil, nil, err
+		}
+		rootObjects = append(rootObjects, id)
+	}
+	for _, t := range e.tasks {
+		id, err := b.addTask(t)
+		if err != nil {
+			return nil, nil, err
+		}
+		rootObjects = append(rootObjects, id)
+	}
+	return b.snapshots, rootObjects, nil
+}
+
+func (b *snapshotBuilder) addContainer(c *container) (string, error) {
+	objects := make([]string, 0, len(c.containers)+len(c.tasks))
+	for _, child := range c.containers {
+		id, err := b.addContainer(child)
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	for _, t := range c.tasks {
+		id, err := b.addTask(t)
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(c.name, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for %s: %w", c.name, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+func (b *snapshotBuilder) addTask(t *task) (string, error) {
+	blocks, err := getTaskBlocks(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert notes of task %s: %w", t.title, err)
+	}
+	b.taskCount++
+	sourcePath := fmt.Sprintf("%s/%d", b.fileName, b.taskCount)
+	details := converter.GetCommonDetails(sourcePath, t.title, "", model.ObjectType_todo)
+	details.Fields[bundle.RelationKeyDone.String()] = pbtypes.Bool(t.completed)
+	relationLinks := []*model.RelationLink{{
+		Key:    bundle.RelationKeyDone.String(),
+		Format: model.RelationFormat_checkbox,
+	}}
+	if t.due != 0 {
+		details.Fields[bundle.RelationKeyDueDate.String()] = pbtypes.Int64(t.due)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyDueDate.String(),
+			Format: model.RelationFormat_date,
+		})
+	}
+	if t.deferDate != 0 {
+		relation := b.relations.Relation(deferDateRelationName, model.RelationFormat_date)
+		details.Fields[relation.Key] = pbtypes.Int64(t.deferDate)
+		relationLinks = append(relationLinks, relation)
+	}
+	if t.recurrence != "" {
+		relation := b.relations.Relation(recurrenceRelationName, model.RelationFormat_shorttext)
+		details.Fields[relation.Key] = pbtypes.String(t.recurrence)
+		relationLinks = append(relationLinks, relation)
+	}
+	if tagIDs := b.relations.OptionIDs(bundle.RelationKeyTag.String(), t.tags); len(tagIDs) != 0 {
+		details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	if t.status != "" {
+		if id := b.relations.OptionID(bundle.RelationKeyStatus.String(), t.status); id != "" {
+			details.Fields[bundle.RelationKeyStatus.String()] = pbtypes.StringList([]string{id})
+			relationLinks = append(relationLinks, &model.RelationLink{
+				Key:    bundle.RelationKeyStatus.String(),
+				Format: model.RelationFormat_status,
+			})
+		}
+	}
+	if t.url != "" {
+		details.Fields[bundle.RelationKeySource.String()] = pbtypes.String(t.url)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeySource.String(),
+			Format: model.RelationFormat_url,
+		})
+	}
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: b.fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	})
+	return id, nil
+}
+
+// getTaskBlocks converts notes of the task from Markdown and adds checklist as checkbox blocks
+func getTaskBlocks(t *task) ([]*model.Block, error) {
+	var blocks []*model.Block
+	if t.notes != "" {
+		var err error
+		blocks, _, err = anymark.MarkdownToBlocks([]byte(t.notes), "", nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, item := range t.checklist {
+		blocks = append(blocks, &model.Block{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text:    item.title,
+					Style:   model.BlockContentText_Checkbox,
+					Checked: item.completed,
+				},
+			},
+		})
+	}
+	return blocks, nil
+}
@@ -0,0 +1,62 @@
+package gtd
+
+import (
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// task is a to-do from Things, an action from OmniFocus or a card from Trello
+type task struct {
+	title      string
+	notes      string
+	due        int64
+	deferDate  int64
+	completed  bool
+	tags       []string
+	recurrence string
+	checklist  []*checklistItem
+	// status is the name of Trello list of the card
+	status string
+	url    string
+}
+
+type checklistItem struct {
+	title     string
+	completed bool
+}
+
+// container is a project, an area of Things, a folder of OmniFocus or a board of Trello. Projects and boards
+// contain tasks, areas and folders contain projects
+type container struct {
+	name       string
+	tasks      []*task
+	containers []*container
+}
+
+// export is the content of exported file: top level containers and tasks without project
+type export struct {
+	containers []*container
+	tasks      []*task
+}
+
+func (e *export) isEmpty() bool {
+	return len(e.containers) == 0 && len(e.tasks) == 0
+}
+
+// parseDate returns unix time of date in ISO 8601 format. Relative dates, like "today" or "someday", are skipped
+func parseDate(value string) int64 {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.Unix()
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t.Unix()
+	}
+	log.Debugf("unsupported date format: %s", value)
+	return 0
+}
@@ -0,0 +1,107 @@
+package gtd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+const (
+	thingsTypeToDo    = "to-do"
+	thingsTypeProject = "project"
+	thingsTypeHeading = "heading"
+	thingsTypeArea    = "area"
+)
+
+// thingsItem is an item of Things JSON format, which is used by Things for import and export of to-dos
+type thingsItem struct {
+	Type       string           `json:"type"`
+	Attributes thingsAttributes `json:"attributes"`
+}
+
+type thingsAttributes struct {
+	Title          string        `json:"title"`
+	Notes          string        `json:"notes"`
+	When           string        `json:"when"`
+	Deadline       string        `json:"deadline"`
+	Tags           []string      `json:"tags"`
+	Completed      bool          `json:"completed"`
+	Area           string        `json:"area"`
+	Repeat         string        `json:"repeat"`
+	Items          []*thingsItem `json:"items"`
+	ChecklistItems []*thingsItem `json:"checklist-items"`
+}
+
+func parseThings(r io.Reader, limits converter.ParseLimits) (*export, error) {
+	var items []*thingsItem
+	if err := limits.DecodeJSON(r, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse Things export: %w", err)
+	}
+	result := &export{}
+	areas := map[string]*container{}
+	for _, item := range items {
+		switch item.Type {
+		case thingsTypeToDo:
+			result.tasks = append(result.tasks, thingsTask(item))
+		case thingsTypeProject:
+			project := thingsContainer(item)
+			if areaName := item.Attributes.Area; areaName != "" {
+				area, ok := areas[areaName]
+				if !ok {
+					area = &container{name: areaName}
+					areas[areaName] = area
+					result.containers = append(result.containers, area)
+				}
+				area.containers = append(area.containers, project)
+				continue
+			}
+			result.containers = append(result.containers, project)
+		case thingsTypeArea:
+			result.containers = append(result.containers, thingsContainer(item))
+		default:
+			log.Warnf("unsupported type of Things item: %s", item.Type)
+		}
+	}
+	return result, nil
+}
+
+// thingsContainer converts project or area. Headings of project are skipped, their to-dos are added to the project
+func thingsContainer(item *thingsItem) *container {
+	c := &container{name: item.Attributes.Title}
+	for _, child := range item.Attributes.Items {
+		switch child.Type {
+		case thingsTypeToDo:
+			c.tasks = append(c.tasks, thingsTask(child))
+		case thingsTypeProject:
+			c.containers = append(c.containers, thingsContainer(child))
+		case thingsTypeHeading:
+			for _, headingChild := range child.Attributes.Items {
+				if headingChild.Type == thingsTypeToDo {
+					c.tasks = append(c.tasks, thingsTask(headingChild))
+				}
+			}
+		}
+	}
+	return c
+}
+
+func thingsTask(item *thingsItem) *task {
+	attrs := item.Attributes
+	t := &task{
+		title:      attrs.Title,
+		notes:      attrs.Notes,
+		due:        parseDate(attrs.Deadline),
+		deferDate:  parseDate(attrs.When),
+		completed:  attrs.Completed,
+		tags:       attrs.Tags,
+		recurrence: attrs.Repeat,
+	}
+	for _, checklist := range attrs.ChecklistItems {
+		t.checklist = append(t.checklist, &checklistItem{
+			title:     checklist.Attributes.Title,
+			completed: checklist.Attributes.Completed,
+		})
+	}
+	return t
+}
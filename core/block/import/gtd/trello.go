@@ -0,0 +1,133 @@
+package gtd
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+const trelloCheckItemComplete = "complete"
+
+// trelloBoard is JSON export of Trello board. Cards refer to their lists, labels and checklists by ids
+type trelloBoard struct {
+	Name       string             `json:"name"`
+	Lists      []*trelloList      `json:"lists"`
+	Cards      []*trelloCard      `json:"cards"`
+	Checklists []*trelloChecklist `json:"checklists"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID          string         `json:"id"`
+	Name        string         `json:"name"`
+	Desc        string         `json:"desc"`
+	IDList      string         `json:"idList"`
+	Closed      bool           `json:"closed"`
+	Due         string         `json:"due"`
+	DueComplete bool           `json:"dueComplete"`
+	Pos         float64        `json:"pos"`
+	ShortURL    string         `json:"shortUrl"`
+	Labels      []*trelloLabel `json:"labels"`
+}
+
+type trelloLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloChecklist struct {
+	IDCard     string             `json:"idCard"`
+	Pos        float64            `json:"pos"`
+	CheckItems []*trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+// parseTrello converts board to project with cards as tasks. Name of the list of card becomes its status,
+// archived cards and cards of archived lists are skipped
+func parseTrello(data []byte, limits converter.ParseLimits) (*export, error) {
+	var board trelloBoard
+	if err := limits.DecodeJSON(bytes.NewReader(data), &board); err != nil {
+		return nil, fmt.Errorf("failed to parse Trello export: %w", err)
+	}
+	lists := make(map[string]*trelloList, len(board.Lists))
+	for _, l := range board.Lists {
+		lists[l.ID] = l
+	}
+	checklists := map[string][]*trelloChecklist{}
+	for _, c := range board.Checklists {
+		checklists[c.IDCard] = append(checklists[c.IDCard], c)
+	}
+	cards := make([]*trelloCard, 0, len(board.Cards))
+	for _, c := range board.Cards {
+		if l := lists[c.IDList]; !c.Closed && (l == nil || !l.Closed) {
+			cards = append(cards, c)
+		}
+	}
+	listPos := func(c *trelloCard) float64 {
+		if l := lists[c.IDList]; l != nil {
+			return l.Pos
+		}
+		return 0
+	}
+	sort.SliceStable(cards, func(i, j int) bool {
+		if pi, pj := listPos(cards[i]), listPos(cards[j]); pi != pj {
+			return pi < pj
+		}
+		return cards[i].Pos < cards[j].Pos
+	})
+	project := &container{name: board.Name}
+	for _, c := range cards {
+		project.tasks = append(project.tasks, trelloTask(c, lists[c.IDList], checklists[c.ID]))
+	}
+	return &export{containers: []*container{project}}, nil
+}
+
+func trelloTask(c *trelloCard, l *trelloList, checklists []*trelloChecklist) *task {
+	t := &task{
+		title:     c.Name,
+		notes:     c.Desc,
+		due:       parseDate(c.Due),
+		completed: c.DueComplete,
+		url:       c.ShortURL,
+	}
+	if l != nil {
+		t.status = l.Name
+	}
+	for _, label := range c.Labels {
+		// labels without name are distinguished only by color
+		name := label.Name
+		if name == "" {
+			name = label.Color
+		}
+		t.tags = append(t.tags, name)
+	}
+	sort.SliceStable(checklists, func(i, j int) bool {
+		return checklists[i].Pos < checklists[j].Pos
+	})
+	for _, checklist := range checklists {
+		items := checklist.CheckItems
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].Pos < items[j].Pos
+		})
+		for _, item := range items {
+			t.checklist = append(t.checklist, &checklistItem{
+				title:     item.Name,
+				completed: item.State == trelloCheckItemComplete,
+			})
+		}
+	}
+	return t
+}
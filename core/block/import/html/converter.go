@@ -3,9 +3,11 @@ package html
 import (
 	"context"
 	"io"
+	"net/url"
 	"path/filepath"
 
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 
 	"github.com/anyproto/anytype-heart/core/block/collection"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
@@ -22,6 +24,7 @@ import (
 )
 
 const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
 const (
 	Name               = "Html"
 	rootCollectionName = "HTML Import"
@@ -29,15 +32,19 @@ const (
 
 var log = logging.Logger("import-html")
 
+var htmlExtensions = []string{".html", ".htm"}
+
 type HTML struct {
 	collectionService *collection.Service
 	tempDirProvider   core.TempDirProvider
+	parseLimits       converter.ParseLimits
 }
 
 func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
 	return &HTML{
 		collectionService: collectionService,
 		tempDirProvider:   tempDirProvider,
+		parseLimits:       converter.DefaultParseLimits,
 	}
 }
 
@@ -59,7 +66,7 @@ func (h *HTML) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest,
 		return nil, nil
 	}
 	progress.SetProgressMessage("Start creating snapshots from files")
-	allErrors := converter.NewError(req.Mode)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	snapshots, targetObjects := h.getSnapshots(req, progress, path, allErrors)
 	if allErrors.ShouldAbortImport(len(path), req.Type) {
 		return nil, allErrors
@@ -91,12 +98,13 @@ func (h *HTML) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest,
 func (h *HTML) getSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress, path []string, allErrors *converter.ConvertError) ([]*converter.Snapshot, []string) {
 	snapshots := make([]*converter.Snapshot, 0)
 	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, h.parseLimits)
 	for _, p := range path {
 		if err := progress.TryStep(1); err != nil {
 			allErrors.Add(converter.ErrCancel)
 			return nil, nil
 		}
-		sn, to := h.handleImportPath(p, allErrors)
+		sn, to := h.handleImportPath(p, converter.SourceFilter(req), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
 		if allErrors.ShouldAbortImport(len(path), req.Type) {
 			return nil, nil
 		}
@@ -106,8 +114,13 @@ func (h *HTML) getSnapshots(req *pb.RpcObjectImportRequest, progress process.Pro
 	return snapshots, targetObjects
 }
 
-func (h *HTML) handleImportPath(path string, allErrors *converter.ConvertError) ([]*converter.Snapshot, []string) {
-	importSource := source.GetSource(path)
+func (h *HTML) handleImportPath(path string,
+	filter *source.Filter,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(path))
 	defer importSource.Close()
 	err := importSource.Initialize(path)
 	if err != nil {
@@ -117,14 +130,15 @@ func (h *HTML) handleImportPath(path string, allErrors *converter.ConvertError)
 		}
 	}
 	var numberOfFiles int
-	if numberOfFiles = importSource.CountFilesWithGivenExtensions([]string{".html"}); numberOfFiles == 0 {
+	if numberOfFiles = importSource.CountFilesWithGivenExtensions(htmlExtensions); numberOfFiles == 0 {
 		allErrors.Add(converter.ErrNoObjectsToImport)
 		return nil, nil
 	}
-	return h.getSnapshotsAndRootObjects(path, allErrors, numberOfFiles, importSource)
+	return h.getSnapshotsAndRootObjects(path, objectType, limits, allErrors, numberOfFiles, importSource)
 }
 
-func (h *HTML) getSnapshotsAndRootObjects(path string,
+func (h *HTML) getSnapshotsAndRootObjects(path, objectType string,
+	limits converter.ParseLimits,
 	allErrors *converter.ConvertError,
 	numberOfFiles int,
 	importSource source.Source,
@@ -132,17 +146,17 @@ func (h *HTML) getSnapshotsAndRootObjects(path string,
 	snapshots := make([]*converter.Snapshot, 0, numberOfFiles)
 	rootObjects := make([]string, 0, numberOfFiles)
 	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
-		if filepath.Ext(fileName) != ".html" {
+		if !lo.Contains(htmlExtensions, filepath.Ext(fileName)) {
 			return true
 		}
-		blocks, err := h.getBlocksForSnapshot(fileReader, importSource, path)
+		blocks, err := h.getBlocksForSnapshot(fileReader, fileName, importSource, path, limits)
 		if err != nil {
-			allErrors.Add(err)
+			allErrors.Add(converter.NewFileError(fileName, err))
 			if allErrors.ShouldAbortImport(len(path), pb.RpcObjectImportRequest_Html) {
 				return false
 			}
 		}
-		sn, id := h.getSnapshot(blocks, fileName)
+		sn, id := h.getSnapshot(blocks, fileName, objectType)
 		snapshots = append(snapshots, sn)
 		rootObjects = append(rootObjects, id)
 		return true
@@ -152,28 +166,28 @@ func (h *HTML) getSnapshotsAndRootObjects(path string,
 	return snapshots, rootObjects
 }
 
-func (h *HTML) getBlocksForSnapshot(rc io.ReadCloser, filesSource source.Source, path string) ([]*model.Block, error) {
-	b, err := io.ReadAll(rc)
+func (h *HTML) getBlocksForSnapshot(rc io.ReadCloser, fileName string, filesSource source.Source, path string, limits converter.ParseLimits) ([]*model.Block, error) {
+	b, err := limits.ReadAll(rc)
 	if err != nil {
 		return nil, err
 	}
 	blocks, _, err := anymark.HTMLToBlocks(b)
 	for _, block := range blocks {
 		if block.GetFile() != nil {
-			if newFileName, _, err := converter.ProvideFileName(block.GetFile().GetName(), filesSource, path, h.tempDirProvider); err == nil {
+			if newFileName, _, err := h.provideAssetName(block.GetFile().GetName(), fileName, filesSource, path); err == nil {
 				block.GetFile().Name = newFileName
 			} else {
 				log.Errorf("failed to update file block with new file name: %v", oserror.TransformError(err))
 			}
 		}
 		if block.GetText() != nil && block.GetText().Marks != nil && len(block.GetText().Marks.Marks) > 0 {
-			h.updateFilesInLinks(block, filesSource, path)
+			h.updateFilesInLinks(block, fileName, filesSource, path)
 		}
 	}
 	return blocks, nil
 }
 
-func (h *HTML) updateFilesInLinks(block *model.Block, filesSource source.Source, path string) {
+func (h *HTML) updateFilesInLinks(block *model.Block, fileName string, filesSource source.Source, path string) {
 	marks := block.GetText().GetMarks().GetMarks()
 	for _, mark := range marks {
 		if mark.Type == model.BlockContentTextMark_Link {
@@ -182,7 +196,7 @@ func (h *HTML) updateFilesInLinks(block *model.Block, filesSource source.Source,
 				newFileName     string
 				createFileBlock bool
 			)
-			if newFileName, createFileBlock, err = converter.ProvideFileName(mark.Param, filesSource, path, h.tempDirProvider); err == nil {
+			if newFileName, createFileBlock, err = h.provideAssetName(mark.Param, fileName, filesSource, path); err == nil {
 				mark.Param = newFileName
 				if createFileBlock {
 					anymark.ConvertTextToFile(block)
@@ -195,11 +209,38 @@ func (h *HTML) updateFilesInLinks(block *model.Block, filesSource source.Source,
 	}
 }
 
-func (h *HTML) getSnapshot(blocks []*model.Block, p string) (*converter.Snapshot, string) {
+// provideAssetName looks for the asset next to the HTML file first, as tools export pages with their assets,
+// e.g. page.html and page_files/image.png. Otherwise, the asset is looked for from the root of import path
+func (h *HTML) provideAssetName(assetName, htmlFileName string, filesSource source.Source, path string) (string, bool, error) {
+	if relativeName, ok := relativeAssetName(assetName, htmlFileName); ok {
+		newFileName, createFileBlock, err := converter.ProvideFileName(relativeName, filesSource, path, h.tempDirProvider)
+		if err == nil && createFileBlock {
+			return newFileName, createFileBlock, nil
+		}
+	}
+	return converter.ProvideFileName(assetName, filesSource, path, h.tempDirProvider)
+}
+
+// relativeAssetName returns the path of the asset relative to the directory of the HTML file.
+// Links to web pages and absolute paths are not changed
+func relativeAssetName(assetName, htmlFileName string) (string, bool) {
+	if assetName == "" || filepath.IsAbs(assetName) {
+		return "", false
+	}
+	if u, err := url.Parse(assetName); err != nil || u.Scheme != "" {
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(assetName); err == nil {
+		assetName = unescaped
+	}
+	return filepath.Join(filepath.Dir(htmlFileName), filepath.FromSlash(assetName)), true
+}
+
+func (h *HTML) getSnapshot(blocks []*model.Block, p, objectType string) (*converter.Snapshot, string) {
 	sn := &model.SmartBlockSnapshotBase{
 		Blocks:      blocks,
 		Details:     converter.GetCommonDetails(p, "", "", model.ObjectType_basic),
-		ObjectTypes: []string{bundle.TypeKeyPage.String()},
+		ObjectTypes: []string{objectType},
 	}
 
 	snapshot := &converter.Snapshot{
@@ -131,6 +131,60 @@ func TestHTML_provideFileName(t *testing.T) {
 	})
 }
 
+func TestHTML_GetSnapshotsWithAssets(t *testing.T) {
+	t.Run("htm page with image next to it - image is imported as file block", func(t *testing.T) {
+		// given
+		h := &HTML{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		importPath, err := filepath.Abs("testdata/assets")
+		assert.Nil(t, err)
+
+		// when
+		sn, ce := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfHtmlParams{
+				HtmlParams: &pb.RpcObjectImportRequestHtmlParams{Path: []string{importPath}},
+			},
+			Type: pb.RpcObjectImportRequest_Html,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		// then
+		assert.Nil(t, ce)
+		assert.Len(t, sn.Snapshots, 2)
+		assert.Equal(t, pbtypes.String("page"), sn.Snapshots[0].Snapshot.Data.Details.Fields["name"])
+		var images []string
+		for _, block := range sn.Snapshots[0].Snapshot.Data.Blocks {
+			if block.GetFile() != nil {
+				images = append(images, block.GetFile().Name)
+			}
+		}
+		assert.Equal(t, []string{filepath.Join(importPath, "page_files", "pic one.png")}, images)
+	})
+}
+
+func TestRelativeAssetName(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		assetName string
+		want      string
+		ok        bool
+	}{
+		{"relative path", "page_files/image%20one.png", filepath.Join("export", "pages", "page_files", "image one.png"), true},
+		{"parent directory", "../images/image.png", filepath.Join("export", "images", "image.png"), true},
+		{"web link", "https://example.com/image.png", "", false},
+		{"empty name", "", "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			name, ok := relativeAssetName(tc.assetName, filepath.Join("export", "pages", "page.html"))
+
+			// then
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, name)
+		})
+	}
+}
+
 func prepareArchivedFiles(t *testing.T) (string, string) {
 	// create test archive
 	archiveName := filepath.Join(".", strconv.FormatInt(rand.Int63(), 10)+".zip")
@@ -0,0 +1,255 @@
+package ical
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	componentEvent = "VEVENT"
+	componentTodo  = "VTODO"
+)
+
+// property is the content line of iCalendar, e.g. DTSTART;TZID=Europe/Berlin:20240304T100000.
+// Names of property and parameters are upper case
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// component is a part of calendar between BEGIN and END lines, e.g. VEVENT or VALARM inside it
+type component struct {
+	name       string
+	properties []*property
+	children   []*component
+}
+
+func (c *component) get(name string) *property {
+	for _, p := range c.properties {
+		if p.name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (c *component) getAll(name string) []*property {
+	var properties []*property
+	for _, p := range c.properties {
+		if p.name == name {
+			properties = append(properties, p)
+		}
+	}
+	return properties
+}
+
+// text returns unescaped value of text property
+func (c *component) text(name string) string {
+	if p := c.get(name); p != nil {
+		return strings.TrimSpace(unescape(p.value))
+	}
+	return ""
+}
+
+// parseCalendar returns events and to-dos of all calendars of the file in order of appearance
+func parseCalendar(data []byte) []*component {
+	var (
+		stack []*component
+		items []*component
+	)
+	for _, line := range unfoldLines(data) {
+		p := parseProperty(line)
+		if p == nil {
+			continue
+		}
+		switch p.name {
+		case "BEGIN":
+			stack = append(stack, &component{name: strings.ToUpper(strings.TrimSpace(p.value))})
+		case "END":
+			if len(stack) == 0 {
+				continue
+			}
+			c := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, c)
+			}
+			if c.name == componentEvent || c.name == componentTodo {
+				items = append(items, c)
+			}
+		default:
+			if len(stack) > 0 {
+				current := stack[len(stack)-1]
+				current.properties = append(current.properties, p)
+			}
+		}
+	}
+	return items
+}
+
+func unfoldLines(data []byte) []string {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseProperty returns nil, if the line is not a content line
+func parseProperty(line string) *property {
+	nameAndParams, value, ok := cutOutsideQuotes(line, ':')
+	if !ok {
+		return nil
+	}
+	parts := splitOutsideQuotes(nameAndParams, ';')
+	name := strings.ToUpper(strings.TrimSpace(parts[0]))
+	if name == "" {
+		return nil
+	}
+	p := &property{name: name, params: map[string]string{}, value: value}
+	for _, param := range parts[1:] {
+		key, paramValue, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(strings.TrimSpace(key))] = strings.Trim(paramValue, `"`)
+	}
+	return p
+}
+
+func cutOutsideQuotes(s string, sep byte) (string, string, bool) {
+	inQuotes := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case sep:
+			if !inQuotes {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return s, "", false
+}
+
+func splitOutsideQuotes(s string, sep byte) []string {
+	var parts []string
+	for {
+		before, after, ok := cutOutsideQuotes(s, sep)
+		parts = append(parts, before)
+		if !ok {
+			return parts
+		}
+		s = after
+	}
+}
+
+func unescape(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
+
+// splitList splits list value like CATEGORIES by unescaped commas
+func splitList(value string) []string {
+	var (
+		items   []string
+		current strings.Builder
+	)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			current.WriteByte(value[i])
+			current.WriteByte(value[i+1])
+			i++
+		case value[i] == ',':
+			items = append(items, strings.TrimSpace(unescape(current.String())))
+			current.Reset()
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+	return append(items, strings.TrimSpace(unescape(current.String())))
+}
+
+// parseTime returns time of DATE or DATE-TIME property and whether it is a date without time.
+// Time in UTC ends with Z, time with TZID parameter is converted from that time zone,
+// floating time and dates are treated as local
+func parseTime(p *property) (time.Time, bool, error) {
+	value := strings.TrimSpace(p.value)
+	if p.params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+	location := time.Local
+	if tzid := p.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			location = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, location)
+	return t, false, err
+}
+
+// parseDuration parses duration of RFC 5545, e.g. PT1H30M, P1D or -P2W
+func parseDuration(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(value, "-"):
+		sign = -1
+		value = value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+	if !strings.HasPrefix(value, "P") || len(value) < 3 {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	var (
+		duration time.Duration
+		number   string
+		isTime   bool
+	)
+	for _, r := range value[1:] {
+		if r >= '0' && r <= '9' {
+			number += string(r)
+			continue
+		}
+		if r == 'T' {
+			isTime = true
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+		number = ""
+		switch {
+		case r == 'W' && !isTime:
+			duration += time.Duration(n) * 7 * 24 * time.Hour
+		case r == 'D' && !isTime:
+			duration += time.Duration(n) * 24 * time.Hour
+		case r == 'H' && isTime:
+			duration += time.Duration(n) * time.Hour
+		case r == 'M' && isTime:
+			duration += time.Duration(n) * time.Minute
+		case r == 'S' && isTime:
+			duration += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration: %s", value)
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid duration: %s", value)
+	}
+	return sign * duration, nil
+}
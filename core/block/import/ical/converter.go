@@ -0,0 +1,185 @@
+package ical
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "iCalendar"
+	rootCollectionName = "Calendar Import"
+	icsExtension       = ".ics"
+)
+
+var log = logging.Logger("import-ical")
+
+// ICalendar imports events and to-dos of iCalendar files. Events become objects with start and end dates,
+// recurrence rule and location, to-dos become tasks with due date. Description is converted to blocks
+type ICalendar struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &ICalendar{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (ic *ICalendar) Name() string {
+	return Name
+}
+
+func (ic *ICalendar) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetICalendarParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (ic *ICalendar) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := ic.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from calendars")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := ic.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(ic.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (ic *ICalendar) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, ic.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := ic.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of events, to-dos, tags and statuses and list of events and to-dos,
+// that should be added to the root collection
+func (ic *ICalendar) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_ICalendar) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{icsExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	var fileNames []string
+	if err := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if strings.EqualFold(filepath.Ext(fileName), icsExtension) {
+			fileNames = append(fileNames, fileName)
+		}
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	sort.Strings(fileNames)
+	builder := newSnapshotBuilder(objectType)
+	var objectIDs []string
+	for _, fileName := range fileNames {
+		ids, err := ic.handleFile(importSource, fileName, limits, builder)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_ICalendar) {
+				return nil, nil
+			}
+			continue
+		}
+		objectIDs = append(objectIDs, ids...)
+	}
+	if len(objectIDs) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return builder.snapshots, objectIDs
+}
+
+func (ic *ICalendar) handleFile(importSource source.Source,
+	fileName string,
+	limits converter.ParseLimits,
+	builder *snapshotBuilder,
+) ([]string, error) {
+	var data []byte
+	err := importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		var err error
+		data, err = limits.ReadAll(fileReader)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	components := parseCalendar(data)
+	if len(components) == 0 {
+		return nil, fmt.Errorf("file doesn't contain events or to-dos")
+	}
+	ids := make([]string, 0, len(components))
+	for i, c := range components {
+		ids = append(ids, builder.addComponent(fileName, i, c))
+	}
+	return ids, nil
+}
@@ -0,0 +1,128 @@
+package ical
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestICalendar_GetSnapshots(t *testing.T) {
+	t.Run("events have dates, recurrence and location, description is converted to blocks", func(t *testing.T) {
+		// given
+		ic := &ICalendar{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := ic.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_ICalendar, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		standup := convertertest.FindSnapshot(sn.Snapshots, "Team standup")
+		offsite := convertertest.FindSnapshot(sn.Snapshots, "Offsite")
+		review := convertertest.FindSnapshot(sn.Snapshots, "Design review")
+		slides := convertertest.FindSnapshot(sn.Snapshots, "Prepare slides")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{standup, offsite, review, slides, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{standup.Id, offsite.Id, review.Id, slides.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, []string{bundle.TypeKeyPage.String()}, standup.Snapshot.Data.ObjectTypes)
+
+		berlin, locationErr := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, locationErr)
+		details := standup.Snapshot.Data.Details
+		assert.Equal(t, time.Date(2024, time.March, 4, 10, 0, 0, 0, berlin).Unix(), int64(getRelationValue(sn.Snapshots, standup, startDateRelationName).GetNumberValue()))
+		assert.Equal(t, time.Date(2024, time.March, 4, 10, 30, 0, 0, berlin).Unix(), int64(getRelationValue(sn.Snapshots, standup, endDateRelationName).GetNumberValue()))
+		assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", getRelationValue(sn.Snapshots, standup, recurrenceRelationName).GetStringValue())
+		assert.Equal(t, "Room 42, 4th floor", getRelationValue(sn.Snapshots, standup, locationRelationName).GetStringValue())
+		assert.Equal(t, []string{"Work"}, getOptionNames(sn.Snapshots, standup, bundle.RelationKeyTag.String()))
+		assert.Equal(t, []string{"Confirmed"}, getOptionNames(sn.Snapshots, standup, bundle.RelationKeyStatus.String()))
+		assert.NotContains(t, details.Fields, bundle.RelationKeyDone.String())
+
+		var texts []string
+		for _, b := range standup.Snapshot.Data.Blocks {
+			texts = append(texts, b.GetText().Text)
+		}
+		assert.Equal(t, []string{
+			"Agenda:", "- yesterday", "- today",
+			"Organizer: Ann Smith <ann@example.com>",
+			"Attendees", "Bob Jones <bob@example.com>", "carol@example.com",
+		}, texts)
+		assert.Equal(t, model.BlockContentText_Marked, standup.Snapshot.Data.Blocks[5].GetText().Style)
+
+		assert.Equal(t, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.Local).Unix(), int64(getRelationValue(sn.Snapshots, offsite, endDateRelationName).GetNumberValue()))
+		assert.Equal(t, time.Date(2024, time.March, 5, 15, 30, 0, 0, time.UTC).Unix(), int64(getRelationValue(sn.Snapshots, review, endDateRelationName).GetNumberValue()))
+		assert.Equal(t, "https://example.com/review", pbtypes.GetString(review.Snapshot.Data.Details, bundle.RelationKeyUrl.String()))
+	})
+	t.Run("to-dos are tasks with due date and done flag", func(t *testing.T) {
+		// given
+		ic := &ICalendar{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := ic.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_ICalendar, "testdata/work.ics"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		slides := convertertest.FindSnapshot(sn.Snapshots, "Prepare slides")
+		require.NotNil(t, slides)
+		details := slides.Snapshot.Data.Details
+		assert.Equal(t, []string{bundle.TypeKeyTask.String()}, slides.Snapshot.Data.ObjectTypes)
+		assert.True(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, time.Date(2024, time.March, 8, 0, 0, 0, 0, time.Local).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()))
+		assert.Equal(t, []string{"Work", "Slides"}, getOptionNames(sn.Snapshots, slides, bundle.RelationKeyTag.String()))
+		assert.Equal(t, []string{"Completed"}, getOptionNames(sn.Snapshots, slides, bundle.RelationKeyStatus.String()))
+	})
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"PT1H30M": 90 * time.Minute,
+		"P1DT12H": 36 * time.Hour,
+		"-P2W":    -14 * 24 * time.Hour,
+		"PT45S":   45 * time.Second,
+	} {
+		duration, err := parseDuration(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, duration, value)
+	}
+	for _, value := range []string{"", "P", "1H", "PT1D", "P1H", "PT5"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
+
+// getRelationValue returns value of custom relation with given name
+func getRelationValue(snapshots []*converter.Snapshot, sn *converter.Snapshot, relationName string) *types.Value {
+	relation := convertertest.FindSnapshot(snapshots, relationName)
+	if relation == nil {
+		return nil
+	}
+	return pbtypes.Get(sn.Snapshot.Data.Details, relation.Id)
+}
+
+func getOptionNames(snapshots []*converter.Snapshot, sn *converter.Snapshot, relationKey string) []string {
+	var names []string
+	for _, id := range pbtypes.GetStringList(sn.Snapshot.Data.Details, relationKey) {
+		for _, option := range snapshots {
+			if option.Id == id {
+				names = append(names, pbtypes.GetString(option.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
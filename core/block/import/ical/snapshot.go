@@ -0,0 +1,223 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	startDateRelationName  = "Start date"
+	endDateRelationName    = "End date"
+	recurrenceRelationName = "Recurrence"
+	locationRelationName   = "Location"
+
+	defaultEventName = "Event"
+	defaultTaskName  = "Task"
+)
+
+// snapshotBuilder creates snapshots of events and to-dos of one import path. Tags, statuses and relations
+// are shared between all objects of the path
+type snapshotBuilder struct {
+	objectType string
+
+	relations *converter.RelationCreator
+	snapshots []*converter.Snapshot
+}
+
+func newSnapshotBuilder(objectType string) *snapshotBuilder {
+	b := &snapshotBuilder{objectType: objectType}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// item collects details and relation links of one object
+type item struct {
+	details       *types.Struct
+	relationLinks []*model.RelationLink
+}
+
+func (i *item) set(key string, format model.RelationFormat, value *types.Value) {
+	i.details.Fields[key] = value
+	i.relationLinks = append(i.relationLinks, &model.RelationLink{Key: key, Format: format})
+}
+
+// addComponent creates object of event or to-do and returns its id. Events get the object type of import,
+// to-dos become tasks
+func (b *snapshotBuilder) addComponent(fileName string, index int, c *component) string {
+	sourcePath := fmt.Sprintf("%s/%d", fileName, index+1)
+	isTodo := c.name == componentTodo
+	title := c.text("SUMMARY")
+	objectType, layout := b.objectType, model.ObjectType_basic
+	if isTodo {
+		objectType, layout = bundle.TypeKeyTask.String(), model.ObjectType_todo
+		if title == "" {
+			title = defaultTaskName
+		}
+	} else if title == "" {
+		title = defaultEventName
+	}
+	i := &item{details: converter.GetCommonDetails(sourcePath, title, "", layout)}
+	b.setDates(i, c, isTodo)
+	if isTodo {
+		status := strings.ToUpper(c.text("STATUS"))
+		i.set(bundle.RelationKeyDone.String(), model.RelationFormat_checkbox, pbtypes.Bool(status == "COMPLETED" || c.get("COMPLETED") != nil))
+	}
+	if rule := c.text("RRULE"); rule != "" {
+		relation := b.relations.Relation(recurrenceRelationName, model.RelationFormat_shorttext)
+		i.set(relation.Key, relation.Format, pbtypes.String(rule))
+	}
+	if location := c.text("LOCATION"); location != "" {
+		relation := b.relations.Relation(locationRelationName, model.RelationFormat_shorttext)
+		i.set(relation.Key, relation.Format, pbtypes.String(location))
+	}
+	if url := c.text("URL"); url != "" {
+		i.set(bundle.RelationKeyUrl.String(), model.RelationFormat_url, pbtypes.String(url))
+	}
+	var tags []string
+	for _, p := range c.getAll("CATEGORIES") {
+		tags = append(tags, splitList(p.value)...)
+	}
+	b.setOptions(i, bundle.RelationKeyTag.String(), model.RelationFormat_tag, tags)
+	if status := c.text("STATUS"); status != "" {
+		b.setOptions(i, bundle.RelationKeyStatus.String(), model.RelationFormat_status, []string{statusName(status)})
+	}
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        getBlocks(c),
+			Details:       i.details,
+			RelationLinks: i.relationLinks,
+			ObjectTypes:   []string{objectType},
+		}},
+	})
+	return id
+}
+
+// setDates sets start date and end date of event, which is calculated from duration, if DTEND is missing.
+// Due date of to-do is set to the bundled relation. Dates in unknown format are skipped
+func (b *snapshotBuilder) setDates(i *item, c *component, isTodo bool) {
+	var (
+		start  time.Time
+		allDay bool
+		err    error
+	)
+	if p := c.get("DTSTART"); p != nil {
+		if start, allDay, err = parseTime(p); err != nil {
+			log.Warnf("invalid start of calendar item: %v", err)
+		} else {
+			relation := b.relations.Relation(startDateRelationName, model.RelationFormat_date)
+			i.set(relation.Key, relation.Format, pbtypes.Int64(start.Unix()))
+		}
+	}
+	if isTodo {
+		if p := c.get("DUE"); p != nil {
+			if due, _, err := parseTime(p); err != nil {
+				log.Warnf("invalid due date of calendar item: %v", err)
+			} else {
+				i.set(bundle.RelationKeyDueDate.String(), model.RelationFormat_date, pbtypes.Int64(due.Unix()))
+			}
+		}
+		return
+	}
+	var end time.Time
+	if p := c.get("DTEND"); p != nil {
+		if end, _, err = parseTime(p); err != nil {
+			log.Warnf("invalid end of calendar item: %v", err)
+		}
+	} else if p = c.get("DURATION"); p != nil && !start.IsZero() {
+		if duration, err := parseDuration(p.value); err != nil {
+			log.Warnf("invalid duration of calendar item: %v", err)
+		} else {
+			end = start.Add(duration)
+		}
+	}
+	if end.IsZero() {
+		return
+	}
+	// end of all-day event is exclusive, so the last day of event is stored
+	if allDay && end.After(start) {
+		end = end.AddDate(0, 0, -1)
+	}
+	relation := b.relations.Relation(endDateRelationName, model.RelationFormat_date)
+	i.set(relation.Key, relation.Format, pbtypes.Int64(end.Unix()))
+}
+
+// getBlocks converts description to blocks, HTML description of Outlook is preferred over plain text.
+// Organizer and attendees of event are added after description
+func getBlocks(c *component) []*model.Block {
+	var blocks []*model.Block
+	if p := c.get("X-ALT-DESC"); p != nil && strings.EqualFold(p.params["FMTTYPE"], "text/html") {
+		htmlBlocks, _, err := anymark.HTMLToBlocks([]byte(unescape(p.value)))
+		if err != nil {
+			log.Warnf("failed to convert HTML description, plain text is used: %v", err)
+		}
+		blocks = htmlBlocks
+	}
+	if len(blocks) == 0 {
+		for _, line := range strings.Split(c.text("DESCRIPTION"), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				blocks = append(blocks, textBlock(line, model.BlockContentText_Paragraph))
+			}
+		}
+	}
+	if p := c.get("ORGANIZER"); p != nil {
+		blocks = append(blocks, textBlock("Organizer: "+personName(p), model.BlockContentText_Paragraph))
+	}
+	attendees := c.getAll("ATTENDEE")
+	if len(attendees) > 0 {
+		blocks = append(blocks, textBlock("Attendees", model.BlockContentText_Header3))
+		for _, p := range attendees {
+			blocks = append(blocks, textBlock(personName(p), model.BlockContentText_Marked))
+		}
+	}
+	return blocks
+}
+
+func textBlock(text string, style model.BlockContentTextStyle) *model.Block {
+	return &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}},
+	}
+}
+
+// personName returns common name of organizer or attendee with email, e.g. Ann Smith <ann@example.com>
+func personName(p *property) string {
+	email := strings.TrimSpace(p.value)
+	if len(email) > len("mailto:") && strings.EqualFold(email[:len("mailto:")], "mailto:") {
+		email = email[len("mailto:"):]
+	}
+	if name := p.params["CN"]; name != "" && name != email {
+		return name + " <" + email + ">"
+	}
+	return email
+}
+
+// statusName returns readable name of status, e.g. Needs action for NEEDS-ACTION
+func statusName(status string) string {
+	name := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(status), "-", " "))
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// setOptions sets options with given names to the relation of object
+func (b *snapshotBuilder) setOptions(i *item, relationKey string, format model.RelationFormat, names []string) {
+	if ids := b.relations.OptionIDs(relationKey, lo.Uniq(names)); len(ids) > 0 {
+		i.set(relationKey, format, pbtypes.StringList(ids))
+	}
+}
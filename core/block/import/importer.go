@@ -10,32 +10,59 @@ import (
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/anyproto/anytype-heart/core/anytype/account"
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/applenotes"
+	"github.com/anyproto/anytype-heart/core/block/import/atlassian"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/import/creator"
 	"github.com/anyproto/anytype-heart/core/block/import/csv"
+	"github.com/anyproto/anytype-heart/core/block/import/enex"
+	"github.com/anyproto/anytype-heart/core/block/import/epub"
+	"github.com/anyproto/anytype-heart/core/block/import/gdrive"
+	"github.com/anyproto/anytype-heart/core/block/import/gtd"
 	"github.com/anyproto/anytype-heart/core/block/import/html"
+	"github.com/anyproto/anytype-heart/core/block/import/ical"
+	"github.com/anyproto/anytype-heart/core/block/import/joplin"
+	"github.com/anyproto/anytype-heart/core/block/import/jsonl"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown"
+	"github.com/anyproto/anytype-heart/core/block/import/nextcloud"
 	"github.com/anyproto/anytype-heart/core/block/import/notion"
 	"github.com/anyproto/anytype-heart/core/block/import/objectid"
+	"github.com/anyproto/anytype-heart/core/block/import/onenote"
+	"github.com/anyproto/anytype-heart/core/block/import/opml"
+	"github.com/anyproto/anytype-heart/core/block/import/orgmode"
 	pbc "github.com/anyproto/anytype-heart/core/block/import/pb"
+	"github.com/anyproto/anytype-heart/core/block/import/plugin"
+	"github.com/anyproto/anytype-heart/core/block/import/quiver"
+	"github.com/anyproto/anytype-heart/core/block/import/roam"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/import/syncer"
+	"github.com/anyproto/anytype-heart/core/block/import/trilium"
 	"github.com/anyproto/anytype-heart/core/block/import/txt"
+	"github.com/anyproto/anytype-heart/core/block/import/vcard"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
 	"github.com/anyproto/anytype-heart/core/block/import/workerpool"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
 	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
@@ -51,6 +78,18 @@ const CName = "importer"
 
 const workerPoolSize = 10
 
+// ErrNoObjectsToUndo is returned on undo of import run, which objects are already deleted or never existed
+var ErrNoObjectsToUndo = errors.New("no objects created by import run")
+
+// ErrNoCheckpoint is returned on resume of import run, which is already finished or never existed
+var ErrNoCheckpoint = errors.New("no checkpoint of import run")
+
+// ErrPluginExists is returned on registration of plugin, which name is used by other plugin or builtin converter
+var ErrPluginExists = errors.New("import plugin already exists")
+
+// ErrUnknownPlugin is returned for plugin, which is not registered
+var ErrUnknownPlugin = errors.New("unknown import plugin")
+
 type Import struct {
 	converters      map[string]converter.Converter
 	s               *block.Service
@@ -58,12 +97,30 @@ type Import struct {
 	idProvider      objectid.IDProvider
 	tempDirProvider core.TempDirProvider
 	fileSync        filesync.FileSync
+	objectStore     objectstore.ObjectStore
+	objectDeleter   objectDeleter
+	templates       templateStateProvider
+	collections     collectionAdder
+	checkpoints     *checkpointStore
+	// readOnly forbids import, when account is opened in read-only mode
+	readOnly bool
 	sync.Mutex
+
+	// plugins are converters registered at runtime, they are guarded separately, so they can be
+	// registered during import
+	plugins     map[string]converter.Converter
+	pluginsLock sync.RWMutex
+}
+
+// objectDeleter deletes objects, which are created by import, on undo of the import
+type objectDeleter interface {
+	DeleteObjectByFullID(id domain.FullID) error
 }
 
 func New() Importer {
 	return &Import{
 		converters: make(map[string]converter.Converter, 0),
+		plugins:    make(map[string]converter.Converter, 0),
 	}
 }
 
@@ -81,6 +138,23 @@ func (i *Import) Init(a *app.App) (err error) {
 		html.New(col, i.tempDirProvider),
 		txt.New(col),
 		csv.New(col),
+		nextcloud.New(col),
+		trilium.New(col),
+		quiver.New(col),
+		gtd.New(col),
+		applenotes.New(col, i.tempDirProvider),
+		atlassian.New(col, i.tempDirProvider),
+		jsonl.New(col),
+		opml.New(col),
+		epub.New(col, i.tempDirProvider),
+		enex.New(col, i.tempDirProvider),
+		roam.New(col),
+		onenote.New(col, i.tempDirProvider),
+		orgmode.New(col),
+		vcard.New(col, i.tempDirProvider),
+		ical.New(col),
+		joplin.New(col, i.tempDirProvider),
+		gdrive.New(col),
 	}
 	for _, c := range converters {
 		i.converters[c.Name()] = c
@@ -88,42 +162,114 @@ func (i *Import) Init(a *app.App) (err error) {
 	resolver := a.MustComponent(idresolver.CName).(idresolver.Resolver)
 	factory := syncer.New(syncer.NewFileSyncer(i.s), syncer.NewBookmarkSyncer(i.s), syncer.NewIconSyncer(i.s, resolver))
 	store := app.MustComponent[objectstore.ObjectStore](a)
+	i.objectStore = store
+	i.objectDeleter = i.s
+	i.templates = i.s
+	i.collections = col
+	localStorage, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	i.checkpoints = newCheckpointStore(localStorage)
 	i.idProvider = objectid.NewIDProvider(store, spaceService)
 	fileStore := app.MustComponent[filestore.FileStore](a)
 	relationSyncer := syncer.NewFileRelationSyncer(i.s, fileStore)
 	objectCreator := app.MustComponent[objectcreator.Service](a)
 	i.oc = creator.New(i.s, factory, store, relationSyncer, fileStore, spaceService, objectCreator)
 	i.fileSync = app.MustComponent[filesync.FileSync](a)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		i.readOnly = cfg.ReadOnly
+	}
 	return nil
 }
 
 // Import get snapshots from converter or external api and create smartblocks from them
-func (i *Import) Import(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin) (string, error) {
+func (i *Import) Import(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin) (*ImportResponse, error) {
+	return i.runImport(ctx, req, origin, "")
+}
+
+// ImportWithRunID imports objects with the given import run id, so the caller can undo the import by the id,
+// even if the import is canceled or failed
+func (i *Import) ImportWithRunID(ctx context.Context,
+	req *pb.RpcObjectImportRequest,
+	origin model.ObjectOrigin,
+	importRunID string,
+) (*ImportResponse, error) {
+	return i.runImport(ctx, req, origin, importRunID)
+}
+
+// runImport imports objects with given import run id. New import run gets id of its process, so the import,
+// which is interrupted by crash, can be resumed by id from process events
+func (i *Import) runImport(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin, importRunID string) (*ImportResponse, error) {
 	if req.SpaceId == "" {
-		return "", fmt.Errorf("spaceId is empty")
+		return nil, fmt.Errorf("spaceId is empty")
+	}
+	if i.readOnly {
+		return nil, domain.ErrReadOnlyMode
 	}
 	i.Lock()
 	defer i.Unlock()
 	progress := i.setupProgressBar(req)
+	stopCancelWatch := cancelOnContextDone(ctx, progress)
+	defer stopCancelWatch()
+	if importRunID == "" {
+		importRunID = progress.Id()
+	}
+	if importRunID == "" {
+		importRunID = uuid.New().String()
+	}
 	var returnedErr error
 	defer func() {
 		i.finishImportProcess(returnedErr, progress)
 		i.sendFileEvents(returnedErr)
+		if returnedErr != nil && !errors.Is(returnedErr, converter.ErrCancel) {
+			i.removeEmptyCheckpoint(importRunID)
+		}
 	}()
 	if i.s != nil && !req.GetNoProgress() {
 		i.s.ProcessAdd(progress)
 	}
-	var rootCollectionID string
+	// paths of request are replaced with downloaded and decrypted temporary files, which are removed after import,
+	// so these paths are changed in the copy of request and checkpoint keeps the original ones
+	if !req.DryRun && !req.Preview {
+		i.saveCheckpointRequest(importRunID, req)
+	}
+	req = proto.Clone(req).(*pb.RpcObjectImportRequest)
+	removeDownloaded, returnedErr := downloadRemotePaths(ctx, req, progress)
+	if returnedErr != nil {
+		return nil, returnedErr
+	}
+	defer removeDownloaded()
+	removeDecrypted, returnedErr := decryptArchivePaths(req)
+	if returnedErr != nil {
+		return nil, returnedErr
+	}
+	defer removeDecrypted()
+	if req.Type == pb.RpcObjectImportRequest_Auto {
+		if returnedErr = resolveAutoImport(req); returnedErr != nil {
+			return nil, returnedErr
+		}
+	}
+	var res *ImportResponse
 	if c, ok := i.converters[req.Type.String()]; ok {
-		rootCollectionID, returnedErr = i.importFromBuiltinConverter(ctx, req, c, progress, origin)
-		return rootCollectionID, returnedErr
+		res, returnedErr = i.importFromBuiltinConverter(ctx, req, c, progress, origin, importRunID)
+		return res, returnedErr
 	}
 	if req.Type == pb.RpcObjectImportRequest_External {
-		returnedErr = i.importFromExternalSource(ctx, req, progress)
-		return rootCollectionID, returnedErr
+		res, returnedErr = i.importFromExternalSource(ctx, req, progress, importRunID)
+		return res, returnedErr
+	}
+	if req.Type == pb.RpcObjectImportRequest_Plugin {
+		c, ok := i.getPlugin(req.GetPluginParams().GetName())
+		if !ok {
+			returnedErr = fmt.Errorf("%w: %s", ErrUnknownPlugin, req.GetPluginParams().GetName())
+			return nil, returnedErr
+		}
+		res, returnedErr = i.importFromBuiltinConverter(ctx, req, c, progress, origin, importRunID)
+		return res, returnedErr
 	}
 	returnedErr = fmt.Errorf("unknown import type %s", req.Type)
-	return rootCollectionID, returnedErr
+	return nil, returnedErr
 }
 
 func (i *Import) sendFileEvents(returnedErr error) {
@@ -138,37 +284,74 @@ func (i *Import) importFromBuiltinConverter(ctx context.Context,
 	c converter.Converter,
 	progress process.Progress,
 	origin model.ObjectOrigin,
-) (string, error) {
-	allErrors := converter.NewError(req.Mode)
+	importRunID string,
+) (*ImportResponse, error) {
+	// dry run and overwrite check need all snapshots before creation of objects
+	if streamConverter, ok := c.(converter.StreamConverter); ok && !req.DryRun && !needOverwriteCheck(req) {
+		return i.importFromStream(ctx, req, streamConverter, progress, origin, importRunID)
+	}
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	res, err := c.GetSnapshots(ctx, req, progress)
 	if !err.IsEmpty() {
 		resultErr := err.GetResultError(req.Type)
 		if shouldReturnError(resultErr, res, req) {
-			return "", resultErr
+			return nil, resultErr
 		}
 		allErrors.Merge(err)
 	}
 	if res == nil {
-		return "", fmt.Errorf("source path doesn't contain %s resources to import", req.Type)
+		return nil, fmt.Errorf("source path doesn't contain %s resources to import", req.Type)
 	}
 
 	if len(res.Snapshots) == 0 {
-		return "", fmt.Errorf("source path doesn't contain %s resources to import", req.Type)
+		return nil, fmt.Errorf("source path doesn't contain %s resources to import", req.Type)
 	}
 
-	_, rootCollectionID := i.createObjects(ctx, res, progress, req, allErrors, origin)
+	if req.DryRun {
+		return i.dryRun(ctx, res, req)
+	}
+
+	if needOverwriteCheck(req) {
+		if response, checkErr := i.checkOverwrite(ctx, res, req); response != nil || checkErr != nil {
+			return response, checkErr
+		}
+	}
+
+	details, rootCollectionID := i.createObjects(ctx, res, progress, req, allErrors, origin, importRunID)
 	resultErr := allErrors.GetResultError(req.Type)
 	if resultErr != nil {
-		rootCollectionID = ""
+		return i.reportErrors(ctx, req, resultErr, allErrors, details, rootCollectionID, importRunID)
 	}
-	return rootCollectionID, resultErr
+	return &ImportResponse{RootCollectionID: rootCollectionID, ImportRunID: importRunID}, nil
+}
+
+// reportErrors returns error of import, which is finished with errors. Response with the import report is returned
+// along with the error, if the report is requested and some objects are created
+func (i *Import) reportErrors(ctx context.Context,
+	req *pb.RpcObjectImportRequest,
+	resultErr error,
+	allErrors *converter.ConvertError,
+	details map[string]*types.Struct,
+	rootCollectionID string,
+	importRunID string,
+) (*ImportResponse, error) {
+	if !shouldCreateReport(req, resultErr, details) {
+		return nil, resultErr
+	}
+	reportID, err := i.createReport(ctx, req, allErrors, details, rootCollectionID, importRunID)
+	if err != nil {
+		log.With("importRunID", importRunID).Errorf("failed to create import report: %s", err)
+		return nil, resultErr
+	}
+	return &ImportResponse{RootCollectionID: rootCollectionID, ImportRunID: importRunID, ReportID: reportID}, resultErr
 }
 
 func (i *Import) importFromExternalSource(ctx context.Context,
 	req *pb.RpcObjectImportRequest,
 	progress process.Progress,
-) error {
-	allErrors := converter.NewError(req.Mode)
+	importRunID string,
+) (*ImportResponse, error) {
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	if req.Snapshots != nil {
 		sn := make([]*converter.Snapshot, len(req.Snapshots))
 		for i, s := range req.Snapshots {
@@ -180,13 +363,124 @@ func (i *Import) importFromExternalSource(ctx context.Context,
 		res := &converter.Response{
 			Snapshots: sn,
 		}
-		i.createObjects(ctx, res, progress, req, allErrors, model.ObjectOrigin_import)
+		if req.DryRun {
+			return i.dryRun(ctx, res, req)
+		}
+		if needOverwriteCheck(req) {
+			if response, checkErr := i.checkOverwrite(ctx, res, req); response != nil || checkErr != nil {
+				return response, checkErr
+			}
+		}
+		details, _ := i.createObjects(ctx, res, progress, req, allErrors, model.ObjectOrigin_import, importRunID)
 		if !allErrors.IsEmpty() {
-			return allErrors.GetResultError(req.Type)
+			return i.reportErrors(ctx, req, allErrors.GetResultError(req.Type), allErrors, details, "", importRunID)
 		}
-		return nil
+		return &ImportResponse{ImportRunID: importRunID}, nil
+	}
+	return nil, converter.ErrNoObjectsToImport
+}
+
+func needOverwriteCheck(req *pb.RpcObjectImportRequest) bool {
+	return req.Preview || (req.UpdateExistingObjects && req.RequireOverwriteConfirmation && !req.OverwriteConfirmed)
+}
+
+// checkOverwrite returns response with existing objects, which will be modified by import. Nil response means,
+// that import can proceed: it isn't a preview and there is nothing to overwrite
+func (i *Import) checkOverwrite(ctx context.Context, res *converter.Response, req *pb.RpcObjectImportRequest) (*ImportResponse, error) {
+	objectsToOverwrite, err := i.getObjectsToOverwrite(ctx, res, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Preview {
+		return &ImportResponse{ObjectsToOverwrite: objectsToOverwrite}, nil
+	}
+	if len(objectsToOverwrite) > 0 {
+		return &ImportResponse{ObjectsToOverwrite: objectsToOverwrite}, converter.ErrOverwriteNotConfirmed
+	}
+	return nil, nil
+}
+
+// getObjectsToOverwrite resolves ids of imported objects without creating them. Objects, which have no create payload,
+// already exist in space and will be overwritten, as well as derived objects like relations, which are already created.
+// Resolving of ids changes relation options, so it is run on copies of snapshots
+func (i *Import) getObjectsToOverwrite(ctx context.Context, res *converter.Response, req *pb.RpcObjectImportRequest) ([]string, error) {
+	if !req.UpdateExistingObjects {
+		return nil, nil
+	}
+	allErrors := converter.NewError(req.Mode)
+	preview := &converter.Response{
+		Snapshots: lo.Map(res.Snapshots, func(sn *converter.Snapshot, _ int) *converter.Snapshot {
+			return sn.Copy()
+		}),
+	}
+	oldIDToNew, createPayloads, err := i.getIDForAllObjects(ctx, preview, allErrors, req)
+	if err != nil {
+		return nil, err
+	}
+	existingDerived, err := i.getExistingDerivedObjects(preview.Snapshots, oldIDToNew, createPayloads)
+	if err != nil {
+		return nil, err
+	}
+	objectsToOverwrite := make([]string, 0)
+	for _, snapshot := range preview.Snapshots {
+		id, ok := oldIDToNew[snapshot.Id]
+		if !ok || lo.Contains(objectsToOverwrite, id) {
+			continue
+		}
+		if _, ok = createPayloads[id]; !ok || lo.Contains(existingDerived, id) {
+			objectsToOverwrite = append(objectsToOverwrite, id)
+		}
+	}
+	return objectsToOverwrite, nil
+}
+
+// getExistingDerivedObjects returns ids of relations, types and options, which are present in the space. Their ids
+// are derived from unique keys, so they get create payload even if the object exists
+func (i *Import) getExistingDerivedObjects(snapshots []*converter.Snapshot,
+	oldIDToNew map[string]string,
+	createPayloads map[string]treestorage.TreeStorageCreatePayload,
+) ([]string, error) {
+	derived := make([]string, 0)
+	for _, snapshot := range snapshots {
+		id := oldIDToNew[snapshot.Id]
+		if _, ok := createPayloads[id]; ok && isDerivedSmartBlockType(snapshot.SbType) {
+			derived = append(derived, id)
+		}
+	}
+	if len(derived) == 0 {
+		return nil, nil
+	}
+	existing, err := i.objectStore.HasIDs(derived...)
+	if err != nil {
+		return nil, fmt.Errorf("check existing derived objects: %w", err)
+	}
+	return existing, nil
+}
+
+func isDerivedSmartBlockType(sbType smartblock.SmartBlockType) bool {
+	switch sbType {
+	case smartblock.SmartBlockTypeRelation, smartblock.SmartBlockTypeObjectType, smartblock.SmartBlockTypeRelationOption:
+		return true
+	}
+	return false
+}
+
+// cancelOnContextDone cancels the import process, when context is done, so import, which is run by other service,
+// is stopped with it
+func cancelOnContextDone(ctx context.Context, progress process.Progress) (stop func()) {
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			_ = progress.Cancel()
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
 	}
-	return converter.ErrNoObjectsToImport
 }
 
 func (i *Import) finishImportProcess(returnedErr error, progress process.Progress) {
@@ -229,6 +523,57 @@ func (i *Import) ListImports(_ *pb.RpcObjectImportListRequest) ([]*pb.RpcObjectI
 	return res, nil
 }
 
+// RegisterPlugin registers converter, which runs executable with args for every import with Plugin type and the name
+func (i *Import) RegisterPlugin(name, executable string, args []string) error {
+	p, err := plugin.NewProcess(name, executable, args)
+	if err != nil {
+		return err
+	}
+	if _, ok := i.converters[name]; ok {
+		return fmt.Errorf("%w: %s", ErrPluginExists, name)
+	}
+	i.pluginsLock.Lock()
+	defer i.pluginsLock.Unlock()
+	if _, ok := i.plugins[name]; ok {
+		return fmt.Errorf("%w: %s", ErrPluginExists, name)
+	}
+	i.plugins[name] = p
+	return nil
+}
+
+// UnregisterPlugin removes plugin, imports, which are already started with it, are not interrupted
+func (i *Import) UnregisterPlugin(name string) error {
+	i.pluginsLock.Lock()
+	defer i.pluginsLock.Unlock()
+	if _, ok := i.plugins[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownPlugin, name)
+	}
+	delete(i.plugins, name)
+	return nil
+}
+
+func (i *Import) getPlugin(name string) (converter.Converter, bool) {
+	i.pluginsLock.RLock()
+	defer i.pluginsLock.RUnlock()
+	c, ok := i.plugins[name]
+	return c, ok
+}
+
+// ListEntries returns files and directories of the import path, which can be selected for import
+// with IncludePaths and ExcludePaths of import request
+func (i *Import) ListEntries(req *pb.RpcObjectImportListEntriesRequest) ([]*pb.RpcObjectImportListEntriesEntry, error) {
+	if req.Path == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+	entries, err := source.ListEntries(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(entries, func(entry source.Entry, _ int) *pb.RpcObjectImportListEntriesEntry {
+		return &pb.RpcObjectImportListEntriesEntry{Path: entry.Path, IsDirectory: entry.IsDirectory}
+	}), nil
+}
+
 // ValidateNotionToken return all registered import types
 func (i *Import) ValidateNotionToken(
 	ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest,
@@ -238,6 +583,9 @@ func (i *Import) ValidateNotionToken(
 }
 
 func (i *Import) ImportWeb(ctx context.Context, req *pb.RpcObjectImportRequest) (string, *types.Struct, error) {
+	if i.readOnly {
+		return "", nil, domain.ErrReadOnlyMode
+	}
 	progress := process.NewProgress(pb.ModelProcess_Import)
 	defer progress.Finish(nil)
 	allErrors := converter.NewError(0)
@@ -254,36 +602,115 @@ func (i *Import) ImportWeb(ctx context.Context, req *pb.RpcObjectImportRequest)
 	}
 
 	progress.SetProgressMessage("Create objects")
-	details, _ := i.createObjects(ctx, res, progress, req, allErrors, model.ObjectOrigin_import)
+	details, _ := i.createObjects(ctx, res, progress, req, allErrors, model.ObjectOrigin_import, "")
 	if !allErrors.IsEmpty() {
 		return "", nil, fmt.Errorf("couldn't create objects")
 	}
 	return res.Snapshots[0].Id, details[res.Snapshots[0].Id], nil
 }
 
+// UndoImport deletes all objects, which were created by the import run with given id, and returns their ids
+func (i *Import) UndoImport(importRunID string) ([]string, error) {
+	if importRunID == "" {
+		return nil, fmt.Errorf("import run id is empty")
+	}
+	if i.readOnly {
+		return nil, domain.ErrReadOnlyMode
+	}
+	i.Lock()
+	defer i.Unlock()
+	i.removeCheckpoint(importRunID)
+	records, _, err := i.objectStore.Query(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyImportRunId.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(importRunID),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects of import run: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, ErrNoObjectsToUndo
+	}
+	deleted := make([]string, 0, len(records))
+	var mErr multierror.Error
+	for _, record := range records {
+		id := domain.FullID{
+			SpaceID:  pbtypes.GetString(record.Details, bundle.RelationKeySpaceId.String()),
+			ObjectID: pbtypes.GetString(record.Details, bundle.RelationKeyId.String()),
+		}
+		if err = i.objectDeleter.DeleteObjectByFullID(id); err != nil {
+			log.With("objectID", id.ObjectID).Errorf("failed to delete imported object: %s", err)
+			mErr.Errors = append(mErr.Errors, err)
+			continue
+		}
+		deleted = append(deleted, id.ObjectID)
+	}
+	return deleted, mErr.ErrorOrNil()
+}
+
 func (i *Import) createObjects(ctx context.Context,
 	res *converter.Response,
 	progress process.Progress,
 	req *pb.RpcObjectImportRequest,
 	allErrors *converter.ConvertError,
 	origin model.ObjectOrigin,
+	importRunID string,
 ) (map[string]*types.Struct, string) {
+	collectionObjects := takeRootCollection(req, res)
+	if err := i.applyTemplate(req, res); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(0, req.Type) {
+			return nil, ""
+		}
+	}
+	setContentHashes(res)
 	oldIDToNew, createPayloads, err := i.getIDForAllObjects(ctx, res, allErrors, req)
 	if err != nil {
 		return nil, ""
 	}
+	details := i.createBatch(ctx, res, progress, req, allErrors, origin, importRunID, oldIDToNew, createPayloads)
+	if allErrors.IsEmpty() {
+		i.removeCheckpoint(importRunID)
+	}
+	if req.GetCollectionId() != "" {
+		if err = i.addToCollection(req, collectionObjects, oldIDToNew); err != nil {
+			allErrors.Add(err)
+		}
+		return details, req.GetCollectionId()
+	}
+	return details, oldIDToNew[res.RootCollectionID]
+}
+
+// createBatch creates objects from snapshots, which ids are already resolved
+func (i *Import) createBatch(ctx context.Context,
+	res *converter.Response,
+	progress process.Progress,
+	req *pb.RpcObjectImportRequest,
+	allErrors *converter.ConvertError,
+	origin model.ObjectOrigin,
+	importRunID string,
+	oldIDToNew map[string]string,
+	createPayloads map[string]treestorage.TreeStorageCreatePayload,
+) map[string]*types.Struct {
+	// keys are taken before snapshots are filtered, so index of snapshot in its source file doesn't depend on it
+	keys := checkpointKeys(res)
+	i.resolveDuplicates(res, req, oldIDToNew, createPayloads)
+	i.resolveCheckpoint(res, importRunID, keys, oldIDToNew, createPayloads)
 	filesIDs := i.getFilesIDs(res)
 	numWorkers := workerPoolSize
 	if len(res.Snapshots) < workerPoolSize {
 		numWorkers = 1
 	}
-	do := creator.NewDataObject(ctx, oldIDToNew, createPayloads, filesIDs, origin, req.SpaceId)
+	do := creator.NewDataObject(ctx, oldIDToNew, createPayloads, filesIDs, origin, req.SpaceId, importRunID)
 	pool := workerpool.NewPool(numWorkers)
 	progress.SetProgressMessage("Create objects")
 	go i.addWork(req.SpaceId, res, pool)
 	go pool.Start(do)
-	details := i.readResultFromPool(pool, req.Mode, allErrors, progress)
-	return details, oldIDToNew[res.RootCollectionID]
+	return i.readResultFromPool(pool, req.Mode, allErrors, progress, importRunID, keys)
 }
 
 func (i *Import) getFilesIDs(res *converter.Response) []string {
@@ -301,9 +728,23 @@ func (i *Import) getIDForAllObjects(ctx context.Context,
 	allErrors *converter.ConvertError,
 	req *pb.RpcObjectImportRequest,
 ) (map[string]string, map[string]treestorage.TreeStorageCreatePayload, error) {
-	relationOptions := make([]*converter.Snapshot, 0)
 	oldIDToNew := make(map[string]string, len(res.Snapshots))
 	createPayloads := make(map[string]treestorage.TreeStorageCreatePayload, len(res.Snapshots))
+	if err := i.resolveIDs(ctx, res, allErrors, req, oldIDToNew, createPayloads); err != nil {
+		return nil, nil, err
+	}
+	return oldIDToNew, createPayloads, nil
+}
+
+// resolveIDs adds ids and create payloads of objects of snapshots to given maps
+func (i *Import) resolveIDs(ctx context.Context,
+	res *converter.Response,
+	allErrors *converter.ConvertError,
+	req *pb.RpcObjectImportRequest,
+	oldIDToNew map[string]string,
+	createPayloads map[string]treestorage.TreeStorageCreatePayload,
+) error {
+	relationOptions := make([]*converter.Snapshot, 0)
 	for _, snapshot := range res.Snapshots {
 		// we will get id of relation options after we figure out according relations keys
 		if lo.Contains(snapshot.Snapshot.GetData().GetObjectTypes(), bundle.TypeKeyRelationOption.String()) {
@@ -314,7 +755,7 @@ func (i *Import) getIDForAllObjects(ctx context.Context,
 		if err != nil {
 			allErrors.Add(err)
 			if req.Mode != pb.RpcObjectImportRequest_IGNORE_ERRORS {
-				return nil, nil, err
+				return err
 			}
 			log.With(zap.String("object name", snapshot.Id)).Error(err)
 		}
@@ -325,12 +766,12 @@ func (i *Import) getIDForAllObjects(ctx context.Context,
 		if err != nil {
 			allErrors.Add(err)
 			if req.Mode != pb.RpcObjectImportRequest_IGNORE_ERRORS {
-				return nil, nil, err
+				return err
 			}
 			log.With(zap.String("object name", option.Id)).Error(err)
 		}
 	}
-	return oldIDToNew, createPayloads, nil
+	return nil
 }
 
 func (i *Import) replaceRelationKeyWithNew(option *converter.Snapshot, oldIDToNew map[string]string) {
@@ -390,6 +831,8 @@ func (i *Import) readResultFromPool(pool *workerpool.WorkerPool,
 	mode pb.RpcObjectImportRequestMode,
 	allErrors *converter.ConvertError,
 	progress process.Progress,
+	importRunID string,
+	checkpointKeys map[string]string,
 ) map[string]*types.Struct {
 	details := make(map[string]*types.Struct, 0)
 	for r := range pool.Results() {
@@ -405,6 +848,8 @@ func (i *Import) readResultFromPool(pool *workerpool.WorkerPool,
 				pool.Stop()
 				return nil
 			}
+		} else {
+			i.saveCheckpointObject(importRunID, checkpointKeys[res.SnapshotID], res.NewID)
 		}
 		details[res.NewID] = res.Details
 	}
@@ -4,25 +4,37 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/anyproto/any-sync/commonspace/object/tree/treechangeproto"
 	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/dgraph-io/badger/v3"
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	cv "github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/import/converter/mock_converter"
+	"github.com/anyproto/anytype-heart/core/block/import/creator"
 	"github.com/anyproto/anytype-heart/core/block/import/creator/mock_creator"
 	"github.com/anyproto/anytype-heart/core/block/import/objectid/mock_objectid"
 	pbc "github.com/anyproto/anytype-heart/core/block/import/pb"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
 	"github.com/anyproto/anytype-heart/core/block/import/web/parsers"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/filestorage/filesync/mock_filesync"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -247,6 +259,78 @@ func Test_ImportIgnoreErrorModeWithTwoErrorsPerFile(t *testing.T) {
 	assert.Contains(t, res.Error(), "converter error", "creator error")
 }
 
+func Test_ImportIgnoreErrorModeWithReport(t *testing.T) {
+	// given
+	i := Import{}
+	converter := mock_converter.NewMockConverter(t)
+	e := cv.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS)
+	e.Add(cv.NewFileError("broken.md", fmt.Errorf("invalid markup")))
+	converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).Return(&cv.Response{Snapshots: []*cv.Snapshot{{
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}},
+		SbType:   smartblock.SmartBlockTypePage,
+		Id:       "page",
+	}}}, e).Times(1)
+	i.converters = map[string]cv.Converter{"Notion": converter}
+
+	var report *cv.Snapshot
+	objectCreator := mock_creator.NewMockService(t)
+	objectCreator.EXPECT().Create(mock.Anything, mock.MatchedBy(func(sn *cv.Snapshot) bool {
+		return sn.Id == "page"
+	})).Return(&types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():   pbtypes.String("Page"),
+		bundle.RelationKeyLayout.String(): pbtypes.Float64(float64(model.ObjectType_basic)),
+	}}, "pageID", nil).Times(1)
+	objectCreator.EXPECT().Create(mock.Anything, mock.MatchedBy(func(sn *cv.Snapshot) bool {
+		return sn.Id != "page"
+	})).RunAndReturn(func(_ *creator.DataObject, sn *cv.Snapshot) (*types.Struct, string, error) {
+		report = sn
+		return nil, "reportID", nil
+	}).Times(1)
+	i.oc = objectCreator
+	idGetter := mock_objectid.NewMockIDGetter(t)
+	idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+			if sn.Id == "page" {
+				return "pageID", treestorage.TreeStorageCreatePayload{}, nil
+			}
+			return "reportID", treestorage.TreeStorageCreatePayload{}, nil
+		}).Times(2)
+	i.idProvider = idGetter
+	fileSync := mock_filesync.NewMockFileSync(t)
+	fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+	i.fileSync = fileSync
+
+	// when
+	res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		Params:       &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{"test"}}},
+		Type:         0,
+		Mode:         pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		SpaceId:      "space1",
+		CreateReport: true,
+	}, model.ObjectOrigin_import)
+
+	// then
+	assert.NotNil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, "reportID", res.ReportID)
+	require.NotNil(t, report)
+	assert.Equal(t, reportName, pbtypes.GetString(report.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+	var (
+		texts   []string
+		targets []string
+	)
+	for _, block := range report.Snapshot.Data.Blocks {
+		if text := block.GetText(); text != nil {
+			texts = append(texts, text.Text)
+		}
+		if link := block.GetLink(); link != nil {
+			targets = append(targets, link.TargetBlockId)
+		}
+	}
+	assert.Equal(t, []string{reportFailedHeader, "broken.md: invalid markup", reportCreatedHeader}, texts)
+	assert.Equal(t, []string{"pageID"}, targets)
+}
+
 func Test_ImportExternalPlugin(t *testing.T) {
 	i := Import{}
 
@@ -781,7 +865,7 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 		i.fileSync = fileSync
 
 		// when
-		rootCollectionID, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
 			Params:                &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{"bafybbbbruo3kqubijrbhr24zonagbz3ksxbrutwjjoczf37axdsusu4a.pb"}}},
 			UpdateExistingObjects: false,
 			Type:                  0,
@@ -791,13 +875,12 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 
 		// then
 		assert.Nil(t, err)
-		assert.Equal(t, expectedRootCollectionID, rootCollectionID)
+		assert.Equal(t, expectedRootCollectionID, res.RootCollectionID)
 	})
 
 	t.Run("return empty root collection id in case of error", func(t *testing.T) {
 		// given
 		i := Import{}
-		expectedRootCollectionID := ""
 		originalRootCollectionID := "rootCollectionID"
 		creatorError := errors.New("creator error")
 
@@ -827,7 +910,7 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 		i.fileSync = fileSync
 
 		// when
-		rootCollectionID, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
 			Params:                &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{"bafybbbbruo3kqubijrbhr24zonagbz3ksxbrutwjjoczf37axdsusu4a.pb"}}},
 			UpdateExistingObjects: false,
 			Type:                  0,
@@ -837,13 +920,12 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 
 		// then
 		assert.NotNil(t, err)
-		assert.Equal(t, expectedRootCollectionID, rootCollectionID)
+		assert.Nil(t, res)
 	})
 
 	t.Run("return empty root collection id in case of error from import converter", func(t *testing.T) {
 		// given
 		i := Import{}
-		expectedRootCollectionID := ""
 		originalRootCollectionID := "rootCollectionID"
 		converterError := cv.NewFromError(errors.New("converter error"), pb.RpcObjectImportRequest_ALL_OR_NOTHING)
 
@@ -865,7 +947,7 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 		i.fileSync = fileSync
 
 		// when
-		rootCollectionID, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
 			Params:                &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{"bafybbbbruo3kqubijrbhr24zonagbz3ksxbrutwjjoczf37axdsusu4a.pb"}}},
 			UpdateExistingObjects: false,
 			Type:                  0,
@@ -875,13 +957,12 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 
 		// then
 		assert.NotNil(t, err)
-		assert.Equal(t, expectedRootCollectionID, rootCollectionID)
+		assert.Nil(t, res)
 	})
 
 	t.Run("return empty root collection id in case of error with Ignore_Error mode", func(t *testing.T) {
 		// given
 		i := Import{}
-		expectedRootCollectionID := ""
 		originalRootCollectionID := "rootCollectionID"
 		converterError := cv.NewFromError(errors.New("converter error"), pb.RpcObjectImportRequest_ALL_OR_NOTHING)
 
@@ -911,7 +992,7 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 		i.fileSync = fileSync
 
 		// when
-		rootCollectionID, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
 			Params:                &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{Path: []string{"bafybbbbruo3kqubijrbhr24zonagbz3ksxbrutwjjoczf37axdsusu4a.pb"}}},
 			UpdateExistingObjects: false,
 			Type:                  0,
@@ -921,6 +1002,763 @@ func Test_ImportRootCollectionInResponse(t *testing.T) {
 
 		// then
 		assert.NotNil(t, err)
-		assert.Equal(t, expectedRootCollectionID, rootCollectionID)
+		assert.Nil(t, res)
+	})
+}
+
+func Test_ImportOverwritePreview(t *testing.T) {
+	getConverter := func(t *testing.T) *mock_converter.MockConverter {
+		converter := mock_converter.NewMockConverter(t)
+		converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).Return(&cv.Response{
+			Snapshots: []*cv.Snapshot{
+				{Snapshot: &pb.ChangeSnapshot{}, Id: "existing", SbType: smartblock.SmartBlockTypePage},
+				{Snapshot: &pb.ChangeSnapshot{}, Id: "new", SbType: smartblock.SmartBlockTypePage},
+			},
+		}, nil).Times(1)
+		return converter
+	}
+	getIDGetter := func(t *testing.T) *mock_objectid.MockIDGetter {
+		idGetter := mock_objectid.NewMockIDGetter(t)
+		idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, true).RunAndReturn(
+			func(spaceID string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+				if sn.Id == "existing" {
+					return "existingID", treestorage.TreeStorageCreatePayload{}, nil
+				}
+				return "newID", treestorage.TreeStorageCreatePayload{RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: "newID"}}, nil
+			})
+		return idGetter
+	}
+	t.Run("preview with overwrite mode returns existing objects and doesn't modify them", func(t *testing.T) {
+		// given
+		i := Import{}
+		i.converters = map[string]cv.Converter{"Notion": getConverter(t)}
+		i.oc = mock_creator.NewMockService(t)
+		i.idProvider = getIDGetter(t)
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		// when
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:                &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			UpdateExistingObjects: true,
+			Preview:               true,
+			Type:                  pb.RpcObjectImportRequest_Notion,
+			SpaceId:               "space1",
+		}, model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"existingID"}, res.ObjectsToOverwrite)
+		assert.Empty(t, res.RootCollectionID)
+	})
+	t.Run("overwrite without confirmation returns error and existing objects", func(t *testing.T) {
+		// given
+		i := Import{}
+		i.converters = map[string]cv.Converter{"Notion": getConverter(t)}
+		i.oc = mock_creator.NewMockService(t)
+		i.idProvider = getIDGetter(t)
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		// when
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:                       &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			UpdateExistingObjects:        true,
+			RequireOverwriteConfirmation: true,
+			Type:                         pb.RpcObjectImportRequest_Notion,
+			SpaceId:                      "space1",
+		}, model.ObjectOrigin_import)
+
+		// then
+		assert.True(t, errors.Is(err, cv.ErrOverwriteNotConfirmed))
+		assert.Equal(t, []string{"existingID"}, res.ObjectsToOverwrite)
+	})
+	t.Run("confirmed overwrite creates objects", func(t *testing.T) {
+		// given
+		i := Import{}
+		i.converters = map[string]cv.Converter{"Notion": getConverter(t)}
+		creator := mock_creator.NewMockService(t)
+		creator.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, "", nil).Times(2)
+		i.oc = creator
+		i.idProvider = getIDGetter(t)
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		// when
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:                       &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			UpdateExistingObjects:        true,
+			RequireOverwriteConfirmation: true,
+			OverwriteConfirmed:           true,
+			Type:                         pb.RpcObjectImportRequest_Notion,
+			SpaceId:                      "space1",
+		}, model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Empty(t, res.ObjectsToOverwrite)
+	})
+	t.Run("preview returns existing derived objects and doesn't change snapshots", func(t *testing.T) {
+		// given
+		i := Import{}
+		store := objectstore.NewStoreFixture(t)
+		store.AddObjects(t, []objectstore.TestObject{{
+			bundle.RelationKeyId:      pbtypes.String(addr.RelationKeyToIdPrefix + "new"),
+			bundle.RelationKeySpaceId: pbtypes.String("space1"),
+		}})
+		i.objectStore = store
+		newIDs := map[string]string{addr.RelationKeyToIdPrefix + "old": addr.RelationKeyToIdPrefix + "new", "option": "optionID"}
+		idGetter := mock_objectid.NewMockIDGetter(t)
+		idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, true).RunAndReturn(
+			func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+				id := newIDs[sn.Id]
+				return id, treestorage.TreeStorageCreatePayload{RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: id}}, nil
+			}).Times(2)
+		i.idProvider = idGetter
+		option := &cv.Snapshot{Id: "option", SbType: smartblock.SmartBlockTypeRelationOption, Snapshot: &pb.ChangeSnapshot{
+			Data: &model.SmartBlockSnapshotBase{
+				Details:     &types.Struct{Fields: map[string]*types.Value{bundle.RelationKeyRelationKey.String(): pbtypes.String("old")}},
+				ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+			},
+		}}
+		res := &cv.Response{Snapshots: []*cv.Snapshot{
+			{Id: addr.RelationKeyToIdPrefix + "old", SbType: smartblock.SmartBlockTypeRelation, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}}},
+			option,
+		}}
+
+		// when
+		objectsToOverwrite, err := i.getObjectsToOverwrite(context.Background(), res, &pb.RpcObjectImportRequest{
+			UpdateExistingObjects: true,
+			Preview:               true,
+			SpaceId:               "space1",
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, []string{addr.RelationKeyToIdPrefix + "new"}, objectsToOverwrite)
+		assert.Equal(t, "old", pbtypes.GetString(option.Snapshot.Data.Details, bundle.RelationKeyRelationKey.String()))
+	})
+}
+
+func Test_ImportDryRun(t *testing.T) {
+	t.Run("dry run returns summary and doesn't create objects", func(t *testing.T) {
+		// given
+		dir := writeFiles(t, map[string]string{"image.png": "image"})
+		i := Import{}
+		converter := mock_converter.NewMockConverter(t)
+		converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).Return(&cv.Response{
+			Snapshots: []*cv.Snapshot{
+				{Id: "page1", SbType: smartblock.SmartBlockTypePage, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+					ObjectTypes: []string{bundle.TypeKeyPage.URL()},
+					Blocks: []*model.Block{{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+						Name: filepath.Join(dir, "image.png"),
+					}}}},
+				}}},
+				{Id: "page2", SbType: smartblock.SmartBlockTypePage, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+					ObjectTypes: []string{bundle.TypeKeyPage.URL()},
+					Blocks: []*model.Block{{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+						Name: "https://example.com/image.png",
+					}}}},
+				}}},
+				{Id: "relation", SbType: smartblock.SmartBlockTypeRelation, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+					ObjectTypes: []string{bundle.TypeKeyRelation.URL()},
+					Details: &types.Struct{Fields: map[string]*types.Value{
+						bundle.RelationKeyName.String(): pbtypes.String("Priority"),
+					}},
+				}}},
+			},
+		}, nil).Times(1)
+		i.converters = map[string]cv.Converter{"Notion": converter}
+		i.oc = mock_creator.NewMockService(t)
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		// when
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:  &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			DryRun:  true,
+			Type:    pb.RpcObjectImportRequest_Notion,
+			SpaceId: "space1",
+		}, model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Empty(t, res.RootCollectionID)
+		assert.Equal(t, []*pb.RpcObjectImportResponseDryRunSummaryObjectTypeCount{
+			{ObjectType: bundle.TypeKeyPage.URL(), Count: 2},
+			{ObjectType: bundle.TypeKeyRelation.URL(), Count: 1},
+		}, res.DryRunSummary.ObjectTypes)
+		assert.Equal(t, []string{"Priority"}, res.DryRunSummary.Relations)
+		assert.Empty(t, res.DryRunSummary.Conflicts)
+		assert.Equal(t, int64(len("image")), res.DryRunSummary.FilesSize)
+	})
+}
+
+func Test_ImportDuplicateStrategy(t *testing.T) {
+	getSnapshot := func(id, source, text string) *cv.Snapshot {
+		return &cv.Snapshot{Id: id, SbType: smartblock.SmartBlockTypePage, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details: &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():           pbtypes.String(id),
+				bundle.RelationKeySourceFilePath.String(): pbtypes.String(source),
+			}},
+			Blocks: []*model.Block{{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}}},
+		}}}
+	}
+	prepareImport := func(t *testing.T, createdObjects int) (*Import, *[]string) {
+		i := &Import{}
+		store := objectstore.NewStoreFixture(t)
+		store.AddObjects(t, []objectstore.TestObject{{
+			bundle.RelationKeyId:                pbtypes.String("existing"),
+			bundle.RelationKeySpaceId:           pbtypes.String("space1"),
+			bundle.RelationKeySourceFilePath:    pbtypes.String("dup.md"),
+			bundle.RelationKeyImportContentHash: pbtypes.String(contentHash(getSnapshot("dup", "dup.md", "text").Snapshot.Data)),
+		}})
+		i.objectStore = store
+
+		converter := mock_converter.NewMockConverter(t)
+		converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).Return(&cv.Response{
+			Snapshots: []*cv.Snapshot{getSnapshot("dup", "dup.md", "text"), getSnapshot("changed", "dup.md", "new text")},
+		}, nil).Times(1)
+		i.converters = map[string]cv.Converter{"Notion": converter}
+
+		idGetter := mock_objectid.NewMockIDGetter(t)
+		idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+				return "new" + sn.Id, treestorage.TreeStorageCreatePayload{RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: "new" + sn.Id}}, nil
+			}).Times(2)
+		i.idProvider = idGetter
+
+		var created []string
+		objectCreator := mock_creator.NewMockService(t)
+		objectCreator.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ *creator.DataObject, sn *cv.Snapshot) (*types.Struct, string, error) {
+				created = append(created, sn.Id)
+				return nil, "new" + sn.Id, nil
+			}).Times(createdObjects)
+		i.oc = objectCreator
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+		return i, &created
+	}
+	getRequest := func(strategy pb.RpcObjectImportRequestDuplicateStrategy) *pb.RpcObjectImportRequest {
+		return &pb.RpcObjectImportRequest{
+			Params:            &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			Type:              pb.RpcObjectImportRequest_Notion,
+			Mode:              pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			DuplicateStrategy: strategy,
+			SpaceId:           "space1",
+		}
+	}
+	t.Run("skip strategy doesn't create objects with the same source and content", func(t *testing.T) {
+		// given
+		i, created := prepareImport(t, 1)
+
+		// when
+		_, err := i.Import(context.Background(), getRequest(pb.RpcObjectImportRequest_SKIP), model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"changed"}, *created)
+	})
+	t.Run("merge strategy updates existing object", func(t *testing.T) {
+		// given
+		i, created := prepareImport(t, 2)
+
+		// when
+		_, err := i.Import(context.Background(), getRequest(pb.RpcObjectImportRequest_MERGE), model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"dup", "changed"}, *created)
+	})
+	t.Run("duplicate strategy creates all objects", func(t *testing.T) {
+		// given
+		i, created := prepareImport(t, 2)
+
+		// when
+		_, err := i.Import(context.Background(), getRequest(pb.RpcObjectImportRequest_DUPLICATE), model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"dup", "changed"}, *created)
+	})
+}
+
+// prepareInterruptedImport returns import, which run "run" failed to create the second of two snapshots,
+// and ids of created snapshots
+func prepareInterruptedImport(t *testing.T, firstSource, secondSource string) (*Import, *[]string) {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	i := Import{checkpoints: newCheckpointStore(db)}
+
+	var run int
+	converter := mock_converter.NewMockConverter(t)
+	converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(context.Context, *pb.RpcObjectImportRequest, process.Progress) (*cv.Response, *cv.ConvertError) {
+			// converter generates new ids of snapshots on each run
+			run++
+			getSnapshot := func(name, source string) *cv.Snapshot {
+				return &cv.Snapshot{Id: fmt.Sprintf("%s%d", name, run), SbType: smartblock.SmartBlockTypePage, Snapshot: &pb.ChangeSnapshot{
+					Data: &model.SmartBlockSnapshotBase{Details: &types.Struct{Fields: map[string]*types.Value{
+						bundle.RelationKeySourceFilePath.String(): pbtypes.String(source),
+					}}},
+				}}
+			}
+			return &cv.Response{Snapshots: []*cv.Snapshot{getSnapshot("first", firstSource), getSnapshot("second", secondSource)}}, nil
+		}).Times(2)
+	i.converters = map[string]cv.Converter{"Notion": converter}
+
+	idGetter := mock_objectid.NewMockIDGetter(t)
+	idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+			return "new" + sn.Id, treestorage.TreeStorageCreatePayload{RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: "new" + sn.Id}}, nil
+		}).Times(4)
+	i.idProvider = idGetter
+
+	var created []string
+	objectCreator := mock_creator.NewMockService(t)
+	objectCreator.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ *creator.DataObject, sn *cv.Snapshot) (*types.Struct, string, error) {
+			if sn.Id == "second1" {
+				return nil, "", errors.New("crash")
+			}
+			created = append(created, sn.Id)
+			return nil, "new" + sn.Id, nil
+		}).Times(3)
+	i.oc = objectCreator
+
+	fileSync := mock_filesync.NewMockFileSync(t)
+	fileSync.EXPECT().SendImportEvents().Return().Times(1)
+	fileSync.EXPECT().ClearImportEvents().Return().Times(2)
+	i.fileSync = fileSync
+
+	_, err = i.runImport(context.Background(), &pb.RpcObjectImportRequest{
+		Params:  &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+		Type:    pb.RpcObjectImportRequest_Notion,
+		Mode:    pb.RpcObjectImportRequest_ALL_OR_NOTHING,
+		SpaceId: "space1",
+	}, model.ObjectOrigin_import, "run")
+	require.NotNil(t, err)
+	return &i, &created
+}
+
+func Test_ResumeImport(t *testing.T) {
+	t.Run("resumed import doesn't create objects created before interruption", func(t *testing.T) {
+		// given
+		i, created := prepareInterruptedImport(t, "first.md", "second.md")
+
+		// when
+		res, err := i.ResumeImport(context.Background(), "run", model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, "run", res.ImportRunID)
+		assert.Equal(t, []string{"first1", "second2"}, *created)
+		_, err = i.ResumeImport(context.Background(), "run", model.ObjectOrigin_import)
+		assert.True(t, errors.Is(err, ErrNoCheckpoint))
 	})
+	t.Run("resumed import creates objects of the same source file, which weren't created before interruption", func(t *testing.T) {
+		// given
+		i, created := prepareInterruptedImport(t, "notes.enex", "notes.enex")
+
+		// when
+		_, err := i.ResumeImport(context.Background(), "run", model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"first1", "second2"}, *created)
+	})
+}
+
+func Test_ImportCheckpointRequest(t *testing.T) {
+	t.Run("checkpoint keeps original paths of request, which are replaced with temporary files", func(t *testing.T) {
+		// given
+		db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+		require.NoError(t, err)
+		defer db.Close()
+		i := Import{checkpoints: newCheckpointStore(db)}
+		archive := filepath.Join("source", "testdata", "zipcrypto.zip")
+		var convertedPath string
+		converter := mock_converter.NewMockConverter(t)
+		converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, req *pb.RpcObjectImportRequest, _ process.Progress) (*cv.Response, *cv.ConvertError) {
+				convertedPath = req.GetMarkdownParams().GetPath()[0]
+				return nil, cv.NewCancelError(errors.New("canceled by user"))
+			}).Times(1)
+		i.converters = map[string]cv.Converter{"Markdown": converter}
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+		req := &pb.RpcObjectImportRequest{
+			Params:   &pb.RpcObjectImportRequestParamsOfMarkdownParams{MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: []string{archive}}},
+			Type:     pb.RpcObjectImportRequest_Markdown,
+			SpaceId:  "space1",
+			Password: "secret",
+		}
+
+		// when
+		_, err = i.runImport(context.Background(), req, model.ObjectOrigin_import, "run")
+
+		// then
+		assert.True(t, errors.Is(err, cv.ErrCancel))
+		assert.NotEqual(t, archive, convertedPath)
+		assert.NoFileExists(t, convertedPath)
+		assert.Equal(t, []string{archive}, req.GetMarkdownParams().GetPath())
+		stored, err := i.checkpoints.getRequest("run")
+		require.NoError(t, err)
+		assert.Equal(t, []string{archive}, stored.GetMarkdownParams().GetPath())
+		assert.Empty(t, stored.Password)
+	})
+	t.Run("resume of encrypted archive requires password", func(t *testing.T) {
+		// given
+		db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true))
+		require.NoError(t, err)
+		defer db.Close()
+		i := Import{checkpoints: newCheckpointStore(db)}
+		i.saveCheckpointRequest("run", &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfMarkdownParams{MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{
+				Path: []string{filepath.Join("source", "testdata", "zipcrypto.zip")},
+			}},
+			Type:     pb.RpcObjectImportRequest_Markdown,
+			SpaceId:  "space1",
+			Password: "secret",
+		})
+
+		// when
+		_, err = i.ResumeImport(context.Background(), "run", model.ObjectOrigin_import)
+
+		// then
+		assert.True(t, errors.Is(err, source.ErrPasswordRequired))
+	})
+}
+
+// streamConverter passes each batch to the stream after preparing ids of all snapshots
+type streamConverter struct {
+	batches          [][]*cv.Snapshot
+	rootCollectionID string
+	sentBatches      int
+}
+
+func (c *streamConverter) GetSnapshots(context.Context, *pb.RpcObjectImportRequest, process.Progress) (*cv.Response, *cv.ConvertError) {
+	return nil, cv.NewFromError(errors.New("snapshots are streamed"), pb.RpcObjectImportRequest_ALL_OR_NOTHING)
+}
+
+func (c *streamConverter) GetSnapshotStream(_ context.Context, req *pb.RpcObjectImportRequest, _ process.Progress, stream cv.SnapshotStream) (*cv.Response, *cv.ConvertError) {
+	var stubs []*cv.Snapshot
+	for _, batch := range c.batches {
+		stubs = append(stubs, batch...)
+	}
+	if err := stream.Prepare(stubs); err != nil {
+		return nil, cv.NewFromError(err, req.Mode)
+	}
+	for _, batch := range c.batches {
+		c.sentBatches++
+		if err := stream.Send(batch); err != nil {
+			return nil, cv.NewFromError(err, req.Mode)
+		}
+	}
+	return &cv.Response{RootCollectionID: c.rootCollectionID}, nil
+}
+
+func (c *streamConverter) Name() string {
+	return "Notion"
+}
+
+func Test_ImportFromStream(t *testing.T) {
+	t.Run("objects are created batch by batch during conversion", func(t *testing.T) {
+		// given
+		i := Import{}
+		getSnapshot := func(id string) *cv.Snapshot {
+			return &cv.Snapshot{Id: id, SbType: smartblock.SmartBlockTypePage, Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}}}
+		}
+		converter := &streamConverter{
+			batches:          [][]*cv.Snapshot{{getSnapshot("page1"), getSnapshot("page2")}, {getSnapshot("collection")}},
+			rootCollectionID: "collection",
+		}
+		i.converters = map[string]cv.Converter{"Notion": converter}
+
+		idGetter := mock_objectid.NewMockIDGetter(t)
+		idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+				return "new" + sn.Id, treestorage.TreeStorageCreatePayload{RootRawChange: &treechangeproto.RawTreeChangeWithId{Id: "new" + sn.Id}}, nil
+			}).Times(3)
+		i.idProvider = idGetter
+
+		createdInBatch := map[string]int{}
+		objectCreator := mock_creator.NewMockService(t)
+		objectCreator.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ *creator.DataObject, sn *cv.Snapshot) (*types.Struct, string, error) {
+				createdInBatch[sn.Id] = converter.sentBatches
+				return nil, "new" + sn.Id, nil
+			}).Times(3)
+		i.oc = objectCreator
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		// when
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:  &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			Type:    pb.RpcObjectImportRequest_Notion,
+			Mode:    pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			SpaceId: "space1",
+		}, model.ObjectOrigin_import)
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, "newcollection", res.RootCollectionID)
+		assert.Equal(t, map[string]int{"page1": 1, "page2": 1, "collection": 2}, createdInBatch)
+	})
+}
+
+func Test_ImportAutoFormat(t *testing.T) {
+	t.Run("format hint routes files to converter even if detection chooses another", func(t *testing.T) {
+		// given
+		dir := writeFiles(t, map[string]string{"note.md": "# Note", "other.md": "text"})
+		i := Import{}
+		markdownConverter := mock_converter.NewMockConverter(t)
+		txtConverter := mock_converter.NewMockConverter(t)
+		txtConverter.EXPECT().GetSnapshots(mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectImportRequest) bool {
+			return req.Type == pb.RpcObjectImportRequest_Txt && assert.ObjectsAreEqual([]string{dir}, req.GetTxtParams().GetPath())
+		}), mock.Anything).Return(nil, cv.NewFromError(cv.ErrNoObjectsToImport, pb.RpcObjectImportRequest_IGNORE_ERRORS)).Times(1)
+		i.converters = map[string]cv.Converter{"Markdown": markdownConverter, "Txt": txtConverter}
+		i.fileSync = getFileSyncWithoutEvents(t)
+
+		// when
+		_, err := i.Import(context.Background(), getAutoRequest(dir, "Txt"), model.ObjectOrigin_import)
+
+		// then
+		assert.True(t, errors.Is(err, cv.ErrNoObjectsToImport))
+	})
+	t.Run("no format hint - format is detected", func(t *testing.T) {
+		// given
+		dir := writeFiles(t, map[string]string{"note.md": "# Note", "image.png": "\x89PNG"})
+		i := Import{}
+		markdownConverter := mock_converter.NewMockConverter(t)
+		markdownConverter.EXPECT().GetSnapshots(mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectImportRequest) bool {
+			return req.Type == pb.RpcObjectImportRequest_Markdown
+		}), mock.Anything).Return(nil, cv.NewFromError(cv.ErrNoObjectsToImport, pb.RpcObjectImportRequest_IGNORE_ERRORS)).Times(1)
+		i.converters = map[string]cv.Converter{"Markdown": markdownConverter}
+		i.fileSync = getFileSyncWithoutEvents(t)
+
+		// when
+		_, err := i.Import(context.Background(), getAutoRequest(dir, ""), model.ObjectOrigin_import)
+
+		// then
+		assert.True(t, errors.Is(err, cv.ErrNoObjectsToImport))
+	})
+	t.Run("content clearly doesn't match format hint - return error", func(t *testing.T) {
+		// given
+		dir := writeFiles(t, map[string]string{"notes.md": "SQLite format 3\x00"})
+		i := Import{}
+		i.converters = map[string]cv.Converter{"Markdown": mock_converter.NewMockConverter(t)}
+		i.fileSync = getFileSyncWithoutEvents(t)
+
+		// when
+		_, err := i.Import(context.Background(), getAutoRequest(dir, "Markdown"), model.ObjectOrigin_import)
+
+		// then
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "looks like AppleNotes, not Markdown")
+	})
+	t.Run("unknown format hint - return error", func(t *testing.T) {
+		// given
+		dir := writeFiles(t, map[string]string{"note.md": "# Note"})
+		i := Import{}
+		i.fileSync = getFileSyncWithoutEvents(t)
+
+		// when
+		_, err := i.Import(context.Background(), getAutoRequest(dir, "Unknown"), model.ObjectOrigin_import)
+
+		// then
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unknown format hint")
+	})
+}
+
+func Test_CancelOnContextDone(t *testing.T) {
+	t.Run("hidden import process is canceled with context", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		progress := process.NewNoOp()
+		stop := cancelOnContextDone(ctx, progress)
+		defer stop()
+
+		// when
+		cancel()
+
+		// then
+		select {
+		case <-progress.Canceled():
+		case <-time.After(time.Second):
+			t.Fatal("import process is not canceled")
+		}
+		assert.Error(t, progress.TryStep(1))
+	})
+	t.Run("finished import isn't canceled", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		progress := process.NewNoOp()
+		stop := cancelOnContextDone(ctx, progress)
+
+		// when
+		stop()
+		cancel()
+
+		// then
+		assert.NoError(t, progress.TryStep(1))
+	})
+}
+
+func Test_UndoImport(t *testing.T) {
+	t.Run("objects created by import are deleted", func(t *testing.T) {
+		// given
+		i := Import{}
+		store := objectstore.NewStoreFixture(t)
+		i.objectStore = store
+		i.objectDeleter = &storeDeleter{store: store}
+		store.AddObjects(t, []objectstore.TestObject{{
+			bundle.RelationKeyId:          pbtypes.String("other"),
+			bundle.RelationKeySpaceId:     pbtypes.String("space1"),
+			bundle.RelationKeyImportRunId: pbtypes.String("otherRun"),
+		}})
+
+		converter := mock_converter.NewMockConverter(t)
+		converter.EXPECT().GetSnapshots(mock.Anything, mock.Anything, mock.Anything).Return(&cv.Response{
+			Snapshots: []*cv.Snapshot{
+				{Id: "page", Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}}},
+				{Id: "collection", Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{}}},
+			},
+			RootCollectionID: "collection",
+		}, nil).Times(1)
+		i.converters = map[string]cv.Converter{"Notion": converter}
+
+		idGetter := mock_objectid.NewMockIDGetter(t)
+		idGetter.EXPECT().GetID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ string, sn *cv.Snapshot, _ time.Time, _ bool) (string, treestorage.TreeStorageCreatePayload, error) {
+				return "new" + sn.Id, treestorage.TreeStorageCreatePayload{}, nil
+			}).Times(2)
+		i.idProvider = idGetter
+
+		objectCreator := mock_creator.NewMockService(t)
+		objectCreator.EXPECT().Create(mock.Anything, mock.Anything).RunAndReturn(
+			func(dataObject *creator.DataObject, sn *cv.Snapshot) (*types.Struct, string, error) {
+				store.AddObjects(t, []objectstore.TestObject{{
+					bundle.RelationKeyId:          pbtypes.String("new" + sn.Id),
+					bundle.RelationKeySpaceId:     pbtypes.String("space1"),
+					bundle.RelationKeyImportRunId: pbtypes.String(dataObject.ImportRunID()),
+				}})
+				return nil, "new" + sn.Id, nil
+			}).Times(2)
+		i.oc = objectCreator
+
+		fileSync := mock_filesync.NewMockFileSync(t)
+		fileSync.EXPECT().SendImportEvents().Return().Times(1)
+		fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+		i.fileSync = fileSync
+
+		res, err := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+			Params:  &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+			Type:    pb.RpcObjectImportRequest_Notion,
+			Mode:    pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			SpaceId: "space1",
+		}, model.ObjectOrigin_import)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, res.ImportRunID)
+		assert.Equal(t, "newcollection", res.RootCollectionID)
+
+		// when
+		deleted, err := i.UndoImport(res.ImportRunID)
+
+		// then
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"newpage", "newcollection"}, deleted)
+		records, err := store.QueryByID([]string{"newpage", "newcollection", "other"})
+		assert.Nil(t, err)
+		assert.Len(t, records, 1)
+		assert.Equal(t, "other", pbtypes.GetString(records[0].Details, bundle.RelationKeyId.String()))
+	})
+	t.Run("import run without objects - return error", func(t *testing.T) {
+		// given
+		i := Import{}
+		i.objectStore = objectstore.NewStoreFixture(t)
+
+		// when
+		deleted, err := i.UndoImport("run")
+
+		// then
+		assert.Empty(t, deleted)
+		assert.True(t, errors.Is(err, ErrNoObjectsToUndo))
+	})
+}
+
+// storeDeleter removes objects from object store, as object deletion does
+type storeDeleter struct {
+	store *objectstore.StoreFixture
+}
+
+func (d *storeDeleter) DeleteObjectByFullID(id domain.FullID) error {
+	return d.store.DeleteDetails(id.ObjectID)
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	return dir
+}
+
+func getAutoRequest(path, formatHint string) *pb.RpcObjectImportRequest {
+	return &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfAutoParams{
+			AutoParams: &pb.RpcObjectImportRequestAutoParams{Path: []string{path}, FormatHint: formatHint},
+		},
+		Type:    pb.RpcObjectImportRequest_Auto,
+		Mode:    pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		SpaceId: "space1",
+	}
+}
+
+func getFileSyncWithoutEvents(t *testing.T) *mock_filesync.MockFileSync {
+	fileSync := mock_filesync.NewMockFileSync(t)
+	fileSync.EXPECT().ClearImportEvents().Return().Times(1)
+	return fileSync
+}
+
+func Test_ImportReadOnly(t *testing.T) {
+	// given
+	i := Import{readOnly: true}
+
+	// when
+	_, importErr := i.Import(context.Background(), &pb.RpcObjectImportRequest{
+		Params:  &pb.RpcObjectImportRequestParamsOfNotionParams{NotionParams: &pb.RpcObjectImportRequestNotionParams{}},
+		Type:    pb.RpcObjectImportRequest_Notion,
+		Mode:    pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		SpaceId: "space1",
+	}, model.ObjectOrigin_import)
+	_, _, webErr := i.ImportWeb(context.Background(), &pb.RpcObjectImportRequest{SpaceId: "space1"})
+	_, undoErr := i.UndoImport("run")
+
+	// then
+	assert.ErrorIs(t, importErr, domain.ErrReadOnlyMode)
+	assert.ErrorIs(t, webErr, domain.ErrReadOnlyMode)
+	assert.ErrorIs(t, undoErr, domain.ErrReadOnlyMode)
 }
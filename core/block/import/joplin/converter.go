@@ -0,0 +1,178 @@
+package joplin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Joplin"
+	rootCollectionName = "Joplin Import"
+	itemExtension      = ".md"
+	resourcesDir       = "resources"
+)
+
+var log = logging.Logger("import-joplin")
+
+// Joplin imports JEX archives and RAW export directories of Joplin. Notes become pages with tags and dates,
+// to-dos become tasks, notebooks become collections and resources are imported as files
+type Joplin struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &Joplin{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		parseLimits:     converter.DefaultParseLimits,
+	}
+}
+
+func (j *Joplin) Name() string {
+	return Name
+}
+
+func (j *Joplin) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetJoplinParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (j *Joplin) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := j.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from Joplin export")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := j.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(j.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (j *Joplin) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, j.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := j.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of notes, notebooks and tags and list of top level notebooks and notes,
+// that should be added to the root collection
+func (j *Joplin) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Joplin) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{itemExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	builder := newExportBuilder(j.service, j.tempDirProvider, importSource, objectType)
+	var itemFiles []string
+	if err := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		switch {
+		case filepath.Base(filepath.Dir(fileName)) == resourcesDir:
+			builder.addResourceFile(fileName)
+		case filepath.Ext(fileName) == itemExtension:
+			itemFiles = append(itemFiles, fileName)
+		}
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	sort.Strings(itemFiles)
+	for _, fileName := range itemFiles {
+		err := importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+			data, err := limits.ReadAll(fileReader)
+			if err != nil {
+				return err
+			}
+			builder.addItem(parseItem(fileName, string(data)))
+			return nil
+		})
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Joplin) {
+				return nil, nil
+			}
+		}
+	}
+	rootObjects, err := builder.build()
+	if err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	if len(rootObjects) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return builder.snapshots, rootObjects
+}
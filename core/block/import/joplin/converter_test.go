@@ -0,0 +1,156 @@
+package joplin
+
+import (
+	"archive/tar"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	workID     = "0000000000000000000000000000000a"
+	projectsID = "0000000000000000000000000000000b"
+	planID     = "0000000000000000000000000000000c"
+	specID     = "0000000000000000000000000000000d"
+	looseID    = "0000000000000000000000000000000e"
+	imageID    = "0000000000000000000000000000000f"
+	manualID   = "00000000000000000000000000000010"
+	tagID      = "00000000000000000000000000000011"
+)
+
+var exportFiles = map[string]string{
+	workID + ".md":     "Work\n\nid: " + workID + "\nparent_id: \ntype_: 2",
+	projectsID + ".md": "Projects\n\nid: " + projectsID + "\nparent_id: " + workID + "\ntype_: 2",
+	planID + ".md": "Plan\n\n![diagram](:/" + imageID + ")\n\nSee [spec](:/" + specID + ")\n\n[manual](:/" + manualID + ")\n\n" +
+		"id: " + planID + "\nparent_id: " + projectsID + "\nuser_created_time: 2024-03-04T10:00:00.000Z\n" +
+		"source_url: https://example.com/plan\nis_todo: 0\ntype_: 1",
+	specID + ".md": "Spec\n\nWrite the spec\n\nid: " + specID + "\nparent_id: " + workID +
+		"\nis_todo: 1\ntodo_due: 1710500000000\ntodo_completed: 1710000000000\ntype_: 1",
+	looseID + ".md":                       "Loose\n\nid: " + looseID + "\nparent_id: ffffffffffffffffffffffffffffffff\ntype_: 1",
+	imageID + ".md":                       "diagram.png\n\nid: " + imageID + "\nmime: image/png\nfile_extension: png\ntype_: 4",
+	manualID + ".md":                      "manual.pdf\n\nid: " + manualID + "\nmime: application/pdf\nfile_extension: pdf\ntype_: 4",
+	tagID + ".md":                         "urgent\n\nid: " + tagID + "\ntype_: 5",
+	"00000000000000000000000000000012.md": "id: 00000000000000000000000000000012\nnote_id: " + planID + "\ntag_id: " + tagID + "\ntype_: 6",
+	"resources/" + imageID + ".png":       "png",
+	"resources/" + manualID + ".pdf":      "pdf",
+}
+
+func writeExport(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "export.jex")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	w := tar.NewWriter(f)
+	for name, content := range exportFiles {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return path
+}
+
+func TestJoplin_GetSnapshots(t *testing.T) {
+	t.Run("notebooks are collections of notes and nested notebooks", func(t *testing.T) {
+		// given
+		j := &Joplin{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Joplin, writeExport(t)), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		work := convertertest.FindSnapshot(sn.Snapshots, "Work")
+		projects := convertertest.FindSnapshot(sn.Snapshots, "Projects")
+		plan := convertertest.FindSnapshot(sn.Snapshots, "Plan")
+		spec := convertertest.FindSnapshot(sn.Snapshots, "Spec")
+		loose := convertertest.FindSnapshot(sn.Snapshots, "Loose")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{work, projects, plan, spec, loose, root} {
+			require.NotNil(t, s)
+		}
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "diagram.png"))
+		assert.Equal(t, []string{work.Id, loose.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, []string{projects.Id, spec.Id}, convertertest.CollectionObjects(work))
+		assert.Equal(t, []string{plan.Id}, convertertest.CollectionObjects(projects))
+	})
+	t.Run("links to notes and resources are resolved, tags and dates are relations", func(t *testing.T) {
+		// given
+		tempDir := t.TempDir()
+		j := &Joplin{tempDirProvider: &convertertest.TempDirProvider{Dir: tempDir}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Joplin, writeExport(t)), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		plan := convertertest.FindSnapshot(sn.Snapshots, "Plan")
+		spec := convertertest.FindSnapshot(sn.Snapshots, "Spec")
+		require.NotNil(t, plan)
+		require.NotNil(t, spec)
+
+		details := plan.Snapshot.Data.Details
+		assert.Equal(t, time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, "https://example.com/plan", pbtypes.GetString(details, bundle.RelationKeySource.String()))
+		tags := pbtypes.GetStringList(details, bundle.RelationKeyTag.String())
+		require.Len(t, tags, 1)
+		for _, s := range sn.Snapshots {
+			if s.Id == tags[0] {
+				assert.Equal(t, "urgent", pbtypes.GetString(s.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+
+		var (
+			files     []*model.BlockContentFile
+			noteLinks []string
+		)
+		for _, b := range plan.Snapshot.Data.Blocks {
+			if file := b.GetFile(); file != nil {
+				files = append(files, file)
+			}
+			for _, mark := range b.GetText().GetMarks().GetMarks() {
+				if mark.Type == model.BlockContentTextMark_Object {
+					noteLinks = append(noteLinks, mark.Param)
+				}
+			}
+		}
+		require.Len(t, files, 2)
+		assert.Equal(t, filepath.Join(tempDir, "resources", imageID+".png"), files[0].Name)
+		assert.Equal(t, model.BlockContentFile_Image, files[0].Type)
+		assert.Equal(t, filepath.Join(tempDir, "resources", manualID+".pdf"), files[1].Name)
+		assert.Equal(t, model.BlockContentFile_PDF, files[1].Type)
+		assert.FileExists(t, files[0].Name)
+		assert.Equal(t, []string{spec.Id}, noteLinks)
+
+		assert.Equal(t, []string{bundle.TypeKeyTask.String()}, spec.Snapshot.Data.ObjectTypes)
+		assert.True(t, pbtypes.GetBool(spec.Snapshot.Data.Details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, int64(1710500000), pbtypes.GetInt64(spec.Snapshot.Data.Details, bundle.RelationKeyDueDate.String()))
+	})
+}
+
+func TestParseItem(t *testing.T) {
+	it := parseItem("note.md", "Title\n\nFirst line\n\nid: abc\nsource_url: https://example.com/a:b\ntype_: 1\n")
+
+	assert.Equal(t, "Title", it.title)
+	assert.Equal(t, "First line", it.body)
+	assert.Equal(t, "abc", it.id())
+	assert.Equal(t, "https://example.com/a:b", it.props["source_url"])
+	assert.Equal(t, itemTypeNote, it.itemType())
+}
@@ -0,0 +1,97 @@
+package joplin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Types of Joplin items, which are stored in type_ property
+const (
+	itemTypeNote     = 1
+	itemTypeFolder   = 2
+	itemTypeResource = 4
+	itemTypeTag      = 5
+	itemTypeNoteTag  = 6
+)
+
+// item is the serialized Joplin object: title, empty line, body and properties in "key: value" lines at the end
+type item struct {
+	fileName string
+	title    string
+	body     string
+	props    map[string]string
+}
+
+// parseItem parses the item same way as Joplin does: properties are read from the end of file up to
+// the first empty line, the first line of the rest is title and the body starts after the next line
+func parseItem(fileName, data string) *item {
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	it := &item{fileName: fileName, props: map[string]string{}}
+	end := len(lines)
+	for end > 0 && lines[end-1] == "" {
+		end--
+	}
+	for ; end > 0; end-- {
+		line := lines[end-1]
+		if line == "" {
+			break
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		it.props[strings.TrimSpace(key)] = strings.ReplaceAll(strings.TrimSpace(value), `\n`, "\n")
+	}
+	// line before properties separates them from body
+	if end > 0 {
+		end--
+	}
+	bodyLines := lines[:end]
+	if len(bodyLines) > 0 {
+		it.title = strings.TrimSpace(bodyLines[0])
+	}
+	if len(bodyLines) > 2 {
+		it.body = strings.Join(bodyLines[2:], "\n")
+	}
+	return it
+}
+
+func (it *item) id() string {
+	return it.props["id"]
+}
+
+func (it *item) itemType() int {
+	t, err := strconv.Atoi(it.props["type_"])
+	if err != nil {
+		return 0
+	}
+	return t
+}
+
+func (it *item) isEncrypted() bool {
+	return it.props["encryption_applied"] == "1"
+}
+
+func (it *item) isTodo() bool {
+	return it.props["is_todo"] == "1"
+}
+
+// time returns unix time of the first set date property in ISO format, e.g. 2024-03-04T10:00:00.000Z
+func (it *item) time(keys ...string) int64 {
+	for _, key := range keys {
+		if t, err := time.Parse(time.RFC3339, it.props[key]); err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
+
+// milliseconds returns unix time of to-do properties, which are stored as milliseconds, e.g. todo_due
+func (it *item) milliseconds(key string) int64 {
+	ms, err := strconv.ParseInt(it.props[key], 10, 64)
+	if err != nil || ms <= 0 {
+		return 0
+	}
+	return ms / 1000
+}
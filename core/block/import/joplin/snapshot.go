@@ -0,0 +1,304 @@
+package joplin
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const defaultNoteName = "Untitled"
+
+// itemLinkRegexp matches links to Joplin items, e.g. :/0123456789abcdef0123456789abcdef
+var itemLinkRegexp = regexp.MustCompile(`^:/([0-9a-fA-F]{32})$`)
+
+var htmlImageRegexp = regexp.MustCompile(`<img[^>]*\ssrc=["'](:/[0-9a-fA-F]{32})["'][^>]*>`)
+
+// exportBuilder creates snapshots of one Joplin export. Notebooks become collections with their notes
+// and nested notebooks, links to notes become links to objects and links to resources become files
+type exportBuilder struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	importSource    source.Source
+	objectType      string
+
+	folders   map[string]*item
+	notes     []*item
+	tags      map[string]*item
+	noteTags  map[string][]string
+	resources map[string]string
+
+	// noteIDs maps Joplin id of note to id of its object
+	noteIDs   map[string]string
+	snapshots []*converter.Snapshot
+	// relations creates relation options of tags
+	relations *converter.RelationCreator
+}
+
+func newExportBuilder(service *collection.Service,
+	tempDirProvider core.TempDirProvider,
+	importSource source.Source,
+	objectType string,
+) *exportBuilder {
+	b := &exportBuilder{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		importSource:    importSource,
+		objectType:      objectType,
+		folders:         map[string]*item{},
+		tags:            map[string]*item{},
+		noteTags:        map[string][]string{},
+		resources:       map[string]string{},
+		noteIDs:         map[string]string{},
+	}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// addResourceFile remembers file of the resource, files are named by id of resource, e.g. resources/<id>.png
+func (b *exportBuilder) addResourceFile(fileName string) {
+	id := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	b.resources[id] = fileName
+}
+
+func (b *exportBuilder) addItem(it *item) {
+	if it.isEncrypted() {
+		log.Warnf("encrypted Joplin item %s is skipped", it.id())
+		return
+	}
+	switch it.itemType() {
+	case itemTypeNote:
+		b.notes = append(b.notes, it)
+		b.noteIDs[it.id()] = uuid.New().String()
+	case itemTypeFolder:
+		b.folders[it.id()] = it
+	case itemTypeTag:
+		b.tags[it.id()] = it
+	case itemTypeNoteTag:
+		b.noteTags[it.props["note_id"]] = append(b.noteTags[it.props["note_id"]], it.props["tag_id"])
+	}
+}
+
+// build creates snapshots of notes and collections of notebooks and returns ids of objects,
+// that should be added to the root collection: top level notebooks and notes without notebook
+func (b *exportBuilder) build() ([]string, error) {
+	folderObjects := map[string][]string{}
+	var rootObjects []string
+	sort.SliceStable(b.notes, func(i, j int) bool {
+		return b.notes[i].title < b.notes[j].title
+	})
+	for _, n := range b.notes {
+		sn, err := b.getNoteSnapshot(n)
+		if err != nil {
+			return nil, err
+		}
+		b.snapshots = append(b.snapshots, sn)
+		if parentID := n.props["parent_id"]; b.folders[parentID] != nil {
+			folderObjects[parentID] = append(folderObjects[parentID], sn.Id)
+		} else {
+			rootObjects = append(rootObjects, sn.Id)
+		}
+	}
+	subfolders := map[string][]*item{}
+	var topFolders []*item
+	for _, f := range b.folders {
+		if parentID := f.props["parent_id"]; b.folders[parentID] != nil {
+			subfolders[parentID] = append(subfolders[parentID], f)
+		} else {
+			topFolders = append(topFolders, f)
+		}
+	}
+	var folderIDs []string
+	for _, f := range sortFolders(topFolders) {
+		id, err := b.addFolder(f, subfolders, folderObjects, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+		folderIDs = append(folderIDs, id)
+	}
+	return append(folderIDs, rootObjects...), nil
+}
+
+// addFolder creates collection of notebook with nested notebooks and notes and returns its id.
+// visited protects from cycles of parents in broken exports
+func (b *exportBuilder) addFolder(f *item,
+	subfolders map[string][]*item,
+	folderObjects map[string][]string,
+	visited map[string]bool,
+) (string, error) {
+	visited[f.id()] = true
+	var objects []string
+	for _, subfolder := range sortFolders(subfolders[f.id()]) {
+		if visited[subfolder.id()] {
+			continue
+		}
+		id, err := b.addFolder(subfolder, subfolders, folderObjects, visited)
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	objects = append(objects, folderObjects[f.id()]...)
+	title := f.title
+	if title == "" {
+		title = defaultNoteName
+	}
+	col, err := converter.NewRootCollection(b.service).MakeCollection(title, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for notebook %s: %w", title, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
+
+func sortFolders(folders []*item) []*item {
+	sort.Slice(folders, func(i, j int) bool {
+		return folders[i].title < folders[j].title
+	})
+	return folders
+}
+
+func (b *exportBuilder) getNoteSnapshot(n *item) (*converter.Snapshot, error) {
+	title := n.title
+	if title == "" {
+		title = defaultNoteName
+	}
+	// Joplin uses HTML for resized images, they are converted to Markdown images to be imported as files
+	body := htmlImageRegexp.ReplaceAllString(n.body, "![](${1})")
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(body), "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert note %s: %w", title, err)
+	}
+	for _, block := range blocks {
+		b.updateLinks(block)
+	}
+	objectType, layout := b.objectType, model.ObjectType_basic
+	if n.isTodo() {
+		objectType, layout = bundle.TypeKeyTask.String(), model.ObjectType_todo
+	}
+	details := converter.GetCommonDetails(n.fileName, title, "", layout)
+	var relationLinks []*model.RelationLink
+	if created := n.time("user_created_time", "created_time"); created != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(created)
+	}
+	if updated := n.time("user_updated_time", "updated_time"); updated != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(updated)
+	}
+	if sourceURL := n.props["source_url"]; sourceURL != "" {
+		details.Fields[bundle.RelationKeySource.String()] = pbtypes.String(sourceURL)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeySource.String(),
+			Format: model.RelationFormat_url,
+		})
+	}
+	if n.isTodo() {
+		details.Fields[bundle.RelationKeyDone.String()] = pbtypes.Bool(n.milliseconds("todo_completed") != 0)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyDone.String(),
+			Format: model.RelationFormat_checkbox,
+		})
+		if due := n.milliseconds("todo_due"); due != 0 {
+			details.Fields[bundle.RelationKeyDueDate.String()] = pbtypes.Int64(due)
+			relationLinks = append(relationLinks, &model.RelationLink{
+				Key:    bundle.RelationKeyDueDate.String(),
+				Format: model.RelationFormat_date,
+			})
+		}
+	}
+	if tagIDs := b.getTagIDs(n.id()); len(tagIDs) != 0 {
+		details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	return &converter.Snapshot{
+		Id:       b.noteIDs[n.id()],
+		FileName: n.fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{objectType},
+		}},
+	}, nil
+}
+
+// updateLinks resolves :/id links of the block. Links to notes become links to objects, images and links
+// to resources become files
+func (b *exportBuilder) updateLinks(block *model.Block) {
+	if file := block.GetFile(); file != nil {
+		if fileName := b.provideResource(file.Name); fileName != "" {
+			file.Name = fileName
+		}
+		return
+	}
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link {
+			continue
+		}
+		match := itemLinkRegexp.FindStringSubmatch(filepath.ToSlash(mark.Param))
+		if match == nil {
+			continue
+		}
+		if id, ok := b.noteIDs[strings.ToLower(match[1])]; ok {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = id
+			continue
+		}
+		if fileName := b.provideResource(mark.Param); fileName != "" {
+			mark.Param = fileName
+			anymark.ConvertTextToFile(block)
+			return
+		}
+	}
+}
+
+// provideResource returns local path of the resource, which is linked with :/id link
+func (b *exportBuilder) provideResource(link string) string {
+	match := itemLinkRegexp.FindStringSubmatch(filepath.ToSlash(link))
+	if match == nil {
+		return ""
+	}
+	resourceFile, ok := b.resources[strings.ToLower(match[1])]
+	if !ok {
+		return ""
+	}
+	fileName, _, err := converter.ProvideFileName(resourceFile, b.importSource, "", b.tempDirProvider)
+	if err != nil {
+		log.Errorf("failed to provide Joplin resource: %v", oserror.TransformError(err))
+		return ""
+	}
+	return fileName
+}
+
+// getTagIDs returns ids of relation options of tags of the note, so the same tag is shared between notes
+func (b *exportBuilder) getTagIDs(noteID string) []string {
+	var ids []string
+	for _, tagID := range b.noteTags[noteID] {
+		tag, ok := b.tags[tagID]
+		if !ok || tag.title == "" {
+			continue
+		}
+		if id := b.relations.OptionID(bundle.RelationKeyTag.String(), tag.title); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
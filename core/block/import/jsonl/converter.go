@@ -0,0 +1,168 @@
+package jsonl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Jsonl"
+	rootCollectionName = "Event Log Import"
+)
+
+var (
+	log        = logging.Logger("import-jsonl")
+	extensions = []string{".jsonl", ".ndjson"}
+)
+
+// defaultParseLimits are applied to each line, because lines are parsed one by one
+var defaultParseLimits = converter.ParseLimits{
+	MaxDepth:    converter.DefaultParseLimits.MaxDepth,
+	MaxBytes:    16 << 20,
+	MaxElements: 1_000_000,
+}
+
+// JSONL imports line-delimited JSON event logs. Every line is an event, which becomes an object
+// with fields of the event as relations
+type JSONL struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &JSONL{service: service, parseLimits: defaultParseLimits}
+}
+
+func (j *JSONL) Name() string {
+	return Name
+}
+
+func (j *JSONL) GetParams(req *pb.RpcObjectImportRequest) *pb.RpcObjectImportRequestJsonlParams {
+	return req.GetJsonlParams()
+}
+
+func (j *JSONL) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	params := j.GetParams(req)
+	if params == nil || len(params.Path) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from event logs")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := j.getSnapshots(req, params, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(j.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (j *JSONL) getSnapshots(req *pb.RpcObjectImportRequest,
+	params *pb.RpcObjectImportRequestJsonlParams,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, j.parseLimits)
+	for _, p := range params.Path {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := j.handleImportPath(p, converter.SourceFilter(req), params, converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(params.Path), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of events, their relations and day collections, and list of objects,
+// that should be added to the root collection
+func (j *JSONL) handleImportPath(importPath string,
+	filter *source.Filter,
+	params *pb.RpcObjectImportRequestJsonlParams,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	pathsCount := len(params.Path)
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Jsonl) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions(extensions) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	builder := newSnapshotBuilder(objectType)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !lo.Contains(extensions, strings.ToLower(filepath.Ext(fileName))) {
+			return true
+		}
+		if err := builder.addEvents(fileName, fileReader, params.TimestampField, limits); err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Jsonl)
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	if len(builder.events) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	if !params.GroupByDay {
+		return builder.snapshots, builder.eventIDs()
+	}
+	rootObjects, err := builder.addDayCollections(converter.NewRootCollection(j.service))
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Jsonl) {
+			return nil, nil
+		}
+	}
+	return builder.snapshots, rootObjects
+}
@@ -0,0 +1,192 @@
+package jsonl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestJSONL_GetSnapshots(t *testing.T) {
+	t.Run("every line is event, events are ordered by time, malformed lines are skipped", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest("testdata", false), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		root := findSnapshots(sn.Snapshots, rootCollectionName)
+		require.Len(t, root, 1)
+		assert.Equal(t, root[0].Id, sn.RootCollectionID)
+		assert.Equal(t, []string{"signup", "login", "cpu", "cpu", "deploy", "heartbeat"}, getNames(sn.Snapshots, convertertest.CollectionObjects(root[0])))
+	})
+	t.Run("every line has its own source path", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest("testdata", false), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		cpu := findSnapshots(sn.Snapshots, "cpu")
+		require.Len(t, cpu, 2)
+		first := pbtypes.GetString(cpu[0].Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		second := pbtypes.GetString(cpu[1].Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		assert.NotEqual(t, first, second)
+		assert.Regexp(t, `metrics\.ndjson/\d+$`, first)
+	})
+	t.Run("keys of events are relations with inferred formats", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest("testdata", false), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		deploy := findSnapshots(sn.Snapshots, "deploy")
+		login := findSnapshots(sn.Snapshots, "login")
+		signup := findSnapshots(sn.Snapshots, "signup")
+		require.Len(t, deploy, 1)
+		require.Len(t, login, 1)
+		require.Len(t, signup, 1)
+		relations := getRelations(sn.Snapshots)
+		assert.Equal(t, model.RelationFormat_date, relations["timestamp"].Format)
+		assert.Equal(t, model.RelationFormat_number, relations["duration"].Format)
+		assert.Equal(t, model.RelationFormat_checkbox, relations["success"].Format)
+		assert.Equal(t, model.RelationFormat_url, relations["url"].Format)
+		assert.Equal(t, model.RelationFormat_shorttext, relations["user"].Format)
+
+		details := deploy[0].Snapshot.Data.Details
+		assert.Equal(t, int64(1709371800), pbtypes.GetInt64(details, relations["timestamp"].Key))
+		assert.Equal(t, int64(1709371800), pbtypes.GetInt64(details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, 12.5, pbtypes.GetFloat64(details, relations["duration"].Key))
+		assert.True(t, pbtypes.GetBool(details, relations["success"].Key))
+		assert.Equal(t, "https://example.com/builds/42", pbtypes.GetString(details, relations["url"].Key))
+		assert.Equal(t, `{"id":7,"name":"kim"}`, pbtypes.GetString(login[0].Snapshot.Data.Details, relations["user"].Key))
+		assert.NotContains(t, signup[0].Snapshot.Data.Details.Fields, relations["duration"].Key)
+		assert.Equal(t, `["beta","web"]`, pbtypes.GetString(signup[0].Snapshot.Data.Details, relations["tags"].Key))
+
+		blocks := deploy[0].Snapshot.Data.Blocks
+		require.Len(t, blocks, 1)
+		assert.Equal(t, model.BlockContentText_Code, blocks[0].GetText().GetStyle())
+		assert.Contains(t, blocks[0].GetText().GetText(), `"event":"deploy"`)
+	})
+	t.Run("unix time in seconds and milliseconds is parsed", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest("testdata/metrics.ndjson", false), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		cpu := findSnapshots(sn.Snapshots, "cpu")
+		require.Len(t, cpu, 2)
+		ts := getRelations(sn.Snapshots)["ts"]
+		require.NotNil(t, ts)
+		assert.Equal(t, model.RelationFormat_date, ts.Format)
+		assert.Equal(t, int64(1709370000), pbtypes.GetInt64(cpu[0].Snapshot.Data.Details, ts.Key))
+		assert.Equal(t, int64(1709370060), pbtypes.GetInt64(cpu[1].Snapshot.Data.Details, ts.Key))
+	})
+	t.Run("events are grouped by day", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest("testdata", true), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		root := findSnapshots(sn.Snapshots, rootCollectionName)
+		firstDay := findSnapshots(sn.Snapshots, "2024-03-01")
+		secondDay := findSnapshots(sn.Snapshots, "2024-03-02")
+		heartbeat := findSnapshots(sn.Snapshots, "heartbeat")
+		for _, s := range [][]*converter.Snapshot{root, firstDay, secondDay, heartbeat} {
+			require.Len(t, s, 1)
+		}
+		assert.Equal(t, []string{firstDay[0].Id, secondDay[0].Id, heartbeat[0].Id}, convertertest.CollectionObjects(root[0]))
+		assert.Equal(t, []string{"signup", "login"}, getNames(sn.Snapshots, convertertest.CollectionObjects(firstDay[0])))
+		assert.Equal(t, []string{"cpu", "cpu", "deploy"}, getNames(sn.Snapshots, convertertest.CollectionObjects(secondDay[0])))
+	})
+	t.Run("directory without event logs - return error", func(t *testing.T) {
+		// given
+		j := &JSONL{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := j.GetSnapshots(context.Background(), getRequest(t.TempDir(), false), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Jsonl), converter.ErrNoObjectsToImport))
+	})
+}
+
+func getNames(snapshots []*converter.Snapshot, ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		for _, sn := range snapshots {
+			if sn.Id == id {
+				names = append(names, pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
+
+func getRelations(snapshots []*converter.Snapshot) map[string]*model.RelationLink {
+	relations := make(map[string]*model.RelationLink)
+	for _, sn := range snapshots {
+		if sn.SbType != smartblock.SmartBlockTypeRelation {
+			continue
+		}
+		details := sn.Snapshot.Data.Details
+		relations[pbtypes.GetString(details, bundle.RelationKeyName.String())] = &model.RelationLink{
+			Key:    sn.Snapshot.Data.Key,
+			Format: model.RelationFormat(pbtypes.GetInt64(details, bundle.RelationKeyRelationFormat.String())),
+		}
+	}
+	return relations
+}
+
+func findSnapshots(snapshots []*converter.Snapshot, name string) []*converter.Snapshot {
+	var found []*converter.Snapshot
+	for _, sn := range snapshots {
+		if sn.SbType != smartblock.SmartBlockTypeRelation && pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String()) == name {
+			found = append(found, sn)
+		}
+	}
+	return found
+}
+
+func getRequest(path string, groupByDay bool) *pb.RpcObjectImportRequest {
+	req := convertertest.NewRequest(pb.RpcObjectImportRequest_Jsonl, path)
+	req.GetJsonlParams().GroupByDay = groupByDay
+	return req
+}
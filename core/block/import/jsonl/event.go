@@ -0,0 +1,150 @@
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+// timestampFields are checked in order, if the timestamp field is not set in params
+var timestampFields = []string{"timestamp", "@timestamp", "time", "ts", "datetime", "date", "created_at", "createdAt"}
+
+// nameFields are checked in order to find the name of event
+var nameFields = []string{"event", "name", "type", "action", "message", "msg"}
+
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+var errNotObject = errors.New("line is not a JSON object")
+
+type event struct {
+	line   int
+	raw    string
+	fields map[string]interface{}
+	// timestampField is the key of field with time of event
+	timestampField string
+	// time is zero, if event has no timestamp
+	time time.Time
+}
+
+// keys returns keys of event fields in alphabetical order, so relations are created in stable order
+func (e *event) keys() []string {
+	keys := make([]string, 0, len(e.fields))
+	for key := range e.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (e *event) name() string {
+	for _, field := range nameFields {
+		if value, ok := e.fields[field].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// readEvents reads events line by line and passes them to the callback, so the file is not loaded whole.
+// Empty lines are ignored, malformed lines and lines exceeding limits are skipped with warning.
+// If timestampField is empty, it's detected by the first event
+func readEvents(fileName string, r io.Reader, timestampField string, limits converter.ParseLimits, callback func(e *event)) error {
+	scanner := bufio.NewScanner(r)
+	if limits.MaxBytes > 0 {
+		scanner.Buffer(make([]byte, 0, 64*1024), int(limits.MaxBytes))
+	}
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		fields, err := parseLine(line, limits)
+		if err != nil {
+			log.Warnf("skip malformed line %d of %s: %v", lineNumber, fileName, err)
+			continue
+		}
+		if timestampField == "" {
+			timestampField = detectTimestampField(fields)
+		}
+		e := &event{line: lineNumber, raw: string(line), fields: fields, timestampField: timestampField}
+		if value, ok := fields[timestampField]; ok {
+			e.time, _ = parseTime(value)
+		}
+		callback(e)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return fmt.Errorf("%w: line %d is longer than %d bytes", converter.ErrParseLimitExceeded, lineNumber+1, limits.MaxBytes)
+		}
+		return fmt.Errorf("failed to read line %d: %w", lineNumber+1, err)
+	}
+	return nil
+}
+
+func parseLine(line []byte, limits converter.ParseLimits) (map[string]interface{}, error) {
+	if line[0] != '{' {
+		return nil, errNotObject
+	}
+	if err := limits.CheckJSON(line); err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON object")
+	}
+	return fields, nil
+}
+
+func detectTimestampField(fields map[string]interface{}) string {
+	for _, field := range timestampFields {
+		if _, ok := fields[field]; ok {
+			return field
+		}
+	}
+	return ""
+}
+
+// parseTime parses RFC 3339 and similar strings and unix time in seconds or milliseconds
+func parseTime(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil || f <= 0 {
+			return time.Time{}, false
+		}
+		// values after year 33658 in seconds are considered as milliseconds
+		if f > 1e12 {
+			return time.UnixMilli(int64(f)).UTC(), true
+		}
+		sec, frac := math.Modf(f)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), true
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func isURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
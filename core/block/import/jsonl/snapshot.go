@@ -0,0 +1,207 @@
+package jsonl
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const dayLayout = "2006-01-02"
+
+type eventObject struct {
+	id   string
+	time time.Time
+}
+
+// snapshotBuilder creates snapshots of events of one import path. Relations are created for keys of events
+// and shared between all events, format of relation is inferred from the first value of the key
+type snapshotBuilder struct {
+	objectType string
+	snapshots  []*converter.Snapshot
+	events     []eventObject
+	relations  *converter.RelationCreator
+}
+
+func newSnapshotBuilder(objectType string) *snapshotBuilder {
+	b := &snapshotBuilder{objectType: objectType}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// addEvents creates snapshots of events of the file while it's read, so only one line is kept in memory
+func (b *snapshotBuilder) addEvents(fileName string, r io.Reader, timestampField string, limits converter.ParseLimits) error {
+	fileDetails := converter.GetCommonDetails(fileName, "", "", model.ObjectType_basic)
+	return readEvents(fileName, r, timestampField, limits, func(e *event) {
+		b.addEvent(fileName, fileDetails, e)
+	})
+}
+
+func (b *snapshotBuilder) addEvent(fileName string, fileDetails *types.Struct, e *event) {
+	name := e.name()
+	if name == "" {
+		name = fmt.Sprintf("%s #%d", strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName)), e.line)
+	}
+	// every line has its own source path, so events are matched to their objects, when the file is imported again
+	details := converter.GetCommonDetails(fmt.Sprintf("%s/%d", fileName, e.line), name, "", model.ObjectType_basic)
+	for _, key := range []string{bundle.RelationKeyCreatedDate.String(), bundle.RelationKeyLastModifiedDate.String()} {
+		details.Fields[key] = pbtypes.CopyVal(fileDetails.Fields[key])
+		if !e.time.IsZero() {
+			details.Fields[key] = pbtypes.Int64(e.time.Unix())
+		}
+	}
+	relationLinks := make([]*model.RelationLink, 0, len(e.fields))
+	for _, key := range e.keys() {
+		value, format, ok := getDetailValue(e.fields[key], key == e.timestampField)
+		if !ok {
+			continue
+		}
+		relation := b.relations.Relation(key, format)
+		if relation.Format != format {
+			if relation.Format != model.RelationFormat_shorttext {
+				log.Warnf("skip value of %s on line %d of %s: %s is expected", key, e.line, fileName, relation.Format)
+				continue
+			}
+			value = pbtypes.String(stringify(e.fields[key]))
+		}
+		details.Fields[relation.Key] = value
+		relationLinks = append(relationLinks, relation)
+	}
+	id := uuid.New().String()
+	b.events = append(b.events, eventObject{id: id, time: e.time})
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        []*model.Block{getCodeBlock(e.raw)},
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	})
+}
+
+// getDetailValue converts JSON value to the value of detail and infers format of relation.
+// Objects and arrays are kept as JSON text
+func getDetailValue(value interface{}, isTimestamp bool) (*types.Value, model.RelationFormat, bool) {
+	if isTimestamp {
+		if t, ok := parseTime(value); ok {
+			return pbtypes.Int64(t.Unix()), model.RelationFormat_date, true
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, 0, false
+	case bool:
+		return pbtypes.Bool(v), model.RelationFormat_checkbox, true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return pbtypes.String(v.String()), model.RelationFormat_shorttext, true
+		}
+		return pbtypes.Float64(f), model.RelationFormat_number, true
+	case string:
+		if t, ok := parseTime(v); ok {
+			return pbtypes.Int64(t.Unix()), model.RelationFormat_date, true
+		}
+		if isURL(v) {
+			return pbtypes.String(v), model.RelationFormat_url, true
+		}
+		return pbtypes.String(v), model.RelationFormat_shorttext, true
+	default:
+		return pbtypes.String(stringify(v)), model.RelationFormat_shorttext, true
+	}
+}
+
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// eventIDs returns ids of events in order of time, events without time are placed at the end in order of lines
+func (b *snapshotBuilder) eventIDs() []string {
+	sort.SliceStable(b.events, func(i, j int) bool {
+		ti, tj := b.events[i].time, b.events[j].time
+		if ti.IsZero() || tj.IsZero() {
+			return !ti.IsZero() && tj.IsZero()
+		}
+		return ti.Before(tj)
+	})
+	ids := make([]string, 0, len(b.events))
+	for _, e := range b.events {
+		ids = append(ids, e.id)
+	}
+	return ids
+}
+
+// addDayCollections puts events to collections of their days and returns ids of day collections
+// and events without time, which should be added to the root collection
+func (b *snapshotBuilder) addDayCollections(rootCollection *converter.RootCollection) ([]string, error) {
+	b.eventIDs()
+	var (
+		days        []string
+		dayEvents   = map[string][]string{}
+		rootObjects []string
+	)
+	for _, e := range b.events {
+		if e.time.IsZero() {
+			rootObjects = append(rootObjects, e.id)
+			continue
+		}
+		day := e.time.Format(dayLayout)
+		if _, ok := dayEvents[day]; !ok {
+			days = append(days, day)
+		}
+		dayEvents[day] = append(dayEvents[day], e.id)
+	}
+	dayCollections := make([]string, 0, len(days))
+	for _, day := range days {
+		col, err := rootCollection.MakeCollection(day, dayEvents[day])
+		if err != nil {
+			return append(dayCollections, rootObjects...), fmt.Errorf("failed to create collection for %s: %w", day, err)
+		}
+		b.snapshots = append(b.snapshots, col)
+		dayCollections = append(dayCollections, col.Id)
+	}
+	return append(dayCollections, rootObjects...), nil
+}
+
+// getCodeBlock keeps the original line of event, so nested data is not lost
+func getCodeBlock(raw string) *model.Block {
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Fields: &types.Struct{
+			Fields: map[string]*types.Value{"lang": pbtypes.String("json")},
+		},
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{
+				Text:  raw,
+				Style: model.BlockContentText_Code,
+			},
+		},
+	}
+}
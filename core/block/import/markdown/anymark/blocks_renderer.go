@@ -234,12 +234,66 @@ func (r *blocksRenderer) AddDivider() {
 	r.addChildIDToParentBlock(divider.Id)
 }
 
+func (r *blocksRenderer) AddLatexBlock(formula string, processor model.BlockContentLatexProcessor) {
+	latex := &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfLatex{
+			Latex: &model.BlockContentLatex{
+				Text:      formula,
+				Processor: processor,
+			},
+		},
+	}
+	r.blocks = append(r.blocks, latex)
+	r.addChildIDToParentBlock(latex.Id)
+}
+
+// AddLabelToTextBlock adds bold label to the beginning of the last opened text block.
+// Text of the first child may be already moved to the block, so its marks are shifted
+func (r *blocksRenderer) AddLabelToTextBlock(label string) {
+	if len(r.openedTextBlocks) == 0 {
+		return
+	}
+	last := r.openedTextBlocks[len(r.openedTextBlocks)-1]
+	t := last.GetText()
+	prefix := label + " "
+	shift := int32(text.UTF16RuneCountString(prefix))
+	labelMark := &model.BlockContentTextMark{
+		Range: &model.Range{From: 0, To: int32(text.UTF16RuneCountString(label))},
+		Type:  model.BlockContentTextMark_Bold,
+	}
+	if t.Text == "" {
+		for _, mark := range last.marksBuffer {
+			shiftMark(mark, shift)
+		}
+		last.textBuffer = prefix + last.textBuffer
+		last.marksBuffer = append([]*model.BlockContentTextMark{labelMark}, last.marksBuffer...)
+		return
+	}
+	if t.Marks == nil {
+		t.Marks = &model.BlockContentTextMarks{}
+	}
+	for _, mark := range t.Marks.Marks {
+		shiftMark(mark, shift)
+	}
+	t.Text = prefix + t.Text
+	t.Marks.Marks = append([]*model.BlockContentTextMark{labelMark}, t.Marks.Marks...)
+}
+
+func shiftMark(mark *model.BlockContentTextMark, shift int32) {
+	if mark.Range != nil {
+		mark.Range.From += shift
+		mark.Range.To += shift
+	}
+}
+
 func isBlockCanHaveChild(block model.Block) bool {
 	if t := block.GetText(); t != nil {
 		return t.Style == model.BlockContentText_Numbered ||
 			t.Style == model.BlockContentText_Marked ||
 			t.Style == model.BlockContentText_Toggle ||
-			t.Style == model.BlockContentText_Quote
+			t.Style == model.BlockContentText_Quote ||
+			t.Style == model.BlockContentText_Callout
 	}
 
 	return false
@@ -308,6 +362,8 @@ func (r *blocksRenderer) CloseTextBlock(content model.BlockContentTextStyle) {
 	case strings.HasPrefix(t.Text, "[x]"):
 		parentBlock = r.normalizeCheckboxBlock(t, parentBlock, "[x]")
 		t.Checked = true
+	case isCalloutQuote(t):
+		r.normalizeCalloutBlock(closingBlock)
 	}
 
 	if parentBlock != nil {
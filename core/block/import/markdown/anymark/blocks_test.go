@@ -128,3 +128,206 @@ func TestPreprocessBlocksThreeCodeBlock(t *testing.T) {
 	assert.Equal(t, blocks[0].GetText().GetText(), bl.GetText().GetText())
 	assert.Equal(t, blocks[1].GetText().GetText(), bl2.GetText().GetText()+"\n"+bl3.GetText().GetText())
 }
+
+func TestConvertCallouts(t *testing.T) {
+	t.Run("note callout with custom title", func(t *testing.T) {
+		// given
+		source := []byte("> [!NOTE] Read **this** first\n> Callout text\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		callout := findBlockByStyle(blocks, model.BlockContentText_Callout)
+		assert.NotNil(t, callout)
+		assert.Equal(t, "Read this first", callout.GetText().Text)
+		assert.Equal(t, "📝", callout.GetText().IconEmoji)
+		assert.Equal(t, "blue", callout.BackgroundColor)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 5, To: 9}, Type: model.BlockContentTextMark_Bold},
+		}, callout.GetText().Marks.Marks)
+		assert.Len(t, callout.ChildrenIds, 1)
+		assert.Equal(t, "Callout text", findBlockByID(blocks, callout.ChildrenIds[0]).GetText().Text)
+	})
+	t.Run("callout with nested content", func(t *testing.T) {
+		// given
+		source := []byte("> [!warning]-\n> First line\n>\n> - item\n>\n> > [!custom] Inner\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		var callouts []*model.Block
+		for _, b := range blocks {
+			if b.GetText().GetStyle() == model.BlockContentText_Callout {
+				callouts = append(callouts, b)
+			}
+		}
+		assert.Len(t, callouts, 2)
+		inner, outer := callouts[0], callouts[1]
+		assert.Equal(t, "Warning", outer.GetText().Text)
+		assert.Equal(t, "⚠️", outer.GetText().IconEmoji)
+		assert.Len(t, outer.ChildrenIds, 3)
+		assert.Equal(t, "First line", findBlockByID(blocks, outer.ChildrenIds[0]).GetText().Text)
+		item := findBlockByID(blocks, outer.ChildrenIds[1])
+		assert.Equal(t, "item", item.GetText().Text)
+		assert.Equal(t, model.BlockContentText_Marked, item.GetText().Style)
+		assert.Equal(t, inner.Id, outer.ChildrenIds[2])
+
+		assert.Equal(t, "Inner", inner.GetText().Text)
+		assert.Empty(t, inner.GetText().IconEmoji)
+		assert.Empty(t, inner.ChildrenIds)
+	})
+}
+
+func TestConvertMath(t *testing.T) {
+	t.Run("formula blocks and fences become latex blocks", func(t *testing.T) {
+		// given
+		source := []byte("$$\n\\int_0^1 x dx\n$$\n\n$$a^2 + b^2$$\n\n$e=mc^2$\n\n```math\n\\sqrt{2}\n```\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		var formulas []string
+		for _, b := range blocks {
+			if latex := b.GetLatex(); latex != nil {
+				assert.Equal(t, model.BlockContentLatex_Latex, latex.Processor)
+				formulas = append(formulas, latex.Text)
+			}
+		}
+		assert.Equal(t, []string{"\\int_0^1 x dx", "a^2 + b^2", "e=mc^2", "\\sqrt{2}"}, formulas)
+	})
+	t.Run("formula inside of text is kept as code", func(t *testing.T) {
+		// given
+		source := []byte("Area is $\\pi r^2$, it costs $5 and $10\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, blocks, 1)
+		assert.Equal(t, "Area is \\pi r^2, it costs $5 and $10", blocks[0].GetText().Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 8, To: 15}, Type: model.BlockContentTextMark_Keyboard},
+		}, blocks[0].GetText().Marks.Marks)
+	})
+	t.Run("mermaid fence becomes embed", func(t *testing.T) {
+		// given
+		source := []byte("```mermaid\ngraph TD\n  A --> B\n```\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		assert.Len(t, blocks, 1)
+		assert.Equal(t, &model.BlockContentLatex{
+			Text:      "graph TD\n  A --> B",
+			Processor: model.BlockContentLatex_Mermaid,
+		}, blocks[0].GetLatex())
+	})
+}
+
+func TestConvertFootnotes(t *testing.T) {
+	// given
+	source := []byte("Text with note[^a] and other[^b].\n\n[^b]: Second\n[^a]: First *note*\n")
+
+	// when
+	blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, "Text with note[1] and other[2].", blocks[0].GetText().Text)
+	var callouts []*model.BlockContentText
+	for _, b := range blocks {
+		if b.GetText().GetStyle() == model.BlockContentText_Callout {
+			callouts = append(callouts, b.GetText())
+		}
+	}
+	assert.Len(t, callouts, 2)
+	assert.Equal(t, "[1] First note", callouts[0].Text)
+	assert.Equal(t, []*model.BlockContentTextMark{
+		{Range: &model.Range{From: 0, To: 3}, Type: model.BlockContentTextMark_Bold},
+		{Range: &model.Range{From: 10, To: 14}, Type: model.BlockContentTextMark_Italic},
+	}, callouts[0].Marks.Marks)
+	assert.Equal(t, "[2] Second", callouts[1].Text)
+}
+
+func TestConvertTables(t *testing.T) {
+	t.Run("html table with merged cells", func(t *testing.T) {
+		// given
+		source := []byte("Table:\n\n<table><thead><tr><th>A</th><th colspan=\"2\" style=\"text-align: center\">B</th></tr></thead>" +
+			"<tr><td rowspan=\"2\"><b>1</b></td><td>2</td><td align=\"right\">3</td></tr>" +
+			"<tr><td>4<table><tr><td>x</td><td>y</td></tr></table></td><td>5</td></tr></table>\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		var rows []*model.Block
+		cells := map[string]*model.Block{}
+		for _, b := range blocks {
+			if b.GetTableRow() != nil {
+				rows = append(rows, b)
+			}
+			if b.GetText() != nil && b.GetText().Text != "Table:" {
+				cells[b.GetText().Text] = b
+			}
+		}
+		assert.Len(t, rows, 3)
+		assert.True(t, rows[0].GetTableRow().IsHeader)
+		assert.False(t, rows[1].GetTableRow().IsHeader)
+		assert.Len(t, cells, 7)
+		assert.Equal(t, model.Block_AlignCenter, cells["B"].Align)
+		assert.Equal(t, model.Block_AlignRight, cells["3"].Align)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 0, To: 1}, Type: model.BlockContentTextMark_Bold},
+		}, cells["1"].GetText().Marks.Marks)
+		assert.Contains(t, cells, "4\nx | y")
+		assert.Contains(t, cells, "5")
+	})
+	t.Run("alignment of markdown table columns", func(t *testing.T) {
+		// given
+		source := []byte("| a | b | c |\n|:--|:-:|--:|\n| 1 | 2 | 3 |\n")
+
+		// when
+		blocks, _, err := MarkdownToBlocks(source, "", nil)
+
+		// then
+		assert.NoError(t, err)
+		aligns := map[string]model.BlockAlign{}
+		for _, b := range blocks {
+			if b.GetText() != nil {
+				aligns[b.GetText().Text] = b.Align
+			}
+		}
+		assert.Equal(t, map[string]model.BlockAlign{
+			"a": model.Block_AlignLeft, "b": model.Block_AlignCenter, "c": model.Block_AlignRight,
+			"1": model.Block_AlignLeft, "2": model.Block_AlignCenter, "3": model.Block_AlignRight,
+		}, aligns)
+	})
+}
+
+func findBlockByStyle(blocks []*model.Block, style model.BlockContentTextStyle) *model.Block {
+	for _, b := range blocks {
+		if b.GetText().GetStyle() == style {
+			return b
+		}
+	}
+	return nil
+}
+
+func findBlockByID(blocks []*model.Block, id string) *model.Block {
+	for _, b := range blocks {
+		if b.Id == id {
+			return b
+		}
+	}
+	return nil
+}
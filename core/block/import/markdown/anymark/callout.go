@@ -0,0 +1,133 @@
+package anymark
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+// calloutMarker matches the first line of Obsidian and GitHub callouts: > [!TYPE] or > [!TYPE]+/- for foldable ones
+var calloutMarker = regexp.MustCompile(`^\[!([A-Za-z-]+)\][+-]?[ \t]*`)
+
+type calloutStyle struct {
+	emoji string
+	color string
+}
+
+// calloutStyles maps callout types and their aliases to icon and background color of callout block.
+// Types, which are not listed here, are imported as callouts with default icon and color
+var calloutStyles = map[string]calloutStyle{
+	"note":      {emoji: "📝", color: "blue"},
+	"abstract":  {emoji: "📋", color: "teal"},
+	"summary":   {emoji: "📋", color: "teal"},
+	"tldr":      {emoji: "📋", color: "teal"},
+	"info":      {emoji: "ℹ️", color: "blue"},
+	"todo":      {emoji: "☑️", color: "blue"},
+	"tip":       {emoji: "💡", color: "teal"},
+	"hint":      {emoji: "💡", color: "teal"},
+	"important": {emoji: "❗", color: "purple"},
+	"success":   {emoji: "✅", color: "lime"},
+	"check":     {emoji: "✅", color: "lime"},
+	"done":      {emoji: "✅", color: "lime"},
+	"question":  {emoji: "❓", color: "yellow"},
+	"help":      {emoji: "❓", color: "yellow"},
+	"faq":       {emoji: "❓", color: "yellow"},
+	"warning":   {emoji: "⚠️", color: "orange"},
+	"caution":   {emoji: "⚠️", color: "red"},
+	"attention": {emoji: "⚠️", color: "orange"},
+	"failure":   {emoji: "❌", color: "red"},
+	"fail":      {emoji: "❌", color: "red"},
+	"missing":   {emoji: "❌", color: "red"},
+	"danger":    {emoji: "⚡", color: "red"},
+	"error":     {emoji: "⚡", color: "red"},
+	"bug":       {emoji: "🐞", color: "red"},
+	"example":   {emoji: "📑", color: "purple"},
+	"quote":     {emoji: "💬", color: "grey"},
+	"cite":      {emoji: "💬", color: "grey"},
+}
+
+func isCalloutQuote(t *model.BlockContentText) bool {
+	return t.Style == model.BlockContentText_Quote && calloutMarker.MatchString(t.Text)
+}
+
+// normalizeCalloutBlock turns quote, which starts with callout marker, into callout block.
+// The rest of the marker line is used as callout title, or the type of callout if the line is empty.
+// The lines after the title are moved to the first child block, so the nested content is kept
+func (r *blocksRenderer) normalizeCalloutBlock(block *textBlock) {
+	t := block.GetText()
+	loc := calloutMarker.FindStringSubmatchIndex(t.Text)
+	calloutType := t.Text[loc[2]:loc[3]]
+	t.Text = t.Text[loc[1]:]
+	r.adjustMarkdownRange(t, loc[1])
+
+	t.Style = model.BlockContentText_Callout
+	if style, ok := calloutStyles[strings.ToLower(calloutType)]; ok {
+		t.IconEmoji = style.emoji
+		block.BackgroundColor = style.color
+	}
+
+	title, body, hasBody := strings.Cut(t.Text, "\n")
+	titleLen := int32(text.UTF16RuneCountString(title))
+	titleMarks, bodyMarks := splitMarks(t.Marks.Marks, titleLen, titleLen+1)
+	t.Text = strings.TrimSpace(title)
+	t.Marks.Marks = titleMarks
+	if t.Text == "" {
+		t.Text = strings.ToUpper(calloutType[:1]) + strings.ToLower(calloutType[1:])
+		t.Marks.Marks = nil
+	}
+
+	if !hasBody || strings.TrimSpace(body) == "" {
+		return
+	}
+	bodyBlock := &model.Block{
+		Id: uuid.New().String(),
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{
+				Text:  body,
+				Marks: &model.BlockContentTextMarks{Marks: bodyMarks},
+			},
+		},
+	}
+	r.blocks = append(r.blocks, bodyBlock)
+	block.ChildrenIds = append([]string{bodyBlock.Id}, block.ChildrenIds...)
+}
+
+// splitMarks divides marks into marks before the end position and marks after the start position.
+// Marks after the start position are shifted, so they are counted from the start
+func splitMarks(marks []*model.BlockContentTextMark, end, start int32) (before, after []*model.BlockContentTextMark) {
+	for _, mark := range marks {
+		if mark.Range == nil || mark.Range.To <= 0 {
+			continue
+		}
+		if mark.Range.From < end {
+			before = append(before, &model.BlockContentTextMark{
+				Range: &model.Range{From: mark.Range.From, To: min32(mark.Range.To, end)},
+				Type:  mark.Type,
+				Param: mark.Param,
+			})
+		}
+		if mark.Range.To > start {
+			from := mark.Range.From - start
+			if from < 0 {
+				from = 0
+			}
+			after = append(after, &model.BlockContentTextMark{
+				Range: &model.Range{From: from, To: mark.Range.To - start},
+				Type:  mark.Type,
+				Param: mark.Param,
+			})
+		}
+	}
+	return before, after
+}
+
+func min32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
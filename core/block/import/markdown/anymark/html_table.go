@@ -0,0 +1,251 @@
+package anymark
+
+import (
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/yuin/goldmark/ast"
+
+	te "github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+// maxHTMLTableSpan limits colspan and rowspan of cells, so broken table can't produce huge grid
+const maxHTMLTableSpan = 100
+
+var textAlignStyles = map[string]model.BlockAlign{
+	"left":   model.Block_AlignLeft,
+	"center": model.Block_AlignCenter,
+	"right":  model.Block_AlignRight,
+}
+
+// htmlTableCell is position of HTML table grid. Cell spanning several rows or columns keeps its content
+// in the top left position, and the other positions are empty
+type htmlTableCell struct {
+	selection *goquery.Selection
+	align     model.BlockAlign
+}
+
+type htmlTableRow struct {
+	cells    []*htmlTableCell
+	isHeader bool
+}
+
+func htmlBlockText(source []byte, n *ast.HTMLBlock) string {
+	var b strings.Builder
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		b.Write(line.Value(source))
+	}
+	if n.HasClosure() {
+		b.Write(n.ClosureLine.Value(source))
+	}
+	return b.String()
+}
+
+// AddHTMLTables converts tables of raw HTML, which Notion and other editors put into Markdown, to table blocks.
+// Other HTML is skipped
+func (r *blocksRenderer) AddHTMLTables(source string) error {
+	if !strings.Contains(strings.ToLower(source), "<table") {
+		return nil
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(source))
+	if err != nil {
+		return err
+	}
+	tables := doc.Find("table").FilterFunction(func(_ int, s *goquery.Selection) bool {
+		return s.ParentsFiltered("table").Length() == 0
+	})
+	for i := range tables.Nodes {
+		if err = r.addHTMLTable(tables.Eq(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *blocksRenderer) addHTMLTable(tableSelection *goquery.Selection) error {
+	rows := htmlTableGrid(tableSelection)
+	if len(rows) == 0 {
+		return nil
+	}
+	var columnsCount int
+	for _, row := range rows {
+		if len(row.cells) > columnsCount {
+			columnsCount = len(row.cells)
+		}
+	}
+	editor := te.NewEditor(nil)
+	s := newTableState()
+	tableID, err := editor.TableCreate(s, pb.RpcBlockTableCreateRequest{})
+	if err != nil {
+		return err
+	}
+	columnIDs := make([]string, 0, columnsCount)
+	for i := 0; i < columnsCount; i++ {
+		colID, err := editor.ColumnCreate(s, pb.RpcBlockTableColumnCreateRequest{
+			Position: model.Block_Inner,
+			TargetId: tableID,
+		})
+		if err != nil {
+			return err
+		}
+		columnIDs = append(columnIDs, colID)
+	}
+	for _, row := range rows {
+		rowID, err := editor.RowCreate(s, pb.RpcBlockTableRowCreateRequest{
+			Position: model.Block_Inner,
+			TargetId: tableID,
+		})
+		if err != nil {
+			return err
+		}
+		if row.isHeader {
+			if err = editor.RowSetHeader(s, pb.RpcBlockTableRowSetHeaderRequest{TargetId: rowID, IsHeader: true}); err != nil {
+				return err
+			}
+		}
+		for i, cell := range row.cells {
+			if cell == nil || cell.selection == nil {
+				continue
+			}
+			block := htmlCellBlock(cell.selection)
+			if block == nil {
+				continue
+			}
+			cellID, err := editor.CellCreate(s, rowID, columnIDs[i], block)
+			if err != nil {
+				return err
+			}
+			s.Get(cellID).Model().Align = cell.align
+		}
+	}
+	r.addChildIDToParentBlock(tableID)
+	r.blocks = append(r.blocks, tableBlocks(s)...)
+	return nil
+}
+
+// htmlTableGrid places cells of the table to the grid. Rows of nested tables are not included
+func htmlTableGrid(tableSelection *goquery.Selection) []*htmlTableRow {
+	trs := tableSelection.Find("tr").FilterFunction(func(_ int, s *goquery.Selection) bool {
+		return s.Closest("table").IsSelection(tableSelection)
+	})
+	rows := make([]*htmlTableRow, trs.Length())
+	for i := range rows {
+		rows[i] = &htmlTableRow{}
+	}
+	trs.Each(func(rowIndex int, tr *goquery.Selection) {
+		row := rows[rowIndex]
+		cells := tr.ChildrenFiltered("td, th")
+		row.isHeader = cells.Length() > 0 && (goquery.NodeName(tr.Parent()) == "thead" ||
+			cells.Length() == cells.Filter("th").Length())
+		column := 0
+		cells.Each(func(_ int, cell *goquery.Selection) {
+			for column < len(row.cells) && row.cells[column] != nil {
+				column++
+			}
+			colspan := htmlSpan(cell, "colspan")
+			rowspan := htmlSpan(cell, "rowspan")
+			if rowIndex+rowspan > len(rows) {
+				rowspan = len(rows) - rowIndex
+			}
+			align := htmlCellAlign(cell)
+			for r := rowIndex; r < rowIndex+rowspan; r++ {
+				for c := column; c < column+colspan; c++ {
+					placeholder := &htmlTableCell{align: align}
+					if r == rowIndex && c == column {
+						placeholder.selection = cell
+					}
+					rows[r].setCell(c, placeholder)
+				}
+			}
+			column += colspan
+		})
+	})
+	return rows
+}
+
+func (r *htmlTableRow) setCell(column int, cell *htmlTableCell) {
+	for len(r.cells) <= column {
+		r.cells = append(r.cells, nil)
+	}
+	if r.cells[column] == nil {
+		r.cells[column] = cell
+	}
+}
+
+func htmlSpan(cell *goquery.Selection, attr string) int {
+	value, err := strconv.Atoi(strings.TrimSpace(cell.AttrOr(attr, "1")))
+	if err != nil || value < 1 {
+		return 1
+	}
+	if value > maxHTMLTableSpan {
+		return maxHTMLTableSpan
+	}
+	return value
+}
+
+func htmlCellAlign(cell *goquery.Selection) model.BlockAlign {
+	if align, ok := textAlignStyles[strings.ToLower(strings.TrimSpace(cell.AttrOr("align", "")))]; ok {
+		return align
+	}
+	for _, declaration := range strings.Split(cell.AttrOr("style", ""), ";") {
+		property, value, found := strings.Cut(declaration, ":")
+		if !found || !strings.EqualFold(strings.TrimSpace(property), "text-align") {
+			continue
+		}
+		if align, ok := textAlignStyles[strings.ToLower(strings.TrimSpace(value))]; ok {
+			return align
+		}
+	}
+	return model.Block_AlignLeft
+}
+
+// htmlCellBlock converts content of the cell to text block. Cells support only text, so nested tables
+// are flattened to lines of text and paragraphs are joined
+func htmlCellBlock(cell *goquery.Selection) *model.Block {
+	cell = cell.Clone()
+	cell.Find("table").Each(func(_ int, nested *goquery.Selection) {
+		var lines []string
+		nested.Find("tr").Each(func(_ int, tr *goquery.Selection) {
+			var values []string
+			tr.ChildrenFiltered("td, th").Each(func(_ int, c *goquery.Selection) {
+				values = append(values, strings.TrimSpace(c.Text()))
+			})
+			lines = append(lines, html.EscapeString(strings.Join(values, " | ")))
+		})
+		nested.ReplaceWithHtml("<br>" + strings.Join(lines, "<br>"))
+	})
+	content, err := cell.Html()
+	if err != nil || strings.TrimSpace(content) == "" {
+		return nil
+	}
+	blocks, _, err := HTMLToBlocks([]byte(content))
+	if err != nil {
+		return nil
+	}
+	cellText := &model.BlockContentText{Marks: &model.BlockContentTextMarks{}}
+	for _, block := range blocks {
+		t := block.GetText()
+		if t == nil || t.Text == "" {
+			continue
+		}
+		if cellText.Text != "" {
+			cellText.Text += "\n"
+		}
+		shift := int32(text.UTF16RuneCountString(cellText.Text))
+		for _, mark := range t.GetMarks().GetMarks() {
+			shiftMark(mark, shift)
+			cellText.Marks.Marks = append(cellText.Marks.Marks, mark)
+		}
+		cellText.Text += t.Text
+	}
+	if cellText.Text == "" {
+		return nil
+	}
+	return &model.Block{Content: &model.BlockContentOfText{Text: cellText}}
+}
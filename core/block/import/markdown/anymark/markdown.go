@@ -26,14 +26,18 @@ var (
 	reWikiWbr = regexp.MustCompile(`<wbr[^>]*>`)
 )
 
-func convertBlocks(source []byte, r ...renderer.NodeRenderer) error {
+// markdownExtensions are syntax extensions of Markdown files, they are not used for Markdown, which is made of HTML
+var markdownExtensions = []goldmark.Extender{extension.Footnote, mathExtension{}}
+
+func convertBlocks(source []byte, extensions []goldmark.Extender, r ...renderer.NodeRenderer) error {
 	nodeRenderers := make([]util.PrioritizedValue, 0, len(r))
 	for _, nodeRenderer := range r {
 		nodeRenderers = append(nodeRenderers, util.Prioritized(nodeRenderer, 100))
 	}
 	gm := goldmark.New(goldmark.WithRenderer(
 		renderer.NewRenderer(renderer.WithNodeRenderers(nodeRenderers...)),
-	), goldmark.WithExtensions(extension.Table), goldmark.WithExtensions(extension.Strikethrough))
+	), goldmark.WithExtensions(extension.Table), goldmark.WithExtensions(extension.Strikethrough),
+		goldmark.WithExtensions(extensions...))
 	return gm.Convert(source, &bytes.Buffer{})
 }
 
@@ -47,7 +51,7 @@ func MarkdownToBlocks(markdownSource []byte,
 	te := table.NewEditor(nil)
 	tr := NewTableRenderer(br, te)
 	// allFileShortPaths,
-	err = convertBlocks(markdownSource, r, tr)
+	err = convertBlocks(markdownSource, markdownExtensions, r, tr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,7 +89,7 @@ func HTMLToBlocks(source []byte) (blocks []*model.Block, rootBlockIDs []string,
 	blRenderer := newBlocksRenderer("", nil)
 	r := NewRenderer(blRenderer)
 	tr := NewTableRenderer(blRenderer, table.NewEditor(nil))
-	err = convertBlocks([]byte(md), r, tr)
+	err = convertBlocks([]byte(md), nil, r, tr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,186 @@
+package anymark
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var mathDelimiter = []byte("$$")
+
+var (
+	kindMathBlock  = ast.NewNodeKind("MathBlock")
+	kindInlineMath = ast.NewNodeKind("InlineMath")
+)
+
+// mathBlock is formula between $$ lines, which is used by Obsidian, Typora and other editors
+type mathBlock struct {
+	ast.BaseBlock
+	lines  []string
+	closed bool
+}
+
+func (n *mathBlock) Kind() ast.NodeKind {
+	return kindMathBlock
+}
+
+func (n *mathBlock) IsRaw() bool {
+	return true
+}
+
+func (n *mathBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Formula": n.formula()}, nil)
+}
+
+func (n *mathBlock) formula() string {
+	return strings.TrimSpace(strings.Join(n.lines, "\n"))
+}
+
+// inlineMath is formula between $ or $$ inside of the line
+type inlineMath struct {
+	ast.BaseInline
+	formula string
+}
+
+func (n *inlineMath) Kind() ast.NodeKind {
+	return kindInlineMath
+}
+
+func (n *inlineMath) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Formula": n.formula}, nil)
+}
+
+// mathExtension adds parsers of math blocks and inline formulas
+type mathExtension struct{}
+
+func (mathExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(util.Prioritized(&mathBlockParser{}, 750)),
+		parser.WithInlineParsers(util.Prioritized(&inlineMathParser{}, 150)),
+	)
+}
+
+type mathBlockParser struct{}
+
+func (p *mathBlockParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+func (p *mathBlockParser) Open(_ ast.Node, reader text.Reader, _ parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	trimmed := bytes.TrimSpace(line)
+	if !bytes.HasPrefix(trimmed, mathDelimiter) {
+		return nil, parser.NoChildren
+	}
+	node := &mathBlock{}
+	rest := trimmed[len(mathDelimiter):]
+	if end := bytes.Index(rest, mathDelimiter); end >= 0 {
+		// formula in one line is a block only if nothing follows it
+		if len(bytes.TrimSpace(rest[end+len(mathDelimiter):])) != 0 {
+			return nil, parser.NoChildren
+		}
+		node.lines = append(node.lines, string(rest[:end]))
+		node.closed = true
+	} else if len(rest) != 0 {
+		node.lines = append(node.lines, string(rest))
+	}
+	reader.Advance(segment.Len() - newlineLength(line))
+	return node, parser.NoChildren
+}
+
+func (p *mathBlockParser) Continue(node ast.Node, reader text.Reader, _ parser.Context) parser.State {
+	n := node.(*mathBlock)
+	if n.closed {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	if line == nil {
+		return parser.Close
+	}
+	trimmed := bytes.TrimSpace(line)
+	reader.Advance(segment.Len() - newlineLength(line))
+	if bytes.HasSuffix(trimmed, mathDelimiter) {
+		n.lines = append(n.lines, string(trimmed[:len(trimmed)-len(mathDelimiter)]))
+		n.closed = true
+		return parser.Close
+	}
+	n.lines = append(n.lines, string(bytes.TrimRight(line, "\r\n")))
+	return parser.Continue | parser.NoChildren
+}
+
+func (p *mathBlockParser) Close(ast.Node, text.Reader, parser.Context) {}
+
+func (p *mathBlockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (p *mathBlockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+func newlineLength(line []byte) int {
+	if len(line) != 0 && line[len(line)-1] == '\n' {
+		return 1
+	}
+	return 0
+}
+
+type inlineMathParser struct{}
+
+func (p *inlineMathParser) Trigger() []byte {
+	return []byte{'$'}
+}
+
+// Parse reads formula between $ or $$. Like Pandoc, formula between single dollars can't start or end with space
+// and can't be followed by digit, so prices like $5 and $10 stay text
+func (p *inlineMathParser) Parse(_ ast.Node, block text.Reader, _ parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	delimiter := line[:1]
+	if bytes.HasPrefix(line, mathDelimiter) {
+		delimiter = mathDelimiter
+	}
+	rest := line[len(delimiter):]
+	if len(rest) == 0 || util.IsSpace(rest[0]) && len(delimiter) == 1 {
+		return nil
+	}
+	for i := 0; i < len(rest); i++ {
+		if rest[i] == '\\' {
+			i++
+			continue
+		}
+		if !bytes.HasPrefix(rest[i:], delimiter) {
+			continue
+		}
+		if i == 0 {
+			return nil
+		}
+		after := i + len(delimiter)
+		if len(delimiter) == 1 && (util.IsSpace(rest[i-1]) || after < len(rest) && isDigit(rest[after])) {
+			continue
+		}
+		block.Advance(len(delimiter) + after)
+		return &inlineMath{formula: strings.TrimSpace(string(rest[:i]))}
+	}
+	return nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// paragraphFormula returns formula, if the paragraph contains only it
+func paragraphFormula(n ast.Node) (string, bool) {
+	if n.ChildCount() != 1 {
+		return "", false
+	}
+	formula, ok := n.FirstChild().(*inlineMath)
+	if !ok {
+		return "", false
+	}
+	return formula.formula, true
+}
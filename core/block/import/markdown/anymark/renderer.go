@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/gogo/protobuf/types"
@@ -46,6 +47,9 @@ func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindParagraph, r.renderParagraph)
 	reg.Register(ast.KindTextBlock, r.renderTextBlock)
 	reg.Register(ast.KindThematicBreak, r.renderThematicBreak)
+	reg.Register(kindMathBlock, r.renderMathBlock)
+	reg.Register(ext.KindFootnoteList, r.renderFootnoteList)
+	reg.Register(ext.KindFootnote, r.renderFootnote)
 
 	// inlines
 
@@ -58,6 +62,29 @@ func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindText, r.renderText)
 	reg.Register(ast.KindString, r.renderString)
 	reg.Register(ext.KindStrikethrough, r.renderStrikethrough)
+	reg.Register(kindInlineMath, r.renderInlineMath)
+	reg.Register(ext.KindFootnoteLink, r.renderFootnoteLink)
+	reg.Register(ext.KindFootnoteBacklink, r.renderFootnoteBacklink)
+}
+
+// fenceProcessors are languages of fenced code, which are imported as latex blocks with the processor
+var fenceProcessors = map[string]model.BlockContentLatexProcessor{
+	"math":    model.BlockContentLatex_Latex,
+	"latex":   model.BlockContentLatex_Latex,
+	"mermaid": model.BlockContentLatex_Mermaid,
+}
+
+func footnoteLabel(index int) string {
+	return "[" + strconv.Itoa(index) + "]"
+}
+
+func linesText(source []byte, n ast.Node) string {
+	var b strings.Builder
+	for i := 0; i < n.Lines().Len(); i++ {
+		line := n.Lines().At(i)
+		b.Write(line.Value(source))
+	}
+	return strings.TrimRight(b.String(), "\n")
 }
 
 func (r *Renderer) writeLines(source []byte, n ast.Node) {
@@ -138,6 +165,12 @@ func (r *Renderer) renderFencedCodeBlock(_ util.BufWriter,
 	entering bool) (ast.WalkStatus, error) {
 	n := node.(*ast.FencedCodeBlock)
 	language := string(n.Language(source))
+	if processor, ok := fenceProcessors[strings.ToLower(language)]; ok {
+		if entering {
+			r.AddLatexBlock(linesText(source, n), processor)
+		}
+		return ast.WalkSkipChildren, nil
+	}
 	var fields *types.Struct
 	if language != "" {
 		fields = &types.Struct{Fields: map[string]*types.Value{"lang": pbtypes.String(language)}}
@@ -155,8 +188,11 @@ func (r *Renderer) renderHTMLBlock(_ util.BufWriter,
 	source []byte,
 	node ast.Node,
 	entering bool) (ast.WalkStatus, error) {
-	// Do not render
-	return ast.WalkContinue, nil
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	// tables are the only HTML, which is rendered
+	return ast.WalkContinue, r.AddHTMLTables(htmlBlockText(source, node.(*ast.HTMLBlock)))
 }
 
 func (r *Renderer) renderList(_ util.BufWriter,
@@ -192,6 +228,13 @@ func (r *Renderer) renderParagraph(_ util.BufWriter,
 	source []byte,
 	n ast.Node,
 	entering bool) (ast.WalkStatus, error) {
+	// paragraph with the only formula is imported as latex block
+	if formula, ok := paragraphFormula(n); ok {
+		if entering {
+			r.AddLatexBlock(formula, model.BlockContentLatex_Latex)
+		}
+		return ast.WalkSkipChildren, nil
+	}
 	if entering {
 		r.OpenNewTextBlock(model.BlockContentText_Paragraph, nil)
 	} else {
@@ -224,6 +267,39 @@ func (r *Renderer) renderThematicBreak(_ util.BufWriter,
 	return ast.WalkContinue, nil
 }
 
+func (r *Renderer) renderMathBlock(_ util.BufWriter,
+	source []byte,
+	node ast.Node,
+	entering bool) (ast.WalkStatus, error) {
+	if entering {
+		r.AddLatexBlock(node.(*mathBlock).formula(), model.BlockContentLatex_Latex)
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFootnoteList(_ util.BufWriter,
+	source []byte,
+	node ast.Node,
+	entering bool) (ast.WalkStatus, error) {
+	// footnotes are rendered at the end of the document in the order of their references
+	return ast.WalkContinue, nil
+}
+
+// renderFootnote renders definition of footnote as callout, which starts with the same label as references to it
+func (r *Renderer) renderFootnote(_ util.BufWriter,
+	source []byte,
+	node ast.Node,
+	entering bool) (ast.WalkStatus, error) {
+	n := node.(*ext.Footnote)
+	if entering {
+		r.OpenNewTextBlock(model.BlockContentText_Callout, nil)
+	} else {
+		r.AddLabelToTextBlock(footnoteLabel(n.Index))
+		r.CloseTextBlock(model.BlockContentText_Callout)
+	}
+	return ast.WalkContinue, nil
+}
+
 func (r *Renderer) renderAutoLink(_ util.BufWriter,
 	source []byte,
 	node ast.Node,
@@ -427,6 +503,33 @@ func (r *Renderer) renderString(_ util.BufWriter, source []byte, node ast.Node,
 	return ast.WalkContinue, nil
 }
 
+func (r *Renderer) renderInlineMath(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	// text blocks don't support formulas, so formula inside of the text is kept as code
+	r.SetMarkStart()
+	r.AddTextToBuffer(node.(*inlineMath).formula)
+	to := int32(text.UTF16RuneCountString(r.GetText()))
+	r.AddMark(model.BlockContentTextMark{
+		Range: &model.Range{From: int32(r.GetMarkStart()), To: to},
+		Type:  model.BlockContentTextMark_Keyboard,
+	})
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFootnoteLink(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		r.AddTextToBuffer(footnoteLabel(node.(*ext.FootnoteLink).Index))
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderFootnoteBacklink(_ util.BufWriter, _ []byte, _ ast.Node, _ bool) (ast.WalkStatus, error) {
+	// callout of footnote is found by its label, so backlinks are not rendered
+	return ast.WalkSkipChildren, nil
+}
+
 func (r *Renderer) renderStrikethrough(_ util.BufWriter, _ []byte, _ ast.Node, entering bool) (ast.WalkStatus, error) {
 	tag := model.BlockContentTextMark_Strikethrough
 	if entering {
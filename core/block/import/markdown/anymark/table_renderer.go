@@ -70,13 +70,7 @@ func (r *TableRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 
 func (r *TableRenderer) renderTable(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
 	if entering {
-		r.blocksState = state.NewDoc("root", map[string]simple.Block{
-			"root": simple.New(&model.Block{
-				Content: &model.BlockContentOfSmartblock{
-					Smartblock: &model.BlockContentSmartblock{},
-				},
-			}),
-		}).NewState()
+		r.blocksState = newTableState()
 		id, err := r.tableEditor.TableCreate(r.blocksState, pb.RpcBlockTableCreateRequest{})
 		r.tableState.tableID = id
 		if err != nil {
@@ -84,18 +78,8 @@ func (r *TableRenderer) renderTable(_ util.BufWriter, _ []byte, node ast.Node, e
 		}
 		return ast.WalkContinue, nil
 	}
-	blocksToAdd := make([]*model.Block, 0, len(r.blocksState.Blocks()))
-	for _, block := range r.blocksState.Blocks() {
-		if block.GetContent() != nil {
-			if _, ok := block.GetContent().(*model.BlockContentOfSmartblock); ok {
-				continue
-			}
-		}
-		blocksToAdd = append(blocksToAdd, block)
-	}
-
 	r.blockRenderer.addChildIDToParentBlock(r.tableState.tableID)
-	r.blockRenderer.blocks = append(r.blockRenderer.blocks, blocksToAdd...)
+	r.blockRenderer.blocks = append(r.blockRenderer.blocks, tableBlocks(r.blocksState)...)
 	r.blocksState = nil
 	r.tableState.resetState()
 	r.blockRenderer.textBuffer = ""
@@ -164,7 +148,7 @@ func (r *TableRenderer) renderTableCell(_ util.BufWriter,
 		))
 		n := node.Lines()
 
-		status, err := r.createCell(n, gm, source, ren)
+		status, err := r.createCell(n, gm, source, ren, cellAlign(node.(*table.TableCell).Alignment))
 		if err != nil {
 			return status, err
 		}
@@ -174,7 +158,7 @@ func (r *TableRenderer) renderTableCell(_ util.BufWriter,
 
 func (r *TableRenderer) createCell(n *text.Segments,
 	gm goldmark.Markdown,
-	source []byte, ren *Renderer) (ast.WalkStatus, error) {
+	source []byte, ren *Renderer, align model.BlockAlign) (ast.WalkStatus, error) {
 	for i := 0; i < n.Len(); i++ {
 		seg := n.At(i)
 		err := gm.Convert(seg.Value(source), &bytes.Buffer{})
@@ -192,10 +176,12 @@ func (r *TableRenderer) createCell(n *text.Segments,
 			if _, ok := block.Content.(*model.BlockContentOfText); !ok {
 				block.Content = &model.BlockContentOfText{Text: &model.BlockContentText{}}
 			}
-			_, err = r.tableEditor.CellCreate(r.blocksState, r.tableState.currTableRow, colID, block)
+			var cellID string
+			cellID, err = r.tableEditor.CellCreate(r.blocksState, r.tableState.currTableRow, colID, block)
 			if err != nil {
 				return ast.WalkContinue, err
 			}
+			r.blocksState.Get(cellID).Model().Align = align
 		}
 	}
 	return 0, nil
@@ -222,3 +208,39 @@ func (r *TableRenderer) getColumnID() (string, error) {
 	r.tableState.currColumnIDIndex++
 	return colID, nil
 }
+
+// newTableState returns state, where table is built before its blocks are added to the blocks of the document
+func newTableState() *state.State {
+	return state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{
+			Content: &model.BlockContentOfSmartblock{
+				Smartblock: &model.BlockContentSmartblock{},
+			},
+		}),
+	}).NewState()
+}
+
+// tableBlocks returns blocks of the table without root block of the state
+func tableBlocks(s *state.State) []*model.Block {
+	blocks := make([]*model.Block, 0, len(s.Blocks()))
+	for _, block := range s.Blocks() {
+		if block.GetContent() != nil {
+			if _, ok := block.GetContent().(*model.BlockContentOfSmartblock); ok {
+				continue
+			}
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func cellAlign(alignment table.Alignment) model.BlockAlign {
+	switch alignment {
+	case table.AlignCenter:
+		return model.Block_AlignCenter
+	case table.AlignRight:
+		return model.Block_AlignRight
+	default:
+		return model.Block_AlignLeft
+	}
+}
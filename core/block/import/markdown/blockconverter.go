@@ -28,21 +28,40 @@ type FileInfo struct {
 	IsRootFile      bool
 	Title           string
 	ParsedBlocks    []*model.Block
+	InlineFields    []inlineField
+	FrontMatter     []frontMatterField
+	Tags            []string
+	// IsCanvas is set for Obsidian canvas files, which are imported as pages
+	IsCanvas bool
 }
 
 func newMDConverter(tempDirProvider core.TempDirProvider) *mdConverter {
 	return &mdConverter{tempDirProvider: tempDirProvider}
 }
 
-func (m *mdConverter) markdownToBlocks(importPath string, importSource source.Source, allErrors *ce.ConvertError) map[string]*FileInfo {
-	files := m.processFiles(importPath, allErrors, importSource)
+func (m *mdConverter) markdownToBlocks(importPath string,
+	importSource source.Source,
+	processShortcodes bool,
+	obsidian bool,
+	concurrency int,
+	limits ce.ParseLimits,
+	allErrors *ce.ConvertError,
+) map[string]*FileInfo {
+	files := m.processFiles(importPath, allErrors, importSource, processShortcodes, obsidian, concurrency, limits)
 
 	log.Debug("2. DirWithMarkdownToBlocks: MarkdownToBlocks completed")
 
 	return files
 }
 
-func (m *mdConverter) processFiles(importPath string, allErrors *ce.ConvertError, importSource source.Source) map[string]*FileInfo {
+func (m *mdConverter) processFiles(importPath string,
+	allErrors *ce.ConvertError,
+	importSource source.Source,
+	processShortcodes bool,
+	obsidian bool,
+	concurrency int,
+	limits ce.ParseLimits,
+) map[string]*FileInfo {
 	err := importSource.Initialize(importPath)
 	if err != nil {
 		allErrors.Add(err)
@@ -54,7 +73,10 @@ func (m *mdConverter) processFiles(importPath string, allErrors *ce.ConvertError
 		allErrors.Add(ce.ErrNoObjectsToImport)
 		return nil
 	}
-	fileInfo := m.getFileInfo(importSource, allErrors)
+	fileInfo := m.getFileInfo(importSource, processShortcodes, obsidian, concurrency, limits, allErrors)
+	if obsidian {
+		resolveWikilinks(fileInfo)
+	}
 	for name, file := range fileInfo {
 		m.processBlocks(name, file, fileInfo)
 		for _, b := range file.ParsedBlocks {
@@ -64,10 +86,23 @@ func (m *mdConverter) processFiles(importPath string, allErrors *ce.ConvertError
 	return fileInfo
 }
 
-func (m *mdConverter) getFileInfo(importSource source.Source, allErrors *ce.ConvertError) map[string]*FileInfo {
+// getFileInfo parses files of the source on several workers. Each worker fills only its own FileInfo,
+// and files are added to the result in order of their names
+func (m *mdConverter) getFileInfo(importSource source.Source,
+	processShortcodes bool,
+	obsidian bool,
+	concurrency int,
+	limits ce.ParseLimits,
+	allErrors *ce.ConvertError,
+) map[string]*FileInfo {
 	fileInfo := make(map[string]*FileInfo, 0)
-	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
-		if err := m.fillFilesInfo(fileInfo, fileName, fileReader); err != nil {
+	if iterateErr := source.ParallelIterate(importSource, concurrency, func(fileName string, fileReader io.Reader) (*FileInfo, error) {
+		file := &FileInfo{}
+		return file, m.createBlocksFromFile(fileName, fileReader, file, processShortcodes, obsidian, limits)
+	}, func(fileName string, file *FileInfo, err error) bool {
+		fileInfo[fileName] = file
+		if err != nil {
+			log.Errorf("failed to create blocks from file: %s", err)
 			allErrors.Add(err)
 			if allErrors.ShouldAbortImport(0, pb.RpcObjectImportRequest_Markdown) {
 				return false
@@ -80,15 +115,6 @@ func (m *mdConverter) getFileInfo(importSource source.Source, allErrors *ce.Conv
 	return fileInfo
 }
 
-func (m *mdConverter) fillFilesInfo(fileInfo map[string]*FileInfo, path string, rc io.ReadCloser) error {
-	fileInfo[path] = &FileInfo{}
-	if err := m.createBlocksFromFile(path, rc, fileInfo); err != nil {
-		log.Errorf("failed to create blocks from file: %s", err)
-		return err
-	}
-	return nil
-}
-
 func (m *mdConverter) processBlocks(shortPath string, file *FileInfo, files map[string]*FileInfo) {
 	for _, block := range file.ParsedBlocks {
 		m.processTextBlock(block, files)
@@ -222,19 +248,44 @@ func (m *mdConverter) convertTextToPageMention(block *model.Block) {
 	}
 }
 
-func (m *mdConverter) createBlocksFromFile(shortPath string, f io.ReadCloser, files map[string]*FileInfo) error {
+func (m *mdConverter) createBlocksFromFile(shortPath string, f io.Reader, file *FileInfo, processShortcodes, obsidian bool, limits ce.ParseLimits) error {
 	if filepath.Base(shortPath) == shortPath {
-		files[shortPath].IsRootFile = true
+		file.IsRootFile = true
+	}
+	if obsidian && strings.EqualFold(filepath.Ext(shortPath), canvasExtension) {
+		b, err := limits.ReadAll(f)
+		if err != nil {
+			return err
+		}
+		if err = limits.CheckJSON(b); err != nil {
+			return ce.NewFileError(shortPath, err)
+		}
+		file.ParsedBlocks, err = canvasToBlocks(b, filepath.Dir(shortPath))
+		if err != nil {
+			return ce.NewFileError(shortPath, err)
+		}
+		file.IsCanvas = true
+		return nil
 	}
 	if filepath.Ext(shortPath) == ".md" {
-		b, err := io.ReadAll(f)
+		b, err := limits.ReadAll(f)
 		if err != nil {
 			return err
 		}
-		files[shortPath].ParsedBlocks, _, err = anymark.MarkdownToBlocks(b, filepath.Dir(shortPath), nil)
+		if processShortcodes {
+			b = convertShortcodes(b)
+		}
+		b, file.FrontMatter = extractFrontMatter(b)
+		b, file.InlineFields = extractInlineFields(b)
+		if obsidian {
+			b = convertWikilinks(b)
+		}
+		file.ParsedBlocks, _, err = anymark.MarkdownToBlocks(b, filepath.Dir(shortPath), nil)
 		if err != nil {
 			log.Errorf("failed to read blocks: %s", err)
 		}
+		file.Tags = extractHashtags(file.ParsedBlocks)
+		replaceDataviewQueries(file.ParsedBlocks)
 	}
 	return nil
 }
@@ -1,8 +1,10 @@
 package markdown
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/samber/lo"
@@ -12,6 +14,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 type MockTempDir struct{}
@@ -36,7 +39,7 @@ func Test_processFiles(t *testing.T) {
 		source := source.GetSource(absolutePath)
 
 		// when
-		files := converter.processFiles(absolutePath, converter2.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source)
+		files := converter.processFiles(absolutePath, converter2.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source, false, false, 1, converter2.DefaultParseLimits)
 
 		// then
 		assert.Len(t, files, 3)
@@ -68,7 +71,7 @@ func Test_processFiles(t *testing.T) {
 		absolutePath := filepath.Join(workingDir, "./testdata")
 
 		// when
-		files := converter.processFiles(absolutePath, converter2.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source)
+		files := converter.processFiles(absolutePath, converter2.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source, false, false, 1, converter2.DefaultParseLimits)
 
 		// then
 		assert.Len(t, files, 1)
@@ -89,3 +92,55 @@ func Test_processFiles(t *testing.T) {
 		assert.Len(t, fileBlocks, 0)
 	})
 }
+
+func Test_createBlocksFromFile(t *testing.T) {
+	content := "{{< figure src=\"images/cat.png\" caption=\"Cat\" >}}\n\n" +
+		"{% highlight go %}\nfmt.Println(\"{{ .Title }}\")\n{% endhighlight %}\n\n" +
+		"{{< youtube w7Ft2ymGmfc >}}\n"
+
+	t.Run("shortcodes processing is on - figure is file block, highlight is code block", func(t *testing.T) {
+		// given
+		converter := newMDConverter(&MockTempDir{})
+		files := map[string]*FileInfo{"posts/post.md": {}}
+
+		// when
+		err := converter.createBlocksFromFile("posts/post.md", io.NopCloser(strings.NewReader(content)), files["posts/post.md"], true, false, converter2.DefaultParseLimits)
+
+		// then
+		assert.Nil(t, err)
+		blocks := files["posts/post.md"].ParsedBlocks
+		fileBlocks := lo.Filter(blocks, func(item *model.Block, index int) bool {
+			return item.GetFile() != nil
+		})
+		assert.Len(t, fileBlocks, 1)
+		assert.Equal(t, filepath.Join("posts", "images", "cat.png"), fileBlocks[0].GetFile().Name)
+		codeBlocks := lo.Filter(blocks, func(item *model.Block, index int) bool {
+			return item.GetText().GetStyle() == model.BlockContentText_Code
+		})
+		assert.Len(t, codeBlocks, 1)
+		assert.Contains(t, codeBlocks[0].GetText().Text, "fmt.Println(\"{{ .Title }}\")")
+		assert.Equal(t, "go", pbtypes.GetString(codeBlocks[0].Fields, "lang"))
+		quoteBlocks := lo.Filter(blocks, func(item *model.Block, index int) bool {
+			return item.GetText().GetStyle() == model.BlockContentText_Quote
+		})
+		assert.Len(t, quoteBlocks, 1)
+		assert.Equal(t, "youtube w7Ft2ymGmfc", quoteBlocks[0].GetText().Text)
+	})
+
+	t.Run("shortcodes processing is off - shortcodes are imported as text", func(t *testing.T) {
+		// given
+		converter := newMDConverter(&MockTempDir{})
+		files := map[string]*FileInfo{"posts/post.md": {}}
+
+		// when
+		err := converter.createBlocksFromFile("posts/post.md", io.NopCloser(strings.NewReader(content)), files["posts/post.md"], false, false, converter2.DefaultParseLimits)
+
+		// then
+		assert.Nil(t, err)
+		fileBlocks := lo.Filter(files["posts/post.md"].ParsedBlocks, func(item *model.Block, index int) bool {
+			return item.GetFile() != nil
+		})
+		assert.Len(t, fileBlocks, 0)
+		assert.Contains(t, files["posts/post.md"].ParsedBlocks[0].GetText().Text, "figure")
+	})
+}
@@ -0,0 +1,115 @@
+package markdown
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const frontMatterDelimiter = "---"
+
+type frontMatterField struct {
+	key    string
+	values []string
+}
+
+// extractFrontMatter removes YAML front matter from the beginning of Markdown and returns its fields in order.
+// Content is returned as is, if front matter isn't closed or isn't valid YAML mapping
+func extractFrontMatter(content []byte) ([]byte, []frontMatterField) {
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	if len(lines) == 0 || strings.TrimSpace(string(lines[0])) != frontMatterDelimiter {
+		return content, nil
+	}
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(string(lines[i]))
+		if line == frontMatterDelimiter || line == "..." {
+			end = i
+			break
+		}
+	}
+	if end == -1 {
+		return content, nil
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(bytes.Join(lines[1:end], nil), &node); err != nil {
+		return content, nil
+	}
+	var fields []frontMatterField
+	if len(node.Content) > 0 {
+		mapping := node.Content[0]
+		if mapping.Kind != yaml.MappingNode {
+			return content, nil
+		}
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			values := scalarValues(mapping.Content[i+1])
+			if len(values) == 0 {
+				continue
+			}
+			fields = append(fields, frontMatterField{key: mapping.Content[i].Value, values: values})
+		}
+	}
+	return bytes.Join(lines[end+1:], nil), fields
+}
+
+func scalarValues(node *yaml.Node) []string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Value == "" || node.Tag == "!!null" {
+			return nil
+		}
+		return []string{node.Value}
+	case yaml.SequenceNode:
+		var values []string
+		for _, item := range node.Content {
+			values = append(values, scalarValues(item)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// applyFrontMatter sets values of bundled text relations, e.g. name and description, to details, and returns
+// tags and the rest of fields, which become inline field relations
+func applyFrontMatter(details *types.Struct, frontMatter []frontMatterField) (fields []inlineField, tags []string, relationLinks []*model.RelationLink) {
+	for _, field := range frontMatter {
+		if strings.EqualFold(field.key, bundle.RelationKeyTag.String()) || strings.EqualFold(field.key, "tags") {
+			tags = append(tags, field.values...)
+			continue
+		}
+		value := strings.Join(field.values, ", ")
+		if isBundledTextRelation(field.key) {
+			details.Fields[field.key] = pbtypes.String(value)
+			relationLinks = append(relationLinks, bundle.MustGetRelationLink(domain.RelationKey(field.key)))
+			continue
+		}
+		fields = append(fields, inlineField{key: field.key, value: value})
+	}
+	return fields, tags, relationLinks
+}
+
+func isBundledTextRelation(key string) bool {
+	if key == bundle.RelationKeyName.String() {
+		return true
+	}
+	if !bundle.HasRelation(key) {
+		return false
+	}
+	rel := bundle.MustGetRelation(domain.RelationKey(key))
+	if rel.Hidden || rel.ReadOnly {
+		return false
+	}
+	switch rel.Format {
+	case model.RelationFormat_shorttext, model.RelationFormat_longtext, model.RelationFormat_url,
+		model.RelationFormat_email, model.RelationFormat_phone:
+		return true
+	}
+	return false
+}
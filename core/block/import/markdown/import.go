@@ -30,10 +30,12 @@ var (
 )
 
 const numberOfStages = 9 // 8 cycles to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
 
 type Markdown struct {
 	blockConverter *mdConverter
 	service        *collection.Service
+	parseLimits    converter.ParseLimits
 }
 
 const (
@@ -42,7 +44,7 @@ const (
 )
 
 func New(tempDirProvider core.TempDirProvider, service *collection.Service) converter.Converter {
-	return &Markdown{blockConverter: newMDConverter(tempDirProvider), service: service}
+	return &Markdown{blockConverter: newMDConverter(tempDirProvider), service: service, parseLimits: converter.DefaultParseLimits}
 }
 
 func (m *Markdown) Name() string {
@@ -66,7 +68,7 @@ func (m *Markdown) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequ
 	if len(paths) == 0 {
 		return nil, nil
 	}
-	allErrors := converter.NewError(req.Mode)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	allSnapshots := m.processFiles(req, progress, paths, allErrors)
 	if allErrors.ShouldAbortImport(len(paths), req.Type) {
 		return nil, allErrors
@@ -117,12 +119,14 @@ func (m *Markdown) getSnapshots(req *pb.RpcObjectImportRequest,
 	progress process.Progress,
 	path string,
 	allErrors *converter.ConvertError) []*converter.Snapshot {
-	importSource := source.GetSource(path)
+	importSource := converter.SourceFilter(req).Wrap(source.GetSource(path))
 	if importSource == nil {
 		return nil
 	}
 	defer importSource.Close()
-	files := m.blockConverter.markdownToBlocks(path, importSource, allErrors)
+	params := req.GetMarkdownParams()
+	limits := converter.GetParseLimits(req, m.parseLimits)
+	files := m.blockConverter.markdownToBlocks(path, importSource, params.GetProcessShortcodes(), params.GetObsidian(), converter.Concurrency(req), limits, allErrors)
 	pathsCount := len(req.GetMarkdownParams().Path)
 	if allErrors.ShouldAbortImport(pathsCount, req.Type) {
 		return nil
@@ -140,8 +144,14 @@ func (m *Markdown) getSnapshots(req *pb.RpcObjectImportRequest,
 		m.processImportStep(pathsCount, files, progress, allErrors, details, m.addChildBlocks) {
 		return nil
 	}
+	for fileName, fileDetails := range details {
+		converter.SetFileTimes(fileDetails, importSource, fileName)
+	}
 
-	return m.createSnapshots(files, progress, details, allErrors)
+	if req.GetMarkdownParams().GetFolderTags() {
+		addFolderTags(path, files)
+	}
+	return m.createSnapshots(files, converter.ObjectTypeKey(req, defaultObjectType), progress, details, allErrors)
 }
 
 func (m *Markdown) processImportStep(pathCount int,
@@ -368,11 +378,14 @@ func (m *Markdown) addLinkBlocks(files map[string]*FileInfo, progress process.Pr
 }
 
 func (m *Markdown) createSnapshots(files map[string]*FileInfo,
+	objectType string,
 	progress process.Progress,
 	details map[string]*types.Struct,
 	allErrors *converter.ConvertError,
 ) []*converter.Snapshot {
 	snapshots := make([]*converter.Snapshot, 0)
+	relations := newInlineFieldRelations()
+	tags := newTagOptions(relations.relations)
 	progress.SetProgressMessage("Start creating snapshots")
 	for name, file := range files {
 		if err := progress.TryStep(1); err != nil {
@@ -385,19 +398,34 @@ func (m *Markdown) createSnapshots(files map[string]*FileInfo,
 			continue
 		}
 
+		var relationLinks []*model.RelationLink
+		if len(file.FrontMatter) != 0 && details[name] != nil {
+			fields, frontMatterTags, links := applyFrontMatter(details[name], file.FrontMatter)
+			relationLinks = append(links, relations.addToDetails(details[name], fields)...)
+			file.Tags = append(file.Tags, frontMatterTags...)
+		}
+		if len(file.InlineFields) != 0 && details[name] != nil {
+			relationLinks = append(relationLinks, relations.addToDetails(details[name], file.InlineFields)...)
+		}
+		if len(file.Tags) != 0 && details[name] != nil {
+			if tagLink := tags.addToDetails(details[name], file.Tags); tagLink != nil {
+				relationLinks = append(relationLinks, tagLink)
+			}
+		}
 		snapshots = append(snapshots, &converter.Snapshot{
 			Id:       file.PageID,
 			FileName: name,
 			SbType:   smartblock.SmartBlockTypePage,
 			Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
-				Blocks:      file.ParsedBlocks,
-				Details:     details[name],
-				ObjectTypes: []string{bundle.TypeKeyPage.String()},
+				Blocks:        file.ParsedBlocks,
+				Details:       details[name],
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{objectType},
 			}},
 		})
 	}
 
-	return snapshots
+	return append(snapshots, relations.snapshots...)
 }
 
 func (m *Markdown) addChildBlocks(files map[string]*FileInfo, progress process.Progress, _ map[string]*types.Struct, allErrors *converter.ConvertError) {
@@ -523,7 +551,7 @@ func (m *Markdown) setNewID(files map[string]*FileInfo, progress process.Progres
 			return
 		}
 
-		if strings.EqualFold(filepath.Ext(name), ".md") || strings.EqualFold(filepath.Ext(name), ".csv") {
+		if strings.EqualFold(filepath.Ext(name), ".md") || strings.EqualFold(filepath.Ext(name), ".csv") || file.IsCanvas {
 			file.PageID = uuid.New().String()
 
 			m.setDetails(file, name, details)
@@ -562,6 +590,9 @@ func (m *Markdown) extractTitleAndEmojiFromBlock(file *FileInfo) (string, string
 func (m *Markdown) getObjectIDs(snapshots []*converter.Snapshot) []string {
 	targetObject := make([]string, 0, len(snapshots))
 	for _, snapshot := range snapshots {
+		if snapshot.SbType == smartblock.SmartBlockTypeRelation || snapshot.SbType == smartblock.SmartBlockTypeRelationOption {
+			continue
+		}
 		targetObject = append(targetObject, snapshot.Id)
 	}
 	return targetObject
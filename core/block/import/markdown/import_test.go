@@ -0,0 +1,252 @@
+package markdown
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestMarkdown_GetSnapshots(t *testing.T) {
+	t.Run("inline fields are relations, dataview query is callout", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		content := "# Hobbit\n\n" +
+			"author:: Tolkien\n" +
+			"I rated it [rating:: 5] stars.\n\n" +
+			"```dataview\nTABLE author FROM #books\n```\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "hobbit.md"), []byte(content), 0600))
+		m := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := m.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Markdown, dir), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		page := convertertest.FindSnapshot(sn.Snapshots, "Hobbit")
+		author := convertertest.FindSnapshot(sn.Snapshots, "author")
+		rating := convertertest.FindSnapshot(sn.Snapshots, "rating")
+		for _, s := range []*converter.Snapshot{page, author, rating} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, smartblock.SmartBlockTypeRelation, author.SbType)
+		assert.Equal(t, "Tolkien", pbtypes.GetString(page.Snapshot.Data.Details, author.Snapshot.Data.Key))
+		assert.Equal(t, "5", pbtypes.GetString(page.Snapshot.Data.Details, rating.Snapshot.Data.Key))
+		assert.Len(t, page.Snapshot.Data.RelationLinks, 2)
+
+		var texts []string
+		var callout *model.BlockContentText
+		for _, block := range page.Snapshot.Data.Blocks {
+			text := block.GetText()
+			if text == nil {
+				continue
+			}
+			assert.NotEqual(t, model.BlockContentText_Code, text.Style)
+			if text.Style == model.BlockContentText_Callout {
+				callout = text
+			}
+			texts = append(texts, text.Text)
+		}
+		assert.NotContains(t, strings.Join(texts, "\n"), "::")
+		assert.Contains(t, texts, "I rated it rating: 5 stars.")
+		require.NotNil(t, callout)
+		assert.Contains(t, callout.Text, "TABLE author FROM #books")
+	})
+	t.Run("folders and inline hashtags are tags", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		notesDir := filepath.Join(dir, "work", "projects")
+		require.NoError(t, os.MkdirAll(notesDir, 0700))
+		content := "# Roadmap\n\nDraft for #Work and #idea, see issue #12.\n\n```\n#notatag\n```\n"
+		require.NoError(t, os.WriteFile(filepath.Join(notesDir, "roadmap.md"), []byte(content), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "inbox.md"), []byte("# Inbox\n\nCall #idea\n"), 0600))
+		m := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		req := convertertest.NewRequest(pb.RpcObjectImportRequest_Markdown, dir)
+		req.GetMarkdownParams().FolderTags = true
+
+		// when
+		sn, err := m.GetSnapshots(context.Background(), req, p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		roadmap := convertertest.FindSnapshot(sn.Snapshots, "Roadmap")
+		inbox := convertertest.FindSnapshot(sn.Snapshots, "Inbox")
+		require.NotNil(t, roadmap)
+		require.NotNil(t, inbox)
+		assert.Equal(t, []string{"work", "projects", "idea"}, getTagNames(sn.Snapshots, roadmap))
+		assert.Equal(t, []string{"idea"}, getTagNames(sn.Snapshots, inbox))
+		assert.Contains(t, roadmap.Snapshot.Data.RelationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "notatag"))
+	})
+	t.Run("folders are not tags by default", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		notesDir := filepath.Join(dir, "work")
+		require.NoError(t, os.MkdirAll(notesDir, 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(notesDir, "roadmap.md"), []byte("# Roadmap\n\n#idea\n"), 0600))
+		m := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := m.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Markdown, dir), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		roadmap := convertertest.FindSnapshot(sn.Snapshots, "Roadmap")
+		require.NotNil(t, roadmap)
+		assert.Equal(t, []string{"idea"}, getTagNames(sn.Snapshots, roadmap))
+	})
+	t.Run("front matter is name, tags and relations", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		content := "---\n" +
+			"name: The Hobbit\n" +
+			"description: There and back again\n" +
+			"tag:\n  - books\n  - fantasy\n" +
+			"author: Tolkien\n" +
+			"---\n\n" +
+			"In a hole in the ground\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "hobbit.md"), []byte(content), 0600))
+		m := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := m.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Markdown, dir), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		page := convertertest.FindSnapshot(sn.Snapshots, "The Hobbit")
+		author := convertertest.FindSnapshot(sn.Snapshots, "author")
+		require.NotNil(t, page)
+		require.NotNil(t, author)
+		details := page.Snapshot.Data.Details
+		assert.Equal(t, "There and back again", pbtypes.GetString(details, bundle.RelationKeyDescription.String()))
+		assert.Equal(t, "Tolkien", pbtypes.GetString(details, author.Snapshot.Data.Key))
+		assert.Equal(t, []string{"books", "fantasy"}, getTagNames(sn.Snapshots, page))
+		for _, block := range page.Snapshot.Data.Blocks {
+			assert.NotContains(t, block.GetText().GetText(), "Tolkien")
+		}
+	})
+	t.Run("Obsidian wikilinks, embeds and canvas are resolved", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "notes"), 0700))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "attachments"), 0700))
+		project := "---\naliases: [Proj]\n---\n# Project\n\nPlans\n"
+		inbox := "# Inbox\n\nSee [[Project|the project]], [[Proj]] and [[Missing]]\n\n![[Project]]\n\n![[diagram.png]]\n\nKeep `[[code]]`\n"
+		board := `{"nodes": [
+			{"id": "2", "type": "link", "url": "https://anytype.io", "x": 0, "y": 200},
+			{"id": "1", "type": "file", "file": "notes/Project.md", "x": 0, "y": 100},
+			{"id": "3", "type": "text", "text": "Card for [[Inbox]]", "x": 0, "y": 0}
+		]}`
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes", "Project.md"), []byte(project), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "Inbox.md"), []byte(inbox), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "attachments", "diagram.png"), []byte("png"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "Board.canvas"), []byte(board), 0600))
+		m := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		req := convertertest.NewRequest(pb.RpcObjectImportRequest_Markdown, dir)
+		req.GetMarkdownParams().Obsidian = true
+
+		// when
+		sn, err := m.GetSnapshots(context.Background(), req, p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		projectPage := convertertest.FindSnapshot(sn.Snapshots, "Project")
+		inboxPage := convertertest.FindSnapshot(sn.Snapshots, "Inbox")
+		boardPage := convertertest.FindSnapshot(sn.Snapshots, "Board")
+		for _, s := range []*converter.Snapshot{projectPage, inboxPage, boardPage} {
+			require.NotNil(t, s)
+		}
+
+		var (
+			mentions []string
+			texts    []string
+			links    []string
+			images   []string
+		)
+		for _, block := range inboxPage.Snapshot.Data.Blocks {
+			if text := block.GetText(); text != nil {
+				texts = append(texts, text.Text)
+				for _, mark := range text.GetMarks().GetMarks() {
+					assert.NotEqual(t, model.BlockContentTextMark_Link, mark.Type)
+					if mark.Type == model.BlockContentTextMark_Mention {
+						mentions = append(mentions, mark.Param)
+					}
+				}
+			}
+			if link := block.GetLink(); link != nil {
+				links = append(links, link.TargetBlockId)
+			}
+			if file := block.GetFile(); file != nil && file.Type == model.BlockContentFile_Image {
+				images = append(images, filepath.Base(file.Name))
+			}
+		}
+		assert.Equal(t, []string{projectPage.Id, projectPage.Id}, mentions)
+		assert.Contains(t, texts, "See the project, Proj and Missing")
+		assert.Contains(t, texts, "Keep [[code]]")
+		assert.Contains(t, links, projectPage.Id)
+		assert.Equal(t, []string{"diagram.png"}, images)
+
+		var (
+			boardLinks     []string
+			bookmarks      []string
+			boardMentions  []string
+			firstBlockText string
+		)
+		for _, block := range boardPage.Snapshot.Data.Blocks {
+			if text := block.GetText(); text != nil && firstBlockText == "" {
+				firstBlockText = text.Text
+				for _, mark := range text.GetMarks().GetMarks() {
+					boardMentions = append(boardMentions, mark.Param)
+				}
+			}
+			if link := block.GetLink(); link != nil {
+				boardLinks = append(boardLinks, link.TargetBlockId)
+			}
+			if bookmark := block.GetBookmark(); bookmark != nil {
+				bookmarks = append(bookmarks, bookmark.Url)
+			}
+		}
+		assert.Equal(t, "Card for Inbox", firstBlockText)
+		assert.Equal(t, []string{inboxPage.Id}, boardMentions)
+		assert.Contains(t, boardLinks, projectPage.Id)
+		assert.Equal(t, []string{"https://anytype.io"}, bookmarks)
+	})
+}
+
+func getTagNames(snapshots []*converter.Snapshot, page *converter.Snapshot) []string {
+	var names []string
+	for _, id := range pbtypes.GetStringList(page.Snapshot.Data.Details, bundle.RelationKeyTag.String()) {
+		for _, sn := range snapshots {
+			if sn.Id == id && sn.SbType == smartblock.SmartBlockTypeRelationOption {
+				names = append(names, pbtypes.GetString(sn.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,136 @@
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const dataviewQueryPrefix = "Dataview query can't be imported:\n"
+
+var (
+	// lineFieldRegexp matches Obsidian Dataview inline field, which takes the whole line: key:: value.
+	// Separator should be followed by space, so text like std::vector is not a field
+	lineFieldRegexp = regexp.MustCompile(`^\s*(?:[-*+]\s+)?([\p{L}\p{N}_][\p{L}\p{N}_ -]*?)::(?:[ \t]+|$)(.*?)\s*$`)
+	// bracketFieldRegexp matches inline fields inside the text: [key:: value] is shown with key, (key:: value) without
+	bracketFieldRegexp = regexp.MustCompile(`([\[(])([\p{L}\p{N}_][\p{L}\p{N}_ -]*?)::[ \t]*([^\[\]()]*?)\s*[\])]`)
+
+	dataviewLanguages = []string{"dataview", "dataviewjs"}
+)
+
+type inlineField struct {
+	key   string
+	value string
+}
+
+// extractInlineFields removes Dataview inline fields from Markdown and returns them in order of appearance.
+// Fields inside code blocks are kept as is
+func extractInlineFields(content []byte) ([]byte, []inlineField) {
+	var (
+		fields    []inlineField
+		result    bytes.Buffer
+		codeFence string
+	)
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(string(line))
+		if fence := codeFenceOf(trimmed); fence != "" {
+			switch {
+			case codeFence == "":
+				codeFence = fence
+			case strings.HasPrefix(trimmed, codeFence) && strings.TrimLeft(trimmed, codeFence[:1]) == "":
+				codeFence = ""
+			}
+		}
+		if codeFence != "" {
+			result.Write(line)
+			continue
+		}
+		if match := lineFieldRegexp.FindStringSubmatch(strings.TrimRight(string(line), "\r\n")); match != nil {
+			fields = append(fields, inlineField{key: strings.TrimSpace(match[1]), value: match[2]})
+			continue
+		}
+		text := bracketFieldRegexp.ReplaceAllStringFunc(string(line), func(s string) string {
+			match := bracketFieldRegexp.FindStringSubmatch(s)
+			field := inlineField{key: strings.TrimSpace(match[2]), value: match[3]}
+			fields = append(fields, field)
+			if match[1] == "(" {
+				return field.value
+			}
+			return field.key + ": " + field.value
+		})
+		result.WriteString(text)
+	}
+	return result.Bytes(), fields
+}
+
+func codeFenceOf(line string) string {
+	for _, fence := range []string{"```", "~~~"} {
+		if strings.HasPrefix(line, fence) {
+			return line[:len(line)-len(strings.TrimLeft(line, fence[:1]))]
+		}
+	}
+	return ""
+}
+
+// replaceDataviewQueries replaces code blocks with Dataview queries by callouts, because queries can't be evaluated
+func replaceDataviewQueries(blocks []*model.Block) {
+	for _, b := range blocks {
+		text := b.GetText()
+		if text == nil || text.Style != model.BlockContentText_Code {
+			continue
+		}
+		lang := pbtypes.GetString(b.Fields, "lang")
+		if !isDataviewLanguage(lang) {
+			continue
+		}
+		text.Style = model.BlockContentText_Callout
+		text.Text = dataviewQueryPrefix + text.Text
+		text.Marks = nil
+		b.Fields = nil
+	}
+}
+
+func isDataviewLanguage(lang string) bool {
+	for _, l := range dataviewLanguages {
+		if strings.EqualFold(lang, l) {
+			return true
+		}
+	}
+	return false
+}
+
+// inlineFieldRelations creates relations for keys of inline fields, so the same key is shared between all files
+type inlineFieldRelations struct {
+	relations *converter.RelationCreator
+	snapshots []*converter.Snapshot
+}
+
+func newInlineFieldRelations() *inlineFieldRelations {
+	r := &inlineFieldRelations{}
+	r.relations = converter.NewRelationCreator(&r.snapshots)
+	return r
+}
+
+// addToDetails sets values of fields to details and returns links to relations of fields.
+// Values of repeated key are joined
+func (r *inlineFieldRelations) addToDetails(details *types.Struct, fields []inlineField) []*model.RelationLink {
+	relationLinks := make([]*model.RelationLink, 0, len(fields))
+	for _, field := range fields {
+		relation := r.relations.RelationByID(strings.ToLower(field.key), field.key, model.RelationFormat_shorttext)
+		value := field.value
+		if current, ok := details.Fields[relation.Key]; ok {
+			value = current.GetStringValue() + ", " + value
+		} else {
+			relationLinks = append(relationLinks, relation)
+		}
+		details.Fields[relation.Key] = pbtypes.String(value)
+	}
+	return relationLinks
+}
@@ -0,0 +1,309 @@
+package markdown
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	// wikilinkPrefix marks destination of Markdown link, which was converted from wikilink. Target of wikilink
+	// is encoded, so it isn't changed when anymark joins destination with directory of the file
+	wikilinkPrefix  = "obsidian-wikilink-"
+	canvasExtension = ".canvas"
+
+	canvasNodeText  = "text"
+	canvasNodeFile  = "file"
+	canvasNodeLink  = "link"
+	canvasNodeGroup = "group"
+)
+
+// wikilinkRegexp matches Obsidian links [[target|alias]] and embeds ![[target]]
+var wikilinkRegexp = regexp.MustCompile(`(!?)\[\[([^\[\]\n]+?)\]\]`)
+
+// aliasKeys are keys of front matter, which contain alternative names of the note
+var aliasKeys = []string{"aliases", "alias"}
+
+// convertWikilinks replaces wikilinks and embeds outside of code with Markdown links and images, which are
+// resolved to files by resolveWikilinks after all files of the vault are read
+func convertWikilinks(content []byte) []byte {
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	var inFence bool
+	for i, line := range lines {
+		if trimmed := bytes.TrimSpace(line); bytes.HasPrefix(trimmed, []byte("```")) || bytes.HasPrefix(trimmed, []byte("~~~")) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		// odd parts are inline code
+		parts := bytes.Split(line, []byte("`"))
+		for j := 0; j < len(parts); j += 2 {
+			parts[j] = wikilinkRegexp.ReplaceAllFunc(parts[j], convertWikilink)
+		}
+		lines[i] = bytes.Join(parts, []byte("`"))
+	}
+	return bytes.Join(lines, nil)
+}
+
+func convertWikilink(match []byte) []byte {
+	groups := wikilinkRegexp.FindSubmatch(match)
+	embed := len(groups[1]) != 0
+	target, alias, _ := strings.Cut(string(groups[2]), "|")
+	// pipe is escaped inside of tables
+	target = strings.TrimSpace(strings.TrimSuffix(target, `\`))
+	if target == "" {
+		return match
+	}
+	text := alias
+	if text == "" || embed {
+		note, heading, _ := strings.Cut(target, "#")
+		text = strings.TrimSuffix(note, ".md")
+		if heading != "" {
+			text += " > " + heading
+		}
+	}
+	destination := wikilinkPrefix + base64.RawURLEncoding.EncodeToString([]byte(target))
+	var result string
+	if embed {
+		result = "!"
+	}
+	result += "[" + escapeLinkText(text) + "](" + destination + ")"
+	return []byte(result)
+}
+
+func escapeLinkText(text string) string {
+	return strings.NewReplacer(`[`, `\[`, `]`, `\]`).Replace(text)
+}
+
+// wikilinkTarget returns target of wikilink from path, which anymark has made from destination of the link
+func wikilinkTarget(path string) (string, bool) {
+	name := filepath.Base(path)
+	if !strings.HasPrefix(name, wikilinkPrefix) {
+		return "", false
+	}
+	target, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(name, wikilinkPrefix))
+	if err != nil {
+		return "", false
+	}
+	return string(target), true
+}
+
+// vaultIndex resolves targets of wikilinks to files like Obsidian does: by path from the root of the vault,
+// by path relative to the note, by name of the file and by aliases of notes
+type vaultIndex struct {
+	paths   map[string]string
+	names   map[string][]string
+	aliases map[string]string
+}
+
+func newVaultIndex(files map[string]*FileInfo) *vaultIndex {
+	index := &vaultIndex{
+		paths:   make(map[string]string, len(files)),
+		names:   make(map[string][]string, len(files)),
+		aliases: map[string]string{},
+	}
+	for name, file := range files {
+		path := strings.ToLower(filepath.ToSlash(name))
+		index.paths[path] = name
+		base := strings.ToLower(filepath.Base(name))
+		index.names[base] = append(index.names[base], name)
+		for _, field := range file.FrontMatter {
+			if !isAliasKey(field.key) {
+				continue
+			}
+			for _, alias := range field.values {
+				index.aliases[strings.ToLower(alias)] = name
+			}
+		}
+	}
+	// the shortest path wins, if several files have the same name
+	for _, names := range index.names {
+		sort.Slice(names, func(i, j int) bool {
+			if len(names[i]) != len(names[j]) {
+				return len(names[i]) < len(names[j])
+			}
+			return names[i] < names[j]
+		})
+	}
+	return index
+}
+
+func isAliasKey(key string) bool {
+	for _, aliasKey := range aliasKeys {
+		if strings.EqualFold(key, aliasKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns name of the file, which is the target of wikilink from the file in dir
+func (v *vaultIndex) resolve(target, dir string) (string, bool) {
+	note, _, _ := strings.Cut(target, "#")
+	note = strings.TrimSpace(filepath.ToSlash(note))
+	if note == "" {
+		return "", false
+	}
+	candidates := []string{note}
+	if filepath.Ext(note) == "" {
+		candidates = []string{note + ".md", note}
+	}
+	for _, candidate := range candidates {
+		if name, ok := v.paths[strings.ToLower(candidate)]; ok {
+			return name, true
+		}
+		if name, ok := v.paths[strings.ToLower(filepath.ToSlash(filepath.Join(dir, candidate)))]; ok {
+			return name, true
+		}
+		if names := v.names[strings.ToLower(filepath.Base(candidate))]; len(names) != 0 {
+			return names[0], true
+		}
+	}
+	if name, ok := v.aliases[strings.ToLower(note)]; ok {
+		return name, true
+	}
+	return "", false
+}
+
+// resolveWikilinks replaces targets of wikilinks with names of files. Links to notes become mentions,
+// embedded notes become link blocks and embedded attachments become file blocks. Unresolved links are kept as text
+func resolveWikilinks(files map[string]*FileInfo) {
+	index := newVaultIndex(files)
+	for name, file := range files {
+		dir := filepath.Dir(name)
+		for _, block := range file.ParsedBlocks {
+			if f := block.GetFile(); f != nil {
+				if target, ok := wikilinkTarget(f.Name); ok {
+					index.resolveEmbed(block, target, dir)
+				}
+				continue
+			}
+			text := block.GetText()
+			if text == nil || text.Marks == nil {
+				continue
+			}
+			marks := text.Marks.Marks[:0]
+			for _, mark := range text.Marks.Marks {
+				target, ok := wikilinkTarget(mark.Param)
+				if mark.Type != model.BlockContentTextMark_Link || !ok {
+					marks = append(marks, mark)
+					continue
+				}
+				targetName, ok := index.resolve(target, dir)
+				if !ok {
+					continue
+				}
+				mark.Param = targetName
+				if strings.EqualFold(filepath.Ext(targetName), ".md") {
+					mark.Type = model.BlockContentTextMark_Mention
+					files[targetName].HasInboundLinks = true
+				}
+				marks = append(marks, mark)
+			}
+			text.Marks.Marks = marks
+		}
+	}
+}
+
+func (v *vaultIndex) resolveEmbed(block *model.Block, target, dir string) {
+	targetName, ok := v.resolve(target, dir)
+	if !ok {
+		block.Content = &model.BlockContentOfText{Text: &model.BlockContentText{Text: "![[" + target + "]]"}}
+		return
+	}
+	if strings.EqualFold(filepath.Ext(targetName), ".md") {
+		block.Content = &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: targetName,
+			Style:         model.BlockContentLink_Page,
+		}}
+		return
+	}
+	// file type is detected by extension the same way as for Markdown links to files
+	block.Content = &model.BlockContentOfText{Text: &model.BlockContentText{
+		Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+			Range: &model.Range{},
+			Type:  model.BlockContentTextMark_Link,
+			Param: targetName,
+		}}},
+	}}
+	anymark.ConvertTextToFile(block)
+}
+
+// canvas is JSON Canvas file of Obsidian. Edges between nodes are not imported
+type canvas struct {
+	Nodes []*canvasNode `json:"nodes"`
+}
+
+type canvasNode struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	File  string `json:"file"`
+	URL   string `json:"url"`
+	Label string `json:"label"`
+	X     int    `json:"x"`
+	Y     int    `json:"y"`
+}
+
+// canvasToBlocks converts nodes of canvas to blocks from top to bottom: text cards become blocks of their Markdown,
+// notes become link blocks, other files become file blocks, web pages become bookmarks and groups become headers
+func canvasToBlocks(content []byte, dir string) ([]*model.Block, error) {
+	var c canvas
+	if err := json.Unmarshal(content, &c); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(c.Nodes, func(i, j int) bool {
+		if c.Nodes[i].Y != c.Nodes[j].Y {
+			return c.Nodes[i].Y < c.Nodes[j].Y
+		}
+		return c.Nodes[i].X < c.Nodes[j].X
+	})
+	var blocks []*model.Block
+	for _, node := range c.Nodes {
+		switch node.Type {
+		case canvasNodeText:
+			textBlocks, _, err := anymark.MarkdownToBlocks(convertWikilinks([]byte(node.Text)), dir, nil)
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, textBlocks...)
+		case canvasNodeFile:
+			if node.File == "" {
+				continue
+			}
+			// paths of files are relative to the root of the vault
+			target := wikilinkPrefix + base64.RawURLEncoding.EncodeToString([]byte(node.File))
+			blocks = append(blocks, &model.Block{
+				Id:      bson.NewObjectId().Hex(),
+				Content: &model.BlockContentOfFile{File: &model.BlockContentFile{Name: target}},
+			})
+		case canvasNodeLink:
+			blocks = append(blocks, &model.Block{
+				Id:      bson.NewObjectId().Hex(),
+				Content: &model.BlockContentOfBookmark{Bookmark: &model.BlockContentBookmark{Url: node.URL}},
+			})
+		case canvasNodeGroup:
+			if node.Label == "" {
+				continue
+			}
+			blocks = append(blocks, &model.Block{
+				Id: bson.NewObjectId().Hex(),
+				Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+					Text:  node.Label,
+					Style: model.BlockContentText_Header3,
+				}},
+			})
+		}
+	}
+	return blocks, nil
+}
@@ -0,0 +1,182 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	shortcodeFigure       = "figure"
+	shortcodeHighlight    = "highlight"
+	shortcodeEndHighlight = "endhighlight"
+	shortcodeRaw          = "raw"
+	shortcodeEndRaw       = "endraw"
+)
+
+// shortcodeRegexp matches Hugo shortcodes {{< name args >}}, {{% name args %}} and Jekyll tags {% name args %}
+var shortcodeRegexp = regexp.MustCompile(`\{\{[<%]-?\s*(/?)([\w.-]+)((?:[^}"]|"[^"]*")*?)\s*-?[>%]\}\}|\{%-?\s*(/?)([\w.-]+)((?:[^%"]|"[^"]*")*?)\s*-?%\}`)
+
+// shortcodeArgRegexp matches named arguments key="value" and positional arguments of shortcode
+var shortcodeArgRegexp = regexp.MustCompile(`([\w-]+)=("[^"]*"|'[^']*'|\S+)|("[^"]*"|'[^']*'|\S+)`)
+
+type shortcode struct {
+	name       string
+	closing    bool
+	positional []string
+	named      map[string]string
+}
+
+func parseShortcode(content []byte, loc []int) *shortcode {
+	closingIdx, nameIdx, argsIdx := 2, 4, 6
+	if loc[nameIdx] == -1 {
+		closingIdx, nameIdx, argsIdx = 8, 10, 12
+	}
+	sc := &shortcode{
+		name:    string(content[loc[nameIdx]:loc[nameIdx+1]]),
+		closing: loc[closingIdx] != loc[closingIdx+1],
+		named:   map[string]string{},
+	}
+	args := string(content[loc[argsIdx]:loc[argsIdx+1]])
+	for _, match := range shortcodeArgRegexp.FindAllStringSubmatch(args, -1) {
+		if match[1] != "" {
+			sc.named[match[1]] = unquote(match[2])
+			continue
+		}
+		sc.positional = append(sc.positional, unquote(match[3]))
+	}
+	return sc
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func (s *shortcode) isHighlightStart() bool {
+	return s.name == shortcodeHighlight && !s.closing
+}
+
+func (s *shortcode) isHighlightEnd() bool {
+	return s.name == shortcodeEndHighlight || (s.name == shortcodeHighlight && s.closing)
+}
+
+// isClosing reports whether shortcode closes paired shortcode, like {{< /name >}} or {% endname %}
+func (s *shortcode) isClosing() bool {
+	return s.closing || strings.HasPrefix(s.name, "end")
+}
+
+// convertShortcodes replaces Hugo and Jekyll shortcodes with Markdown, which is converted to blocks:
+// figures become images (and then file blocks), highlight becomes fenced code block with language
+// and unknown shortcodes become quotes, so their content is kept without template braces
+func convertShortcodes(content []byte) []byte {
+	var (
+		result      bytes.Buffer
+		last        int
+		inHighlight bool
+	)
+	for _, loc := range shortcodeRegexp.FindAllSubmatchIndex(content, -1) {
+		sc := parseShortcode(content, loc)
+		if inHighlight && !sc.isHighlightEnd() {
+			// code inside highlight is kept as is
+			continue
+		}
+		result.Write(content[last:loc[0]])
+		last = loc[1]
+		switch {
+		case sc.isHighlightStart():
+			inHighlight = true
+			result.WriteString(codeFenceStart(sc, content, loc[1]))
+		case sc.isHighlightEnd():
+			inHighlight = false
+			if !endsWithNewLine(result.Bytes()) {
+				result.WriteString("\n")
+			}
+			result.WriteString("```")
+		case sc.name == shortcodeFigure:
+			result.WriteString(figureToImage(sc))
+		case sc.name == shortcodeRaw, sc.name == shortcodeEndRaw, sc.isClosing():
+			// content of paired shortcode is kept, only tags are removed
+		default:
+			result.WriteString(shortcodeToNote(sc, isWholeLine(content, loc[0], loc[1])))
+		}
+	}
+	result.Write(content[last:])
+	return result.Bytes()
+}
+
+func codeFenceStart(sc *shortcode, content []byte, end int) string {
+	var lang string
+	if len(sc.positional) > 0 {
+		lang = sc.positional[0]
+	} else {
+		lang = sc.named["lang"]
+	}
+	fence := "```" + lang
+	if end < len(content) && content[end] != '\n' && content[end] != '\r' {
+		fence += "\n"
+	}
+	return fence
+}
+
+func figureToImage(sc *shortcode) string {
+	src := sc.named["src"]
+	if src == "" && len(sc.positional) > 0 {
+		src = sc.positional[0]
+	}
+	if src == "" {
+		return ""
+	}
+	if strings.ContainsAny(src, " ()") {
+		src = "<" + src + ">"
+	}
+	var title string
+	for _, key := range []string{"alt", "caption", "title"} {
+		if title = sc.named[key]; title != "" {
+			break
+		}
+	}
+	return fmt.Sprintf("![%s](%s)", title, src)
+}
+
+func shortcodeToNote(sc *shortcode, wholeLine bool) string {
+	parts := []string{sc.name}
+	parts = append(parts, sc.positional...)
+	for _, key := range sortedKeys(sc.named) {
+		parts = append(parts, fmt.Sprintf("%s: %s", key, sc.named[key]))
+	}
+	note := strings.Join(parts, " ")
+	if wholeLine {
+		return "> " + note
+	}
+	return "_" + note + "_"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func endsWithNewLine(b []byte) bool {
+	return len(b) == 0 || b[len(b)-1] == '\n'
+}
+
+// isWholeLine reports whether there is no other text on the line with the shortcode
+func isWholeLine(content []byte, start, end int) bool {
+	lineStart := bytes.LastIndexByte(content[:start], '\n') + 1
+	lineEnd := bytes.IndexByte(content[end:], '\n')
+	if lineEnd == -1 {
+		lineEnd = len(content)
+	} else {
+		lineEnd += end
+	}
+	return len(bytes.TrimSpace(content[lineStart:start])) == 0 && len(bytes.TrimSpace(content[end:lineEnd])) == 0
+}
@@ -0,0 +1,97 @@
+package markdown
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// hashtagRegexp matches #tag preceded by start of the text or space. Tag should start with letter,
+// so issue numbers like #12 are not tags
+var hashtagRegexp = regexp.MustCompile(`(?:^|\s)#(\p{L}[\p{L}\p{N}_-]*)`)
+
+// extractHashtags returns names of inline #hashtags from text blocks. Code blocks are skipped
+func extractHashtags(blocks []*model.Block) []string {
+	var tags []string
+	for _, b := range blocks {
+		text := b.GetText()
+		if text == nil || text.Style == model.BlockContentText_Code {
+			continue
+		}
+		for _, match := range hashtagRegexp.FindAllStringSubmatch(text.Text, -1) {
+			tags = append(tags, match[1])
+		}
+	}
+	return tags
+}
+
+// addFolderTags prepends names of folders, which contain the file inside the import path, to tags of the file,
+// e.g. work/projects/note.md gets tags work and projects
+func addFolderTags(importPath string, files map[string]*FileInfo) {
+	for name, file := range files {
+		file.Tags = append(getFolderTags(importPath, name), file.Tags...)
+	}
+}
+
+func getFolderTags(importPath, fileName string) []string {
+	// files of directory have absolute paths, while files of archive have paths relative to its root
+	if relPath, err := filepath.Rel(importPath, fileName); err == nil {
+		fileName = relPath
+	}
+	var tags []string
+	for _, folder := range strings.Split(filepath.ToSlash(filepath.Dir(fileName)), "/") {
+		if folder == "" || folder == "." || folder == ".." {
+			continue
+		}
+		tags = append(tags, folder)
+	}
+	return tags
+}
+
+// tagOptions sets relation options of tags to files, so the same tag is shared between files.
+// Tags are compared case-insensitively and the first met spelling is used as the name of the option
+type tagOptions struct {
+	relations *converter.RelationCreator
+	// names maps lower-cased tag to its first met spelling
+	names map[string]string
+}
+
+func newTagOptions(relations *converter.RelationCreator) *tagOptions {
+	return &tagOptions{relations: relations, names: map[string]string{}}
+}
+
+// addToDetails sets de-duplicated tags to details and returns link to the tag relation, if there are any tags
+func (t *tagOptions) addToDetails(details *types.Struct, names []string) *model.RelationLink {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		spelling, ok := t.names[strings.ToLower(name)]
+		if !ok {
+			spelling = name
+			t.names[strings.ToLower(name)] = name
+		}
+		id := t.relations.OptionID(bundle.RelationKeyTag.String(), spelling)
+		if id == "" || lo.Contains(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(ids)
+	return &model.RelationLink{
+		Key:    bundle.RelationKeyTag.String(),
+		Format: model.RelationFormat_tag,
+	}
+}
@@ -0,0 +1,274 @@
+package nextcloud
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Nextcloud"
+	rootCollectionName = "Nextcloud Notes Import"
+)
+
+var log = logging.Logger("import-nextcloud")
+
+// notesExtensions are the formats Nextcloud Notes app can store note in
+var notesExtensions = []string{".md", ".txt"}
+
+type Nextcloud struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &Nextcloud{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (n *Nextcloud) Name() string {
+	return Name
+}
+
+func (n *Nextcloud) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetNextcloudParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (n *Nextcloud) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := n.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from notes")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := n.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(n.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (n *Nextcloud) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, n.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := n.handleImportPath(p, converter.SourceFilter(req), converter.ObjectTypeKey(req, defaultObjectType), len(paths), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of notes and categories and list of objects,
+// that should be added to the root collection: top-level categories and notes without category
+func (n *Nextcloud) handleImportPath(importPath string,
+	filter *source.Filter,
+	objectType string,
+	pathsCount int,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.NewDirectory())
+	defer importSource.Close()
+	err := importSource.Initialize(importPath)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Nextcloud) {
+			return nil, nil
+		}
+	}
+	var numberOfFiles int
+	if numberOfFiles = importSource.CountFilesWithGivenExtensions(notesExtensions); numberOfFiles == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	snapshots := make([]*converter.Snapshot, 0, numberOfFiles)
+	notesByCategory := make(map[string][]string, 0)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		category, ok := getCategory(importPath, fileName)
+		if !ok {
+			return true
+		}
+		sn, err := n.getNoteSnapshot(fileName, objectType, fileReader, limits)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Nextcloud) {
+				return false
+			}
+			return true
+		}
+		snapshots = append(snapshots, sn)
+		notesByCategory[category] = append(notesByCategory[category], sn.Id)
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	categories, rootObjects, err := n.makeCategoryCollections(notesByCategory)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Nextcloud) {
+			return nil, nil
+		}
+	}
+	return append(snapshots, categories...), rootObjects
+}
+
+// getCategory returns category of the note, which Nextcloud Notes keeps as a relative path of note's directory.
+// Files in hidden directories (e.g. .attachments.{id}) and non-note files are skipped
+func getCategory(importPath, fileName string) (string, bool) {
+	if !isNote(fileName) {
+		return "", false
+	}
+	relPath, err := filepath.Rel(importPath, fileName)
+	if err != nil {
+		return "", false
+	}
+	category := filepath.ToSlash(filepath.Dir(relPath))
+	if category == "." {
+		return "", true
+	}
+	for _, part := range strings.Split(category, "/") {
+		if strings.HasPrefix(part, ".") {
+			return "", false
+		}
+	}
+	return category, true
+}
+
+func isNote(fileName string) bool {
+	ext := filepath.Ext(fileName)
+	for _, noteExt := range notesExtensions {
+		if strings.EqualFold(ext, noteExt) {
+			return true
+		}
+	}
+	return false
+}
+
+// makeCategoryCollections creates collection for each category. Nested category collection is added to the collection of
+// parent category, so the folder structure of notes is preserved
+func (n *Nextcloud) makeCategoryCollections(notesByCategory map[string][]string) ([]*converter.Snapshot, []string, error) {
+	categoryObjects := make(map[string][]string, len(notesByCategory))
+	for category, notes := range notesByCategory {
+		categoryObjects[category] = append(categoryObjects[category], notes...)
+		// parent categories without notes should also have their collections
+		for parent := parentCategory(category); parent != ""; parent = parentCategory(parent) {
+			if _, ok := categoryObjects[parent]; !ok {
+				categoryObjects[parent] = []string{}
+			}
+		}
+	}
+	categories := make([]string, 0, len(categoryObjects))
+	for category := range categoryObjects {
+		if category != "" {
+			categories = append(categories, category)
+		}
+	}
+	// deepest categories go first, so their collections are ready before parent collections are created
+	sort.Slice(categories, func(i, j int) bool {
+		di, dj := strings.Count(categories[i], "/"), strings.Count(categories[j], "/")
+		if di != dj {
+			return di > dj
+		}
+		return categories[i] < categories[j]
+	})
+	rootCollection := converter.NewRootCollection(n.service)
+	snapshots := make([]*converter.Snapshot, 0, len(categories))
+	for _, category := range categories {
+		sn, err := rootCollection.MakeCollection(category, categoryObjects[category])
+		if err != nil {
+			return nil, nil, err
+		}
+		snapshots = append(snapshots, sn)
+		parent := parentCategory(category)
+		categoryObjects[parent] = append(categoryObjects[parent], sn.Id)
+	}
+	return snapshots, categoryObjects[""], nil
+}
+
+func parentCategory(category string) string {
+	if idx := strings.LastIndex(category, "/"); idx != -1 {
+		return category[:idx]
+	}
+	return ""
+}
+
+func (n *Nextcloud) getNoteSnapshot(fileName, objectType string, rc io.ReadCloser, limits converter.ParseLimits) (*converter.Snapshot, error) {
+	b, err := limits.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	blocks, _, err := anymark.MarkdownToBlocks(b, filepath.Dir(fileName), nil)
+	if err != nil {
+		return nil, err
+	}
+	details := converter.GetCommonDetails(fileName, "", "", model.ObjectType_basic)
+	if isFavorite(fileName) {
+		details.Fields[bundle.RelationKeyIsFavorite.String()] = pbtypes.Bool(true)
+	}
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      blocks,
+		Details:     details,
+		ObjectTypes: []string{objectType},
+	}
+	return &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package nextcloud
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestNextcloud_GetSnapshots(t *testing.T) {
+	t.Run("categories are converted to nested collections", func(t *testing.T) {
+		// given
+		n := &Nextcloud{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := n.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Nextcloud, "testdata/notes"), p)
+
+		// then
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		assert.Len(t, sn.Snapshots, 8) // 4 notes, 3 categories and root collection
+
+		shopping := convertertest.FindSnapshot(sn.Snapshots, "Shopping list")
+		meeting := convertertest.FindSnapshot(sn.Snapshots, "Meeting")
+		roadmap := convertertest.FindSnapshot(sn.Snapshots, "Roadmap")
+		recipe := convertertest.FindSnapshot(sn.Snapshots, "Recipe")
+		work := convertertest.FindSnapshot(sn.Snapshots, "Work")
+		projects := convertertest.FindSnapshot(sn.Snapshots, "Work/Projects")
+		personal := convertertest.FindSnapshot(sn.Snapshots, "Personal")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{shopping, meeting, roadmap, recipe, work, projects, personal, root} {
+			assert.NotNil(t, s)
+		}
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "notes"))
+
+		assert.Equal(t, bundle.TypeKeyPage.String(), meeting.Snapshot.Data.ObjectTypes[0])
+		assert.Equal(t, bundle.TypeKeyCollection.String(), work.Snapshot.Data.ObjectTypes[0])
+		assert.False(t, pbtypes.GetBool(work.Snapshot.Data.Details, bundle.RelationKeyIsFavorite.String()))
+
+		assert.ElementsMatch(t, []string{meeting.Id, projects.Id}, convertertest.CollectionObjects(work))
+		assert.ElementsMatch(t, []string{roadmap.Id}, convertertest.CollectionObjects(projects))
+		assert.ElementsMatch(t, []string{recipe.Id}, convertertest.CollectionObjects(personal))
+		assert.ElementsMatch(t, []string{shopping.Id, work.Id, personal.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+	})
+	t.Run("note content is converted with anymark", func(t *testing.T) {
+		// given
+		n := &Nextcloud{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := n.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Nextcloud, "testdata/notes"), p)
+
+		// then
+		assert.Nil(t, err)
+		meeting := convertertest.FindSnapshot(sn.Snapshots, "Meeting")
+		assert.NotNil(t, meeting)
+		var texts []string
+		for _, block := range meeting.Snapshot.Data.Blocks {
+			if text := block.GetText(); text != nil {
+				texts = append(texts, text.Text)
+				if text.Style == model.BlockContentText_Header1 {
+					assert.Equal(t, "Meeting", text.Text)
+				}
+			}
+		}
+		assert.Contains(t, texts, "Discuss roadmap for the next quarter")
+	})
+	t.Run("modified time and favorite flag are preserved", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		notePath := filepath.Join(dir, "Ideas", "Note.md")
+		assert.Nil(t, os.MkdirAll(filepath.Dir(notePath), 0777))
+		assert.Nil(t, os.WriteFile(notePath, []byte("some idea"), 0666))
+		modificationTime := time.Date(2023, 9, 21, 1, 0, 0, 0, time.UTC)
+		assert.Nil(t, os.Chtimes(notePath, modificationTime, modificationTime))
+		favoriteIsSupported := setFavorite(notePath) == nil
+
+		n := &Nextcloud{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := n.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Nextcloud, dir), p)
+
+		// then
+		assert.Nil(t, err)
+		note := convertertest.FindSnapshot(sn.Snapshots, "Note")
+		assert.NotNil(t, note)
+		assert.Equal(t, modificationTime.Unix(), pbtypes.GetInt64(note.Snapshot.Data.Details, bundle.RelationKeyLastModifiedDate.String()))
+		if favoriteIsSupported {
+			assert.True(t, pbtypes.GetBool(note.Snapshot.Data.Details, bundle.RelationKeyIsFavorite.String()))
+		}
+	})
+	t.Run("directory without notes - return error", func(t *testing.T) {
+		// given
+		n := &Nextcloud{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := n.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Nextcloud, t.TempDir()), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Nextcloud), converter.ErrNoObjectsToImport))
+	})
+}
@@ -0,0 +1,25 @@
+//go:build linux && !android
+
+package nextcloud
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+)
+
+// favoriteAttr is the extended attribute, which is set by sync clients for notes marked as favorite
+const favoriteAttr = "user.nextcloud.favorite"
+
+func isFavorite(fileName string) bool {
+	value := make([]byte, 16)
+	n, err := syscall.Getxattr(fileName, favoriteAttr, value)
+	if err != nil {
+		if !errors.Is(err, syscall.ENODATA) && !errors.Is(err, syscall.ENOTSUP) {
+			log.Warnf("failed to read favorite attribute of note: %s", err)
+		}
+		return false
+	}
+	v := strings.TrimSpace(string(value[:n]))
+	return v == "1" || strings.EqualFold(v, "true")
+}
@@ -0,0 +1,9 @@
+//go:build linux && !android
+
+package nextcloud
+
+import "syscall"
+
+func setFavorite(fileName string) error {
+	return syscall.Setxattr(fileName, favoriteAttr, []byte("1"), 0)
+}
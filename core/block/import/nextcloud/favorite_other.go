@@ -0,0 +1,7 @@
+//go:build !linux || android
+
+package nextcloud
+
+func isFavorite(string) bool {
+	return false
+}
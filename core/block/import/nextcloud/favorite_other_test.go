@@ -0,0 +1,9 @@
+//go:build !linux || android
+
+package nextcloud
+
+import "errors"
+
+func setFavorite(string) error {
+	return errors.New("favorite attribute is not supported")
+}
@@ -327,7 +327,15 @@ func (*Service) fillBlocks(blockType Type, buffer []byte) []interface{} {
 			return blocks
 		}
 		blocks = append(blocks, &l)
-	case TypeUnsupported, TypeTemplate, TypeSyncedBlock:
+	case TypeSyncedBlock:
+		var sb SyncedBlock
+		err := json.Unmarshal(buffer, &sb)
+		if err != nil {
+			log.With(zap.String("method", "getBlocks")).Error(err)
+			return blocks
+		}
+		blocks = append(blocks, &sb)
+	case TypeUnsupported, TypeTemplate:
 		var u UnsupportedBlock
 		err := json.Unmarshal(buffer, &u)
 		if err != nil {
@@ -1091,3 +1091,65 @@ func Test_GetBlocksAndChildrenResponseError(t *testing.T) {
 	_, ok = columnListBlock.ColumnList.([]interface{})
 	assert.True(t, ok)
 }
+
+func Test_GetBlocksAndChildrenSyncedBlockDuplicate(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/blocks/original/children" {
+			w.Write([]byte(`
+		{
+			"object": "list",
+			"results": [
+				{
+					"object": "block",
+					"id": "paragraph",
+					"has_children": false,
+					"archived": false,
+					"type": "paragraph",
+					"paragraph": {
+						"rich_text": [{"type": "text", "text": {"content": "synced"}, "plain_text": "synced"}],
+						"color": "default"
+					}
+				}
+			],
+			"next_cursor": null,
+			"has_more": false
+		}
+		`))
+			return
+		}
+		w.Write([]byte(`
+		{
+			"object": "list",
+			"results": [
+				{
+					"object": "block",
+					"id": "duplicate",
+					"has_children": true,
+					"archived": false,
+					"type": "synced_block",
+					"synced_block": {
+						"synced_from": {"type": "block_id", "block_id": "original"}
+					}
+				}
+			],
+			"next_cursor": null,
+			"has_more": false
+		}
+		`))
+	}))
+
+	defer s.Close()
+	pageSize := int64(100)
+	c := client.NewClient()
+	c.BasePath = s.URL
+
+	blockService := New(c)
+	bl, err := blockService.GetBlocksAndChildren(context.TODO(), "id", "key", pageSize, pb.RpcObjectImportRequest_ALL_OR_NOTHING)
+	assert.Nil(t, err)
+	assert.Len(t, bl, 1)
+	syncedBlock, ok := bl[0].(*SyncedBlock)
+	assert.True(t, ok)
+	assert.Len(t, syncedBlock.SyncedBlock.Children, 1)
+	_, ok = syncedBlock.SyncedBlock.Children[0].(*ParagraphBlock)
+	assert.True(t, ok)
+}
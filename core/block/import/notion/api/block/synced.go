@@ -0,0 +1,42 @@
+package block
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/import/notion/api"
+)
+
+// SyncedBlock is original synced block or its duplicate. Anytype has no synced blocks,
+// so content of both is imported in place as usual blocks
+type SyncedBlock struct {
+	Block
+	SyncedBlock SyncedObject `json:"synced_block"`
+}
+
+type SyncedObject struct {
+	// SyncedFrom is empty for original synced block
+	SyncedFrom *SyncedFrom   `json:"synced_from"`
+	Children   []interface{} `json:"children"`
+}
+
+type SyncedFrom struct {
+	BlockID string `json:"block_id"`
+}
+
+// GetID returns id of original block for duplicate, because Notion returns content only for original block
+func (s *SyncedBlock) GetID() string {
+	if s.SyncedBlock.SyncedFrom != nil && s.SyncedBlock.SyncedFrom.BlockID != "" {
+		return s.SyncedBlock.SyncedFrom.BlockID
+	}
+	return s.ID
+}
+
+func (s *SyncedBlock) HasChild() bool {
+	return s.HasChildren || s.SyncedBlock.SyncedFrom != nil
+}
+
+func (s *SyncedBlock) SetChildren(children []interface{}) {
+	s.SyncedBlock.Children = children
+}
+
+func (s *SyncedBlock) GetBlocks(req *api.NotionImportContext, pageID string) *MapResponse {
+	return MapBlocks(req, s.SyncedBlock.Children, pageID)
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
 
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
@@ -15,7 +16,9 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/workerpool"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
 var log = logging.Logger("notion-page")
@@ -61,19 +64,27 @@ func (p *Page) GetObjectType() string {
 	return ObjectType
 }
 
-// GetPages transform Page objects from Notion to snaphots
+// GetPages transform Page objects from Notion to snaphots. If stream is set, snapshots are passed to it
+// in batches instead of returning them
 func (ds *Service) GetPages(ctx context.Context,
 	apiKey string,
 	mode pb.RpcObjectImportRequestMode,
+	objectType string,
 	pages []Page,
 	notionImportContext *api.NotionImportContext,
 	relations *property.PropertiesStore,
-	progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	progress process.Progress,
+	stream converter.SnapshotStream) (*converter.Response, *converter.ConvertError) {
 	progress.SetProgressMessage("Start creating pages from notion")
 	convertError := ds.fillNotionImportContext(pages, progress, notionImportContext)
 	if convertError != nil {
 		return nil, convertError
 	}
+	if stream != nil {
+		if err := stream.Prepare(ds.getPagesStubs(pages, notionImportContext)); err != nil {
+			return nil, converter.NewFromError(err, mode)
+		}
+	}
 	numWorkers := workerPoolSize
 	if len(pages) < workerPoolSize {
 		numWorkers = 1
@@ -82,10 +93,10 @@ func (ds *Service) GetPages(ctx context.Context,
 
 	go ds.addWorkToPool(pages, pool)
 
-	do := NewDataObject(ctx, apiKey, mode, notionImportContext, relations)
+	do := NewDataObject(ctx, apiKey, mode, objectType, notionImportContext, relations)
 	go pool.Start(do)
 
-	allSnapshots, converterError := ds.readResultFromPool(pool, mode, progress)
+	allSnapshots, converterError := ds.readResultFromPool(pool, mode, progress, stream)
 	if converterError.IsEmpty() {
 		return &converter.Response{Snapshots: allSnapshots}, nil
 	}
@@ -93,7 +104,11 @@ func (ds *Service) GetPages(ctx context.Context,
 	return &converter.Response{Snapshots: allSnapshots}, converterError
 }
 
-func (ds *Service) readResultFromPool(pool *workerpool.WorkerPool, mode pb.RpcObjectImportRequestMode, progress process.Progress) ([]*converter.Snapshot, *converter.ConvertError) {
+func (ds *Service) readResultFromPool(pool *workerpool.WorkerPool,
+	mode pb.RpcObjectImportRequestMode,
+	progress process.Progress,
+	stream converter.SnapshotStream,
+) ([]*converter.Snapshot, *converter.ConvertError) {
 	allSnapshots := make([]*converter.Snapshot, 0)
 	ce := converter.NewError(mode)
 
@@ -111,10 +126,39 @@ func (ds *Service) readResultFromPool(pool *workerpool.WorkerPool, mode pb.RpcOb
 			}
 		}
 		allSnapshots = append(allSnapshots, res.snapshot...)
+		if stream != nil && len(allSnapshots) >= converter.BatchSize {
+			if err := stream.Send(allSnapshots); err != nil {
+				pool.Stop()
+				ce.Add(err)
+				return nil, ce
+			}
+			allSnapshots = make([]*converter.Snapshot, 0)
+		}
+	}
+	if stream != nil {
+		if err := stream.Send(allSnapshots); err != nil {
+			ce.Add(err)
+		}
+		return nil, ce
 	}
 	return allSnapshots, ce
 }
 
+// getPagesStubs returns snapshots of pages without content, which are used to resolve ids of pages
+// before their conversion
+func (ds *Service) getPagesStubs(pages []Page, importContext *api.NotionImportContext) []*converter.Snapshot {
+	stubs := make([]*converter.Snapshot, 0, len(pages))
+	for _, p := range pages {
+		details, _ := (&Task{p: p}).prepareDetails()
+		stubs = append(stubs, &converter.Snapshot{
+			Id:       importContext.NotionPageIdsToAnytype[p.ID],
+			SbType:   smartblock.SmartBlockTypePage,
+			Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{Details: &types.Struct{Fields: details}}},
+		})
+	}
+	return stubs
+}
+
 func (ds *Service) addWorkToPool(pages []Page, pool *workerpool.WorkerPool) {
 	var (
 		relMutex    = &sync.Mutex{}
@@ -24,15 +24,16 @@ import (
 )
 
 type DataObject struct {
-	apiKey    string
-	mode      pb.RpcObjectImportRequestMode
-	request   *api.NotionImportContext
-	ctx       context.Context
-	relations *property.PropertiesStore
+	apiKey     string
+	mode       pb.RpcObjectImportRequestMode
+	objectType string
+	request    *api.NotionImportContext
+	ctx        context.Context
+	relations  *property.PropertiesStore
 }
 
-func NewDataObject(ctx context.Context, apiKey string, mode pb.RpcObjectImportRequestMode, request *api.NotionImportContext, relations *property.PropertiesStore) *DataObject {
-	return &DataObject{apiKey: apiKey, mode: mode, request: request, ctx: ctx, relations: relations}
+func NewDataObject(ctx context.Context, apiKey string, mode pb.RpcObjectImportRequestMode, objectType string, request *api.NotionImportContext, relations *property.PropertiesStore) *DataObject {
+	return &DataObject{apiKey: apiKey, mode: mode, objectType: objectType, request: request, ctx: ctx, relations: relations}
 }
 
 type Result struct {
@@ -89,7 +90,7 @@ func (pt *Task) makeSnapshotFromPages(object *DataObject, allErrors *converter.C
 		}
 	}
 	resp := pt.blockService.MapNotionBlocksToAnytype(object.request, notionBlocks, pt.p.ID)
-	snapshot := pt.provideSnapshot(resp.Blocks, details, relationLinks)
+	snapshot := pt.provideSnapshot(resp.Blocks, details, relationLinks, object.objectType)
 	return snapshot, subObjectsSnapshots
 }
 
@@ -101,11 +102,11 @@ func (pt *Task) provideDetails(object *DataObject) (map[string]*types.Value, []*
 	return details, relationsSnapshots, relationLinks
 }
 
-func (pt *Task) provideSnapshot(notionBlocks []*model.Block, details map[string]*types.Value, relationLinks []*model.RelationLink) *model.SmartBlockSnapshotBase {
+func (pt *Task) provideSnapshot(notionBlocks []*model.Block, details map[string]*types.Value, relationLinks []*model.RelationLink, objectType string) *model.SmartBlockSnapshotBase {
 	snapshot := &model.SmartBlockSnapshotBase{
 		Blocks:        notionBlocks,
 		Details:       &types.Struct{Fields: details},
-		ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+		ObjectTypes:   []string{objectType},
 		RelationLinks: relationLinks,
 	}
 	return snapshot
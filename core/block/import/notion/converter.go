@@ -16,6 +16,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/notion/api/search"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 )
 
 const (
@@ -24,6 +25,7 @@ const (
 	numberOfStepsForPages     = 4 // 3 cycles to get snapshots and 1 cycle to create objects
 	numberOfStepsForDatabases = 2 // 1 cycles to get snapshots and 1 cycle to create objects
 	stepForSearch             = 1
+	defaultObjectType         = bundle.TypeKeyPage
 )
 
 type Notion struct {
@@ -42,7 +44,21 @@ func New(c *collection.Service) converter.Converter {
 }
 
 func (n *Notion) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
-	ce := converter.NewError(req.Mode)
+	return n.getSnapshots(req, progress, nil)
+}
+
+// GetSnapshotStream passes pages to the stream in batches, as soon as they are received from Notion,
+// so large workspaces are not kept in memory. Databases and collections are passed in the last batch
+func (n *Notion) GetSnapshotStream(ctx context.Context,
+	req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	stream converter.SnapshotStream,
+) (*converter.Response, *converter.ConvertError) {
+	return n.getSnapshots(req, progress, stream)
+}
+
+func (n *Notion) getSnapshots(req *pb.RpcObjectImportRequest, progress process.Progress, stream converter.SnapshotStream) (*converter.Response, *converter.ConvertError) {
+	ce := converter.NewErrorWithProgress(req.Mode, progress)
 	apiKey := n.getParams(req)
 	if apiKey == "" {
 		ce.Add(fmt.Errorf("failed to extract apikey"))
@@ -87,8 +103,14 @@ func (n *Notion) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportReques
 	if ce.ShouldAbortImport(0, req.Type) {
 		return nil, ce
 	}
+	if stream != nil && dbSnapshots != nil {
+		if err = stream.Prepare(dbSnapshots.Snapshots); err != nil {
+			ce.Add(err)
+			return nil, ce
+		}
+	}
 
-	pgSnapshots, pgErr := n.pgService.GetPages(ctx, apiKey, req.Mode, pages, notionImportContext, relations, progress)
+	pgSnapshots, pgErr := n.pgService.GetPages(ctx, apiKey, req.Mode, converter.ObjectTypeKey(req, defaultObjectType), pages, notionImportContext, relations, progress, stream)
 	if pgErr != nil {
 		log.With("error", pgErr).Warnf("import from notion pages failed")
 		ce.Merge(pgErr)
@@ -121,6 +143,13 @@ func (n *Notion) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportReques
 		dbs = append(dbs, rootCollectionSnapshot)
 		rootCollectionID = rootCollectionSnapshot.Id
 	}
+	if stream != nil {
+		if err = stream.Send(dbs); err != nil {
+			ce.Add(err)
+			return nil, ce
+		}
+		dbs, pgs = nil, nil
+	}
 	allSnapshots := make([]*converter.Snapshot, 0, len(pgs)+len(dbs))
 	allSnapshots = append(allSnapshots, pgs...)
 	allSnapshots = append(allSnapshots, dbs...)
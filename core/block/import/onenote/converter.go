@@ -0,0 +1,292 @@
+package onenote
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "OneNote"
+	rootCollectionName = "OneNote Import"
+	docxExtension      = ".docx"
+)
+
+var log = logging.Logger("import-onenote")
+
+// OneNote imports pages of OneNote notebooks exported to Word documents. The directory or zip archive
+// with the export is imported as the notebook, folders of sections inside it are imported as collections.
+// Binary .one and .onepkg files are not supported, they should be exported to .docx from OneNote first
+type OneNote struct {
+	service         *collection.Service
+	tempDirProvider core.TempDirProvider
+	parseLimits     converter.ParseLimits
+}
+
+func New(service *collection.Service, tempDirProvider core.TempDirProvider) converter.Converter {
+	return &OneNote{
+		service:         service,
+		tempDirProvider: tempDirProvider,
+		parseLimits:     converter.DefaultParseLimits,
+	}
+}
+
+func (o *OneNote) Name() string {
+	return Name
+}
+
+func (o *OneNote) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOneNoteParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (o *OneNote) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from OneNote pages")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := o.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(o.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (o *OneNote) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, o.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := o.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// notebookBuilder creates snapshots of pages of one import path. Folders of sections are converted to collections
+type notebookBuilder struct {
+	importPath string
+	objectType string
+	limits     converter.ParseLimits
+	assetsDir  string
+	service    *collection.Service
+
+	snapshots []*converter.Snapshot
+	// folderPages maps folder relative to the root of export to ids of pages in it
+	folderPages map[string][]string
+	// subfolders maps folder to folders inside it
+	subfolders map[string][]string
+}
+
+// handleImportPath returns snapshots of pages and collections and list of objects,
+// that should be added to the root collection: the collection of notebook or the page, if single page is imported
+func (o *OneNote) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_OneNote) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{docxExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	assetsDir, err := os.MkdirTemp(o.tempDirProvider.TempDir(), "onenote")
+	if err != nil {
+		allErrors.Add(oserror.TransformError(err))
+		return nil, nil
+	}
+	b := &notebookBuilder{
+		importPath:  importPath,
+		objectType:  objectType,
+		limits:      limits,
+		assetsDir:   assetsDir,
+		service:     o.service,
+		folderPages: map[string][]string{},
+		subfolders:  map[string][]string{},
+	}
+	var pageFiles []string
+	if err = importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if strings.EqualFold(filepath.Ext(fileName), docxExtension) {
+			pageFiles = append(pageFiles, fileName)
+		}
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	// files of directory are iterated in random order, so pages are sorted to keep the order of sections
+	sort.Strings(pageFiles)
+	for _, fileName := range pageFiles {
+		err = importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+			return b.addPage(fileName, fileReader)
+		})
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_OneNote) {
+				return nil, nil
+			}
+		}
+	}
+	if len(b.snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	rootObjects, err := b.build()
+	if err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	return b.snapshots, rootObjects
+}
+
+func (b *notebookBuilder) addPage(fileName string, fileReader io.Reader) error {
+	data, err := b.limits.ReadAll(fileReader)
+	if err != nil {
+		return err
+	}
+	pageAssetsDir := filepath.Join(b.assetsDir, uuid.New().String())
+	content, err := convertDocx(data, b.limits, pageAssetsDir)
+	if err != nil {
+		return err
+	}
+	blocks, _, err := anymark.HTMLToBlocks([]byte(content))
+	if err != nil {
+		return fmt.Errorf("failed to convert page to blocks: %w", err)
+	}
+	id := uuid.New().String()
+	title := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:      blocks,
+			Details:     converter.GetCommonDetails(fileName, title, "", model.ObjectType_basic),
+			ObjectTypes: []string{b.objectType},
+		}},
+	})
+	folder := b.relativeFolder(fileName)
+	b.folderPages[folder] = append(b.folderPages[folder], id)
+	return nil
+}
+
+// relativeFolder returns the folder of the page relative to the root of export with slash separators.
+// Files of directory have absolute paths, while files of archive have paths relative to its root
+func (b *notebookBuilder) relativeFolder(fileName string) string {
+	if relPath, err := filepath.Rel(b.importPath, fileName); err == nil && !strings.HasPrefix(relPath, "..") {
+		fileName = relPath
+	}
+	return path.Dir(filepath.ToSlash(fileName))
+}
+
+// build creates collections of section folders and the collection of the notebook and returns ids of objects,
+// that should be added to the root collection
+func (b *notebookBuilder) build() ([]string, error) {
+	for folder := range b.folderPages {
+		for folder != "." && folder != "/" {
+			parent := path.Dir(folder)
+			if !lo.Contains(b.subfolders[parent], folder) {
+				b.subfolders[parent] = append(b.subfolders[parent], folder)
+			}
+			folder = parent
+		}
+	}
+	if strings.EqualFold(filepath.Ext(b.importPath), docxExtension) {
+		return b.folderPages["."], nil
+	}
+	notebook := strings.TrimSuffix(filepath.Base(b.importPath), filepath.Ext(b.importPath))
+	id, err := b.addFolder(".", notebook)
+	if err != nil {
+		return nil, err
+	}
+	return []string{id}, nil
+}
+
+func (b *notebookBuilder) addFolder(folder, title string) (string, error) {
+	var objects []string
+	subfolders := b.subfolders[folder]
+	sort.Strings(subfolders)
+	for _, subfolder := range subfolders {
+		id, err := b.addFolder(subfolder, path.Base(subfolder))
+		if err != nil {
+			return "", err
+		}
+		objects = append(objects, id)
+	}
+	objects = append(objects, b.folderPages[folder]...)
+	col, err := converter.NewRootCollection(b.service).MakeCollection(title, objects)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for %s: %w", title, err)
+	}
+	b.snapshots = append(b.snapshots, col)
+	return col.Id, nil
+}
@@ -0,0 +1,184 @@
+package onenote
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const documentRels = `<?xml version="1.0"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="media/image1.png"/>
+  <Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="https://example.com/agenda" TargetMode="External"/>
+</Relationships>`
+
+const meetingDocument = `<?xml version="1.0"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+  xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+  xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">
+  <w:body>
+    <w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Meeting</w:t></w:r></w:p>
+    <w:p>
+      <w:r><w:t xml:space="preserve">See </w:t></w:r>
+      <w:r><w:rPr><w:b/></w:rPr><w:t>the</w:t></w:r>
+      <w:r><w:t xml:space="preserve"> </w:t></w:r>
+      <w:hyperlink r:id="rId2"><w:r><w:t>agenda</w:t></w:r></w:hyperlink>
+    </w:p>
+    <w:p><w:r><w:drawing><a:graphic><a:graphicData><a:blip r:embed="rId1"/></a:graphicData></a:graphic></w:drawing></w:r></w:p>
+    <w:tbl>
+      <w:tr><w:tc><w:p><w:r><w:t>Name</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Role</w:t></w:r></w:p></w:tc></w:tr>
+      <w:tr><w:tc><w:p><w:r><w:t>Ann</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>Host</w:t></w:r></w:p></w:tc></w:tr>
+    </w:tbl>
+  </w:body>
+</w:document>`
+
+const todoDocument = `<?xml version="1.0"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+  <w:body>
+    <w:p><w:pPr><w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t>Buy milk</w:t></w:r></w:p>
+  </w:body>
+</w:document>`
+
+func writeDocx(t *testing.T, docxPath string, files map[string]string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(docxPath), 0777))
+	f, err := os.Create(docxPath)
+	require.NoError(t, err)
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for name, content := range files {
+		fw, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = fw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+}
+
+func writeNotebook(t *testing.T) string {
+	notebook := filepath.Join(t.TempDir(), "Work")
+	writeDocx(t, filepath.Join(notebook, "Meetings", "Meeting.docx"), map[string]string{
+		documentFile:            meetingDocument,
+		relsFile:                documentRels,
+		"word/media/image1.png": "png",
+	})
+	writeDocx(t, filepath.Join(notebook, "Tasks", "Todo.docx"), map[string]string{documentFile: todoDocument})
+	return notebook
+}
+
+func TestOneNote_GetSnapshots(t *testing.T) {
+	t.Run("notebook and sections are collections of pages", func(t *testing.T) {
+		// given
+		o := &OneNote{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_OneNote, writeNotebook(t)), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		meeting := convertertest.FindSnapshot(sn.Snapshots, "Meeting")
+		todo := convertertest.FindSnapshot(sn.Snapshots, "Todo")
+		meetings := convertertest.FindSnapshot(sn.Snapshots, "Meetings")
+		tasks := convertertest.FindSnapshot(sn.Snapshots, "Tasks")
+		work := convertertest.FindSnapshot(sn.Snapshots, "Work")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{meeting, todo, meetings, tasks, work, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{meeting.Id}, convertertest.CollectionObjects(meetings))
+		assert.Equal(t, []string{todo.Id}, convertertest.CollectionObjects(tasks))
+		assert.Equal(t, []string{meetings.Id, tasks.Id}, convertertest.CollectionObjects(work))
+		assert.Equal(t, []string{work.Id}, convertertest.CollectionObjects(root))
+
+		todoBlocks := todo.Snapshot.Data.Blocks
+		require.Len(t, todoBlocks, 1)
+		assert.Equal(t, "Buy milk", todoBlocks[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Marked, todoBlocks[0].GetText().Style)
+	})
+	t.Run("page keeps text styles, links, images and tables", func(t *testing.T) {
+		// given
+		o := &OneNote{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_OneNote, writeNotebook(t)), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		meeting := convertertest.FindSnapshot(sn.Snapshots, "Meeting")
+		require.NotNil(t, meeting)
+		blocks := meeting.Snapshot.Data.Blocks
+		require.True(t, len(blocks) > 3)
+
+		assert.Equal(t, "Meeting", blocks[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Header1, blocks[0].GetText().Style)
+		text := blocks[1].GetText()
+		assert.Equal(t, "See the agenda", text.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 4, To: 7}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 8, To: 14}, Type: model.BlockContentTextMark_Link, Param: "https://example.com/agenda"},
+		}, text.Marks.Marks)
+
+		file := blocks[2].GetFile()
+		require.NotNil(t, file)
+		assert.Equal(t, model.BlockContentFile_Image, file.Type)
+		assert.Equal(t, "image1.png", filepath.Base(file.Name))
+		_, statErr := os.Stat(file.Name)
+		assert.NoError(t, statErr)
+
+		var tables int
+		for _, b := range blocks {
+			if b.GetTable() != nil {
+				tables++
+			}
+		}
+		assert.Equal(t, 1, tables)
+	})
+	t.Run("single page is added to the root collection", func(t *testing.T) {
+		// given
+		o := &OneNote{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		docxPath := filepath.Join(t.TempDir(), "Todo.docx")
+		writeDocx(t, docxPath, map[string]string{documentFile: todoDocument})
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_OneNote, docxPath), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		require.Len(t, sn.Snapshots, 2)
+		todo := convertertest.FindSnapshot(sn.Snapshots, "Todo")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		require.NotNil(t, todo)
+		require.NotNil(t, root)
+		assert.Equal(t, []string{todo.Id}, convertertest.CollectionObjects(root))
+	})
+	t.Run("file is not a Word document - return error", func(t *testing.T) {
+		// given
+		o := &OneNote{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		docxPath := filepath.Join(t.TempDir(), "broken.docx")
+		require.NoError(t, os.WriteFile(docxPath, []byte("not a document"), 0600))
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_OneNote, docxPath), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+	})
+}
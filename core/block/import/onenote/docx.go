@@ -0,0 +1,325 @@
+package onenote
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+const (
+	documentFile = "word/document.xml"
+	relsFile     = "word/_rels/document.xml.rels"
+)
+
+type relationships struct {
+	Relationships []struct {
+		ID         string `xml:"Id,attr"`
+		Target     string `xml:"Target,attr"`
+		TargetMode string `xml:"TargetMode,attr"`
+	} `xml:"Relationship"`
+}
+
+// docxConverter converts document.xml of docx to HTML, which is converted to blocks the same way as HTML import.
+// Only content, which OneNote puts to exported pages, is supported: paragraphs with headings and lists,
+// text styles, links, tables and images
+type docxConverter struct {
+	files map[string]*zip.File
+	// targets maps relationship id to the file in the archive for images or to URL for links
+	targets   map[string]string
+	external  map[string]bool
+	limits    converter.ParseLimits
+	assetsDir string
+
+	out strings.Builder
+	// paragraph and run collect content of current paragraph and run, which is wrapped by tags of run styles
+	paragraph  strings.Builder
+	run        strings.Builder
+	styles     runStyles
+	style      string
+	isListItem bool
+	inText     bool
+	// tableRows counts rows of tables, which are open now. The first row of table is its header
+	tableRows []int
+	cellTag   []string
+	linkTag   []string
+}
+
+type runStyles struct {
+	bold, italic, underline, strike bool
+}
+
+// convertDocx returns HTML of the document. Images are extracted to assetsDir
+func convertDocx(data []byte, limits converter.ParseLimits, assetsDir string) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("open docx: %w", err)
+	}
+	c := &docxConverter{
+		files:     make(map[string]*zip.File, len(archive.File)),
+		targets:   map[string]string{},
+		external:  map[string]bool{},
+		limits:    limits,
+		assetsDir: assetsDir,
+	}
+	for _, f := range archive.File {
+		c.files[f.Name] = f
+	}
+	if err = c.readRelationships(); err != nil {
+		return "", err
+	}
+	document, err := c.readFile(documentFile)
+	if err != nil {
+		return "", err
+	}
+	if err = limits.CheckXML(document); err != nil {
+		return "", err
+	}
+	if err = c.convert(document); err != nil {
+		return "", fmt.Errorf("convert %s: %w", documentFile, err)
+	}
+	return c.out.String(), nil
+}
+
+func (c *docxConverter) readFile(name string) ([]byte, error) {
+	f, ok := c.files[name]
+	if !ok {
+		return nil, fmt.Errorf("%s is not found", name)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return c.limits.ReadAll(r)
+}
+
+func (c *docxConverter) readRelationships() error {
+	data, err := c.readFile(relsFile)
+	if err != nil {
+		// documents without images and links may have no relationships
+		return nil
+	}
+	rels := &relationships{}
+	if err = c.limits.DecodeXML(bytes.NewReader(data), rels); err != nil {
+		return fmt.Errorf("parse %s: %w", relsFile, err)
+	}
+	for _, rel := range rels.Relationships {
+		if strings.EqualFold(rel.TargetMode, "External") {
+			c.targets[rel.ID] = rel.Target
+			c.external[rel.ID] = true
+			continue
+		}
+		c.targets[rel.ID] = path.Join(path.Dir(documentFile), rel.Target)
+	}
+	return nil
+}
+
+func (c *docxConverter) convert(document []byte) error {
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			c.start(t)
+		case xml.EndElement:
+			c.end(t.Name.Local)
+		case xml.CharData:
+			if c.inText {
+				c.run.WriteString(html.EscapeString(string(t)))
+			}
+		}
+	}
+}
+
+func (c *docxConverter) start(t xml.StartElement) {
+	switch t.Name.Local {
+	case "p":
+		c.paragraph.Reset()
+		c.style = ""
+		c.isListItem = false
+	case "pStyle":
+		c.style = attr(t, "val")
+	case "numPr":
+		c.isListItem = true
+	case "r":
+		c.run.Reset()
+		c.styles = runStyles{}
+	case "b":
+		c.styles.bold = isOn(t)
+	case "i":
+		c.styles.italic = isOn(t)
+	case "u":
+		c.styles.underline = attr(t, "val") != "none" && isOn(t)
+	case "strike":
+		c.styles.strike = isOn(t)
+	case "t":
+		c.inText = true
+	case "tab":
+		c.run.WriteString(" ")
+	case "br":
+		c.run.WriteString("<br>")
+	case "blip":
+		if src := c.extractImage(attr(t, "embed")); src != "" {
+			c.paragraph.WriteString(`<img src="` + html.EscapeString(src) + `">`)
+		}
+	case "hyperlink":
+		// links to anchors inside the document are not supported, their text is kept as is
+		tag := "span"
+		if id := attr(t, "id"); c.external[id] {
+			tag = "a"
+			c.paragraph.WriteString(`<a href="` + html.EscapeString(c.targets[id]) + `">`)
+		}
+		c.linkTag = append(c.linkTag, tag)
+	case "tbl":
+		c.out.WriteString("<table>")
+		c.tableRows = append(c.tableRows, 0)
+	case "tr":
+		c.out.WriteString("<tr>")
+	case "tc":
+		if len(c.tableRows) == 0 {
+			return
+		}
+		tag := "td"
+		if c.tableRows[len(c.tableRows)-1] == 0 {
+			tag = "th"
+		}
+		c.cellTag = append(c.cellTag, tag)
+		c.out.WriteString("<" + tag + ">")
+	}
+}
+
+func (c *docxConverter) end(name string) {
+	switch name {
+	case "t":
+		c.inText = false
+	case "r":
+		c.paragraph.WriteString(c.styles.wrap(c.run.String()))
+	case "hyperlink":
+		if len(c.linkTag) == 0 {
+			return
+		}
+		if c.linkTag[len(c.linkTag)-1] == "a" {
+			c.paragraph.WriteString("</a>")
+		}
+		c.linkTag = c.linkTag[:len(c.linkTag)-1]
+	case "p":
+		c.writeParagraph()
+	case "tc":
+		if len(c.cellTag) == 0 {
+			return
+		}
+		tag := c.cellTag[len(c.cellTag)-1]
+		c.cellTag = c.cellTag[:len(c.cellTag)-1]
+		c.out.WriteString("</" + tag + ">")
+	case "tr":
+		if len(c.tableRows) == 0 {
+			return
+		}
+		c.tableRows[len(c.tableRows)-1]++
+		c.out.WriteString("</tr>")
+	case "tbl":
+		if len(c.tableRows) == 0 {
+			return
+		}
+		c.tableRows = c.tableRows[:len(c.tableRows)-1]
+		c.out.WriteString("</table>")
+	}
+}
+
+func (c *docxConverter) writeParagraph() {
+	content := c.paragraph.String()
+	if len(c.tableRows) > 0 {
+		// cells of tables contain only inline content
+		if content != "" {
+			c.out.WriteString(content + " ")
+		}
+		return
+	}
+	if content == "" {
+		return
+	}
+	tag := "p"
+	switch style := strings.ToLower(c.style); {
+	case style == "title" || style == "heading1":
+		tag = "h1"
+	case style == "heading2":
+		tag = "h2"
+	case strings.HasPrefix(style, "heading"):
+		tag = "h3"
+	case c.isListItem:
+		c.out.WriteString("<ul><li>" + content + "</li></ul>")
+		return
+	}
+	c.out.WriteString("<" + tag + ">" + content + "</" + tag + ">")
+}
+
+func (s runStyles) wrap(text string) string {
+	if text == "" {
+		return ""
+	}
+	for _, style := range []struct {
+		on  bool
+		tag string
+	}{{s.bold, "b"}, {s.italic, "i"}, {s.underline, "u"}, {s.strike, "del"}} {
+		if style.on {
+			text = "<" + style.tag + ">" + text + "</" + style.tag + ">"
+		}
+	}
+	return text
+}
+
+// extractImage writes the image of relationship to assetsDir and returns its path
+func (c *docxConverter) extractImage(id string) string {
+	name, ok := c.targets[id]
+	if !ok || c.external[id] {
+		return ""
+	}
+	data, err := c.readFile(name)
+	if err != nil {
+		log.Warnf("failed to read image %s of docx: %v", name, err)
+		return ""
+	}
+	imagePath := filepath.Join(c.assetsDir, filepath.FromSlash(name))
+	if err = os.MkdirAll(filepath.Dir(imagePath), 0777); err == nil {
+		err = os.WriteFile(imagePath, data, 0600)
+	}
+	if err != nil {
+		log.Warnf("failed to extract image %s of docx: %v", name, err)
+		return ""
+	}
+	return imagePath
+}
+
+func attr(t xml.StartElement, name string) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// isOn reports whether toggle property of run is enabled, e.g. <w:b/> or <w:b w:val="true"/>
+func isOn(t xml.StartElement) bool {
+	switch attr(t, "val") {
+	case "0", "false", "off":
+		return false
+	}
+	return true
+}
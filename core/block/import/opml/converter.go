@@ -0,0 +1,166 @@
+package opml
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Opml"
+	rootCollectionName = "OPML Import"
+)
+
+var extensions = []string{".opml"}
+
+// OPML imports outlines exported from outliners like Workflowy or Dynalist. Every file becomes a page,
+// where outline items are nested bulleted blocks
+type OPML struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &OPML{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (o *OPML) Name() string {
+	return Name
+}
+
+func (o *OPML) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOpmlParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (o *OPML) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from outlines")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := o.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(o.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (o *OPML) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, o.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := o.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+func (o *OPML) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Opml) {
+			return nil, nil
+		}
+	}
+	numberOfFiles := importSource.CountFilesWithGivenExtensions(extensions)
+	if numberOfFiles == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	snapshots := make([]*converter.Snapshot, 0, numberOfFiles)
+	targetObjects := make([]string, 0, numberOfFiles)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !lo.Contains(extensions, strings.ToLower(filepath.Ext(fileName))) {
+			return true
+		}
+		doc, err := parseDocument(fileReader, limits)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Opml)
+		}
+		sn := o.getSnapshot(doc, fileName, objectType)
+		snapshots = append(snapshots, sn)
+		targetObjects = append(targetObjects, sn.Id)
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return snapshots, targetObjects
+}
+
+func (o *OPML) getSnapshot(doc *document, fileName, objectType string) *converter.Snapshot {
+	sn := &model.SmartBlockSnapshotBase{
+		Blocks:      doc.blocks(),
+		Details:     converter.GetCommonDetails(fileName, strings.TrimSpace(doc.Title), "", model.ObjectType_basic),
+		ObjectTypes: []string{objectType},
+	}
+	return &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &pb.ChangeSnapshot{Data: sn},
+		SbType:   smartblock.SmartBlockTypePage,
+	}
+}
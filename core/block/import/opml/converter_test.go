@@ -0,0 +1,85 @@
+package opml
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestOPML_GetSnapshots(t *testing.T) {
+	t.Run("outline items are nested bulleted blocks with notes", func(t *testing.T) {
+		// given
+		o := &OPML{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Opml, filepath.Join("testdata", "workflowy.opml")), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		require.Len(t, sn.Snapshots, 2)
+		page := sn.Snapshots[0]
+		assert.Equal(t, "Project plan", pbtypes.GetString(page.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+
+		blocks := page.Snapshot.Data.Blocks
+		require.Len(t, blocks, 6)
+		byId := make(map[string]*model.Block, len(blocks))
+		for _, b := range blocks {
+			byId[b.Id] = b
+		}
+		research := blocks[0]
+		assert.Equal(t, "Research", research.GetText().GetText())
+		assert.Equal(t, model.BlockContentText_Marked, research.GetText().GetStyle())
+		require.Len(t, research.ChildrenIds, 3)
+		note := byId[research.ChildrenIds[0]]
+		assert.Equal(t, "Collect links first", note.GetText().GetText())
+		assert.Equal(t, model.BlockContentText_Paragraph, note.GetText().GetStyle())
+		competitors := byId[research.ChildrenIds[1]]
+		assert.Equal(t, "Competitors", competitors.GetText().GetText())
+		require.Len(t, competitors.ChildrenIds, 1)
+		assert.Equal(t, "Pricing", byId[competitors.ChildrenIds[0]].GetText().GetText())
+		assert.Equal(t, "Interviews", byId[research.ChildrenIds[2]].GetText().GetText())
+		assert.Equal(t, "Launch", blocks[5].GetText().GetText())
+		assert.Empty(t, blocks[5].ChildrenIds)
+	})
+	t.Run("document without title is named by file", func(t *testing.T) {
+		// given
+		o := &OPML{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Opml, filepath.Join("testdata", "untitled.opml")), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		assert.Equal(t, "untitled", pbtypes.GetString(sn.Snapshots[0].Snapshot.Data.Details, bundle.RelationKeyName.String()))
+	})
+	t.Run("malformed file - error", func(t *testing.T) {
+		// given
+		o := &OPML{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		path := filepath.Join(t.TempDir(), "broken.opml")
+		require.NoError(t, os.WriteFile(path, []byte(`<opml><body><outline text="broken"></body>`), 0600))
+
+		// when
+		_, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Opml, path), p)
+
+		// then
+		require.NotNil(t, err)
+		assert.False(t, err.IsEmpty())
+	})
+}
@@ -0,0 +1,71 @@
+package opml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type document struct {
+	XMLName  xml.Name  `xml:"opml"`
+	Title    string    `xml:"head>title"`
+	Outlines []outline `xml:"body>outline"`
+}
+
+// outline is the item of outline. Workflowy and Dynalist keep notes of items in the _note attribute
+type outline struct {
+	Text     string    `xml:"text,attr"`
+	Note     string    `xml:"_note,attr"`
+	Children []outline `xml:"outline"`
+}
+
+func parseDocument(rc io.ReadCloser, limits converter.ParseLimits) (*document, error) {
+	defer rc.Close()
+	doc := &document{}
+	if err := limits.DecodeXML(rc, doc); err != nil {
+		return nil, fmt.Errorf("parse opml: %w", err)
+	}
+	return doc, nil
+}
+
+// blocks returns flat list of blocks, where nesting of outline is kept by children ids. Root block is added
+// by object creator with top-level items as children
+func (d *document) blocks() []*model.Block {
+	var blocks []*model.Block
+	for i := range d.Outlines {
+		blocks = d.Outlines[i].appendBlocks(blocks)
+	}
+	return blocks
+}
+
+// appendBlocks adds bulleted block of the item, followed by blocks of its note and nested items.
+// Note is the first child of the item's block, so it is shown right under the item's text
+func (o *outline) appendBlocks(blocks []*model.Block) []*model.Block {
+	block := newTextBlock(o.Text, model.BlockContentText_Marked)
+	blocks = append(blocks, block)
+	if o.Note != "" {
+		note := newTextBlock(o.Note, model.BlockContentText_Paragraph)
+		block.ChildrenIds = append(block.ChildrenIds, note.Id)
+		blocks = append(blocks, note)
+	}
+	for i := range o.Children {
+		childIdx := len(blocks)
+		blocks = o.Children[i].appendBlocks(blocks)
+		block.ChildrenIds = append(block.ChildrenIds, blocks[childIdx].Id)
+	}
+	return blocks
+}
+
+func newTextBlock(text string, style model.BlockContentTextStyle) *model.Block {
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: text, Style: style},
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package orgmode
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Org"
+	rootCollectionName = "Org Import"
+	orgExtension       = ".org"
+)
+
+var log = logging.Logger("import-org")
+
+// Org imports Org-mode files. Each file becomes a page with headlines as headers, while headlines
+// with TODO keywords become tasks with status, tags, scheduled and deadline dates
+type Org struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &Org{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (o *Org) Name() string {
+	return Name
+}
+
+func (o *Org) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOrgParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (o *Org) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from Org files")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := o.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(o.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (o *Org) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, o.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := o.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of files, their tasks, tags and statuses and list of pages of files,
+// that should be added to the root collection
+func (o *Org) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Org) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{orgExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	var fileNames []string
+	if err := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if strings.EqualFold(filepath.Ext(fileName), orgExtension) {
+			fileNames = append(fileNames, fileName)
+		}
+		return true
+	}); err != nil {
+		allErrors.Add(err)
+		return nil, nil
+	}
+	sort.Strings(fileNames)
+	builder := newSnapshotBuilder(objectType)
+	pageIDs := make([]string, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		doc, err := readDocument(importSource, fileName, limits)
+		var id string
+		if err == nil {
+			id, err = builder.addDocument(fileName, doc)
+		}
+		if err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Org) {
+				return nil, nil
+			}
+			continue
+		}
+		pageIDs = append(pageIDs, id)
+	}
+	if len(pageIDs) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return builder.snapshots, pageIDs
+}
+
+func readDocument(importSource source.Source, fileName string, limits converter.ParseLimits) (*document, error) {
+	var data []byte
+	err := importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		var err error
+		data, err = limits.ReadAll(fileReader)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseDocument(string(data)), nil
+}
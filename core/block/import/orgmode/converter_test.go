@@ -0,0 +1,136 @@
+package orgmode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestOrg_GetSnapshots(t *testing.T) {
+	t.Run("file is page with headers, headlines with keywords are linked tasks", func(t *testing.T) {
+		// given
+		o := &Org{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Org, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		projects := convertertest.FindSnapshot(sn.Snapshots, "Projects")
+		writeCopy := convertertest.FindSnapshot(sn.Snapshots, "Write copy")
+		pickDomain := convertertest.FindSnapshot(sn.Snapshots, "Pick domain")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{projects, writeCopy, pickDomain, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{projects.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, []string{"work", "web"}, getOptionNames(sn.Snapshots, projects, bundle.RelationKeyTag.String()))
+
+		var (
+			headers []string
+			links   []string
+			tables  int
+		)
+		for _, b := range projects.Snapshot.Data.Blocks {
+			if text := b.GetText(); text != nil && text.Style == model.BlockContentText_Header1 {
+				headers = append(headers, text.Text)
+			}
+			if link := b.GetLink(); link != nil {
+				links = append(links, link.TargetBlockId)
+			}
+			if b.GetTable() != nil {
+				tables++
+			}
+		}
+		assert.Equal(t, []string{"Website", "Notes"}, headers)
+		assert.Equal(t, []string{writeCopy.Id, pickDomain.Id}, links)
+		assert.Equal(t, 1, tables)
+	})
+	t.Run("keywords, tags and planning of headline are relations of task", func(t *testing.T) {
+		// given
+		o := &Org{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Org, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		writeCopy := convertertest.FindSnapshot(sn.Snapshots, "Write copy")
+		pickDomain := convertertest.FindSnapshot(sn.Snapshots, "Pick domain")
+		require.NotNil(t, writeCopy)
+		require.NotNil(t, pickDomain)
+
+		details := writeCopy.Snapshot.Data.Details
+		assert.Equal(t, []string{bundle.TypeKeyTask.String()}, writeCopy.Snapshot.Data.ObjectTypes)
+		assert.False(t, pbtypes.GetBool(details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, []string{"NEXT"}, getOptionNames(sn.Snapshots, writeCopy, bundle.RelationKeyStatus.String()))
+		assert.Equal(t, []string{"writing"}, getOptionNames(sn.Snapshots, writeCopy, bundle.RelationKeyTag.String()))
+		deadline := time.Date(2024, time.March, 8, 17, 0, 0, 0, time.Local).Unix()
+		assert.Equal(t, deadline, pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()))
+		scheduled := convertertest.FindSnapshot(sn.Snapshots, scheduledRelationName)
+		require.NotNil(t, scheduled)
+		assert.Equal(t, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.Local).Unix(), pbtypes.GetInt64(details, scheduled.Id))
+
+		blocks := writeCopy.Snapshot.Data.Blocks
+		require.Len(t, blocks, 2)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks[0].GetText().Style)
+		assert.True(t, blocks[0].GetText().Checked)
+		assert.False(t, blocks[1].GetText().Checked)
+
+		assert.True(t, pbtypes.GetBool(pickDomain.Snapshot.Data.Details, bundle.RelationKeyDone.String()))
+		assert.Equal(t, []string{"DONE"}, getOptionNames(sn.Snapshots, pickDomain, bundle.RelationKeyStatus.String()))
+	})
+}
+
+func TestToMarkdown(t *testing.T) {
+	lines := []string{
+		"Some *bold*, /italic/ and =code= text with [[https://orgmode.org][link]]",
+		":LOGBOOK:",
+		"- State \"DONE\"",
+		":END:",
+		"#+BEGIN_QUOTE",
+		"Quote",
+		"#+END_QUOTE",
+		"| a | b |",
+		"| 1 | 2 |",
+	}
+
+	md := toMarkdown(lines)
+
+	assert.Equal(t, "Some **bold**, *italic* and `code` text with [link](https://orgmode.org)\n"+
+		"> Quote\n\n"+
+		"\n| a | b |\n| --- | --- |\n| 1 | 2 |\n\n", md)
+}
+
+func TestParseKeywords(t *testing.T) {
+	assert.Equal(t, defaultKeywords, parseKeywords([]string{"* TODO task"}))
+	assert.Equal(t, map[string]bool{"TODO": false, "WAIT": false, "DONE": true},
+		parseKeywords([]string{"#+SEQ_TODO: TODO(t) WAIT(w@/!) DONE(d)"}))
+}
+
+func getOptionNames(snapshots []*converter.Snapshot, sn *converter.Snapshot, relationKey string) []string {
+	var names []string
+	for _, id := range pbtypes.GetStringList(sn.Snapshot.Data.Details, relationKey) {
+		for _, option := range snapshots {
+			if option.Id == id {
+				names = append(names, pbtypes.GetString(option.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,210 @@
+package orgmode
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	timestampLayout     = "2006-01-02"
+	timestampTimeLayout = "2006-01-02 15:04"
+)
+
+var (
+	headlineRegexp = regexp.MustCompile(`^(\*+)\s+(.*)$`)
+	priorityRegexp = regexp.MustCompile(`^\[#[A-Za-z0-9]\]\s*`)
+	tagsRegexp     = regexp.MustCompile(`\s+(:[\w@#%:]+:)\s*$`)
+	// planningRegexp matches timestamps of planning line, e.g. SCHEDULED: <2024-01-10 Wed 10:00>
+	planningRegexp = regexp.MustCompile(`(SCHEDULED|DEADLINE|CLOSED):\s*[<\[](\d{4}-\d{2}-\d{2})(?:\s+[^\s\d>\]][^\s>\]]*)?(?:\s+(\d{1,2}:\d{2}))?`)
+)
+
+// defaultKeywords are used, if the document doesn't define its own TODO keywords. Values are true for done states
+var defaultKeywords = map[string]bool{"TODO": false, "DONE": true}
+
+// document is the Org file split to headlines. Content of headlines is kept as lines of text,
+// which are converted to Markdown later
+type document struct {
+	title string
+	tags  []string
+	// body contains lines before the first headline
+	body      []string
+	headlines []*headline
+	// keywords maps TODO keywords of the document to whether they are done states
+	keywords map[string]bool
+}
+
+type headline struct {
+	level     int
+	keyword   string
+	title     string
+	tags      []string
+	scheduled int64
+	deadline  int64
+	body      []string
+	children  []*headline
+}
+
+func (h *headline) isTask() bool {
+	return h.keyword != ""
+}
+
+func parseDocument(content string) *document {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	doc := &document{keywords: parseKeywords(lines)}
+	var (
+		stack        []*headline
+		afterHeading bool
+	)
+	for _, line := range lines {
+		if match := headlineRegexp.FindStringSubmatch(line); match != nil {
+			h := doc.parseHeadline(len(match[1]), match[2])
+			for len(stack) > 0 && stack[len(stack)-1].level >= h.level {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				doc.headlines = append(doc.headlines, h)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, h)
+			}
+			stack = append(stack, h)
+			afterHeading = true
+			continue
+		}
+		if len(stack) == 0 {
+			doc.parseSetting(line)
+			doc.body = append(doc.body, line)
+			continue
+		}
+		current := stack[len(stack)-1]
+		// planning line may only follow the headline
+		if afterHeading && current.parsePlanning(line) {
+			afterHeading = false
+			continue
+		}
+		afterHeading = false
+		current.body = append(current.body, line)
+	}
+	return doc
+}
+
+// parseSetting reads the title and tags of the document from in-buffer settings
+func (d *document) parseSetting(line string) {
+	key, value, ok := parseKeywordLine(line)
+	if !ok {
+		return
+	}
+	switch key {
+	case "TITLE":
+		d.title = value
+	case "FILETAGS":
+		d.tags = append(d.tags, splitTags(value)...)
+	}
+}
+
+func (d *document) parseHeadline(level int, text string) *headline {
+	h := &headline{level: level}
+	if word, rest, _ := strings.Cut(text, " "); word != "" {
+		if _, ok := d.keywords[word]; ok {
+			h.keyword = word
+			text = strings.TrimSpace(rest)
+		}
+	}
+	text = priorityRegexp.ReplaceAllString(text, "")
+	if match := tagsRegexp.FindStringSubmatchIndex(text); match != nil {
+		h.tags = splitTags(text[match[2]:match[3]])
+		text = text[:match[0]]
+	}
+	h.title = strings.TrimSpace(text)
+	return h
+}
+
+// parsePlanning returns false, if the line is not a planning line
+func (h *headline) parsePlanning(line string) bool {
+	matches := planningRegexp.FindAllStringSubmatch(line, -1)
+	if len(matches) == 0 || !strings.HasPrefix(strings.TrimSpace(line), matches[0][1]) {
+		return false
+	}
+	for _, match := range matches {
+		timestamp := parseTimestamp(match[2], match[3])
+		switch match[1] {
+		case "SCHEDULED":
+			h.scheduled = timestamp
+		case "DEADLINE":
+			h.deadline = timestamp
+		}
+	}
+	return true
+}
+
+// parseKeywords returns TODO keywords from #+TODO lines, e.g. #+TODO: TODO NEXT | DONE CANCELED.
+// Keywords after the bar are done states, the last keyword is the done state, if there is no bar
+func parseKeywords(lines []string) map[string]bool {
+	keywords := map[string]bool{}
+	for _, line := range lines {
+		key, value, ok := parseKeywordLine(line)
+		if !ok || (key != "TODO" && key != "SEQ_TODO" && key != "TYP_TODO") {
+			continue
+		}
+		active, done, hasBar := strings.Cut(value, "|")
+		activeWords := strings.Fields(active)
+		doneWords := strings.Fields(done)
+		if !hasBar && len(activeWords) > 0 {
+			doneWords = activeWords[len(activeWords)-1:]
+			activeWords = activeWords[:len(activeWords)-1]
+		}
+		for _, word := range activeWords {
+			keywords[trimFastAccessKey(word)] = false
+		}
+		for _, word := range doneWords {
+			keywords[trimFastAccessKey(word)] = true
+		}
+	}
+	if len(keywords) == 0 {
+		return defaultKeywords
+	}
+	return keywords
+}
+
+// parseKeywordLine splits the line like #+TITLE: value to upper case key and value
+func parseKeywordLine(line string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "#+") {
+		return "", "", false
+	}
+	key, value, ok := strings.Cut(line[2:], ":")
+	if !ok {
+		return "", "", false
+	}
+	return strings.ToUpper(key), strings.TrimSpace(value), true
+}
+
+// trimFastAccessKey removes selection key and logging settings of keyword, e.g. WAIT(w@/!)
+func trimFastAccessKey(word string) string {
+	if i := strings.Index(word, "("); i > 0 {
+		return word[:i]
+	}
+	return word
+}
+
+// splitTags splits tags like :work:urgent: or work urgent
+func splitTags(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == ':' || r == ' ' || r == '\t'
+	})
+}
+
+// parseTimestamp returns unix time of the date and optional time of timestamp in local time zone
+func parseTimestamp(date, clock string) int64 {
+	layout, value := timestampLayout, date
+	if clock != "" {
+		layout, value = timestampTimeLayout, date+" "+clock
+	}
+	t, err := time.ParseInLocation(layout, value, time.Local)
+	if err != nil {
+		log.Debugf("unsupported timestamp: %s", value)
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,198 @@
+package orgmode
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	linkRegexp      = regexp.MustCompile(`\[\[([^\]]+)\](?:\[([^\]]+)\])?\]`)
+	listItemRegexp  = regexp.MustCompile(`^(\s*)([-+*]|\d+[.)])\s+(?:\[([ Xx-])\]\s+)?(.*)$`)
+	drawerRegexp    = regexp.MustCompile(`^:[A-Za-z_]+:$`)
+	tableRuleRegexp = regexp.MustCompile(`^\|[-+:| ]*-[-+:| ]*$`)
+)
+
+// emphasis maps markers of Org emphasis to Markdown, in the order of conversion. Code is converted first,
+// so markers inside it are not converted
+var emphasis = []struct {
+	regexp      *regexp.Regexp
+	open, close string
+}{
+	{emphasisRegexp("="), "`", "`"},
+	{emphasisRegexp("~"), "`", "`"},
+	{emphasisRegexp("*"), "**", "**"},
+	{emphasisRegexp("/"), "*", "*"},
+	{emphasisRegexp("+"), "~~", "~~"},
+	{emphasisRegexp("_"), "<u>", "</u>"},
+}
+
+// emphasisRegexp matches the text between markers, which are placed at word boundaries
+func emphasisRegexp(marker string) *regexp.Regexp {
+	m := regexp.QuoteMeta(marker)
+	return regexp.MustCompile(`(^|[\s('"{])` + m + `([^\s` + m + `](?:[^` + m + `]*?[^\s` + m + `])?)` + m + `($|[\s\-.,:!?;'")}\[])`)
+}
+
+// toMarkdown converts lines of Org content to Markdown. Drawers, comments and in-buffer settings are skipped
+func toMarkdown(lines []string) string {
+	var (
+		out      strings.Builder
+		table    [][]string
+		block    string
+		inDrawer bool
+	)
+	flushTable := func() {
+		if len(table) > 0 {
+			writeTable(&out, table)
+			table = nil
+		}
+	}
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if inDrawer {
+			inDrawer = !strings.EqualFold(trimmed, ":END:")
+			continue
+		}
+		if block != "" {
+			if strings.HasPrefix(strings.ToUpper(trimmed), "#+END_") {
+				if block == "src" || block == "example" {
+					out.WriteString("```\n")
+				}
+				out.WriteString("\n")
+				block = ""
+				continue
+			}
+			switch block {
+			case "src", "example":
+				out.WriteString(line + "\n")
+			case "quote":
+				out.WriteString("> " + convertInline(trimmed) + "\n")
+			default:
+				out.WriteString(convertInline(trimmed) + "\n")
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "|") {
+			if !tableRuleRegexp.MatchString(trimmed) {
+				table = append(table, splitTableRow(trimmed))
+			}
+			continue
+		}
+		flushTable()
+		switch {
+		case drawerRegexp.MatchString(trimmed):
+			inDrawer = true
+		case strings.HasPrefix(strings.ToUpper(trimmed), "#+BEGIN_"):
+			name, args, _ := strings.Cut(trimmed[len("#+BEGIN_"):], " ")
+			block = strings.ToLower(name)
+			switch block {
+			case "src":
+				var language string
+				if fields := strings.Fields(args); len(fields) > 0 {
+					language = fields[0]
+				}
+				out.WriteString("```" + language + "\n")
+			case "example":
+				out.WriteString("```\n")
+			}
+		case strings.HasPrefix(trimmed, "#+"), trimmed == "#", strings.HasPrefix(trimmed, "# "):
+			// in-buffer settings and comments are not content
+		case len(trimmed) >= 5 && strings.Trim(trimmed, "-") == "":
+			out.WriteString("---\n")
+		default:
+			out.WriteString(convertListItem(line) + "\n")
+		}
+	}
+	flushTable()
+	if block == "src" || block == "example" {
+		out.WriteString("```\n")
+	}
+	return out.String()
+}
+
+// convertListItem converts bullets and checkboxes of list items, other lines are converted as paragraphs
+func convertListItem(line string) string {
+	match := listItemRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return convertInline(line)
+	}
+	indent, bullet, checkbox, text := match[1], match[2], match[3], match[4]
+	if n, err := strconv.Atoi(strings.TrimRight(bullet, ".)")); err == nil {
+		bullet = fmt.Sprintf("%d.", n)
+	} else {
+		bullet = "-"
+	}
+	switch checkbox {
+	case "X", "x":
+		bullet += " [x]"
+	case " ", "-":
+		bullet += " [ ]"
+	}
+	return indent + bullet + " " + convertInline(text)
+}
+
+// convertInline converts links and emphasis. Text of links is converted, while their targets are kept as is
+func convertInline(s string) string {
+	var out strings.Builder
+	last := 0
+	for _, match := range linkRegexp.FindAllStringSubmatchIndex(s, -1) {
+		out.WriteString(convertEmphasis(s[last:match[0]]))
+		target := strings.TrimPrefix(s[match[2]:match[3]], "file:")
+		text := target
+		if match[4] >= 0 {
+			text = s[match[4]:match[5]]
+		}
+		out.WriteString("[" + convertEmphasis(text) + "](" + strings.ReplaceAll(target, " ", "%20") + ")")
+		last = match[1]
+	}
+	out.WriteString(convertEmphasis(s[last:]))
+	return out.String()
+}
+
+func convertEmphasis(s string) string {
+	for _, e := range emphasis {
+		// matches share boundary characters, so adjacent emphasis needs several passes
+		for {
+			converted := e.regexp.ReplaceAllString(s, "${1}"+e.open+"${2}"+e.close+"${3}")
+			if converted == s {
+				break
+			}
+			s = converted
+		}
+	}
+	return s
+}
+
+func splitTableRow(row string) []string {
+	row = strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|")
+	cells := strings.Split(row, "|")
+	for i, cell := range cells {
+		cells[i] = convertInline(strings.TrimSpace(cell))
+	}
+	return cells
+}
+
+// writeTable writes the table in Markdown format. The first row of the table is its header,
+// as Markdown tables can't be written without it
+func writeTable(out *strings.Builder, rows [][]string) {
+	columns := 0
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	writeRow := func(row []string) {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		out.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+	out.WriteString("\n")
+	writeRow(rows[0])
+	out.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+	for _, row := range rows[1:] {
+		writeRow(row)
+	}
+	out.WriteString("\n")
+}
@@ -0,0 +1,208 @@
+package orgmode
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	scheduledRelationName = "Scheduled"
+	maxHeaderLevel        = 3
+)
+
+// snapshotBuilder creates snapshots of Org files of one import path. Headlines with TODO keywords become tasks,
+// which are linked from the page of the file. Tags, statuses and relations are shared between all objects of the path
+type snapshotBuilder struct {
+	objectType string
+
+	relations *converter.RelationCreator
+	snapshots []*converter.Snapshot
+	taskCount int
+
+	// fileName and keywords belong to the document, which is converted now
+	fileName string
+	keywords map[string]bool
+}
+
+func newSnapshotBuilder(objectType string) *snapshotBuilder {
+	b := &snapshotBuilder{objectType: objectType}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+// content collects blocks and tags of one object. Text is collected as Markdown,
+// which is converted to blocks before the link to the task is added
+type content struct {
+	markdown strings.Builder
+	blocks   []*model.Block
+	tags     []string
+}
+
+func (c *content) flush() error {
+	defer c.markdown.Reset()
+	if strings.TrimSpace(c.markdown.String()) == "" {
+		return nil
+	}
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(c.markdown.String()), "", nil)
+	if err != nil {
+		return err
+	}
+	c.blocks = append(c.blocks, blocks...)
+	return nil
+}
+
+func (c *content) addLink(targetID string) error {
+	if err := c.flush(); err != nil {
+		return err
+	}
+	c.blocks = append(c.blocks, &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: targetID,
+			Style:         model.BlockContentLink_Page,
+		}},
+	})
+	return nil
+}
+
+// addDocument creates the page of the file and tasks of its headlines and returns id of the page
+func (b *snapshotBuilder) addDocument(fileName string, doc *document) (string, error) {
+	b.fileName = fileName
+	b.keywords = doc.keywords
+	c := &content{}
+	c.markdown.WriteString(toMarkdown(doc.body))
+	if err := b.addHeadlines(c, doc.headlines, 1); err != nil {
+		return "", err
+	}
+	if err := c.flush(); err != nil {
+		return "", err
+	}
+	title := doc.title
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	}
+	details := converter.GetCommonDetails(fileName, title, "", model.ObjectType_basic)
+	relationLinks := b.setOptions(details, nil, bundle.RelationKeyTag.String(), model.RelationFormat_tag, append(doc.tags, c.tags...))
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        c.blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	})
+	return id, nil
+}
+
+// addHeadlines adds headlines to the content as headers, nested headlines get the next level of header.
+// Headlines with TODO keywords are added as links to tasks
+func (b *snapshotBuilder) addHeadlines(c *content, headlines []*headline, level int) error {
+	for _, h := range headlines {
+		if h.isTask() {
+			id, err := b.addTask(h)
+			if err != nil {
+				return err
+			}
+			if err = c.addLink(id); err != nil {
+				return err
+			}
+			continue
+		}
+		c.tags = append(c.tags, h.tags...)
+		headerLevel := level
+		if headerLevel > maxHeaderLevel {
+			headerLevel = maxHeaderLevel
+		}
+		c.markdown.WriteString("\n" + strings.Repeat("#", headerLevel) + " " + convertInline(h.title) + "\n\n")
+		c.markdown.WriteString(toMarkdown(h.body))
+		if err := b.addHeadlines(c, h.children, level+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addTask creates task with status of TODO keyword, tags and dates of the headline. Content and nested headlines
+// of the headline become content of the task
+func (b *snapshotBuilder) addTask(h *headline) (string, error) {
+	c := &content{tags: h.tags}
+	c.markdown.WriteString(toMarkdown(h.body))
+	if err := b.addHeadlines(c, h.children, 1); err != nil {
+		return "", err
+	}
+	if err := c.flush(); err != nil {
+		return "", fmt.Errorf("failed to convert content of %s: %w", h.title, err)
+	}
+	b.taskCount++
+	sourcePath := fmt.Sprintf("%s/%d", b.fileName, b.taskCount)
+	title := h.title
+	if title == "" {
+		title = h.keyword
+	}
+	details := converter.GetCommonDetails(sourcePath, title, "", model.ObjectType_todo)
+	details.Fields[bundle.RelationKeyDone.String()] = pbtypes.Bool(b.keywords[h.keyword])
+	relationLinks := []*model.RelationLink{{
+		Key:    bundle.RelationKeyDone.String(),
+		Format: model.RelationFormat_checkbox,
+	}}
+	relationLinks = b.setOptions(details, relationLinks, bundle.RelationKeyStatus.String(), model.RelationFormat_status, []string{h.keyword})
+	relationLinks = b.setOptions(details, relationLinks, bundle.RelationKeyTag.String(), model.RelationFormat_tag, c.tags)
+	if h.deadline != 0 {
+		details.Fields[bundle.RelationKeyDueDate.String()] = pbtypes.Int64(h.deadline)
+		relationLinks = append(relationLinks, &model.RelationLink{
+			Key:    bundle.RelationKeyDueDate.String(),
+			Format: model.RelationFormat_date,
+		})
+	}
+	if h.scheduled != 0 {
+		relation := b.relations.Relation(scheduledRelationName, model.RelationFormat_date)
+		details.Fields[relation.Key] = pbtypes.Int64(h.scheduled)
+		relationLinks = append(relationLinks, relation)
+	}
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: b.fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        c.blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{bundle.TypeKeyTask.String()},
+		}},
+	})
+	return id, nil
+}
+
+// setOptions sets options with given names to the relation of object and returns relation links with the relation
+func (b *snapshotBuilder) setOptions(details *types.Struct,
+	relationLinks []*model.RelationLink,
+	relationKey string,
+	format model.RelationFormat,
+	names []string,
+) []*model.RelationLink {
+	ids := b.relations.OptionIDs(relationKey, lo.Uniq(names))
+	if len(ids) == 0 {
+		return relationLinks
+	}
+	details.Fields[relationKey] = pbtypes.StringList(ids)
+	return append(relationLinks, &model.RelationLink{Key: relationKey, Format: format})
+}
@@ -1,6 +1,7 @@
 package pb
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -42,12 +43,14 @@ type Pb struct {
 	service        *collection.Service
 	accountService account.Service
 	iconOption     int64
+	parseLimits    converter.ParseLimits
 }
 
 func New(service *collection.Service, accountService account.Service) converter.Converter {
 	return &Pb{
 		service:        service,
 		accountService: accountService,
+		parseLimits:    converter.DefaultParseLimits,
 	}
 }
 
@@ -56,8 +59,9 @@ func (p *Pb) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, p
 	if e != nil || params == nil {
 		return nil, converter.NewFromError(fmt.Errorf("wrong parameters"), req.Mode)
 	}
-	allErrors := converter.NewError(req.Mode)
-	allSnapshots, widgetSnapshot := p.getSnapshots(progress, params.GetPath(), req.IsMigration, allErrors)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	limits := converter.GetParseLimits(req, p.parseLimits)
+	allSnapshots, widgetSnapshot := p.getSnapshots(progress, params.GetPath(), req.IsMigration, limits, allErrors)
 	oldToNewID := p.updateLinksToObjects(allSnapshots, allErrors, len(params.GetPath()))
 	p.updateDetails(allSnapshots)
 	if allErrors.ShouldAbortImport(len(params.GetPath()), req.Type) {
@@ -99,6 +103,7 @@ func (p *Pb) getSnapshots(
 	progress process.Progress,
 	allPaths []string,
 	isMigration bool,
+	limits converter.ParseLimits,
 	allErrors *converter.ConvertError,
 ) ([]*converter.Snapshot, *converter.Snapshot) {
 	allSnapshots := make([]*converter.Snapshot, 0)
@@ -108,7 +113,7 @@ func (p *Pb) getSnapshots(
 			allErrors.Add(converter.ErrCancel)
 			return nil, nil
 		}
-		snapshots, widget := p.handleImportPath(len(path), path, allErrors, isMigration)
+		snapshots, widget := p.handleImportPath(len(path), path, limits, allErrors, isMigration)
 		if allErrors.ShouldAbortImport(len(allPaths), pb.RpcObjectImportRequest_Pb) {
 			return nil, nil
 		}
@@ -121,6 +126,7 @@ func (p *Pb) getSnapshots(
 func (p *Pb) handleImportPath(
 	pathCount int,
 	path string,
+	limits converter.ParseLimits,
 	allErrors *converter.ConvertError,
 	isMigration bool) ([]*converter.Snapshot, *converter.Snapshot) {
 	importSource := source.GetSource(path)
@@ -136,7 +142,7 @@ func (p *Pb) handleImportPath(
 		profileID           string
 		needToImportWidgets bool
 	)
-	profile, err := p.getProfileFromFiles(importSource)
+	profile, err := p.getProfileFromFiles(importSource, limits)
 	if err != nil {
 		allErrors.Add(err)
 		if allErrors.ShouldAbortImport(pathCount, pb.RpcObjectImportRequest_Pb) {
@@ -154,7 +160,7 @@ func (p *Pb) handleImportPath(
 		needToImportWidgets = p.needToImportWidgets(profile.Address, pr.AccountAddr)
 		profileID = profile.ProfileId
 	}
-	return p.getSnapshotsFromProvidedFiles(pathCount, importSource, allErrors, path, profileID, needToImportWidgets, isMigration)
+	return p.getSnapshotsFromProvidedFiles(pathCount, importSource, limits, allErrors, path, profileID, needToImportWidgets, isMigration)
 }
 
 func (p *Pb) extractFiles(importPath string, importSource source.Source) error {
@@ -168,14 +174,14 @@ func (p *Pb) extractFiles(importPath string, importSource source.Source) error {
 	return nil
 }
 
-func (p *Pb) getProfileFromFiles(importSource source.Source) (*pb.Profile, error) {
+func (p *Pb) getProfileFromFiles(importSource source.Source, limits converter.ParseLimits) (*pb.Profile, error) {
 	var (
 		profile *pb.Profile
 		err     error
 	)
 	iterateError := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
 		if filepath.Base(fileName) == constant.ProfileFile {
-			profile, err = p.readProfileFile(fileReader)
+			profile, err = p.readProfileFile(fileReader, limits)
 			return false
 		}
 		return true
@@ -186,10 +192,10 @@ func (p *Pb) getProfileFromFiles(importSource source.Source) (*pb.Profile, error
 	return profile, err
 }
 
-func (p *Pb) readProfileFile(f io.ReadCloser) (*pb.Profile, error) {
+func (p *Pb) readProfileFile(f io.ReadCloser, limits converter.ParseLimits) (*pb.Profile, error) {
 	defer f.Close()
 	profile := &pb.Profile{}
-	data, err := io.ReadAll(f)
+	data, err := limits.ReadAll(f)
 	if err != nil {
 		return nil, err
 	}
@@ -206,6 +212,7 @@ func (p *Pb) needToImportWidgets(address, accountID string) bool {
 func (p *Pb) getSnapshotsFromProvidedFiles(
 	pathCount int,
 	pbFiles source.Source,
+	limits converter.ParseLimits,
 	allErrors *converter.ConvertError,
 	path, profileID string,
 	needToImportWidgets, isMigration bool,
@@ -213,7 +220,7 @@ func (p *Pb) getSnapshotsFromProvidedFiles(
 	allSnapshots := make([]*converter.Snapshot, 0)
 	var widgetSnapshot *converter.Snapshot
 	if iterateErr := pbFiles.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
-		snapshot, err := p.makeSnapshot(fileName, profileID, path, fileReader, isMigration)
+		snapshot, err := p.makeSnapshot(fileName, profileID, path, fileReader, limits, isMigration)
 		if err != nil {
 			allErrors.Add(err)
 			if allErrors.ShouldAbortImport(pathCount, pb.RpcObjectImportRequest_Pb) {
@@ -235,11 +242,11 @@ func (p *Pb) getSnapshotsFromProvidedFiles(
 	return allSnapshots, widgetSnapshot
 }
 
-func (p *Pb) makeSnapshot(name, profileID, path string, file io.ReadCloser, isMigration bool) (*converter.Snapshot, error) {
+func (p *Pb) makeSnapshot(name, profileID, path string, file io.ReadCloser, limits converter.ParseLimits, isMigration bool) (*converter.Snapshot, error) {
 	if name == constant.ProfileFile || name == configFile {
 		return nil, nil
 	}
-	snapshot, errGS := p.getSnapshotFromFile(file, name)
+	snapshot, errGS := p.getSnapshotFromFile(file, name, limits)
 	if errGS != nil {
 		return nil, errGS
 	}
@@ -260,19 +267,26 @@ func (p *Pb) makeSnapshot(name, profileID, path string, file io.ReadCloser, isMi
 	}, nil
 }
 
-func (p *Pb) getSnapshotFromFile(rd io.ReadCloser, name string) (*pb.SnapshotWithType, error) {
+func (p *Pb) getSnapshotFromFile(rd io.ReadCloser, name string, limits converter.ParseLimits) (*pb.SnapshotWithType, error) {
 	defer rd.Close()
 	if filepath.Ext(name) == ".json" {
+		data, err := limits.ReadAll(rd)
+		if err != nil {
+			return nil, fmt.Errorf("PB:GetSnapshot %w", err)
+		}
+		if err = limits.CheckJSON(data); err != nil {
+			return nil, fmt.Errorf("PB:GetSnapshot %w", err)
+		}
 		snapshot := &pb.SnapshotWithType{}
 		um := jsonpb.Unmarshaler{}
-		if uErr := um.Unmarshal(rd, snapshot); uErr != nil {
+		if uErr := um.Unmarshal(bytes.NewReader(data), snapshot); uErr != nil {
 			return nil, fmt.Errorf("PB:GetSnapshot %w", uErr)
 		}
 		return snapshot, nil
 	}
 	if filepath.Ext(name) == ".pb" {
 		snapshot := &pb.SnapshotWithType{}
-		data, err := io.ReadAll(rd)
+		data, err := limits.ReadAll(rd)
 		if err != nil {
 			return nil, fmt.Errorf("PB:GetSnapshot %w", err)
 		}
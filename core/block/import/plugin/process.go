@@ -0,0 +1,194 @@
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const (
+	// maxMessageSize limits size of one message of the plugin, so broken plugin can't exhaust memory
+	maxMessageSize = 64 << 20
+	// maxStderrSize limits output of the plugin, which is added to the error, if the plugin fails
+	maxStderrSize = 4 << 10
+)
+
+var log = logging.Logger("import-plugin")
+
+var (
+	ErrEmptyName         = errors.New("name of plugin is empty")
+	ErrInvalidExecutable = errors.New("executable of plugin must be an absolute path")
+)
+
+// Process is converter, which runs external executable for every import. Request is written to stdin of the process,
+// and snapshots, errors and progress are read from its stdout. Messages are prefixed with their size as unsigned varint,
+// which is the delimited format of protobuf libraries
+type Process struct {
+	name       string
+	executable string
+	args       []string
+}
+
+func NewProcess(name, executable string, args []string) (*Process, error) {
+	if name == "" {
+		return nil, ErrEmptyName
+	}
+	if !filepath.IsAbs(executable) {
+		return nil, ErrInvalidExecutable
+	}
+	return &Process{name: name, executable: executable, args: args}, nil
+}
+
+func (p *Process) Name() string {
+	return p.name
+}
+
+func (p *Process) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	input, err := encodeMessage(req)
+	if err != nil {
+		return nil, converter.NewFromError(err, req.Mode)
+	}
+	stderr := &limitedBuffer{limit: maxStderrSize}
+	cmd := exec.CommandContext(ctx, p.executable, p.args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, converter.NewFromError(err, req.Mode)
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, converter.NewFromError(fmt.Errorf("start plugin %s: %w", p.name, err), req.Mode)
+	}
+	progress.SetProgressMessage("Start converting files with plugin " + p.name)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	res := &converter.Response{}
+	var cancelErr error
+	readErr := readMessages(bufio.NewReader(stdout), func(msg *pb.RpcObjectImportPluginMessage) error {
+		if cancelErr = progress.TryStep(0); cancelErr != nil {
+			return cancelErr
+		}
+		return p.handleMessage(msg, res, progress, allErrors)
+	})
+	if readErr != nil {
+		// plugin is killed, because nobody reads its output anymore
+		cancel()
+		if cancelErr != nil {
+			_ = cmd.Wait()
+			return nil, converter.NewCancelError(cancelErr)
+		}
+		allErrors.Add(readErr)
+	}
+	if err = cmd.Wait(); err != nil && readErr == nil {
+		allErrors.Add(fmt.Errorf("plugin %s failed: %w: %s", p.name, err, bytes.TrimSpace(stderr.Bytes())))
+	}
+	if allErrors.ShouldAbortImport(len(req.GetPluginParams().GetPath()), req.Type) {
+		return nil, allErrors
+	}
+	if len(res.Snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, allErrors
+	}
+	if allErrors.IsEmpty() {
+		return res, nil
+	}
+	return res, allErrors
+}
+
+func (p *Process) handleMessage(msg *pb.RpcObjectImportPluginMessage,
+	res *converter.Response,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) error {
+	switch value := msg.Value.(type) {
+	case *pb.RpcObjectImportPluginMessageValueOfSnapshot:
+		sn := value.Snapshot
+		if sn == nil || sn.Id == "" || sn.Snapshot == nil {
+			return fmt.Errorf("plugin %s sent snapshot without id or content", p.name)
+		}
+		res.Snapshots = append(res.Snapshots, &converter.Snapshot{
+			Id:       sn.Id,
+			SbType:   smartblock.SmartBlockType(sn.SbType),
+			FileName: sn.FileName,
+			Snapshot: &pb.ChangeSnapshot{Data: sn.Snapshot},
+		})
+	case *pb.RpcObjectImportPluginMessageValueOfError:
+		allErrors.Add(converter.NewFileError(value.Error.GetFileName(), errors.New(value.Error.GetDescription())))
+	case *pb.RpcObjectImportPluginMessageValueOfProgress:
+		progress.SetTotal(value.Progress.GetTotal())
+		progress.SetDone(value.Progress.GetDone())
+	case *pb.RpcObjectImportPluginMessageValueOfRootCollectionId:
+		res.RootCollectionID = value.RootCollectionId
+	default:
+		log.Warnf("plugin %s sent unknown message", p.name)
+	}
+	return nil
+}
+
+// readMessages calls handler for every message of the reader until its end
+func readMessages(r *bufio.Reader, handler func(msg *pb.RpcObjectImportPluginMessage) error) error {
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read size of plugin message: %w", err)
+		}
+		if size > maxMessageSize {
+			return fmt.Errorf("plugin message of %d bytes exceeds limit of %d bytes", size, maxMessageSize)
+		}
+		data := make([]byte, size)
+		if _, err = io.ReadFull(r, data); err != nil {
+			return fmt.Errorf("read plugin message: %w", err)
+		}
+		msg := &pb.RpcObjectImportPluginMessage{}
+		if err = proto.Unmarshal(data, msg); err != nil {
+			return fmt.Errorf("decode plugin message: %w", err)
+		}
+		if err = handler(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// encodeMessage returns message prefixed with its size
+func encodeMessage(msg proto.Message) ([]byte, error) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.AppendUvarint(nil, uint64(len(data))), data...), nil
+}
+
+// limitedBuffer keeps only the beginning of written data
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if free := b.limit - b.Len(); free > 0 {
+		if len(p) > free {
+			b.Buffer.Write(p[:free])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
@@ -0,0 +1,167 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// helperModeEnv switches test binary to the mode of plugin, so tests can run it as external process
+const helperModeEnv = "IMPORT_PLUGIN_HELPER_MODE"
+
+const (
+	helperModeConvert = "convert"
+	helperModeFail    = "fail"
+)
+
+func TestHelperPlugin(t *testing.T) {
+	mode := os.Getenv(helperModeEnv)
+	if mode == "" {
+		return
+	}
+	if mode == helperModeFail {
+		fmt.Fprint(os.Stderr, "broken file")
+		os.Exit(1)
+	}
+	r := bufio.NewReader(os.Stdin)
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		os.Exit(2)
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(r, data); err != nil {
+		os.Exit(2)
+	}
+	req := &pb.RpcObjectImportRequest{}
+	if err = proto.Unmarshal(data, req); err != nil {
+		os.Exit(2)
+	}
+	paths := req.GetPluginParams().GetPath()
+	messages := []*pb.RpcObjectImportPluginMessage{
+		{Value: &pb.RpcObjectImportPluginMessageValueOfProgress{Progress: &pb.RpcObjectImportPluginMessageProgress{Total: 2}}},
+		{Value: &pb.RpcObjectImportPluginMessageValueOfSnapshot{Snapshot: &pb.RpcObjectImportPluginMessageSnapshot{
+			Id:       "page",
+			SbType:   model.SmartBlockType_Page,
+			FileName: paths[0],
+			Snapshot: &model.SmartBlockSnapshotBase{ObjectTypes: []string{req.GetPluginParams().GetOptions()["type"]}},
+		}}},
+		{Value: &pb.RpcObjectImportPluginMessageValueOfError{Error: &pb.RpcObjectImportPluginMessageError{
+			FileName:    paths[1],
+			Description: "unsupported file",
+		}}},
+		{Value: &pb.RpcObjectImportPluginMessageValueOfRootCollectionId{RootCollectionId: "page"}},
+	}
+	for _, msg := range messages {
+		data, err := encodeMessage(msg)
+		if err != nil {
+			os.Exit(2)
+		}
+		os.Stdout.Write(data)
+	}
+	os.Exit(0)
+}
+
+func TestProcess_GetSnapshots(t *testing.T) {
+	t.Run("snapshots and errors are read from output of plugin", func(t *testing.T) {
+		// given
+		t.Setenv(helperModeEnv, helperModeConvert)
+		p := newHelperProcess(t)
+		req := getRequest(pb.RpcObjectImportRequest_IGNORE_ERRORS)
+
+		// when
+		res, ce := p.GetSnapshots(context.Background(), req, process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		require.NotNil(t, res)
+		require.Len(t, res.Snapshots, 1)
+		sn := res.Snapshots[0]
+		assert.Equal(t, "page", sn.Id)
+		assert.Equal(t, "note.txt", sn.FileName)
+		assert.Equal(t, []string{"ot-page"}, sn.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, "page", res.RootCollectionID)
+		require.NotNil(t, ce)
+		var fileErr *converter.FileError
+		require.ErrorAs(t, ce.Errors()[0], &fileErr)
+		assert.Equal(t, "image.heic", fileErr.FileName)
+	})
+	t.Run("error of plugin is returned with its output", func(t *testing.T) {
+		// given
+		t.Setenv(helperModeEnv, helperModeFail)
+		p := newHelperProcess(t)
+		req := getRequest(pb.RpcObjectImportRequest_ALL_OR_NOTHING)
+
+		// when
+		res, ce := p.GetSnapshots(context.Background(), req, process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		assert.Nil(t, res)
+		require.NotNil(t, ce)
+		assert.Contains(t, ce.Error().Error(), "broken file")
+	})
+}
+
+func TestNewProcess(t *testing.T) {
+	t.Run("relative path of executable is rejected", func(t *testing.T) {
+		// when
+		_, err := NewProcess("converter", "converter", nil)
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidExecutable)
+	})
+	t.Run("empty name is rejected", func(t *testing.T) {
+		// when
+		_, err := NewProcess("", "/usr/bin/converter", nil)
+
+		// then
+		assert.ErrorIs(t, err, ErrEmptyName)
+	})
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	// given
+	b := &limitedBuffer{limit: 4}
+
+	// when
+	n, err := b.Write([]byte("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	n, err = b.Write([]byte("def"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "abcd", b.String())
+}
+
+func newHelperProcess(t *testing.T) *Process {
+	executable, err := os.Executable()
+	require.NoError(t, err)
+	p, err := NewProcess("helper", executable, []string{"-test.run=^TestHelperPlugin$"})
+	require.NoError(t, err)
+	return p
+}
+
+func getRequest(mode pb.RpcObjectImportRequestMode) *pb.RpcObjectImportRequest {
+	return &pb.RpcObjectImportRequest{
+		Type: pb.RpcObjectImportRequest_Plugin,
+		Mode: mode,
+		Params: &pb.RpcObjectImportRequestParamsOfPluginParams{PluginParams: &pb.RpcObjectImportRequestPluginParams{
+			Name:    "helper",
+			Path:    []string{"note.txt", "image.heic"},
+			Options: map[string]string{"type": "ot-page"},
+		}},
+	}
+}
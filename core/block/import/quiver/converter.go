@@ -0,0 +1,215 @@
+package quiver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Quiver"
+	rootCollectionName = "Quiver Import"
+)
+
+var log = logging.Logger("import-quiver")
+
+type Quiver struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &Quiver{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (q *Quiver) Name() string {
+	return Name
+}
+
+func (q *Quiver) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetQuiverParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (q *Quiver) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := q.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from notebooks")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := q.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(q.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (q *Quiver) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, q.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := q.handleImportPath(p, converter.ObjectTypeKey(req, defaultObjectType), converter.SourceFilter(req), limits, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+// handleImportPath returns snapshots of notes, notebooks and tags and list of objects,
+// that should be added to the root collection: notebooks and notes outside of notebooks
+func (q *Quiver) handleImportPath(importPath, objectType string,
+	filter *source.Filter,
+	limits converter.ParseLimits,
+	pathsCount int,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	defer importSource.Close()
+	err := importSource.Initialize(importPath)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver) {
+			return nil, nil
+		}
+	}
+	lib := newLibrary(limits)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if err = lib.addFile(fileName, fileReader); err != nil {
+			allErrors.Add(converter.NewFileError(fileName, err))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver)
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	looseNotes := lib.groupNotes()
+	notebooks := getNotebooks(lib)
+	if len(looseNotes) == 0 && len(notebooks) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+
+	var tagSnapshots []*converter.Snapshot
+	relations := converter.NewRelationCreator(&tagSnapshots)
+	snapshots := make([]*converter.Snapshot, 0)
+	rootObjects := make([]string, 0)
+	for _, nb := range notebooks {
+		notes := q.convertNotes(nb.notes, objectType, relations, pathsCount, allErrors)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver) {
+			return nil, nil
+		}
+		col, err := converter.NewRootCollection(q.service).MakeCollection(nb.name(), getIDs(notes))
+		if err != nil {
+			allErrors.Add(fmt.Errorf("failed to create collection for notebook %s: %w", nb.name(), err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver) {
+				return nil, nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, notes...)
+		snapshots = append(snapshots, col)
+		rootObjects = append(rootObjects, col.Id)
+	}
+	notes := q.convertNotes(looseNotes, objectType, relations, pathsCount, allErrors)
+	if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver) {
+		return nil, nil
+	}
+	snapshots = append(snapshots, notes...)
+	rootObjects = append(rootObjects, getIDs(notes)...)
+	return append(snapshots, tagSnapshots...), rootObjects
+}
+
+// getNotebooks returns notebooks with notes sorted by path, deleted notes are not imported
+func getNotebooks(lib *library) []*notebook {
+	notebooks := make([]*notebook, 0, len(lib.notebooks))
+	for _, nb := range lib.notebooks {
+		if nb.isTrash() || len(nb.notes) == 0 {
+			continue
+		}
+		notebooks = append(notebooks, nb)
+	}
+	sort.Slice(notebooks, func(i, j int) bool {
+		return notebooks[i].path < notebooks[j].path
+	})
+	return notebooks
+}
+
+func (q *Quiver) convertNotes(notes []*note,
+	objectType string,
+	relations *converter.RelationCreator,
+	pathsCount int,
+	allErrors *converter.ConvertError,
+) []*converter.Snapshot {
+	sort.Slice(notes, func(i, j int) bool {
+		return notes[i].path < notes[j].path
+	})
+	snapshots := make([]*converter.Snapshot, 0, len(notes))
+	for _, n := range notes {
+		sn, err := getNoteSnapshot(n, objectType, relations)
+		if err != nil {
+			allErrors.Add(converter.NewFileError(n.path, err))
+			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Quiver) {
+				return nil
+			}
+			continue
+		}
+		snapshots = append(snapshots, sn)
+	}
+	return snapshots
+}
+
+func getIDs(snapshots []*converter.Snapshot) []string {
+	ids := make([]string, 0, len(snapshots))
+	for _, sn := range snapshots {
+		ids = append(ids, sn.Id)
+	}
+	return ids
+}
@@ -0,0 +1,114 @@
+package quiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestQuiver_GetSnapshots(t *testing.T) {
+	t.Run("notebooks are converted to collections, trash is skipped", func(t *testing.T) {
+		// given
+		q := &Quiver{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := q.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Quiver, "testdata/Library.qvlibrary"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		cells := convertertest.FindSnapshot(sn.Snapshots, "Cells")
+		plain := convertertest.FindSnapshot(sn.Snapshots, "Plain")
+		notebook := convertertest.FindSnapshot(sn.Snapshots, "Development")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{cells, plain, notebook, root} {
+			require.NotNil(t, s)
+		}
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Deleted"))
+		assert.Nil(t, convertertest.FindSnapshot(sn.Snapshots, "Trash"))
+		assert.Equal(t, []string{cells.Id, plain.Id}, convertertest.CollectionObjects(notebook))
+		assert.Equal(t, []string{notebook.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+	})
+	t.Run("cells are converted to blocks in the same order", func(t *testing.T) {
+		// given
+		q := &Quiver{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := q.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Quiver, "testdata/Library.qvlibrary"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		cells := convertertest.FindSnapshot(sn.Snapshots, "Cells")
+		require.NotNil(t, cells)
+		var contents []string
+		for _, block := range cells.Snapshot.Data.Blocks {
+			switch {
+			case block.GetLatex() != nil:
+				contents = append(contents, "latex:"+block.GetLatex().Text)
+			case block.GetText() != nil && block.GetText().Style == model.BlockContentText_Code:
+				contents = append(contents, "code:"+pbtypes.GetString(block.Fields, "lang")+":"+block.GetText().Text)
+			case block.GetText() != nil:
+				contents = append(contents, "text:"+block.GetText().Text)
+			}
+		}
+		assert.Equal(t, []string{
+			"text:Text cell",
+			`code:golang:fmt.Println("hello")`,
+			`latex:e^{i\pi} + 1 = 0`,
+			"text:Markdown cell",
+		}, contents)
+	})
+	t.Run("tags and dates are taken from note meta", func(t *testing.T) {
+		// given
+		q := &Quiver{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := q.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Quiver, "testdata/Library.qvlibrary"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		cells := convertertest.FindSnapshot(sn.Snapshots, "Cells")
+		plain := convertertest.FindSnapshot(sn.Snapshots, "Plain")
+		goTag := convertertest.FindSnapshot(sn.Snapshots, "go")
+		mathTag := convertertest.FindSnapshot(sn.Snapshots, "math")
+		for _, s := range []*converter.Snapshot{cells, plain, goTag, mathTag} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, smartblock.SmartBlockTypeRelationOption, goTag.SbType)
+		assert.Equal(t, []string{goTag.Id, mathTag.Id}, pbtypes.GetStringList(cells.Snapshot.Data.Details, bundle.RelationKeyTag.String()))
+		assert.Equal(t, []string{goTag.Id}, pbtypes.GetStringList(plain.Snapshot.Data.Details, bundle.RelationKeyTag.String()))
+		assert.Equal(t, int64(1695282000), pbtypes.GetInt64(cells.Snapshot.Data.Details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1695286800), pbtypes.GetInt64(cells.Snapshot.Data.Details, bundle.RelationKeyLastModifiedDate.String()))
+	})
+	t.Run("directory without notes - return error", func(t *testing.T) {
+		// given
+		q := &Quiver{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := q.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Quiver, t.TempDir()), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Quiver), converter.ErrNoObjectsToImport))
+	})
+}
@@ -0,0 +1,181 @@
+package quiver
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+)
+
+const (
+	notebookExt     = ".qvnotebook"
+	noteExt         = ".qvnote"
+	metaFileName    = "meta.json"
+	contentFileName = "content.json"
+	// trashNotebookID is the uuid of the notebook, where Quiver keeps deleted notes
+	trashNotebookID = "Trash"
+)
+
+const (
+	cellTypeText     = "text"
+	cellTypeMarkdown = "markdown"
+	cellTypeCode     = "code"
+	cellTypeLatex    = "latex"
+	cellTypeDiagram  = "diagram"
+)
+
+type notebookMeta struct {
+	Name string `json:"name"`
+	UUID string `json:"uuid"`
+}
+
+type noteMeta struct {
+	Title     string   `json:"title"`
+	UUID      string   `json:"uuid"`
+	Tags      []string `json:"tags"`
+	CreatedAt int64    `json:"created_at"`
+	UpdatedAt int64    `json:"updated_at"`
+}
+
+type noteContent struct {
+	Title string  `json:"title"`
+	Cells []*cell `json:"cells"`
+}
+
+// cell is a part of the note. Data of text cell is HTML, of diagram cell is the source of diagram
+type cell struct {
+	Type     string `json:"type"`
+	Language string `json:"language"`
+	Data     string `json:"data"`
+}
+
+type note struct {
+	path    string
+	meta    *noteMeta
+	content *noteContent
+}
+
+type notebook struct {
+	path  string
+	meta  *notebookMeta
+	notes []*note
+}
+
+// library collects notebooks and notes from files of Quiver library. Notes, which are not inside notebook,
+// are imported without collection
+type library struct {
+	notebooks map[string]*notebook
+	notes     map[string]*note
+	limits    converter.ParseLimits
+}
+
+func newLibrary(limits converter.ParseLimits) *library {
+	return &library{
+		notebooks: map[string]*notebook{},
+		notes:     map[string]*note{},
+		limits:    limits,
+	}
+}
+
+// addFile reads meta or content of note or notebook, other files are skipped
+func (l *library) addFile(fileName string, fileReader io.Reader) error {
+	dir := filepath.Dir(fileName)
+	base := filepath.Base(fileName)
+	switch filepath.Ext(dir) {
+	case notebookExt:
+		if base != metaFileName {
+			return nil
+		}
+		meta := &notebookMeta{}
+		if err := l.decode(fileName, fileReader, meta); err != nil {
+			return err
+		}
+		l.getNotebook(dir).meta = meta
+	case noteExt:
+		switch base {
+		case metaFileName:
+			meta := &noteMeta{}
+			if err := l.decode(fileName, fileReader, meta); err != nil {
+				return err
+			}
+			l.getNote(dir).meta = meta
+		case contentFileName:
+			content := &noteContent{}
+			if err := l.decode(fileName, fileReader, content); err != nil {
+				return err
+			}
+			l.getNote(dir).content = content
+		}
+	}
+	return nil
+}
+
+func (l *library) decode(fileName string, fileReader io.Reader, v interface{}) error {
+	if err := l.limits.DecodeJSON(fileReader, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", fileName, err)
+	}
+	return nil
+}
+
+func (l *library) getNotebook(dir string) *notebook {
+	if nb, ok := l.notebooks[dir]; ok {
+		return nb
+	}
+	nb := &notebook{path: dir}
+	l.notebooks[dir] = nb
+	return nb
+}
+
+func (l *library) getNote(dir string) *note {
+	if n, ok := l.notes[dir]; ok {
+		return n
+	}
+	n := &note{path: dir}
+	l.notes[dir] = n
+	return n
+}
+
+// groupNotes adds notes with content to their notebooks and returns notes, which don't belong to any notebook
+func (l *library) groupNotes() []*note {
+	var looseNotes []*note
+	for _, n := range l.notes {
+		if n.content == nil {
+			continue
+		}
+		if filepath.Ext(filepath.Dir(n.path)) != notebookExt {
+			looseNotes = append(looseNotes, n)
+			continue
+		}
+		nb := l.getNotebook(filepath.Dir(n.path))
+		nb.notes = append(nb.notes, n)
+	}
+	return looseNotes
+}
+
+func (n *note) title() string {
+	if n.meta != nil && n.meta.Title != "" {
+		return n.meta.Title
+	}
+	if n.content.Title != "" {
+		return n.content.Title
+	}
+	return trimExt(n.path)
+}
+
+func (nb *notebook) name() string {
+	if nb.meta != nil && nb.meta.Name != "" {
+		return nb.meta.Name
+	}
+	return trimExt(nb.path)
+}
+
+func (nb *notebook) isTrash() bool {
+	return nb.meta != nil && nb.meta.UUID == trashNotebookID
+}
+
+func trimExt(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
@@ -0,0 +1,103 @@
+package quiver
+
+import (
+	"fmt"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func getNoteSnapshot(n *note, objectType string, relations *converter.RelationCreator) (*converter.Snapshot, error) {
+	blocks, err := getBlocks(n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert note %s: %w", n.title(), err)
+	}
+	details := converter.GetCommonDetails(n.path, n.title(), "", model.ObjectType_basic)
+	var relationLinks []*model.RelationLink
+	if n.meta != nil {
+		if n.meta.CreatedAt != 0 {
+			details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(n.meta.CreatedAt)
+		}
+		if n.meta.UpdatedAt != 0 {
+			details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(n.meta.UpdatedAt)
+		}
+		if tagIDs := relations.OptionIDs(bundle.RelationKeyTag.String(), n.meta.Tags); len(tagIDs) != 0 {
+			details.Fields[bundle.RelationKeyTag.String()] = pbtypes.StringList(tagIDs)
+			relationLinks = append(relationLinks, &model.RelationLink{
+				Key:    bundle.RelationKeyTag.String(),
+				Format: model.RelationFormat_tag,
+			})
+		}
+	}
+	return &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: n.path,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{objectType},
+		}},
+	}, nil
+}
+
+// getBlocks converts cells of the note to blocks, keeping order of cells
+func getBlocks(n *note) ([]*model.Block, error) {
+	var blocks []*model.Block
+	for _, c := range n.content.Cells {
+		cellBlocks, err := getCellBlocks(c, n.path)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, cellBlocks...)
+	}
+	return blocks, nil
+}
+
+func getCellBlocks(c *cell, notePath string) ([]*model.Block, error) {
+	switch c.Type {
+	case cellTypeText:
+		blocks, _, err := anymark.HTMLToBlocks([]byte(c.Data))
+		return blocks, err
+	case cellTypeMarkdown:
+		blocks, _, err := anymark.MarkdownToBlocks([]byte(c.Data), notePath, nil)
+		return blocks, err
+	case cellTypeCode, cellTypeDiagram:
+		return []*model.Block{getCodeBlock(c.Data, c.Language)}, nil
+	case cellTypeLatex:
+		return []*model.Block{{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfLatex{
+				Latex: &model.BlockContentLatex{Text: c.Data},
+			},
+		}}, nil
+	default:
+		log.Warnf("unsupported type of Quiver cell: %s", c.Type)
+		return nil, nil
+	}
+}
+
+func getCodeBlock(code, language string) *model.Block {
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Fields: &types.Struct{
+			Fields: map[string]*types.Value{"lang": pbtypes.String(language)},
+		},
+		Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{
+				Text:  code,
+				Style: model.BlockContentText_Code,
+			},
+		},
+	}
+}
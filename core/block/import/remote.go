@@ -0,0 +1,55 @@
+package importer
+
+import (
+	"context"
+	"os"
+	"reflect"
+
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+// downloadRemotePaths replaces links in paths of request with downloaded files, so converters import them
+// like local files. Returned function removes downloaded files
+func downloadRemotePaths(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (func(), error) {
+	var downloaded []string
+	cleanup := func() {
+		for _, path := range downloaded {
+			if err := os.Remove(path); err != nil {
+				log.Errorf("failed to remove downloaded file: %s", err)
+			}
+		}
+	}
+	paths := requestPaths(req)
+	for idx, path := range paths {
+		if !source.IsRemote(path) {
+			continue
+		}
+		localPath, err := source.DownloadRemote(ctx, path, progress)
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		downloaded = append(downloaded, localPath)
+		paths[idx] = localPath
+	}
+	return cleanup, nil
+}
+
+// requestPaths returns paths from params of request. All params of file based imports have Path field,
+// and params are wrapped into oneof type with the single field
+func requestPaths(req *pb.RpcObjectImportRequest) []string {
+	if req.Params == nil {
+		return nil
+	}
+	wrapper := reflect.ValueOf(req.Params)
+	if wrapper.Kind() != reflect.Pointer || wrapper.IsNil() || wrapper.Elem().NumField() == 0 {
+		return nil
+	}
+	params, ok := wrapper.Elem().Field(0).Interface().(interface{ GetPath() []string })
+	if !ok {
+		return nil
+	}
+	return params.GetPath()
+}
@@ -0,0 +1,169 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	reportName          = "Import report"
+	reportFailedHeader  = "Failed files"
+	reportCreatedHeader = "Imported objects"
+	// maxReportObjects limits number of links to created objects, the rest of them are available in the root collection
+	maxReportObjects = 1000
+)
+
+// reportLayouts are layouts of objects, which are listed in the report. Relations, options, types and files are skipped
+var reportLayouts = map[model.ObjectTypeLayout]bool{
+	model.ObjectType_basic:      true,
+	model.ObjectType_profile:    true,
+	model.ObjectType_todo:       true,
+	model.ObjectType_set:        true,
+	model.ObjectType_collection: true,
+	model.ObjectType_note:       true,
+	model.ObjectType_bookmark:   true,
+}
+
+// shouldCreateReport reports whether import, which finished with errors, created objects, that are worth listing.
+// Canceled imports and imports, which exceed limits, are reported with their errors only
+func shouldCreateReport(req *pb.RpcObjectImportRequest, resultErr error, details map[string]*types.Struct) bool {
+	if !req.CreateReport || req.Mode != pb.RpcObjectImportRequest_IGNORE_ERRORS || resultErr == nil || len(details) == 0 {
+		return false
+	}
+	return !errors.Is(resultErr, converter.ErrCancel) && !errors.Is(resultErr, converter.ErrLimitExceeded)
+}
+
+// createReport creates page with files, which failed to import, and links to created objects, and returns its id.
+// Report belongs to the import run, so it is removed on undo of the import
+func (i *Import) createReport(ctx context.Context,
+	req *pb.RpcObjectImportRequest,
+	allErrors *converter.ConvertError,
+	details map[string]*types.Struct,
+	rootCollectionID string,
+	importRunID string,
+) (string, error) {
+	res := &converter.Response{Snapshots: []*converter.Snapshot{
+		getReportSnapshot(allErrors.Errors(), details, rootCollectionID),
+	}}
+	reportErrors := converter.NewError(pb.RpcObjectImportRequest_ALL_OR_NOTHING)
+	oldIDToNew, createPayloads, err := i.getIDForAllObjects(ctx, res, reportErrors, req)
+	if err != nil {
+		return "", fmt.Errorf("get id of import report: %w", err)
+	}
+	// links of the report point to already created objects
+	for id := range details {
+		oldIDToNew[id] = id
+	}
+	if rootCollectionID != "" {
+		oldIDToNew[rootCollectionID] = rootCollectionID
+	}
+	i.createBatch(ctx, res, process.NewNoOp(), req, reportErrors, model.ObjectOrigin_import, importRunID, oldIDToNew, createPayloads)
+	if !reportErrors.IsEmpty() {
+		return "", fmt.Errorf("create import report: %w", reportErrors.Error())
+	}
+	return oldIDToNew[res.Snapshots[0].Id], nil
+}
+
+func getReportSnapshot(importErrors []error, details map[string]*types.Struct, rootCollectionID string) *converter.Snapshot {
+	id := uuid.New().String()
+	root := &model.Block{Id: id, Content: &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}}
+	blocks := []*model.Block{root}
+	addBlock := func(block *model.Block) {
+		block.Id = bson.NewObjectId().Hex()
+		root.ChildrenIds = append(root.ChildrenIds, block.Id)
+		blocks = append(blocks, block)
+	}
+
+	addBlock(newReportText(reportFailedHeader, model.BlockContentText_Header2))
+	for _, err := range importErrors {
+		if err == nil {
+			continue
+		}
+		var fileErr *converter.FileError
+		if !errors.As(err, &fileErr) || fileErr.FileName == "" {
+			addBlock(newReportText(err.Error(), model.BlockContentText_Marked))
+			continue
+		}
+		block := newReportText(fileErr.FileName+": "+fileErr.Err.Error(), model.BlockContentText_Marked)
+		block.GetText().Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+			Range: &model.Range{From: 0, To: int32(text.UTF16RuneCountString(fileErr.FileName))},
+			Type:  model.BlockContentTextMark_Bold,
+		}}}
+		addBlock(block)
+	}
+
+	addBlock(newReportText(reportCreatedHeader, model.BlockContentText_Header2))
+	if rootCollectionID != "" {
+		addBlock(newReportLink(rootCollectionID))
+	}
+	objects := getReportObjects(details, rootCollectionID)
+	for n, objectID := range objects {
+		if n == maxReportObjects {
+			addBlock(newReportText(fmt.Sprintf("and %d more", len(objects)-maxReportObjects), model.BlockContentText_Paragraph))
+			break
+		}
+		addBlock(newReportLink(objectID))
+	}
+
+	return &converter.Snapshot{
+		Id:     id,
+		SbType: smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks: blocks,
+			Details: &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():   pbtypes.String(reportName),
+				bundle.RelationKeyLayout.String(): pbtypes.Float64(float64(model.ObjectType_basic)),
+			}},
+			ObjectTypes: []string{bundle.TypeKeyPage.String()},
+		}},
+	}
+}
+
+// getReportObjects returns ids of created objects sorted by name
+func getReportObjects(details map[string]*types.Struct, rootCollectionID string) []string {
+	objects := make([]string, 0, len(details))
+	for id, objectDetails := range details {
+		if id == "" || id == rootCollectionID || objectDetails == nil {
+			continue
+		}
+		layout := model.ObjectTypeLayout(pbtypes.GetInt64(objectDetails, bundle.RelationKeyLayout.String()))
+		if reportLayouts[layout] {
+			objects = append(objects, id)
+		}
+	}
+	sort.Slice(objects, func(a, b int) bool {
+		nameA := pbtypes.GetString(details[objects[a]], bundle.RelationKeyName.String())
+		nameB := pbtypes.GetString(details[objects[b]], bundle.RelationKeyName.String())
+		if nameA != nameB {
+			return nameA < nameB
+		}
+		return objects[a] < objects[b]
+	})
+	return objects
+}
+
+func newReportText(value string, style model.BlockContentTextStyle) *model.Block {
+	return &model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: value, Style: style}}}
+}
+
+func newReportLink(targetID string) *model.Block {
+	return &model.Block{Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+		TargetBlockId: targetID,
+		Style:         model.BlockContentLink_Page,
+	}}}
+}
@@ -0,0 +1,171 @@
+package roam
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+const (
+	Name               = "Roam"
+	rootCollectionName = "Roam Import"
+	exportExtension    = ".json"
+)
+
+// Roam imports JSON exports of Roam Research graphs. Pages become objects with nested blocks, links to pages
+// and block references become links to objects
+type Roam struct {
+	service     *collection.Service
+	parseLimits converter.ParseLimits
+}
+
+func New(service *collection.Service) converter.Converter {
+	return &Roam{service: service, parseLimits: converter.DefaultParseLimits}
+}
+
+func (r *Roam) Name() string {
+	return Name
+}
+
+func (r *Roam) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetRoamParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (r *Roam) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*converter.Response, *converter.ConvertError) {
+	paths := r.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from Roam pages")
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	snapshots, targetObjects := r.getSnapshots(req, paths, progress, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := converter.NewRootCollection(r.service)
+	rootCol, err := rootCollection.MakeRootCollection(rootCollectionName, targetObjects)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &converter.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, allErrors
+}
+
+func (r *Roam) getSnapshots(req *pb.RpcObjectImportRequest,
+	paths []string,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	snapshots := make([]*converter.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, r.parseLimits)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(converter.ErrCancel)
+			return nil, nil
+		}
+		sn, to := r.handleImportPath(p, converter.SourceFilter(req), len(paths), converter.ObjectTypeKey(req, defaultObjectType), limits, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+type exportFile struct {
+	fileName string
+	pages    []*page
+}
+
+func (r *Roam) handleImportPath(importPath string,
+	filter *source.Filter,
+	pathsCount int,
+	objectType string,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(importPath))
+	if importSource == nil {
+		allErrors.Add(fmt.Errorf("failed to identify source: %s", importPath))
+		return nil, nil
+	}
+	defer importSource.Close()
+	if err := importSource.Initialize(importPath); err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Roam) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{exportExtension}) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	builder := newSnapshotBuilder(objectType)
+	var files []exportFile
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), exportExtension) {
+			return true
+		}
+		var pages []*page
+		if err := limits.DecodeJSON(fileReader, &pages); err != nil {
+			allErrors.Add(converter.NewFileError(fileName, fmt.Errorf("parse Roam export: %w", err)))
+			return !allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Roam)
+		}
+		builder.addPages(pages)
+		files = append(files, exportFile{fileName: fileName, pages: pages})
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	snapshots := make([]*converter.Snapshot, 0)
+	pageIDs := make([]string, 0)
+	added := make(map[string]struct{})
+	for _, f := range files {
+		for _, p := range f.pages {
+			if p.Title == "" {
+				continue
+			}
+			// the same page may be exported to several files, only the first one is imported
+			if _, ok := added[p.Title]; ok {
+				continue
+			}
+			added[p.Title] = struct{}{}
+			sn := builder.getSnapshot(p, f.fileName)
+			snapshots = append(snapshots, sn)
+			pageIDs = append(pageIDs, sn.Id)
+		}
+	}
+	if len(snapshots) == 0 {
+		allErrors.Add(converter.ErrNoObjectsToImport)
+		return nil, nil
+	}
+	return snapshots, pageIDs
+}
@@ -0,0 +1,115 @@
+package roam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestRoam_GetSnapshots(t *testing.T) {
+	t.Run("pages are objects with nested blocks", func(t *testing.T) {
+		// given
+		r := &Roam{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := r.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Roam, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		project := convertertest.FindSnapshot(sn.Snapshots, "Project")
+		ideas := convertertest.FindSnapshot(sn.Snapshots, "Ideas")
+		daily := convertertest.FindSnapshot(sn.Snapshots, "2024-01-02")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{project, ideas, daily, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{project.Id, ideas.Id, daily.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, int64(1704103200), pbtypes.GetInt64(project.Snapshot.Data.Details, bundle.RelationKeyCreatedDate.String()))
+		assert.Equal(t, int64(1704189600), pbtypes.GetInt64(project.Snapshot.Data.Details, bundle.RelationKeyLastModifiedDate.String()))
+		assert.Equal(t, int64(1704153600), pbtypes.GetInt64(daily.Snapshot.Data.Details, bundle.RelationKeyCreatedDate.String()))
+
+		blocks := project.Snapshot.Data.Blocks
+		require.Len(t, blocks, 4)
+		goals := blocks[0].GetText()
+		assert.Equal(t, "Goals", goals.Text)
+		assert.Equal(t, model.BlockContentText_Header2, goals.Style)
+		assert.Equal(t, []string{blocks[1].Id, blocks[2].Id}, blocks[0].ChildrenIds)
+		assert.Equal(t, model.BlockContentText_Marked, blocks[1].GetText().Style)
+		assert.Equal(t, "Write plan", blocks[2].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks[2].GetText().Style)
+		assert.True(t, blocks[2].GetText().Checked)
+		assert.Equal(t, "Next step", blocks[3].GetText().Text)
+		assert.Empty(t, blocks[3].ChildrenIds)
+	})
+	t.Run("links and block references are links to objects", func(t *testing.T) {
+		// given
+		r := &Roam{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := r.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Roam, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		project := convertertest.FindSnapshot(sn.Snapshots, "Project")
+		ideas := convertertest.FindSnapshot(sn.Snapshots, "Ideas")
+		require.NotNil(t, project)
+		require.NotNil(t, ideas)
+
+		ship := project.Snapshot.Data.Blocks[1].GetText()
+		assert.Equal(t, "Ship it, see Ideas", ship.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 13, To: 18}, Type: model.BlockContentTextMark_Mention, Param: ideas.Id},
+		}, ship.Marks.Marks)
+
+		idea := ideas.Snapshot.Data.Blocks[0].GetText()
+		assert.Equal(t, "As said in Ship it, see [[Ideas]], tag Project and [[Missing]]", idea.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 11, To: 33}, Type: model.BlockContentTextMark_Object, Param: project.Id},
+			{Range: &model.Range{From: 39, To: 46}, Type: model.BlockContentTextMark_Mention, Param: project.Id},
+		}, idea.Marks.Marks)
+	})
+	t.Run("every page of the file has its own source path", func(t *testing.T) {
+		// given
+		r := &Roam{parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := r.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Roam, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		project := convertertest.FindSnapshot(sn.Snapshots, "Project")
+		ideas := convertertest.FindSnapshot(sn.Snapshots, "Ideas")
+		require.NotNil(t, project)
+		require.NotNil(t, ideas)
+		projectPath := pbtypes.GetString(project.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		ideasPath := pbtypes.GetString(ideas.Snapshot.Data.Details, bundle.RelationKeySourceFilePath.String())
+		assert.Regexp(t, `graph\.json/Project$`, projectPath)
+		assert.Regexp(t, `graph\.json/ideas-page$`, ideasPath)
+	})
+}
+
+func TestParseDailyNoteTitle(t *testing.T) {
+	day, ok := parseDailyNoteTitle("March 23rd, 2023")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, time.March, 23, 0, 0, 0, 0, time.UTC), day)
+
+	_, ok = parseDailyNoteTitle("Meeting notes")
+	assert.False(t, ok)
+}
@@ -0,0 +1,250 @@
+package roam
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const dayLayout = "2006-01-02"
+
+// dailyNoteRegexp matches titles of Roam daily notes, e.g. January 2nd, 2024
+var dailyNoteRegexp = regexp.MustCompile(`^([A-Z][a-z]+) (\d{1,2})(?:st|nd|rd|th), (\d{4})$`)
+
+var todoPrefixes = map[string]bool{
+	"{{[[TODO]]}}": false,
+	"{{TODO}}":     false,
+	"{{[[DONE]]}}": true,
+	"{{DONE}}":     true,
+}
+
+type page struct {
+	Title      string   `json:"title"`
+	UID        string   `json:"uid"`
+	Children   []*block `json:"children"`
+	CreateTime int64    `json:"create-time"`
+	EditTime   int64    `json:"edit-time"`
+}
+
+type block struct {
+	String   string   `json:"string"`
+	UID      string   `json:"uid"`
+	Heading  int      `json:"heading"`
+	Children []*block `json:"children"`
+}
+
+type blockRef struct {
+	pageID string
+	text   string
+}
+
+// snapshotBuilder creates snapshots of pages of one import path. Links are resolved between all pages of the path
+type snapshotBuilder struct {
+	objectType string
+	// pageIDs maps title of page to its object id
+	pageIDs map[string]string
+	// blockRefs maps uid of block to the page of block and its text
+	blockRefs map[string]blockRef
+}
+
+func newSnapshotBuilder(objectType string) *snapshotBuilder {
+	return &snapshotBuilder{
+		objectType: objectType,
+		pageIDs:    map[string]string{},
+		blockRefs:  map[string]blockRef{},
+	}
+}
+
+// addPages registers pages, so links to them can be resolved before pages are converted
+func (b *snapshotBuilder) addPages(pages []*page) {
+	for _, p := range pages {
+		if p.Title == "" {
+			continue
+		}
+		id, ok := b.pageIDs[p.Title]
+		if !ok {
+			id = uuid.New().String()
+			b.pageIDs[p.Title] = id
+		}
+		b.addBlockRefs(id, p.Children)
+	}
+}
+
+func (b *snapshotBuilder) addBlockRefs(pageID string, blocks []*block) {
+	for _, bl := range blocks {
+		if bl.UID != "" {
+			b.blockRefs[bl.UID] = blockRef{pageID: pageID, text: bl.String}
+		}
+		b.addBlockRefs(pageID, bl.Children)
+	}
+}
+
+// sourcePath returns the path of the page in the export file, so pages are matched to their objects, when the file
+// is imported again. Pages of old exports have no uid, but their titles are unique too
+func (p *page) sourcePath(fileName string) string {
+	if p.UID != "" {
+		return fileName + "/" + p.UID
+	}
+	return fileName + "/" + p.Title
+}
+
+func (b *snapshotBuilder) getSnapshot(p *page, fileName string) *converter.Snapshot {
+	title := p.Title
+	var dailyNote time.Time
+	if day, ok := parseDailyNoteTitle(p.Title); ok {
+		title = day.Format(dayLayout)
+		dailyNote = day
+	}
+	details := converter.GetCommonDetails(p.sourcePath(fileName), title, "", model.ObjectType_basic)
+	if p.CreateTime != 0 {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(p.CreateTime / 1000)
+	} else if !dailyNote.IsZero() {
+		details.Fields[bundle.RelationKeyCreatedDate.String()] = pbtypes.Int64(dailyNote.Unix())
+	}
+	if p.EditTime != 0 {
+		details.Fields[bundle.RelationKeyLastModifiedDate.String()] = pbtypes.Int64(p.EditTime / 1000)
+	}
+	var blocks []*model.Block
+	for _, child := range p.Children {
+		blocks = b.appendBlocks(blocks, child)
+	}
+	return &converter.Snapshot{
+		Id:       b.pageIDs[p.Title],
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:      blocks,
+			Details:     details,
+			ObjectTypes: []string{b.objectType},
+		}},
+	}
+}
+
+// appendBlocks adds the block and its children to the flat list of blocks. Nesting is kept by children ids
+func (b *snapshotBuilder) appendBlocks(blocks []*model.Block, bl *block) []*model.Block {
+	content := &model.BlockContentText{Style: model.BlockContentText_Marked}
+	s := bl.String
+	for prefix, checked := range todoPrefixes {
+		if strings.HasPrefix(s, prefix) {
+			s = strings.TrimSpace(strings.TrimPrefix(s, prefix))
+			content.Style = model.BlockContentText_Checkbox
+			content.Checked = checked
+			break
+		}
+	}
+	switch bl.Heading {
+	case 1:
+		content.Style = model.BlockContentText_Header1
+	case 2:
+		content.Style = model.BlockContentText_Header2
+	case 3:
+		content.Style = model.BlockContentText_Header3
+	}
+	var marks []*model.BlockContentTextMark
+	content.Text, marks = b.convertText(s)
+	if len(marks) > 0 {
+		content.Marks = &model.BlockContentTextMarks{Marks: marks}
+	}
+	newBlock := &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: content},
+	}
+	blocks = append(blocks, newBlock)
+	for _, child := range bl.Children {
+		childIdx := len(blocks)
+		blocks = b.appendBlocks(blocks, child)
+		newBlock.ChildrenIds = append(newBlock.ChildrenIds, blocks[childIdx].Id)
+	}
+	return blocks
+}
+
+// convertText replaces [[page]] and #[[page]] links with mentions of pages and ((uid)) block references
+// with text of referenced blocks linked to their pages. Unknown links are kept as is
+func (b *snapshotBuilder) convertText(s string) (string, []*model.BlockContentTextMark) {
+	var (
+		out   strings.Builder
+		marks []*model.BlockContentTextMark
+	)
+	addLink := func(text string, markType model.BlockContentTextMarkType, param string) {
+		from := int32(textutil.UTF16RuneCountString(out.String()))
+		out.WriteString(text)
+		marks = append(marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: from, To: int32(textutil.UTF16RuneCountString(out.String()))},
+			Type:  markType,
+			Param: param,
+		})
+	}
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case strings.HasPrefix(rest, "[[") || strings.HasPrefix(rest, "#[["):
+			start := strings.Index(rest, "[[")
+			end := closingBrackets(rest, start)
+			if end < 0 {
+				break
+			}
+			title := rest[start+2 : end]
+			if id, ok := b.pageIDs[title]; ok {
+				addLink(title, model.BlockContentTextMark_Mention, id)
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(rest, "(("):
+			end := strings.Index(rest, "))")
+			if end < 0 {
+				break
+			}
+			if ref, ok := b.blockRefs[rest[2:end]]; ok {
+				addLink(ref.text, model.BlockContentTextMark_Object, ref.pageID)
+				i += end + 2
+				continue
+			}
+		}
+		out.WriteByte(s[i])
+		i++
+	}
+	return out.String(), marks
+}
+
+// closingBrackets returns the index of ]] which closes [[ at start, nested links are skipped
+func closingBrackets(s string, start int) int {
+	depth := 0
+	for i := start; i+1 < len(s); i++ {
+		switch s[i : i+2] {
+		case "[[":
+			depth++
+			i++
+		case "]]":
+			depth--
+			if depth == 0 {
+				return i
+			}
+			i++
+		}
+	}
+	return -1
+}
+
+// parseDailyNoteTitle returns the day of Roam daily note
+func parseDailyNoteTitle(title string) (time.Time, bool) {
+	match := dailyNoteRegexp.FindStringSubmatch(title)
+	if match == nil {
+		return time.Time{}, false
+	}
+	day, err := time.Parse("January 2 2006", match[1]+" "+match[2]+" "+match[3])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return day, true
+}
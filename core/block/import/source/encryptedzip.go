@@ -0,0 +1,492 @@
+package source
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+var (
+	ErrPasswordRequired = errors.New("archive is encrypted, password is required")
+	ErrWrongPassword    = errors.New("wrong password of archive")
+)
+
+const (
+	encryptedFlag      = 0x1
+	dataDescriptorFlag = 0x8
+
+	// aesMethod and aesExtraID mark entries encrypted by WinZip AES, the real compression method is kept in extra field
+	aesMethod  = 99
+	aesExtraID = 0x9901
+
+	zipCryptoHeaderLen = 12
+	aesVerifierLen     = 2
+	aesAuthCodeLen     = 10
+	aesIterations      = 1000
+)
+
+// IsEncryptedZip returns true, if some entry of zip archive is encrypted
+func IsEncryptedZip(importPath string) (bool, error) {
+	archiveReader, err := zip.OpenReader(importPath)
+	if err != nil {
+		return false, err
+	}
+	defer archiveReader.Close()
+	for _, f := range archiveReader.File {
+		if f.Flags&encryptedFlag != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DecryptZip writes content of encrypted zip archive to temporary unencrypted archive and returns its path,
+// so the archive is imported by Zip source like any other one. Both traditional PKWARE encryption and WinZip AES
+// are supported. Caller removes the file
+func DecryptZip(importPath, password string) (string, error) {
+	if password == "" {
+		return "", ErrPasswordRequired
+	}
+	archiveReader, err := zip.OpenReader(importPath)
+	if err != nil {
+		return "", err
+	}
+	defer archiveReader.Close()
+	if err = DefaultArchiveLimits.checkFiles(archiveReader.File); err != nil {
+		return "", err
+	}
+	tempFile, err := os.CreateTemp("", "import-*-"+filepath.Base(importPath))
+	if err != nil {
+		return "", oserror.TransformError(err)
+	}
+	if err = decryptFiles(archiveReader.File, tempFile, []byte(password)); err != nil {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+		return "", err
+	}
+	if err = tempFile.Close(); err != nil {
+		os.Remove(tempFile.Name())
+		return "", oserror.TransformError(err)
+	}
+	return tempFile.Name(), nil
+}
+
+func decryptFiles(files []*zip.File, target io.Writer, password []byte) error {
+	writer := zip.NewWriter(target)
+	for _, f := range files {
+		if isServiceEntry(f) || f.FileInfo().IsDir() {
+			continue
+		}
+		if err := decryptFile(f, writer, password); err != nil {
+			return fmt.Errorf("decrypt %s: %w", f.Name, err)
+		}
+	}
+	return writer.Close()
+}
+
+func decryptFile(f *zip.File, writer *zip.Writer, password []byte) error {
+	content, err := openDecrypted(f, password)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	fileWriter, err := writer.CreateHeader(&zip.FileHeader{
+		Name:     f.Name,
+		Comment:  f.Comment,
+		NonUTF8:  f.NonUTF8,
+		Method:   zip.Deflate,
+		Modified: f.Modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fileWriter, content)
+	return err
+}
+
+// openDecrypted returns decrypted and decompressed content of the entry. Size and checksum of content
+// are checked at the end of reading, so corrupted or forged entries fail with error
+func openDecrypted(f *zip.File, password []byte) (io.ReadCloser, error) {
+	if f.Flags&encryptedFlag == 0 {
+		return f.Open()
+	}
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		decrypted io.Reader
+		method    = f.Method
+		checkCRC  = true
+	)
+	if f.Method == aesMethod {
+		var version uint16
+		decrypted, method, version, err = newAESReader(f, raw, password)
+		// CRC isn't stored by AE-2, authentication code is checked instead
+		checkCRC = version == 1
+	} else {
+		decrypted, err = newZipCryptoReader(f, raw, password)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var content io.ReadCloser
+	switch method {
+	case zip.Store:
+		content = io.NopCloser(decrypted)
+	case zip.Deflate:
+		content = flate.NewReader(decrypted)
+	default:
+		return nil, zip.ErrAlgorithm
+	}
+	return &checksumReader{
+		ReadCloser: content,
+		hash:       crc32.NewIEEE(),
+		crc:        f.CRC32,
+		checkCRC:   checkCRC,
+		size:       f.UncompressedSize64,
+	}, nil
+}
+
+type checksumReader struct {
+	io.ReadCloser
+	hash     hash.Hash32
+	crc      uint32
+	checkCRC bool
+	size     uint64
+	read     uint64
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	r.read += uint64(n)
+	if r.read > r.size {
+		return 0, zip.ErrFormat
+	}
+	if errors.Is(err, io.EOF) {
+		if r.read != r.size || (r.checkCRC && r.hash.Sum32() != r.crc) {
+			return n, zip.ErrChecksum
+		}
+	}
+	return n, err
+}
+
+// zipCryptoReader decrypts traditional PKWARE encryption
+type zipCryptoReader struct {
+	reader io.Reader
+	keys   [3]uint32
+}
+
+func newZipCryptoReader(f *zip.File, raw io.Reader, password []byte) (io.Reader, error) {
+	r := &zipCryptoReader{reader: raw, keys: [3]uint32{0x12345678, 0x23456789, 0x34567890}}
+	for _, b := range password {
+		r.update(b)
+	}
+	header := make([]byte, zipCryptoHeaderLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	// the last byte of header is the high byte of CRC or, if CRC is written after content, of modification time
+	check := byte(f.CRC32 >> 24)
+	if f.Flags&dataDescriptorFlag != 0 {
+		check = byte(f.ModifiedTime >> 8)
+	}
+	if header[zipCryptoHeaderLen-1] != check {
+		return nil, ErrWrongPassword
+	}
+	return r, nil
+}
+
+func (r *zipCryptoReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	for i := 0; i < n; i++ {
+		temp := uint16(r.keys[2] | 2)
+		p[i] ^= byte((uint32(temp) * uint32(temp^1)) >> 8)
+		r.update(p[i])
+	}
+	return n, err
+}
+
+func (r *zipCryptoReader) update(b byte) {
+	r.keys[0] = crc32Update(r.keys[0], b)
+	r.keys[1] = (r.keys[1]+r.keys[0]&0xff)*134775813 + 1
+	r.keys[2] = crc32Update(r.keys[2], byte(r.keys[1]>>24))
+}
+
+func crc32Update(crc uint32, b byte) uint32 {
+	return crc32.IEEETable[byte(crc)^b] ^ (crc >> 8)
+}
+
+// aesReader decrypts WinZip AES encryption: AES in counter mode with little endian counter,
+// content is authenticated by HMAC-SHA1 of encrypted data
+type aesReader struct {
+	*aesCTR
+	reader io.Reader
+	raw    io.Reader
+	mac    hash.Hash
+}
+
+// aesCTR is AES in counter mode with little endian counter, which starts from 1, as WinZip AES uses it
+type aesCTR struct {
+	block    cipher.Block
+	counter  [aes.BlockSize]byte
+	stream   [aes.BlockSize]byte
+	position int
+}
+
+func newAESCTR(block cipher.Block) *aesCTR {
+	return &aesCTR{block: block, position: aes.BlockSize}
+}
+
+func newAESReader(f *zip.File, raw io.Reader, password []byte) (reader io.Reader, method uint16, version uint16, err error) {
+	version, strength, method, err := parseAESExtra(f.Extra)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	keyLen := 8 * (int(strength) + 1)
+	saltLen := keyLen / 2
+	overhead := uint64(saltLen + aesVerifierLen + aesAuthCodeLen)
+	if f.CompressedSize64 < overhead {
+		return nil, 0, 0, zip.ErrFormat
+	}
+	salt := make([]byte, saltLen+aesVerifierLen)
+	if _, err = io.ReadFull(raw, salt); err != nil {
+		return nil, 0, 0, err
+	}
+	keys := pbkdf2.Key(password, salt[:saltLen], aesIterations, 2*keyLen+aesVerifierLen, sha1.New)
+	if subtle.ConstantTimeCompare(keys[2*keyLen:], salt[saltLen:]) != 1 {
+		return nil, 0, 0, ErrWrongPassword
+	}
+	block, err := aes.NewCipher(keys[:keyLen])
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	r := &aesReader{
+		aesCTR: newAESCTR(block),
+		reader: io.LimitReader(raw, int64(f.CompressedSize64-overhead)),
+		raw:    raw,
+		mac:    hmac.New(sha1.New, keys[keyLen:2*keyLen]),
+	}
+	return r, method, version, nil
+}
+
+// parseAESExtra returns version, key strength and compression method of the entry encrypted by WinZip AES
+func parseAESExtra(extra []byte) (version uint16, strength byte, method uint16, err error) {
+	for len(extra) >= 4 {
+		id := binary.LittleEndian.Uint16(extra)
+		size := int(binary.LittleEndian.Uint16(extra[2:]))
+		extra = extra[4:]
+		if size > len(extra) {
+			break
+		}
+		if id == aesExtraID && size >= 7 {
+			version = binary.LittleEndian.Uint16(extra)
+			strength = extra[4]
+			method = binary.LittleEndian.Uint16(extra[5:])
+			if strength < 1 || strength > 3 {
+				return 0, 0, 0, zip.ErrAlgorithm
+			}
+			return version, strength, method, nil
+		}
+		extra = extra[size:]
+	}
+	return 0, 0, 0, zip.ErrFormat
+}
+
+func (r *aesReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.mac.Write(p[:n])
+	r.xor(p[:n])
+	if errors.Is(err, io.EOF) {
+		authCode := make([]byte, aesAuthCodeLen)
+		if _, readErr := io.ReadFull(r.raw, authCode); readErr != nil {
+			return n, readErr
+		}
+		if !bytes.Equal(r.mac.Sum(nil)[:aesAuthCodeLen], authCode) {
+			return n, zip.ErrChecksum
+		}
+	}
+	return n, err
+}
+
+func (c *aesCTR) xor(p []byte) {
+	for i := range p {
+		if c.position == aes.BlockSize {
+			c.nextBlock()
+		}
+		p[i] ^= c.stream[c.position]
+		c.position++
+	}
+}
+
+func (c *aesCTR) nextBlock() {
+	for i := range c.counter {
+		c.counter[i]++
+		if c.counter[i] != 0 {
+			break
+		}
+	}
+	c.block.Encrypt(c.stream[:], c.counter[:])
+	c.position = 0
+}
+
+const (
+	// aesVersion2 is AE-2 format, which doesn't store CRC of content, so nothing about plain content is revealed
+	aesVersion2 = 2
+	// aesStrength256 is strength of AES-256 key
+	aesStrength256 = 3
+	aesKeyLen256   = 32
+)
+
+// EncryptZip writes entries of zip archive to the target archive encrypted by WinZip AES-256, which is opened
+// by DecryptZip and common archivers. Compressed content of entries is encrypted as is, without recompression
+func EncryptZip(archivePath, targetPath, password string) error {
+	if password == "" {
+		return ErrPasswordRequired
+	}
+	archiveReader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer archiveReader.Close()
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	if err = encryptFiles(archiveReader.File, target, []byte(password)); err != nil {
+		target.Close()
+		os.Remove(targetPath)
+		return err
+	}
+	if err = target.Close(); err != nil {
+		os.Remove(targetPath)
+		return oserror.TransformError(err)
+	}
+	return nil
+}
+
+func encryptFiles(files []*zip.File, target io.Writer, password []byte) error {
+	writer := zip.NewWriter(target)
+	for _, f := range files {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if err := encryptFile(f, writer, password); err != nil {
+			return fmt.Errorf("encrypt %s: %w", f.Name, err)
+		}
+	}
+	return writer.Close()
+}
+
+// encryptFile writes encrypted content to temporary file first, because size of encrypted content is written
+// to the header before the content
+func encryptFile(f *zip.File, writer *zip.Writer, password []byte) error {
+	if f.Flags&encryptedFlag != 0 {
+		return fmt.Errorf("entry is already encrypted")
+	}
+	raw, err := f.OpenRaw()
+	if err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp("", "encrypt-*")
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	defer os.Remove(temp.Name())
+	defer temp.Close()
+	size, err := writeAESEncrypted(temp, raw, password)
+	if err != nil {
+		return err
+	}
+	if _, err = temp.Seek(0, io.SeekStart); err != nil {
+		return oserror.TransformError(err)
+	}
+	header := f.FileHeader
+	header.Flags = f.Flags&^dataDescriptorFlag | encryptedFlag
+	header.Method = aesMethod
+	header.CRC32 = 0
+	header.CompressedSize64 = size
+	header.Extra = aesExtra(f.Method)
+	fileWriter, err := writer.CreateRaw(&header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fileWriter, temp)
+	return err
+}
+
+// writeAESEncrypted writes salt, password verifier, encrypted content and its authentication code
+// and returns number of written bytes
+func writeAESEncrypted(w io.Writer, content io.Reader, password []byte) (uint64, error) {
+	salt := make([]byte, aesKeyLen256/2)
+	if _, err := rand.Read(salt); err != nil {
+		return 0, err
+	}
+	keys := pbkdf2.Key(password, salt, aesIterations, 2*aesKeyLen256+aesVerifierLen, sha1.New)
+	block, err := aes.NewCipher(keys[:aesKeyLen256])
+	if err != nil {
+		return 0, err
+	}
+	ctr := newAESCTR(block)
+	mac := hmac.New(sha1.New, keys[aesKeyLen256:2*aesKeyLen256])
+	if _, err = w.Write(salt); err != nil {
+		return 0, err
+	}
+	if _, err = w.Write(keys[2*aesKeyLen256:]); err != nil {
+		return 0, err
+	}
+	size := uint64(len(salt) + aesVerifierLen + aesAuthCodeLen)
+	buf := make([]byte, 32<<10)
+	for {
+		n, readErr := content.Read(buf)
+		if n > 0 {
+			ctr.xor(buf[:n])
+			mac.Write(buf[:n])
+			if _, err = w.Write(buf[:n]); err != nil {
+				return 0, err
+			}
+			size += uint64(n)
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return 0, readErr
+		}
+	}
+	if _, err = w.Write(mac.Sum(nil)[:aesAuthCodeLen]); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// aesExtra returns extra field of WinZip AES, which keeps the real compression method of the entry
+func aesExtra(method uint16) []byte {
+	extra := make([]byte, 11)
+	binary.LittleEndian.PutUint16(extra, aesExtraID)
+	binary.LittleEndian.PutUint16(extra[2:], 7)
+	binary.LittleEndian.PutUint16(extra[4:], aesVersion2)
+	copy(extra[6:], "AE")
+	extra[8] = aesStrength256
+	binary.LittleEndian.PutUint16(extra[9:], method)
+	return extra
+}
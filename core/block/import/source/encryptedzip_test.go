@@ -0,0 +1,117 @@
+package source
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptZip(t *testing.T) {
+	for _, archive := range []string{"testdata/zipcrypto.zip", "testdata/aes.zip"} {
+		t.Run("files are decrypted from "+archive, func(t *testing.T) {
+			// given
+			encrypted, err := IsEncryptedZip(archive)
+			require.NoError(t, err)
+			require.True(t, encrypted)
+
+			// when
+			path, err := DecryptZip(archive, "secret")
+
+			// then
+			require.NoError(t, err)
+			defer os.Remove(path)
+			z := NewZip()
+			defer z.Close()
+			require.NoError(t, z.Initialize(path))
+			contents := make(map[string]string)
+			require.NoError(t, z.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+				content, err := io.ReadAll(fileReader)
+				require.NoError(t, err)
+				contents[fileName] = string(content)
+				return true
+			}))
+			assert.Equal(t, map[string]string{
+				"notes/a.md": "hello from encrypted archive",
+				"notes/b.md": strings.Repeat("word ", 300),
+			}, contents)
+		})
+		t.Run("wrong password - error for "+archive, func(t *testing.T) {
+			// when
+			_, err := DecryptZip(archive, "wrong")
+
+			// then
+			assert.ErrorIs(t, err, ErrWrongPassword)
+		})
+		t.Run("empty password - error for "+archive, func(t *testing.T) {
+			// when
+			_, err := DecryptZip(archive, "")
+
+			// then
+			assert.ErrorIs(t, err, ErrPasswordRequired)
+		})
+	}
+	t.Run("archive isn't encrypted", func(t *testing.T) {
+		// given
+		archive := writeZip(t, map[string]string{"a.md": "a"})
+
+		// when
+		encrypted, err := IsEncryptedZip(archive)
+
+		// then
+		assert.NoError(t, err)
+		assert.False(t, encrypted)
+	})
+}
+
+func TestEncryptZip(t *testing.T) {
+	t.Run("encrypted archive is decrypted with the same password", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"notes/a.md": strings.Repeat("text of note ", 1000), "b.txt": "b"})
+		encryptedPath := filepath.Join(t.TempDir(), "encrypted.zip")
+
+		// when
+		err := EncryptZip(archivePath, encryptedPath, "secret")
+
+		// then
+		require.NoError(t, err)
+		encrypted, err := IsEncryptedZip(encryptedPath)
+		require.NoError(t, err)
+		assert.True(t, encrypted)
+		decryptedPath, err := DecryptZip(encryptedPath, "secret")
+		require.NoError(t, err)
+		defer os.Remove(decryptedPath)
+		archive, err := zip.OpenReader(decryptedPath)
+		require.NoError(t, err)
+		defer archive.Close()
+		f, err := archive.Open("notes/a.md")
+		require.NoError(t, err)
+		content, err := io.ReadAll(f)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("text of note ", 1000), string(content))
+	})
+	t.Run("wrong password - error", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"a.md": "a"})
+		encryptedPath := filepath.Join(t.TempDir(), "encrypted.zip")
+		require.NoError(t, EncryptZip(archivePath, encryptedPath, "secret"))
+
+		// when
+		_, err := DecryptZip(encryptedPath, "wrong")
+
+		// then
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+	t.Run("empty password - error", func(t *testing.T) {
+		// when
+		err := EncryptZip(writeZip(t, map[string]string{"a.md": "a"}), filepath.Join(t.TempDir(), "encrypted.zip"), "")
+
+		// then
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+	})
+}
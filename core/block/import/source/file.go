@@ -45,6 +45,10 @@ func (f *File) ProcessFile(fileName string, callback func(fileReader io.ReadClos
 	return callback(fileReader)
 }
 
+func (f *File) fileNames() []string {
+	return []string{f.fileName}
+}
+
 func (f *File) CountFilesWithGivenExtensions(extension []string) int {
 	if lo.Contains(extension, filepath.Ext(f.fileName)) {
 		return 1
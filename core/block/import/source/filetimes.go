@@ -0,0 +1,38 @@
+package source
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/import/converter/filetime"
+)
+
+// fileTimesProvider is implemented by archives, which keep modification time of entries in their headers
+type fileTimesProvider interface {
+	modificationTime(fileName string) int64
+}
+
+// FileTimes returns creation and modification time of the file of the source in seconds. Archives don't keep
+// creation time of entries, so modification time is used for both. Zero is returned for unknown time
+func FileTimes(s Source, fileName string) (created, modified int64) {
+	switch wrapped := s.(type) {
+	case *filteredSource:
+		s = wrapped.Source
+	case *filteredOpener:
+		s = wrapped.Source
+	}
+	if p, ok := s.(fileTimesProvider); ok {
+		modified = p.modificationTime(fileName)
+		return modified, modified
+	}
+	return filetime.ExtractFileTimes(fileName)
+}
+
+func (t *Tar) modificationTime(fileName string) int64 {
+	return t.entries[fileName].modified
+}
+
+func (z *Zip) modificationTime(fileName string) int64 {
+	f, ok := z.fileReaders[fileName]
+	if !ok || f.Modified.IsZero() {
+		return 0
+	}
+	return f.Modified.Unix()
+}
@@ -0,0 +1,52 @@
+package source
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTimes(t *testing.T) {
+	t.Run("modification time of zip entry is used", func(t *testing.T) {
+		// given
+		modified := time.Date(2021, 3, 4, 10, 0, 0, 0, time.UTC)
+		archivePath := filepath.Join(t.TempDir(), "archive.zip")
+		archive, err := os.Create(archivePath)
+		require.NoError(t, err)
+		w := zip.NewWriter(archive)
+		f, err := w.CreateHeader(&zip.FileHeader{Name: "notes/a.md", Method: zip.Deflate, Modified: modified})
+		require.NoError(t, err)
+		_, err = f.Write([]byte("a"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		require.NoError(t, archive.Close())
+		s := NewFilter(nil, []string{"other"}).Wrap(NewZip())
+		require.NoError(t, s.Initialize(archivePath))
+		defer s.Close()
+
+		// when
+		created, modifiedTS := FileTimes(s, "notes/a.md")
+
+		// then
+		assert.Equal(t, modified.Unix(), modifiedTS)
+		assert.Equal(t, modified.Unix(), created)
+	})
+	t.Run("modification time of file of directory is used", func(t *testing.T) {
+		// given
+		modified := time.Date(2022, 5, 6, 10, 0, 0, 0, time.UTC)
+		filePath := filepath.Join(t.TempDir(), "a.md")
+		require.NoError(t, os.WriteFile(filePath, []byte("a"), 0600))
+		require.NoError(t, os.Chtimes(filePath, modified, modified))
+
+		// when
+		_, modifiedTS := FileTimes(NewDirectory(), filePath)
+
+		// then
+		assert.Equal(t, modified.Unix(), modifiedTS)
+	})
+}
@@ -0,0 +1,176 @@
+package source
+
+import (
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Entry is a file or directory inside the import path
+type Entry struct {
+	// Path is relative to the import path and uses slash as separator
+	Path        string
+	IsDirectory bool
+}
+
+// ListEntries returns files of the import path and directories, which contain them, so clients can show
+// the tree of import path and let user choose, what to import
+func ListEntries(importPath string) ([]Entry, error) {
+	s := GetSource(importPath)
+	defer s.Close()
+	if err := s.Initialize(importPath); err != nil {
+		return nil, err
+	}
+	var names []string
+	if l, ok := s.(lister); ok {
+		names = l.fileNames()
+	} else {
+		err := s.Iterate(func(fileName string, _ io.ReadCloser) bool {
+			names = append(names, fileName)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	entries := make(map[string]Entry, len(names))
+	for _, name := range names {
+		relPath := relativePath(importPath, name)
+		entries[relPath] = Entry{Path: relPath}
+		for dir := path.Dir(relPath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			entries[dir] = Entry{Path: dir, IsDirectory: true}
+		}
+	}
+	result := lo.Values(entries)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
+// lister is implemented by sources, which know names of their files without reading them
+type lister interface {
+	fileNames() []string
+}
+
+// Filter selects files of the import path, which are imported. Paths are relative to the import path,
+// and path of directory selects all files inside it
+type Filter struct {
+	include []string
+	exclude []string
+}
+
+// NewFilter returns nil, if nothing is selected, so all files are imported
+func NewFilter(include, exclude []string) *Filter {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil
+	}
+	return &Filter{include: cleanPaths(include), exclude: cleanPaths(exclude)}
+}
+
+func cleanPaths(paths []string) []string {
+	return lo.Map(paths, func(p string, _ int) string {
+		return strings.TrimPrefix(path.Clean(filepath.ToSlash(p)), "/")
+	})
+}
+
+// Match checks the path relative to the import path
+func (f *Filter) Match(relPath string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !containsPath(f.include, relPath) {
+		return false
+	}
+	return !containsPath(f.exclude, relPath)
+}
+
+func containsPath(selected []string, relPath string) bool {
+	return lo.ContainsBy(selected, func(p string) bool {
+		return p == relPath || strings.HasPrefix(relPath, p+"/")
+	})
+}
+
+// Wrap returns source, which skips not matched files during iteration. Files are still available by ProcessFile,
+// so attachments of imported pages are imported, even if they are not selected
+func (f *Filter) Wrap(s Source) Source {
+	if f == nil {
+		return s
+	}
+	filtered := &filteredSource{Source: s, filter: f}
+	if o, ok := s.(opener); ok {
+		return &filteredOpener{filteredSource: filtered, opener: o}
+	}
+	return filtered
+}
+
+type filteredSource struct {
+	Source
+	filter     *Filter
+	importPath string
+}
+
+func (s *filteredSource) Initialize(importPath string) error {
+	s.importPath = importPath
+	return s.Source.Initialize(importPath)
+}
+
+func (s *filteredSource) match(fileName string) bool {
+	return s.filter.Match(relativePath(s.importPath, fileName))
+}
+
+func (s *filteredSource) Iterate(callback func(fileName string, fileReader io.ReadCloser) bool) error {
+	return s.Source.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+		if !s.match(fileName) {
+			return true
+		}
+		return callback(fileName, fileReader)
+	})
+}
+
+func (s *filteredSource) CountFilesWithGivenExtensions(extensions []string) int {
+	l, ok := s.Source.(lister)
+	if !ok {
+		return s.Source.CountFilesWithGivenExtensions(extensions)
+	}
+	return lo.CountBy(l.fileNames(), func(fileName string) bool {
+		return s.match(fileName) && lo.Contains(extensions, filepath.Ext(fileName))
+	})
+}
+
+// filteredOpener keeps parallel iteration for sources, which support it
+type filteredOpener struct {
+	*filteredSource
+	opener opener
+}
+
+func (s *filteredOpener) fileNames() []string {
+	return lo.Filter(s.opener.fileNames(), func(fileName string, _ int) bool {
+		return s.match(fileName)
+	})
+}
+
+func (s *filteredOpener) open(fileName string) (io.ReadCloser, error) {
+	return s.opener.open(fileName)
+}
+
+// relativePath returns name of file relative to the import path. Files of directory are named by their full path,
+// and files of archives are already relative to the archive
+func relativePath(importPath, fileName string) string {
+	if !strings.HasPrefix(fileName, importPath) {
+		return filepath.ToSlash(fileName)
+	}
+	rel, err := filepath.Rel(importPath, fileName)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(fileName)
+	}
+	if rel == "." {
+		// import path is the file itself
+		return filepath.Base(fileName)
+	}
+	return filepath.ToSlash(rel)
+}
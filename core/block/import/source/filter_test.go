@@ -0,0 +1,112 @@
+package source
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListEntries(t *testing.T) {
+	t.Run("zip - files and their directories are listed", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"notes/daily/a.md": "a", "notes/b.md": "b", "c.md": "c"})
+
+		// when
+		entries, err := ListEntries(archivePath)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{
+			{Path: "c.md"},
+			{Path: "notes", IsDirectory: true},
+			{Path: "notes/b.md"},
+			{Path: "notes/daily", IsDirectory: true},
+			{Path: "notes/daily/a.md"},
+		}, entries)
+	})
+	t.Run("directory - paths are relative to directory", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "notes"), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes", "a.md"), []byte("a"), 0600))
+
+		// when
+		entries, err := ListEntries(dir)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Entry{{Path: "notes", IsDirectory: true}, {Path: "notes/a.md"}}, entries)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	files := map[string]string{"notes/daily/a.md": "a", "notes/b.md": "b", "c.md": "c", "images/d.png": "d"}
+
+	t.Run("only included files are iterated, excluded files are skipped", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, files)
+		s := NewFilter([]string{"notes", "c.md"}, []string{"notes/daily"}).Wrap(GetSource(archivePath))
+		defer s.Close()
+		require.NoError(t, s.Initialize(archivePath))
+
+		// when
+		var names []string
+		err := s.Iterate(func(fileName string, _ io.ReadCloser) bool {
+			names = append(names, fileName)
+			return true
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"notes/b.md", "c.md"}, names)
+		assert.Equal(t, 2, s.CountFilesWithGivenExtensions([]string{".md"}))
+	})
+	t.Run("not selected files are available by name", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, files)
+		s := NewFilter([]string{"c.md"}, nil).Wrap(GetSource(archivePath))
+		defer s.Close()
+		require.NoError(t, s.Initialize(archivePath))
+
+		// when
+		var content []byte
+		err := s.ProcessFile("images/d.png", func(fileReader io.ReadCloser) (err error) {
+			content, err = io.ReadAll(fileReader)
+			return err
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "d", string(content))
+	})
+	t.Run("directory - parallel iteration is filtered", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "notes"), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes", "a.md"), []byte("a"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.md"), []byte("b"), 0600))
+		s := NewFilter(nil, []string{"notes"}).Wrap(GetSource(dir))
+		require.NoError(t, s.Initialize(dir))
+
+		// when
+		var names []string
+		err := ParallelIterate(s, 2, func(fileName string, _ io.Reader) (string, error) {
+			return fileName, nil
+		}, func(fileName string, _ string, _ error) bool {
+			names = append(names, fileName)
+			return true
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "b.md")}, names)
+	})
+	t.Run("nothing is selected - source isn't wrapped", func(t *testing.T) {
+		s := NewZip()
+		assert.Same(t, s, NewFilter(nil, nil).Wrap(s))
+	})
+}
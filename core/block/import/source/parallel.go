@@ -0,0 +1,123 @@
+package source
+
+import (
+	"io"
+	"os"
+	"sort"
+	"sync/atomic"
+
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+// opener is implemented by sources, which files can be opened independently and concurrently
+type opener interface {
+	fileNames() []string
+	open(fileName string) (io.ReadCloser, error)
+}
+
+type parallelResult[T any] struct {
+	fileName string
+	result   T
+	err      error
+	openErr  error
+}
+
+// ParallelIterate reads and converts files of the source concurrently, keeping at most workers files open
+// at the same time. Results are delivered to the callback one by one in order of file names, so the output
+// doesn't depend on the order, in which reads are finished. If callback returns false, iteration is stopped
+func ParallelIterate[T any](s Source,
+	workers int,
+	convert func(fileName string, fileReader io.Reader) (T, error),
+	callback func(fileName string, result T, err error) bool,
+) error {
+	o, ok := s.(opener)
+	if !ok {
+		return s.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+			result, err := convert(fileName, fileReader)
+			return callback(fileName, result, err)
+		})
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	names := o.fileNames()
+	sort.Strings(names)
+
+	var stopped atomic.Bool
+	openReaders := make(chan struct{}, workers)
+	// results are queued in order of files, buffer limits number of results, which are not delivered yet
+	pending := make(chan chan parallelResult[T], workers)
+	go func() {
+		defer close(pending)
+		for _, name := range names {
+			if stopped.Load() {
+				return
+			}
+			resultCh := make(chan parallelResult[T], 1)
+			pending <- resultCh
+			go func(name string) {
+				openReaders <- struct{}{}
+				defer func() { <-openReaders }()
+				resultCh <- readAndConvert(o, name, convert)
+			}(name)
+		}
+	}()
+
+	var iterateErr error
+	for resultCh := range pending {
+		res := <-resultCh
+		if stopped.Load() {
+			continue
+		}
+		if res.openErr != nil {
+			iterateErr = res.openErr
+			stopped.Store(true)
+			continue
+		}
+		if !callback(res.fileName, res.result, res.err) {
+			stopped.Store(true)
+		}
+	}
+	return iterateErr
+}
+
+func readAndConvert[T any](o opener, fileName string, convert func(fileName string, fileReader io.Reader) (T, error)) parallelResult[T] {
+	res := parallelResult[T]{fileName: fileName}
+	fileReader, err := o.open(fileName)
+	if err != nil {
+		res.openErr = oserror.TransformError(err)
+		return res
+	}
+	defer fileReader.Close()
+	res.result, res.err = convert(fileName, fileReader)
+	return res
+}
+
+func (z *Zip) fileNames() []string {
+	names := make([]string, 0, len(z.fileReaders))
+	for name := range z.fileReaders {
+		names = append(names, name)
+	}
+	return names
+}
+
+// open is safe for concurrent use, because files of archive are read independently
+func (z *Zip) open(fileName string) (io.ReadCloser, error) {
+	file, ok := z.fileReaders[fileName]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return file.Open()
+}
+
+func (d *Directory) fileNames() []string {
+	names := make([]string, 0, len(d.fileReaders))
+	for name := range d.fileReaders {
+		names = append(names, name)
+	}
+	return names
+}
+
+func (d *Directory) open(fileName string) (io.ReadCloser, error) {
+	return os.Open(fileName)
+}
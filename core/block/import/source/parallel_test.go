@@ -0,0 +1,133 @@
+package source
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	filesCount = 20
+	workers    = 4
+)
+
+func TestParallelIterate(t *testing.T) {
+	t.Run("directory - files are read concurrently, results are ordered", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		for i := 0; i < filesCount; i++ {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, fileName(i)), []byte(fileName(i)), 0600))
+		}
+		s := NewDirectory()
+		require.NoError(t, s.Initialize(dir))
+
+		// when
+		names, contents, maxOpen := iterate(t, s)
+
+		// then
+		expected := make([]string, 0, filesCount)
+		for i := 0; i < filesCount; i++ {
+			expected = append(expected, filepath.Join(dir, fileName(i)))
+		}
+		sort.Strings(expected)
+		assert.Equal(t, expected, names)
+		for i, name := range names {
+			assert.Equal(t, filepath.Base(name), contents[i])
+		}
+		assert.Greater(t, maxOpen, int32(1))
+		assert.LessOrEqual(t, maxOpen, int32(workers))
+	})
+	t.Run("zip - files are read concurrently, results are ordered", func(t *testing.T) {
+		// given
+		archivePath := filepath.Join(t.TempDir(), "archive.zip")
+		archive, err := os.Create(archivePath)
+		require.NoError(t, err)
+		w := zip.NewWriter(archive)
+		// files are added in reverse order, so order of archive differs from order of results
+		for i := filesCount - 1; i >= 0; i-- {
+			f, err := w.Create(fileName(i))
+			require.NoError(t, err)
+			_, err = f.Write([]byte(fileName(i)))
+			require.NoError(t, err)
+		}
+		require.NoError(t, w.Close())
+		require.NoError(t, archive.Close())
+		s := NewZip()
+		require.NoError(t, s.Initialize(archivePath))
+		defer s.Close()
+
+		// when
+		names, contents, maxOpen := iterate(t, s)
+
+		// then
+		expected := make([]string, 0, filesCount)
+		for i := 0; i < filesCount; i++ {
+			expected = append(expected, fileName(i))
+		}
+		assert.Equal(t, expected, names)
+		assert.Equal(t, expected, contents)
+		assert.Greater(t, maxOpen, int32(1))
+		assert.LessOrEqual(t, maxOpen, int32(workers))
+	})
+	t.Run("callback returns false - iteration is stopped", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		for i := 0; i < filesCount; i++ {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, fileName(i)), []byte(fileName(i)), 0600))
+		}
+		s := NewDirectory()
+		require.NoError(t, s.Initialize(dir))
+
+		// when
+		var delivered []string
+		err := ParallelIterate(s, workers, func(fileName string, fileReader io.Reader) (string, error) {
+			return fileName, nil
+		}, func(fileName string, result string, err error) bool {
+			delivered = append(delivered, filepath.Base(result))
+			return len(delivered) < 3
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []string{fileName(0), fileName(1), fileName(2)}, delivered)
+	})
+}
+
+func fileName(i int) string {
+	return fmt.Sprintf("file%02d.txt", i)
+}
+
+// iterate reads files slowly, so reads overlap, and returns delivered results and maximum number of open readers
+func iterate(t *testing.T, s Source) ([]string, []string, int32) {
+	var open, maxOpen int32
+	var names, contents []string
+	err := ParallelIterate(s, workers, func(fileName string, fileReader io.Reader) (string, error) {
+		current := atomic.AddInt32(&open, 1)
+		defer atomic.AddInt32(&open, -1)
+		for {
+			prev := atomic.LoadInt32(&maxOpen)
+			if current <= prev || atomic.CompareAndSwapInt32(&maxOpen, prev, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		content, err := io.ReadAll(fileReader)
+		return string(content), err
+	}, func(fileName string, result string, err error) bool {
+		require.NoError(t, err)
+		names = append(names, fileName)
+		contents = append(contents, result)
+		return true
+	})
+	require.NoError(t, err)
+	return names, contents, atomic.LoadInt32(&maxOpen)
+}
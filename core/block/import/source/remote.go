@@ -0,0 +1,108 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+const (
+	remoteScheme = "https://"
+	// downloadTimeout limits the whole download, including reading of the body
+	downloadTimeout = time.Hour
+)
+
+var (
+	httpClient = &http.Client{Timeout: downloadTimeout}
+	// maxDownloadSize limits size of the downloaded file the same way as size of archive entry is limited
+	maxDownloadSize = DefaultArchiveLimits.MaxFileSize
+)
+
+// IsRemote returns true, if import path is a link to the file, which should be downloaded before import
+func IsRemote(importPath string) bool {
+	return len(importPath) > len(remoteScheme) && strings.EqualFold(importPath[:len(remoteScheme)], remoteScheme)
+}
+
+// DownloadRemote downloads the file by link to temporary file and returns its path. Name of the file from link
+// is kept, so the downloaded archive is opened by the same source as the local one. Caller removes the file
+func DownloadRemote(ctx context.Context, link string, progress process.Progress) (string, error) {
+	parsedURL, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("parse link: %w", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-progress.Canceled():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsedURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("download %s: %w", link, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("download %s: unexpected status %s", link, resp.Status)
+	}
+	if resp.ContentLength > 0 && uint64(resp.ContentLength) > maxDownloadSize {
+		return "", fmt.Errorf("%w: file %s is larger than %d bytes", ErrArchiveLimitExceeded, link, maxDownloadSize)
+	}
+
+	tempFile, err := os.CreateTemp("", "import-*-"+remoteFileName(parsedURL))
+	if err != nil {
+		return "", oserror.TransformError(err)
+	}
+	defer tempFile.Close()
+	progress.SetProgressMessage("Downloading " + link)
+	if resp.ContentLength > 0 {
+		progress.SetTotal(resp.ContentLength)
+	}
+	progress.SetDone(0)
+	// one byte over the limit is read to find out, that the body is larger than the limit
+	body := io.LimitReader(resp.Body, int64(maxDownloadSize)+1)
+	written, err := io.Copy(tempFile, io.TeeReader(body, &progressWriter{progress: progress}))
+	if err == nil && uint64(written) > maxDownloadSize {
+		err = fmt.Errorf("%w: file is larger than %d bytes", ErrArchiveLimitExceeded, maxDownloadSize)
+	}
+	if err != nil {
+		tempFile.Close()
+		os.Remove(tempFile.Name())
+		return "", fmt.Errorf("download %s: %w", link, err)
+	}
+	progress.SetDone(0)
+	return tempFile.Name(), nil
+}
+
+func remoteFileName(link *url.URL) string {
+	name := path.Base(link.Path)
+	if name == "." || name == "/" {
+		return "download"
+	}
+	return name
+}
+
+// progressWriter reports number of downloaded bytes
+type progressWriter struct {
+	progress process.Progress
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.progress.AddDone(int64(len(p)))
+	return len(p), nil
+}
@@ -0,0 +1,70 @@
+package source
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestDownloadRemote(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/exports/notes.tar.gz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("archive"))
+	}))
+	defer server.Close()
+	defaultClient := httpClient
+	httpClient = server.Client()
+	defer func() { httpClient = defaultClient }()
+
+	t.Run("file is downloaded with name from link", func(t *testing.T) {
+		// given
+		link := server.URL + "/exports/notes.tar.gz"
+		require.True(t, IsRemote(link))
+
+		// when
+		path, err := DownloadRemote(context.Background(), link, process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		require.NoError(t, err)
+		defer os.Remove(path)
+		assert.True(t, strings.HasSuffix(path, "notes.tar.gz"))
+		assert.IsType(t, &TarGz{}, GetSource(path))
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(content))
+	})
+	t.Run("unexpected status - error", func(t *testing.T) {
+		// when
+		_, err := DownloadRemote(context.Background(), server.URL+"/missing.zip", process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		assert.Error(t, err)
+	})
+	t.Run("file larger than limit - error", func(t *testing.T) {
+		// given
+		maxDownloadSize = 3
+		defer func() { maxDownloadSize = DefaultArchiveLimits.MaxFileSize }()
+
+		// when
+		_, err := DownloadRemote(context.Background(), server.URL+"/exports/notes.tar.gz", process.NewProgress(pb.ModelProcess_Import))
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+	t.Run("local path isn't remote", func(t *testing.T) {
+		assert.False(t, IsRemote("/home/user/export.zip"))
+		assert.False(t, IsRemote("http://example.com/export.zip"))
+	})
+}
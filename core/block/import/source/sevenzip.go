@@ -0,0 +1,92 @@
+package source
+
+import (
+	"io"
+	"path/filepath"
+
+	"github.com/bodgit/sevenzip"
+	"github.com/samber/lo"
+
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+type SevenZip struct {
+	archiveReader *sevenzip.ReadCloser
+	fileReaders   map[string]*sevenzip.File
+	limits        ArchiveLimits
+}
+
+func NewSevenZip() *SevenZip {
+	return &SevenZip{fileReaders: make(map[string]*sevenzip.File, 0), limits: DefaultArchiveLimits}
+}
+
+func (s *SevenZip) Initialize(importPath string) error {
+	archiveReader, err := sevenzip.OpenReader(importPath)
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	s.archiveReader = archiveReader
+	// headers of all entries are read on open, so limits are checked before any of entries is decompressed
+	counter := archiveCounter{limits: s.limits}
+	fileReaders := make(map[string]*sevenzip.File, len(archiveReader.File))
+	for _, f := range archiveReader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if err = counter.add(f.Name, f.UncompressedSize); err != nil {
+			return err
+		}
+		fileReaders[filepath.Clean(f.Name)] = f
+	}
+	s.fileReaders = fileReaders
+	return nil
+}
+
+func (s *SevenZip) Iterate(callback func(fileName string, fileReader io.ReadCloser) bool) error {
+	for name, file := range s.fileReaders {
+		fileReader, err := file.Open()
+		if err != nil {
+			return oserror.TransformError(err)
+		}
+		isContinue := callback(name, fileReader)
+		fileReader.Close()
+		if !isContinue {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *SevenZip) ProcessFile(fileName string, callback func(fileReader io.ReadCloser) error) error {
+	if file, ok := s.fileReaders[fileName]; ok {
+		fileReader, err := file.Open()
+		if err != nil {
+			return oserror.TransformError(err)
+		}
+		defer fileReader.Close()
+		if err = callback(fileReader); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SevenZip) fileNames() []string {
+	return lo.Keys(s.fileReaders)
+}
+
+func (s *SevenZip) CountFilesWithGivenExtensions(extension []string) int {
+	var numberOfFiles int
+	for name := range s.fileReaders {
+		if lo.Contains(extension, filepath.Ext(name)) {
+			numberOfFiles++
+		}
+	}
+	return numberOfFiles
+}
+
+func (s *SevenZip) Close() {
+	if s.archiveReader != nil {
+		s.archiveReader.Close()
+	}
+}
@@ -0,0 +1,75 @@
+package source
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSevenZip(t *testing.T) {
+	t.Run("files are read from archive", func(t *testing.T) {
+		// given
+		s := GetSource(filepath.Join("testdata", "export.7z"))
+		require.IsType(t, &SevenZip{}, s)
+		require.NoError(t, s.Initialize(filepath.Join("testdata", "export.7z")))
+		defer s.Close()
+
+		// when
+		contents := make(map[string]string)
+		err := s.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+			content, err := io.ReadAll(fileReader)
+			require.NoError(t, err)
+			contents[fileName] = string(content)
+			return true
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{filepath.Join("notes", "test.md"): "# Test\n", "readme.txt": "readme\n"}, contents)
+		assert.Equal(t, 1, s.CountFilesWithGivenExtensions([]string{".md"}))
+	})
+	t.Run("process file by name", func(t *testing.T) {
+		// given
+		s := NewSevenZip()
+		require.NoError(t, s.Initialize(filepath.Join("testdata", "export.7z")))
+		defer s.Close()
+
+		// when
+		var content []byte
+		err := s.ProcessFile("readme.txt", func(fileReader io.ReadCloser) error {
+			var err error
+			content, err = io.ReadAll(fileReader)
+			return err
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "readme\n", string(content))
+	})
+	t.Run("entry outside of archive - error", func(t *testing.T) {
+		// given
+		s := NewSevenZip()
+		defer s.Close()
+
+		// when
+		err := s.Initialize(filepath.Join("testdata", "unsafe.7z"))
+
+		// then
+		assert.ErrorIs(t, err, ErrUnsafeArchivePath)
+	})
+	t.Run("archive larger than limits - error", func(t *testing.T) {
+		// given
+		s := NewSevenZip()
+		s.limits = ArchiveLimits{MaxFiles: 1}
+		defer s.Close()
+
+		// when
+		err := s.Initialize(filepath.Join("testdata", "export.7z"))
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+}
@@ -14,6 +14,12 @@ var log = logging.Logger("import-source")
 
 var extensions = []string{".md", ".csv", ".txt", ".pb", ".json", ".html"}
 
+// tarExtensions are extensions of uncompressed tar archives, e.g. JEX export of Joplin
+var tarExtensions = []string{".tar", ".jex"}
+
+// tarGzExtensions are extensions of gzip compressed tar archives
+var tarGzExtensions = []string{".tar.gz", ".tgz"}
+
 type Source interface {
 	Initialize(importPath string) error
 	Iterate(callback func(fileName string, fileReader io.ReadCloser) bool) error
@@ -27,6 +33,12 @@ func GetSource(importPath string) Source {
 	switch {
 	case strings.EqualFold(importFileExt, ".zip"):
 		return NewZip()
+	case hasSuffix(importPath, tarGzExtensions):
+		return NewTarGz()
+	case strings.EqualFold(importFileExt, ".7z"):
+		return NewSevenZip()
+	case isSupportedExtension(strings.ToLower(importFileExt), tarExtensions):
+		return NewTar()
 	case isSupportedExtension(importFileExt, extensions):
 		return NewFile()
 	default:
@@ -37,3 +49,11 @@ func GetSource(importPath string) Source {
 func isSupportedExtension(ext string, expectedExt []string) bool {
 	return lo.Contains(expectedExt, ext)
 }
+
+// hasSuffix checks the whole suffix of path, because filepath.Ext returns only the last extension, e.g. .gz of .tar.gz
+func hasSuffix(path string, suffixes []string) bool {
+	lowerPath := strings.ToLower(path)
+	return lo.ContainsBy(suffixes, func(suffix string) bool {
+		return strings.HasSuffix(lowerPath, suffix)
+	})
+}
@@ -0,0 +1,107 @@
+package source
+
+import (
+	"archive/tar"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+// tarEntry is the position of file content inside the archive. Content of tar entries isn't compressed,
+// so files are read directly from the archive without extraction
+type tarEntry struct {
+	offset   int64
+	size     int64
+	modified int64
+}
+
+type Tar struct {
+	archive *os.File
+	entries map[string]tarEntry
+	limits  ArchiveLimits
+}
+
+func NewTar() *Tar {
+	return &Tar{entries: make(map[string]tarEntry, 0), limits: DefaultArchiveLimits}
+}
+
+func (t *Tar) Initialize(importPath string) error {
+	archive, err := os.Open(importPath)
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	return t.readEntries(archive)
+}
+
+// readEntries reads headers of the archive and keeps the archive open to read content of entries
+func (t *Tar) readEntries(archive *os.File) error {
+	t.archive = archive
+	reader := tar.NewReader(archive)
+	counter := archiveCounter{limits: t.limits}
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		if err = counter.add(header.Name, uint64(header.Size)); err != nil {
+			return err
+		}
+		// tar reader doesn't read ahead, so after the header the archive is at the start of file content
+		offset, err := archive.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return oserror.TransformError(err)
+		}
+		t.entries[filepath.Clean(header.Name)] = tarEntry{offset: offset, size: header.Size, modified: header.ModTime.Unix()}
+	}
+}
+
+func (t *Tar) Iterate(callback func(fileName string, fileReader io.ReadCloser) bool) error {
+	for name, entry := range t.entries {
+		if !callback(name, t.open(entry)) {
+			break
+		}
+	}
+	return nil
+}
+
+func (t *Tar) ProcessFile(fileName string, callback func(fileReader io.ReadCloser) error) error {
+	if entry, ok := t.entries[fileName]; ok {
+		return callback(t.open(entry))
+	}
+	return nil
+}
+
+func (t *Tar) open(entry tarEntry) io.ReadCloser {
+	return io.NopCloser(io.NewSectionReader(t.archive, entry.offset, entry.size))
+}
+
+func (t *Tar) fileNames() []string {
+	return lo.Keys(t.entries)
+}
+
+func (t *Tar) CountFilesWithGivenExtensions(extension []string) int {
+	var numberOfFiles int
+	for name := range t.entries {
+		if lo.Contains(extension, filepath.Ext(name)) {
+			numberOfFiles++
+		}
+	}
+	return numberOfFiles
+}
+
+func (t *Tar) Close() {
+	if t.archive != nil {
+		t.archive.Close()
+	}
+}
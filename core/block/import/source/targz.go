@@ -0,0 +1,76 @@
+package source
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	oserror "github.com/anyproto/anytype-heart/util/os"
+)
+
+// TarGz is a gzip compressed tar archive. Compressed stream can't be read from arbitrary position,
+// so the archive is decompressed to temporary file and files are read from it like from Tar
+type TarGz struct {
+	*Tar
+	tempFile string
+}
+
+// tarEntryOverhead is the max size of tar header and padding of one entry, which is added to sizes of files
+const tarEntryOverhead = 2048
+
+func NewTarGz() *TarGz {
+	return &TarGz{Tar: NewTar()}
+}
+
+func (t *TarGz) Initialize(importPath string) error {
+	archive, err := os.Open(importPath)
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	defer archive.Close()
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+	tempFile, err := os.CreateTemp("", "import-*.tar")
+	if err != nil {
+		return oserror.TransformError(err)
+	}
+	t.tempFile = tempFile.Name()
+	// decompressed archive is limited before its headers are checked, so gzip bomb doesn't exhaust the disk
+	maxSize := t.maxTarSize()
+	written, err := io.Copy(tempFile, io.LimitReader(gzipReader, maxSize+1))
+	if err != nil {
+		tempFile.Close()
+		return oserror.TransformError(err)
+	}
+	if written > maxSize {
+		tempFile.Close()
+		return fmt.Errorf("%w: decompressed archive is larger than %d bytes", ErrArchiveLimitExceeded, maxSize)
+	}
+	if _, err = tempFile.Seek(0, io.SeekStart); err != nil {
+		tempFile.Close()
+		return oserror.TransformError(err)
+	}
+	return t.readEntries(tempFile)
+}
+
+// maxTarSize returns the max size of decompressed archive, which contains files within limits
+func (t *TarGz) maxTarSize() int64 {
+	if t.limits.MaxTotalSize == 0 {
+		return math.MaxInt64 - 1
+	}
+	return int64(t.limits.MaxTotalSize) + int64(t.limits.MaxFiles+1)*tarEntryOverhead
+}
+
+func (t *TarGz) Close() {
+	t.Tar.Close()
+	if t.tempFile != "" {
+		if err := os.Remove(t.tempFile); err != nil {
+			log.Errorf("failed to remove temporary tar file: %s", err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package source
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTarGz(t *testing.T) {
+	t.Run("files are read from compressed archive", func(t *testing.T) {
+		// given
+		archivePath := filepath.Join(t.TempDir(), "export.tar.gz")
+		writeTarGz(t, archivePath, map[string]string{"notes/a.md": "a", "notes/b.txt": "b"})
+		s := GetSource(archivePath)
+		require.IsType(t, &TarGz{}, s)
+		require.NoError(t, s.Initialize(archivePath))
+
+		// when
+		contents := make(map[string]string)
+		err := s.Iterate(func(fileName string, fileReader io.ReadCloser) bool {
+			content, err := io.ReadAll(fileReader)
+			require.NoError(t, err)
+			contents[fileName] = string(content)
+			return true
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{filepath.Join("notes", "a.md"): "a", filepath.Join("notes", "b.txt"): "b"}, contents)
+		assert.Equal(t, 1, s.CountFilesWithGivenExtensions([]string{".md"}))
+		tempFile := s.(*TarGz).tempFile
+		s.Close()
+		_, err = os.Stat(tempFile)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("entry outside of archive - error", func(t *testing.T) {
+		// given
+		archivePath := filepath.Join(t.TempDir(), "export.tar.gz")
+		writeTarGz(t, archivePath, map[string]string{"../evil.md": "a"})
+		s := NewTarGz()
+		defer s.Close()
+
+		// when
+		err := s.Initialize(archivePath)
+
+		// then
+		assert.ErrorIs(t, err, ErrUnsafeArchivePath)
+	})
+	t.Run("decompressed archive larger than limits - error", func(t *testing.T) {
+		// given
+		archivePath := filepath.Join(t.TempDir(), "export.tar.gz")
+		writeTarGz(t, archivePath, map[string]string{"notes/a.md": strings.Repeat("a", 10000)})
+		s := NewTarGz()
+		s.limits = ArchiveLimits{MaxTotalSize: 100, MaxFiles: 1}
+		defer s.Close()
+
+		// when
+		err := s.Initialize(archivePath)
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+	t.Run("tgz extension", func(t *testing.T) {
+		assert.IsType(t, &TarGz{}, GetSource("export.TGZ"))
+	})
+}
+
+func writeTarGz(t *testing.T, path string, files map[string]string) {
+	archive, err := os.Create(path)
+	require.NoError(t, err)
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+		_, err = tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	require.NoError(t, archive.Close())
+}
@@ -2,8 +2,10 @@ package source
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
 	"io"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -12,13 +14,37 @@ import (
 	oserror "github.com/anyproto/anytype-heart/util/os"
 )
 
+var (
+	ErrUnsafeArchivePath    = errors.New("archive entry path is outside of the archive")
+	ErrArchiveLimitExceeded = errors.New("archive limit is exceeded")
+)
+
+// ArchiveLimits bound resources, which are used to read archive, so archive bombs fail with error
+// instead of exhausting disk or memory. Sizes are checked against headers of entries, and zip reader
+// fails, if content of entry is larger than its header says
+type ArchiveLimits struct {
+	// MaxFileSize is the max uncompressed size of one entry
+	MaxFileSize uint64
+	// MaxTotalSize is the max uncompressed size of all entries
+	MaxTotalSize uint64
+	// MaxFiles is the max number of entries
+	MaxFiles int
+}
+
+var DefaultArchiveLimits = ArchiveLimits{
+	MaxFileSize:  4 << 30,
+	MaxTotalSize: 64 << 30,
+	MaxFiles:     1_000_000,
+}
+
 type Zip struct {
 	archiveReader *zip.ReadCloser
 	fileReaders   map[string]*zip.File
+	limits        ArchiveLimits
 }
 
 func NewZip() *Zip {
-	return &Zip{fileReaders: make(map[string]*zip.File, 0)}
+	return &Zip{fileReaders: make(map[string]*zip.File, 0), limits: DefaultArchiveLimits}
 }
 
 func (z *Zip) Initialize(importPath string) error {
@@ -27,9 +53,12 @@ func (z *Zip) Initialize(importPath string) error {
 	if err != nil {
 		return err
 	}
+	if err = z.limits.checkFiles(archiveReader.File); err != nil {
+		return err
+	}
 	fileReaders := make(map[string]*zip.File, len(archiveReader.File))
 	for i, f := range archiveReader.File {
-		if strings.HasPrefix(f.Name, "__MACOSX/") {
+		if isServiceEntry(f) {
 			continue
 		}
 		fileReaders[normalizeName(f, i)] = f
@@ -38,6 +67,70 @@ func (z *Zip) Initialize(importPath string) error {
 	return nil
 }
 
+// checkFiles checks paths and sizes of entries before any of them is read
+func (l ArchiveLimits) checkFiles(files []*zip.File) error {
+	if l.MaxFiles > 0 && len(files) > l.MaxFiles {
+		return fmt.Errorf("%w: archive contains more than %d files", ErrArchiveLimitExceeded, l.MaxFiles)
+	}
+	counter := archiveCounter{limits: l}
+	for _, f := range files {
+		if isServiceEntry(f) {
+			continue
+		}
+		if err := counter.add(f.Name, f.UncompressedSize64); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveCounter checks entries of archive one by one against limits, so archives, which entries are known
+// only after reading of previous ones, like tar, are checked the same way as zip
+type archiveCounter struct {
+	limits    ArchiveLimits
+	files     int
+	totalSize uint64
+}
+
+func (c *archiveCounter) add(name string, size uint64) error {
+	if err := checkEntryPath(name); err != nil {
+		return err
+	}
+	c.files++
+	if c.limits.MaxFiles > 0 && c.files > c.limits.MaxFiles {
+		return fmt.Errorf("%w: archive contains more than %d files", ErrArchiveLimitExceeded, c.limits.MaxFiles)
+	}
+	if c.limits.MaxFileSize > 0 && size > c.limits.MaxFileSize {
+		return fmt.Errorf("%w: file %s is larger than %d bytes", ErrArchiveLimitExceeded, name, c.limits.MaxFileSize)
+	}
+	c.totalSize += size
+	if c.limits.MaxTotalSize > 0 && c.totalSize > c.limits.MaxTotalSize {
+		return fmt.Errorf("%w: files are larger than %d bytes in total", ErrArchiveLimitExceeded, c.limits.MaxTotalSize)
+	}
+	return nil
+}
+
+// isServiceEntry returns true for metadata of macOS archiver, which isn't imported
+func isServiceEntry(f *zip.File) bool {
+	return strings.HasPrefix(f.Name, "__MACOSX/")
+}
+
+// checkEntryPath rejects absolute paths and paths with parent directory references, so files of archive
+// can't be extracted outside of the target directory
+func checkEntryPath(name string) error {
+	slashed := strings.ReplaceAll(name, "\\", "/")
+	// volume name, like C:, is checked explicitly, because it isn't recognized by filepath outside of Windows
+	if path.IsAbs(slashed) || filepath.IsAbs(name) || (len(slashed) > 1 && slashed[1] == ':') {
+		return fmt.Errorf("%w: %s is absolute", ErrUnsafeArchivePath, name)
+	}
+	for _, part := range strings.Split(slashed, "/") {
+		if part == ".." {
+			return fmt.Errorf("%w: %s contains parent directory reference", ErrUnsafeArchivePath, name)
+		}
+	}
+	return nil
+}
+
 func normalizeName(f *zip.File, index int) string {
 	fileName := f.Name
 	if f.NonUTF8 {
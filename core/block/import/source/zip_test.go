@@ -0,0 +1,97 @@
+package source
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZip_Initialize(t *testing.T) {
+	t.Run("regular archive - files are read", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"notes/a.md": "a", "__MACOSX/notes/a.md": "meta"})
+		z := NewZip()
+		defer z.Close()
+
+		// when
+		err := z.Initialize(archivePath)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, z.CountFilesWithGivenExtensions([]string{".md"}))
+	})
+	for _, name := range []string{"../evil.md", "notes/../../evil.md", "/etc/evil.md", "..\\evil.md", "C:/evil.md"} {
+		t.Run("path traversal - error for "+name, func(t *testing.T) {
+			// given
+			archivePath := writeZip(t, map[string]string{name: "evil"})
+			z := NewZip()
+			defer z.Close()
+
+			// when
+			err := z.Initialize(archivePath)
+
+			// then
+			assert.ErrorIs(t, err, ErrUnsafeArchivePath)
+		})
+	}
+	t.Run("file is too large - error", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"a.md": strings.Repeat("a", 100)})
+		z := NewZip()
+		defer z.Close()
+		z.limits.MaxFileSize = 50
+
+		// when
+		err := z.Initialize(archivePath)
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+	t.Run("files are too large in total - error", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"a.md": strings.Repeat("a", 40), "b.md": strings.Repeat("b", 40)})
+		z := NewZip()
+		defer z.Close()
+		z.limits.MaxTotalSize = 50
+
+		// when
+		err := z.Initialize(archivePath)
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+	t.Run("too many files - error", func(t *testing.T) {
+		// given
+		archivePath := writeZip(t, map[string]string{"a.md": "a", "b.md": "b", "c.md": "c"})
+		z := NewZip()
+		defer z.Close()
+		z.limits.MaxFiles = 2
+
+		// when
+		err := z.Initialize(archivePath)
+
+		// then
+		assert.ErrorIs(t, err, ErrArchiveLimitExceeded)
+	})
+}
+
+func writeZip(t *testing.T, files map[string]string) string {
+	archivePath := filepath.Join(t.TempDir(), "archive.zip")
+	archive, err := os.Create(archivePath)
+	require.NoError(t, err)
+	w := zip.NewWriter(archive)
+	for name, content := range files {
+		f, err := w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, archive.Close())
+	return archivePath
+}
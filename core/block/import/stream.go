@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// importFromStream creates objects during conversion by StreamConverter, so snapshots of the whole import
+// aren't kept in memory
+func (i *Import) importFromStream(ctx context.Context,
+	req *pb.RpcObjectImportRequest,
+	c converter.StreamConverter,
+	progress process.Progress,
+	origin model.ObjectOrigin,
+	importRunID string,
+) (*ImportResponse, error) {
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
+	i.saveCheckpointRequest(importRunID, req)
+	stream := newSnapshotStream(ctx, i, req, progress, allErrors, origin, importRunID)
+	res, err := c.GetSnapshotStream(ctx, req, progress, stream)
+	if !err.IsEmpty() {
+		resultErr := err.GetResultError(req.Type)
+		if shouldReturnError(resultErr, &converter.Response{Snapshots: stream.sent}, req) {
+			return nil, resultErr
+		}
+		allErrors.Merge(err)
+	}
+	if len(stream.sent) == 0 {
+		return nil, fmt.Errorf("source path doesn't contain %s resources to import", req.Type)
+	}
+	if resultErr := allErrors.GetResultError(req.Type); resultErr != nil {
+		return nil, resultErr
+	}
+	i.removeCheckpoint(importRunID)
+	var rootCollectionID string
+	if res != nil {
+		rootCollectionID = stream.oldIDToNew[res.RootCollectionID]
+	}
+	return &ImportResponse{RootCollectionID: rootCollectionID, ImportRunID: importRunID}, nil
+}
+
+// snapshotStream creates objects from batches of snapshots. Ids of objects are kept for the whole import,
+// so links between objects of different batches are resolved
+type snapshotStream struct {
+	ctx         context.Context
+	i           *Import
+	req         *pb.RpcObjectImportRequest
+	progress    process.Progress
+	allErrors   *converter.ConvertError
+	origin      model.ObjectOrigin
+	importRunID string
+
+	oldIDToNew     map[string]string
+	createPayloads map[string]treestorage.TreeStorageCreatePayload
+	// sent contains snapshots without content, which are only used to check that import isn't empty
+	sent []*converter.Snapshot
+}
+
+func newSnapshotStream(ctx context.Context,
+	i *Import,
+	req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	allErrors *converter.ConvertError,
+	origin model.ObjectOrigin,
+	importRunID string,
+) *snapshotStream {
+	return &snapshotStream{
+		ctx:            ctx,
+		i:              i,
+		req:            req,
+		progress:       progress,
+		allErrors:      allErrors,
+		origin:         origin,
+		importRunID:    importRunID,
+		oldIDToNew:     make(map[string]string),
+		createPayloads: make(map[string]treestorage.TreeStorageCreatePayload),
+	}
+}
+
+func (s *snapshotStream) Prepare(snapshots []*converter.Snapshot) error {
+	return s.i.resolveIDs(s.ctx, &converter.Response{Snapshots: snapshots}, s.allErrors, s.req, s.oldIDToNew, s.createPayloads)
+}
+
+func (s *snapshotStream) Send(snapshots []*converter.Snapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	res := &converter.Response{Snapshots: snapshots}
+	setContentHashes(res)
+	unresolved := lo.Filter(snapshots, func(sn *converter.Snapshot, _ int) bool {
+		_, ok := s.oldIDToNew[sn.Id]
+		return !ok
+	})
+	if err := s.Prepare(unresolved); err != nil {
+		return err
+	}
+	for _, sn := range snapshots {
+		s.sent = append(s.sent, &converter.Snapshot{Id: sn.Id, SbType: sn.SbType})
+	}
+	s.i.createBatch(s.ctx, res, s.progress, s.req, s.allErrors, s.origin, s.importRunID, s.oldIDToNew, s.createPayloads)
+	if s.allErrors.ShouldAbortImport(0, s.req.Type) {
+		return s.allErrors.GetResultError(s.req.Type)
+	}
+	return nil
+}
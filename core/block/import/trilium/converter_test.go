@@ -0,0 +1,161 @@
+package trilium
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestTrilium_GetSnapshots(t *testing.T) {
+	t.Run("note tree is converted to nested collections, clones are linked", func(t *testing.T) {
+		// given
+		tr := &Trilium{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		archive := makeArchive(t, "testdata/export")
+
+		// when
+		sn, err := tr.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Trilium, archive), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+
+		projectsPage := findPage(sn.Snapshots, "Projects")
+		projects := findCollection(sn.Snapshots, "Projects")
+		roadmap := findPage(sn.Snapshots, "Roadmap")
+		plan := findPage(sn.Snapshots, "Plan")
+		team := findPage(sn.Snapshots, "Team")
+		root := findCollection(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{projectsPage, projects, roadmap, plan, team, root} {
+			require.NotNil(t, s)
+		}
+		assert.Len(t, sn.Snapshots, 10) // 4 pages, 3 relations, 1 collection and root collection
+		assert.Equal(t, []string{projectsPage.Id, roadmap.Id, plan.Id, team.Id}, convertertest.CollectionObjects(projects))
+		assert.Equal(t, []string{projects.Id, team.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, root.Id, sn.RootCollectionID)
+		assert.Contains(t, getTexts(plan), "Write the specification first")
+		assert.Contains(t, getTexts(roadmap), "Release the new version in spring")
+	})
+	t.Run("labels and relations are converted to relations", func(t *testing.T) {
+		// given
+		tr := &Trilium{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		archive := makeArchive(t, "testdata/export")
+
+		// when
+		sn, err := tr.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Trilium, archive), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		roadmap := findPage(sn.Snapshots, "Roadmap")
+		team := findPage(sn.Snapshots, "Team")
+		priority := findRelation(sn.Snapshots, "priority")
+		draft := findRelation(sn.Snapshots, "draft")
+		owner := findRelation(sn.Snapshots, "owner")
+		for _, s := range []*converter.Snapshot{roadmap, team, priority, draft, owner} {
+			require.NotNil(t, s)
+		}
+
+		assert.ElementsMatch(t, []*model.RelationLink{
+			{Key: priority.Id, Format: model.RelationFormat_shorttext},
+			{Key: draft.Id, Format: model.RelationFormat_checkbox},
+			{Key: owner.Id, Format: model.RelationFormat_object},
+		}, roadmap.Snapshot.Data.RelationLinks)
+		details := roadmap.Snapshot.Data.Details
+		assert.Equal(t, "high", pbtypes.GetString(details, priority.Id))
+		assert.True(t, pbtypes.GetBool(details, draft.Id))
+		assert.Equal(t, []string{team.Id}, pbtypes.GetStringList(details, owner.Id))
+	})
+	t.Run("archive without meta file - return error", func(t *testing.T) {
+		// given
+		tr := &Trilium{}
+		p := process.NewProgress(pb.ModelProcess_Import)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "note.html"), []byte("<p>note</p>"), 0666))
+		archive := makeArchive(t, dir)
+
+		// when
+		sn, err := tr.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_Trilium, archive), p)
+
+		// then
+		assert.Nil(t, sn)
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(pb.RpcObjectImportRequest_Trilium), converter.ErrNoObjectsToImport))
+	})
+}
+
+// makeArchive packs directory into zip archive, the same way Trilium exports notes
+func makeArchive(t *testing.T, dir string) string {
+	archiveName := filepath.Join(t.TempDir(), "export.zip")
+	file, err := os.Create(archiveName)
+	require.NoError(t, err)
+	defer file.Close()
+	writer := zip.NewWriter(file)
+	defer writer.Close()
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		w, err := writer.Create(filepath.ToSlash(name))
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(w, f)
+		return err
+	})
+	require.NoError(t, err)
+	return archiveName
+}
+
+func getTexts(sn *converter.Snapshot) []string {
+	var texts []string
+	for _, block := range sn.Snapshot.Data.Blocks {
+		if text := block.GetText(); text != nil {
+			texts = append(texts, text.Text)
+		}
+	}
+	return texts
+}
+
+func findPage(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.SbType == smartblock.SmartBlockTypePage && sn.Snapshot.Data.Collections == nil
+	})
+}
+
+func findCollection(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.Snapshot.Data.Collections != nil
+	})
+}
+
+func findRelation(snapshots []*converter.Snapshot, name string) *converter.Snapshot {
+	return convertertest.FindSnapshot(snapshots, name, func(sn *converter.Snapshot) bool {
+		return sn.SbType == smartblock.SmartBlockTypeRelation
+	})
+}
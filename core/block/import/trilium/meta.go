@@ -0,0 +1,69 @@
+package trilium
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+)
+
+const metaFileName = "!!!meta.json"
+
+const (
+	attributeTypeLabel    = "label"
+	attributeTypeRelation = "relation"
+)
+
+const (
+	formatHTML     = "html"
+	formatMarkdown = "markdown"
+	noteTypeCode   = "code"
+)
+
+// exportMeta describes the tree of notes from Trilium export
+type exportMeta struct {
+	FormatVersion int         `json:"formatVersion"`
+	AppVersion    string      `json:"appVersion"`
+	Files         []*noteMeta `json:"files"`
+}
+
+type noteMeta struct {
+	IsClone      bool         `json:"isClone"`
+	NoteID       string       `json:"noteId"`
+	NotePath     []string     `json:"notePath"`
+	Title        string       `json:"title"`
+	NotePosition int          `json:"notePosition"`
+	Prefix       string       `json:"prefix"`
+	Type         string       `json:"type"`
+	Mime         string       `json:"mime"`
+	Format       string       `json:"format"`
+	DataFileName string       `json:"dataFileName"`
+	DirFileName  string       `json:"dirFileName"`
+	Attributes   []*attribute `json:"attributes"`
+	Children     []*noteMeta  `json:"children"`
+}
+
+// attribute is either a label with text value or a relation, which value is id of another note
+type attribute struct {
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Value         string `json:"value"`
+	IsInheritable bool   `json:"isInheritable"`
+	Position      int    `json:"position"`
+}
+
+func readMeta(importSource source.Source, limits converter.ParseLimits) (*exportMeta, error) {
+	var meta *exportMeta
+	err := importSource.ProcessFile(metaFileName, func(fileReader io.ReadCloser) error {
+		meta = &exportMeta{}
+		if err := limits.DecodeJSON(fileReader, meta); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", metaFileName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
@@ -0,0 +1,280 @@
+package trilium
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// clonePrefix marks clone of the note in collection, which is replaced with the id of original note's object,
+// when all notes are converted
+const clonePrefix = "trilium-clone:"
+
+// noteTree converts notes from Trilium export. Notes with children become collections, so the tree is preserved
+type noteTree struct {
+	importPath   string
+	objectType   string
+	importSource source.Source
+	limits       converter.ParseLimits
+	collection   *converter.RootCollection
+	allErrors    *converter.ConvertError
+
+	snapshots   []*converter.Snapshot
+	collections []*converter.Snapshot
+	// pages maps Trilium note id to the page with note content
+	pages map[string]*converter.Snapshot
+	// objects maps Trilium note id to the object, which represents note in collections
+	objects map[string]string
+	// relations creates relation for each Trilium attribute
+	relations *converter.RelationCreator
+	// objectRelations contains keys of relations, which values are Trilium note ids
+	objectRelations map[string]struct{}
+}
+
+func newNoteTree(importPath, objectType string,
+	importSource source.Source,
+	limits converter.ParseLimits,
+	service *collection.Service,
+	allErrors *converter.ConvertError,
+) *noteTree {
+	t := &noteTree{
+		importPath:      importPath,
+		objectType:      objectType,
+		importSource:    importSource,
+		limits:          limits,
+		collection:      converter.NewRootCollection(service),
+		allErrors:       allErrors,
+		pages:           map[string]*converter.Snapshot{},
+		objects:         map[string]string{},
+		objectRelations: map[string]struct{}{},
+	}
+	t.relations = converter.NewRelationCreator(&t.snapshots)
+	return t
+}
+
+// convert returns snapshots of all notes and ids of objects for top-level notes
+func (t *noteTree) convert(notes []*noteMeta) ([]*converter.Snapshot, []string, error) {
+	rootObjects := make([]string, 0, len(notes))
+	for _, note := range sortNotes(notes) {
+		id, err := t.convertNote(note, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		rootObjects = append(rootObjects, id)
+	}
+	t.resolveLinks()
+	return t.snapshots, t.resolveClones(rootObjects), nil
+}
+
+func (t *noteTree) convertNote(note *noteMeta, dir string) (string, error) {
+	if note.IsClone {
+		return clonePrefix + note.NoteID, nil
+	}
+	var page *converter.Snapshot
+	if note.DataFileName != "" || len(note.Children) == 0 {
+		page = t.makePage(note, dir)
+		t.pages[note.NoteID] = page
+		t.snapshots = append(t.snapshots, page)
+	}
+	if len(note.Children) == 0 {
+		t.objects[note.NoteID] = page.Id
+		return page.Id, nil
+	}
+	targets := make([]string, 0, len(note.Children)+1)
+	if page != nil {
+		targets = append(targets, page.Id)
+	}
+	childrenDir := path.Join(dir, note.DirFileName)
+	for _, child := range sortNotes(note.Children) {
+		id, err := t.convertNote(child, childrenDir)
+		if err != nil {
+			return "", err
+		}
+		targets = append(targets, id)
+	}
+	col, err := t.collection.MakeCollection(note.Title, targets)
+	if err != nil {
+		return "", fmt.Errorf("failed to create collection for note %s: %w", note.Title, err)
+	}
+	t.snapshots = append(t.snapshots, col)
+	t.collections = append(t.collections, col)
+	t.objects[note.NoteID] = col.Id
+	return col.Id, nil
+}
+
+func sortNotes(notes []*noteMeta) []*noteMeta {
+	sorted := make([]*noteMeta, len(notes))
+	copy(sorted, notes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].NotePosition < sorted[j].NotePosition
+	})
+	return sorted
+}
+
+func (t *noteTree) makePage(note *noteMeta, dir string) *converter.Snapshot {
+	var (
+		blocks   []*model.Block
+		fileName string
+	)
+	if note.DataFileName != "" {
+		fileName = path.Join(dir, note.DataFileName)
+		var err error
+		if blocks, err = t.getBlocks(note, fileName); err != nil {
+			t.allErrors.Add(fmt.Errorf("failed to convert note %s: %w", note.Title, err))
+		}
+	}
+	details := converter.GetCommonDetails(filepath.Join(t.importPath, filepath.FromSlash(fileName)), note.Title, "", model.ObjectType_basic)
+	relationLinks := t.addAttributes(details, note.Attributes)
+	return &converter.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        blocks,
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{t.objectType},
+		}},
+	}
+}
+
+func (t *noteTree) getBlocks(note *noteMeta, fileName string) ([]*model.Block, error) {
+	var content []byte
+	err := t.importSource.ProcessFile(fileName, func(fileReader io.ReadCloser) error {
+		var err error
+		content, err = t.limits.ReadAll(fileReader)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*model.Block
+	switch {
+	case note.Type == noteTypeCode:
+		blocks = []*model.Block{{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text:  string(content),
+				Style: model.BlockContentText_Code,
+			}},
+		}}
+	case note.Format == formatMarkdown:
+		blocks, _, err = anymark.MarkdownToBlocks(content, path.Dir(fileName), nil)
+	case note.Format == formatHTML:
+		blocks, _, err = anymark.HTMLToBlocks(content)
+	default:
+		log.Warnf("note %s has unsupported format %s", note.NoteID, note.Format)
+	}
+	return blocks, err
+}
+
+// addAttributes sets Trilium labels and relations as details of the object. Values of relations are ids of
+// Trilium notes, they are replaced with ids of objects in resolveLinks
+func (t *noteTree) addAttributes(details *types.Struct, attributes []*attribute) []*model.RelationLink {
+	relationLinks := make([]*model.RelationLink, 0, len(attributes))
+	for _, attr := range attributes {
+		if attr.Name == "" {
+			continue
+		}
+		relation := t.getRelation(attr)
+		current := details.Fields[relation.Key]
+		switch relation.Format {
+		case model.RelationFormat_object:
+			details.Fields[relation.Key] = pbtypes.StringList(append(pbtypes.GetStringListValue(current), attr.Value))
+		case model.RelationFormat_checkbox:
+			details.Fields[relation.Key] = pbtypes.Bool(true)
+		default:
+			value := attr.Value
+			if current != nil {
+				value = current.GetStringValue() + ", " + value
+			}
+			details.Fields[relation.Key] = pbtypes.String(value)
+		}
+		if current == nil {
+			relationLinks = append(relationLinks, relation)
+		}
+	}
+	return relationLinks
+}
+
+// getRelation returns relation for attribute, creating relation snapshot for each new attribute name.
+// Labels without value are flags, so they are imported as checkboxes
+func (t *noteTree) getRelation(attr *attribute) *model.RelationLink {
+	format := model.RelationFormat_shorttext
+	switch {
+	case attr.Type == attributeTypeRelation:
+		format = model.RelationFormat_object
+	case attr.Value == "":
+		format = model.RelationFormat_checkbox
+	}
+	relationID := strings.Join([]string{attr.Type, attr.Name, format.String()}, "/")
+	relation := t.relations.RelationByID(relationID, attr.Name, format)
+	if format == model.RelationFormat_object {
+		t.objectRelations[relation.Key] = struct{}{}
+	}
+	return relation
+}
+
+// resolveLinks replaces Trilium note ids in collections and relations with ids of imported objects.
+// Clones are added to collections as links to the object of original note, so notes are not duplicated
+func (t *noteTree) resolveLinks() {
+	for _, col := range t.collections {
+		store := col.Snapshot.Data.Collections
+		objects := pbtypes.GetStringList(store, template.CollectionStoreKey)
+		store.Fields[template.CollectionStoreKey] = pbtypes.StringList(t.resolveClones(objects))
+	}
+	for _, page := range t.pages {
+		details := page.Snapshot.Data.Details
+		for key := range t.objectRelations {
+			noteIDs := pbtypes.GetStringList(details, key)
+			if len(noteIDs) == 0 {
+				continue
+			}
+			objects := make([]string, 0, len(noteIDs))
+			for _, noteID := range noteIDs {
+				if id := t.linkTarget(noteID); id != "" {
+					objects = append(objects, id)
+				}
+			}
+			details.Fields[key] = pbtypes.StringList(objects)
+		}
+	}
+}
+
+func (t *noteTree) resolveClones(ids []string) []string {
+	resolved := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if noteID, ok := strings.CutPrefix(id, clonePrefix); ok {
+			if id = t.objects[noteID]; id == "" {
+				continue
+			}
+		}
+		resolved = append(resolved, id)
+	}
+	return resolved
+}
+
+// linkTarget returns page of the note, or its collection, if note has no content
+func (t *noteTree) linkTarget(noteID string) string {
+	if page, ok := t.pages[noteID]; ok {
+		return page.Id
+	}
+	return t.objects[noteID]
+}
@@ -1,10 +1,15 @@
 package txt
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"path/filepath"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/globalsign/mgo/bson"
 	"github.com/google/uuid"
 
 	"github.com/anyproto/anytype-heart/core/block/collection"
@@ -19,17 +24,23 @@ import (
 )
 
 const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const defaultObjectType = bundle.TypeKeyPage
+
+// defaultPartSize is size of text of one page, larger files are split to several pages
+const defaultPartSize = 1 << 20
+
 const (
 	Name               = "Txt"
 	rootCollectionName = "TXT Import"
 )
 
 type TXT struct {
-	service *collection.Service
+	service     *collection.Service
+	parseLimits converter.ParseLimits
 }
 
 func New(service *collection.Service) converter.Converter {
-	return &TXT{service: service}
+	return &TXT{service: service, parseLimits: converter.DefaultParseLimits}
 }
 
 func (t *TXT) Name() string {
@@ -50,7 +61,7 @@ func (t *TXT) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest,
 		return nil, nil
 	}
 	progress.SetProgressMessage("Start creating snapshots from files")
-	allErrors := converter.NewError(req.Mode)
+	allErrors := converter.NewErrorWithProgress(req.Mode, progress)
 	snapshots, targetObjects := t.getSnapshots(req, progress, paths, allErrors)
 	if allErrors.ShouldAbortImport(len(paths), req.Type) {
 		return nil, allErrors
@@ -85,12 +96,13 @@ func (t *TXT) getSnapshots(req *pb.RpcObjectImportRequest,
 ) ([]*converter.Snapshot, []string) {
 	snapshots := make([]*converter.Snapshot, 0)
 	targetObjects := make([]string, 0)
+	limits := converter.GetParseLimits(req, t.parseLimits)
 	for _, p := range paths {
 		if err := progress.TryStep(1); err != nil {
 			allErrors.Add(converter.ErrCancel)
 			return nil, nil
 		}
-		sn, to := t.handleImportPath(p, len(paths), allErrors)
+		sn, to := t.handleImportPath(p, converter.SourceFilter(req), converter.ObjectTypeKey(req, defaultObjectType), len(paths), converter.Concurrency(req), partSize(req), limits, allErrors)
 		if allErrors.ShouldAbortImport(len(paths), req.Type) {
 			return nil, nil
 		}
@@ -100,8 +112,21 @@ func (t *TXT) getSnapshots(req *pb.RpcObjectImportRequest,
 	return snapshots, targetObjects
 }
 
-func (t *TXT) handleImportPath(p string, pathsCount int, allErrors *converter.ConvertError) ([]*converter.Snapshot, []string) {
-	importSource := source.GetSource(p)
+func partSize(req *pb.RpcObjectImportRequest) int {
+	if size := req.GetTxtParams().GetPartSize(); size > 0 {
+		return int(size)
+	}
+	return defaultPartSize
+}
+
+func (t *TXT) handleImportPath(p string,
+	filter *source.Filter,
+	objectType string,
+	pathsCount, concurrency, partSize int,
+	limits converter.ParseLimits,
+	allErrors *converter.ConvertError,
+) ([]*converter.Snapshot, []string) {
+	importSource := filter.Wrap(source.GetSource(p))
 	defer importSource.Close()
 	err := importSource.Initialize(p)
 	if err != nil {
@@ -117,21 +142,27 @@ func (t *TXT) handleImportPath(p string, pathsCount int, allErrors *converter.Co
 	}
 	snapshots := make([]*converter.Snapshot, 0, numberOfFiles)
 	targetObjects := make([]string, 0, numberOfFiles)
-	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+	iterateErr := source.ParallelIterate(importSource, concurrency, func(fileName string, fileReader io.Reader) ([][]*model.Block, error) {
+		if filepath.Ext(fileName) != ".txt" {
+			return nil, nil
+		}
+		return t.getBlocksForSnapshot(fileReader, partSize, limits)
+	}, func(fileName string, parts [][]*model.Block, err error) bool {
 		if filepath.Ext(fileName) != ".txt" {
 			return true
 		}
-		var blocks []*model.Block
-		blocks, err = t.getBlocksForSnapshot(fileReader)
 		if err != nil {
-			allErrors.Add(err)
+			allErrors.Add(converter.NewFileError(fileName, err))
 			if allErrors.ShouldAbortImport(pathsCount, pb.RpcObjectImportRequest_Txt) {
 				return false
 			}
 		}
-		sn, id := t.getSnapshot(blocks, fileName)
-		snapshots = append(snapshots, sn)
-		targetObjects = append(targetObjects, id)
+		sn, ids := t.getPartSnapshots(parts, fileName, objectType)
+		for _, s := range sn {
+			converter.SetFileTimes(s.Snapshot.Data.Details, importSource, fileName)
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, ids...)
 		return true
 	})
 	if iterateErr != nil {
@@ -140,24 +171,98 @@ func (t *TXT) handleImportPath(p string, pathsCount int, allErrors *converter.Co
 	return snapshots, targetObjects
 }
 
-func (t *TXT) getBlocksForSnapshot(rc io.ReadCloser) ([]*model.Block, error) {
-	defer rc.Close()
-	b, err := io.ReadAll(rc)
+// getBlocksForSnapshot returns blocks of parts of the file, text of the file is converted to UTF-8
+func (t *TXT) getBlocksForSnapshot(r io.Reader, partSize int, limits converter.ParseLimits) ([][]*model.Block, error) {
+	b, err := limits.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	blocks, _, err := anymark.MarkdownToBlocks(b, "", []string{})
-	if err != nil {
-		return nil, err
+	if b, err = decodeText(b); err != nil {
+		return nil, fmt.Errorf("decode text: %w", err)
+	}
+	texts := splitText(b, partSize)
+	parts := make([][]*model.Block, 0, len(texts))
+	for _, text := range texts {
+		blocks, _, err := anymark.MarkdownToBlocks(text, "", []string{})
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, blocks)
+	}
+	return parts, nil
+}
+
+// splitText splits text to parts, which are not larger than size, by paragraphs, lines or characters
+func splitText(data []byte, size int) [][]byte {
+	var parts [][]byte
+	for len(data) > size {
+		cut := bytes.LastIndex(data[:size], []byte("\n\n"))
+		if cut <= 0 {
+			cut = bytes.LastIndexByte(data[:size], '\n')
+		}
+		if cut <= 0 {
+			cut = size
+			for cut > 0 && !utf8.RuneStart(data[cut]) {
+				cut--
+			}
+			if cut == 0 {
+				cut = size
+			}
+		}
+		parts = append(parts, data[:cut])
+		data = bytes.TrimLeft(data[cut:], "\r\n")
+	}
+	if len(data) != 0 || len(parts) == 0 {
+		parts = append(parts, data)
+	}
+	return parts
+}
+
+// getPartSnapshots returns page for every part of the file. Parts are linked to the previous and the next ones
+func (t *TXT) getPartSnapshots(parts [][]*model.Block, p, objectType string) ([]*converter.Snapshot, []string) {
+	if len(parts) <= 1 {
+		var blocks []*model.Block
+		if len(parts) == 1 {
+			blocks = parts[0]
+		}
+		sn, id := t.getSnapshot(blocks, p, "", objectType)
+		return []*converter.Snapshot{sn}, []string{id}
+	}
+	name := strings.TrimSuffix(filepath.Base(p), filepath.Ext(p))
+	snapshots := make([]*converter.Snapshot, 0, len(parts))
+	ids := make([]string, 0, len(parts))
+	for i, blocks := range parts {
+		sn, id := t.getSnapshot(blocks, p, fmt.Sprintf("%s (%d/%d)", name, i+1, len(parts)), objectType)
+		snapshots = append(snapshots, sn)
+		ids = append(ids, id)
+	}
+	for i, sn := range snapshots {
+		data := sn.Snapshot.Data
+		if i > 0 {
+			data.Blocks = append([]*model.Block{newLinkBlock(ids[i-1])}, data.Blocks...)
+		}
+		if i < len(snapshots)-1 {
+			data.Blocks = append(data.Blocks, newLinkBlock(ids[i+1]))
+		}
+	}
+	return snapshots, ids
+}
+
+func newLinkBlock(targetID string) *model.Block {
+	return &model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{
+			TargetBlockId: targetID,
+			Style:         model.BlockContentLink_Page,
+		}},
 	}
-	return blocks, nil
 }
 
-func (t *TXT) getSnapshot(blocks []*model.Block, p string) (*converter.Snapshot, string) {
+func (t *TXT) getSnapshot(blocks []*model.Block, p, name, objectType string) (*converter.Snapshot, string) {
 	sn := &model.SmartBlockSnapshotBase{
 		Blocks:      blocks,
-		Details:     converter.GetCommonDetails(p, "", "", model.ObjectType_basic),
-		ObjectTypes: []string{bundle.TypeKeyPage.String()},
+		Details:     converter.GetCommonDetails(p, name, "", model.ObjectType_basic),
+		ObjectTypes: []string{objectType},
 	}
 
 	snapshot := &converter.Snapshot{
@@ -3,9 +3,13 @@ package txt
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
 	"github.com/anyproto/anytype-heart/core/block/process"
@@ -53,3 +57,98 @@ func TestTXT_GetSnapshots(t *testing.T) {
 	assert.Equal(t, text, "test")
 	assert.True(t, found)
 }
+
+func TestTXT_GetSnapshotsConcurrently(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	var expected []string
+	for i := 0; i < 20; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file%02d.txt", i))
+		require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("text %d", i)), 0600))
+		expected = append(expected, path)
+	}
+	h := &TXT{}
+	p := process.NewProgress(pb.ModelProcess_Import)
+
+	// when
+	sn, err := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfTxtParams{
+			TxtParams: &pb.RpcObjectImportRequestTxtParams{Path: []string{dir}},
+		},
+		Type:        pb.RpcObjectImportRequest_Txt,
+		Mode:        pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		Concurrency: 4,
+	}, p)
+
+	// then
+	assert.Nil(t, err)
+	require.Len(t, sn.Snapshots, len(expected)+1)
+	for i, path := range expected {
+		assert.Equal(t, path, sn.Snapshots[i].FileName)
+	}
+}
+
+func TestTXT_GetSnapshotsWithParts(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	path := filepath.Join(dir, "book.txt")
+	require.NoError(t, os.WriteFile(path, []byte("first paragraph\n\nsecond paragraph\n\nthird paragraph"), 0600))
+	h := &TXT{}
+	p := process.NewProgress(pb.ModelProcess_Import)
+
+	// when
+	sn, err := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfTxtParams{
+			TxtParams: &pb.RpcObjectImportRequestTxtParams{Path: []string{path}, PartSize: 20},
+		},
+		Type: pb.RpcObjectImportRequest_Txt,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, p)
+
+	// then
+	assert.Nil(t, err)
+	require.Len(t, sn.Snapshots, 4)
+	parts := sn.Snapshots[:3]
+	for i, part := range parts {
+		assert.Equal(t, fmt.Sprintf("book (%d/3)", i+1), pbtypes.GetString(part.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+		var links []string
+		for _, block := range part.Snapshot.Data.Blocks {
+			if link := block.GetLink(); link != nil {
+				links = append(links, link.TargetBlockId)
+			}
+		}
+		var expectedLinks []string
+		if i > 0 {
+			expectedLinks = append(expectedLinks, parts[i-1].Id)
+		}
+		if i < len(parts)-1 {
+			expectedLinks = append(expectedLinks, parts[i+1].Id)
+		}
+		assert.Equal(t, expectedLinks, links)
+	}
+	assert.Equal(t, "second paragraph", parts[1].Snapshot.Data.Blocks[1].GetText().GetText())
+	assert.Equal(t, sn.RootCollectionID, sn.Snapshots[3].Id)
+}
+
+func TestTXT_GetSnapshotsWithParseLimits(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	path := filepath.Join(dir, "big.txt")
+	require.NoError(t, os.WriteFile(path, []byte("text, which is larger than the limit"), 0600))
+	h := &TXT{parseLimits: converter.DefaultParseLimits}
+	p := process.NewProgress(pb.ModelProcess_Import)
+
+	// when
+	_, err := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfTxtParams{
+			TxtParams: &pb.RpcObjectImportRequestTxtParams{Path: []string{path}},
+		},
+		Type:        pb.RpcObjectImportRequest_Txt,
+		Mode:        pb.RpcObjectImportRequest_ALL_OR_NOTHING,
+		ParseLimits: &pb.RpcObjectImportRequestParseLimits{MaxBytes: 10},
+	}, p)
+
+	// then
+	require.NotNil(t, err)
+	assert.ErrorContains(t, err.GetResultError(pb.RpcObjectImportRequest_Txt), converter.ErrParseLimitExceeded.Error())
+}
@@ -0,0 +1,105 @@
+package txt
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// utf16SampleSize is number of bytes, which are checked to detect UTF-16 without byte order mark
+const utf16SampleSize = 4096
+
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// decodeText returns text in UTF-8. Encoding is detected by byte order mark, then UTF-16 without byte order mark
+// and UTF-8 are checked. Text, which is valid Shift-JIS with kana, is decoded as Shift-JIS,
+// and the rest is decoded as Windows-1252, which is superset of printable characters of Latin-1
+func decodeText(data []byte) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, utf8BOM):
+		return data[len(utf8BOM):], nil
+	case bytes.HasPrefix(data, utf16LEBOM):
+		return decode(data, unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM))
+	case bytes.HasPrefix(data, utf16BEBOM):
+		return decode(data, unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM))
+	}
+	if endianness, ok := detectUTF16(data); ok {
+		return decode(data, unicode.UTF16(endianness, unicode.IgnoreBOM))
+	}
+	if utf8.Valid(data) {
+		return data, nil
+	}
+	if isShiftJIS(data) {
+		return decode(data, japanese.ShiftJIS)
+	}
+	return decode(data, charmap.Windows1252)
+}
+
+func decode(data []byte, enc encoding.Encoding) ([]byte, error) {
+	return enc.NewDecoder().Bytes(data)
+}
+
+// detectUTF16 checks zero bytes, which are high bytes of ASCII characters in UTF-16. Text in other encodings
+// doesn't contain zero bytes
+func detectUTF16(data []byte) (unicode.Endianness, bool) {
+	sample := data
+	if len(sample) > utf16SampleSize {
+		sample = sample[:utf16SampleSize]
+	}
+	pairs := len(sample) / 2
+	if pairs == 0 {
+		return unicode.LittleEndian, false
+	}
+	var evenZeros, oddZeros int
+	for i := 0; i+1 < len(sample); i += 2 {
+		if sample[i] == 0 {
+			evenZeros++
+		}
+		if sample[i+1] == 0 {
+			oddZeros++
+		}
+	}
+	switch {
+	case oddZeros*5 >= pairs*2 && evenZeros*20 < pairs:
+		return unicode.LittleEndian, true
+	case evenZeros*5 >= pairs*2 && oddZeros*20 < pairs:
+		return unicode.BigEndian, true
+	}
+	return unicode.LittleEndian, false
+}
+
+// isShiftJIS checks that all bytes form valid Shift-JIS characters and that text contains hiragana or katakana,
+// which lead bytes are rare in Latin-1 text
+func isShiftJIS(data []byte) bool {
+	var hasKana bool
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		switch {
+		case b < 0x80 || b >= 0xA1 && b <= 0xDF:
+			continue
+		case b >= 0x81 && b <= 0x9F || b >= 0xE0 && b <= 0xFC:
+			if i+1 >= len(data) {
+				return false
+			}
+			trail := data[i+1]
+			if trail < 0x40 || trail > 0xFC || trail == 0x7F {
+				return false
+			}
+			if b == 0x82 || b == 0x83 {
+				hasKana = true
+			}
+			i++
+		default:
+			return false
+		}
+	}
+	return hasKana
+}
@@ -0,0 +1,73 @@
+package txt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestDecodeText(t *testing.T) {
+	t.Run("UTF-8 text is not changed", func(t *testing.T) {
+		// when
+		data, err := decodeText([]byte("привет, мир"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "привет, мир", string(data))
+	})
+	t.Run("UTF-8 byte order mark is removed", func(t *testing.T) {
+		// when
+		data, err := decodeText(append([]byte{0xEF, 0xBB, 0xBF}, "text"...))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "text", string(data))
+	})
+	t.Run("UTF-16 with byte order mark", func(t *testing.T) {
+		// given
+		encoded, err := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder().Bytes([]byte("日本語 text"))
+		require.NoError(t, err)
+
+		// when
+		data, err := decodeText(encoded)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "日本語 text", string(data))
+	})
+	t.Run("UTF-16 without byte order mark", func(t *testing.T) {
+		// given
+		encoded, err := unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM).NewEncoder().Bytes([]byte("plain text"))
+		require.NoError(t, err)
+
+		// when
+		data, err := decodeText(encoded)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "plain text", string(data))
+	})
+	t.Run("Shift-JIS", func(t *testing.T) {
+		// given
+		encoded, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte("こんにちは、世界"))
+		require.NoError(t, err)
+
+		// when
+		data, err := decodeText(encoded)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "こんにちは、世界", string(data))
+	})
+	t.Run("Latin-1", func(t *testing.T) {
+		// when
+		data, err := decodeText([]byte("caf\xe9 cr\xe8me br\xfbl\xe9e"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "café crème brûlée", string(data))
+	})
+}
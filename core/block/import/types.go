@@ -17,9 +17,31 @@ import (
 // Importer incapsulate logic with import
 type Importer interface {
 	app.Component
-	Import(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin) (string, error)
+	Import(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin) (*ImportResponse, error)
+	// nolint: lll
+	ImportWithRunID(ctx context.Context, req *pb.RpcObjectImportRequest, origin model.ObjectOrigin, importRunID string) (*ImportResponse, error)
 	ListImports(req *pb.RpcObjectImportListRequest) ([]*pb.RpcObjectImportListImportResponse, error)
+	ListEntries(req *pb.RpcObjectImportListEntriesRequest) ([]*pb.RpcObjectImportListEntriesEntry, error)
 	ImportWeb(ctx context.Context, req *pb.RpcObjectImportRequest) (string, *types.Struct, error)
+	UndoImport(importRunID string) ([]string, error)
+	ResumeImport(ctx context.Context, importRunID string, origin model.ObjectOrigin) (*ImportResponse, error)
+	RegisterPlugin(name, executable string, args []string) error
+	UnregisterPlugin(name string) error
 	// nolint: lll
 	ValidateNotionToken(ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest) (pb.RpcObjectImportNotionValidateTokenResponseErrorCode, error)
 }
+
+// ImportResponse contains result of import
+type ImportResponse struct {
+	RootCollectionID string
+	// ImportRunID is set to all objects created by import, so the import can be undone with UndoImport
+	ImportRunID string
+	// ObjectsToOverwrite contains ids of existing objects, which are modified by import with UpdateExistingObjects.
+	// It is filled only for preview and for import, which overwrite is not confirmed yet
+	ObjectsToOverwrite []string
+	// DryRunSummary describes objects, relations and files, which would be created by import. It is filled only for dry run
+	DryRunSummary *pb.RpcObjectImportResponseDryRunSummary
+	// ReportID is id of the object, which lists failed files and created objects. It is set only for import,
+	// which is finished with errors and requested the report
+	ReportID string
+}
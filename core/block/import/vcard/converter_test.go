@@ -0,0 +1,104 @@
+package vcard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/converter/convertertest"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestVCard_GetSnapshots(t *testing.T) {
+	t.Run("cards are contacts with relations, organization is shared object", func(t *testing.T) {
+		// given
+		v := &VCard{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := v.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_VCard, "testdata"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		ann := convertertest.FindSnapshot(sn.Snapshots, "Ann Smith")
+		jorg := convertertest.FindSnapshot(sn.Snapshots, "Jörg Müller")
+		acme := convertertest.FindSnapshot(sn.Snapshots, "Acme Inc.")
+		root := convertertest.FindSnapshot(sn.Snapshots, rootCollectionName)
+		for _, s := range []*converter.Snapshot{ann, jorg, acme, root} {
+			require.NotNil(t, s)
+		}
+		assert.Equal(t, []string{acme.Id, ann.Id, jorg.Id}, convertertest.CollectionObjects(root))
+		assert.Equal(t, []string{bundle.TypeKeyContact.String()}, ann.Snapshot.Data.ObjectTypes)
+
+		details := ann.Snapshot.Data.Details
+		assert.Equal(t, "ann.smith@example.org", pbtypes.GetString(details, bundle.RelationKeyEmail.String()))
+		assert.Equal(t, "+1 555 0100", pbtypes.GetString(details, bundle.RelationKeyPhone.String()))
+		assert.Equal(t, "Software Engineer", pbtypes.GetString(details, bundle.RelationKeyJob.String()))
+		assert.Equal(t, "https://ann.example.org", pbtypes.GetString(details, bundle.RelationKeyUrl.String()))
+		assert.Equal(t, time.Date(1990, time.April, 12, 0, 0, 0, 0, time.UTC).Unix(), pbtypes.GetInt64(details, bundle.RelationKeyDateOfBirth.String()))
+		assert.Equal(t, []string{acme.Id}, pbtypes.GetStringList(details, bundle.RelationKeyCompany.String()))
+		assert.Equal(t, []string{acme.Id}, pbtypes.GetStringList(jorg.Snapshot.Data.Details, bundle.RelationKeyCompany.String()))
+		assert.Equal(t, "+49 30 1234", pbtypes.GetString(jorg.Snapshot.Data.Details, bundle.RelationKeyPhone.String()))
+		assert.Equal(t, []string{"Friends", "Work"}, getOptionNames(sn.Snapshots, ann, bundle.RelationKeyTag.String()))
+		assert.Equal(t, pbtypes.GetStringList(details, bundle.RelationKeyTag.String())[1],
+			pbtypes.GetStringList(jorg.Snapshot.Data.Details, bundle.RelationKeyTag.String())[0])
+
+		var lines []string
+		for _, b := range ann.Snapshot.Data.Blocks {
+			lines = append(lines, b.GetText().Text)
+		}
+		assert.Equal(t, []string{
+			"Email (work): ann@example.com",
+			"Address (work): 1 Main St, Springfield, 12345, USA",
+			"Met at the conference, 2023",
+		}, lines)
+	})
+	t.Run("inline photo is extracted to icon image", func(t *testing.T) {
+		// given
+		v := &VCard{tempDirProvider: &convertertest.TempDirProvider{Dir: t.TempDir()}, parseLimits: converter.DefaultParseLimits}
+		p := process.NewProgress(pb.ModelProcess_Import)
+
+		// when
+		sn, err := v.GetSnapshots(context.Background(), convertertest.NewRequest(pb.RpcObjectImportRequest_VCard, "testdata/contacts.vcf"), p)
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		ann := convertertest.FindSnapshot(sn.Snapshots, "Ann Smith")
+		require.NotNil(t, ann)
+		icon := pbtypes.GetString(ann.Snapshot.Data.Details, bundle.RelationKeyIconImage.String())
+		assert.FileExists(t, icon)
+		assert.Contains(t, icon, ".png")
+	})
+}
+
+func TestParseCards(t *testing.T) {
+	data := "BEGIN:VCARD\r\nFN:Very long\r\n  name\r\nitem1.EMAIL;TYPE=\"work,pref\":a@example.com\r\nEND:VCARD\r\nNOTE:outside\r\n"
+
+	cards := parseCards([]byte(data))
+
+	require.Len(t, cards, 1)
+	assert.Equal(t, "Very long name", cards[0].name())
+	assert.Equal(t, "a@example.com", cards[0].first("EMAIL"))
+	assert.True(t, cards[0].get("EMAIL")[0].hasType("work"))
+}
+
+func getOptionNames(snapshots []*converter.Snapshot, sn *converter.Snapshot, relationKey string) []string {
+	var names []string
+	for _, id := range pbtypes.GetStringList(sn.Snapshot.Data.Details, relationKey) {
+		for _, option := range snapshots {
+			if option.Id == id {
+				names = append(names, pbtypes.GetString(option.Snapshot.Data.Details, bundle.RelationKeyName.String()))
+			}
+		}
+	}
+	return names
+}
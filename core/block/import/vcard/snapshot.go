@@ -0,0 +1,226 @@
+package vcard
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const defaultContactName = "Contact"
+
+// snapshotBuilder creates snapshots of contacts of one import path. Organizations and tags are shared between contacts
+type snapshotBuilder struct {
+	objectType string
+	assetsDir  string
+
+	snapshots   []*converter.Snapshot
+	rootObjects []string
+	// companies maps name of organization to id of its object
+	companies map[string]string
+	// relations creates relation options of tags
+	relations *converter.RelationCreator
+}
+
+func newSnapshotBuilder(objectType, assetsDir string) *snapshotBuilder {
+	b := &snapshotBuilder{
+		objectType: objectType,
+		assetsDir:  assetsDir,
+		companies:  map[string]string{},
+	}
+	b.relations = converter.NewRelationCreator(&b.snapshots)
+	return b
+}
+
+func (b *snapshotBuilder) addContact(fileName string, index int, c *card) {
+	sourcePath := fmt.Sprintf("%s/%d", fileName, index+1)
+	emails := c.get("EMAIL")
+	phones := c.get("TEL")
+	name := c.name()
+	if name == "" && len(emails) > 0 {
+		name = emails[0].value
+	}
+	if name == "" {
+		name = defaultContactName
+	}
+	details := converter.GetCommonDetails(sourcePath, name, "", model.ObjectType_profile)
+	var relationLinks []*model.RelationLink
+	setDetail := func(key domain.RelationKey, format model.RelationFormat, value *types.Value) {
+		details.Fields[key.String()] = value
+		relationLinks = append(relationLinks, &model.RelationLink{Key: key.String(), Format: format})
+	}
+	if len(emails) > 0 {
+		setDetail(bundle.RelationKeyEmail, model.RelationFormat_email, pbtypes.String(emails[0].value))
+	}
+	if len(phones) > 0 {
+		setDetail(bundle.RelationKeyPhone, model.RelationFormat_phone, pbtypes.String(phoneNumber(phones[0].value)))
+	}
+	if org := splitComponents(c.first("ORG"))[0]; org != "" {
+		setDetail(bundle.RelationKeyCompany, model.RelationFormat_object, pbtypes.StringList([]string{b.getCompanyID(fileName, org)}))
+	}
+	if title := unescape(c.first("TITLE")); title != "" {
+		setDetail(bundle.RelationKeyJob, model.RelationFormat_longtext, pbtypes.String(title))
+	}
+	if role := unescape(c.first("ROLE")); role != "" {
+		setDetail(bundle.RelationKeyOccupation, model.RelationFormat_longtext, pbtypes.String(role))
+	}
+	if birthday := parseBirthday(c.first("BDAY")); birthday != 0 {
+		setDetail(bundle.RelationKeyDateOfBirth, model.RelationFormat_date, pbtypes.Int64(birthday))
+	}
+	urls := c.get("URL")
+	if len(urls) > 0 {
+		setDetail(bundle.RelationKeyUrl, model.RelationFormat_url, pbtypes.String(urls[0].value))
+	}
+	if tagIDs := b.getTagIDs(c.get("CATEGORIES")); len(tagIDs) > 0 {
+		setDetail(bundle.RelationKeyTag, model.RelationFormat_tag, pbtypes.StringList(tagIDs))
+	}
+	if photos := c.get("PHOTO"); len(photos) > 0 {
+		if icon := b.providePhoto(photos[0]); icon != "" {
+			setDetail(bundle.RelationKeyIconImage, model.RelationFormat_file, pbtypes.String(icon))
+		}
+	}
+	id := uuid.New().String()
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Blocks:        getBlocks(c, emails, phones, urls),
+			Details:       details,
+			RelationLinks: relationLinks,
+			ObjectTypes:   []string{b.objectType},
+		}},
+	})
+	b.rootObjects = append(b.rootObjects, id)
+}
+
+// getBlocks returns details, which don't fit relations: other emails, phones and URLs, addresses and notes
+func getBlocks(c *card, emails, phones, urls []*property) []*model.Block {
+	var lines []string
+	for _, p := range others(emails) {
+		lines = append(lines, withLabel("Email", p, p.value))
+	}
+	for _, p := range others(phones) {
+		lines = append(lines, withLabel("Phone", p, phoneNumber(p.value)))
+	}
+	for _, p := range others(urls) {
+		lines = append(lines, withLabel("URL", p, p.value))
+	}
+	for _, p := range c.get("ADR") {
+		var parts []string
+		for _, part := range splitComponents(p.value) {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		if len(parts) > 0 {
+			lines = append(lines, withLabel("Address", p, strings.Join(parts, ", ")))
+		}
+	}
+	for _, p := range c.get("NOTE") {
+		if note := strings.TrimSpace(unescape(p.value)); note != "" {
+			lines = append(lines, note)
+		}
+	}
+	blocks := make([]*model.Block, 0, len(lines))
+	for _, line := range lines {
+		blocks = append(blocks, &model.Block{
+			Id:      bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: line}},
+		})
+	}
+	return blocks
+}
+
+// withLabel adds types of the property, e.g. Phone (work, cell): +1 555 0100
+func withLabel(label string, p *property, value string) string {
+	var propertyTypes []string
+	for _, t := range p.params["TYPE"] {
+		for _, v := range strings.Split(t, ",") {
+			if v = strings.ToLower(strings.TrimSpace(v)); v != "" && v != "pref" && v != "internet" && v != "voice" {
+				propertyTypes = append(propertyTypes, v)
+			}
+		}
+	}
+	if len(propertyTypes) > 0 {
+		label += " (" + strings.Join(propertyTypes, ", ") + ")"
+	}
+	return label + ": " + value
+}
+
+// others returns properties except the first one, which is set to relation
+func others(properties []*property) []*property {
+	if len(properties) == 0 {
+		return nil
+	}
+	return properties[1:]
+}
+
+func phoneNumber(value string) string {
+	return strings.TrimPrefix(strings.TrimSpace(value), "tel:")
+}
+
+// providePhoto returns local path of extracted inline photo or URL of external one
+func (b *snapshotBuilder) providePhoto(p *property) string {
+	data, ext, url := p.photo()
+	if url != "" {
+		return url
+	}
+	if len(data) == 0 {
+		return ""
+	}
+	photoPath := filepath.Join(b.assetsDir, bson.NewObjectId().Hex()+ext)
+	if err := os.WriteFile(photoPath, data, 0600); err != nil {
+		log.Warnf("failed to extract photo of contact: %v", err)
+		return ""
+	}
+	return photoPath
+}
+
+// getCompanyID returns id of object of organization, so contacts of the same organization are linked to one object
+func (b *snapshotBuilder) getCompanyID(fileName, name string) string {
+	if id, ok := b.companies[name]; ok {
+		return id
+	}
+	id := uuid.New().String()
+	b.companies[name] = id
+	b.snapshots = append(b.snapshots, &converter.Snapshot{
+		Id:       id,
+		FileName: fileName,
+		SbType:   smartblock.SmartBlockTypePage,
+		Snapshot: &pb.ChangeSnapshot{Data: &model.SmartBlockSnapshotBase{
+			Details:     converter.GetCommonDetails(fileName+"/"+name, name, "", model.ObjectType_basic),
+			ObjectTypes: []string{bundle.TypeKeyPage.String()},
+		}},
+	})
+	b.rootObjects = append(b.rootObjects, id)
+	return id
+}
+
+// getTagIDs returns ids of relation options of categories, so the same tag is shared between contacts
+func (b *snapshotBuilder) getTagIDs(categories []*property) []string {
+	var ids []string
+	for _, p := range categories {
+		for _, name := range strings.Split(p.value, ",") {
+			if name = strings.TrimSpace(unescape(name)); name == "" {
+				continue
+			}
+			if id := b.relations.OptionID(bundle.RelationKeyTag.String(), name); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
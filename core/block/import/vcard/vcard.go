@@ -0,0 +1,273 @@
+package vcard
+
+import (
+	"encoding/base64"
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"time"
+)
+
+// property is the content line of vCard, e.g. EMAIL;TYPE=work:ann@example.com. Names of property
+// and parameters are upper case, group prefix of the name is removed
+type property struct {
+	name   string
+	params map[string][]string
+	value  string
+}
+
+func (p *property) hasType(t string) bool {
+	for _, value := range p.params["TYPE"] {
+		for _, v := range strings.Split(value, ",") {
+			if strings.EqualFold(v, t) {
+				return true
+			}
+		}
+	}
+	// vCard 2.1 allows types without parameter name, e.g. TEL;WORK;VOICE:
+	_, ok := p.params[strings.ToUpper(t)]
+	return ok
+}
+
+type card struct {
+	properties []*property
+}
+
+// get returns properties with the name. Properties with PREF type or parameter go first
+func (c *card) get(name string) []*property {
+	var preferred, other []*property
+	for _, p := range c.properties {
+		if p.name != name {
+			continue
+		}
+		if _, ok := p.params["PREF"]; ok || p.hasType("pref") {
+			preferred = append(preferred, p)
+		} else {
+			other = append(other, p)
+		}
+	}
+	return append(preferred, other...)
+}
+
+func (c *card) first(name string) string {
+	if properties := c.get(name); len(properties) > 0 {
+		return properties[0].value
+	}
+	return ""
+}
+
+// name returns formatted name of the contact or builds it from structured name
+func (c *card) name() string {
+	if name := strings.TrimSpace(unescape(c.first("FN"))); name != "" {
+		return name
+	}
+	parts := splitComponents(c.first("N"))
+	var name []string
+	// N is Family;Given;Additional;Prefix;Suffix
+	for _, i := range []int{3, 1, 2, 0, 4} {
+		if i < len(parts) && parts[i] != "" {
+			name = append(name, parts[i])
+		}
+	}
+	return strings.Join(name, " ")
+}
+
+// parseCards returns cards of vCard file. Folded lines are joined, values of vCard 2.1 in quoted-printable
+// encoding are decoded
+func parseCards(data []byte) []*card {
+	var (
+		cards   []*card
+		current *card
+	)
+	for _, line := range unfoldLines(data) {
+		p := parseProperty(line)
+		if p == nil {
+			continue
+		}
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VCARD"):
+			current = &card{}
+		case p.name == "END" && strings.EqualFold(p.value, "VCARD"):
+			if current != nil {
+				cards = append(cards, current)
+			}
+			current = nil
+		case current != nil:
+			current.properties = append(current.properties, p)
+		}
+	}
+	return cards
+}
+
+func unfoldLines(data []byte) []string {
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if len(lines) == 0 {
+			lines = append(lines, line)
+			continue
+		}
+		last := lines[len(lines)-1]
+		switch {
+		case strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t"):
+			lines[len(lines)-1] = last + line[1:]
+		case strings.HasSuffix(last, "=") && strings.Contains(strings.ToUpper(last), "QUOTED-PRINTABLE"):
+			// soft line break of quoted-printable value
+			lines[len(lines)-1] = last[:len(last)-1] + line
+		default:
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseProperty returns nil, if the line is not a content line
+func parseProperty(line string) *property {
+	nameAndParams, value, ok := cutOutsideQuotes(line, ':')
+	if !ok {
+		return nil
+	}
+	parts := splitOutsideQuotes(nameAndParams, ';')
+	name := strings.ToUpper(strings.TrimSpace(parts[0]))
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" {
+		return nil
+	}
+	p := &property{name: name, params: map[string][]string{}, value: value}
+	for _, param := range parts[1:] {
+		key, paramValue, _ := strings.Cut(param, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		p.params[key] = append(p.params[key], strings.Trim(paramValue, `"`))
+	}
+	if encoding := p.params["ENCODING"]; len(encoding) > 0 && strings.EqualFold(encoding[0], "QUOTED-PRINTABLE") {
+		if decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(value))); err == nil {
+			p.value = string(decoded)
+		}
+	}
+	return p
+}
+
+func cutOutsideQuotes(s string, sep byte) (string, string, bool) {
+	inQuotes := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case sep:
+			if !inQuotes {
+				return s[:i], s[i+1:], true
+			}
+		}
+	}
+	return s, "", false
+}
+
+func splitOutsideQuotes(s string, sep byte) []string {
+	var parts []string
+	for {
+		before, after, ok := cutOutsideQuotes(s, sep)
+		parts = append(parts, before)
+		if !ok {
+			return parts
+		}
+		s = after
+	}
+}
+
+// splitComponents splits structured value like N or ADR by unescaped semicolons and unescapes components
+func splitComponents(value string) []string {
+	var (
+		components []string
+		current    strings.Builder
+	)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			current.WriteByte(value[i])
+			current.WriteByte(value[i+1])
+			i++
+		case value[i] == ';':
+			components = append(components, strings.TrimSpace(unescape(current.String())))
+			current.Reset()
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+	return append(components, strings.TrimSpace(unescape(current.String())))
+}
+
+func unescape(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
+
+// photo returns content and file extension of inline photo or URL of external one
+func (p *property) photo() (data []byte, ext string, url string) {
+	value := strings.TrimSpace(p.value)
+	if strings.HasPrefix(value, "data:") {
+		// vCard 4.0 data URI, e.g. data:image/jpeg;base64,...
+		header, content, _ := strings.Cut(value[len("data:"):], ",")
+		mediaType, _, _ := strings.Cut(header, ";")
+		data, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, "", ""
+		}
+		_, subtype, _ := strings.Cut(mediaType, "/")
+		return data, imageExtension(subtype), ""
+	}
+	encoding := strings.ToUpper(strings.Join(p.params["ENCODING"], ""))
+	if encoding == "B" || encoding == "BASE64" {
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
+		if err != nil {
+			return nil, "", ""
+		}
+		return data, imageExtension(p.photoType()), ""
+	}
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return nil, "", value
+	}
+	return nil, "", ""
+}
+
+// photoType returns type of image, which is set as TYPE parameter or as parameter without name in vCard 2.1
+func (p *property) photoType() string {
+	if types := p.params["TYPE"]; len(types) > 0 {
+		return types[0]
+	}
+	for key := range p.params {
+		if imageExtension(key) != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+func imageExtension(imageType string) string {
+	switch strings.ToUpper(imageType) {
+	case "JPEG", "JPG":
+		return ".jpg"
+	case "PNG":
+		return ".png"
+	case "GIF":
+		return ".gif"
+	case "WEBP":
+		return ".webp"
+	}
+	return ""
+}
+
+// parseBirthday returns unix time of birthday. Dates without year, e.g. --0412, are not supported
+func parseBirthday(value string) int64 {
+	value = strings.TrimSpace(value)
+	if date, _, ok := strings.Cut(value, "T"); ok {
+		value = date
+	}
+	for _, layout := range []string{"2006-01-02", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
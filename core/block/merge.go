@@ -0,0 +1,192 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/restriction"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var ErrBadMergeInput = errors.New("bad merge input")
+
+// mergedSystemRelations are system relations edited by users, so they are merged like other relations
+var mergedSystemRelations = []domain.RelationKey{
+	bundle.RelationKeyTag,
+	bundle.RelationKeyDescription,
+	bundle.RelationKeyIconEmoji,
+	bundle.RelationKeyIconImage,
+	bundle.RelationKeyDone,
+}
+
+type mergeTarget interface {
+	basic.Duplicatable
+	basic.Restrictionable
+}
+
+// MergeObjects merges source objects into the target, which is usually one of duplicates: blocks of sources are
+// appended to the target, relations missing in the target are copied and values of list relations are joined.
+// Links to sources in other objects are replaced with links to the target and sources are moved to the bin
+func (s *Service) MergeObjects(targetID string, sourceIDs []string) error {
+	sourceIDs = lo.Uniq(sourceIDs)
+	if targetID == "" || len(sourceIDs) == 0 {
+		return fmt.Errorf("%w: target and sources are required", ErrBadMergeInput)
+	}
+	if lo.Contains(sourceIDs, targetID) {
+		return fmt.Errorf("%w: target is one of sources", ErrBadMergeInput)
+	}
+	spaceID, err := s.resolver.ResolveSpaceID(targetID)
+	if err != nil {
+		return fmt.Errorf("resolve space of target: %w", err)
+	}
+	for _, sourceID := range sourceIDs {
+		sourceSpaceID, err := s.resolver.ResolveSpaceID(sourceID)
+		if err != nil {
+			return fmt.Errorf("resolve space of source: %w", err)
+		}
+		if sourceSpaceID != spaceID {
+			return fmt.Errorf("%w: objects are in different spaces", ErrBadMergeInput)
+		}
+	}
+
+	for _, sourceID := range sourceIDs {
+		if err = s.mergeObject(targetID, sourceID); err != nil {
+			return fmt.Errorf("merge %s: %w", sourceID, err)
+		}
+	}
+	return s.SetPagesIsArchived(nil, pb.RpcObjectListSetIsArchivedRequest{ObjectIds: sourceIDs, IsArchived: true})
+}
+
+func (s *Service) mergeObject(targetID, sourceID string) error {
+	err := DoState2(s, sourceID, targetID, func(srcState, destState *state.State, sb smartblock.SmartBlock, tb mergeTarget) error {
+		if err := tb.Restrictions().Object.Check(model.Restrictions_Blocks); err != nil {
+			return restriction.ErrRestricted
+		}
+		blockIDs := lo.Filter(srcState.Pick(srcState.RootId()).Model().ChildrenIds, func(id string, _ int) bool {
+			return !state.IsRequiredBlockId(id)
+		})
+		if _, err := tb.Duplicate(srcState, destState, "", model.Block_Inner, blockIDs); err != nil {
+			return fmt.Errorf("copy blocks: %w", err)
+		}
+		mergeDetails(srcState, destState)
+		replaceObjectLinks(destState, sourceID, targetID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	linking, err := s.objectStore.GetInboundLinksByID(sourceID)
+	if err != nil {
+		return fmt.Errorf("get inbound links: %w", err)
+	}
+	for _, id := range linking {
+		if id == targetID || id == sourceID {
+			continue
+		}
+		err = DoStateAsync(s, id, func(st *state.State, sb smartblock.SmartBlock) error {
+			replaceObjectLinks(st, sourceID, targetID)
+			return nil
+		})
+		if err != nil {
+			log.With("objectID", id).Errorf("replace links to merged object: %s", err)
+		}
+	}
+	return nil
+}
+
+// mergeDetails copies relations of the source, which are not set in the target. Values of tags, objects and files
+// are joined. Other system relations of the target, like name and type, are kept
+func mergeDetails(srcState, destState *state.State) {
+	srcDetails := srcState.Details()
+	destDetails := destState.Details()
+	for _, rel := range srcState.GetRelationLinks() {
+		key := domain.RelationKey(rel.Key)
+		if bundle.IsSystemRelation(key) && !lo.Contains(mergedSystemRelations, key) {
+			continue
+		}
+		value := srcDetails.GetFields()[rel.Key]
+		if isEmptyValue(value) {
+			continue
+		}
+		destValue := destDetails.GetFields()[rel.Key]
+		switch {
+		case isEmptyValue(destValue):
+			destState.AddRelationLinks(rel)
+			destState.SetDetail(rel.Key, pbtypes.CopyVal(value))
+		case isMultiValueFormat(rel.Format) && value.GetListValue() != nil && destValue.GetListValue() != nil:
+			joined := lo.Uniq(append(pbtypes.GetStringListValue(destValue), pbtypes.GetStringListValue(value)...))
+			destState.SetDetail(rel.Key, pbtypes.StringList(joined))
+		}
+	}
+	if collection := srcState.GetStoreSlice(template.CollectionStoreKey); len(collection) > 0 {
+		destState.UpdateStoreSlice(template.CollectionStoreKey, lo.Uniq(append(destState.GetStoreSlice(template.CollectionStoreKey), collection...)))
+	}
+}
+
+func isMultiValueFormat(format model.RelationFormat) bool {
+	return format == model.RelationFormat_tag || format == model.RelationFormat_object || format == model.RelationFormat_file
+}
+
+func isEmptyValue(v *types.Value) bool {
+	switch k := v.GetKind().(type) {
+	case nil, *types.Value_NullValue:
+		return true
+	case *types.Value_StringValue:
+		return k.StringValue == ""
+	case *types.Value_BoolValue:
+		return !k.BoolValue
+	case *types.Value_ListValue:
+		return len(k.ListValue.GetValues()) == 0
+	}
+	return false
+}
+
+// replaceObjectLinks replaces links to the object in blocks, object relations and collection of the state
+func replaceObjectLinks(st *state.State, oldID, newID string) {
+	replacer := func(id string) string {
+		if id == oldID {
+			return newID
+		}
+		return id
+	}
+	_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+		if _, ok := b.(simple.ObjectLinkReplacer); ok {
+			// get the block for modification
+			st.Get(b.Model().Id).(simple.ObjectLinkReplacer).ReplaceLinkIds(replacer)
+		}
+		return true
+	})
+
+	details := st.Details()
+	for _, rel := range st.GetRelationLinks() {
+		if rel.Format != model.RelationFormat_object {
+			continue
+		}
+		ids := pbtypes.GetStringList(details, rel.Key)
+		if !lo.Contains(ids, oldID) {
+			continue
+		}
+		if details.GetFields()[rel.Key].GetListValue() == nil {
+			st.SetDetail(rel.Key, pbtypes.String(newID))
+			continue
+		}
+		st.SetDetail(rel.Key, pbtypes.StringList(lo.Uniq(lo.Map(ids, func(id string, _ int) string { return replacer(id) }))))
+	}
+
+	if collection := st.GetStoreSlice(template.CollectionStoreKey); lo.Contains(collection, oldID) {
+		st.UpdateStoreSlice(template.CollectionStoreKey, lo.Uniq(lo.Map(collection, func(id string, _ int) string { return replacer(id) })))
+	}
+}
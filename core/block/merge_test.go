@@ -0,0 +1,86 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestMergeDetails(t *testing.T) {
+	// given
+	src := newObjectState()
+	src.AddRelationLinks(
+		&model.RelationLink{Key: bundle.RelationKeyName.String(), Format: model.RelationFormat_shorttext},
+		&model.RelationLink{Key: bundle.RelationKeyDescription.String(), Format: model.RelationFormat_longtext},
+		&model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag},
+		&model.RelationLink{Key: bundle.RelationKeyDueDate.String(), Format: model.RelationFormat_date},
+		&model.RelationLink{Key: bundle.RelationKeyStatus.String(), Format: model.RelationFormat_status},
+	)
+	src.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Copy"))
+	src.SetDetail(bundle.RelationKeyDescription.String(), pbtypes.String("Notes of the meeting"))
+	src.SetDetail(bundle.RelationKeyTag.String(), pbtypes.StringList([]string{"tag1", "tag2"}))
+	src.SetDetail(bundle.RelationKeyDueDate.String(), pbtypes.Int64(1700000000))
+	src.SetDetail(bundle.RelationKeyStatus.String(), pbtypes.StringList([]string{"done"}))
+	src.UpdateStoreSlice(template.CollectionStoreKey, []string{"obj1", "obj2"})
+
+	dest := newObjectState()
+	dest.AddRelationLinks(
+		&model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag},
+		&model.RelationLink{Key: bundle.RelationKeyStatus.String(), Format: model.RelationFormat_status},
+	)
+	dest.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Original"))
+	dest.SetDetail(bundle.RelationKeyTag.String(), pbtypes.StringList([]string{"tag2", "tag3"}))
+	dest.SetDetail(bundle.RelationKeyStatus.String(), pbtypes.StringList([]string{"inProgress"}))
+	dest.UpdateStoreSlice(template.CollectionStoreKey, []string{"obj2", "obj3"})
+
+	// when
+	mergeDetails(src, dest)
+
+	// then
+	details := dest.Details()
+	assert.Equal(t, "Original", pbtypes.GetString(details, bundle.RelationKeyName.String()))
+	assert.Equal(t, "Notes of the meeting", pbtypes.GetString(details, bundle.RelationKeyDescription.String()))
+	assert.Equal(t, []string{"tag2", "tag3", "tag1"}, pbtypes.GetStringList(details, bundle.RelationKeyTag.String()))
+	assert.Equal(t, []string{"inProgress"}, pbtypes.GetStringList(details, bundle.RelationKeyStatus.String()))
+	assert.Equal(t, int64(1700000000), pbtypes.GetInt64(details, bundle.RelationKeyDueDate.String()))
+	assert.True(t, dest.HasRelation(bundle.RelationKeyDueDate.String()))
+	assert.Equal(t, []string{"obj2", "obj3", "obj1"}, dest.GetStoreSlice(template.CollectionStoreKey))
+}
+
+func TestReplaceObjectLinks(t *testing.T) {
+	// given
+	st := newObjectState()
+	st.Add(simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "old"}}}))
+	st.Add(simple.New(&model.Block{Id: "mention", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text: "see old",
+		Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+			{Type: model.BlockContentTextMark_Mention, Param: "old", Range: &model.Range{From: 4, To: 7}},
+		}},
+	}}}))
+	st.Get("root").Model().ChildrenIds = append(st.Get("root").Model().ChildrenIds, "link", "mention")
+	st.AddRelationLinks(&model.RelationLink{Key: bundle.RelationKeyAssignee.String(), Format: model.RelationFormat_object})
+	st.SetDetail(bundle.RelationKeyAssignee.String(), pbtypes.StringList([]string{"old", "new", "other"}))
+	st.UpdateStoreSlice(template.CollectionStoreKey, []string{"old", "other"})
+
+	// when
+	replaceObjectLinks(st, "old", "new")
+
+	// then
+	assert.Equal(t, "new", st.Pick("link").Model().GetLink().TargetBlockId)
+	assert.Equal(t, "new", st.Pick("mention").Model().GetText().Marks.Marks[0].Param)
+	assert.Equal(t, []string{"new", "other"}, pbtypes.GetStringList(st.Details(), bundle.RelationKeyAssignee.String()))
+	assert.Equal(t, []string{"new", "other"}, st.GetStoreSlice(template.CollectionStoreKey))
+}
+
+func TestMergeObjects_badInput(t *testing.T) {
+	s := &Service{}
+	assert.ErrorIs(t, s.MergeObjects("", []string{"obj1"}), ErrBadMergeInput)
+	assert.ErrorIs(t, s.MergeObjects("obj1", nil), ErrBadMergeInput)
+	assert.ErrorIs(t, s.MergeObjects("obj1", []string{"obj1", "obj2"}), ErrBadMergeInput)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"golang.org/x/exp/slices"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block/bookmark"
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
@@ -54,6 +55,8 @@ type service struct {
 	bookmark          bookmark.Service
 	app               *app.App
 	spaceService      space.Service
+	// readOnly forbids creation of objects, when account is opened in read-only mode
+	readOnly bool
 }
 
 type CollectionService interface {
@@ -71,6 +74,9 @@ func (s *service) Init(a *app.App) (err error) {
 	s.collectionService = app.MustComponent[CollectionService](a)
 	s.spaceService = app.MustComponent[space.Service](a)
 	s.app = a
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
 	return nil
 }
 
@@ -135,6 +141,9 @@ func (s *service) CreateSmartBlockFromState(ctx context.Context, spaceID string,
 }
 
 func (s *service) CreateSmartBlockFromStateInSpace(ctx context.Context, spc space.Space, objectTypeKeys []domain.TypeKey, createState *state.State) (id string, newDetails *types.Struct, err error) {
+	if s.readOnly {
+		return "", nil, domain.ErrReadOnlyMode
+	}
 	if createState == nil {
 		createState = state.NewDoc("", nil).(*state.State)
 	}
@@ -235,6 +244,9 @@ func (s *service) CreateObject(ctx context.Context, spaceID string, req CreateOb
 
 // CreateObjectInSpace is high-level method for creating new objects
 func (s *service) CreateObjectInSpace(ctx context.Context, space space.Space, req CreateObjectRequest) (id string, details *types.Struct, err error) {
+	if s.readOnly {
+		return "", nil, domain.ErrReadOnlyMode
+	}
 	details = req.Details
 	if details.GetFields() == nil {
 		details = &types.Struct{Fields: map[string]*types.Value{}}
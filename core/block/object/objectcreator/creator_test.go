@@ -0,0 +1,25 @@
+package objectcreator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+)
+
+func TestService_ReadOnly(t *testing.T) {
+	// given
+	s := &service{readOnly: true}
+
+	// when
+	_, _, createErr := s.CreateObjectInSpace(context.Background(), nil, CreateObjectRequest{ObjectTypeKey: bundle.TypeKeyPage})
+	_, _, stateErr := s.CreateSmartBlockFromStateInSpace(context.Background(), nil, nil, state.NewDoc("", nil).NewState())
+
+	// then
+	assert.ErrorIs(t, createErr, domain.ErrReadOnlyMode)
+	assert.ErrorIs(t, stateErr, domain.ErrReadOnlyMode)
+}
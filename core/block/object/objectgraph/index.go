@@ -0,0 +1,384 @@
+package objectgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const IndexCName = "graphIndex"
+
+const (
+	defaultNeighborhoodDepth = 1
+	maxNeighborhoodDepth     = 10
+)
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("object is not found in the graph")
+	ErrNoPath   = errors.New("no path between objects")
+)
+
+// defaultOrphanLayouts are layouts of objects created by users. Other objects, like types, relations and
+// files, are usually not linked, so they are not orphans by default
+var defaultOrphanLayouts = []model.ObjectTypeLayout{
+	model.ObjectType_basic,
+	model.ObjectType_profile,
+	model.ObjectType_todo,
+	model.ObjectType_note,
+	model.ObjectType_bookmark,
+	model.ObjectType_set,
+	model.ObjectType_collection,
+}
+
+// Index answers graph queries over links of objects. Links are kept in memory and updated, when details of
+// objects are saved to the object store
+type Index interface {
+	Neighborhood(req *pb.RpcObjectGraphNeighborhoodRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error)
+	Path(req *pb.RpcObjectGraphPathRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error)
+	Orphans(req *pb.RpcObjectGraphOrphansRequest) ([]*types.Struct, error)
+	app.ComponentRunnable
+}
+
+func NewIndex() Index {
+	return &index{
+		nodes:    map[string]*node{},
+		incoming: map[string]map[string]struct{}{},
+	}
+}
+
+// node is the object, which is not deleted, archived or hidden
+type node struct {
+	spaceID string
+	layout  model.ObjectTypeLayout
+	links   map[string]struct{}
+}
+
+type index struct {
+	objectStore objectstore.ObjectStore
+
+	mu    sync.RWMutex
+	nodes map[string]*node
+	// incoming are ids of nodes linking to the object. Object itself may be missing in nodes
+	incoming map[string]map[string]struct{}
+	// changed are ids of objects updated while the graph is loaded, so loaded details don't overwrite them
+	changed map[string]struct{}
+}
+
+func (g *index) Init(a *app.App) error {
+	g.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	return nil
+}
+
+func (g *index) Name() string {
+	return IndexCName
+}
+
+func (g *index) Run(context.Context) error {
+	g.mu.Lock()
+	g.changed = map[string]struct{}{}
+	g.mu.Unlock()
+	g.objectStore.SubscribeForChanges(g.onChange)
+
+	records, _, err := g.objectStore.Query(database.Query{})
+	if err != nil {
+		return fmt.Errorf("query objects: %w", err)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, rec := range records {
+		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+		if _, ok := g.changed[id]; ok {
+			continue
+		}
+		g.setNode(id, rec.Details)
+	}
+	g.changed = nil
+	return nil
+}
+
+func (g *index) Close(context.Context) error {
+	return nil
+}
+
+func (g *index) onChange(id string, _, newDetails *types.Struct) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.changed != nil {
+		g.changed[id] = struct{}{}
+	}
+	g.setNode(id, newDetails)
+}
+
+// setNode replaces links of the object. Deleted, archived and hidden objects are removed from the graph
+func (g *index) setNode(id string, details *types.Struct) {
+	if old, ok := g.nodes[id]; ok {
+		for target := range old.links {
+			delete(g.incoming[target], id)
+			if len(g.incoming[target]) == 0 {
+				delete(g.incoming, target)
+			}
+		}
+		delete(g.nodes, id)
+	}
+	if pbtypes.GetBool(details, bundle.RelationKeyIsDeleted.String()) ||
+		pbtypes.GetBool(details, bundle.RelationKeyIsArchived.String()) ||
+		pbtypes.GetBool(details, bundle.RelationKeyIsHidden.String()) {
+		return
+	}
+	n := &node{
+		spaceID: pbtypes.GetString(details, bundle.RelationKeySpaceId.String()),
+		layout:  model.ObjectTypeLayout(pbtypes.GetInt64(details, bundle.RelationKeyLayout.String())),
+		links:   map[string]struct{}{},
+	}
+	for _, target := range pbtypes.GetStringList(details, bundle.RelationKeyLinks.String()) {
+		if target == id {
+			continue
+		}
+		n.links[target] = struct{}{}
+		if g.incoming[target] == nil {
+			g.incoming[target] = map[string]struct{}{}
+		}
+		g.incoming[target][id] = struct{}{}
+	}
+	g.nodes[id] = n
+}
+
+// neighbors returns sorted ids of nodes of the same space linked with the node in the direction
+func (g *index) neighbors(id string, direction pb.RpcObjectGraphDirection) []string {
+	n := g.nodes[id]
+	var ids []string
+	add := func(neighborID string) {
+		if neighbor, ok := g.nodes[neighborID]; ok && neighbor.spaceID == n.spaceID {
+			ids = append(ids, neighborID)
+		}
+	}
+	if direction != pb.RpcObjectGraph_Incoming {
+		for target := range n.links {
+			add(target)
+		}
+	}
+	if direction != pb.RpcObjectGraph_Outgoing {
+		for source := range g.incoming[id] {
+			add(source)
+		}
+	}
+	ids = lo.Uniq(ids)
+	sort.Strings(ids)
+	return ids
+}
+
+// edge returns the link between nodes, the link from the first node is preferred
+func (g *index) edge(from, to string) *pb.RpcObjectGraphEdge {
+	if _, ok := g.nodes[from].links[to]; ok {
+		return &pb.RpcObjectGraphEdge{Source: from, Target: to, Type: pb.RpcObjectGraphEdge_Link}
+	}
+	return &pb.RpcObjectGraphEdge{Source: to, Target: from, Type: pb.RpcObjectGraphEdge_Link}
+}
+
+func (g *index) Neighborhood(req *pb.RpcObjectGraphNeighborhoodRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error) {
+	if req.ObjectId == "" {
+		return nil, nil, fmt.Errorf("%w: object id is required", ErrBadInput)
+	}
+	depth := int(req.Depth)
+	if depth <= 0 {
+		depth = defaultNeighborhoodDepth
+	}
+	if depth > maxNeighborhoodDepth {
+		return nil, nil, fmt.Errorf("%w: depth must not exceed %d", ErrBadInput, maxNeighborhoodDepth)
+	}
+
+	ids, edges, err := g.neighborhood(req.ObjectId, depth, req.Direction, int(req.Limit))
+	if err != nil {
+		return nil, nil, err
+	}
+	nodes, err := g.details(ids, req.Keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nodes, edges, nil
+}
+
+// neighborhood returns nodes in the order of breadth-first search and all links between them
+func (g *index) neighborhood(id string, depth int, direction pb.RpcObjectGraphDirection, limit int) ([]string, []*pb.RpcObjectGraphEdge, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if _, ok := g.nodes[id]; !ok {
+		return nil, nil, ErrNotFound
+	}
+
+	visited := map[string]struct{}{id: {}}
+	ids := []string{id}
+	level := []string{id}
+	for d := 0; d < depth && len(level) > 0; d++ {
+		var next []string
+		for _, cur := range level {
+			for _, neighbor := range g.neighbors(cur, direction) {
+				if limit > 0 && len(ids) >= limit {
+					break
+				}
+				if _, ok := visited[neighbor]; ok {
+					continue
+				}
+				visited[neighbor] = struct{}{}
+				ids = append(ids, neighbor)
+				next = append(next, neighbor)
+			}
+		}
+		level = next
+	}
+
+	var edges []*pb.RpcObjectGraphEdge
+	for _, source := range ids {
+		targets := lo.Keys(g.nodes[source].links)
+		sort.Strings(targets)
+		for _, target := range targets {
+			if _, ok := visited[target]; ok {
+				edges = append(edges, &pb.RpcObjectGraphEdge{Source: source, Target: target, Type: pb.RpcObjectGraphEdge_Link})
+			}
+		}
+	}
+	return ids, edges, nil
+}
+
+func (g *index) Path(req *pb.RpcObjectGraphPathRequest) ([]*types.Struct, []*pb.RpcObjectGraphEdge, error) {
+	if req.SourceId == "" || req.TargetId == "" {
+		return nil, nil, fmt.Errorf("%w: source and target ids are required", ErrBadInput)
+	}
+	ids, edges, err := g.path(req.SourceId, req.TargetId, req.Direction, int(req.MaxDepth))
+	if err != nil {
+		return nil, nil, err
+	}
+	nodes, err := g.details(ids, req.Keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nodes, edges, nil
+}
+
+// path finds the shortest path by breadth-first search. Neighbors are visited in the order of ids, so the
+// same path is returned for the same graph
+func (g *index) path(sourceID, targetID string, direction pb.RpcObjectGraphDirection, maxDepth int) ([]string, []*pb.RpcObjectGraphEdge, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	source, ok := g.nodes[sourceID]
+	if !ok {
+		return nil, nil, fmt.Errorf("source: %w", ErrNotFound)
+	}
+	target, ok := g.nodes[targetID]
+	if !ok {
+		return nil, nil, fmt.Errorf("target: %w", ErrNotFound)
+	}
+	if source.spaceID != target.spaceID {
+		return nil, nil, ErrNoPath
+	}
+
+	parents := map[string]string{sourceID: ""}
+	level := []string{sourceID}
+	for d := 0; len(level) > 0 && (maxDepth <= 0 || d < maxDepth); d++ {
+		if _, found := parents[targetID]; found {
+			break
+		}
+		var next []string
+		for _, cur := range level {
+			for _, neighbor := range g.neighbors(cur, direction) {
+				if _, visited := parents[neighbor]; visited {
+					continue
+				}
+				parents[neighbor] = cur
+				next = append(next, neighbor)
+			}
+		}
+		level = next
+	}
+	if _, found := parents[targetID]; !found {
+		return nil, nil, ErrNoPath
+	}
+
+	ids := []string{targetID}
+	for cur := targetID; cur != sourceID; cur = parents[cur] {
+		ids = append(ids, parents[cur])
+	}
+	ids = lo.Reverse(ids)
+	edges := make([]*pb.RpcObjectGraphEdge, 0, len(ids)-1)
+	for i := 1; i < len(ids); i++ {
+		edges = append(edges, g.edge(ids[i-1], ids[i]))
+	}
+	return ids, edges, nil
+}
+
+func (g *index) Orphans(req *pb.RpcObjectGraphOrphansRequest) ([]*types.Struct, error) {
+	if req.SpaceId == "" {
+		return nil, fmt.Errorf("%w: space id is required", ErrBadInput)
+	}
+	layouts := req.Layouts
+	if len(layouts) == 0 {
+		layouts = defaultOrphanLayouts
+	}
+	records, err := g.objectStore.QueryByID(g.orphans(req.SpaceId, layouts))
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	// recently modified orphans go first, as they are likely created by mistake or not linked yet
+	sort.SliceStable(records, func(i, j int) bool {
+		return pbtypes.GetInt64(records[i].Details, bundle.RelationKeyLastModifiedDate.String()) >
+			pbtypes.GetInt64(records[j].Details, bundle.RelationKeyLastModifiedDate.String())
+	})
+	if req.Limit > 0 && len(records) > int(req.Limit) {
+		records = records[:req.Limit]
+	}
+	result := make([]*types.Struct, 0, len(records))
+	for _, rec := range records {
+		result = append(result, pbtypes.Map(rec.Details, req.Keys...))
+	}
+	return result, nil
+}
+
+// orphans returns ids of nodes of the space without links to and from other nodes
+func (g *index) orphans(spaceID string, layouts []model.ObjectTypeLayout) []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var ids []string
+	for id, n := range g.nodes {
+		if n.spaceID != spaceID || !lo.Contains(layouts, n.layout) {
+			continue
+		}
+		if len(g.neighbors(id, pb.RpcObjectGraph_Both)) == 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// details returns details of the objects in the order of ids
+func (g *index) details(ids []string, keys []string) ([]*types.Struct, error) {
+	records, err := g.objectStore.QueryByID(ids)
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	byID := make(map[string]*types.Struct, len(records))
+	for _, rec := range records {
+		byID[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = rec.Details
+	}
+	result := make([]*types.Struct, 0, len(ids))
+	for _, id := range ids {
+		if details, ok := byID[id]; ok {
+			result = append(result, pbtypes.Map(details, keys...))
+		}
+	}
+	return result, nil
+}
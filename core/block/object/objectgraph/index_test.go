@@ -0,0 +1,198 @@
+package objectgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func makeObject(id string, links ...string) objectstore.TestObject {
+	return objectstore.TestObject{
+		bundle.RelationKeyId:      pbtypes.String(id),
+		bundle.RelationKeySpaceId: pbtypes.String("space1"),
+		bundle.RelationKeyLayout:  pbtypes.Int64(int64(model.ObjectType_basic)),
+		bundle.RelationKeyLinks:   pbtypes.StringList(links),
+	}
+}
+
+// newTestIndex returns the index of the graph: a -> b -> c -> d, a -> c, e -> a and orphan f
+func newTestIndex(t *testing.T) (*index, *objectstore.StoreFixture) {
+	store := objectstore.NewStoreFixture(t)
+	store.AddObjects(t, []objectstore.TestObject{
+		makeObject("a", "b", "c"),
+		makeObject("b", "c"),
+		makeObject("c", "d"),
+		makeObject("d"),
+		makeObject("e", "a"),
+		makeObject("f"),
+	})
+	g := NewIndex().(*index)
+	g.objectStore = store
+	require.NoError(t, g.Run(context.Background()))
+	return g, store
+}
+
+func ids(details []*types.Struct) []string {
+	result := make([]string, 0, len(details))
+	for _, d := range details {
+		result = append(result, pbtypes.GetString(d, bundle.RelationKeyId.String()))
+	}
+	return result
+}
+
+func TestIndex_Neighborhood(t *testing.T) {
+	t.Run("one hop in both directions", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		nodes, edges, err := g.Neighborhood(&pb.RpcObjectGraphNeighborhoodRequest{ObjectId: "a"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "e"}, ids(nodes))
+		assert.Equal(t, []*pb.RpcObjectGraphEdge{
+			{Source: "a", Target: "b"},
+			{Source: "a", Target: "c"},
+			{Source: "b", Target: "c"},
+			{Source: "e", Target: "a"},
+		}, edges)
+	})
+	t.Run("outgoing links within two hops", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		nodes, _, err := g.Neighborhood(&pb.RpcObjectGraphNeighborhoodRequest{
+			ObjectId:  "b",
+			Depth:     2,
+			Direction: pb.RpcObjectGraph_Outgoing,
+			Keys:      []string{bundle.RelationKeyId.String()},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b", "c", "d"}, ids(nodes))
+		assert.Len(t, nodes[0].Fields, 1)
+	})
+	t.Run("archived object is not in the graph", func(t *testing.T) {
+		// given
+		g, store := newTestIndex(t)
+		obj := makeObject("e", "a")
+		obj[bundle.RelationKeyIsArchived] = pbtypes.Bool(true)
+		store.AddObjects(t, []objectstore.TestObject{obj})
+
+		// when
+		nodes, _, err := g.Neighborhood(&pb.RpcObjectGraphNeighborhoodRequest{ObjectId: "a", Direction: pb.RpcObjectGraph_Incoming})
+		_, _, errArchived := g.Neighborhood(&pb.RpcObjectGraphNeighborhoodRequest{ObjectId: "e"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, ids(nodes))
+		assert.ErrorIs(t, errArchived, ErrNotFound)
+	})
+	t.Run("too deep", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		_, _, err := g.Neighborhood(&pb.RpcObjectGraphNeighborhoodRequest{ObjectId: "a", Depth: maxNeighborhoodDepth + 1})
+
+		// then
+		assert.ErrorIs(t, err, ErrBadInput)
+	})
+}
+
+func TestIndex_Path(t *testing.T) {
+	t.Run("shortest path by links", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		nodes, edges, err := g.Path(&pb.RpcObjectGraphPathRequest{SourceId: "e", TargetId: "d", Direction: pb.RpcObjectGraph_Outgoing})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"e", "a", "c", "d"}, ids(nodes))
+		assert.Equal(t, []*pb.RpcObjectGraphEdge{
+			{Source: "e", Target: "a"},
+			{Source: "a", Target: "c"},
+			{Source: "c", Target: "d"},
+		}, edges)
+	})
+	t.Run("path against links", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		_, _, errOutgoing := g.Path(&pb.RpcObjectGraphPathRequest{SourceId: "d", TargetId: "b", Direction: pb.RpcObjectGraph_Outgoing})
+		nodes, edges, err := g.Path(&pb.RpcObjectGraphPathRequest{SourceId: "d", TargetId: "b"})
+
+		// then
+		assert.ErrorIs(t, errOutgoing, ErrNoPath)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"d", "c", "b"}, ids(nodes))
+		assert.Equal(t, []*pb.RpcObjectGraphEdge{
+			{Source: "c", Target: "d"},
+			{Source: "b", Target: "c"},
+		}, edges)
+	})
+	t.Run("path is longer than max depth", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		_, _, err := g.Path(&pb.RpcObjectGraphPathRequest{SourceId: "e", TargetId: "d", MaxDepth: 2})
+
+		// then
+		assert.ErrorIs(t, err, ErrNoPath)
+	})
+	t.Run("unknown object", func(t *testing.T) {
+		// given
+		g, _ := newTestIndex(t)
+
+		// when
+		_, _, err := g.Path(&pb.RpcObjectGraphPathRequest{SourceId: "a", TargetId: "x"})
+
+		// then
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestIndex_Orphans(t *testing.T) {
+	t.Run("objects without links", func(t *testing.T) {
+		// given
+		g, store := newTestIndex(t)
+		relation := makeObject("rel")
+		relation[bundle.RelationKeyLayout] = pbtypes.Int64(int64(model.ObjectType_relation))
+		store.AddObjects(t, []objectstore.TestObject{relation})
+
+		// when
+		records, err := g.Orphans(&pb.RpcObjectGraphOrphansRequest{SpaceId: "space1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"f"}, ids(records))
+	})
+	t.Run("object becomes orphan, when links are removed", func(t *testing.T) {
+		// given
+		g, store := newTestIndex(t)
+
+		// when
+		store.AddObjects(t, []objectstore.TestObject{makeObject("e")})
+		records, err := g.Orphans(&pb.RpcObjectGraphOrphansRequest{SpaceId: "space1"})
+
+		// then
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"e", "f"}, ids(records))
+	})
+}
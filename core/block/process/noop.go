@@ -1,13 +1,21 @@
 package process
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/anyproto/anytype-heart/pb"
 )
 
-type noOp struct{}
+// noOp is progress, which isn't shown to the client. It still can be canceled, so process, which is run
+// by other service, is stopped with it
+type noOp struct {
+	cancel chan struct{}
+	once   sync.Once
+}
 
 func NewNoOp() Progress {
-	return &noOp{}
+	return &noOp{cancel: make(chan struct{})}
 }
 
 // nolint:revive
@@ -16,7 +24,10 @@ func (n *noOp) Id() string {
 }
 
 func (n *noOp) Cancel() (err error) {
-	return err
+	n.once.Do(func() {
+		close(n.cancel)
+	})
+	return nil
 }
 
 func (n *noOp) Info() pb.ModelProcess {
@@ -40,12 +51,20 @@ func (n *noOp) SetProgressMessage(msg string) {
 }
 
 func (n *noOp) Canceled() chan struct{} {
-	return nil
+	return n.cancel
 }
 
 func (n *noOp) Finish(error) {
 }
 
 func (n *noOp) TryStep(delta int64) error {
+	select {
+	case <-n.cancel:
+		return fmt.Errorf("cancelled import")
+	default:
+	}
 	return nil
 }
+
+func (n *noOp) ReportError(string, error, pb.EventProcessErrorSeverity) {
+}
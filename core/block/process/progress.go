@@ -19,14 +19,17 @@ type Progress interface {
 	Canceled() chan struct{}
 	Finish(err error)
 	TryStep(delta int64) error
+	// ReportError sends error or warning to the client while process is running
+	ReportError(fileName string, err error, severity pb.EventProcessErrorSeverity)
 }
 
 func NewProgress(pType pb.ModelProcessType) Progress {
 	return &progress{
-		id:     bson.NewObjectId().Hex(),
-		done:   make(chan struct{}),
-		cancel: make(chan struct{}),
-		pType:  pType,
+		id:             bson.NewObjectId().Hex(),
+		done:           make(chan struct{}),
+		cancel:         make(chan struct{}),
+		errorsReported: make(chan struct{}, 1),
+		pType:          pType,
 	}
 }
 
@@ -42,6 +45,9 @@ type progress struct {
 	isCancelled         bool
 	isDone              bool
 	isFinishedWithError bool
+
+	errors         []*pb.EventProcessError
+	errorsReported chan struct{}
 }
 
 func (p *progress) SetTotal(total int64) {
@@ -139,3 +145,34 @@ func (p *progress) TryStep(delta int64) error {
 
 	return nil
 }
+
+func (p *progress) ReportError(fileName string, err error, severity pb.EventProcessErrorSeverity) {
+	if err == nil {
+		return
+	}
+	p.m.Lock()
+	p.errors = append(p.errors, &pb.EventProcessError{
+		ProcessId:   p.id,
+		FileName:    fileName,
+		Description: err.Error(),
+		Severity:    severity,
+	})
+	p.m.Unlock()
+	select {
+	case p.errorsReported <- struct{}{}:
+	default:
+	}
+}
+
+func (p *progress) ErrorsReported() chan struct{} {
+	return p.errorsReported
+}
+
+// TakeErrors returns errors reported since the previous call
+func (p *progress) TakeErrors() []*pb.EventProcessError {
+	p.m.Lock()
+	defer p.m.Unlock()
+	errs := p.errors
+	p.errors = nil
+	return errs
+}
@@ -27,6 +27,12 @@ type Process interface {
 	Done() chan struct{}
 }
 
+// errorReporter is implemented by processes, which report errors while they are running
+type errorReporter interface {
+	ErrorsReported() chan struct{}
+	TakeErrors() []*pb.EventProcessError
+}
+
 type Service interface {
 	// Add adds new process to pool
 	Add(p Process) (err error)
@@ -85,9 +91,18 @@ func (s *service) monitor(p Process) {
 			},
 		},
 	})
-	var prevInfo = info
+	var (
+		prevInfo       = info
+		errorsReported chan struct{}
+	)
+	reporter, ok := p.(errorReporter)
+	if ok {
+		errorsReported = reporter.ErrorsReported()
+	}
 	for {
 		select {
+		case <-errorsReported:
+			s.sendErrors(reporter)
 		case <-ticker.C:
 			info := p.Info()
 			if !infoEquals(info, prevInfo) {
@@ -105,6 +120,9 @@ func (s *service) monitor(p Process) {
 				prevInfo = info
 			}
 		case <-p.Done():
+			if reporter != nil {
+				s.sendErrors(reporter)
+			}
 			info := p.Info()
 			s.eventSender.Broadcast(&pb.Event{
 				Messages: []*pb.EventMessage{
@@ -169,6 +187,22 @@ func (s *service) Close(ctx context.Context) (err error) {
 	return nil
 }
 
+func (s *service) sendErrors(reporter errorReporter) {
+	errs := reporter.TakeErrors()
+	if len(errs) == 0 {
+		return
+	}
+	messages := make([]*pb.EventMessage, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, &pb.EventMessage{
+			Value: &pb.EventMessageValueOfProcessError{
+				ProcessError: err,
+			},
+		})
+	}
+	s.eventSender.Broadcast(&pb.Event{Messages: messages})
+}
+
 func infoEquals(i1, i2 pb.ModelProcess) bool {
 	return reflect.DeepEqual(i1, i2)
 }
@@ -2,6 +2,7 @@ package process
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -59,6 +60,39 @@ func TestService_Cancel(t *testing.T) {
 	}
 }
 
+func TestService_ReportError(t *testing.T) {
+	// given
+	var events = make(chan *pb.Event, 20)
+	s := NewTest(t, func(e *pb.Event) {
+		events <- e
+	})
+	p := NewProgress(pb.ModelProcess_Import)
+	require.NoError(t, s.Add(p))
+
+	// when
+	p.ReportError("file.md", fmt.Errorf("broken link"), pb.EventProcessError_Warning)
+
+	// then
+	var processError *pb.EventProcessError
+	for processError == nil {
+		select {
+		case e := <-events:
+			assert.Nil(t, e.Messages[0].GetProcessDone())
+			processError = e.Messages[0].GetProcessError()
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	assert.Equal(t, &pb.EventProcessError{
+		ProcessId:   p.Id(),
+		FileName:    "file.md",
+		Description: "broken link",
+		Severity:    pb.EventProcessError_Warning,
+	}, processError)
+	p.Finish(nil)
+	assert.NoError(t, s.Close(context.Background()))
+}
+
 func newTestProcess(id string) *testProcess {
 	return &testProcess{
 		id:   id,
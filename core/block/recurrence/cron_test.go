@@ -0,0 +1,75 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, tc := range []struct {
+		expr  string
+		valid bool
+	}{
+		{expr: "0 9 * * *", valid: true},
+		{expr: "*/15 8-18 * * 1-5", valid: true},
+		{expr: "0 0 1,15 * *", valid: true},
+		{expr: "0 9 * *"},
+		{expr: "60 9 * * *"},
+		{expr: "0 9 * * 7"},
+		{expr: "0 9 */0 * *"},
+		{expr: "0 9 5-1 * *"},
+		{expr: "a 9 * * *"},
+	} {
+		t.Run(tc.expr, func(t *testing.T) {
+			_, err := parseCron(tc.expr)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	// 2023-11-15 is Wednesday
+	after := time.Date(2023, 11, 15, 10, 30, 20, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{name: "daily", expr: "0 9 * * *", expected: time.Date(2023, 11, 16, 9, 0, 0, 0, time.UTC)},
+		{name: "later today", expr: "45 10 * * *", expected: time.Date(2023, 11, 15, 10, 45, 0, 0, time.UTC)},
+		{name: "step of minutes", expr: "*/20 * * * *", expected: time.Date(2023, 11, 15, 10, 40, 0, 0, time.UTC)},
+		{name: "weekly on monday", expr: "0 9 * * 1", expected: time.Date(2023, 11, 20, 9, 0, 0, 0, time.UTC)},
+		{name: "monthly", expr: "0 0 1 * *", expected: time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "yearly", expr: "0 0 1 1 *", expected: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "day of month or day of week", expr: "0 9 20 * 5", expected: time.Date(2023, 11, 17, 9, 0, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 0 29 2 *", expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			schedule, err := parseCron(tc.expr)
+			require.NoError(t, err)
+
+			// when
+			next, ok := schedule.next(after)
+
+			// then
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+
+	t.Run("impossible date", func(t *testing.T) {
+		schedule, err := parseCron("0 0 31 2 *")
+		require.NoError(t, err)
+		_, ok := schedule.next(after)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,403 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "recurrence"
+
+var log = logging.Logger("anytype-mw-recurrence")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("recurrence is not found")
+)
+
+const (
+	recurrenceKeyPrefix = "/recurrence/"
+	// maxCatchUp limits the number of objects created for missed occurrences of one recurrence
+	maxCatchUp     = 100
+	nameDateLayout = "2006-01-02"
+)
+
+// Service creates objects from templates by their schedules. Occurrences missed while the app was closed
+// are handled on start according to the catch-up option of the recurrence
+type Service interface {
+	Set(recurrence *pb.RpcTemplateRecurrence) error
+	Remove(templateID string) error
+	List() ([]*pb.RpcTemplateRecurrence, error)
+	app.ComponentRunnable
+}
+
+type objectCreator interface {
+	CreateObject(ctx context.Context, spaceID string, req objectcreator.CreateObjectRequest) (id string, details *types.Struct, err error)
+}
+
+type collectionAdder interface {
+	Add(ctx session.Context, req *pb.RpcObjectCollectionAddRequest) error
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+type service struct {
+	objectStore   objectstore.ObjectStore
+	objectCreator objectCreator
+	collections   collectionAdder
+	db            *badger.DB
+	readOnly      bool
+
+	// changed wakes up the loop to reschedule the next occurrence
+	changed chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	now     func() time.Time
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.objectCreator = app.MustComponent[objectCreator](a)
+	s.collections = app.MustComponent[collectionAdder](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
+	s.changed = make(chan struct{}, 1)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	// objects aren't created in read-only mode, so missed occurrences are caught up on the next normal start
+	if s.readOnly {
+		return nil
+	}
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// Set saves the recurrence of the template. The first object is created on the next occurrence after now
+func (s *service) Set(recurrence *pb.RpcTemplateRecurrence) error {
+	if s.readOnly {
+		return domain.ErrReadOnlyMode
+	}
+	if err := validateRecurrence(recurrence); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recurrence = &pb.RpcTemplateRecurrence{
+		TemplateId:   recurrence.TemplateId,
+		Cron:         recurrence.Cron,
+		CollectionId: recurrence.CollectionId,
+		CatchUp:      recurrence.CatchUp,
+		LastRunAt:    s.now().Unix(),
+	}
+	if err := badgerhelper.SetValue(s.db, recurrenceKey(recurrence.TemplateId), recurrence); err != nil {
+		return fmt.Errorf("save recurrence: %w", err)
+	}
+	s.notify()
+	return nil
+}
+
+func (s *service) Remove(templateID string) error {
+	if s.readOnly {
+		return domain.ErrReadOnlyMode
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.get(templateID); err != nil {
+		return err
+	}
+	if err := badgerhelper.DeleteValue(s.db, recurrenceKey(templateID)); err != nil {
+		return fmt.Errorf("remove recurrence: %w", err)
+	}
+	s.notify()
+	return nil
+}
+
+// List returns recurrences sorted by template id
+func (s *service) List() ([]*pb.RpcTemplateRecurrence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list()
+}
+
+func validateRecurrence(recurrence *pb.RpcTemplateRecurrence) error {
+	if recurrence == nil {
+		return fmt.Errorf("%w: recurrence is empty", ErrBadInput)
+	}
+	if recurrence.TemplateId == "" {
+		return fmt.Errorf("%w: template is required", ErrBadInput)
+	}
+	if _, err := parseCron(recurrence.Cron); err != nil {
+		return fmt.Errorf("%w: invalid cron: %w", ErrBadInput, err)
+	}
+	return nil
+}
+
+func recurrenceKey(templateID string) []byte {
+	return []byte(recurrenceKeyPrefix + templateID)
+}
+
+func (s *service) notify() {
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (s *service) get(templateID string) (*pb.RpcTemplateRecurrence, error) {
+	recurrence, err := badgerhelper.GetValue(s.db, recurrenceKey(templateID), unmarshalRecurrence)
+	if badgerhelper.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	return recurrence, err
+}
+
+func unmarshalRecurrence(raw []byte) (*pb.RpcTemplateRecurrence, error) {
+	recurrence := &pb.RpcTemplateRecurrence{}
+	return recurrence, recurrence.Unmarshal(raw)
+}
+
+func (s *service) list() ([]*pb.RpcTemplateRecurrence, error) {
+	var recurrences []*pb.RpcTemplateRecurrence
+	err := s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(recurrenceKeyPrefix), PrefetchValues: true})
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			recurrence, err := unmarshalRecurrence(raw)
+			if err != nil {
+				return fmt.Errorf("unmarshal recurrence: %w", err)
+			}
+			recurrences = append(recurrences, recurrence)
+		}
+		return nil
+	})
+	sort.Slice(recurrences, func(i, j int) bool {
+		return recurrences[i].TemplateId < recurrences[j].TemplateId
+	})
+	return recurrences, err
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		// missed occurrences are due right after start
+		s.runDue()
+		wait, ok := s.nextOccurrenceIn()
+		var (
+			timer *time.Timer
+			fire  <-chan time.Time
+		)
+		if ok {
+			timer = time.NewTimer(wait)
+			fire = timer.C
+		}
+		select {
+		case <-s.ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-s.changed:
+			if timer != nil {
+				timer.Stop()
+			}
+		case <-fire:
+		}
+	}
+}
+
+// nextOccurrenceIn returns time left to the nearest occurrence of all recurrences, ok is false if there are none
+func (s *service) nextOccurrenceIn() (wait time.Duration, ok bool) {
+	recurrences, err := s.List()
+	if err != nil {
+		log.Errorf("list recurrences: %v", err)
+		return 0, false
+	}
+	var nearest time.Time
+	for _, recurrence := range recurrences {
+		schedule, err := parseCron(recurrence.Cron)
+		if err != nil {
+			continue
+		}
+		next, found := schedule.next(time.Unix(recurrence.LastRunAt, 0))
+		if found && (nearest.IsZero() || next.Before(nearest)) {
+			nearest = next
+		}
+	}
+	if nearest.IsZero() {
+		return 0, false
+	}
+	if wait = nearest.Sub(s.now()); wait < 0 {
+		wait = 0
+	}
+	return wait, true
+}
+
+// runDue creates objects for occurrences between the last run of every recurrence and now
+func (s *service) runDue() {
+	recurrences, err := s.List()
+	if err != nil {
+		log.Errorf("list recurrences: %v", err)
+		return
+	}
+	now := s.now()
+	for _, recurrence := range recurrences {
+		schedule, err := parseCron(recurrence.Cron)
+		if err != nil {
+			log.With("templateId", recurrence.TemplateId).Errorf("invalid cron: %v", err)
+			continue
+		}
+		occurrences := dueOccurrences(schedule, recurrence.CatchUp, time.Unix(recurrence.LastRunAt, 0), now)
+		if len(occurrences) == 0 {
+			continue
+		}
+		for _, occurrence := range occurrences {
+			if err = s.create(recurrence, occurrence); err != nil {
+				log.With("templateId", recurrence.TemplateId).Errorf("create object: %v", err)
+				break
+			}
+		}
+		// failed creation isn't repeated, otherwise the broken template creates objects on every check
+		if err = s.updateLastRunAt(recurrence.TemplateId, now); err != nil {
+			log.With("templateId", recurrence.TemplateId).Errorf("save last run: %v", err)
+		}
+	}
+}
+
+// dueOccurrences returns occurrences after the last run until now, which should be created according to catch-up.
+// The last occurrence is created on time, so only older ones are missed
+func dueOccurrences(schedule *cronSchedule, catchUp pb.RpcTemplateRecurrenceCatchUp, lastRun, now time.Time) []time.Time {
+	var occurrences []time.Time
+	for t, ok := schedule.next(lastRun); ok && !t.After(now); t, ok = schedule.next(t) {
+		occurrences = append(occurrences, t)
+		if len(occurrences) > maxCatchUp {
+			occurrences = occurrences[1:]
+		}
+	}
+	if len(occurrences) == 0 {
+		return nil
+	}
+	latest := occurrences[len(occurrences)-1]
+	switch catchUp {
+	case pb.RpcTemplateRecurrence_All:
+		return occurrences
+	case pb.RpcTemplateRecurrence_None:
+		// occurrence is on time, if it's checked in the minute of occurrence
+		if now.Sub(latest) < time.Minute {
+			return []time.Time{latest}
+		}
+		return nil
+	default:
+		return []time.Time{latest}
+	}
+}
+
+func (s *service) create(recurrence *pb.RpcTemplateRecurrence, occurrence time.Time) error {
+	templateDetails, err := s.objectStore.GetDetails(recurrence.TemplateId)
+	if err != nil {
+		return fmt.Errorf("get template: %w", err)
+	}
+	details := templateDetails.GetDetails()
+	if pbtypes.GetBool(details, bundle.RelationKeyIsDeleted.String()) || pbtypes.GetString(details, bundle.RelationKeySpaceId.String()) == "" {
+		return fmt.Errorf("template is deleted")
+	}
+	objectTypeKey, err := s.targetTypeKey(details)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(pbtypes.GetString(details, bundle.RelationKeyName.String()) + " " + occurrence.Format(nameDateLayout))
+	id, _, err := s.objectCreator.CreateObject(s.ctx, pbtypes.GetString(details, bundle.RelationKeySpaceId.String()), objectcreator.CreateObjectRequest{
+		ObjectTypeKey: objectTypeKey,
+		TemplateId:    recurrence.TemplateId,
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String(): pbtypes.String(name),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if recurrence.CollectionId == "" {
+		return nil
+	}
+	return s.collections.Add(nil, &pb.RpcObjectCollectionAddRequest{ContextId: recurrence.CollectionId, ObjectIds: []string{id}})
+}
+
+// targetTypeKey returns key of the type, which objects are created by the template
+func (s *service) targetTypeKey(templateDetails *types.Struct) (domain.TypeKey, error) {
+	typeID := pbtypes.GetString(templateDetails, bundle.RelationKeyTargetObjectType.String())
+	if typeID == "" {
+		return bundle.TypeKeyPage, nil
+	}
+	typeDetails, err := s.objectStore.GetDetails(typeID)
+	if err != nil {
+		return "", fmt.Errorf("get type of template: %w", err)
+	}
+	uniqueKey, err := domain.UnmarshalUniqueKey(pbtypes.GetString(typeDetails.GetDetails(), bundle.RelationKeyUniqueKey.String()))
+	if err != nil {
+		return "", fmt.Errorf("get unique key of type: %w", err)
+	}
+	return domain.TypeKey(uniqueKey.InternalKey()), nil
+}
+
+func (s *service) updateLastRunAt(templateID string, t time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recurrence, err := s.get(templateID)
+	if errors.Is(err, ErrNotFound) {
+		// recurrence is removed while objects were created
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	recurrence.LastRunAt = t.Unix()
+	return badgerhelper.SetValue(s.db, recurrenceKey(templateID), recurrence)
+}
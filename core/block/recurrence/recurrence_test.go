@@ -0,0 +1,65 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestDueOccurrences(t *testing.T) {
+	schedule, err := parseCron("0 9 * * *")
+	require.NoError(t, err)
+	lastRun := time.Date(2023, 11, 10, 12, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time {
+		return time.Date(2023, 11, d, 9, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("nothing is due", func(t *testing.T) {
+		now := time.Date(2023, 11, 11, 8, 0, 0, 0, time.UTC)
+		assert.Empty(t, dueOccurrences(schedule, pb.RpcTemplateRecurrence_All, lastRun, now))
+	})
+	t.Run("occurrence on time", func(t *testing.T) {
+		for _, catchUp := range []pb.RpcTemplateRecurrenceCatchUp{
+			pb.RpcTemplateRecurrence_Latest, pb.RpcTemplateRecurrence_All, pb.RpcTemplateRecurrence_None,
+		} {
+			assert.Equal(t, []time.Time{day(11)}, dueOccurrences(schedule, catchUp, lastRun, day(11)), catchUp.String())
+		}
+	})
+	t.Run("catch up all missed", func(t *testing.T) {
+		now := time.Date(2023, 11, 13, 18, 0, 0, 0, time.UTC)
+		assert.Equal(t, []time.Time{day(11), day(12), day(13)}, dueOccurrences(schedule, pb.RpcTemplateRecurrence_All, lastRun, now))
+	})
+	t.Run("catch up latest missed", func(t *testing.T) {
+		now := time.Date(2023, 11, 13, 18, 0, 0, 0, time.UTC)
+		assert.Equal(t, []time.Time{day(13)}, dueOccurrences(schedule, pb.RpcTemplateRecurrence_Latest, lastRun, now))
+	})
+	t.Run("skip missed", func(t *testing.T) {
+		now := time.Date(2023, 11, 13, 18, 0, 0, 0, time.UTC)
+		assert.Empty(t, dueOccurrences(schedule, pb.RpcTemplateRecurrence_None, lastRun, now))
+	})
+	t.Run("number of missed is limited", func(t *testing.T) {
+		now := lastRun.AddDate(1, 0, 0)
+		occurrences := dueOccurrences(schedule, pb.RpcTemplateRecurrence_All, lastRun, now)
+		require.Len(t, occurrences, maxCatchUp)
+		assert.Equal(t, time.Date(2024, 11, 10, 9, 0, 0, 0, time.UTC), occurrences[maxCatchUp-1])
+	})
+}
+
+func TestService_ReadOnly(t *testing.T) {
+	// given
+	s := New().(*service)
+	s.readOnly = true
+
+	// when
+	setErr := s.Set(&pb.RpcTemplateRecurrence{TemplateId: "template", Cron: "0 9 * * *"})
+	removeErr := s.Remove("template")
+
+	// then
+	assert.ErrorIs(t, setErr, domain.ErrReadOnlyMode)
+	assert.ErrorIs(t, removeErr, domain.ErrReadOnlyMode)
+}
@@ -194,6 +194,9 @@ func (or ObjectRestrictions) ToPB() *types.Value {
 }
 
 func (s *service) getObjectRestrictions(rh RestrictionHolder) (r ObjectRestrictions) {
+	if s.readOnly {
+		return objRestrictAll
+	}
 	uk := rh.UniqueKey()
 	if uk != nil {
 		return GetRestrictionsForUniqueKey(rh.UniqueKey())
@@ -128,3 +128,17 @@ func TestTemplateRestriction(t *testing.T) {
 		model.Restrictions_Template,
 	))
 }
+
+func TestReadOnlyRestriction(t *testing.T) {
+	// given
+	rest := &service{readOnly: true}
+
+	// when
+	r := rest.GetRestrictions(&restrictionHolder{
+		sbType:       coresb.SmartBlockTypePage,
+		objectTypeID: bundle.TypeKeyPage.URL(),
+	})
+
+	// then
+	assert.ErrorIs(t, r.Object.Check(model.Restrictions_Blocks, model.Restrictions_Details), ErrRestricted)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/anyproto/any-sync/app"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -29,6 +30,8 @@ type Service interface {
 
 type service struct {
 	objectStore objectstore.ObjectStore
+	// readOnly restricts every object, when account is opened in read-only mode
+	readOnly bool
 }
 
 func New() Service {
@@ -37,6 +40,9 @@ func New() Service {
 
 func (s *service) Init(a *app.App) (err error) {
 	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
 	return
 }
 
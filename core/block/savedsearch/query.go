@@ -0,0 +1,66 @@
+package savedsearch
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// subscriber keeps parameters of subscription to saved search, so subscription is renewed with them,
+// when the query is changed
+type subscriber struct {
+	objectID          string
+	keys              []string
+	limit             int64
+	noDepSubscription bool
+}
+
+// encodeQuery returns value of savedSearchQuery relation
+func encodeQuery(query *pb.RpcSavedSearchQuery) (string, error) {
+	if query == nil {
+		return "", fmt.Errorf("%w: query is empty", ErrBadInput)
+	}
+	for _, filter := range query.Filters {
+		if filter.RelationKey == "" {
+			return "", fmt.Errorf("%w: relation of filter is empty", ErrBadInput)
+		}
+	}
+	return (&jsonpb.Marshaler{}).MarshalToString(query)
+}
+
+func decodeQuery(details *types.Struct) (*pb.RpcSavedSearchQuery, error) {
+	query := &pb.RpcSavedSearchQuery{}
+	err := jsonpb.UnmarshalString(pbtypes.GetString(details, bundle.RelationKeySavedSearchQuery.String()), query)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal query: %w", err)
+	}
+	return query, nil
+}
+
+func isSavedSearch(details *types.Struct) bool {
+	return pbtypes.GetString(details, bundle.RelationKeySavedSearchQuery.String()) != ""
+}
+
+// subscribeRequest returns request of subscription to objects of the space matching the query
+func subscribeRequest(subID, spaceID string, query *pb.RpcSavedSearchQuery, sub subscriber) pb.RpcObjectSearchSubscribeRequest {
+	filters := append([]*model.BlockContentDataviewFilter{{
+		RelationKey: bundle.RelationKeySpaceId.String(),
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.String(spaceID),
+	}}, query.Filters...)
+	return pb.RpcObjectSearchSubscribeRequest{
+		SubId:             subID,
+		Filters:           filters,
+		Sorts:             query.Sorts,
+		Source:            query.Source,
+		Keys:              sub.keys,
+		Limit:             sub.limit,
+		NoDepSubscription: sub.noDepSubscription,
+	}
+}
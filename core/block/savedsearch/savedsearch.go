@@ -0,0 +1,250 @@
+package savedsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "savedsearch"
+
+var log = logging.Logger("anytype-mw-savedsearch")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("saved search is not found")
+)
+
+const changesQueueSize = 256
+
+// Service keeps search queries as objects with savedSearchQuery relation. Subscription to saved search is usual
+// subscription of objects, so clients receive changes of matching objects as events. When the query is changed,
+// e.g. on another device, subscriptions are renewed and clients receive new records
+type Service interface {
+	Create(ctx context.Context, spaceID, name string, query *pb.RpcSavedSearchQuery) (id string, err error)
+	Update(objectID, name string, query *pb.RpcSavedSearchQuery) error
+	Subscribe(req *pb.RpcSavedSearchSubscribeRequest) (*pb.RpcObjectSearchSubscribeResponse, error)
+	app.ComponentRunnable
+}
+
+type detailsModifier interface {
+	ModifyDetails(objectId string, modifier func(current *types.Struct) (*types.Struct, error)) error
+}
+
+type objectCreator interface {
+	CreateObject(ctx context.Context, spaceID string, req objectcreator.CreateObjectRequest) (id string, details *types.Struct, err error)
+}
+
+func New() Service {
+	return &service{subscribers: map[string]subscriber{}}
+}
+
+type service struct {
+	objectStore     objectstore.ObjectStore
+	detailsModifier detailsModifier
+	objectCreator   objectCreator
+	subscription    subscription.Service
+	eventSender     event.Sender
+
+	mu sync.Mutex
+	// subscribers by ids of subscriptions
+	subscribers map[string]subscriber
+
+	changes chan queryChange
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// queryChange is the change of query of saved search taken from the object store
+type queryChange struct {
+	objectID string
+	details  *types.Struct
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.detailsModifier = app.MustComponent[detailsModifier](a)
+	s.objectCreator = app.MustComponent[objectCreator](a)
+	s.subscription = app.MustComponent[subscription.Service](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	s.changes = make(chan queryChange, changesQueueSize)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.objectStore.SubscribeForChanges(s.onChange)
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// Create saves the query as new object in the space
+func (s *service) Create(ctx context.Context, spaceID, name string, query *pb.RpcSavedSearchQuery) (string, error) {
+	if spaceID == "" {
+		return "", fmt.Errorf("%w: space is required", ErrBadInput)
+	}
+	raw, err := encodeQuery(query)
+	if err != nil {
+		return "", err
+	}
+	id, _, err := s.objectCreator.CreateObject(ctx, spaceID, objectcreator.CreateObjectRequest{
+		ObjectTypeKey: bundle.TypeKeyPage,
+		Details: &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyName.String():             pbtypes.String(name),
+			bundle.RelationKeySavedSearchQuery.String(): pbtypes.String(raw),
+		}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create object of saved search: %w", err)
+	}
+	return id, nil
+}
+
+// Update replaces name and query of the saved search. Subscriptions are renewed, when the change is indexed
+func (s *service) Update(objectID, name string, query *pb.RpcSavedSearchQuery) error {
+	raw, err := encodeQuery(query)
+	if err != nil {
+		return err
+	}
+	if _, err = s.savedSearchDetails(objectID); err != nil {
+		return err
+	}
+	return s.detailsModifier.ModifyDetails(objectID, func(current *types.Struct) (*types.Struct, error) {
+		current = pbtypes.CopyStruct(current)
+		current.Fields[bundle.RelationKeyName.String()] = pbtypes.String(name)
+		current.Fields[bundle.RelationKeySavedSearchQuery.String()] = pbtypes.String(raw)
+		return current, nil
+	})
+}
+
+func (s *service) Subscribe(req *pb.RpcSavedSearchSubscribeRequest) (*pb.RpcObjectSearchSubscribeResponse, error) {
+	details, err := s.savedSearchDetails(req.ObjectId)
+	if err != nil {
+		return nil, err
+	}
+	query, err := decodeQuery(details)
+	if err != nil {
+		return nil, err
+	}
+	sub := subscriber{
+		objectID:          req.ObjectId,
+		keys:              req.Keys,
+		limit:             req.Limit,
+		noDepSubscription: req.NoDepSubscription,
+	}
+	spaceID := pbtypes.GetString(details, bundle.RelationKeySpaceId.String())
+	resp, err := s.subscription.Search(subscribeRequest(req.SubId, spaceID, query, sub))
+	if err != nil {
+		return nil, fmt.Errorf("subscribe: %w", err)
+	}
+	s.mu.Lock()
+	s.subscribers[resp.SubId] = sub
+	s.mu.Unlock()
+	return resp, nil
+}
+
+func (s *service) savedSearchDetails(objectID string) (*types.Struct, error) {
+	if objectID == "" {
+		return nil, fmt.Errorf("%w: object id is empty", ErrBadInput)
+	}
+	details, err := s.objectStore.GetDetails(objectID)
+	if err != nil {
+		return nil, fmt.Errorf("get details of saved search: %w", err)
+	}
+	if !isSavedSearch(details.GetDetails()) || pbtypes.GetBool(details.GetDetails(), bundle.RelationKeyIsDeleted.String()) {
+		return nil, ErrNotFound
+	}
+	return details.GetDetails(), nil
+}
+
+// onChange is called by the object store, so the change of query is only queued and handled by the loop
+func (s *service) onChange(id string, oldDetails, newDetails *types.Struct) {
+	oldQuery := pbtypes.GetString(oldDetails, bundle.RelationKeySavedSearchQuery.String())
+	newQuery := pbtypes.GetString(newDetails, bundle.RelationKeySavedSearchQuery.String())
+	if oldQuery == newQuery || newQuery == "" {
+		return
+	}
+	select {
+	case s.changes <- queryChange{objectID: id, details: newDetails}:
+	default:
+		log.With("objectId", id).Warnf("saved search queue is full, change of query is skipped")
+	}
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case change := <-s.changes:
+			s.renewSubscriptions(change)
+		}
+	}
+}
+
+// renewSubscriptions subscribes again to the changed query and sends new records to the clients. Subscriptions
+// closed by clients are forgotten
+func (s *service) renewSubscriptions(change queryChange) {
+	query, err := decodeQuery(change.details)
+	if err != nil {
+		log.With("objectId", change.objectID).Errorf("skip invalid query: %s", err)
+		return
+	}
+	spaceID := pbtypes.GetString(change.details, bundle.RelationKeySpaceId.String())
+	active := s.subscription.SubscriptionIDs()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for subID, sub := range s.subscribers {
+		if !slices.Contains(active, subID) {
+			delete(s.subscribers, subID)
+			continue
+		}
+		if sub.objectID != change.objectID {
+			continue
+		}
+		resp, err := s.subscription.Search(subscribeRequest(subID, spaceID, query, sub))
+		if err != nil {
+			log.With("subId", subID).Errorf("renew subscription to saved search: %s", err)
+			continue
+		}
+		s.eventSender.Broadcast(&pb.Event{Messages: []*pb.EventMessage{{
+			Value: &pb.EventMessageValueOfSavedSearchQueryChanged{SavedSearchQueryChanged: &pb.EventSavedSearchQueryChanged{
+				ObjectId:     change.objectID,
+				SubId:        subID,
+				Records:      resp.Records,
+				Dependencies: resp.Dependencies,
+				Counters:     resp.Counters,
+			}},
+		}}})
+	}
+}
@@ -0,0 +1,115 @@
+package savedsearch
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type fakeSubscriptions struct {
+	subscription.Service
+	active   []string
+	requests []pb.RpcObjectSearchSubscribeRequest
+}
+
+func (f *fakeSubscriptions) Search(req pb.RpcObjectSearchSubscribeRequest) (*pb.RpcObjectSearchSubscribeResponse, error) {
+	f.requests = append(f.requests, req)
+	return &pb.RpcObjectSearchSubscribeResponse{
+		SubId:   req.SubId,
+		Records: []*types.Struct{{Fields: map[string]*types.Value{bundle.RelationKeyId.String(): pbtypes.String("task1")}}},
+	}, nil
+}
+
+func (f *fakeSubscriptions) SubscriptionIDs() []string {
+	return f.active
+}
+
+func queryDetails(t *testing.T, status string) *types.Struct {
+	raw, err := encodeQuery(&pb.RpcSavedSearchQuery{Filters: []*model.BlockContentDataviewFilter{{
+		RelationKey: "status",
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.String(status),
+	}}})
+	require.NoError(t, err)
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeySpaceId.String():          pbtypes.String("space1"),
+		bundle.RelationKeySavedSearchQuery.String(): pbtypes.String(raw),
+	}}
+}
+
+func TestRenewSubscriptions(t *testing.T) {
+	// given
+	subscriptions := &fakeSubscriptions{active: []string{"sub1", "sub2"}}
+	var events []*pb.Event
+	s := &service{
+		subscription: subscriptions,
+		eventSender: event.NewCallbackSender(func(e *pb.Event) {
+			events = append(events, e)
+		}),
+		subscribers: map[string]subscriber{
+			"sub1": {objectID: "search1", keys: []string{"id"}},
+			"sub2": {objectID: "search2"},
+			"sub3": {objectID: "search1"},
+		},
+	}
+
+	// when
+	s.renewSubscriptions(queryChange{objectID: "search1", details: queryDetails(t, "done")})
+
+	// then
+	require.Len(t, subscriptions.requests, 1)
+	req := subscriptions.requests[0]
+	assert.Equal(t, "sub1", req.SubId)
+	assert.Equal(t, []string{"id"}, req.Keys)
+	require.Len(t, req.Filters, 2)
+	assert.Equal(t, bundle.RelationKeySpaceId.String(), req.Filters[0].RelationKey)
+	assert.Equal(t, "done", req.Filters[1].Value.GetStringValue())
+
+	require.Len(t, events, 1)
+	changed := events[0].Messages[0].GetSavedSearchQueryChanged()
+	require.NotNil(t, changed)
+	assert.Equal(t, "search1", changed.ObjectId)
+	assert.Equal(t, "sub1", changed.SubId)
+	assert.Len(t, changed.Records, 1)
+
+	// closed subscription is forgotten
+	assert.NotContains(t, s.subscribers, "sub3")
+	assert.Contains(t, s.subscribers, "sub2")
+}
+
+func TestOnChange(t *testing.T) {
+	t.Run("change of query is queued", func(t *testing.T) {
+		// given
+		s := &service{changes: make(chan queryChange, 1)}
+
+		// when
+		s.onChange("search1", queryDetails(t, "todo"), queryDetails(t, "done"))
+
+		// then
+		require.Len(t, s.changes, 1)
+		assert.Equal(t, "search1", (<-s.changes).objectID)
+	})
+	t.Run("other changes are skipped", func(t *testing.T) {
+		// given
+		s := &service{changes: make(chan queryChange, 1)}
+		details := queryDetails(t, "todo")
+		renamed := pbtypes.CopyStruct(details)
+		renamed.Fields[bundle.RelationKeyName.String()] = pbtypes.String("Todo")
+
+		// when
+		s.onChange("search1", details, renamed)
+		s.onChange("task1", nil, &types.Struct{Fields: map[string]*types.Value{}})
+
+		// then
+		assert.Len(t, s.changes, 0)
+	})
+}
@@ -68,6 +68,11 @@ func (l *Latex) Diff(b simple.Block) (msgs []simple.EventMessage, err error) {
 		changes.Text = &pb.EventBlockSetLatexText{Value: latex.content.Text}
 	}
 
+	if l.content.Processor != latex.content.Processor {
+		hasChanges = true
+		changes.Processor = &pb.EventBlockSetLatexProcessor{Value: latex.content.Processor}
+	}
+
 	if hasChanges {
 		msgs = append(msgs, simple.EventMessage{Msg: &pb.EventMessage{Value: &pb.EventMessageValueOfBlockSetLatex{BlockSetLatex: changes}}})
 	}
@@ -82,5 +87,8 @@ func (l *Latex) ApplyEvent(e *pb.EventBlockSetLatex) error {
 	if e.Text != nil {
 		l.content.Text = e.Text.GetValue()
 	}
+	if e.Processor != nil {
+		l.content.Processor = e.Processor.GetValue()
+	}
 	return nil
 }
@@ -57,4 +57,19 @@ func TestLatex_Diff(t *testing.T) {
 			},
 		}), diff)
 	})
+	t.Run("processor diff", func(t *testing.T) {
+		b1 := testBlock()
+		b2 := testBlock()
+		b2.content.Processor = model.BlockContentLatex_Mermaid
+
+		diff, err := b1.Diff(b2)
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		assert.Equal(t, test.MakeEvent(&pb.EventMessageValueOfBlockSetLatex{
+			BlockSetLatex: &pb.EventBlockSetLatex{
+				Id:        b1.Id,
+				Processor: &pb.EventBlockSetLatexProcessor{Value: model.BlockContentLatex_Mermaid},
+			},
+		}), diff)
+	})
 }
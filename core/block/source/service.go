@@ -14,6 +14,7 @@ import (
 	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files"
@@ -65,6 +66,7 @@ type service struct {
 	fileService        files.Service
 	identityService    identityService
 	objectStore        objectstore.ObjectStore
+	readOnly           bool
 
 	mu        sync.Mutex
 	staticIds map[string]Source
@@ -83,6 +85,9 @@ func (s *service) Init(a *app.App) (err error) {
 
 	s.fileService = app.MustComponent[files.Service](a)
 	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
 	return
 }
 
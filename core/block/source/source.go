@@ -158,6 +158,7 @@ func (s *service) newTreeSource(ctx context.Context, space Space, id string, bui
 		sbtProvider:        s.sbtProvider,
 		fileService:        s.fileService,
 		objectStore:        s.objectStore,
+		readOnly:           s.readOnly,
 	}, nil
 }
 
@@ -177,6 +178,7 @@ type source struct {
 	receiver             ChangeReceiver
 	unsubscribe          func()
 	metaOnly             bool
+	readOnly             bool
 	closed               chan struct{}
 	openedAt             time.Time
 
@@ -231,7 +233,7 @@ func (s *source) Rebuild(ot objecttree.ObjectTree) {
 }
 
 func (s *source) ReadOnly() bool {
-	return false
+	return s.readOnly
 }
 
 func (s *source) Id() string {
@@ -314,6 +316,9 @@ type PushChangeParams struct {
 }
 
 func (s *source) PushChange(params PushChangeParams) (id string, err error) {
+	if s.readOnly {
+		return "", domain.ErrReadOnlyMode
+	}
 	if params.Time.IsZero() {
 		params.Time = time.Now()
 	}
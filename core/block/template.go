@@ -0,0 +1,147 @@
+package block
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	templateservice "github.com/anyproto/anytype-heart/core/block/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/internalflag"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+// templateDetailsToSkip are details of the template, which aren't merged into the object
+var templateDetailsToSkip = []string{
+	bundle.RelationKeyId.String(),
+	bundle.RelationKeyType.String(),
+	bundle.RelationKeyLayout.String(),
+	bundle.RelationKeyInternalFlags.String(),
+}
+
+type objectTypeSetter interface {
+	SetObjectTypesInState(s *state.State, objectTypeKeys []domain.TypeKey) (err error)
+	basic.Duplicatable
+}
+
+// SetObjectTypeWithTemplate changes type of the object and applies default template of the new type in the same change.
+// Template replaces body of the empty object, non-empty object is changed according to the strategy
+func (s *Service) SetObjectTypeWithTemplate(
+	ctx session.Context, objectId string, objectTypeUniqueKey string, strategy pb.RpcObjectSetObjectTypeTemplateStrategy,
+) error {
+	objectTypeKey, err := domain.GetTypeKeyFromRawUniqueKey(objectTypeUniqueKey)
+	if err != nil {
+		return fmt.Errorf("get type key from raw unique key: %w", err)
+	}
+	return Do(s, objectId, func(sb smartblock.SmartBlock) error {
+		setter, ok := sb.(objectTypeSetter)
+		if !ok {
+			return fmt.Errorf("type of object %s can't be changed", objectId)
+		}
+		st := sb.NewStateCtx(ctx)
+		if err := setter.SetObjectTypesInState(st, []domain.TypeKey{objectTypeKey}); err != nil {
+			return err
+		}
+		flags := internalflag.NewFromState(st)
+		flags.Remove(model.InternalFlag_editorSelectType)
+		flags.Remove(model.InternalFlag_editorDeleteEmpty)
+		flags.AddToState(st)
+
+		templateID, err := s.defaultTemplateID(sb.SpaceID(), objectTypeKey)
+		if err != nil {
+			return err
+		}
+		if templateID != "" && templateID != templateservice.BlankTemplateID {
+			if err = s.applyTemplateInState(setter, st, templateID, strategy); err != nil {
+				return fmt.Errorf("apply template %s: %w", templateID, err)
+			}
+		}
+		// KeepInternalFlags is set because we allow to choose template further
+		return sb.Apply(st, smartblock.NoRestrictions, smartblock.KeepInternalFlags)
+	})
+}
+
+func (s *Service) defaultTemplateID(spaceID string, objectTypeKey domain.TypeKey) (string, error) {
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypeObjectType, objectTypeKey.String())
+	if err != nil {
+		return "", fmt.Errorf("create unique key: %w", err)
+	}
+	typeDetails, err := s.objectStore.GetObjectByUniqueKey(spaceID, uk)
+	if err != nil {
+		return "", fmt.Errorf("get object type %s: %w", objectTypeKey, err)
+	}
+	return pbtypes.GetString(typeDetails.GetDetails(), bundle.RelationKeyDefaultTemplateId.String()), nil
+}
+
+func (s *Service) applyTemplateInState(
+	dup basic.Duplicatable, st *state.State, templateID string, strategy pb.RpcObjectSetObjectTypeTemplateStrategy,
+) error {
+	empty := isBodyEmpty(st)
+	if !empty && strategy == pb.RpcObjectSetObjectType_Skip {
+		return nil
+	}
+	templateState, err := s.StateFromTemplate(templateID, "")
+	if err != nil {
+		return err
+	}
+	if empty {
+		for _, id := range bodyBlockIDs(st) {
+			st.Unlink(id)
+		}
+	}
+	// blocks are copied with new ids, because the object may be created from the same template
+	if _, err = dup.Duplicate(templateState, st, "", model.Block_Inner, bodyBlockIDs(templateState)); err != nil {
+		return fmt.Errorf("copy blocks: %w", err)
+	}
+	mergeTemplateDetails(st, templateState)
+	return nil
+}
+
+// bodyBlockIDs returns top-level blocks of the object except the header with title and description
+func bodyBlockIDs(st *state.State) []string {
+	root := st.Pick(st.RootId())
+	if root == nil {
+		return nil
+	}
+	return slice.Filter(root.Model().ChildrenIds, func(id string) bool {
+		return id != template.HeaderLayoutId
+	})
+}
+
+// isBodyEmpty checks that the body of the object has only empty text blocks, like new page
+func isBodyEmpty(st *state.State) bool {
+	for _, id := range bodyBlockIDs(st) {
+		b := st.Pick(id)
+		if b == nil {
+			continue
+		}
+		text := b.Model().GetText()
+		if text == nil || text.Text != "" || len(b.Model().ChildrenIds) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeTemplateDetails sets details of the template, which are empty in the object, so values set by user are kept
+func mergeTemplateDetails(st, templateState *state.State) {
+	details := pbtypes.ValueGetter(st.Details())
+	for key, value := range templateState.Details().GetFields() {
+		if slice.FindPos(templateDetailsToSkip, key) >= 0 || !(database.FilterEmpty{Key: key}).FilterObject(details) {
+			continue
+		}
+		st.SetDetail(key, pbtypes.CopyVal(value))
+		if link := templateState.GetRelationLinks().Get(key); link != nil {
+			st.AddRelationLinks(link)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package block
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func newObjectState(texts ...string) *state.State {
+	blocks := map[string]simple.Block{
+		template.HeaderLayoutId: simple.New(&model.Block{Id: template.HeaderLayoutId}),
+	}
+	root := &model.Block{Id: "root", ChildrenIds: []string{template.HeaderLayoutId}}
+	for i, text := range texts {
+		id := "text" + strconv.Itoa(i)
+		blocks[id] = simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text}}})
+		root.ChildrenIds = append(root.ChildrenIds, id)
+	}
+	blocks["root"] = simple.New(root)
+	return state.NewDoc("root", blocks).(*state.State)
+}
+
+func TestIsBodyEmpty(t *testing.T) {
+	t.Run("only header", func(t *testing.T) {
+		assert.True(t, isBodyEmpty(newObjectState()))
+	})
+	t.Run("empty text blocks", func(t *testing.T) {
+		assert.True(t, isBodyEmpty(newObjectState("", "")))
+	})
+	t.Run("text", func(t *testing.T) {
+		assert.False(t, isBodyEmpty(newObjectState("", "content")))
+	})
+}
+
+func TestMergeTemplateDetails(t *testing.T) {
+	// given
+	st := newObjectState()
+	st.SetDetails(&types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():   pbtypes.String("My task"),
+		bundle.RelationKeyStatus.String(): pbtypes.String(""),
+	}})
+	templateState := newObjectState()
+	templateState.SetDetails(&types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyName.String():        pbtypes.String("Task template"),
+		bundle.RelationKeyStatus.String():      pbtypes.String("todo"),
+		bundle.RelationKeyDescription.String(): pbtypes.String("description"),
+		bundle.RelationKeyType.String():        pbtypes.String("templateType"),
+	}})
+
+	// when
+	mergeTemplateDetails(st, templateState)
+
+	// then
+	assert.Equal(t, "My task", pbtypes.GetString(st.Details(), bundle.RelationKeyName.String()))
+	assert.Equal(t, "todo", pbtypes.GetString(st.Details(), bundle.RelationKeyStatus.String()))
+	assert.Equal(t, "description", pbtypes.GetString(st.Details(), bundle.RelationKeyDescription.String()))
+	assert.Empty(t, pbtypes.GetString(st.Details(), bundle.RelationKeyType.String()))
+}
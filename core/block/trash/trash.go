@@ -0,0 +1,461 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "trash"
+
+var log = logging.Logger("anytype-mw-trash")
+
+var ErrInvalidRetention = errors.New("invalid trash retention")
+
+const (
+	retentionKey      = "/trash/retention"
+	archivedKeyPrefix = "/trash/archived/"
+
+	// DefaultRetentionDays is the retention period of the policy, which isn't set yet
+	DefaultRetentionDays = 30
+	maxRetentionDays     = 3650
+	checkInterval        = time.Hour
+	batchSize            = 50
+)
+
+// Service keeps the time, when objects were moved to the bin, and periodically deletes objects, which are in the bin
+// longer than the retention period. Files of deleted objects are deleted too, if other objects don't use them
+type Service interface {
+	GetRetention() (*pb.RpcTrashRetention, error)
+	SetRetention(retention *pb.RpcTrashRetention) error
+	app.ComponentRunnable
+}
+
+type objectDeleter interface {
+	DeleteArchivedObject(id string) error
+	DeleteObjectByFullID(id domain.FullID) error
+}
+
+func New() Service {
+	return &service{
+		archivedAt: map[string]int64{},
+		pending:    map[string]int64{},
+		notify:     make(chan struct{}, 1),
+		changed:    make(chan struct{}, 1),
+		now:        time.Now,
+	}
+}
+
+type service struct {
+	deleter     objectDeleter
+	objectStore objectstore.ObjectStore
+	sbtProvider typeprovider.SmartBlockTypeProvider
+	eventSender event.Sender
+	db          *badger.DB
+	readOnly    bool
+
+	mu sync.Mutex
+	// archivedAt is the unix time, when the object was moved to the bin
+	archivedAt map[string]int64
+	// pending are changes of archivedAt, which aren't saved yet, zero time means removal
+	pending map[string]int64
+	notify  chan struct{}
+
+	// changed wakes up the loop to purge objects by the new retention policy
+	changed chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	now     func() time.Time
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.deleter = a.MustComponent(block.CName).(objectDeleter)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.sbtProvider = app.MustComponent[typeprovider.SmartBlockTypeProvider](a)
+	s.eventSender = app.MustComponent[event.Sender](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	// objects aren't deleted in read-only mode, so the bin is purged on the next normal start
+	if s.readOnly {
+		return nil
+	}
+	if err := s.loadArchivedAt(); err != nil {
+		return fmt.Errorf("load archive times: %w", err)
+	}
+	s.objectStore.SubscribeForChanges(s.onChange)
+	if err := s.trackArchived(); err != nil {
+		return fmt.Errorf("track archived objects: %w", err)
+	}
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+func (s *service) GetRetention() (*pb.RpcTrashRetention, error) {
+	retention, err := badgerhelper.GetValue(s.db, []byte(retentionKey), func(raw []byte) (*pb.RpcTrashRetention, error) {
+		retention := &pb.RpcTrashRetention{}
+		return retention, retention.Unmarshal(raw)
+	})
+	if badgerhelper.IsNotFound(err) {
+		return &pb.RpcTrashRetention{Days: DefaultRetentionDays}, nil
+	}
+	return retention, err
+}
+
+// SetRetention saves the policy and purges objects, which are expired by it, right away
+func (s *service) SetRetention(retention *pb.RpcTrashRetention) error {
+	if s.readOnly {
+		return domain.ErrReadOnlyMode
+	}
+	if err := validateRetention(retention); err != nil {
+		return err
+	}
+	if err := badgerhelper.SetValue(s.db, []byte(retentionKey), retention); err != nil {
+		return fmt.Errorf("save retention: %w", err)
+	}
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func validateRetention(retention *pb.RpcTrashRetention) error {
+	if retention == nil {
+		return fmt.Errorf("%w: retention is empty", ErrInvalidRetention)
+	}
+	if !retention.Enabled {
+		return nil
+	}
+	if retention.Days < 1 || retention.Days > maxRetentionDays {
+		return fmt.Errorf("%w: days should be from 1 to %d", ErrInvalidRetention, maxRetentionDays)
+	}
+	return nil
+}
+
+func (s *service) loadArchivedAt() error {
+	return s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(archivedKeyPrefix), PrefetchValues: true})
+		defer iter.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			archivedAt, err := badgerhelper.UnmarshalInt(raw)
+			if err != nil {
+				return fmt.Errorf("unmarshal time: %w", err)
+			}
+			s.archivedAt[strings.TrimPrefix(string(iter.Item().Key()), archivedKeyPrefix)] = int64(archivedAt)
+		}
+		return nil
+	})
+}
+
+// trackArchived adds objects of the bin, which were archived by other devices or before the service was added.
+// Their retention period starts now
+func (s *service) trackArchived() error {
+	ids, _, err := s.objectStore.QueryObjectIDs(database.Query{
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyIsArchived.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	now := s.now().Unix()
+	for _, id := range ids {
+		if _, ok := s.archivedAt[id]; !ok {
+			s.archivedAt[id] = now
+			s.pending[id] = now
+		}
+	}
+	s.mu.Unlock()
+	s.wake()
+	return nil
+}
+
+func (s *service) onChange(id string, _, newDetails *types.Struct) {
+	archived := pbtypes.GetBool(newDetails, bundle.RelationKeyIsArchived.String()) &&
+		!pbtypes.GetBool(newDetails, bundle.RelationKeyIsDeleted.String())
+	s.mu.Lock()
+	_, tracked := s.archivedAt[id]
+	switch {
+	case archived && !tracked:
+		now := s.now().Unix()
+		s.archivedAt[id] = now
+		s.pending[id] = now
+	case !archived && tracked:
+		delete(s.archivedAt, id)
+		s.pending[id] = 0
+	default:
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+	s.wake()
+}
+
+func (s *service) forget(id string) {
+	s.mu.Lock()
+	delete(s.archivedAt, id)
+	s.pending[id] = 0
+	s.mu.Unlock()
+	s.wake()
+}
+
+func (s *service) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	s.purge()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.notify:
+			for s.ctx.Err() == nil && s.savePending() {
+			}
+		case <-s.changed:
+			s.purge()
+		case <-ticker.C:
+			s.purge()
+		}
+	}
+}
+
+// savePending saves the batch of pending changes of archive times and returns false, when there is nothing to do
+func (s *service) savePending() bool {
+	s.mu.Lock()
+	batch := make(map[string]int64, batchSize)
+	for id, archivedAt := range s.pending {
+		if len(batch) >= batchSize {
+			break
+		}
+		delete(s.pending, id)
+		batch[id] = archivedAt
+	}
+	s.mu.Unlock()
+	if len(batch) == 0 {
+		return false
+	}
+	err := s.db.Update(func(txn *badger.Txn) error {
+		for id, archivedAt := range batch {
+			key := []byte(archivedKeyPrefix + id)
+			if archivedAt == 0 {
+				if err := txn.Delete(key); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := badgerhelper.SetValueTxn(txn, key, int(archivedAt)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorf("save archive times: %s", err)
+	}
+	return true
+}
+
+// purge deletes objects, which are in the bin longer than the retention period, in batches of the same space
+func (s *service) purge() {
+	retention, err := s.GetRetention()
+	if err != nil {
+		log.Errorf("get trash retention: %s", err)
+		return
+	}
+	if !retention.Enabled {
+		return
+	}
+	deadline := s.now().Add(-time.Duration(retention.Days) * 24 * time.Hour).Unix()
+	s.mu.Lock()
+	ids := expiredIDs(s.archivedAt, deadline)
+	s.mu.Unlock()
+	if len(ids) == 0 {
+		return
+	}
+
+	records, err := s.objectStore.QueryByID(ids)
+	if err != nil {
+		log.Errorf("query expired objects: %s", err)
+		return
+	}
+	found := make(map[string]struct{}, len(records))
+	bySpace := map[string][]string{}
+	for _, rec := range records {
+		id := pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())
+		found[id] = struct{}{}
+		if pbtypes.GetBool(rec.Details, bundle.RelationKeyIsDeleted.String()) || !pbtypes.GetBool(rec.Details, bundle.RelationKeyIsArchived.String()) {
+			s.forget(id)
+			continue
+		}
+		spaceID := pbtypes.GetString(rec.Details, bundle.RelationKeySpaceId.String())
+		bySpace[spaceID] = append(bySpace[spaceID], id)
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			s.forget(id)
+		}
+	}
+
+	for spaceID, spaceIDs := range bySpace {
+		for start := 0; start < len(spaceIDs); start += batchSize {
+			if s.ctx.Err() != nil {
+				return
+			}
+			end := start + batchSize
+			if end > len(spaceIDs) {
+				end = len(spaceIDs)
+			}
+			s.purgeBatch(spaceID, spaceIDs[start:end])
+		}
+	}
+}
+
+func (s *service) purgeBatch(spaceID string, ids []string) {
+	files := s.linkedFiles(spaceID, ids)
+	s.eventSender.Broadcast(&pb.Event{Messages: []*pb.EventMessage{{
+		Value: &pb.EventMessageValueOfTrashPurge{TrashPurge: &pb.EventTrashPurge{SpaceId: spaceID, Ids: ids}},
+	}}})
+
+	deleted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if err := s.deleter.DeleteArchivedObject(id); err != nil {
+			log.With("objectID", id).Errorf("delete expired object: %s", err)
+			continue
+		}
+		deleted[id] = struct{}{}
+		s.forget(id)
+	}
+
+	for _, fileID := range files {
+		if _, ok := deleted[fileID]; ok {
+			continue
+		}
+		used, err := s.isUsed(fileID, deleted)
+		if err != nil {
+			log.With("fileID", fileID).Errorf("get file links: %s", err)
+			continue
+		}
+		if used {
+			continue
+		}
+		if err = s.deleter.DeleteObjectByFullID(domain.FullID{SpaceID: spaceID, ObjectID: fileID}); err != nil {
+			log.With("fileID", fileID).Errorf("delete file of expired object: %s", err)
+		}
+	}
+}
+
+// linkedFiles returns files of file blocks and relations of objects
+func (s *service) linkedFiles(spaceID string, ids []string) []string {
+	var files []string
+	for _, id := range ids {
+		links, err := s.objectStore.GetOutboundLinksByID(id)
+		if err != nil {
+			log.With("objectID", id).Errorf("get links: %s", err)
+			continue
+		}
+		for _, link := range links {
+			if sbType, err := s.sbtProvider.Type(spaceID, link); err == nil && sbType == coresb.SmartBlockTypeFile {
+				files = append(files, link)
+			}
+		}
+	}
+	return lo.Uniq(files)
+}
+
+// isUsed reports whether the file is linked by objects, which aren't deleted
+func (s *service) isUsed(fileID string, deleted map[string]struct{}) (bool, error) {
+	linking, err := s.objectStore.GetInboundLinksByID(fileID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range linking {
+		if _, ok := deleted[id]; !ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// expiredIDs returns ids of objects moved to the bin before the deadline, the oldest go first
+func expiredIDs(archivedAt map[string]int64, deadline int64) []string {
+	var ids []string
+	for id, t := range archivedAt {
+		if t <= deadline {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if archivedAt[ids[i]] != archivedAt[ids[j]] {
+			return archivedAt[ids[i]] < archivedAt[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
@@ -0,0 +1,116 @@
+package trash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestValidateRetention(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		retention *pb.RpcTrashRetention
+		valid     bool
+	}{
+		{"disabled", &pb.RpcTrashRetention{}, true},
+		{"valid", &pb.RpcTrashRetention{Enabled: true, Days: 30}, true},
+		{"zero days", &pb.RpcTrashRetention{Enabled: true}, false},
+		{"too many days", &pb.RpcTrashRetention{Enabled: true, Days: maxRetentionDays + 1}, false},
+		{"empty", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := validateRetention(tc.retention)
+
+			// then
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidRetention)
+			}
+		})
+	}
+}
+
+func TestExpiredIDs(t *testing.T) {
+	// given
+	archivedAt := map[string]int64{
+		"obj1": 300,
+		"obj2": 100,
+		"obj3": 500,
+		"obj4": 100,
+	}
+
+	// when
+	ids := expiredIDs(archivedAt, 300)
+
+	// then
+	assert.Equal(t, []string{"obj2", "obj4", "obj1"}, ids)
+}
+
+func TestService_onChange(t *testing.T) {
+	details := func(isArchived, isDeleted bool) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsArchived.String(): pbtypes.Bool(isArchived),
+			bundle.RelationKeyIsDeleted.String():  pbtypes.Bool(isDeleted),
+		}}
+	}
+	newService := func(now int64) *service {
+		s := New().(*service)
+		s.now = func() time.Time { return time.Unix(now, 0) }
+		return s
+	}
+
+	t.Run("archived object is tracked from the time it was moved to the bin", func(t *testing.T) {
+		// given
+		s := newService(100)
+
+		// when
+		s.onChange("obj1", details(false, false), details(true, false))
+		s.now = func() time.Time { return time.Unix(200, 0) }
+		s.onChange("obj1", details(true, false), details(true, false))
+
+		// then
+		assert.Equal(t, map[string]int64{"obj1": 100}, s.archivedAt)
+		assert.Equal(t, map[string]int64{"obj1": 100}, s.pending)
+	})
+
+	t.Run("restored and deleted objects are not tracked", func(t *testing.T) {
+		// given
+		s := newService(100)
+		s.onChange("obj1", nil, details(true, false))
+		s.onChange("obj2", nil, details(true, false))
+
+		// when
+		s.onChange("obj1", details(true, false), details(false, false))
+		s.onChange("obj2", details(true, false), details(true, true))
+		s.onChange("obj3", nil, details(false, false))
+
+		// then
+		assert.Empty(t, s.archivedAt)
+		assert.Equal(t, map[string]int64{"obj1": 0, "obj2": 0}, s.pending)
+	})
+}
+
+func TestService_ReadOnly(t *testing.T) {
+	// given
+	s := New().(*service)
+	s.readOnly = true
+
+	// when
+	runErr := s.Run(context.Background())
+	setErr := s.SetRetention(&pb.RpcTrashRetention{Enabled: true, Days: 30})
+
+	// then
+	assert.NoError(t, runErr)
+	assert.ErrorIs(t, setErr, domain.ErrReadOnlyMode)
+	assert.NoError(t, s.Close(context.Background()))
+}
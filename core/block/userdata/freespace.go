@@ -0,0 +1,16 @@
+//go:build !windows
+
+package userdata
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// freeSpace returns number of bytes, which are available to the user on the file system of dir
+func freeSpace(dir string) (uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
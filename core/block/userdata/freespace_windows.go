@@ -0,0 +1,20 @@
+//go:build windows
+
+package userdata
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// freeSpace returns number of bytes, which are available to the user on the volume of dir
+func freeSpace(dir string) (uint64, error) {
+	dirPtr, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, err
+	}
+	var available uint64
+	if err = windows.GetDiskFreeSpaceEx(dirPtr, &available, nil, nil); err != nil {
+		return 0, err
+	}
+	return available, nil
+}
@@ -0,0 +1,189 @@
+package userdata
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// restoreRun keeps spaces and import runs, which are made by restore, so they are rolled back,
+// if restore is canceled or failed
+type restoreRun struct {
+	*service
+	progress      process.Progress
+	createdSpaces []string
+	importRunIDs  []string
+}
+
+// Restore imports spaces of the dump: personal space is imported to the personal space of the account and a new space
+// is created for every other one. Settings of the dump are saved to the config. The dump is validated before
+// anything is changed. Restore is shown to the client as process, which can be canceled. Ids of restored spaces
+// are returned
+func (s *service) Restore(ctx context.Context, path, password string) (spaceIDs []string, err error) {
+	progress := process.NewProgress(pb.ModelProcess_UserDataRestore)
+	if err = s.processService.Add(progress); err != nil {
+		return nil, fmt.Errorf("add process: %w", err)
+	}
+	defer func() {
+		progress.Finish(err)
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-progress.Canceled():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	r := &restoreRun{service: s, progress: progress}
+	spaceIDs, err = r.restore(ctx, path, password)
+	if err != nil {
+		r.rollback()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return spaceIDs, nil
+}
+
+func (r *restoreRun) restore(ctx context.Context, path, password string) ([]string, error) {
+	r.progress.SetProgressMessage("Read archive")
+	encrypted, err := source.IsEncryptedZip(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadInput, err)
+	}
+	if encrypted {
+		decrypted, err := source.DecryptZip(path, password)
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(decrypted)
+		path = decrypted
+	}
+	archiveReader, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadInput, err)
+	}
+	defer archiveReader.Close()
+	m, err := readManifest(&archiveReader.Reader)
+	if err != nil {
+		return nil, err
+	}
+	r.progress.SetProgressMessage("Validate archive")
+	size, err := validateDump(path, &archiveReader.Reader, m, r.accountService.AccountID())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkFreeSpace(size, os.TempDir(), r.config.RepoPath); err != nil {
+		return nil, err
+	}
+	tempDir, err := os.MkdirTemp("", archivePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	// archive is read, every space is created and imported, then settings are applied
+	r.progress.SetTotal(int64(2*len(m.Spaces) + 2))
+	r.progress.AddDone(1)
+
+	spaceIDs := make([]string, 0, len(m.Spaces))
+	for i, entry := range m.Spaces {
+		spaceArchive := filepath.Join(tempDir, fmt.Sprintf("%d.zip", i))
+		if err = extractFile(&archiveReader.Reader, entry.Archive, spaceArchive); err != nil {
+			return nil, fmt.Errorf("extract space %s: %w", entry.SpaceID, err)
+		}
+		spaceID, err := r.restoreSpace(ctx, entry.SpaceID == m.PersonalSpaceID, spaceArchive)
+		if err != nil {
+			return nil, fmt.Errorf("restore space %s: %w", entry.SpaceID, err)
+		}
+		spaceIDs = append(spaceIDs, spaceID)
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.progress.SetProgressMessage("Apply settings")
+	if err = r.applySettings(m.Settings); err != nil {
+		return nil, fmt.Errorf("apply settings: %w", err)
+	}
+	r.progress.AddDone(1)
+	return spaceIDs, nil
+}
+
+func (r *restoreRun) restoreSpace(ctx context.Context, isPersonal bool, archivePath string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	r.progress.SetProgressMessage("Create space")
+	spaceID := r.accountService.PersonalSpaceID()
+	if !isPersonal {
+		sp, err := r.spaceService.Create(ctx)
+		if err != nil {
+			return "", fmt.Errorf("create space: %w", err)
+		}
+		spaceID = sp.Id()
+		r.createdSpaces = append(r.createdSpaces, spaceID)
+	}
+	r.progress.AddDone(1)
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	r.progress.SetProgressMessage("Import objects and files")
+	importRunID := uuid.New().String()
+	if isPersonal {
+		// objects of new spaces are removed with spaces
+		r.importRunIDs = append(r.importRunIDs, importRunID)
+	}
+	_, err := r.importer.ImportWithRunID(ctx, &pb.RpcObjectImportRequest{
+		SpaceId:     spaceID,
+		Type:        pb.RpcObjectImportRequest_Pb,
+		Mode:        pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		IsMigration: isPersonal,
+		NoProgress:  true,
+		Params: &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{
+			Path:         []string{archivePath},
+			NoCollection: true,
+		}},
+	}, model.ObjectOrigin_import, importRunID)
+	if err != nil {
+		return "", err
+	}
+	r.progress.AddDone(1)
+	return spaceID, nil
+}
+
+// rollback removes objects, imported to the personal space, and spaces, created by restore
+func (r *restoreRun) rollback() {
+	for _, importRunID := range r.importRunIDs {
+		if _, err := r.importer.UndoImport(importRunID); err != nil && !errors.Is(err, importer.ErrNoObjectsToUndo) {
+			log.With("importRunID", importRunID).Errorf("failed to undo import of restore: %s", err)
+		}
+	}
+	for _, spaceID := range r.createdSpaces {
+		if err := r.spaceService.Delete(context.Background(), spaceID); err != nil {
+			log.With("spaceID", spaceID).Errorf("failed to delete space of restore: %s", err)
+		}
+	}
+}
+
+func (s *service) applySettings(st settings) error {
+	return config.WriteJsonConfig(s.config.GetConfigPath(), config.ConfigRequired{
+		TimeZone:        st.TimeZone,
+		LocalCacheLimit: st.LocalCacheLimit,
+	})
+}
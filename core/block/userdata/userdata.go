@@ -0,0 +1,267 @@
+package userdata
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+
+	"github.com/anyproto/anytype-heart/core/anytype/account"
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/backup"
+	"github.com/anyproto/anytype-heart/core/block/export"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/space"
+)
+
+const CName = "userdata"
+
+var log = logging.Logger("anytype-mw-userdata")
+
+var (
+	ErrBadInput       = errors.New("bad input")
+	ErrInvalidArchive = errors.New("archive is not user data dump")
+)
+
+const (
+	manifestVersion = 1
+	manifestFile    = "manifest.json"
+	spacesDir       = "spaces"
+	archivePrefix   = "anytype-userdata-"
+	timeLayout      = "20060102-150405"
+)
+
+// Service moves the whole account to other device: Dump writes profile, every space and settings to a single
+// archive, and Restore imports it to the current account
+type Service interface {
+	Dump(ctx context.Context, path, password string) (string, error)
+	Restore(ctx context.Context, path, password string) ([]string, error)
+	app.Component
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+// manifest describes content of the dump, every space is stored as protobuf export archive
+type manifest struct {
+	Version         int          `json:"version"`
+	AccountID       string       `json:"accountId,omitempty"`
+	PersonalSpaceID string       `json:"personalSpaceId"`
+	Spaces          []spaceEntry `json:"spaces"`
+	Settings        settings     `json:"settings"`
+}
+
+type spaceEntry struct {
+	SpaceID string `json:"spaceId"`
+	Archive string `json:"archive"`
+}
+
+type settings struct {
+	TimeZone        string `json:"timeZone,omitempty"`
+	LocalCacheLimit uint64 `json:"localCacheLimit,omitempty"`
+}
+
+type service struct {
+	exporter       export.Export
+	importer       importer.Importer
+	objectStore    objectstore.ObjectStore
+	accountService account.Service
+	spaceService   space.Service
+	processService process.Service
+	config         *config.Config
+	now            func() time.Time
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.exporter = app.MustComponent[export.Export](a)
+	s.importer = app.MustComponent[importer.Importer](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.accountService = app.MustComponent[account.Service](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.config = app.MustComponent[*config.Config](a)
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+// Dump creates archive of user data in the directory of path and returns path of the archive
+func (s *service) Dump(ctx context.Context, path, password string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("%w: path should be absolute", ErrBadInput)
+	}
+	tempDir, err := os.MkdirTemp("", archivePrefix)
+	if err != nil {
+		return "", fmt.Errorf("create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	m, archives, err := s.exportSpaces(ctx, tempDir)
+	if err != nil {
+		return "", err
+	}
+	target := filepath.Join(path, archivePrefix+s.now().Format(timeLayout)+".zip")
+	if password == "" {
+		if err = writeDump(target, m, archives); err != nil {
+			return "", fmt.Errorf("write archive: %w", err)
+		}
+		return target, nil
+	}
+	plain := filepath.Join(tempDir, "userdata.zip")
+	if err = writeDump(plain, m, archives); err != nil {
+		return "", fmt.Errorf("write archive: %w", err)
+	}
+	if err = source.EncryptZip(plain, target, password); err != nil {
+		return "", fmt.Errorf("encrypt archive: %w", err)
+	}
+	return target, nil
+}
+
+// exportSpaces exports every space to its own folder of dir and returns manifest and paths of archives by their
+// names in the dump. Personal space goes first, so it's restored first
+func (s *service) exportSpaces(ctx context.Context, dir string) (*manifest, map[string]string, error) {
+	spaceIDs, err := backup.SpaceIDs(s.objectStore)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get spaces: %w", err)
+	}
+	personalSpaceID := s.accountService.PersonalSpaceID()
+	m := &manifest{
+		Version:         manifestVersion,
+		AccountID:       s.accountService.AccountID(),
+		PersonalSpaceID: personalSpaceID,
+		Settings: settings{
+			TimeZone:        s.config.TimeZone,
+			LocalCacheLimit: s.config.LocalCacheLimit,
+		},
+	}
+	archives := make(map[string]string, len(spaceIDs))
+	for _, spaceID := range personalFirst(spaceIDs, personalSpaceID) {
+		spacePath := filepath.Join(dir, spaceID)
+		if err = os.MkdirAll(spacePath, 0700); err != nil {
+			return nil, nil, fmt.Errorf("create export directory: %w", err)
+		}
+		archivePath, _, err := s.exporter.Export(ctx, pb.RpcObjectListExportRequest{
+			SpaceId:         spaceID,
+			Path:            spacePath,
+			Format:          pb.RpcObjectListExport_Protobuf,
+			Zip:             true,
+			IncludeNested:   true,
+			IncludeFiles:    true,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("export space %s: %w", spaceID, err)
+		}
+		if archivePath == "" {
+			return nil, nil, fmt.Errorf("export space %s: %w", spaceID, context.Canceled)
+		}
+		entry := spaceEntry{SpaceID: spaceID, Archive: path.Join(spacesDir, spaceID+".zip")}
+		m.Spaces = append(m.Spaces, entry)
+		archives[entry.Archive] = archivePath
+	}
+	return m, archives, nil
+}
+
+func personalFirst(spaceIDs []string, personalSpaceID string) []string {
+	ordered := make([]string, 0, len(spaceIDs)+1)
+	ordered = append(ordered, personalSpaceID)
+	for _, spaceID := range spaceIDs {
+		if spaceID != personalSpaceID {
+			ordered = append(ordered, spaceID)
+		}
+	}
+	return ordered
+}
+
+// writeDump writes manifest and archives of spaces to zip archive. Archives of spaces are already compressed,
+// so they are stored as is
+func writeDump(target string, m *manifest, archives map[string]string) (err error) {
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	zw := zip.NewWriter(f)
+	w, err := zw.Create(manifestFile)
+	if err != nil {
+		return err
+	}
+	if err = json.NewEncoder(w).Encode(m); err != nil {
+		return err
+	}
+	for _, entry := range m.Spaces {
+		if err = writeStored(zw, entry.Archive, archives[entry.Archive]); err != nil {
+			return fmt.Errorf("write space %s: %w", entry.SpaceID, err)
+		}
+	}
+	return zw.Close()
+}
+
+func writeStored(zw *zip.Writer, name, filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
+
+func readManifest(archiveReader *zip.Reader) (*manifest, error) {
+	f, err := archiveReader.Open(manifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer f.Close()
+	m := &manifest{}
+	if err = json.NewDecoder(f).Decode(m); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if m.Version > manifestVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidArchive, m.Version)
+	}
+	return m, nil
+}
+
+func extractFile(archiveReader *zip.Reader, name, target string) (err error) {
+	r, err := archiveReader.Open(name)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	defer r.Close()
+	f, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	_, err = io.Copy(f, r)
+	return err
+}
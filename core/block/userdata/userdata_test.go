@@ -0,0 +1,167 @@
+package userdata
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalFirst(t *testing.T) {
+	// when
+	ordered := personalFirst([]string{"space1", "personal", "space2"}, "personal")
+
+	// then
+	assert.Equal(t, []string{"personal", "space1", "space2"}, ordered)
+}
+
+func TestDump(t *testing.T) {
+	t.Run("manifest and archives of spaces are read from dump", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		spaceArchive := filepath.Join(dir, "export.zip")
+		require.NoError(t, os.WriteFile(spaceArchive, []byte("space content"), 0600))
+		m := &manifest{
+			Version:         manifestVersion,
+			PersonalSpaceID: "personal",
+			Spaces:          []spaceEntry{{SpaceID: "personal", Archive: "spaces/personal.zip"}},
+			Settings:        settings{TimeZone: "Europe/Berlin", LocalCacheLimit: 1024},
+		}
+		dumpPath := filepath.Join(dir, "dump.zip")
+
+		// when
+		err := writeDump(dumpPath, m, map[string]string{"spaces/personal.zip": spaceArchive})
+
+		// then
+		require.NoError(t, err)
+		archiveReader, err := zip.OpenReader(dumpPath)
+		require.NoError(t, err)
+		defer archiveReader.Close()
+		read, err := readManifest(&archiveReader.Reader)
+		require.NoError(t, err)
+		assert.Equal(t, m, read)
+		extracted := filepath.Join(dir, "extracted.zip")
+		require.NoError(t, extractFile(&archiveReader.Reader, "spaces/personal.zip", extracted))
+		content, err := os.ReadFile(extracted)
+		require.NoError(t, err)
+		assert.Equal(t, "space content", string(content))
+	})
+	t.Run("archive without manifest is invalid", func(t *testing.T) {
+		// given
+		dumpPath := filepath.Join(t.TempDir(), "other.zip")
+		f, err := os.Create(dumpPath)
+		require.NoError(t, err)
+		zw := zip.NewWriter(f)
+		_, err = zw.Create("page.md")
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		require.NoError(t, f.Close())
+		archiveReader, err := zip.OpenReader(dumpPath)
+		require.NoError(t, err)
+		defer archiveReader.Close()
+
+		// when
+		_, err = readManifest(&archiveReader.Reader)
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func TestValidateDump(t *testing.T) {
+	writeSpaceArchive := func(t *testing.T, dir string) string {
+		spaceArchive := filepath.Join(dir, "space.zip")
+		f, err := os.Create(spaceArchive)
+		require.NoError(t, err)
+		zw := zip.NewWriter(f)
+		w, err := zw.Create("profile")
+		require.NoError(t, err)
+		_, err = w.Write([]byte("profile"))
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		require.NoError(t, f.Close())
+		return spaceArchive
+	}
+	openDump := func(t *testing.T, m *manifest, spaceArchive string) (string, *zip.ReadCloser) {
+		dumpPath := filepath.Join(t.TempDir(), "dump.zip")
+		require.NoError(t, writeDump(dumpPath, m, map[string]string{"spaces/personal.zip": spaceArchive}))
+		archiveReader, err := zip.OpenReader(dumpPath)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			archiveReader.Close()
+		})
+		return dumpPath, archiveReader
+	}
+	newManifest := func() *manifest {
+		return &manifest{
+			Version:         manifestVersion,
+			AccountID:       "account",
+			PersonalSpaceID: "personal",
+			Spaces:          []spaceEntry{{SpaceID: "personal", Archive: "spaces/personal.zip"}},
+		}
+	}
+
+	t.Run("valid dump", func(t *testing.T) {
+		// given
+		spaceArchive := writeSpaceArchive(t, t.TempDir())
+		info, err := os.Stat(spaceArchive)
+		require.NoError(t, err)
+		m := newManifest()
+		dumpPath, archiveReader := openDump(t, m, spaceArchive)
+
+		// when
+		size, err := validateDump(dumpPath, &archiveReader.Reader, m, "account")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, uint64(info.Size()), size)
+	})
+	t.Run("dump of other account", func(t *testing.T) {
+		// given
+		m := newManifest()
+		dumpPath, archiveReader := openDump(t, m, writeSpaceArchive(t, t.TempDir()))
+
+		// when
+		_, err := validateDump(dumpPath, &archiveReader.Reader, m, "other")
+
+		// then
+		assert.ErrorIs(t, err, ErrAccountMismatch)
+	})
+	t.Run("personal space is missing", func(t *testing.T) {
+		// given
+		m := newManifest()
+		dumpPath, archiveReader := openDump(t, m, writeSpaceArchive(t, t.TempDir()))
+		m.PersonalSpaceID = "other"
+
+		// when
+		_, err := validateDump(dumpPath, &archiveReader.Reader, m, "account")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+	t.Run("corrupted space archive", func(t *testing.T) {
+		// given
+		spaceArchive := filepath.Join(t.TempDir(), "space.zip")
+		require.NoError(t, os.WriteFile(spaceArchive, []byte("not a zip"), 0600))
+		m := newManifest()
+		dumpPath, archiveReader := openDump(t, m, spaceArchive)
+
+		// when
+		_, err := validateDump(dumpPath, &archiveReader.Reader, m, "account")
+
+		// then
+		assert.ErrorIs(t, err, ErrInvalidArchive)
+	})
+}
+
+func TestCheckFreeSpace(t *testing.T) {
+	t.Run("enough space", func(t *testing.T) {
+		assert.NoError(t, checkFreeSpace(1, t.TempDir()))
+	})
+	t.Run("not enough space", func(t *testing.T) {
+		assert.ErrorIs(t, checkFreeSpace(1<<62, t.TempDir()), ErrNotEnoughSpace)
+	})
+}
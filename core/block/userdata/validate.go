@@ -0,0 +1,83 @@
+package userdata
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	ErrAccountMismatch = errors.New("dump was made from different account")
+	ErrNotEnoughSpace  = errors.New("not enough disk space")
+)
+
+// validateDump checks the dump before anything is changed by restore: manifest describes personal space and
+// every space archive is a readable zip of the dump made by the same account. Total size of space archives
+// is returned
+func validateDump(archivePath string, archiveReader *zip.Reader, m *manifest, accountID string) (uint64, error) {
+	if m.AccountID != "" && m.AccountID != accountID {
+		return 0, ErrAccountMismatch
+	}
+	if m.PersonalSpaceID == "" || len(m.Spaces) == 0 || m.Spaces[0].SpaceID != m.PersonalSpaceID {
+		return 0, fmt.Errorf("%w: personal space is missing", ErrInvalidArchive)
+	}
+	files := make(map[string]*zip.File, len(archiveReader.File))
+	for _, f := range archiveReader.File {
+		files[f.Name] = f
+	}
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		return 0, err
+	}
+	defer archiveFile.Close()
+	var size uint64
+	for _, entry := range m.Spaces {
+		f, ok := files[entry.Archive]
+		if !ok {
+			return 0, fmt.Errorf("%w: archive of space %s is missing", ErrInvalidArchive, entry.SpaceID)
+		}
+		if err = validateSpaceArchive(archiveFile, f); err != nil {
+			return 0, fmt.Errorf("space %s: %w", entry.SpaceID, err)
+		}
+		size += f.UncompressedSize64
+	}
+	return size, nil
+}
+
+// validateSpaceArchive reads the directory of space archive, which is stored in the dump without compression,
+// so the archive isn't extracted
+func validateSpaceArchive(archiveFile io.ReaderAt, f *zip.File) error {
+	if f.Method != zip.Store {
+		return fmt.Errorf("%w: space archive is compressed", ErrInvalidArchive)
+	}
+	offset, err := f.DataOffset()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	size := int64(f.UncompressedSize64)
+	spaceReader, err := zip.NewReader(io.NewSectionReader(archiveFile, offset, size), size)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	if len(spaceReader.File) == 0 {
+		return fmt.Errorf("%w: space archive is empty", ErrInvalidArchive)
+	}
+	return nil
+}
+
+// checkFreeSpace checks that every directory has size bytes of free space: archives of spaces are extracted
+// to the temporary directory, and their objects and files are written to the repository
+func checkFreeSpace(size uint64, dirs ...string) error {
+	for _, dir := range dirs {
+		available, err := freeSpace(dir)
+		if err != nil {
+			return fmt.Errorf("get free space of %s: %w", dir, err)
+		}
+		if available < size {
+			return fmt.Errorf("%w: %d bytes are required in %s, %d are available", ErrNotEnoughSpace, size, dir, available)
+		}
+	}
+	return nil
+}
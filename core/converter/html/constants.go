@@ -43,6 +43,28 @@ const (
 	wrapExportEnd = `</div>
 			</body>
 		</html>`
+	// wrapSiteStart is formatted with title of the page, so the page is readable without network access
+	wrapSiteStart = `<!DOCTYPE html>
+<html>
+	<head>
+		<meta http-equiv="content-type" content="text/html; charset=utf-8" />
+		<meta name="viewport" content="width=device-width, initial-scale=1" />
+		<title>%s</title>
+		<style type="text/css">
+			body { max-width: 704px; margin: 0px auto; padding: 40px 16px; font-family: sans-serif; color: #2c2b27; }
+			.row > * { display: flex; }
+			.paragraph {` + styleParagraph + `}
+			.callout-image { width: 20px; height: 20px; font-size: 16px; line-height: 20px; margin-right: 6px; display: inline-block; }
+			img { max-width: 100vw; }
+			a { color: inherit; }
+			kbd {` + styleKbd + `}
+		</style>
+	</head>
+	<body>
+		<div class="anytype-container">`
+	wrapSiteEnd = `</div>
+	</body>
+</html>`
 
 	styleParagraph = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
 	styleHeader1   = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
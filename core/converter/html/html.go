@@ -11,6 +11,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files"
@@ -28,6 +29,15 @@ type HTML struct {
 	s           *state.State
 	buf         *bytes.Buffer
 	fileService files.Service
+
+	// links resolves links to other objects, they are replaced by message about Anytype if it's nil
+	links LinkResolver
+}
+
+// LinkResolver returns title and href of the linked object or file, ok is false if it isn't available
+type LinkResolver interface {
+	ObjectLink(id string) (title, href string, ok bool)
+	FileLink(file *model.BlockContentFile) (href string, ok bool)
 }
 
 func (h *HTML) Convert() (result string) {
@@ -75,6 +85,9 @@ func (h *HTML) render(rs *renderState, b *model.Block) {
 	case *model.BlockContentOfTable:
 		rs.Close()
 		h.renderTable(b)
+	case *model.BlockContentOfDataview:
+		rs.Close()
+		h.renderDataview(b)
 	default:
 		rs.Close()
 		h.renderLayout(b)
@@ -161,6 +174,14 @@ func (h *HTML) renderFile(b *model.Block) {
 		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
 	</div>`
 
+	if file.Type != model.BlockContentFile_Image && h.links != nil {
+		if href, ok := h.links.FileLink(file); ok {
+			fmt.Fprintf(h.buf, `<div class="file"><a href="%s">%s</a>`, html.EscapeString(href), html.EscapeString(file.Name))
+			h.renderChildren(b)
+			h.buf.WriteString("</div>")
+			return
+		}
+	}
 	switch file.Type {
 	case model.BlockContentFile_File:
 		h.buf.WriteString(`<div class="file"><div class="name">`)
@@ -253,6 +274,14 @@ func (h *HTML) renderLayout(b *model.Block) {
 }
 
 func (h *HTML) renderLink(b *model.Block) {
+	if h.links != nil {
+		if title, href, ok := h.links.ObjectLink(b.GetLink().GetTargetBlockId()); ok {
+			fmt.Fprintf(h.buf, `<div class="link"><a href="%s">%s</a>`, html.EscapeString(href), html.EscapeString(title))
+			h.renderChildren(b)
+			h.buf.WriteString("</div>")
+			return
+		}
+	}
 	if len(b.ChildrenIds) > 0 {
 		h.buf.WriteString("<div>")
 	}
@@ -266,6 +295,22 @@ func (h *HTML) renderLink(b *model.Block) {
 	}
 }
 
+// renderDataview lists objects of collection, so the page of collection is the index of its objects.
+// Objects of sets are selected by query, which can't be rendered
+func (h *HTML) renderDataview(b *model.Block) {
+	if h.links == nil || !b.GetDataview().GetIsCollection() {
+		h.renderLayout(b)
+		return
+	}
+	h.buf.WriteString(`<ul class="index">`)
+	for _, id := range h.s.GetStoreSlice(template.CollectionStoreKey) {
+		if title, href, ok := h.links.ObjectLink(id); ok {
+			fmt.Fprintf(h.buf, `<li><a href="%s">%s</a></li>`, html.EscapeString(href), html.EscapeString(title))
+		}
+	}
+	h.buf.WriteString("</ul>")
+}
+
 func (h *HTML) renderTable(b *model.Block) {
 	tb, err := table.NewTable(h.s, b.Id)
 	if err != nil {
@@ -389,6 +434,17 @@ func (h *HTML) writeTag(m *model.BlockContentTextMark, start bool) {
 		} else {
 			h.buf.WriteString("</u>")
 		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		if h.links == nil {
+			return
+		}
+		if _, href, ok := h.links.ObjectLink(m.Param); ok {
+			if start {
+				fmt.Fprintf(h.buf, `<a href="%s">`, html.EscapeString(href))
+			} else {
+				h.buf.WriteString("</a>")
+			}
+		}
 	}
 }
 
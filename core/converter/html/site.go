@@ -0,0 +1,100 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// Site renders object to the standalone page of static site. Links to exported objects are relative hrefs,
+// images are embedded into the page and collections list their objects
+type Site struct {
+	*HTML
+	fn         FileNamer
+	filesDir   string
+	knownDocs  map[string]*types.Struct
+	fileHashes []string
+}
+
+// NewSiteConverter returns converter for export. Files except images are linked to filesDir,
+// if it's empty, files aren't exported and only their names are shown
+func NewSiteConverter(spaceID string, fileService files.Service, s *state.State, fn FileNamer, filesDir string) converter.Converter {
+	site := &Site{fn: fn, filesDir: filesDir}
+	site.HTML = NewHTMLConverter(spaceID, fileService, s)
+	site.HTML.links = site
+	return site
+}
+
+func (s *Site) Convert(model.SmartBlockType) []byte {
+	s.buf = bytes.NewBuffer(nil)
+	fmt.Fprintf(s.buf, wrapSiteStart, html.EscapeString(pageTitle(s.s)))
+	s.renderChildren(s.s.Pick(s.s.RootId()).Model())
+	s.buf.WriteString(wrapSiteEnd)
+	return s.buf.Bytes()
+}
+
+func (s *Site) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	s.knownDocs = docs
+	return s
+}
+
+func (s *Site) FileHashes() []string {
+	return s.fileHashes
+}
+
+// ImageHashes returns nothing, because images are embedded
+func (s *Site) ImageHashes() []string {
+	return nil
+}
+
+func (s *Site) Ext() string {
+	return ".html"
+}
+
+func (s *Site) ObjectLink(id string) (title, href string, ok bool) {
+	info, ok := s.knownDocs[id]
+	if !ok {
+		return
+	}
+	title = pbtypes.GetString(info, bundle.RelationKeyName.String())
+	if title == "" {
+		title = pbtypes.GetString(info, bundle.RelationKeySnippet.String())
+	}
+	if title == "" {
+		title = id
+	}
+	// pages are exported to the same folder, so the name is the relative href
+	href = filepath.ToSlash(s.fn.Get("", id, title, s.Ext()))
+	return title, href, true
+}
+
+func (s *Site) FileLink(file *model.BlockContentFile) (href string, ok bool) {
+	if s.filesDir == "" {
+		return "", false
+	}
+	s.fileHashes = append(s.fileHashes, file.Hash)
+	return filepath.ToSlash(s.fn.Get(s.filesDir, file.Hash, filepath.Base(file.Name), filepath.Ext(file.Name))), true
+}
+
+// pageTitle returns name of the object or its snippet, if name is empty
+func pageTitle(s *state.State) string {
+	name := pbtypes.GetString(s.Details(), bundle.RelationKeyName.String())
+	if name == "" {
+		name = s.Snippet()
+	}
+	return name
+}
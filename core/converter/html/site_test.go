@@ -0,0 +1,82 @@
+package html
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type testNamer struct{}
+
+func (testNamer) Get(dir, _, title, ext string) string {
+	return filepath.Join(dir, title+ext)
+}
+
+func TestSite_Convert(t *testing.T) {
+	knownDocs := map[string]*types.Struct{
+		"page1": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("page1")}},
+		"page2": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("page2")}},
+	}
+
+	t.Run("links are relative hrefs", func(t *testing.T) {
+		// given
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"link", "text", "file"}}),
+			"link": simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{
+				Link: &model.BlockContentLink{TargetBlockId: "page1"},
+			}}),
+			"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "see page2",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 4, To: 9}, Type: model.BlockContentTextMark_Mention, Param: "page2"},
+				}},
+			}}}),
+			"file": simple.New(&model.Block{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+				Hash:  "hash",
+				Name:  "report.pdf",
+				Type:  model.BlockContentFile_File,
+				State: model.BlockContentFile_Done,
+			}}}),
+		}).(*state.State)
+		s.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("Root & more"))
+		c := NewSiteConverter("space1", nil, s, testNamer{}, "files").SetKnownDocs(knownDocs)
+
+		// when
+		result := string(c.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Contains(t, result, "<title>Root &amp; more</title>")
+		assert.Contains(t, result, `<div class="link"><a href="page1.html">page1</a></div>`)
+		assert.Contains(t, result, `see <a href="page2.html">page2</a>`)
+		assert.Contains(t, result, `<div class="file"><a href="files/report.pdf">report.pdf</a></div>`)
+		assert.Equal(t, []string{"hash"}, c.FileHashes())
+		assert.NotContains(t, result, "This content is available in Anytype")
+	})
+
+	t.Run("collection is index of its objects", func(t *testing.T) {
+		// given
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"dataview"}}),
+			"dataview": simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{
+				Dataview: &model.BlockContentDataview{IsCollection: true},
+			}}),
+		}).(*state.State)
+		s.UpdateStoreSlice(template.CollectionStoreKey, []string{"page2", "unknown", "page1"})
+		c := NewSiteConverter("space1", nil, s, testNamer{}, "").SetKnownDocs(knownDocs)
+
+		// when
+		result := string(c.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Contains(t, result, `<ul class="index"><li><a href="page2.html">page2</a></li><li><a href="page1.html">page1</a></li></ul>`)
+	})
+}
@@ -0,0 +1,164 @@
+package md
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// renderFrontMatter writes relations of the object as YAML front matter. Objects and options,
+// which are exported too, are written by their names, so relations are readable and can be imported back
+func (h *MD) renderFrontMatter(buf writer) {
+	fields := make(map[string]interface{})
+	for key, value := range h.s.Details().GetFields() {
+		if !isExportedRelation(key) || isEmptyValue(value) {
+			continue
+		}
+		fields[h.relationName(key)] = h.valueToInterface(value)
+	}
+	if len(fields) == 0 {
+		return
+	}
+	data, err := yaml.Marshal(fields)
+	if err != nil {
+		log.Warnf("failed to export relations in markdown: %v", err)
+		return
+	}
+	buf.WriteString("---\n")
+	buf.Write(data)
+	buf.WriteString("---\n\n")
+}
+
+// isExportedRelation skips internal relations, which are hidden from user or calculated by middleware
+func isExportedRelation(key string) bool {
+	if key == bundle.RelationKeyName.String() {
+		return true
+	}
+	if !bundle.HasRelation(key) {
+		return true
+	}
+	rel := bundle.MustGetRelation(domain.RelationKey(key))
+	return !rel.Hidden && rel.DataSource == model.Relation_details
+}
+
+func isEmptyValue(value *types.Value) bool {
+	switch v := value.GetKind().(type) {
+	case nil, *types.Value_NullValue:
+		return true
+	case *types.Value_StringValue:
+		return v.StringValue == ""
+	case *types.Value_ListValue:
+		return len(v.ListValue.GetValues()) == 0
+	}
+	return false
+}
+
+// relationName returns name of custom relation, if the relation is exported, and key otherwise
+func (h *MD) relationName(key string) string {
+	for _, details := range h.knownDocs {
+		if pbtypes.GetString(details, bundle.RelationKeyRelationKey.String()) != key {
+			continue
+		}
+		if name := pbtypes.GetString(details, bundle.RelationKeyName.String()); name != "" {
+			return name
+		}
+	}
+	return key
+}
+
+func (h *MD) valueToInterface(value *types.Value) interface{} {
+	switch v := value.GetKind().(type) {
+	case *types.Value_StringValue:
+		return h.objectName(v.StringValue)
+	case *types.Value_ListValue:
+		values := make([]interface{}, 0, len(v.ListValue.GetValues()))
+		for _, item := range v.ListValue.GetValues() {
+			values = append(values, h.valueToInterface(item))
+		}
+		return values
+	}
+	return pbtypes.ValueToInterface(value)
+}
+
+// objectName returns name of known object, so links to objects and options are readable outside of Anytype
+func (h *MD) objectName(id string) string {
+	details, ok := h.knownDocs[id]
+	if !ok {
+		return id
+	}
+	if name := pbtypes.GetString(details, bundle.RelationKeyName.String()); name != "" {
+		return name
+	}
+	return id
+}
+
+func (h *MD) valueToString(value *types.Value) string {
+	switch v := value.GetKind().(type) {
+	case *types.Value_StringValue:
+		return h.objectName(v.StringValue)
+	case *types.Value_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *types.Value_NumberValue:
+		return strconv.FormatFloat(v.NumberValue, 'f', -1, 64)
+	case *types.Value_ListValue:
+		values := make([]string, 0, len(v.ListValue.GetValues()))
+		for _, item := range v.ListValue.GetValues() {
+			values = append(values, h.valueToString(item))
+		}
+		return strings.Join(values, ", ")
+	}
+	return ""
+}
+
+// renderDataview writes objects of the collection as CSV code block with columns of the first view.
+// Objects of sets are selected by query, so only the header is written for them
+func (h *MD) renderDataview(buf writer, in *renderState, b *model.Block) {
+	dv := b.GetDataview()
+	if dv == nil {
+		return
+	}
+	keys := []string{bundle.RelationKeyName.String()}
+	if len(dv.Views) > 0 {
+		for _, rel := range dv.Views[0].Relations {
+			if rel.IsVisible && rel.Key != bundle.RelationKeyName.String() {
+				keys = append(keys, rel.Key)
+			}
+		}
+	}
+	records := [][]string{make([]string, 0, len(keys))}
+	for _, key := range keys {
+		records[0] = append(records[0], h.relationName(key))
+	}
+	if dv.IsCollection {
+		for _, id := range h.s.GetStoreSlice(template.CollectionStoreKey) {
+			details, ok := h.knownDocs[id]
+			if !ok {
+				continue
+			}
+			record := make([]string, 0, len(keys))
+			for _, key := range keys {
+				record = append(record, h.valueToString(pbtypes.Get(details, key)))
+			}
+			records = append(records, record)
+		}
+	}
+	data := bytes.NewBuffer(nil)
+	if err := csv.NewWriter(data).WriteAll(records); err != nil {
+		log.Warnf("failed to export dataview in markdown: %v", err)
+		return
+	}
+	buf.WriteString(in.indent)
+	buf.WriteString("```csv\n")
+	buf.Write(data.Bytes())
+	buf.WriteString("```\n")
+}
@@ -25,6 +25,9 @@ import (
 
 var log = logging.Logger("md-export")
 
+// AssetsDir is the folder of exported files, links to them are relative to the exported documents
+const AssetsDir = "assets"
+
 type FileNamer interface {
 	Get(path, hash, title, ext string) (name string)
 }
@@ -54,6 +57,7 @@ func (h *MD) Convert(model.SmartBlockType) (result []byte) {
 	}
 	buf := bytes.NewBuffer(nil)
 	in := new(renderState)
+	h.renderFrontMatter(buf)
 	h.renderChildren(buf, in, h.s.Pick(h.s.RootId()).Model())
 	result = buf.Bytes()
 	buf.Reset()
@@ -110,6 +114,8 @@ func (h *MD) render(buf writer, in *renderState, b *model.Block) {
 		h.renderLatex(buf, in, b)
 	case *model.BlockContentOfTable:
 		h.renderTable(buf, in, b)
+	case *model.BlockContentOfDataview:
+		h.renderDataview(buf, in, b)
 	default:
 		h.renderLayout(buf, in, b)
 	}
@@ -219,12 +225,13 @@ func (h *MD) renderFile(buf writer, in *renderState, b *model.Block) {
 		return
 	}
 	name := escape.MarkdownCharacters(html.EscapeString(file.Name))
+	filename := filepath.ToSlash(h.fn.Get(AssetsDir, file.Hash, filepath.Base(file.Name), filepath.Ext(file.Name)))
 	buf.WriteString(in.indent)
 	if file.Type != model.BlockContentFile_Image {
-		fmt.Fprintf(buf, "[%s](%s)    \n", name, h.fn.Get("files", file.Hash, filepath.Base(file.Name), filepath.Ext(file.Name)))
+		fmt.Fprintf(buf, "[%s](%s)    \n", name, filename)
 		h.fileHashes = append(h.fileHashes, file.Hash)
 	} else {
-		fmt.Fprintf(buf, "![%s](%s)    \n", name, h.fn.Get("files", file.Hash, filepath.Base(file.Name), filepath.Ext(file.Name)))
+		fmt.Fprintf(buf, "![%s](%s)    \n", name, filename)
 		h.imageHashes = append(h.imageHashes, file.Hash)
 	}
 }
@@ -401,7 +408,8 @@ func (h *MD) getLinkInfo(docId string) (title, filename string, ok bool) {
 	if title == "" {
 		title = docId
 	}
-	filename = h.fn.Get("", docId, title, h.Ext())
+	// documents are exported to the same folder, so the name is the relative path
+	filename = filepath.ToSlash(h.fn.Get("", docId, title, h.Ext()))
 	return
 }
 
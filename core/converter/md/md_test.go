@@ -1,13 +1,18 @@
 package md
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestMD_Convert(t *testing.T) {
@@ -131,4 +136,83 @@ func TestMD_Convert(t *testing.T) {
 		exp := "***[some](http://golang.org)*** [t](http://golang.org) [e](http://golang.org)xt **wi~~th m~~**~~ar~~ks @mention   \n"
 		assert.Equal(t, exp, string(res))
 	})
+
+	t.Run("relations are front matter", func(t *testing.T) {
+		// given
+		s := newState(&model.Block{
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: "text"},
+			},
+		})
+		s.SetDetail(bundle.RelationKeyName.String(), pbtypes.String("The Hobbit"))
+		s.SetDetail(bundle.RelationKeyTag.String(), pbtypes.StringList([]string{"tag1"}))
+		s.SetDetail(bundle.RelationKeyLastModifiedDate.String(), pbtypes.Int64(1))
+		s.SetDetail("customKey", pbtypes.String("Tolkien"))
+		c := NewMDConverter(s, nil).SetKnownDocs(map[string]*types.Struct{
+			"tag1": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("books")}},
+			"relation": {Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():        pbtypes.String("author"),
+				bundle.RelationKeyRelationKey.String(): pbtypes.String("customKey"),
+			}},
+		})
+
+		// when
+		res := c.Convert(0)
+
+		// then
+		exp := "---\nauthor: Tolkien\nname: The Hobbit\ntag:\n    - books\n---\n\ntext   \n"
+		assert.Equal(t, exp, string(res))
+	})
+
+	t.Run("collection is csv, files are in assets folder", func(t *testing.T) {
+		// given
+		s := newState(
+			&model.Block{
+				Content: &model.BlockContentOfDataview{
+					Dataview: &model.BlockContentDataview{
+						IsCollection: true,
+						Views: []*model.BlockContentDataviewView{{
+							Relations: []*model.BlockContentDataviewRelation{
+								{Key: bundle.RelationKeyName.String(), IsVisible: true},
+								{Key: bundle.RelationKeyDescription.String(), IsVisible: true},
+								{Key: bundle.RelationKeyDone.String()},
+							},
+						}},
+					},
+				},
+			},
+			&model.Block{
+				Content: &model.BlockContentOfFile{
+					File: &model.BlockContentFile{
+						Hash:  "hash",
+						Name:  "cat.png",
+						Type:  model.BlockContentFile_Image,
+						State: model.BlockContentFile_Done,
+					},
+				},
+			},
+		)
+		s.UpdateStoreSlice(template.CollectionStoreKey, []string{"page1", "page2", "unknown"})
+		c := NewMDConverter(s, testNamer{}).SetKnownDocs(map[string]*types.Struct{
+			"page1": {Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():        pbtypes.String("Page, first"),
+				bundle.RelationKeyDescription.String(): pbtypes.String("description"),
+			}},
+			"page2": {Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Page 2")}},
+		})
+
+		// when
+		res := c.Convert(0)
+
+		// then
+		exp := "```csv\nname,description\n\"Page, first\",description\nPage 2,\n```\n![cat.png](assets/cat.png)    \n"
+		assert.Equal(t, exp, string(res))
+		assert.Equal(t, []string{"hash"}, c.ImageHashes())
+	})
+}
+
+type testNamer struct{}
+
+func (testNamer) Get(dir, _, title, _ string) string {
+	return filepath.Join(dir, title)
 }
@@ -0,0 +1,147 @@
+package objectjson
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/slice"
+)
+
+var log = logging.Logger("object-json-converter")
+
+// SchemaVersion is written to every exported object. It is increased on every change of Object,
+// which breaks existing readers, see docs/ExportStructuredJson.md
+const SchemaVersion = 1
+
+// Object is the exported object
+type Object struct {
+	SchemaVersion  int                    `json:"schemaVersion"`
+	ID             string                 `json:"id"`
+	SmartBlockType string                 `json:"smartBlockType"`
+	ObjectTypes    []string               `json:"objectTypes"`
+	Details        map[string]interface{} `json:"details"`
+	Relations      []Relation             `json:"relations"`
+	Blocks         *Block                 `json:"blocks,omitempty"`
+}
+
+// Relation describes relation of the object, so values of details can be interpreted without Anytype
+type Relation struct {
+	Key    string `json:"key"`
+	Name   string `json:"name,omitempty"`
+	Format string `json:"format"`
+}
+
+// Block is the block with all its fields, except ids of children, which are replaced by children themselves
+type Block map[string]interface{}
+
+func NewConverter(s *state.State) converter.Converter {
+	return &objectJSON{s: s}
+}
+
+type objectJSON struct {
+	s         *state.State
+	knownDocs map[string]*types.Struct
+}
+
+func (c *objectJSON) Convert(sbType model.SmartBlockType) []byte {
+	obj := Object{
+		SchemaVersion:  SchemaVersion,
+		ID:             c.s.RootId(),
+		SmartBlockType: sbType.String(),
+		ObjectTypes:    slice.UnwrapStrings(c.s.ObjectTypeKeys()),
+		Details:        pbtypes.StructToMap(c.s.CombinedDetails()),
+		Relations:      c.relations(),
+		Blocks:         c.blockTree(c.s.RootId()),
+	}
+	if obj.Details == nil {
+		obj.Details = map[string]interface{}{}
+	}
+	result, err := json.MarshalIndent(obj, "", " ")
+	if err != nil {
+		log.Errorf("failed to convert object to json: %s", err)
+	}
+	return result
+}
+
+func (c *objectJSON) relations() []Relation {
+	links := c.s.GetRelationLinks()
+	relations := make([]Relation, 0, len(links))
+	for _, link := range links {
+		relations = append(relations, Relation{
+			Key:    link.Key,
+			Name:   c.relationName(link.Key),
+			Format: link.Format.String(),
+		})
+	}
+	return relations
+}
+
+// relationName returns name of bundled relation or exported custom relation
+func (c *objectJSON) relationName(key string) string {
+	if rel, err := bundle.GetRelation(domain.RelationKey(key)); err == nil {
+		return rel.Name
+	}
+	for _, details := range c.knownDocs {
+		if pbtypes.GetString(details, bundle.RelationKeyRelationKey.String()) == key {
+			return pbtypes.GetString(details, bundle.RelationKeyName.String())
+		}
+	}
+	return ""
+}
+
+func (c *objectJSON) blockTree(id string) *Block {
+	b := c.s.Pick(id)
+	if b == nil {
+		return nil
+	}
+	m := pbtypes.CopyBlock(b.Model())
+	childrenIds := m.ChildrenIds
+	m.ChildrenIds = nil
+	data, err := (&jsonpb.Marshaler{}).MarshalToString(m)
+	if err != nil {
+		log.Errorf("failed to convert block to json: %s", err)
+		return nil
+	}
+	block := Block{}
+	if err = json.Unmarshal([]byte(data), &block); err != nil {
+		log.Errorf("failed to convert block to json: %s", err)
+		return nil
+	}
+	children := make([]*Block, 0, len(childrenIds))
+	for _, childID := range childrenIds {
+		if child := c.blockTree(childID); child != nil {
+			children = append(children, child)
+		}
+	}
+	if len(children) > 0 {
+		block["children"] = children
+	}
+	return &block
+}
+
+func (c *objectJSON) Ext() string {
+	return ".json"
+}
+
+func (c *objectJSON) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	c.knownDocs = docs
+	return c
+}
+
+// FileHashes returns nothing, because files are referenced by hashes in blocks and details
+func (c *objectJSON) FileHashes() []string {
+	return nil
+}
+
+func (c *objectJSON) ImageHashes() []string {
+	return nil
+}
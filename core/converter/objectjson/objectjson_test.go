@@ -0,0 +1,46 @@
+package objectjson
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestObjectJSON_Convert(t *testing.T) {
+	// given
+	s := state.NewDoc("root", map[string]simple.Block{
+		"root":   simple.New(&model.Block{Id: "root", ChildrenIds: []string{"parent"}}),
+		"parent": simple.New(&model.Block{Id: "parent", ChildrenIds: []string{"child"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "parent"}}}),
+		"child":  simple.New(&model.Block{Id: "child", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "child"}}}),
+	}).(*state.State)
+	s.SetDetailAndBundledRelation(bundle.RelationKeyName, pbtypes.String("Groceries"))
+
+	// when
+	result := NewConverter(s).Convert(model.SmartBlockType_Page)
+
+	// then
+	var obj map[string]interface{}
+	require.NoError(t, json.Unmarshal(result, &obj))
+	assert.Equal(t, float64(SchemaVersion), obj["schemaVersion"])
+	assert.Equal(t, "root", obj["id"])
+	assert.Equal(t, "Page", obj["smartBlockType"])
+	assert.Equal(t, "Groceries", obj["details"].(map[string]interface{})["name"])
+	assert.Contains(t, obj["relations"], map[string]interface{}{"key": "name", "name": "Name", "format": "shorttext"})
+
+	root := obj["blocks"].(map[string]interface{})
+	assert.Equal(t, "root", root["id"])
+	assert.NotContains(t, root, "childrenIds")
+	parent := root["children"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "parent", parent["text"].(map[string]interface{})["text"])
+	child := parent["children"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "child", child["id"])
+	assert.NotContains(t, child, "children")
+}
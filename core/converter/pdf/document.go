@@ -0,0 +1,162 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"strings"
+)
+
+// A4 page in points with margins
+const (
+	pageWidth    = 595.0
+	pageHeight   = 842.0
+	pageMargin   = 56.0
+	contentWidth = pageWidth - 2*pageMargin
+)
+
+// document writes PDF 1.4 file with standard fonts, pages and images. Objects are numbered in order of creation,
+// catalog and page tree take the first two numbers and are written at the end, when all pages are known
+type document struct {
+	objects [][]byte
+	pages   []int
+	images  []int
+	content *bytes.Buffer
+}
+
+const (
+	catalogObject = 1
+	pagesObject   = 2
+	firstFont     = 3
+)
+
+func newDocument() *document {
+	d := &document{objects: make([][]byte, firstFont-1)}
+	for _, name := range fontNames {
+		d.addObject([]byte(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", name)))
+	}
+	return d
+}
+
+func (d *document) addObject(data []byte) int {
+	d.objects = append(d.objects, data)
+	return len(d.objects)
+}
+
+// newPage finishes the current page and starts the next one
+func (d *document) newPage() {
+	d.finishPage()
+	d.content = bytes.NewBuffer(nil)
+}
+
+func (d *document) finishPage() {
+	if d.content == nil {
+		return
+	}
+	stream := d.addObject(streamObject("", d.content.Bytes()))
+	// page refers to resources, which are written at the end, so it's stored as template
+	d.pages = append(d.pages, d.addObject([]byte(fmt.Sprintf("<< /Type /Page /Parent %d 0 R /Contents %d 0 R /Resources %%s >>", pagesObject, stream))))
+	d.content = nil
+}
+
+// text writes encoded text, x and y are coordinates of baseline from the top left corner of page
+func (d *document) text(f font, size, x, y float64, text []byte) {
+	fmt.Fprintf(d.content, "BT /F%d %.2f Tf %.2f %.2f Td (%s) Tj ET\n", int(f)+1, size, x, pageHeight-y, escapeString(text))
+}
+
+func (d *document) line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(d.content, "0.8 G %.2f %.2f m %.2f %.2f l S 0 G\n", x1, pageHeight-y1, x2, pageHeight-y2)
+}
+
+// addImage writes image as RGB bitmap and returns its name for drawImage
+func (d *document) addImage(img image.Image) string {
+	bounds := img.Bounds()
+	pixels := bytes.NewBuffer(make([]byte, 0, bounds.Dx()*bounds.Dy()*3))
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			// transparent pixels are blended with white background
+			r, g, b = blend(r, a), blend(g, a), blend(b, a)
+			pixels.Write([]byte{byte(r >> 8), byte(g >> 8), byte(b >> 8)})
+		}
+	}
+	compressed := bytes.NewBuffer(nil)
+	w := zlib.NewWriter(compressed)
+	w.Write(pixels.Bytes())
+	w.Close()
+	header := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /FlateDecode ",
+		bounds.Dx(), bounds.Dy())
+	d.images = append(d.images, d.addObject(streamObject(header, compressed.Bytes())))
+	return fmt.Sprintf("Im%d", len(d.images))
+}
+
+func blend(c, alpha uint32) uint32 {
+	return c + 0xffff - alpha
+}
+
+// drawImage draws image with top left corner at x and y
+func (d *document) drawImage(name string, x, y, width, height float64) {
+	fmt.Fprintf(d.content, "q %.2f 0 0 %.2f %.2f %.2f cm /%s Do Q\n", width, height, x, pageHeight-y-height, name)
+}
+
+func (d *document) bytes() []byte {
+	d.finishPage()
+	if len(d.pages) == 0 {
+		d.newPage()
+		d.finishPage()
+	}
+	fonts := make([]string, 0, len(fontNames))
+	for i := range fontNames {
+		fonts = append(fonts, fmt.Sprintf("/F%d %d 0 R", i+1, firstFont+i))
+	}
+	images := make([]string, 0, len(d.images))
+	for i, id := range d.images {
+		images = append(images, fmt.Sprintf("/Im%d %d 0 R", i+1, id))
+	}
+	resources := fmt.Sprintf("<< /Font << %s >> /XObject << %s >> >>", strings.Join(fonts, " "), strings.Join(images, " "))
+	kids := make([]string, 0, len(d.pages))
+	for _, id := range d.pages {
+		d.objects[id-1] = []byte(fmt.Sprintf(string(d.objects[id-1]), resources))
+		kids = append(kids, fmt.Sprintf("%d 0 R", id))
+	}
+	d.objects[catalogObject-1] = []byte(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObject))
+	d.objects[pagesObject-1] = []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %.0f %.0f] >>",
+		strings.Join(kids, " "), len(kids), pageWidth, pageHeight))
+
+	buf := bytes.NewBuffer(nil)
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(d.objects))
+	for i, obj := range d.objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(buf, "%d 0 obj\n", i+1)
+		buf.Write(obj)
+		buf.WriteString("\nendobj\n")
+	}
+	xref := buf.Len()
+	fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(buf, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, catalogObject, xref)
+	return buf.Bytes()
+}
+
+func streamObject(header string, data []byte) []byte {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "<< %s/Length %d >>\nstream\n", header, len(data))
+	buf.Write(data)
+	buf.WriteString("\nendstream")
+	return buf.Bytes()
+}
+
+func escapeString(text []byte) []byte {
+	result := make([]byte, 0, len(text))
+	for _, c := range text {
+		if c == '(' || c == ')' || c == '\\' {
+			result = append(result, '\\')
+		}
+		result = append(result, c)
+	}
+	return result
+}
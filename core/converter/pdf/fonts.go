@@ -0,0 +1,93 @@
+package pdf
+
+// font is one of standard PDF fonts, they are available in every reader, so they aren't embedded
+type font int
+
+const (
+	fontRegular font = iota
+	fontBold
+	fontItalic
+	fontBoldItalic
+	fontMono
+)
+
+var fontNames = []string{"Helvetica", "Helvetica-Bold", "Helvetica-Oblique", "Helvetica-BoldOblique", "Courier"}
+
+const (
+	firstWidthChar     = 32
+	defaultCharWidth   = 556
+	monospaceCharWidth = 600
+)
+
+// widths of printable ASCII characters from Adobe font metrics, in thousandths of font size
+var (
+	helveticaWidths = []int{
+		278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+		1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+		333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+		556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+	}
+	helveticaBoldWidths = []int{
+		278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+		556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+		975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+		667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+		333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+		611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+	}
+)
+
+// textWidth returns width of encoded text in points
+func textWidth(f font, text []byte, size float64) float64 {
+	var widths []int
+	switch f {
+	case fontRegular, fontItalic:
+		widths = helveticaWidths
+	case fontBold, fontBoldItalic:
+		widths = helveticaBoldWidths
+	}
+	var total int
+	for _, c := range text {
+		switch {
+		case widths == nil:
+			total += monospaceCharWidth
+		case int(c) >= firstWidthChar && int(c)-firstWidthChar < len(widths):
+			total += widths[int(c)-firstWidthChar]
+		default:
+			total += defaultCharWidth
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// winAnsi maps characters of Windows-1252, which differ from Latin-1
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b,
+	'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// encode converts text to WinAnsiEncoding of standard fonts. Characters, which aren't supported by it, are replaced by '?'
+func encode(text string) []byte {
+	result := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			result = append(result, ' ', ' ', ' ', ' ')
+		case r == '\n':
+			result = append(result, ' ')
+		case r >= 32 && r < 127, r >= 160 && r <= 255:
+			result = append(result, byte(r))
+		default:
+			if c, ok := winAnsi[r]; ok {
+				result = append(result, c)
+			} else if r >= 32 {
+				result = append(result, '?')
+			}
+		}
+	}
+	return result
+}
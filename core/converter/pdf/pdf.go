@@ -0,0 +1,426 @@
+package pdf
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	_ "golang.org/x/image/webp"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var log = logging.Logger("pdf-export")
+
+const (
+	lineSpacing = 1.4
+	indentStep  = 18.0
+	imageWidth  = 1024
+)
+
+type textStyle struct {
+	size float64
+	font font
+	// spacing is the space before the block
+	spacing float64
+}
+
+var (
+	defaultTextStyle = textStyle{size: 11, font: fontRegular, spacing: 4}
+	textStyles       = map[model.BlockContentTextStyle]textStyle{
+		model.BlockContentText_Title:   {size: 26, font: fontBold, spacing: 0},
+		model.BlockContentText_Header1: {size: 22, font: fontBold, spacing: 14},
+		model.BlockContentText_Header2: {size: 17, font: fontBold, spacing: 12},
+		model.BlockContentText_Header3: {size: 14, font: fontBold, spacing: 10},
+		model.BlockContentText_Header4: {size: 12, font: fontBold, spacing: 8},
+		model.BlockContentText_Quote:   {size: 12, font: fontItalic, spacing: 6},
+		model.BlockContentText_Code:    {size: 9.5, font: fontMono, spacing: 6},
+	}
+)
+
+// NewConverter returns converter, which renders object to PDF. Every first level header starts a new page,
+// images are embedded into the document
+func NewConverter(spaceID string, fileService files.Service, s *state.State) converter.Converter {
+	return &PDF{spaceID: spaceID, fileService: fileService, s: s}
+}
+
+type PDF struct {
+	spaceID     string
+	fileService files.Service
+	s           *state.State
+	knownDocs   map[string]*types.Struct
+
+	doc *document
+	// y is the top of free space on the current page
+	y float64
+	// pageHasContent is false, if nothing is written to the current page yet
+	pageHasContent bool
+}
+
+func (p *PDF) Convert(model.SmartBlockType) []byte {
+	p.doc = newDocument()
+	p.startPage()
+	if root := p.s.Pick(p.s.RootId()); root != nil {
+		p.renderChildren(root.Model(), 0)
+	}
+	return p.doc.bytes()
+}
+
+func (p *PDF) SetKnownDocs(docs map[string]*types.Struct) converter.Converter {
+	p.knownDocs = docs
+	return p
+}
+
+func (p *PDF) FileHashes() []string {
+	return nil
+}
+
+// ImageHashes returns nothing, because images are embedded
+func (p *PDF) ImageHashes() []string {
+	return nil
+}
+
+func (p *PDF) Ext() string {
+	return ".pdf"
+}
+
+func (p *PDF) startPage() {
+	p.doc.newPage()
+	p.y = pageMargin
+	p.pageHasContent = false
+}
+
+// reserve starts a new page, if there is no space for the content of given height
+func (p *PDF) reserve(height float64) {
+	if p.pageHasContent && p.y+height > pageHeight-pageMargin {
+		p.startPage()
+	}
+	p.pageHasContent = true
+}
+
+func (p *PDF) renderChildren(parent *model.Block, indent float64) {
+	number := 0
+	for _, id := range parent.ChildrenIds {
+		b := p.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		if b.Model().GetText().GetStyle() == model.BlockContentText_Numbered {
+			number++
+		} else {
+			number = 0
+		}
+		p.render(b.Model(), indent, number)
+	}
+}
+
+func (p *PDF) render(b *model.Block, indent float64, number int) {
+	switch b.Content.(type) {
+	case *model.BlockContentOfText:
+		p.renderText(b, indent, number)
+	case *model.BlockContentOfFile:
+		p.renderFile(b, indent)
+	case *model.BlockContentOfBookmark:
+		bm := b.GetBookmark()
+		p.renderLine(indent, defaultTextStyle, []run{{text: firstNotEmpty(bm.Title, bm.Url), font: fontRegular}, {text: " " + bm.Url, font: fontItalic}})
+	case *model.BlockContentOfDiv:
+		p.reserve(12)
+		p.doc.line(pageMargin+indent, p.y+6, pageWidth-pageMargin, p.y+6)
+		p.y += 12
+	case *model.BlockContentOfLink:
+		if title, ok := p.objectTitle(b.GetLink().GetTargetBlockId()); ok {
+			p.renderLine(indent, defaultTextStyle, []run{{text: "-> " + title, font: fontRegular}})
+		}
+	case *model.BlockContentOfLatex:
+		p.renderLine(indent, textStyles[model.BlockContentText_Code], []run{{text: b.GetLatex().GetText(), font: fontMono}})
+	case *model.BlockContentOfTable:
+		p.renderTable(b, indent)
+	case *model.BlockContentOfDataview:
+		p.renderDataview(b, indent)
+	}
+	if _, ok := b.Content.(*model.BlockContentOfText); !ok {
+		p.renderChildren(b, indent)
+	}
+}
+
+func (p *PDF) renderText(b *model.Block, indent float64, number int) {
+	text := b.GetText()
+	style, ok := textStyles[text.Style]
+	if !ok {
+		style = defaultTextStyle
+	}
+	if text.Style == model.BlockContentText_Header1 && p.pageHasContent {
+		p.startPage()
+	}
+	var prefix string
+	switch text.Style {
+	case model.BlockContentText_Marked:
+		prefix = "• "
+	case model.BlockContentText_Numbered:
+		prefix = fmt.Sprintf("%d. ", number)
+	case model.BlockContentText_Checkbox:
+		prefix = "[ ] "
+		if text.Checked {
+			prefix = "[x] "
+		}
+	}
+	if text.Style == model.BlockContentText_Code {
+		for _, line := range strings.Split(text.Text, "\n") {
+			p.renderLine(indent, style, []run{{text: line, font: fontMono}})
+			style.spacing = 0
+		}
+	} else {
+		textIndent := indent
+		if text.Style == model.BlockContentText_Quote {
+			textIndent += indentStep / 2
+		}
+		p.renderLine(textIndent, style, append([]run{{text: prefix, font: style.font}}, textRuns(text, style.font)...))
+	}
+	p.renderChildren(b, indent+indentStep)
+}
+
+type run struct {
+	text string
+	font font
+}
+
+// textRuns splits text by bold and italic marks
+func textRuns(text *model.BlockContentText, base font) []run {
+	runes := []rune(text.Text)
+	if base == fontMono || len(text.Marks.GetMarks()) == 0 {
+		return []run{{text: text.Text, font: base}}
+	}
+	fonts := make([]font, len(runes))
+	for i := range fonts {
+		fonts[i] = base
+	}
+	for _, m := range text.Marks.Marks {
+		if m.Range == nil {
+			continue
+		}
+		for i := int(m.Range.From); i < int(m.Range.To) && i < len(runes); i++ {
+			switch m.Type {
+			case model.BlockContentTextMark_Bold:
+				fonts[i] = withBold(fonts[i])
+			case model.BlockContentTextMark_Italic:
+				fonts[i] = withItalic(fonts[i])
+			}
+		}
+	}
+	var runs []run
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if i == len(runes) || fonts[i] != fonts[start] {
+			runs = append(runs, run{text: string(runes[start:i]), font: fonts[start]})
+			start = i
+		}
+	}
+	return runs
+}
+
+func withBold(f font) font {
+	if f == fontItalic || f == fontBoldItalic {
+		return fontBoldItalic
+	}
+	return fontBold
+}
+
+func withItalic(f font) font {
+	if f == fontBold || f == fontBoldItalic {
+		return fontBoldItalic
+	}
+	return fontItalic
+}
+
+type word struct {
+	text  []byte
+	font  font
+	width float64
+}
+
+// renderLine writes runs of text, which are wrapped by words to the width of page
+func (p *PDF) renderLine(indent float64, style textStyle, runs []run) {
+	maxWidth := contentWidth - indent
+	lineHeight := style.size * lineSpacing
+	var (
+		line  []word
+		width float64
+	)
+	flush := func() {
+		p.reserve(lineHeight)
+		x := pageMargin + indent
+		for _, w := range line {
+			p.doc.text(w.font, style.size, x, p.y+style.size, w.text)
+			x += w.width
+		}
+		p.y += lineHeight
+		line, width = nil, 0
+	}
+	p.y += style.spacing
+	for _, r := range runs {
+		for _, text := range splitWords(r.text) {
+			encoded := encode(text)
+			w := word{text: encoded, font: r.font, width: textWidth(r.font, encoded, style.size)}
+			if len(line) > 0 && width+w.width > maxWidth && strings.TrimSpace(text) != "" {
+				flush()
+			}
+			line = append(line, w)
+			width += w.width
+		}
+	}
+	flush()
+}
+
+// splitWords splits text to words, which keep trailing spaces, so words are joined back without changes
+func splitWords(text string) []string {
+	var words []string
+	start := 0
+	for i := 1; i < len(text); i++ {
+		if text[i-1] == ' ' && text[i] != ' ' {
+			words = append(words, text[start:i])
+			start = i
+		}
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
+
+func (p *PDF) renderFile(b *model.Block, indent float64) {
+	file := b.GetFile()
+	if file.State != model.BlockContentFile_Done {
+		return
+	}
+	if file.Type == model.BlockContentFile_Image {
+		if img := p.getImage(file.Hash); img != nil {
+			p.renderImage(img, indent)
+			return
+		}
+	}
+	p.renderLine(indent, defaultTextStyle, []run{{text: file.Name, font: fontItalic}})
+}
+
+func (p *PDF) renderImage(img image.Image, indent float64) {
+	bounds := img.Bounds()
+	width, height := float64(bounds.Dx()), float64(bounds.Dy())
+	// image is scaled down to fit the page, small images keep their size
+	maxWidth, maxHeight := contentWidth-indent, pageHeight-2*pageMargin
+	if width > maxWidth {
+		height, width = height*maxWidth/width, maxWidth
+	}
+	if height > maxHeight {
+		width, height = width*maxHeight/height, maxHeight
+	}
+	p.y += defaultTextStyle.spacing
+	p.reserve(height)
+	name := p.doc.addImage(img)
+	p.doc.drawImage(name, pageMargin+indent, p.y, width, height)
+	p.y += height
+}
+
+func (p *PDF) getImage(hash string) image.Image {
+	if p.fileService == nil {
+		return nil
+	}
+	ctx := context.Background()
+	im, err := p.fileService.ImageByHash(ctx, domain.FullID{SpaceID: p.spaceID, ObjectID: hash})
+	if err != nil {
+		log.Warnf("failed to get image: %v", err)
+		return nil
+	}
+	f, err := im.GetFileForWidth(ctx, imageWidth)
+	if err != nil {
+		log.Warnf("failed to get image file: %v", err)
+		return nil
+	}
+	rd, err := f.Reader(ctx)
+	if err != nil {
+		log.Warnf("failed to read image: %v", err)
+		return nil
+	}
+	img, _, err := image.Decode(rd)
+	if err != nil {
+		log.Warnf("failed to decode image: %v", err)
+		return nil
+	}
+	return img
+}
+
+// renderTable writes rows of table as lines with cells separated by vertical bar
+func (p *PDF) renderTable(b *model.Block, indent float64) {
+	tb, err := table.NewTable(p.s, b.Id)
+	if err != nil {
+		log.Warnf("failed to render table: %v", err)
+		return
+	}
+	rows := make([][]string, len(tb.RowIDs()))
+	for i := range rows {
+		rows[i] = make([]string, len(tb.ColumnIDs()))
+	}
+	err = tb.Iterate(func(cell simple.Block, pos table.CellPosition) bool {
+		if cell != nil {
+			rows[pos.RowNumber][pos.ColNumber] = cell.Model().GetText().GetText()
+		}
+		return true
+	})
+	if err != nil {
+		log.Warnf("failed to render table: %v", err)
+		return
+	}
+	for i, row := range rows {
+		f := fontRegular
+		if i == 0 {
+			f = fontBold
+		}
+		p.renderLine(indent, defaultTextStyle, []run{{text: strings.Join(row, " | "), font: f}})
+	}
+}
+
+// renderDataview lists objects of collection. Objects of sets are selected by query, which can't be rendered
+func (p *PDF) renderDataview(b *model.Block, indent float64) {
+	if !b.GetDataview().GetIsCollection() {
+		return
+	}
+	for _, id := range p.s.GetStoreSlice(template.CollectionStoreKey) {
+		if title, ok := p.objectTitle(id); ok {
+			p.renderLine(indent, defaultTextStyle, []run{{text: "• " + title, font: fontRegular}})
+		}
+	}
+}
+
+func (p *PDF) objectTitle(id string) (string, bool) {
+	details, ok := p.knownDocs[id]
+	if !ok {
+		return "", false
+	}
+	return firstNotEmpty(
+		pbtypes.GetString(details, bundle.RelationKeyName.String()),
+		pbtypes.GetString(details, bundle.RelationKeySnippet.String()),
+		id,
+	), true
+}
+
+func firstNotEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,72 @@
+package pdf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newState(bs ...*model.Block) *state.State {
+	blocks := map[string]simple.Block{}
+	var ids []string
+	for _, b := range bs {
+		ids = append(ids, b.Id)
+		blocks[b.Id] = simple.New(b)
+	}
+	blocks["root"] = simple.New(&model.Block{Id: "root", ChildrenIds: ids})
+	return state.NewDoc("root", blocks).(*state.State)
+}
+
+func textBlock(id, text string, style model.BlockContentTextStyle) *model.Block {
+	return &model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: text, Style: style}}}
+}
+
+func TestPDF_Convert(t *testing.T) {
+	t.Run("first level headers start new pages", func(t *testing.T) {
+		// given
+		s := newState(
+			textBlock("h1", "Chapter 1", model.BlockContentText_Header1),
+			textBlock("p1", "Text (of) chapter", model.BlockContentText_Paragraph),
+			textBlock("h2", "Section", model.BlockContentText_Header2),
+			textBlock("h3", "Chapter 2", model.BlockContentText_Header1),
+		)
+
+		// when
+		result := NewConverter("space1", nil, s).Convert(model.SmartBlockType_Page)
+
+		// then
+		assert.True(t, bytes.HasPrefix(result, []byte("%PDF-1.4")))
+		assert.Contains(t, string(result), "/Count 2")
+		assert.Contains(t, string(result), `(Chapter 1) Tj`)
+		assert.Contains(t, string(result), `(Text \(of\) chapter) Tj`)
+		assert.True(t, bytes.HasSuffix(result, []byte("%%EOF\n")))
+	})
+
+	t.Run("long text is wrapped and continues on the next page", func(t *testing.T) {
+		// given
+		var blocks []*model.Block
+		for i := 0; i < 60; i++ {
+			blocks = append(blocks, textBlock(string(rune('a'+i%26))+string(rune('a'+i/26)),
+				strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 3),
+				model.BlockContentText_Paragraph))
+		}
+		s := newState(blocks...)
+
+		// when
+		result := NewConverter("space1", nil, s).Convert(model.SmartBlockType_Page)
+
+		// then
+		assert.Greater(t, bytes.Count(result, []byte("/Type /Page ")), 2)
+	})
+}
+
+func TestSplitWords(t *testing.T) {
+	assert.Equal(t, []string{"one ", "two  ", "three"}, splitWords("one two  three"))
+	assert.Equal(t, []string{"  ", "lead"}, splitWords("  lead"))
+}
@@ -40,6 +40,7 @@ type Debug interface {
 	DumpLocalstore(ctx context.Context, spaceID string, objectIds []string, path string) (filename string, err error)
 	SpaceSummary(ctx context.Context, spaceID string) (summary SpaceSummary, err error)
 	TreeHeads(ctx context.Context, id string) (info TreeInfo, err error)
+	CheckSpace(ctx context.Context, spaceID string) (broken []TreeError, err error)
 }
 
 type debug struct {
@@ -139,6 +140,30 @@ func (d *debug) SpaceSummary(ctx context.Context, spaceID string) (summary Space
 	return
 }
 
+// TreeError describes the tree, which can't be built from the local storage
+type TreeError struct {
+	Id  string
+	Err error
+}
+
+// CheckSpace builds full history of every tree of the space from the local storage and returns trees, which are broken
+func (d *debug) CheckSpace(ctx context.Context, spaceID string) (broken []TreeError, err error) {
+	spc, err := d.spaceService.Get(ctx, spaceID)
+	if err != nil {
+		return
+	}
+	for _, t := range spc.DebugAllHeads() {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		_, buildErr := spc.TreeBuilder().BuildHistoryTree(ctx, t.Id, objecttreebuilder.HistoryTreeOpts{BuildFullTree: true})
+		if buildErr != nil {
+			broken = append(broken, TreeError{Id: t.Id, Err: buildErr})
+		}
+	}
+	return
+}
+
 func (d *debug) TreeHeads(ctx context.Context, id string) (info TreeInfo, err error) {
 	spcID, err := d.resolver.ResolveSpaceID(id)
 	if err != nil {
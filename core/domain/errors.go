@@ -5,3 +5,6 @@ import "errors"
 var ErrFileNotFound = errors.New("file not found")
 
 var ErrValidationFailed = errors.New("validation failed")
+
+// ErrReadOnlyMode is returned by operations, which change data of the account opened in read-only mode
+var ErrReadOnlyMode = errors.New("account is opened in read-only mode")
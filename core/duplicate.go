@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/duplicate"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func (mw *Middleware) ObjectDuplicatesList(cctx context.Context, req *pb.RpcObjectDuplicatesListRequest) *pb.RpcObjectDuplicatesListResponse {
+	response := func(code pb.RpcObjectDuplicatesListResponseErrorCode, clusters []*pb.RpcObjectDuplicatesListCluster, err error) *pb.RpcObjectDuplicatesListResponse {
+		m := &pb.RpcObjectDuplicatesListResponse{
+			Error:    &pb.RpcObjectDuplicatesListResponseError{Code: code},
+			Clusters: clusters,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	clusters, err := getService[duplicate.Service](mw).Clusters(req.SpaceId, req.MinSimilarity)
+	if errors.Is(err, duplicate.ErrBadInput) {
+		return response(pb.RpcObjectDuplicatesListResponseError_BAD_INPUT, nil, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectDuplicatesListResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	if req.Limit > 0 && len(clusters) > int(req.Limit) {
+		clusters = clusters[:req.Limit]
+	}
+	result := make([]*pb.RpcObjectDuplicatesListCluster, 0, len(clusters))
+	for _, c := range clusters {
+		cluster := &pb.RpcObjectDuplicatesListCluster{Similarity: c.Similarity}
+		for _, details := range c.Objects {
+			cluster.Objects = append(cluster.Objects, pbtypes.Map(details, req.Keys...))
+		}
+		result = append(result, cluster)
+	}
+	return response(pb.RpcObjectDuplicatesListResponseError_NULL, result, nil)
+}
+
+func (mw *Middleware) ObjectMerge(cctx context.Context, req *pb.RpcObjectMergeRequest) *pb.RpcObjectMergeResponse {
+	response := func(code pb.RpcObjectMergeResponseErrorCode, err error) *pb.RpcObjectMergeResponse {
+		m := &pb.RpcObjectMergeResponse{Error: &pb.RpcObjectMergeResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := mw.doBlockService(func(bs *block.Service) error {
+		return bs.MergeObjects(req.TargetId, req.SourceIds)
+	})
+	if errors.Is(err, block.ErrBadMergeInput) {
+		return response(pb.RpcObjectMergeResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectMergeResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectMergeResponseError_NULL, nil)
+}
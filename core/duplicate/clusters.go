@@ -0,0 +1,167 @@
+package duplicate
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type pair struct {
+	a, b       string
+	similarity float64
+}
+
+func (s *service) Clusters(spaceID string, minSimilarity float64) ([]Cluster, error) {
+	if spaceID == "" {
+		return nil, fmt.Errorf("%w: space id is required", ErrBadInput)
+	}
+	if minSimilarity < 0 || minSimilarity > 1 {
+		return nil, fmt.Errorf("%w: similarity must be from 0 to 1", ErrBadInput)
+	}
+	if minSimilarity == 0 {
+		minSimilarity = DefaultMinSimilarity
+	}
+
+	s.mu.RLock()
+	pairs := similarPairs(s.fingerprints, spaceID, minSimilarity)
+	s.mu.RUnlock()
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(pairs)*2)
+	for _, p := range pairs {
+		ids = append(ids, p.a, p.b)
+	}
+	records, err := s.objectStore.QueryByID(ids)
+	if err != nil {
+		return nil, fmt.Errorf("query objects: %w", err)
+	}
+	details := make(map[string]*types.Struct, len(records))
+	for _, rec := range records {
+		if pbtypes.GetBool(rec.Details, bundle.RelationKeyIsDeleted.String()) || pbtypes.GetBool(rec.Details, bundle.RelationKeyIsArchived.String()) {
+			continue
+		}
+		details[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = rec.Details
+	}
+	return makeClusters(pairs, details), nil
+}
+
+// similarPairs returns pairs of objects of the space with the similarity not less than min. Candidates are
+// objects with equal parts of text fingerprints or equal names, so all objects are not compared to each other
+func similarPairs(fingerprints map[string]fingerprint, spaceID string, minSimilarity float64) []pair {
+	buckets := map[string][]string{}
+	for id, f := range fingerprints {
+		if f.SpaceID != spaceID {
+			continue
+		}
+		if f.HasText {
+			for i, band := range bands(f.Hash) {
+				key := fmt.Sprintf("band%d:%d", i, band)
+				buckets[key] = append(buckets[key], id)
+			}
+		}
+		if f.Name != "" {
+			buckets["name:"+f.Name] = append(buckets["name:"+f.Name], id)
+		}
+	}
+
+	seen := map[[2]string]struct{}{}
+	var pairs []pair
+	for _, bucket := range buckets {
+		for i := 0; i < len(bucket); i++ {
+			for j := i + 1; j < len(bucket); j++ {
+				a, b := bucket[i], bucket[j]
+				if a > b {
+					a, b = b, a
+				}
+				if _, ok := seen[[2]string{a, b}]; ok {
+					continue
+				}
+				seen[[2]string{a, b}] = struct{}{}
+				if sim := similarity(fingerprints[a], fingerprints[b]); sim >= minSimilarity {
+					pairs = append(pairs, pair{a: a, b: b, similarity: sim})
+				}
+			}
+		}
+	}
+	return pairs
+}
+
+// makeClusters joins pairs of objects with the same layout into clusters. Objects without details are skipped
+func makeClusters(pairs []pair, details map[string]*types.Struct) []Cluster {
+	parent := map[string]string{}
+	var find func(id string) string
+	find = func(id string) string {
+		if p, ok := parent[id]; ok && p != id {
+			parent[id] = find(p)
+			return parent[id]
+		}
+		parent[id] = id
+		return id
+	}
+
+	for _, p := range pairs {
+		da, okA := details[p.a]
+		db, okB := details[p.b]
+		if !okA || !okB || pbtypes.GetInt64(da, bundle.RelationKeyLayout.String()) != pbtypes.GetInt64(db, bundle.RelationKeyLayout.String()) {
+			continue
+		}
+		parent[find(p.a)] = find(p.b)
+	}
+
+	members := map[string][]string{}
+	for id := range parent {
+		root := find(id)
+		members[root] = append(members[root], id)
+	}
+	minSimilarity := map[string]float64{}
+	for _, p := range pairs {
+		_, okA := parent[p.a]
+		_, okB := parent[p.b]
+		if !okA || !okB || find(p.a) != find(p.b) {
+			continue
+		}
+		root := find(p.a)
+		if cur, ok := minSimilarity[root]; !ok || p.similarity < cur {
+			minSimilarity[root] = p.similarity
+		}
+	}
+
+	clusters := make([]Cluster, 0, len(members))
+	for root, ids := range members {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			ci := pbtypes.GetInt64(details[ids[i]], bundle.RelationKeyCreatedDate.String())
+			cj := pbtypes.GetInt64(details[ids[j]], bundle.RelationKeyCreatedDate.String())
+			if ci != cj {
+				return ci < cj
+			}
+			return ids[i] < ids[j]
+		})
+		objects := make([]*types.Struct, 0, len(ids))
+		for _, id := range ids {
+			objects = append(objects, details[id])
+		}
+		// similarity is rounded, so clusters with almost equal similarity are ordered by size
+		clusters = append(clusters, Cluster{Objects: objects, Similarity: math.Round(minSimilarity[root]*1000) / 1000})
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Similarity != clusters[j].Similarity {
+			return clusters[i].Similarity > clusters[j].Similarity
+		}
+		if len(clusters[i].Objects) != len(clusters[j].Objects) {
+			return len(clusters[i].Objects) > len(clusters[j].Objects)
+		}
+		return pbtypes.GetString(clusters[i].Objects[0], bundle.RelationKeyId.String()) <
+			pbtypes.GetString(clusters[j].Objects[0], bundle.RelationKeyId.String())
+	})
+	return clusters
+}
@@ -0,0 +1,82 @@
+package duplicate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func makeDetails(id string, layout model.ObjectTypeLayout, createdDate int64) *types.Struct {
+	return &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeyId.String():          pbtypes.String(id),
+		bundle.RelationKeyLayout.String():      pbtypes.Int64(int64(layout)),
+		bundle.RelationKeyCreatedDate.String(): pbtypes.Int64(createdDate),
+	}}
+}
+
+func clusterIDs(clusters []Cluster) [][]string {
+	result := make([][]string, 0, len(clusters))
+	for _, c := range clusters {
+		var ids []string
+		for _, d := range c.Objects {
+			ids = append(ids, pbtypes.GetString(d, bundle.RelationKeyId.String()))
+		}
+		result = append(result, ids)
+	}
+	return result
+}
+
+func TestClusters(t *testing.T) {
+	edited := strings.Replace(meetingNotes, "mobile application", "mobile app", 1)
+	fingerprints := map[string]fingerprint{
+		"notes":     newFingerprint("space1", "Planning meeting", meetingNotes),
+		"notesCopy": newFingerprint("space1", "Planning meeting", edited),
+		"notes2":    newFingerprint("space1", "Meeting", meetingNotes),
+		"otherNote": newFingerprint("space1", "Apple pie", "Recipe of the apple pie: mix flour, butter and sugar, put sliced apples on the dough and bake"),
+		"todo":      newFingerprint("space1", "Groceries", ""),
+		"todoCopy":  newFingerprint("space1", "groceries", ""),
+		"otherTodo": newFingerprint("space2", "Groceries", ""),
+	}
+
+	t.Run("similar objects of the space", func(t *testing.T) {
+		// given
+		pairs := similarPairs(fingerprints, "space1", DefaultMinSimilarity)
+		details := map[string]*types.Struct{
+			"notes":     makeDetails("notes", model.ObjectType_basic, 3),
+			"notesCopy": makeDetails("notesCopy", model.ObjectType_basic, 2),
+			"notes2":    makeDetails("notes2", model.ObjectType_basic, 1),
+			"otherNote": makeDetails("otherNote", model.ObjectType_basic, 1),
+			"todo":      makeDetails("todo", model.ObjectType_todo, 1),
+			"todoCopy":  makeDetails("todoCopy", model.ObjectType_todo, 2),
+		}
+
+		// when
+		clusters := makeClusters(pairs, details)
+
+		// then
+		assert.Equal(t, [][]string{{"todo", "todoCopy"}, {"notes2", "notesCopy", "notes"}}, clusterIDs(clusters))
+		assert.Equal(t, 1.0, clusters[0].Similarity)
+		assert.GreaterOrEqual(t, clusters[1].Similarity, DefaultMinSimilarity)
+	})
+	t.Run("objects with other layout or without details are skipped", func(t *testing.T) {
+		// given
+		pairs := similarPairs(fingerprints, "space1", DefaultMinSimilarity)
+		details := map[string]*types.Struct{
+			"notes":     makeDetails("notes", model.ObjectType_basic, 1),
+			"notesCopy": makeDetails("notesCopy", model.ObjectType_note, 2),
+			"todo":      makeDetails("todo", model.ObjectType_todo, 1),
+		}
+
+		// when
+		clusters := makeClusters(pairs, details)
+
+		// then
+		assert.Empty(t, clusters)
+	})
+}
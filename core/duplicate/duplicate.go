@@ -0,0 +1,275 @@
+package duplicate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const CName = "duplicate"
+
+var log = logging.Logger("anytype-mw-duplicate")
+
+var ErrBadInput = errors.New("bad input")
+
+const (
+	fingerprintKeyPrefix = "/duplicate/fingerprint/"
+	versionKey           = "/duplicate/version"
+	// fingerprintVersion is increased, when fingerprints are computed in other way, so they are computed again
+	fingerprintVersion = "1"
+
+	batchSize = 64
+	// DefaultMinSimilarity is the similarity of objects in clusters, when it is not set
+	DefaultMinSimilarity = 0.8
+)
+
+// Service keeps fingerprints of texts and names of objects and finds clusters of likely duplicates. Fingerprints
+// are updated in background from documents of the full-text indexer
+type Service interface {
+	// Index queues documents of the full-text index to update their fingerprints
+	Index(docs ...ftsearch.SearchDoc)
+	// Clusters returns groups of objects of the space, which are similar to each other. Objects of clusters
+	// are ordered by creation date, so the original object goes first
+	Clusters(spaceID string, minSimilarity float64) ([]Cluster, error)
+	app.ComponentRunnable
+}
+
+type Cluster struct {
+	Objects []*types.Struct
+	// Similarity is the lowest similarity of objects joined into the cluster from 0 to 1
+	Similarity float64
+}
+
+func New() Service {
+	return &service{
+		fingerprints: map[string]fingerprint{},
+		pending:      map[string]*ftsearch.SearchDoc{},
+		notify:       make(chan struct{}, 1),
+	}
+}
+
+type service struct {
+	objectStore objectstore.ObjectStore
+	db          *badger.DB
+
+	mu           sync.RWMutex
+	fingerprints map[string]fingerprint
+
+	pendingMu sync.Mutex
+	// pending are documents by object id, nil document means removal of the fingerprint
+	pending map[string]*ftsearch.SearchDoc
+	notify  chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	if err := s.loadFingerprints(); err != nil {
+		return fmt.Errorf("load fingerprints: %w", err)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.objectStore.SubscribeForChanges(s.onChange)
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// loadFingerprints loads fingerprints of the current version. Otherwise, fingerprints are removed and all objects
+// are queued to the full-text indexer, which passes their documents to the service again
+func (s *service) loadFingerprints() error {
+	version, err := badgerhelper.GetValue(s.db, []byte(versionKey), badgerhelper.UnmarshalString)
+	if err != nil && !badgerhelper.IsNotFound(err) {
+		return fmt.Errorf("get version: %w", err)
+	}
+	if version != fingerprintVersion {
+		return s.resetFingerprints()
+	}
+	return s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(fingerprintKeyPrefix), PrefetchValues: true})
+		defer iter.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			f, err := decodeFingerprint(raw)
+			if err != nil {
+				return fmt.Errorf("decode fingerprint: %w", err)
+			}
+			s.fingerprints[strings.TrimPrefix(string(iter.Item().Key()), fingerprintKeyPrefix)] = f
+		}
+		return nil
+	})
+}
+
+func (s *service) resetFingerprints() error {
+	if err := s.db.DropPrefix([]byte(fingerprintKeyPrefix)); err != nil {
+		return fmt.Errorf("remove fingerprints: %w", err)
+	}
+	if err := badgerhelper.SetValue(s.db, []byte(versionKey), fingerprintVersion); err != nil {
+		return fmt.Errorf("save version: %w", err)
+	}
+	ids, err := s.objectStore.ListIds()
+	if err != nil {
+		return fmt.Errorf("list ids: %w", err)
+	}
+	for _, id := range ids {
+		if err = s.objectStore.AddToIndexQueue(id); err != nil {
+			return fmt.Errorf("add to index queue: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *service) Index(docs ...ftsearch.SearchDoc) {
+	if len(docs) == 0 {
+		return
+	}
+	s.pendingMu.Lock()
+	for i := range docs {
+		s.pending[docs[i].Id] = &docs[i]
+	}
+	s.pendingMu.Unlock()
+	s.wake()
+}
+
+// onChange is called by the object store, so fingerprints of deleted objects are only queued for removal
+func (s *service) onChange(id string, _, newDetails *types.Struct) {
+	if !pbtypes.GetBool(newDetails, bundle.RelationKeyIsDeleted.String()) {
+		return
+	}
+	s.mu.RLock()
+	_, ok := s.fingerprints[id]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+	s.pendingMu.Lock()
+	s.pending[id] = nil
+	s.pendingMu.Unlock()
+	s.wake()
+}
+
+func (s *service) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.notify:
+			for s.ctx.Err() == nil && s.processBatch() {
+			}
+		}
+	}
+}
+
+// processBatch saves fingerprints of the batch of pending documents and returns false, when there is nothing to do
+func (s *service) processBatch() bool {
+	s.pendingMu.Lock()
+	batch := make(map[string]*fingerprint, batchSize)
+	for id, doc := range s.pending {
+		if len(batch) >= batchSize {
+			break
+		}
+		delete(s.pending, id)
+		if doc == nil {
+			batch[id] = nil
+			continue
+		}
+		f := newFingerprint(doc.SpaceID, doc.Title, doc.Text)
+		batch[id] = &f
+	}
+	s.pendingMu.Unlock()
+	if len(batch) == 0 {
+		return false
+	}
+	if err := s.saveFingerprints(batch); err != nil {
+		log.Errorf("save fingerprints: %s", err)
+	}
+	return true
+}
+
+// saveFingerprints saves fingerprints by object ids, nil fingerprint is removed
+func (s *service) saveFingerprints(batch map[string]*fingerprint) error {
+	err := s.db.Update(func(txn *badger.Txn) error {
+		for id, f := range batch {
+			if f == nil {
+				if err := txn.Delete(fingerprintKey(id)); err != nil {
+					return err
+				}
+				continue
+			}
+			raw, err := f.encode()
+			if err != nil {
+				return err
+			}
+			if err = txn.Set(fingerprintKey(id), raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, f := range batch {
+		if f == nil {
+			delete(s.fingerprints, id)
+		} else {
+			s.fingerprints[id] = *f
+		}
+	}
+	return nil
+}
+
+func fingerprintKey(id string) []byte {
+	return []byte(fingerprintKeyPrefix + id)
+}
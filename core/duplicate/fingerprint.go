@@ -0,0 +1,146 @@
+package duplicate
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+const (
+	// shingleSize is the maximal number of words hashed together. Single words make fingerprints of short texts
+	// stable to small edits and pairs of words distinguish texts with the same words in other order
+	shingleSize = 2
+	// minTextWords is the minimal number of words in the text to compare objects by the text. Fingerprints of
+	// shorter texts are not reliable, so such objects are compared by names only
+	minTextWords = 8
+	// maxTextDistance is the distance between fingerprints of unrelated texts, texts with smaller
+	// distance are similar
+	maxTextDistance = 32
+	// textWeight is the weight of the text similarity, the rest is the weight of the name similarity
+	textWeight = 0.8
+)
+
+// fingerprint describes the content of the object
+type fingerprint struct {
+	SpaceID string `json:"spaceId"`
+	// Name is the normalized name
+	Name string `json:"name,omitempty"`
+	// Hash is the simhash of the text
+	Hash    uint64 `json:"hash"`
+	HasText bool   `json:"hasText,omitempty"`
+}
+
+func newFingerprint(spaceID, name, text string) fingerprint {
+	words := splitWords(text)
+	return fingerprint{
+		SpaceID: spaceID,
+		Name:    normalizeName(name),
+		Hash:    simhash(words),
+		HasText: len(words) >= minTextWords,
+	}
+}
+
+func (f fingerprint) encode() ([]byte, error) {
+	return json.Marshal(f)
+}
+
+func decodeFingerprint(raw []byte) (fingerprint, error) {
+	var f fingerprint
+	err := json.Unmarshal(raw, &f)
+	return f, err
+}
+
+// similarity returns the similarity of objects from 0 to 1. Objects with texts are compared mostly by texts,
+// objects without texts are compared by names
+func similarity(a, b fingerprint) float64 {
+	name := nameSimilarity(a.Name, b.Name)
+	if !a.HasText || !b.HasText {
+		if a.HasText != b.HasText {
+			return 0
+		}
+		return name
+	}
+	text := 1 - float64(bits.OnesCount64(a.Hash^b.Hash))/maxTextDistance
+	if text < 0 {
+		text = 0
+	}
+	return textWeight*text + (1-textWeight)*name
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// simhash returns the hash of words and word shingles, which differs in a few bits for similar texts
+func simhash(words []string) uint64 {
+	if len(words) == 0 {
+		return 0
+	}
+	var weights [64]int
+	h := fnv.New64a()
+	for size := 1; size <= shingleSize; size++ {
+		for i := 0; i+size <= len(words); i++ {
+			h.Reset()
+			_, _ = h.Write([]byte(strings.Join(words[i:i+size], " ")))
+			sum := h.Sum64()
+			for bit := 0; bit < 64; bit++ {
+				if sum&(1<<bit) != 0 {
+					weights[bit]++
+				} else {
+					weights[bit]--
+				}
+			}
+		}
+	}
+	var hash uint64
+	for bit, w := range weights {
+		if w > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return hash
+}
+
+func normalizeName(name string) string {
+	return strings.Join(splitWords(name), " ")
+}
+
+// nameSimilarity returns the Jaccard similarity of character trigrams of normalized names
+func nameSimilarity(a, b string) float64 {
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	ta, tb := trigrams(a), trigrams(b)
+	var common int
+	for t := range ta {
+		if _, ok := tb[t]; ok {
+			common++
+		}
+	}
+	return float64(common) / float64(len(ta)+len(tb)-common)
+}
+
+func trigrams(s string) map[string]struct{} {
+	runes := []rune(" " + s + " ")
+	result := make(map[string]struct{}, len(runes))
+	for i := 0; i+3 <= len(runes); i++ {
+		result[string(runes[i:i+3])] = struct{}{}
+	}
+	return result
+}
+
+// bands splits the hash into 8 bytes. Hashes with the distance up to 7 bits have at least one equal byte
+func bands(hash uint64) [8]byte {
+	var result [8]byte
+	for i := range result {
+		result[i] = byte(hash >> (8 * i))
+	}
+	return result
+}
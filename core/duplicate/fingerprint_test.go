@@ -0,0 +1,93 @@
+package duplicate
+
+import (
+	"math/bits"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const meetingNotes = "Quarterly planning meeting with the design team. We discussed the roadmap for the next release, " +
+	"agreed on the priorities for the mobile application and assigned owners for every milestone of the project"
+
+func TestSimhash(t *testing.T) {
+	t.Run("similar texts", func(t *testing.T) {
+		// given
+		edited := strings.Replace(meetingNotes, "mobile application", "mobile app", 1)
+
+		// when
+		distance := bits.OnesCount64(simhash(splitWords(meetingNotes)) ^ simhash(splitWords(edited)))
+
+		// then
+		assert.LessOrEqual(t, distance, 7)
+	})
+	t.Run("unrelated texts", func(t *testing.T) {
+		// given
+		other := "Recipe of the apple pie: mix flour, butter and sugar, put sliced apples on the dough and bake for forty minutes"
+
+		// when
+		distance := bits.OnesCount64(simhash(splitWords(meetingNotes)) ^ simhash(splitWords(other)))
+
+		// then
+		assert.Greater(t, distance, 16)
+	})
+	t.Run("appended sentence", func(t *testing.T) {
+		// when
+		distance := bits.OnesCount64(simhash(splitWords(meetingNotes)) ^ simhash(splitWords(meetingNotes+" Next meeting is on Monday.")))
+
+		// then
+		assert.LessOrEqual(t, distance, 7)
+	})
+	t.Run("case and punctuation are ignored", func(t *testing.T) {
+		assert.Equal(t, simhash(splitWords("Hello, World!")), simhash(splitWords("hello world")))
+	})
+}
+
+func TestNameSimilarity(t *testing.T) {
+	assert.Equal(t, 1.0, nameSimilarity(normalizeName("Meeting notes"), normalizeName("meeting   Notes!")))
+	assert.Greater(t, nameSimilarity("meeting notes", "meeting notes 2"), 0.7)
+	assert.Less(t, nameSimilarity("meeting notes", "apple pie"), 0.1)
+	assert.Zero(t, nameSimilarity("", ""))
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Run("same text", func(t *testing.T) {
+		a := newFingerprint("space1", "Meeting", meetingNotes)
+		b := newFingerprint("space1", "Planning", meetingNotes)
+		assert.GreaterOrEqual(t, similarity(a, b), textWeight)
+	})
+	t.Run("objects without text are compared by names", func(t *testing.T) {
+		a := newFingerprint("space1", "Meeting notes", "")
+		b := newFingerprint("space1", "meeting notes", "short")
+		assert.Equal(t, 1.0, similarity(a, b))
+	})
+	t.Run("object with text is not duplicate of empty object", func(t *testing.T) {
+		a := newFingerprint("space1", "Meeting notes", meetingNotes)
+		b := newFingerprint("space1", "Meeting notes", "")
+		assert.Zero(t, similarity(a, b))
+	})
+}
+
+func TestBands(t *testing.T) {
+	a := simhash(splitWords(meetingNotes))
+	for _, distance := range []int{1, 4, 7} {
+		// given
+		b := a
+		for bit := 0; bit < distance; bit++ {
+			b ^= 1 << (bit * 9)
+		}
+
+		// when
+		ba, bb := bands(a), bands(b)
+
+		// then
+		var equal int
+		for i := range ba {
+			if ba[i] == bb[i] {
+				equal++
+			}
+		}
+		assert.GreaterOrEqual(t, equal, 1)
+	}
+}
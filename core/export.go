@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"errors"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/export"
@@ -15,6 +16,11 @@ func (mw *Middleware) ObjectListExport(cctx context.Context, req *pb.RpcObjectLi
 				Code: pb.RpcObjectListExportResponseError_NULL,
 			},
 		}
+		if errors.Is(err, export.ErrPasswordWithoutZip) {
+			res.Error.Code = pb.RpcObjectListExportResponseError_BAD_INPUT
+			res.Error.Description = err.Error()
+			return
+		}
 		if err != nil {
 			res.Error.Code = pb.RpcObjectListExportResponseError_UNKNOWN_ERROR
 			res.Error.Description = err.Error()
@@ -8,6 +8,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/filestorage"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -138,3 +139,23 @@ func (mw *Middleware) FileSpaceUsage(cctx context.Context, req *pb.RpcFileSpaceU
 	}
 	return response(pb.RpcFileSpaceUsageResponseError_NULL, nil, usage)
 }
+
+func (mw *Middleware) FileRunGC(cctx context.Context, req *pb.RpcFileRunGCRequest) *pb.RpcFileRunGCResponse {
+	response := func(stats filestorage.GCStats, code pb.RpcFileRunGCResponseErrorCode, err error) *pb.RpcFileRunGCResponse {
+		m := &pb.RpcFileRunGCResponse{
+			Error:         &pb.RpcFileRunGCResponseError{Code: code},
+			BlocksRemoved: uint64(stats.BlocksRemoved),
+			BytesFreed:    stats.BytesFreed,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	stats, err := getService[filestorage.FileStorage](mw).RunGC(cctx)
+	if err != nil {
+		return response(stats, pb.RpcFileRunGCResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(stats, pb.RpcFileRunGCResponseError_NULL, nil)
+}
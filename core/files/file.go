@@ -135,6 +135,7 @@ func (f *file) Hash() string {
 }
 
 func (f *file) Reader(ctx context.Context) (io.ReadSeeker, error) {
+	f.node.touchFile(ctx, domain.FullID{SpaceID: f.spaceID, ObjectID: f.hash})
 	return f.node.getContentReader(ctx, f.spaceID, f.info)
 }
 
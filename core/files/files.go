@@ -512,10 +512,26 @@ func (s *service) fileContent(ctx context.Context, id domain.FullID) (io.ReadSee
 	if err != nil {
 		return nil, nil, err
 	}
+	s.touchFile(ctx, id)
+	s.prioritizeUpload(id)
 	reader, err = s.getContentReader(ctx, id.SpaceID, file)
 	return reader, file, err
 }
 
+// touchFile marks file as recently accessed, so its blocks are evicted from local cache after blocks of other files
+func (s *service) touchFile(ctx context.Context, id domain.FullID) {
+	if err := s.fileSync.TouchFile(ctx, id.SpaceID, id.ObjectID); err != nil {
+		log.With("fileID", id.ObjectID).Errorf("failed to touch file in local cache: %s", err)
+	}
+}
+
+// prioritizeUpload moves the file, which is opened by user, ahead of other files in upload queue
+func (s *service) prioritizeUpload(id domain.FullID) {
+	if err := s.fileSync.Prioritize(id.SpaceID, id.ObjectID); err != nil {
+		log.With("fileID", id.ObjectID).Errorf("failed to prioritize file upload: %s", err)
+	}
+}
+
 func (s *service) getContentReader(ctx context.Context, spaceID string, file *storage.FileInfo) (symmetric.ReadSeekCloser, error) {
 	fileCid, err := cid.Parse(file.Hash)
 	if err != nil {
@@ -0,0 +1,73 @@
+package filetext
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const docxDocumentPath = "word/document.xml"
+
+// extractDocx returns text of the paragraphs of the main document part
+func extractDocx(data []byte) (string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("open docx: %w", err)
+	}
+	for _, f := range zr.File {
+		if f.Name != docxDocumentPath {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", fmt.Errorf("open document: %w", err)
+		}
+		defer rc.Close()
+		return parseDocumentXML(io.LimitReader(rc, MaxFileSize))
+	}
+	return "", fmt.Errorf("document part not found")
+}
+
+func parseDocumentXML(r io.Reader) (string, error) {
+	var (
+		sb     strings.Builder
+		inText bool
+	)
+	decoder := xml.NewDecoder(r)
+	for sb.Len() < MaxTextLength {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("parse document: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				sb.WriteByte('\t')
+			case "br", "cr":
+				sb.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				sb.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				sb.Write(t)
+			}
+		}
+	}
+	return sb.String(), nil
+}
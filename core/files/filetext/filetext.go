@@ -0,0 +1,103 @@
+// Package filetext extracts plain text from files attached to objects, so they can be found by full-text search
+package filetext
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	// MaxFileSize is the max size of the file, which text is extracted
+	MaxFileSize = 20 << 20
+	// MaxTextLength limits the length of the extracted text in bytes
+	MaxTextLength = 1 << 20
+)
+
+var (
+	ErrUnsupported = errors.New("unsupported file format")
+	ErrTooLarge    = errors.New("file is too large")
+)
+
+type format int
+
+const (
+	formatUnsupported format = iota
+	formatPlain
+	formatDocx
+	formatPdf
+)
+
+var plainExtensions = map[string]struct{}{
+	".txt":  {},
+	".md":   {},
+	".csv":  {},
+	".tsv":  {},
+	".json": {},
+	".xml":  {},
+	".log":  {},
+	".yaml": {},
+	".yml":  {},
+}
+
+// IsSupported returns true, if the text can be extracted from the file with the media type or name
+func IsSupported(media, name string) bool {
+	return detectFormat(media, name) != formatUnsupported
+}
+
+// Extract reads the file and returns its text. Supported formats are plain text, DOCX and PDF. Text of PDF
+// is extracted on best effort basis, as fonts with custom encodings are not decoded
+func Extract(r io.Reader, size int64, media, name string) (string, error) {
+	f := detectFormat(media, name)
+	if f == formatUnsupported {
+		return "", ErrUnsupported
+	}
+	if size > MaxFileSize {
+		return "", ErrTooLarge
+	}
+	data, err := io.ReadAll(io.LimitReader(r, MaxFileSize+1))
+	if err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+	if len(data) > MaxFileSize {
+		return "", ErrTooLarge
+	}
+
+	var text string
+	switch f {
+	case formatPlain:
+		text = strings.ToValidUTF8(string(data), "")
+	case formatDocx:
+		text, err = extractDocx(data)
+	case formatPdf:
+		text, err = extractPdf(data)
+	}
+	if err != nil {
+		return "", err
+	}
+	return textutil.TruncateBytes(strings.TrimSpace(text), MaxTextLength), nil
+}
+
+func detectFormat(media, name string) format {
+	media = strings.ToLower(media)
+	if i := strings.IndexByte(media, ';'); i >= 0 {
+		media = strings.TrimSpace(media[:i])
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	switch {
+	case media == "application/pdf" || ext == ".pdf":
+		return formatPdf
+	case media == "application/vnd.openxmlformats-officedocument.wordprocessingml.document" || ext == ".docx":
+		return formatDocx
+	case strings.HasPrefix(media, "text/") || media == "application/json" || media == "application/xml":
+		return formatPlain
+	}
+	if _, ok := plainExtensions[ext]; ok {
+		return formatPlain
+	}
+	return formatUnsupported
+}
@@ -0,0 +1,109 @@
+package filetext
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeDocx(t *testing.T, body string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	w, err := zw.Create(docxDocumentPath)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>%s</w:body></w:document>`, body)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func makePdf(t *testing.T, content string, compress bool) []byte {
+	stream := []byte(content)
+	dict := fmt.Sprintf("<< /Length %d >>", len(stream))
+	if compress {
+		buf := &bytes.Buffer{}
+		zw := zlib.NewWriter(buf)
+		_, err := zw.Write(stream)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		stream = buf.Bytes()
+		dict = fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>", len(stream))
+	}
+	return []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n4 0 obj\n" + dict + "\nstream\n" + string(stream) + "\nendstream\nendobj\n%%EOF")
+}
+
+func TestExtract(t *testing.T) {
+	t.Run("plain text", func(t *testing.T) {
+		// when
+		text, err := Extract(strings.NewReader("  meeting notes\n"), 16, "text/plain; charset=utf-8", "notes")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "meeting notes", text)
+	})
+	t.Run("plain text by extension", func(t *testing.T) {
+		// when
+		text, err := Extract(strings.NewReader("# Title"), 7, "application/octet-stream", "readme.md")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "# Title", text)
+	})
+	t.Run("docx", func(t *testing.T) {
+		// given
+		data := makeDocx(t, `<w:p><w:r><w:t>Quarterly</w:t></w:r><w:r><w:t xml:space="preserve"> report</w:t></w:r></w:p><w:p><w:r><w:t>Second</w:t><w:tab/><w:t>line</w:t></w:r></w:p>`)
+
+		// when
+		text, err := Extract(bytes.NewReader(data), int64(len(data)), "", "report.docx")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Quarterly report\nSecond\tline", text)
+	})
+	t.Run("pdf", func(t *testing.T) {
+		content := "BT /F1 12 Tf 72 712 Td (Hello \\(pdf\\)) Tj T* [(Wor) -20 (ld) -500 (again)] TJ ET"
+		for _, compress := range []bool{false, true} {
+			// given
+			data := makePdf(t, content, compress)
+
+			// when
+			text, err := Extract(bytes.NewReader(data), int64(len(data)), "application/pdf", "doc.pdf")
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, "Hello (pdf)\nWorld again", text)
+		}
+	})
+	t.Run("pdf with utf-16 strings", func(t *testing.T) {
+		// given
+		data := makePdf(t, "BT <FEFF041F04400438> Tj ET", false)
+
+		// when
+		text, err := Extract(bytes.NewReader(data), int64(len(data)), "application/pdf", "doc.pdf")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "При", text)
+	})
+	t.Run("unsupported format", func(t *testing.T) {
+		// when
+		_, err := Extract(strings.NewReader("data"), 4, "application/zip", "archive.zip")
+
+		// then
+		assert.ErrorIs(t, err, ErrUnsupported)
+	})
+	t.Run("too large file", func(t *testing.T) {
+		// when
+		_, err := Extract(strings.NewReader(""), MaxFileSize+1, "text/plain", "big.txt")
+
+		// then
+		assert.ErrorIs(t, err, ErrTooLarge)
+	})
+}
@@ -0,0 +1,303 @@
+package filetext
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+var (
+	pdfHeader      = []byte("%PDF")
+	pdfObj         = []byte("obj")
+	pdfStream      = []byte("stream")
+	pdfEndStream   = []byte("endstream")
+	pdfFlateDecode = []byte("/FlateDecode")
+
+	// skippedStreams are markers of streams, which don't contain page content
+	skippedStreams = [][]byte{
+		[]byte("/Image"),
+		[]byte("/FontFile"),
+		[]byte("/Length1"),
+		[]byte("/XRef"),
+		[]byte("/ObjStm"),
+		[]byte("/Metadata"),
+		[]byte("/DCTDecode"),
+		[]byte("/JPXDecode"),
+		[]byte("/CCITTFaxDecode"),
+		[]byte("/JBIG2Decode"),
+	}
+)
+
+// kerningSpace is the minimal offset in TJ array, which is treated as a space between words
+const kerningSpace = -200
+
+// extractPdf returns text shown by text operators of content streams. Streams are decoded, if they are
+// not compressed or compressed with FlateDecode
+func extractPdf(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), pdfHeader) {
+		return "", fmt.Errorf("not a pdf file")
+	}
+	var sb strings.Builder
+	for pos := 0; sb.Len() < MaxTextLength; {
+		i := bytes.Index(data[pos:], pdfStream)
+		if i < 0 {
+			break
+		}
+		start := pos + i
+		if bytes.HasSuffix(data[:start], []byte("end")) {
+			pos = start + len(pdfStream)
+			continue
+		}
+		header := data[pos:start]
+		if j := bytes.LastIndex(header, pdfObj); j >= 0 {
+			header = header[j:]
+		}
+		bodyStart := start + len(pdfStream)
+		if bytes.HasPrefix(data[bodyStart:], []byte("\r\n")) {
+			bodyStart += 2
+		} else if bytes.HasPrefix(data[bodyStart:], []byte("\n")) {
+			bodyStart++
+		}
+		end := bytes.Index(data[bodyStart:], pdfEndStream)
+		if end < 0 {
+			break
+		}
+		content := data[bodyStart : bodyStart+end]
+		pos = bodyStart + end + len(pdfEndStream)
+
+		if isSkippedStream(header) {
+			continue
+		}
+		if bytes.Contains(header, pdfFlateDecode) {
+			var err error
+			if content, err = inflate(content); err != nil {
+				continue
+			}
+		}
+		sb.WriteString(parseContentStream(content))
+	}
+	return sb.String(), nil
+}
+
+func isSkippedStream(header []byte) bool {
+	for _, marker := range skippedStreams {
+		if bytes.Contains(header, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func inflate(content []byte) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	data, err := io.ReadAll(io.LimitReader(zr, MaxFileSize))
+	// streams are often truncated or have garbage at the end, so decoded part is used
+	if err != nil && len(data) == 0 {
+		return nil, err
+	}
+	return data, nil
+}
+
+// parseContentStream collects strings shown by Tj, TJ, ' and " operators
+func parseContentStream(content []byte) string {
+	var (
+		sb       strings.Builder
+		operands []string
+		inArray  bool
+	)
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case isPdfSpace(c):
+			i++
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			var s []byte
+			s, i = readLiteralString(content, i)
+			operands = append(operands, decodePdfString(s))
+		case c == '<' && i+1 < len(content) && content[i+1] == '<', c == '>' && i+1 < len(content) && content[i+1] == '>':
+			i += 2
+		case c == '<':
+			var s []byte
+			s, i = readHexString(content, i)
+			operands = append(operands, decodePdfString(s))
+		case c == '[':
+			inArray = true
+			i++
+		case c == ']':
+			inArray = false
+			i++
+		case c == '/':
+			i++
+			for i < len(content) && !isPdfSpace(content[i]) && !isPdfDelimiter(content[i]) {
+				i++
+			}
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			start := i
+			i++
+			for i < len(content) && (content[i] == '.' || (content[i] >= '0' && content[i] <= '9')) {
+				i++
+			}
+			if n, err := strconv.ParseFloat(string(content[start:i]), 64); err == nil && inArray && n < kerningSpace {
+				operands = append(operands, " ")
+			}
+		default:
+			start := i
+			for i < len(content) && !isPdfSpace(content[i]) && !isPdfDelimiter(content[i]) {
+				i++
+			}
+			if i == start {
+				i++
+				continue
+			}
+			switch string(content[start:i]) {
+			case "Tj", "TJ":
+				sb.WriteString(strings.Join(operands, ""))
+			case "'", "\"":
+				sb.WriteByte('\n')
+				sb.WriteString(strings.Join(operands, ""))
+			case "Td", "TD", "Tm":
+				sb.WriteByte(' ')
+			case "T*", "ET":
+				sb.WriteByte('\n')
+			}
+			operands = operands[:0]
+		}
+	}
+	return sb.String()
+}
+
+func isPdfSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isPdfDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// readLiteralString reads the string in parentheses starting at i and returns it with position after the string
+func readLiteralString(content []byte, i int) ([]byte, int) {
+	var (
+		s     []byte
+		depth = 1
+	)
+	for i++; i < len(content); i++ {
+		c := content[i]
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s, i + 1
+			}
+		case '\\':
+			i++
+			if i >= len(content) {
+				return s, i
+			}
+			switch e := content[i]; e {
+			case 'n':
+				s = append(s, '\n')
+			case 'r':
+				s = append(s, '\r')
+			case 't':
+				s = append(s, '\t')
+			case 'b':
+				s = append(s, '\b')
+			case 'f':
+				s = append(s, '\f')
+			case '\r':
+				if i+1 < len(content) && content[i+1] == '\n' {
+					i++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					n := 0
+					for j := 0; j < 3 && i < len(content) && content[i] >= '0' && content[i] <= '7'; j++ {
+						n = n*8 + int(content[i]-'0')
+						i++
+					}
+					i--
+					s = append(s, byte(n))
+				} else {
+					s = append(s, e)
+				}
+			}
+			continue
+		}
+		s = append(s, c)
+	}
+	return s, i
+}
+
+// readHexString reads the string in angle brackets starting at i and returns it with position after the string
+func readHexString(content []byte, i int) ([]byte, int) {
+	var (
+		s      []byte
+		digits []byte
+	)
+	for i++; i < len(content) && content[i] != '>'; i++ {
+		if v, ok := hexValue(content[i]); ok {
+			digits = append(digits, v)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, 0)
+	}
+	for j := 0; j < len(digits); j += 2 {
+		s = append(s, digits[j]<<4|digits[j+1])
+	}
+	return s, i + 1
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// decodePdfString decodes UTF-16 strings with byte order mark, other strings are treated as Latin-1.
+// Control characters, which are produced by glyph ids of fonts with custom encodings, are dropped
+func decodePdfString(s []byte) string {
+	var runes []rune
+	if len(s) >= 2 && s[0] == 0xfe && s[1] == 0xff {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		runes = utf16.Decode(units)
+	} else {
+		runes = make([]rune, 0, len(s))
+		for _, b := range s {
+			runes = append(runes, rune(b))
+		}
+	}
+	var sb strings.Builder
+	for _, r := range runes {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,320 @@
+package ocr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/getblock"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	CName = "ocr"
+	// EngineCName is the name of optional component implementing Engine. If it is not registered, tesseract
+	// is used, when it is installed
+	EngineCName = "ocr-engine"
+)
+
+var log = logging.Logger("anytype-mw-ocr")
+
+var ErrBadInput = errors.New("bad input")
+
+const (
+	tasksQueueSize   = 256
+	recognizeTimeout = 2 * time.Minute
+	// maxImageSize limits the size of original image passed to the engine
+	maxImageSize = 20 << 20
+	// maxTextLength limits the length of recognized text in bytes
+	maxTextLength = 64 << 10
+)
+
+// Engine recognizes text in the image
+type Engine interface {
+	Recognize(ctx context.Context, image io.Reader, media string) (string, error)
+}
+
+// Service recognizes text in images of spaces, where recognition is enabled, and keeps it in ocrText local
+// relation of the image object, so the image is found by full-text search
+type Service interface {
+	SetEnabled(ctx context.Context, spaceID string, enabled bool) error
+	app.ComponentRunnable
+}
+
+type detailsModifier interface {
+	ModifyDetails(objectId string, modifier func(current *types.Struct) (*types.Struct, error)) error
+}
+
+func New() Service {
+	return &service{pending: map[string]struct{}{}}
+}
+
+type service struct {
+	objectStore     objectstore.ObjectStore
+	picker          getblock.ObjectGetter
+	detailsModifier detailsModifier
+	spaceService    space.Service
+	fileService     files.Service
+	engine          Engine
+
+	mu sync.Mutex
+	// pending are ids of queued images
+	pending map[string]struct{}
+
+	tasks  chan task
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// task is recognition of text in the image. Task without object id is recognition in all images of the space
+// or of all spaces, if space id is empty too
+type task struct {
+	objectID string
+	spaceID  string
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.picker = app.MustComponent[getblock.ObjectGetter](a)
+	s.detailsModifier = app.MustComponent[detailsModifier](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.fileService = app.MustComponent[files.Service](a)
+	if engine, ok := a.Component(EngineCName).(Engine); ok {
+		s.engine = engine
+	} else {
+		s.engine = defaultEngine()
+	}
+	s.tasks = make(chan task, tasksQueueSize)
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	if s.engine == nil {
+		log.Infof("text recognition engine is not available")
+		return nil
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.objectStore.SubscribeForChanges(s.onChange)
+	// images, which were not recognized before the last close
+	s.tasks <- task{}
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// SetEnabled sets ocrEnabled relation of the workspace object of the space. Images of the space are recognized,
+// when the change is indexed
+func (s *service) SetEnabled(ctx context.Context, spaceID string, enabled bool) error {
+	if spaceID == "" {
+		return fmt.Errorf("%w: space is required", ErrBadInput)
+	}
+	workspaceID, err := s.workspaceID(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+	return s.detailsModifier.ModifyDetails(workspaceID, func(current *types.Struct) (*types.Struct, error) {
+		current = pbtypes.CopyStruct(current)
+		if current.Fields == nil {
+			current.Fields = map[string]*types.Value{}
+		}
+		current.Fields[bundle.RelationKeyOcrEnabled.String()] = pbtypes.Bool(enabled)
+		return current, nil
+	})
+}
+
+func (s *service) workspaceID(ctx context.Context, spaceID string) (string, error) {
+	spc, err := s.spaceService.Get(ctx, spaceID)
+	if err != nil {
+		return "", fmt.Errorf("get space: %w", err)
+	}
+	return spc.DerivedIDs().Workspace, nil
+}
+
+// onChange is called by the object store, so tasks are only queued and handled by the loop
+func (s *service) onChange(id string, oldDetails, newDetails *types.Struct) {
+	spaceID := pbtypes.GetString(newDetails, bundle.RelationKeySpaceId.String())
+	if pbtypes.GetBool(newDetails, bundle.RelationKeyOcrEnabled.String()) &&
+		!pbtypes.GetBool(oldDetails, bundle.RelationKeyOcrEnabled.String()) {
+		s.queue(task{spaceID: spaceID})
+		return
+	}
+	if !needsRecognition(newDetails) {
+		return
+	}
+	s.mu.Lock()
+	_, queued := s.pending[id]
+	if !queued {
+		s.pending[id] = struct{}{}
+	}
+	s.mu.Unlock()
+	if !queued && !s.queue(task{objectID: id, spaceID: spaceID}) {
+		s.done(id)
+	}
+}
+
+func (s *service) queue(t task) bool {
+	select {
+	case s.tasks <- t:
+		return true
+	default:
+		log.With("objectId", t.objectID).With("spaceId", t.spaceID).Warnf("text recognition queue is full, task is skipped")
+		return false
+	}
+}
+
+func (s *service) done(id string) {
+	s.mu.Lock()
+	delete(s.pending, id)
+	s.mu.Unlock()
+}
+
+func needsRecognition(details *types.Struct) bool {
+	if model.ObjectTypeLayout(pbtypes.GetInt64(details, bundle.RelationKeyLayout.String())) != model.ObjectType_image {
+		return false
+	}
+	if pbtypes.GetBool(details, bundle.RelationKeyIsDeleted.String()) {
+		return false
+	}
+	// recognized text is set even if it is empty, so the image is not recognized again
+	_, recognized := details.GetFields()[bundle.RelationKeyOcrText.String()]
+	return !recognized
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case t := <-s.tasks:
+			if t.objectID == "" {
+				s.recognizeAll(t.spaceID)
+				continue
+			}
+			if err := s.recognizeImage(t); err != nil {
+				log.With("objectId", t.objectID).Errorf("recognize text: %s", err)
+			}
+			s.done(t.objectID)
+		}
+	}
+}
+
+// recognizeAll recognizes text in images of the space or of all spaces, which were not recognized yet
+func (s *service) recognizeAll(spaceID string) {
+	filters := []*model.BlockContentDataviewFilter{{
+		RelationKey: bundle.RelationKeyLayout.String(),
+		Condition:   model.BlockContentDataviewFilter_Equal,
+		Value:       pbtypes.Int64(int64(model.ObjectType_image)),
+	}}
+	if spaceID != "" {
+		filters = append(filters, &model.BlockContentDataviewFilter{
+			RelationKey: bundle.RelationKeySpaceId.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       pbtypes.String(spaceID),
+		})
+	}
+	records, _, err := s.objectStore.Query(database.Query{Filters: filters})
+	if err != nil {
+		log.With("spaceId", spaceID).Errorf("query images: %s", err)
+		return
+	}
+	for _, rec := range records {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if !needsRecognition(rec.Details) {
+			continue
+		}
+		t := task{
+			objectID: pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()),
+			spaceID:  pbtypes.GetString(rec.Details, bundle.RelationKeySpaceId.String()),
+		}
+		if err = s.recognizeImage(t); err != nil {
+			log.With("objectId", t.objectID).Errorf("recognize text: %s", err)
+		}
+	}
+}
+
+func (s *service) recognizeImage(t task) error {
+	enabled, err := s.isEnabled(t.spaceID)
+	if err != nil || !enabled {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, recognizeTimeout)
+	defer cancel()
+	text, err := s.recognize(ctx, domain.FullID{SpaceID: t.spaceID, ObjectID: t.objectID})
+	if err != nil {
+		return err
+	}
+	return getblock.Do(s.picker, t.objectID, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		st.SetLocalDetail(bundle.RelationKeyOcrText.String(), pbtypes.String(text))
+		return sb.Apply(st, smartblock.NoHistory, smartblock.SkipIfNoChanges, smartblock.KeepInternalFlags)
+	})
+}
+
+func (s *service) isEnabled(spaceID string) (bool, error) {
+	workspaceID, err := s.workspaceID(s.ctx, spaceID)
+	if err != nil {
+		return false, err
+	}
+	details, err := s.objectStore.GetDetails(workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("get details of workspace: %w", err)
+	}
+	return pbtypes.GetBool(details.GetDetails(), bundle.RelationKeyOcrEnabled.String()), nil
+}
+
+// recognize returns text of the original image. Text of too large images is empty
+func (s *service) recognize(ctx context.Context, id domain.FullID) (string, error) {
+	image, err := s.fileService.ImageByHash(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("get image: %w", err)
+	}
+	file, err := image.GetOriginalFile(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get original file: %w", err)
+	}
+	if file.Meta().Size > maxImageSize {
+		return "", nil
+	}
+	r, err := file.Reader(ctx)
+	if err != nil {
+		return "", fmt.Errorf("read image: %w", err)
+	}
+	text, err := s.engine.Recognize(ctx, io.LimitReader(r, maxImageSize), file.Meta().Media)
+	if err != nil {
+		return "", fmt.Errorf("engine: %w", err)
+	}
+	return textutil.TruncateBytes(strings.TrimSpace(text), maxTextLength), nil
+}
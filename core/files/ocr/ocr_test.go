@@ -0,0 +1,158 @@
+package ocr
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type fakeEngine struct {
+	media string
+	image string
+}
+
+func (e *fakeEngine) Recognize(_ context.Context, image io.Reader, media string) (string, error) {
+	data, err := io.ReadAll(image)
+	e.image, e.media = string(data), media
+	return "  Invoice #42\n", err
+}
+
+type fakeFile struct {
+	files.File
+	meta *files.FileMeta
+	data string
+}
+
+func (f *fakeFile) Meta() *files.FileMeta {
+	return f.meta
+}
+
+func (f *fakeFile) Reader(context.Context) (io.ReadSeeker, error) {
+	return strings.NewReader(f.data), nil
+}
+
+type fakeImage struct {
+	files.Image
+	original *fakeFile
+}
+
+func (i *fakeImage) GetOriginalFile(context.Context) (files.File, error) {
+	return i.original, nil
+}
+
+type fakeFiles struct {
+	files.Service
+	images map[domain.FullID]*fakeImage
+}
+
+func (f *fakeFiles) ImageByHash(_ context.Context, id domain.FullID) (files.Image, error) {
+	return f.images[id], nil
+}
+
+func imageDetails(extra ...string) *types.Struct {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeySpaceId.String(): pbtypes.String("space1"),
+		bundle.RelationKeyLayout.String():  pbtypes.Int64(int64(model.ObjectType_image)),
+	}}
+	for _, key := range extra {
+		details.Fields[key] = pbtypes.String("")
+	}
+	return details
+}
+
+func TestOnChange(t *testing.T) {
+	t.Run("new image is queued once", func(t *testing.T) {
+		// given
+		s := &service{pending: map[string]struct{}{}, tasks: make(chan task, 10)}
+
+		// when
+		s.onChange("image1", nil, imageDetails())
+		s.onChange("image1", nil, imageDetails())
+
+		// then
+		require.Len(t, s.tasks, 1)
+		assert.Equal(t, task{objectID: "image1", spaceID: "space1"}, <-s.tasks)
+	})
+	t.Run("recognized images and other objects are skipped", func(t *testing.T) {
+		// given
+		s := &service{pending: map[string]struct{}{}, tasks: make(chan task, 10)}
+		page := imageDetails()
+		page.Fields[bundle.RelationKeyLayout.String()] = pbtypes.Int64(int64(model.ObjectType_basic))
+
+		// when
+		s.onChange("image1", nil, imageDetails(bundle.RelationKeyOcrText.String()))
+		s.onChange("page1", nil, page)
+
+		// then
+		assert.Len(t, s.tasks, 0)
+	})
+	t.Run("enabling of recognition queues images of the space", func(t *testing.T) {
+		// given
+		s := &service{pending: map[string]struct{}{}, tasks: make(chan task, 10)}
+		workspace := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeySpaceId.String():    pbtypes.String("space1"),
+			bundle.RelationKeyOcrEnabled.String(): pbtypes.Bool(true),
+		}}
+
+		// when
+		s.onChange("workspace1", nil, workspace)
+		s.onChange("workspace1", workspace, workspace)
+
+		// then
+		require.Len(t, s.tasks, 1)
+		assert.Equal(t, task{spaceID: "space1"}, <-s.tasks)
+	})
+}
+
+func TestRecognize(t *testing.T) {
+	id := domain.FullID{SpaceID: "space1", ObjectID: "image1"}
+
+	t.Run("text of original image", func(t *testing.T) {
+		// given
+		engine := &fakeEngine{}
+		s := &service{
+			engine: engine,
+			fileService: &fakeFiles{images: map[domain.FullID]*fakeImage{
+				id: {original: &fakeFile{meta: &files.FileMeta{Media: "image/png", Size: 3}, data: "png"}},
+			}},
+		}
+
+		// when
+		text, err := s.recognize(context.Background(), id)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Invoice #42", text)
+		assert.Equal(t, "png", engine.image)
+		assert.Equal(t, "image/png", engine.media)
+	})
+	t.Run("too large image is not recognized", func(t *testing.T) {
+		// given
+		engine := &fakeEngine{}
+		s := &service{
+			engine: engine,
+			fileService: &fakeFiles{images: map[domain.FullID]*fakeImage{
+				id: {original: &fakeFile{meta: &files.FileMeta{Media: "image/png", Size: maxImageSize + 1}}},
+			}},
+		}
+
+		// when
+		text, err := s.recognize(context.Background(), id)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, text)
+		assert.Empty(t, engine.media)
+	})
+}
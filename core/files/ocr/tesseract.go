@@ -0,0 +1,41 @@
+//go:build !gomobile
+
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+const tesseractBin = "tesseract"
+
+// tesseract recognizes text with tesseract command line tool
+type tesseract struct {
+	path string
+}
+
+// defaultEngine returns tesseract engine, if it is installed
+func defaultEngine() Engine {
+	path, err := exec.LookPath(tesseractBin)
+	if err != nil {
+		return nil
+	}
+	return &tesseract{path: path}
+}
+
+func (t *tesseract) Recognize(ctx context.Context, image io.Reader, _ string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	// image is read from stdin and text is written to stdout
+	cmd := exec.CommandContext(ctx, t.path, "stdin", "stdout")
+	cmd.Stdin = image
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("run tesseract: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
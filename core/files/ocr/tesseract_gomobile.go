@@ -0,0 +1,9 @@
+//go:build gomobile
+
+package ocr
+
+// defaultEngine returns nil, as external tools can't be run on mobile devices. Engine can be registered
+// as component with EngineCName
+func defaultEngine() Engine {
+	return nil
+}
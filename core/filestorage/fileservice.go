@@ -5,17 +5,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/anyproto/any-sync/app/logger"
 	"github.com/anyproto/any-sync/commonfile/fileblockstore"
 	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/anyproto/any-sync/commonfile/fileservice"
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
 	"github.com/anyproto/any-sync/net/rpc/server"
 	"github.com/dgraph-io/badger/v3"
 	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
 	"go.uber.org/zap"
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
@@ -23,6 +26,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
 	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
 	"github.com/anyproto/anytype-heart/space/spacecore/storage"
 )
 
@@ -40,6 +44,12 @@ type FileStorage interface {
 	app.ComponentRunnable
 
 	LocalDiskUsage(ctx context.Context) (uint64, error)
+	RunGC(ctx context.Context) (GCStats, error)
+}
+
+// DownloadLimiter is implemented by the component, which caps download rate of files of spaces
+type DownloadLimiter interface {
+	WaitDownload(ctx context.Context, spaceId string, bytes int) error
 }
 
 type fileStorage struct {
@@ -53,6 +63,12 @@ type fileStorage struct {
 	rpcStore     rpcstore.Service
 	spaceStorage storage.ClientStorage
 	eventSender  event.Sender
+	limiter      DownloadLimiter
+	fileStore    filestore.FileStore
+	dagService   format.DAGService
+
+	gcLock   sync.Mutex
+	gcCancel context.CancelFunc
 }
 
 var _ fileblockstore.BlockStoreLocal = &fileStorage{}
@@ -69,6 +85,13 @@ func (f *fileStorage) Init(a *app.App) (err error) {
 	f.spaceStorage = a.MustComponent(spacestorage.CName).(storage.ClientStorage)
 	f.handler = &rpcHandler{spaceStorage: f.spaceStorage}
 	f.eventSender = app.MustComponent[event.Sender](a)
+	f.fileStore = app.MustComponent[filestore.FileStore](a)
+	f.dagService = a.MustComponent(fileservice.CName).(fileservice.FileService).DAGService()
+	a.IterateComponents(func(c app.Component) {
+		if limiter, ok := c.(DownloadLimiter); ok {
+			f.limiter = limiter
+		}
+	})
 	if fileCfg.IPFSStorageAddr == "" {
 		f.flatfsPath = filepath.Join(app.MustComponent[wallet.Wallet](a).RepoPath(), FlatfsDirName)
 	} else {
@@ -100,8 +123,13 @@ func (f *fileStorage) Run(ctx context.Context) (err error) {
 		localStore: localStore,
 		origin:     f.rpcStore.NewStore(),
 		oldStore:   oldStore,
+		limiter:    f.limiter,
 	}
 	f.proxy = ps
+
+	var gcCtx context.Context
+	gcCtx, f.gcCancel = context.WithCancel(context.Background())
+	go f.gcLoop(gcCtx)
 	return
 }
 
@@ -144,5 +172,8 @@ func (f *fileStorage) NotExistsBlocks(ctx context.Context, bs []blocks.Block) (n
 }
 
 func (f *fileStorage) Close(ctx context.Context) (err error) {
+	if f.gcCancel != nil {
+		f.gcCancel()
+	}
 	return f.proxy.Close()
 }
@@ -0,0 +1,153 @@
+package filesync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// BandwidthLimit caps the rate of file transfers of a space in bytes per second. Zero means no limit
+type BandwidthLimit struct {
+	Upload   uint64
+	Download uint64
+}
+
+// rateLimiter spreads transfers over time, so the average rate doesn't exceed the limit.
+// Every transfer reserves time after the previous reservations and waits for its start
+type rateLimiter struct {
+	sync.Mutex
+	rate uint64
+	// next is the time, when all reserved transfers are finished
+	next time.Time
+}
+
+func (l *rateLimiter) reserve(now time.Time, bytes int) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(time.Duration(float64(bytes) / float64(l.rate) * float64(time.Second)))
+	return delay
+}
+
+type spaceLimiters struct {
+	upload   *rateLimiter
+	download *rateLimiter
+}
+
+// bandwidthLimits holds rate limiters of spaces
+type bandwidthLimits struct {
+	sync.Mutex
+	spaces map[string]*spaceLimiters
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newBandwidthLimits() *bandwidthLimits {
+	return &bandwidthLimits{
+		spaces: map[string]*spaceLimiters{},
+		now:    time.Now,
+		sleep:  sleepCtx,
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func newRateLimiter(rate uint64) *rateLimiter {
+	if rate == 0 {
+		return nil
+	}
+	return &rateLimiter{rate: rate}
+}
+
+func (b *bandwidthLimits) set(spaceId string, limit BandwidthLimit) {
+	b.Lock()
+	defer b.Unlock()
+	if limit.Upload == 0 && limit.Download == 0 {
+		delete(b.spaces, spaceId)
+		return
+	}
+	b.spaces[spaceId] = &spaceLimiters{
+		upload:   newRateLimiter(limit.Upload),
+		download: newRateLimiter(limit.Download),
+	}
+}
+
+func (b *bandwidthLimits) get(spaceId string) BandwidthLimit {
+	b.Lock()
+	defer b.Unlock()
+	var limit BandwidthLimit
+	if l, ok := b.spaces[spaceId]; ok {
+		if l.upload != nil {
+			limit.Upload = l.upload.rate
+		}
+		if l.download != nil {
+			limit.Download = l.download.rate
+		}
+	}
+	return limit
+}
+
+func (b *bandwidthLimits) limiters(spaceId string) *spaceLimiters {
+	b.Lock()
+	defer b.Unlock()
+	return b.spaces[spaceId]
+}
+
+func (b *bandwidthLimits) wait(ctx context.Context, limiter *rateLimiter, bytes int) error {
+	if limiter == nil || bytes <= 0 {
+		return nil
+	}
+	delay := limiter.reserve(b.now(), bytes)
+	if delay <= 0 {
+		return nil
+	}
+	return b.sleep(ctx, delay)
+}
+
+func (b *bandwidthLimits) waitUpload(ctx context.Context, spaceId string, bytes int) error {
+	l := b.limiters(spaceId)
+	if l == nil {
+		return nil
+	}
+	return b.wait(ctx, l.upload, bytes)
+}
+
+func (b *bandwidthLimits) waitDownload(ctx context.Context, spaceId string, bytes int) error {
+	l := b.limiters(spaceId)
+	if l == nil {
+		return nil
+	}
+	return b.wait(ctx, l.download, bytes)
+}
+
+// SetBandwidthLimit caps upload and download rate of files of the space. Zero limit removes the cap
+func (f *fileSync) SetBandwidthLimit(spaceId string, limit BandwidthLimit) {
+	log.Info("set bandwidth limit", zap.String("spaceID", spaceId), zap.Uint64("upload", limit.Upload), zap.Uint64("download", limit.Download))
+	f.bandwidth.set(spaceId, limit)
+}
+
+// BandwidthLimit returns the current bandwidth limit of the space
+func (f *fileSync) BandwidthLimit(spaceId string) BandwidthLimit {
+	return f.bandwidth.get(spaceId)
+}
+
+// WaitDownload delays the caller, which has downloaded the given amount of bytes of the space,
+// so downloads don't exceed the bandwidth limit of the space
+func (f *fileSync) WaitDownload(ctx context.Context, spaceId string, bytes int) error {
+	return f.bandwidth.waitDownload(ctx, spaceId, bytes)
+}
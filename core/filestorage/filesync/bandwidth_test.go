@@ -0,0 +1,75 @@
+package filesync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBandwidthLimits() (*bandwidthLimits, *[]time.Duration) {
+	b := newBandwidthLimits()
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	b.now = func() time.Time {
+		return now
+	}
+	var delays []time.Duration
+	b.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	return b, &delays
+}
+
+func TestBandwidthLimits(t *testing.T) {
+	t.Run("transfers of space are spread according to limit", func(t *testing.T) {
+		// given
+		b, delays := newTestBandwidthLimits()
+		b.set("space1", BandwidthLimit{Upload: 1000, Download: 500})
+
+		// when
+		for i := 0; i < 3; i++ {
+			require.NoError(t, b.waitUpload(context.Background(), "space1", 500))
+		}
+		require.NoError(t, b.waitDownload(context.Background(), "space1", 500))
+		require.NoError(t, b.waitDownload(context.Background(), "space1", 500))
+
+		// then
+		assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second, time.Second}, *delays)
+	})
+	t.Run("other spaces and removed limits are not throttled", func(t *testing.T) {
+		// given
+		b, delays := newTestBandwidthLimits()
+		b.set("space1", BandwidthLimit{Upload: 1000})
+		b.set("space2", BandwidthLimit{Upload: 1000})
+
+		// when
+		b.set("space2", BandwidthLimit{})
+		for i := 0; i < 3; i++ {
+			require.NoError(t, b.waitUpload(context.Background(), "space2", 1000))
+			require.NoError(t, b.waitUpload(context.Background(), "space3", 1000))
+			require.NoError(t, b.waitDownload(context.Background(), "space1", 1000))
+		}
+
+		// then
+		assert.Empty(t, *delays)
+		assert.Equal(t, BandwidthLimit{Upload: 1000}, b.get("space1"))
+		assert.Equal(t, BandwidthLimit{}, b.get("space2"))
+	})
+	t.Run("canceled context - return error", func(t *testing.T) {
+		// given
+		b, _ := newTestBandwidthLimits()
+		b.set("space1", BandwidthLimit{Upload: 1000})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		require.NoError(t, b.waitUpload(ctx, "space1", 1000))
+		err := b.waitUpload(ctx, "space1", 1000)
+
+		// then
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
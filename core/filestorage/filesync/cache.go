@@ -0,0 +1,240 @@
+package filesync
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/ipfs/go-cid"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/filestorage"
+)
+
+// localCache tracks file blocks stored locally in order of access, so the total size of cached blocks can be bounded
+type localCache struct {
+	sync.Mutex
+	limit  uint64
+	size   uint64
+	lru    *list.List // front is the least recently accessed block
+	blocks map[cid.Cid]*list.Element
+	pinned map[string]struct{}
+}
+
+type cachedBlock struct {
+	spaceID string
+	cid     cid.Cid
+	size    uint64
+	fileIDs map[string]struct{}
+}
+
+func newLocalCache() *localCache {
+	return &localCache{
+		lru:    list.New(),
+		blocks: map[cid.Cid]*list.Element{},
+		pinned: map[string]struct{}{},
+	}
+}
+
+func (c *localCache) setLimit(limit uint64) {
+	c.Lock()
+	defer c.Unlock()
+	c.limit = limit
+}
+
+func (c *localCache) isLimited() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.limit > 0
+}
+
+func (c *localCache) pin(fileID string) {
+	c.Lock()
+	defer c.Unlock()
+	c.pinned[fileID] = struct{}{}
+}
+
+func (c *localCache) unpin(fileID string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.pinned, fileID)
+}
+
+func (c *localCache) touch(spaceID, fileID string, blockCid cid.Cid, size uint64) {
+	c.Lock()
+	defer c.Unlock()
+	if el, ok := c.blocks[blockCid]; ok {
+		el.Value.(*cachedBlock).fileIDs[fileID] = struct{}{}
+		c.lru.MoveToBack(el)
+		return
+	}
+	c.blocks[blockCid] = c.lru.PushBack(&cachedBlock{
+		spaceID: spaceID,
+		cid:     blockCid,
+		size:    size,
+		fileIDs: map[string]struct{}{fileID: {}},
+	})
+	c.size += size
+}
+
+func (c *localCache) remove(blockCid cid.Cid) {
+	c.Lock()
+	defer c.Unlock()
+	if el, ok := c.blocks[blockCid]; ok {
+		c.size -= el.Value.(*cachedBlock).size
+		c.lru.Remove(el)
+		delete(c.blocks, blockCid)
+	}
+}
+
+// evictionCandidates returns not pinned blocks starting from the least recently accessed one
+// and the number of bytes exceeding the limit
+func (c *localCache) evictionCandidates() ([]cachedBlock, uint64) {
+	c.Lock()
+	defer c.Unlock()
+	if c.limit == 0 || c.size <= c.limit {
+		return nil, 0
+	}
+	var candidates []cachedBlock
+	for el := c.lru.Front(); el != nil; el = el.Next() {
+		b := el.Value.(*cachedBlock)
+		if !c.isPinned(b) {
+			candidates = append(candidates, *b)
+		}
+	}
+	return candidates, c.size - c.limit
+}
+
+func (c *localCache) isPinned(b *cachedBlock) bool {
+	for fileID := range b.fileIDs {
+		if _, ok := c.pinned[fileID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetLocalCacheLimit sets the maximum size of file blocks stored locally. Zero limit disables eviction
+func (f *fileSync) SetLocalCacheLimit(limit uint64) {
+	f.cache.setLimit(limit)
+}
+
+// PinFile exempts blocks of the file from eviction from local cache
+func (f *fileSync) PinFile(fileID string) {
+	f.cache.pin(fileID)
+}
+
+func (f *fileSync) UnpinFile(fileID string) {
+	f.cache.unpin(fileID)
+}
+
+// TouchFile marks locally stored blocks of the file as recently accessed and evicts least recently accessed blocks
+// if the local cache exceeds the limit. The access time is saved, so the order of eviction survives restarts
+func (f *fileSync) TouchFile(ctx context.Context, spaceID, fileID string) error {
+	if err := f.queue.setFileAccessTime(spaceID, fileID, time.Now()); err != nil {
+		return fmt.Errorf("save access time: %w", err)
+	}
+	if !f.cache.isLimited() {
+		return nil
+	}
+	if err := f.touchFileBlocks(ctx, spaceID, fileID); err != nil {
+		return err
+	}
+	return f.evictFromLocalCache(ctx)
+}
+
+func (f *fileSync) touchFileBlocks(ctx context.Context, spaceID, fileID string) error {
+	fileCid, err := cid.Parse(fileID)
+	if err != nil {
+		return fmt.Errorf("parse CID %s: %w", fileID, err)
+	}
+	ctx = context.WithValue(ctx, filestorage.CtxKeyRemoteLoadDisabled, true)
+	dagService := f.dagServiceForSpace(spaceID)
+	visited := map[cid.Cid]struct{}{}
+	var visit func(c cid.Cid)
+	visit = func(c cid.Cid) {
+		if _, ok := visited[c]; ok {
+			return
+		}
+		visited[c] = struct{}{}
+		node, err := dagService.Get(ctx, c)
+		if err != nil {
+			// block is not downloaded yet
+			return
+		}
+		f.cache.touch(spaceID, fileID, c, uint64(len(node.RawData())))
+		for _, link := range node.Links() {
+			visit(link.Cid)
+		}
+	}
+	visit(fileCid)
+	return nil
+}
+
+// restoreLocalCache fills the local cache with blocks of previously accessed files in order of access
+// and evicts blocks exceeding the limit
+func (f *fileSync) restoreLocalCache(ctx context.Context) error {
+	if !f.cache.isLimited() {
+		return nil
+	}
+	accesses, err := f.queue.listFileAccesses()
+	if err != nil {
+		return fmt.Errorf("list accessed files: %w", err)
+	}
+	for _, access := range accesses {
+		if err = f.touchFileBlocks(ctx, access.SpaceId, access.FileId); err != nil {
+			log.Warn("can't restore file in local cache", zap.String("fileID", access.FileId), zap.Error(err))
+		}
+	}
+	return f.evictFromLocalCache(ctx)
+}
+
+// evictFromLocalCache removes least recently accessed blocks, that are confirmed to be present in the remote space,
+// until the size of local cache fits into the limit
+func (f *fileSync) evictFromLocalCache(ctx context.Context) error {
+	candidates, bytesToEvict := f.cache.evictionCandidates()
+	if len(candidates) == 0 {
+		return nil
+	}
+	cidsBySpace := map[string][]cid.Cid{}
+	for _, b := range candidates {
+		cidsBySpace[b.spaceID] = append(cidsBySpace[b.spaceID], b.cid)
+	}
+	uploaded := map[cid.Cid]struct{}{}
+	for spaceID, cids := range cidsBySpace {
+		availabilities, err := f.rpcStore.CheckAvailability(ctx, spaceID, cids)
+		if err != nil {
+			return fmt.Errorf("check availability: %w", err)
+		}
+		for _, availability := range availabilities {
+			if availability.Status != fileproto.AvailabilityStatus_ExistsInSpace {
+				continue
+			}
+			blockCid, err := cid.Cast(availability.Cid)
+			if err != nil {
+				return fmt.Errorf("cast cid: %w", err)
+			}
+			uploaded[blockCid] = struct{}{}
+		}
+	}
+
+	var evicted uint64
+	for _, b := range candidates {
+		if evicted >= bytesToEvict {
+			break
+		}
+		if _, ok := uploaded[b.cid]; !ok {
+			continue
+		}
+		if err := f.dagServiceForSpace(b.spaceID).Remove(ctx, b.cid); err != nil {
+			log.Warn("can't evict block from local cache", zap.String("cid", b.cid.String()), zap.Error(err))
+			continue
+		}
+		f.cache.remove(b.cid)
+		evicted += b.size
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package filesync
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileSync_TouchFile(t *testing.T) {
+	t.Run("least recently accessed blocks are evicted, pinned ones remain", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		spaceId := "space1"
+		pinned, first, second := fx.addRandomFile(t), fx.addRandomFile(t), fx.addRandomFile(t)
+		fileSize := fx.fileSize(t, pinned)
+
+		fx.expectAvailability(spaceId, fileproto.AvailabilityStatus_ExistsInSpace)
+		fx.SetLocalCacheLimit(2*fileSize + fileSize/2)
+		fx.PinFile(pinned.Cid().String())
+
+		// when
+		for _, n := range []ipld.Node{pinned, first, second} {
+			require.NoError(t, fx.TouchFile(ctx, spaceId, n.Cid().String()))
+		}
+
+		// then
+		_, err := fx.fileService.DAGService().Get(ctx, pinned.Cid())
+		assert.NoError(t, err)
+		_, err = fx.fileService.DAGService().Get(ctx, first.Cid())
+		assert.Error(t, err)
+		_, err = fx.fileService.DAGService().Get(ctx, second.Cid())
+		assert.NoError(t, err)
+	})
+	t.Run("blocks that are not uploaded are not evicted", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		spaceId := "space1"
+		first, second := fx.addRandomFile(t), fx.addRandomFile(t)
+		fileSize := fx.fileSize(t, first)
+
+		fx.expectAvailability(spaceId, fileproto.AvailabilityStatus_NotExists)
+		fx.SetLocalCacheLimit(fileSize)
+
+		// when
+		for _, n := range []ipld.Node{first, second} {
+			require.NoError(t, fx.TouchFile(ctx, spaceId, n.Cid().String()))
+		}
+
+		// then
+		for _, n := range []ipld.Node{first, second} {
+			_, err := fx.fileService.DAGService().Get(ctx, n.Cid())
+			assert.NoError(t, err)
+		}
+	})
+}
+
+func TestFileSync_RestoreLocalCache(t *testing.T) {
+	t.Run("blocks are evicted in order of saved access times", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		spaceId := "space1"
+		first, second := fx.addRandomFile(t), fx.addRandomFile(t)
+		fileSize := fx.fileSize(t, first)
+		fx.expectAvailability(spaceId, fileproto.AvailabilityStatus_ExistsInSpace)
+		fs := fx.FileSync.(*fileSync)
+		require.NoError(t, fs.queue.setFileAccessTime(spaceId, second.Cid().String(), time.Now()))
+		require.NoError(t, fs.queue.setFileAccessTime(spaceId, first.Cid().String(), time.Now().Add(-time.Minute)))
+		fx.SetLocalCacheLimit(fileSize + fileSize/2)
+
+		// when
+		err := fs.restoreLocalCache(ctx)
+
+		// then
+		require.NoError(t, err)
+		_, err = fx.fileService.DAGService().Get(ctx, first.Cid())
+		assert.Error(t, err)
+		_, err = fx.fileService.DAGService().Get(ctx, second.Cid())
+		assert.NoError(t, err)
+	})
+	t.Run("removed file is forgotten", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		fs := fx.FileSync.(*fileSync)
+		require.NoError(t, fs.queue.setFileAccessTime("space1", "file1", time.Now()))
+
+		// when
+		require.NoError(t, fs.queue.DoneRemove("space1", "file1"))
+
+		// then
+		accesses, err := fs.queue.listFileAccesses()
+		require.NoError(t, err)
+		assert.Empty(t, accesses)
+	})
+}
+
+func (f *fixture) expectAvailability(spaceId string, status fileproto.AvailabilityStatus) {
+	f.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+		return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+			return &fileproto.BlockAvailability{
+				Cid:    c.Bytes(),
+				Status: status,
+			}
+		}), nil
+	}).AnyTimes()
+}
+
+func (f *fixture) addRandomFile(t *testing.T) ipld.Node {
+	var buf = make([]byte, 1024)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	n, err := f.fileService.AddFile(ctx, bytes.NewReader(buf))
+	require.NoError(t, err)
+	return n
+}
+
+func (f *fixture) fileSize(t *testing.T, n ipld.Node) uint64 {
+	size := uint64(len(n.RawData()))
+	for _, link := range n.Links() {
+		child, err := f.fileService.DAGService().Get(ctx, link.Cid)
+		require.NoError(t, err)
+		size += f.fileSize(t, child)
+	}
+	return size
+}
@@ -14,6 +14,8 @@ import (
 	ipld "github.com/ipfs/go-ipld-format"
 	"go.uber.org/zap"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/files/filehelper"
 	"github.com/anyproto/anytype-heart/core/filestorage/rpcstore"
@@ -38,12 +40,28 @@ type FileSync interface {
 	FileStat(ctx context.Context, spaceId, fileId string) (fs FileStat, err error)
 	FileListStats(ctx context.Context, spaceId string, fileIDs []string) ([]FileStat, error)
 	SyncStatus() (ss SyncStatus, err error)
+	FileStatus(spaceId, fileId string) (FileSyncStatus, error)
+	FileStatuses(spaceId string) ([]FileSyncStatus, error)
 	HasUpload(spaceId, fileId string) (ok bool, err error)
 	IsFileUploadLimited(spaceId, fileId string) (ok bool, err error)
 	DebugQueue(*http.Request) (*QueueInfo, error)
 	SendImportEvents()
 	ClearImportEvents()
 	CalculateFileSize(ctx context.Context, spaceId string, fileID string) (int, error)
+	SetLocalCacheLimit(limit uint64)
+	PinFile(fileID string)
+	UnpinFile(fileID string)
+	TouchFile(ctx context.Context, spaceID, fileID string) error
+	FindLocalOnly(spaceId string) ([]string, error)
+	ReconcileSpace(spaceId string) ([]string, error)
+	SetUploadWindow(window *UploadWindow) error
+	SetBandwidthLimit(spaceId string, limit BandwidthLimit)
+	BandwidthLimit(spaceId string) BandwidthLimit
+	Prioritize(spaceId, fileId string) error
+	ExportQueue() ([]byte, error)
+	ImportQueue(data []byte) error
+	VerifySpace(ctx context.Context, spaceId string, progress VerifyProgress) (VerifyReport, error)
+	RetryFailedUploads() (int, error)
 	app.ComponentRunnable
 }
 
@@ -51,6 +69,8 @@ type QueueInfo struct {
 	UploadingQueue []*QueueItem
 	DiscardedQueue []*QueueItem
 	RemovingQueue  []*QueueItem
+	// FailedQueue holds files, which upload has been given up after repeated failures
+	FailedQueue []*QueueItem
 }
 
 type personalSpaceIDGetter interface {
@@ -59,6 +79,10 @@ type personalSpaceIDGetter interface {
 
 type SyncStatus struct {
 	QueueLen int
+	// Scheduled is true when queued files wait for the upload window
+	Scheduled bool
+	// FailedLen is the number of files, which upload has been given up after repeated failures
+	FailedLen int
 }
 
 type fileSync struct {
@@ -74,16 +98,28 @@ type fileSync struct {
 	eventSender      event.Sender
 	onUpload         func(spaceID, fileID string) error
 	personalIDGetter personalSpaceIDGetter
+	spaceIdResolver  idresolver.Resolver
+	// readOnly disables upload and removal of files, when account is opened in read-only mode
+	readOnly bool
 
 	spaceStatsLock    sync.Mutex
 	spaceStats        map[string]SpaceStat
 	importEventsMutex sync.Mutex
 	importEvents      []*pb.Event
+
+	cache     *localCache
+	schedule  *uploadSchedule
+	bandwidth *bandwidthLimits
+	statuses  *uploadStatuses
 }
 
 func New() FileSync {
 	return &fileSync{
 		spaceStats: map[string]SpaceStat{},
+		cache:      newLocalCache(),
+		schedule:   newUploadSchedule(),
+		bandwidth:  newBandwidthLimits(),
+		statuses:   newUploadStatuses(),
 	}
 }
 
@@ -93,7 +129,16 @@ func (f *fileSync) Init(a *app.App) (err error) {
 	f.dagService = a.MustComponent(fileservice.CName).(fileservice.FileService).DAGService()
 	f.fileStore = app.MustComponent[filestore.FileStore](a)
 	f.personalIDGetter = app.MustComponent[personalSpaceIDGetter](a)
+	f.spaceIdResolver = app.MustComponent[idresolver.Resolver](a)
 	f.eventSender = app.MustComponent[event.Sender](a)
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		fileCfg, err := cfg.FSConfig()
+		if err != nil {
+			return fmt.Errorf("get file config: %w", err)
+		}
+		f.cache.setLimit(fileCfg.LocalCacheLimit)
+		f.readOnly = cfg.ReadOnly
+	}
 	f.removePingCh = make(chan struct{})
 	f.uploadPingCh = make(chan struct{})
 	return
@@ -120,12 +165,20 @@ func (f *fileSync) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return
 	}
+	if f.readOnly {
+		return
+	}
 
 	go f.precacheSpaceStats()
 
 	f.loopCtx, f.loopCancel = context.WithCancel(context.Background())
 	go f.addLoop()
 	go f.removeLoop()
+	go func() {
+		if err := f.restoreLocalCache(f.loopCtx); err != nil {
+			log.Error("can't restore local cache", zap.Error(err))
+		}
+	}()
 	return
 }
 
@@ -161,7 +214,13 @@ func (f *fileSync) SyncStatus() (ss SyncStatus, err error) {
 	if err != nil {
 		return
 	}
+	fl, err := f.queue.FailedLen()
+	if err != nil {
+		return
+	}
 	return SyncStatus{
-		QueueLen: ql,
+		QueueLen:  ql,
+		Scheduled: f.isUploadScheduled(ql),
+		FailedLen: fl,
 	}, nil
 }
@@ -15,8 +15,10 @@ import (
 	"github.com/anyproto/any-sync/commonfile/fileproto"
 	"github.com/anyproto/any-sync/commonfile/fileservice"
 	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	blocks "github.com/ipfs/go-block-format"
 	"github.com/ipfs/go-cid"
 	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -66,6 +68,55 @@ func TestFileSync_AddFile(t *testing.T) {
 	fx.waitEmptyQueue(t, time.Second*5)
 }
 
+func TestFileSync_ResumeUpload(t *testing.T) {
+	// given
+	fx := newFixture(t)
+	defer fx.Finish(t)
+	var buf = make([]byte, 12*fileservice.ChunkSize)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	n, err := fx.fileService.AddFile(ctx, bytes.NewReader(buf))
+	require.NoError(t, err)
+	fileId := n.Cid().String()
+	spaceId := "space2"
+
+	fx.fileStoreMock.EXPECT().GetFileSize(fileId).Return(0, fmt.Errorf("not found")).AnyTimes()
+	fx.fileStoreMock.EXPECT().SetFileSize(fileId, gomock.Any()).Return(nil).AnyTimes()
+	fx.rpcStore.EXPECT().SpaceInfo(gomock.Any(), spaceId).Return(&fileproto.SpaceInfoResponse{LimitBytes: 100 * 1024 * 1024}, nil).AnyTimes()
+	fx.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+		return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+			return &fileproto.BlockAvailability{
+				Cid:    c.Bytes(),
+				Status: fileproto.AvailabilityStatus_NotExists,
+			}
+		}), nil
+	}).AnyTimes()
+	var uploads [][]cid.Cid
+	fx.rpcStore.EXPECT().AddToFile(gomock.Any(), spaceId, fileId, gomock.Any()).DoAndReturn(func(_ context.Context, _, _ string, bs []blocks.Block) error {
+		if len(uploads) == 1 {
+			uploads = append(uploads, nil)
+			return fmt.Errorf("connection lost")
+		}
+		uploads = append(uploads, lo.Map(bs, func(b blocks.Block, _ int) cid.Cid {
+			return b.Cid()
+		}))
+		return nil
+	}).Times(3)
+	fs := fx.FileSync.(*fileSync)
+
+	// when
+	firstErr := fs.uploadFile(ctx, spaceId, fileId)
+	secondErr := fs.uploadFile(ctx, spaceId, fileId)
+
+	// then
+	require.Error(t, firstErr)
+	require.NoError(t, secondErr)
+	require.Len(t, uploads, 3)
+	assert.Len(t, uploads[0], batchSize)
+	assert.Len(t, uploads[2], 3)
+	assert.Empty(t, lo.Intersect(uploads[0], uploads[2]))
+}
+
 func TestFileSync_RemoveFile(t *testing.T) {
 	t.Skip("https://linear.app/anytype/issue/GO-1229/fix-testfilesync-removefile")
 	return
@@ -88,6 +139,16 @@ func (s *personalSpaceIdStub) PersonalSpaceID() string {
 	return s.personalSpaceId
 }
 
+type spaceResolverStub struct {
+	spaceId string
+}
+
+func (s *spaceResolverStub) Name() string          { return "spaceResolverStub" }
+func (s *spaceResolverStub) Init(a *app.App) error { return nil }
+func (s *spaceResolverStub) ResolveSpaceID(objectID string) (string, error) {
+	return s.spaceId, nil
+}
+
 func newFixture(t *testing.T) *fixture {
 	fx := &fixture{
 		FileSync:    New(),
@@ -112,6 +173,7 @@ func newFixture(t *testing.T) *fixture {
 	fx.fileStoreMock = fileStoreMock
 
 	personalSpaceIdGetter := &personalSpaceIdStub{personalSpaceId: "space1"}
+	spaceIdResolver := &spaceResolverStub{spaceId: "space1"}
 
 	sender := mock_event.NewMockSender(t)
 	sender.EXPECT().Name().Return("event")
@@ -125,6 +187,7 @@ func newFixture(t *testing.T) *fixture {
 		Register(fx.FileSync).
 		Register(fileStoreMock).
 		Register(personalSpaceIdGetter).
+		Register(spaceIdResolver).
 		Register(sender)
 	require.NoError(t, fx.a.Start(ctx))
 	return fx
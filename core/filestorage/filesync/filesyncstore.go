@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -27,7 +28,9 @@ var (
 	uploadKeyPrefix       = []byte(keyPrefix + "queue/upload/")
 	removeKeyPrefix       = []byte(keyPrefix + "queue/remove/")
 	discardedKeyPrefix    = []byte(keyPrefix + "queue/discarded/")
+	failedKeyPrefix       = []byte(keyPrefix + "queue/failed/")
 	queueSchemaVersionKey = []byte(keyPrefix + "queue/schema_version")
+	cacheAccessKeyPrefix  = []byte(keyPrefix + "cache/access/")
 )
 
 type fileSyncStore struct {
@@ -51,9 +54,21 @@ type QueueItem struct {
 	Timestamp   int64
 	AddedByUser bool
 	Imported    bool
+	// Priority of the item, items with higher priority are processed first
+	Priority int
+	// Attempts is the number of failed upload attempts
+	Attempts int
+	// RetryAt is the time in milliseconds, before which the item is not taken from the queue
+	RetryAt int64
 }
 
+// userPriority is the priority of files, which are requested by user, e.g. opened
+const userPriority = 1
+
 func (it *QueueItem) less(other *QueueItem) bool {
+	if it.Priority != other.Priority {
+		return it.Priority > other.Priority
+	}
 	return it.Timestamp < other.Timestamp
 }
 
@@ -158,16 +173,24 @@ func (s *fileSyncStore) QueueUpload(spaceID string, fileID string, addedByUser b
 			logger.Info("add file to upload queue: file is in discarded queue")
 			return nil
 		}
+		if err = txn.Delete(failedKey(spaceID, fileID)); err != nil {
+			return fmt.Errorf("remove from failed queue: %w", err)
+		}
 		ok, err = isKeyExists(txn, uploadKey(spaceID, fileID))
 		if err != nil {
 			return fmt.Errorf("check upload key: %w", err)
 		}
+		var priority int
 		if ok {
 			logger.Info("add file to upload queue: file is already in queue, update timestamp")
+			priority, err = getQueuedPriority(txn, uploadKey(spaceID, fileID))
+			if err != nil {
+				return fmt.Errorf("get priority: %w", err)
+			}
 		} else {
 			logger.Info("add file to upload queue")
 		}
-		raw, err := createQueueItem(addedByUser, imported)
+		raw, err := createQueueItem(addedByUser, imported, priority)
 		if err != nil {
 			return fmt.Errorf("create queue item: %w", err)
 		}
@@ -175,12 +198,55 @@ func (s *fileSyncStore) QueueUpload(spaceID string, fileID string, addedByUser b
 	})
 }
 
-func createQueueItem(addedByUser bool, imported bool) ([]byte, error) {
+func createQueueItem(addedByUser bool, imported bool, priority int) ([]byte, error) {
 	return json.Marshal(QueueItem{
 		Timestamp:   time.Now().UnixMilli(),
 		AddedByUser: addedByUser,
 		Imported:    imported,
+		Priority:    priority,
+	})
+}
+
+func getQueuedPriority(txn *badger.Txn, key []byte) (int, error) {
+	item, err := txn.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	it, err := getQueueItem(item)
+	if err != nil {
+		return 0, err
+	}
+	return it.Priority, nil
+}
+
+// SetUploadPriority changes the priority of the file in upload queue keeping its position among files
+// with the same priority. It returns false, if the file is not queued for upload
+func (s *fileSyncStore) SetUploadPriority(spaceId, fileId string, priority int) (ok bool, err error) {
+	err = s.updateTxn(func(txn *badger.Txn) error {
+		ok = false
+		item, err := txn.Get(uploadKey(spaceId, fileId))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		it, err := getQueueItem(item)
+		if err != nil {
+			return fmt.Errorf("get queue item: %w", err)
+		}
+		ok = true
+		if it.Priority == priority {
+			return nil
+		}
+		it.Priority = priority
+		raw, err := json.Marshal(it)
+		if err != nil {
+			return fmt.Errorf("marshal queue item: %w", err)
+		}
+		return txn.Set(uploadKey(spaceId, fileId), raw)
 	})
+	return
 }
 
 func (s *fileSyncStore) QueueDiscarded(spaceId, fileId string) (err error) {
@@ -188,7 +254,7 @@ func (s *fileSyncStore) QueueDiscarded(spaceId, fileId string) (err error) {
 		if err = txn.Delete(uploadKey(spaceId, fileId)); err != nil {
 			return err
 		}
-		raw, err := createQueueItem(false, false)
+		raw, err := createQueueItem(false, false, 0)
 		if err != nil {
 			return fmt.Errorf("create queue item: %w", err)
 		}
@@ -196,12 +262,83 @@ func (s *fileSyncStore) QueueDiscarded(spaceId, fileId string) (err error) {
 	})
 }
 
+// QueueRetry puts the item, which upload has failed, to the back of the upload queue or the discarded queue.
+// The item is not taken from the queue until its RetryAt time
+func (s *fileSyncStore) QueueRetry(it *QueueItem, discarded bool) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		key, staleKey := uploadKey(it.SpaceID, it.FileID), discardedKey(it.SpaceID, it.FileID)
+		if discarded {
+			key, staleKey = staleKey, key
+		}
+		if err := txn.Delete(staleKey); err != nil {
+			return err
+		}
+		return setRetryItem(txn, key, it, time.Now().UnixMilli())
+	})
+}
+
+// QueueFailed moves the item to the failed queue, which files are not uploaded until they are queued again
+func (s *fileSyncStore) QueueFailed(it *QueueItem) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		if err := txn.Delete(uploadKey(it.SpaceID, it.FileID)); err != nil {
+			return err
+		}
+		if err := txn.Delete(discardedKey(it.SpaceID, it.FileID)); err != nil {
+			return err
+		}
+		return setRetryItem(txn, failedKey(it.SpaceID, it.FileID), it, it.Timestamp)
+	})
+}
+
+func setRetryItem(txn *badger.Txn, key []byte, it *QueueItem, timestamp int64) error {
+	raw, err := json.Marshal(QueueItem{
+		Timestamp:   timestamp,
+		AddedByUser: it.AddedByUser,
+		Imported:    it.Imported,
+		Priority:    it.Priority,
+		Attempts:    it.Attempts,
+		RetryAt:     it.RetryAt,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal queue item: %w", err)
+	}
+	return txn.Set(key, raw)
+}
+
+// RequeueFailed moves all items of the failed queue back to the upload queue with reset attempts.
+// It returns the number of requeued items
+func (s *fileSyncStore) RequeueFailed() (requeued int, err error) {
+	items, err := s.listItemsByPrefix(failedKeyPrefix)
+	if err != nil {
+		return 0, fmt.Errorf("list failed items: %w", err)
+	}
+	err = s.updateTxn(func(txn *badger.Txn) error {
+		for _, it := range items {
+			if err := txn.Delete(failedKey(it.SpaceID, it.FileID)); err != nil {
+				return err
+			}
+			raw, err := createQueueItem(it.AddedByUser, it.Imported, it.Priority)
+			if err != nil {
+				return fmt.Errorf("create queue item: %w", err)
+			}
+			if err = txn.Set(uploadKey(it.SpaceID, it.FileID), raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
 func (s *fileSyncStore) QueueRemove(spaceId, fileId string) (err error) {
 	return s.updateTxn(func(txn *badger.Txn) error {
 		if err = removeFromUploadingQueue(txn, spaceId, fileId); err != nil {
 			return err
 		}
-		raw, err := createQueueItem(false, false)
+		raw, err := createQueueItem(false, false, 0)
 		if err != nil {
 			return fmt.Errorf("create queue item: %w", err)
 		}
@@ -225,9 +362,102 @@ func removeFromUploadingQueue(txn *badger.Txn, spaceID string, fileID string) er
 	if err := txn.Delete(discardedKey(spaceID, fileID)); err != nil {
 		return fmt.Errorf("remove from discarded uploading queue: %w", err)
 	}
+	if err := txn.Delete(failedKey(spaceID, fileID)); err != nil {
+		return fmt.Errorf("remove from failed uploading queue: %w", err)
+	}
+	if err := txn.Delete(uploadProgressKey(spaceID, fileID)); err != nil {
+		return fmt.Errorf("remove upload progress: %w", err)
+	}
 	return nil
 }
 
+// uploadProgress is the number of blocks of the file in order of DAG walk, which are confirmed by the node.
+// LastCid is the last confirmed block, it's used to check that the walk gives the same blocks on resume
+type uploadProgress struct {
+	Blocks  int
+	LastCid string
+	// Bytes is the size of confirmed blocks
+	Bytes       int
+	TotalBlocks int
+}
+
+// getUploadProgress returns saved progress of the file upload or empty progress, if the upload hasn't been started
+func (s *fileSyncStore) getUploadProgress(spaceId, fileId string) (progress uploadProgress, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(uploadProgressKey(spaceId, fileId))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return item.Value(func(raw []byte) error {
+			return json.Unmarshal(raw, &progress)
+		})
+	})
+	return
+}
+
+func (s *fileSyncStore) setUploadProgress(spaceId, fileId string, progress uploadProgress) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		raw, err := json.Marshal(progress)
+		if err != nil {
+			return fmt.Errorf("marshal upload progress: %w", err)
+		}
+		return txn.Set(uploadProgressKey(spaceId, fileId), raw)
+	})
+}
+
+func (s *fileSyncStore) resetUploadProgress(spaceId, fileId string) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		return txn.Delete(uploadProgressKey(spaceId, fileId))
+	})
+}
+
+// fileAccess is the time of the last access to the file, it's used to restore the order of local cache eviction
+type fileAccess struct {
+	SpaceId   string
+	FileId    string
+	Timestamp uint64
+}
+
+func (s *fileSyncStore) setFileAccessTime(spaceId, fileId string, accessedAt time.Time) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		return txn.Set(cacheAccessKey(spaceId, fileId), binTime(accessedAt.UnixMilli()))
+	})
+}
+
+// listFileAccesses returns accessed files starting from the least recently accessed one
+func (s *fileSyncStore) listFileAccesses() ([]fileAccess, error) {
+	var accesses []fileAccess
+	err := s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchSize:   100,
+			PrefetchValues: true,
+			Prefix:         cacheAccessKeyPrefix,
+		})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			ts, err := getTimestamp(item)
+			if err != nil {
+				return fmt.Errorf("get access time %s: %w", item.Key(), err)
+			}
+			fileId, spaceId := extractFileAndSpaceID(item)
+			accesses = append(accesses, fileAccess{SpaceId: spaceId, FileId: fileId, Timestamp: ts})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(accesses, func(i, j int) bool {
+		return accesses[i].Timestamp < accesses[j].Timestamp
+	})
+	return accesses, nil
+}
+
 func (s *fileSyncStore) DoneRemove(spaceId, fileId string) (err error) {
 	return s.updateTxn(func(txn *badger.Txn) error {
 		if err = txn.Delete(removeKey(spaceId, fileId)); err != nil {
@@ -236,6 +466,9 @@ func (s *fileSyncStore) DoneRemove(spaceId, fileId string) (err error) {
 		if err = txn.Delete(doneUploadKey(spaceId, fileId)); err != nil {
 			return err
 		}
+		if err = txn.Delete(cacheAccessKey(spaceId, fileId)); err != nil {
+			return err
+		}
 		return txn.Set(doneRemoveKey(spaceId, fileId), binTime(time.Now().UnixMilli()))
 	})
 }
@@ -286,6 +519,27 @@ func (s *fileSyncStore) HasUpload(spaceId, fileId string) (ok bool, err error) {
 	return
 }
 
+// GetUploadItem returns the item of upload queue for given file or nil, if the file is not queued for upload
+func (s *fileSyncStore) GetUploadItem(spaceId, fileId string) (it *QueueItem, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(uploadKey(spaceId, fileId))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		it, err = getQueueItem(item)
+		if err != nil {
+			return fmt.Errorf("get queue item: %w", err)
+		}
+		it.SpaceID = spaceId
+		it.FileID = fileId
+		return nil
+	})
+	return
+}
+
 func (s *fileSyncStore) IsFileUploadLimited(spaceId, fileId string) (ok bool, err error) {
 	err = s.db.View(func(txn *badger.Txn) error {
 		ok, err = isKeyExists(txn, discardedKey(spaceId, fileId))
@@ -294,12 +548,89 @@ func (s *fileSyncStore) IsFileUploadLimited(spaceId, fileId string) (ok bool, er
 	return
 }
 
+// importQueue adds items to the queues keeping their timestamps. Files, which are already uploaded, removed or queued,
+// are skipped. It returns the number of added items
+func (s *fileSyncStore) importQueue(info *QueueInfo) (added int, err error) {
+	uploadSkipKeys := []func(spaceId, fileId string) []byte{uploadKey, discardedKey, failedKey, removeKey, doneUploadKey}
+	err = s.updateTxn(func(txn *badger.Txn) error {
+		added = 0
+		removed, err := importQueueItems(txn, info.RemovingQueue, removeKey, removeKey, doneRemoveKey)
+		if err != nil {
+			return fmt.Errorf("import removing queue: %w", err)
+		}
+		for _, it := range removed {
+			if err = removeFromUploadingQueue(txn, it.SpaceID, it.FileID); err != nil {
+				return err
+			}
+		}
+		uploading, err := importQueueItems(txn, info.UploadingQueue, uploadKey, uploadSkipKeys...)
+		if err != nil {
+			return fmt.Errorf("import uploading queue: %w", err)
+		}
+		discarded, err := importQueueItems(txn, info.DiscardedQueue, discardedKey, uploadSkipKeys...)
+		if err != nil {
+			return fmt.Errorf("import discarded queue: %w", err)
+		}
+		failed, err := importQueueItems(txn, info.FailedQueue, failedKey, uploadSkipKeys...)
+		if err != nil {
+			return fmt.Errorf("import failed queue: %w", err)
+		}
+		added = len(removed) + len(uploading) + len(discarded) + len(failed)
+		return nil
+	})
+	return
+}
+
+// importQueueItems puts items by keys made with queueKey, unless the file has any of skipKeys. It returns added items
+func importQueueItems(txn *badger.Txn,
+	items []*QueueItem,
+	queueKey func(spaceId, fileId string) []byte,
+	skipKeys ...func(spaceId, fileId string) []byte,
+) ([]*QueueItem, error) {
+	var added []*QueueItem
+	for _, it := range items {
+		ok, err := isAnyKeyExists(txn, it.SpaceID, it.FileID, skipKeys)
+		if err != nil {
+			return nil, fmt.Errorf("check existing keys: %w", err)
+		}
+		if ok {
+			continue
+		}
+		raw, err := json.Marshal(QueueItem{
+			Timestamp:   it.Timestamp,
+			AddedByUser: it.AddedByUser,
+			Imported:    it.Imported,
+			Priority:    it.Priority,
+			Attempts:    it.Attempts,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marshal queue item: %w", err)
+		}
+		if err = txn.Set(queueKey(it.SpaceID, it.FileID), raw); err != nil {
+			return nil, err
+		}
+		added = append(added, it)
+	}
+	return added, nil
+}
+
+func isAnyKeyExists(txn *badger.Txn, spaceId, fileId string, keys []func(spaceId, fileId string) []byte) (bool, error) {
+	for _, key := range keys {
+		ok, err := isKeyExists(txn, key(spaceId, fileId))
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
 func (s *fileSyncStore) GetRemove() (it *QueueItem, err error) {
 	return s.getOne(removeKeyPrefix)
 }
 
-// getOne returns the oldest key from the queue with given prefix
+// getOne returns the oldest key from the queue with given prefix. Items postponed for retry are skipped
 func (s *fileSyncStore) getOne(prefix []byte) (earliest *QueueItem, err error) {
+	now := time.Now().UnixMilli()
 	err = s.db.View(func(txn *badger.Txn) error {
 		it := txn.NewIterator(badger.IteratorOptions{
 			PrefetchSize:   100,
@@ -314,6 +645,9 @@ func (s *fileSyncStore) getOne(prefix []byte) (earliest *QueueItem, err error) {
 			if err != nil {
 				return fmt.Errorf("get queue item %s: %w", item.Key(), err)
 			}
+			if qItem.RetryAt > now {
+				continue
+			}
 			if earliest == nil || qItem.less(earliest) {
 				earliest = qItem
 				fileId, spaceId := extractFileAndSpaceID(item)
@@ -389,6 +723,31 @@ func (s *fileSyncStore) QueueLen() (l int, err error) {
 	return
 }
 
+// FailedLen returns the number of files, which upload has been given up
+func (s *fileSyncStore) FailedLen() (l int, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchSize:   100,
+			PrefetchValues: false,
+			Prefix:         failedKeyPrefix,
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			l++
+		}
+		return nil
+	})
+	return
+}
+
+func (s *fileSyncStore) IsUploadFailed(spaceId, fileId string) (ok bool, err error) {
+	err = s.db.View(func(txn *badger.Txn) error {
+		ok, err = isKeyExists(txn, failedKey(spaceId, fileId))
+		return err
+	})
+	return
+}
+
 func (s *fileSyncStore) IsAlreadyUploaded(spaceId, fileId string) (done bool, err error) {
 	err = s.db.View(func(txn *badger.Txn) error {
 		_, e := txn.Get(doneUploadKey(spaceId, fileId))
@@ -438,10 +797,22 @@ func discardedKey(spaceId, fileId string) (key []byte) {
 	return []byte(keyPrefix + "queue/discarded/" + spaceId + "/" + fileId)
 }
 
+func failedKey(spaceId, fileId string) (key []byte) {
+	return []byte(keyPrefix + "queue/failed/" + spaceId + "/" + fileId)
+}
+
 func removeKey(spaceId, fileId string) (key []byte) {
 	return []byte(keyPrefix + "queue/remove/" + spaceId + "/" + fileId)
 }
 
+func uploadProgressKey(spaceId, fileId string) (key []byte) {
+	return []byte(keyPrefix + "progress/upload/" + spaceId + "/" + fileId)
+}
+
+func cacheAccessKey(spaceId, fileId string) (key []byte) {
+	return []byte(keyPrefix + "cache/access/" + spaceId + "/" + fileId)
+}
+
 func doneUploadKey(spaceId, fileId string) (key []byte) {
 	return []byte(keyPrefix + "done/upload/" + spaceId + "/" + fileId)
 }
@@ -109,6 +109,34 @@ func TestFileSyncStore_CheckSorting(t *testing.T) {
 	assert.False(t, it.AddedByUser)
 }
 
+func TestFileSyncStore_SetUploadPriority(t *testing.T) {
+	fx := newStoreFixture(t)
+	defer fx.Finish()
+
+	require.NoError(t, fx.QueueUpload("spaceId1", "fileId1", false, false))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, fx.QueueUpload("spaceId1", "fileId2", false, false))
+	ok, err := fx.SetUploadPriority("spaceId1", "fileId2", userPriority)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	it, err := fx.GetUpload()
+	require.NoError(t, err)
+	assert.Equal(t, "fileId2", it.FileID)
+	assert.Equal(t, userPriority, it.Priority)
+
+	// priority is kept, when the file is pushed back to the queue
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, fx.QueueUpload("spaceId1", "fileId2", false, false))
+	it, err = fx.GetUpload()
+	require.NoError(t, err)
+	assert.Equal(t, "fileId2", it.FileID)
+
+	ok, err = fx.SetUploadPriority("spaceId1", "fileId3", userPriority)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
 func TestFileSyncStore_IsDone(t *testing.T) {
 	fx := newStoreFixture(t)
 	defer fx.Finish()
@@ -122,6 +150,25 @@ func TestFileSyncStore_IsDone(t *testing.T) {
 	assert.True(t, done)
 }
 
+func TestFileSyncStore_UploadProgress(t *testing.T) {
+	fx := newStoreFixture(t)
+	defer fx.Finish()
+	require.NoError(t, fx.QueueUpload("spaceId1", "fileId1", false, false))
+	progress, err := fx.getUploadProgress("spaceId1", "fileId1")
+	require.NoError(t, err)
+	assert.Equal(t, uploadProgress{}, progress)
+
+	require.NoError(t, fx.setUploadProgress("spaceId1", "fileId1", uploadProgress{Blocks: 10, LastCid: "cid10"}))
+	progress, err = fx.getUploadProgress("spaceId1", "fileId1")
+	require.NoError(t, err)
+	assert.Equal(t, uploadProgress{Blocks: 10, LastCid: "cid10"}, progress)
+
+	require.NoError(t, fx.DoneUpload("spaceId1", "fileId1"))
+	progress, err = fx.getUploadProgress("spaceId1", "fileId1")
+	require.NoError(t, err)
+	assert.Equal(t, uploadProgress{}, progress)
+}
+
 func TestMigration(t *testing.T) {
 	fx := newStoreFixture(t)
 	defer fx.Finish()
@@ -72,6 +72,48 @@ func (_c *MockFileSync_AddFile_Call) RunAndReturn(run func(string, string, bool,
 	return _c
 }
 
+// BandwidthLimit provides a mock function with given fields: spaceId
+func (_m *MockFileSync) BandwidthLimit(spaceId string) filesync.BandwidthLimit {
+	ret := _m.Called(spaceId)
+
+	var r0 filesync.BandwidthLimit
+	if rf, ok := ret.Get(0).(func(string) filesync.BandwidthLimit); ok {
+		r0 = rf(spaceId)
+	} else {
+		r0 = ret.Get(0).(filesync.BandwidthLimit)
+	}
+
+	return r0
+}
+
+// MockFileSync_BandwidthLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BandwidthLimit'
+type MockFileSync_BandwidthLimit_Call struct {
+	*mock.Call
+}
+
+// BandwidthLimit is a helper method to define mock.On call
+//   - spaceId string
+func (_e *MockFileSync_Expecter) BandwidthLimit(spaceId interface{}) *MockFileSync_BandwidthLimit_Call {
+	return &MockFileSync_BandwidthLimit_Call{Call: _e.mock.On("BandwidthLimit", spaceId)}
+}
+
+func (_c *MockFileSync_BandwidthLimit_Call) Run(run func(spaceId string)) *MockFileSync_BandwidthLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_BandwidthLimit_Call) Return(_a0 filesync.BandwidthLimit) *MockFileSync_BandwidthLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFileSync_BandwidthLimit_Call) RunAndReturn(run func(string) filesync.BandwidthLimit) *MockFileSync_BandwidthLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalculateFileSize provides a mock function with given fields: ctx, spaceId, fileID
 func (_m *MockFileSync) CalculateFileSize(ctx context.Context, spaceId string, fileID string) (int, error) {
 	ret := _m.Called(ctx, spaceId, fileID)
@@ -254,6 +296,59 @@ func (_c *MockFileSync_DebugQueue_Call) RunAndReturn(run func(*http.Request) (*f
 	return _c
 }
 
+// ExportQueue provides a mock function with given fields:
+func (_m *MockFileSync) ExportQueue() ([]byte, error) {
+	ret := _m.Called()
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]byte, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_ExportQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportQueue'
+type MockFileSync_ExportQueue_Call struct {
+	*mock.Call
+}
+
+// ExportQueue is a helper method to define mock.On call
+func (_e *MockFileSync_Expecter) ExportQueue() *MockFileSync_ExportQueue_Call {
+	return &MockFileSync_ExportQueue_Call{Call: _e.mock.On("ExportQueue")}
+}
+
+func (_c *MockFileSync_ExportQueue_Call) Run(run func()) *MockFileSync_ExportQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFileSync_ExportQueue_Call) Return(_a0 []byte, _a1 error) *MockFileSync_ExportQueue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_ExportQueue_Call) RunAndReturn(run func() ([]byte, error)) *MockFileSync_ExportQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FileListStats provides a mock function with given fields: ctx, spaceId, fileIDs
 func (_m *MockFileSync) FileListStats(ctx context.Context, spaceId string, fileIDs []string) ([]filesync.FileStat, error) {
 	ret := _m.Called(ctx, spaceId, fileIDs)
@@ -364,6 +459,167 @@ func (_c *MockFileSync_FileStat_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// FileStatus provides a mock function with given fields: spaceId, fileId
+func (_m *MockFileSync) FileStatus(spaceId string, fileId string) (filesync.FileSyncStatus, error) {
+	ret := _m.Called(spaceId, fileId)
+
+	var r0 filesync.FileSyncStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (filesync.FileSyncStatus, error)); ok {
+		return rf(spaceId, fileId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) filesync.FileSyncStatus); ok {
+		r0 = rf(spaceId, fileId)
+	} else {
+		r0 = ret.Get(0).(filesync.FileSyncStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(spaceId, fileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_FileStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileStatus'
+type MockFileSync_FileStatus_Call struct {
+	*mock.Call
+}
+
+// FileStatus is a helper method to define mock.On call
+//   - spaceId string
+//   - fileId string
+func (_e *MockFileSync_Expecter) FileStatus(spaceId interface{}, fileId interface{}) *MockFileSync_FileStatus_Call {
+	return &MockFileSync_FileStatus_Call{Call: _e.mock.On("FileStatus", spaceId, fileId)}
+}
+
+func (_c *MockFileSync_FileStatus_Call) Run(run func(spaceId string, fileId string)) *MockFileSync_FileStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_FileStatus_Call) Return(_a0 filesync.FileSyncStatus, _a1 error) *MockFileSync_FileStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_FileStatus_Call) RunAndReturn(run func(string, string) (filesync.FileSyncStatus, error)) *MockFileSync_FileStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FileStatuses provides a mock function with given fields: spaceId
+func (_m *MockFileSync) FileStatuses(spaceId string) ([]filesync.FileSyncStatus, error) {
+	ret := _m.Called(spaceId)
+
+	var r0 []filesync.FileSyncStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]filesync.FileSyncStatus, error)); ok {
+		return rf(spaceId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []filesync.FileSyncStatus); ok {
+		r0 = rf(spaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]filesync.FileSyncStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_FileStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FileStatuses'
+type MockFileSync_FileStatuses_Call struct {
+	*mock.Call
+}
+
+// FileStatuses is a helper method to define mock.On call
+//   - spaceId string
+func (_e *MockFileSync_Expecter) FileStatuses(spaceId interface{}) *MockFileSync_FileStatuses_Call {
+	return &MockFileSync_FileStatuses_Call{Call: _e.mock.On("FileStatuses", spaceId)}
+}
+
+func (_c *MockFileSync_FileStatuses_Call) Run(run func(spaceId string)) *MockFileSync_FileStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_FileStatuses_Call) Return(_a0 []filesync.FileSyncStatus, _a1 error) *MockFileSync_FileStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_FileStatuses_Call) RunAndReturn(run func(string) ([]filesync.FileSyncStatus, error)) *MockFileSync_FileStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLocalOnly provides a mock function with given fields: spaceId
+func (_m *MockFileSync) FindLocalOnly(spaceId string) ([]string, error) {
+	ret := _m.Called(spaceId)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(spaceId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(spaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_FindLocalOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLocalOnly'
+type MockFileSync_FindLocalOnly_Call struct {
+	*mock.Call
+}
+
+// FindLocalOnly is a helper method to define mock.On call
+//   - spaceId string
+func (_e *MockFileSync_Expecter) FindLocalOnly(spaceId interface{}) *MockFileSync_FindLocalOnly_Call {
+	return &MockFileSync_FindLocalOnly_Call{Call: _e.mock.On("FindLocalOnly", spaceId)}
+}
+
+func (_c *MockFileSync_FindLocalOnly_Call) Run(run func(spaceId string)) *MockFileSync_FindLocalOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_FindLocalOnly_Call) Return(_a0 []string, _a1 error) *MockFileSync_FindLocalOnly_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_FindLocalOnly_Call) RunAndReturn(run func(string) ([]string, error)) *MockFileSync_FindLocalOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasUpload provides a mock function with given fields: spaceId, fileId
 func (_m *MockFileSync) HasUpload(spaceId string, fileId string) (bool, error) {
 	ret := _m.Called(spaceId, fileId)
@@ -417,6 +673,48 @@ func (_c *MockFileSync_HasUpload_Call) RunAndReturn(run func(string, string) (bo
 	return _c
 }
 
+// ImportQueue provides a mock function with given fields: data
+func (_m *MockFileSync) ImportQueue(data []byte) error {
+	ret := _m.Called(data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte) error); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFileSync_ImportQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportQueue'
+type MockFileSync_ImportQueue_Call struct {
+	*mock.Call
+}
+
+// ImportQueue is a helper method to define mock.On call
+//   - data []byte
+func (_e *MockFileSync_Expecter) ImportQueue(data interface{}) *MockFileSync_ImportQueue_Call {
+	return &MockFileSync_ImportQueue_Call{Call: _e.mock.On("ImportQueue", data)}
+}
+
+func (_c *MockFileSync_ImportQueue_Call) Run(run func(data []byte)) *MockFileSync_ImportQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_ImportQueue_Call) Return(_a0 error) *MockFileSync_ImportQueue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFileSync_ImportQueue_Call) RunAndReturn(run func([]byte) error) *MockFileSync_ImportQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Init provides a mock function with given fields: a
 func (_m *MockFileSync) Init(a *app.App) error {
 	ret := _m.Called(a)
@@ -586,6 +884,136 @@ func (_c *MockFileSync_OnUpload_Call) RunAndReturn(run func(func(string, string)
 	return _c
 }
 
+// PinFile provides a mock function with given fields: fileID
+func (_m *MockFileSync) PinFile(fileID string) {
+	_m.Called(fileID)
+}
+
+// MockFileSync_PinFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PinFile'
+type MockFileSync_PinFile_Call struct {
+	*mock.Call
+}
+
+// PinFile is a helper method to define mock.On call
+//   - fileID string
+func (_e *MockFileSync_Expecter) PinFile(fileID interface{}) *MockFileSync_PinFile_Call {
+	return &MockFileSync_PinFile_Call{Call: _e.mock.On("PinFile", fileID)}
+}
+
+func (_c *MockFileSync_PinFile_Call) Run(run func(fileID string)) *MockFileSync_PinFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_PinFile_Call) Return() *MockFileSync_PinFile_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockFileSync_PinFile_Call) RunAndReturn(run func(string)) *MockFileSync_PinFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Prioritize provides a mock function with given fields: spaceId, fileId
+func (_m *MockFileSync) Prioritize(spaceId string, fileId string) error {
+	ret := _m.Called(spaceId, fileId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(spaceId, fileId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFileSync_Prioritize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prioritize'
+type MockFileSync_Prioritize_Call struct {
+	*mock.Call
+}
+
+// Prioritize is a helper method to define mock.On call
+//   - spaceId string
+//   - fileId string
+func (_e *MockFileSync_Expecter) Prioritize(spaceId interface{}, fileId interface{}) *MockFileSync_Prioritize_Call {
+	return &MockFileSync_Prioritize_Call{Call: _e.mock.On("Prioritize", spaceId, fileId)}
+}
+
+func (_c *MockFileSync_Prioritize_Call) Run(run func(spaceId string, fileId string)) *MockFileSync_Prioritize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_Prioritize_Call) Return(_a0 error) *MockFileSync_Prioritize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFileSync_Prioritize_Call) RunAndReturn(run func(string, string) error) *MockFileSync_Prioritize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileSpace provides a mock function with given fields: spaceId
+func (_m *MockFileSync) ReconcileSpace(spaceId string) ([]string, error) {
+	ret := _m.Called(spaceId)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(spaceId)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(spaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(spaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_ReconcileSpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileSpace'
+type MockFileSync_ReconcileSpace_Call struct {
+	*mock.Call
+}
+
+// ReconcileSpace is a helper method to define mock.On call
+//   - spaceId string
+func (_e *MockFileSync_Expecter) ReconcileSpace(spaceId interface{}) *MockFileSync_ReconcileSpace_Call {
+	return &MockFileSync_ReconcileSpace_Call{Call: _e.mock.On("ReconcileSpace", spaceId)}
+}
+
+func (_c *MockFileSync_ReconcileSpace_Call) Run(run func(spaceId string)) *MockFileSync_ReconcileSpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_ReconcileSpace_Call) Return(_a0 []string, _a1 error) *MockFileSync_ReconcileSpace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_ReconcileSpace_Call) RunAndReturn(run func(string) ([]string, error)) *MockFileSync_ReconcileSpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveFile provides a mock function with given fields: spaceId, fileId
 func (_m *MockFileSync) RemoveFile(spaceId string, fileId string) error {
 	ret := _m.Called(spaceId, fileId)
@@ -629,6 +1057,57 @@ func (_c *MockFileSync_RemoveFile_Call) RunAndReturn(run func(string, string) er
 	return _c
 }
 
+// RetryFailedUploads provides a mock function with given fields:
+func (_m *MockFileSync) RetryFailedUploads() (int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_RetryFailedUploads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetryFailedUploads'
+type MockFileSync_RetryFailedUploads_Call struct {
+	*mock.Call
+}
+
+// RetryFailedUploads is a helper method to define mock.On call
+func (_e *MockFileSync_Expecter) RetryFailedUploads() *MockFileSync_RetryFailedUploads_Call {
+	return &MockFileSync_RetryFailedUploads_Call{Call: _e.mock.On("RetryFailedUploads")}
+}
+
+func (_c *MockFileSync_RetryFailedUploads_Call) Run(run func()) *MockFileSync_RetryFailedUploads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockFileSync_RetryFailedUploads_Call) Return(_a0 int, _a1 error) *MockFileSync_RetryFailedUploads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_RetryFailedUploads_Call) RunAndReturn(run func() (int, error)) *MockFileSync_RetryFailedUploads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Run provides a mock function with given fields: ctx
 func (_m *MockFileSync) Run(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -703,6 +1182,115 @@ func (_c *MockFileSync_SendImportEvents_Call) RunAndReturn(run func()) *MockFile
 	return _c
 }
 
+// SetBandwidthLimit provides a mock function with given fields: spaceId, limit
+func (_m *MockFileSync) SetBandwidthLimit(spaceId string, limit filesync.BandwidthLimit) {
+	_m.Called(spaceId, limit)
+}
+
+// MockFileSync_SetBandwidthLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBandwidthLimit'
+type MockFileSync_SetBandwidthLimit_Call struct {
+	*mock.Call
+}
+
+// SetBandwidthLimit is a helper method to define mock.On call
+//   - spaceId string
+//   - limit filesync.BandwidthLimit
+func (_e *MockFileSync_Expecter) SetBandwidthLimit(spaceId interface{}, limit interface{}) *MockFileSync_SetBandwidthLimit_Call {
+	return &MockFileSync_SetBandwidthLimit_Call{Call: _e.mock.On("SetBandwidthLimit", spaceId, limit)}
+}
+
+func (_c *MockFileSync_SetBandwidthLimit_Call) Run(run func(spaceId string, limit filesync.BandwidthLimit)) *MockFileSync_SetBandwidthLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(filesync.BandwidthLimit))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_SetBandwidthLimit_Call) Return() *MockFileSync_SetBandwidthLimit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockFileSync_SetBandwidthLimit_Call) RunAndReturn(run func(string, filesync.BandwidthLimit)) *MockFileSync_SetBandwidthLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLocalCacheLimit provides a mock function with given fields: limit
+func (_m *MockFileSync) SetLocalCacheLimit(limit uint64) {
+	_m.Called(limit)
+}
+
+// MockFileSync_SetLocalCacheLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLocalCacheLimit'
+type MockFileSync_SetLocalCacheLimit_Call struct {
+	*mock.Call
+}
+
+// SetLocalCacheLimit is a helper method to define mock.On call
+//   - limit uint64
+func (_e *MockFileSync_Expecter) SetLocalCacheLimit(limit interface{}) *MockFileSync_SetLocalCacheLimit_Call {
+	return &MockFileSync_SetLocalCacheLimit_Call{Call: _e.mock.On("SetLocalCacheLimit", limit)}
+}
+
+func (_c *MockFileSync_SetLocalCacheLimit_Call) Run(run func(limit uint64)) *MockFileSync_SetLocalCacheLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_SetLocalCacheLimit_Call) Return() *MockFileSync_SetLocalCacheLimit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockFileSync_SetLocalCacheLimit_Call) RunAndReturn(run func(uint64)) *MockFileSync_SetLocalCacheLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUploadWindow provides a mock function with given fields: window
+func (_m *MockFileSync) SetUploadWindow(window *filesync.UploadWindow) error {
+	ret := _m.Called(window)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*filesync.UploadWindow) error); ok {
+		r0 = rf(window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFileSync_SetUploadWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUploadWindow'
+type MockFileSync_SetUploadWindow_Call struct {
+	*mock.Call
+}
+
+// SetUploadWindow is a helper method to define mock.On call
+//   - window *filesync.UploadWindow
+func (_e *MockFileSync_Expecter) SetUploadWindow(window interface{}) *MockFileSync_SetUploadWindow_Call {
+	return &MockFileSync_SetUploadWindow_Call{Call: _e.mock.On("SetUploadWindow", window)}
+}
+
+func (_c *MockFileSync_SetUploadWindow_Call) Run(run func(window *filesync.UploadWindow)) *MockFileSync_SetUploadWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*filesync.UploadWindow))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_SetUploadWindow_Call) Return(_a0 error) *MockFileSync_SetUploadWindow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFileSync_SetUploadWindow_Call) RunAndReturn(run func(*filesync.UploadWindow) error) *MockFileSync_SetUploadWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SpaceStat provides a mock function with given fields: ctx, spaceId
 func (_m *MockFileSync) SpaceStat(ctx context.Context, spaceId string) (filesync.SpaceStat, error) {
 	ret := _m.Called(ctx, spaceId)
@@ -807,6 +1395,137 @@ func (_c *MockFileSync_SyncStatus_Call) RunAndReturn(run func() (filesync.SyncSt
 	return _c
 }
 
+// TouchFile provides a mock function with given fields: ctx, spaceID, fileID
+func (_m *MockFileSync) TouchFile(ctx context.Context, spaceID string, fileID string) error {
+	ret := _m.Called(ctx, spaceID, fileID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, spaceID, fileID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockFileSync_TouchFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TouchFile'
+type MockFileSync_TouchFile_Call struct {
+	*mock.Call
+}
+
+// TouchFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spaceID string
+//   - fileID string
+func (_e *MockFileSync_Expecter) TouchFile(ctx interface{}, spaceID interface{}, fileID interface{}) *MockFileSync_TouchFile_Call {
+	return &MockFileSync_TouchFile_Call{Call: _e.mock.On("TouchFile", ctx, spaceID, fileID)}
+}
+
+func (_c *MockFileSync_TouchFile_Call) Run(run func(ctx context.Context, spaceID string, fileID string)) *MockFileSync_TouchFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_TouchFile_Call) Return(_a0 error) *MockFileSync_TouchFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockFileSync_TouchFile_Call) RunAndReturn(run func(context.Context, string, string) error) *MockFileSync_TouchFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnpinFile provides a mock function with given fields: fileID
+func (_m *MockFileSync) UnpinFile(fileID string) {
+	_m.Called(fileID)
+}
+
+// MockFileSync_UnpinFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpinFile'
+type MockFileSync_UnpinFile_Call struct {
+	*mock.Call
+}
+
+// UnpinFile is a helper method to define mock.On call
+//   - fileID string
+func (_e *MockFileSync_Expecter) UnpinFile(fileID interface{}) *MockFileSync_UnpinFile_Call {
+	return &MockFileSync_UnpinFile_Call{Call: _e.mock.On("UnpinFile", fileID)}
+}
+
+func (_c *MockFileSync_UnpinFile_Call) Run(run func(fileID string)) *MockFileSync_UnpinFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_UnpinFile_Call) Return() *MockFileSync_UnpinFile_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockFileSync_UnpinFile_Call) RunAndReturn(run func(string)) *MockFileSync_UnpinFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifySpace provides a mock function with given fields: ctx, spaceId, progress
+func (_m *MockFileSync) VerifySpace(ctx context.Context, spaceId string, progress filesync.VerifyProgress) (filesync.VerifyReport, error) {
+	ret := _m.Called(ctx, spaceId, progress)
+
+	var r0 filesync.VerifyReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, filesync.VerifyProgress) (filesync.VerifyReport, error)); ok {
+		return rf(ctx, spaceId, progress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, filesync.VerifyProgress) filesync.VerifyReport); ok {
+		r0 = rf(ctx, spaceId, progress)
+	} else {
+		r0 = ret.Get(0).(filesync.VerifyReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, filesync.VerifyProgress) error); ok {
+		r1 = rf(ctx, spaceId, progress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileSync_VerifySpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySpace'
+type MockFileSync_VerifySpace_Call struct {
+	*mock.Call
+}
+
+// VerifySpace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - spaceId string
+//   - progress filesync.VerifyProgress
+func (_e *MockFileSync_Expecter) VerifySpace(ctx interface{}, spaceId interface{}, progress interface{}) *MockFileSync_VerifySpace_Call {
+	return &MockFileSync_VerifySpace_Call{Call: _e.mock.On("VerifySpace", ctx, spaceId, progress)}
+}
+
+func (_c *MockFileSync_VerifySpace_Call) Run(run func(ctx context.Context, spaceId string, progress filesync.VerifyProgress)) *MockFileSync_VerifySpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(filesync.VerifyProgress))
+	})
+	return _c
+}
+
+func (_c *MockFileSync_VerifySpace_Call) Return(_a0 filesync.VerifyReport, _a1 error) *MockFileSync_VerifySpace_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileSync_VerifySpace_Call) RunAndReturn(run func(context.Context, string, filesync.VerifyProgress) (filesync.VerifyReport, error)) *MockFileSync_VerifySpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockFileSync creates a new instance of MockFileSync. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockFileSync(t interface {
@@ -0,0 +1,56 @@
+package filesync
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// exportedQueue is the serialized form of upload, discarded and remove queues. File data is not exported,
+// it should be transferred with the file storage
+type exportedQueue struct {
+	SchemaVersion int
+	QueueInfo
+}
+
+// ExportQueue serializes queues of pending uploads and removals, so they can be restored on another device
+func (f *fileSync) ExportQueue() ([]byte, error) {
+	info, err := f.DebugQueue(nil)
+	if err != nil {
+		return nil, fmt.Errorf("list queues: %w", err)
+	}
+	return json.Marshal(exportedQueue{
+		SchemaVersion: queueSchemaVersion,
+		QueueInfo:     *info,
+	})
+}
+
+// ImportQueue restores queues exported with ExportQueue. Files, which are already uploaded or queued, are skipped
+func (f *fileSync) ImportQueue(data []byte) error {
+	var queue exportedQueue
+	if err := json.Unmarshal(data, &queue); err != nil {
+		return fmt.Errorf("unmarshal queue: %w", err)
+	}
+	if queue.SchemaVersion != queueSchemaVersion {
+		return fmt.Errorf("unsupported queue schema version %d", queue.SchemaVersion)
+	}
+	for _, items := range [][]*QueueItem{queue.UploadingQueue, queue.DiscardedQueue, queue.RemovingQueue, queue.FailedQueue} {
+		for _, it := range items {
+			if it.SpaceID == "" || it.FileID == "" {
+				return fmt.Errorf("queue item without space or file id")
+			}
+		}
+	}
+	added, err := f.queue.importQueue(&queue.QueueInfo)
+	if err != nil {
+		return fmt.Errorf("import queue: %w", err)
+	}
+	log.Info("import file sync queue", zap.Int("added", added))
+	f.pingUpload()
+	select {
+	case f.removePingCh <- struct{}{}:
+	default:
+	}
+	return nil
+}
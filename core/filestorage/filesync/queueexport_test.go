@@ -0,0 +1,66 @@
+package filesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSync_ImportQueue(t *testing.T) {
+	t.Run("exported queue is imported to another file sync", func(t *testing.T) {
+		// given
+		source := newFixture(t)
+		defer source.Finish(t)
+		source.pauseUploads(t)
+		store := source.FileSync.(*fileSync).queue
+		require.NoError(t, store.QueueUpload("space1", "file1", true, false))
+		require.NoError(t, store.QueueUpload("space1", "file2", false, true))
+		require.NoError(t, store.QueueDiscarded("space1", "file3"))
+		uploading, err := store.GetUploadItem("space1", "file1")
+		require.NoError(t, err)
+
+		target := newFixture(t)
+		defer target.Finish(t)
+		target.pauseUploads(t)
+		require.NoError(t, target.FileSync.(*fileSync).queue.DoneUpload("space1", "file2"))
+
+		// when
+		data, err := source.ExportQueue()
+		require.NoError(t, err)
+		err = target.ImportQueue(data)
+
+		// then
+		require.NoError(t, err)
+		info, err := target.DebugQueue(nil)
+		require.NoError(t, err)
+		// file2 is already uploaded on target device
+		require.Len(t, info.UploadingQueue, 1)
+		assert.Equal(t, uploading, info.UploadingQueue[0])
+		require.Len(t, info.DiscardedQueue, 1)
+		assert.Equal(t, "file3", info.DiscardedQueue[0].FileID)
+		assert.Empty(t, info.RemovingQueue)
+	})
+	t.Run("queue of unknown schema version - return error", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+
+		// when
+		err := fx.ImportQueue([]byte(`{"SchemaVersion": 100}`))
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+// pauseUploads sets the upload window, which doesn't contain current time, so queued files stay in the queue
+func (f *fixture) pauseUploads(t *testing.T) {
+	now := time.Now()
+	offset := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute
+	require.NoError(t, f.SetUploadWindow(&UploadWindow{
+		Start: (offset + 2*time.Hour) % day,
+		End:   (offset + 3*time.Hour) % day,
+	}))
+}
@@ -0,0 +1,115 @@
+package filesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/filestorage"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore"
+)
+
+// FindLocalOnly returns files of the space, that are stored locally, but never got into the upload queue:
+// they are not queued and none of their blocks exist in the remote space
+func (f *fileSync) FindLocalOnly(spaceId string) ([]string, error) {
+	fileIds, err := f.fileStore.ListTargets()
+	if err != nil {
+		return nil, fmt.Errorf("list local files: %w", err)
+	}
+	ctx := context.WithValue(f.loopCtx, filestorage.CtxKeyRemoteLoadDisabled, true)
+	var localOnly []string
+	for _, fileId := range fileIds {
+		ok, err := f.isLocalOnly(ctx, spaceId, fileId)
+		if err != nil {
+			return nil, fmt.Errorf("check file %s: %w", fileId, err)
+		}
+		if ok {
+			localOnly = append(localOnly, fileId)
+		}
+	}
+	return localOnly, nil
+}
+
+// ReconcileSpace adds local-only files of the space to the upload queue and returns their ids
+func (f *fileSync) ReconcileSpace(spaceId string) ([]string, error) {
+	fileIds, err := f.FindLocalOnly(spaceId)
+	if err != nil {
+		return nil, fmt.Errorf("find local-only files: %w", err)
+	}
+	for _, fileId := range fileIds {
+		log.Info("add local-only file to upload queue", zap.String("fileID", fileId))
+		if err = f.AddFile(spaceId, fileId, false, false); err != nil {
+			return nil, fmt.Errorf("add file %s: %w", fileId, err)
+		}
+	}
+	return fileIds, nil
+}
+
+func (f *fileSync) isLocalOnly(ctx context.Context, spaceId, fileId string) (bool, error) {
+	fileSpaceId, err := f.spaceIdResolver.ResolveSpaceID(fileId)
+	if err != nil {
+		log.Debug("can't resolve space of file", zap.String("fileID", fileId), zap.Error(err))
+		return false, nil
+	}
+	if fileSpaceId != spaceId {
+		return false, nil
+	}
+	status, err := f.fileStore.GetSyncStatus(fileId)
+	if err != nil && !errors.Is(err, localstore.ErrNotFound) {
+		return false, fmt.Errorf("get file sync status: %w", err)
+	}
+	if status == int(syncstatus.StatusSynced) {
+		return false, nil
+	}
+	queued, err := f.queue.isFileQueued(spaceId, fileId)
+	if err != nil {
+		return false, fmt.Errorf("check queue: %w", err)
+	}
+	if queued {
+		return false, nil
+	}
+	var fileCids []cid.Cid
+	err = f.walkDAG(ctx, spaceId, fileId, func(node ipld.Node) error {
+		fileCids = append(fileCids, node.Cid())
+		return nil
+	})
+	if err != nil {
+		// file is not fully stored locally, so it can't be uploaded from this device
+		log.Debug("can't walk local file blocks", zap.String("fileID", fileId), zap.Error(err))
+		return false, nil
+	}
+	exists, err := f.remoteExistsCids(ctx, spaceId, fileCids)
+	if err != nil {
+		return false, fmt.Errorf("check remote blocks: %w", err)
+	}
+	return len(exists) == 0, nil
+}
+
+// remoteExistsCids returns cids of blocks, that exist in the remote space
+func (f *fileSync) remoteExistsCids(ctx context.Context, spaceId string, cids []cid.Cid) ([]cid.Cid, error) {
+	var exists []cid.Cid
+	for _, batch := range lo.Chunk(cids, batchSize) {
+		availabilities, err := f.rpcStore.CheckAvailability(ctx, spaceId, batch)
+		if err != nil {
+			return nil, fmt.Errorf("check availability: %w", err)
+		}
+		for _, availability := range availabilities {
+			if availability.Status != fileproto.AvailabilityStatus_ExistsInSpace {
+				continue
+			}
+			blockCid, err := cid.Cast(availability.Cid)
+			if err != nil {
+				return nil, fmt.Errorf("cast cid: %w", err)
+			}
+			exists = append(exists, blockCid)
+		}
+	}
+	return exists, nil
+}
@@ -0,0 +1,62 @@
+package filesync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	"github.com/ipfs/go-cid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+func TestFileSync_ReconcileSpace(t *testing.T) {
+	// given
+	fx := newFixture(t)
+	defer fx.Finish(t)
+	spaceId := "space1"
+	localOnly, uploaded := fx.addRandomFile(t), fx.addRandomFile(t)
+	localOnlyId, uploadedId := localOnly.Cid().String(), uploaded.Cid().String()
+	uploadedCids := map[cid.Cid]struct{}{}
+	uploadedCids[uploaded.Cid()] = struct{}{}
+	for _, link := range uploaded.Links() {
+		uploadedCids[link.Cid] = struct{}{}
+	}
+
+	fx.fileStoreMock.EXPECT().ListTargets().Return([]string{localOnlyId, uploadedId}, nil).AnyTimes()
+	fx.fileStoreMock.EXPECT().GetSyncStatus(gomock.Any()).Return(int(syncstatus.StatusNotSynced), nil).AnyTimes()
+	fx.fileStoreMock.EXPECT().ListByTarget(localOnlyId).Return([]*storage.FileInfo{{}}, nil).AnyTimes()
+	fx.fileStoreMock.EXPECT().GetFileSize(localOnlyId).Return(0, fmt.Errorf("not found")).AnyTimes()
+	fx.fileStoreMock.EXPECT().SetFileSize(localOnlyId, gomock.Any()).Return(nil).AnyTimes()
+	fx.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+		return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+			status := fileproto.AvailabilityStatus_NotExists
+			if _, ok := uploadedCids[c]; ok {
+				status = fileproto.AvailabilityStatus_ExistsInSpace
+			}
+			return &fileproto.BlockAvailability{
+				Cid:    c.Bytes(),
+				Status: status,
+			}
+		}), nil
+	}).AnyTimes()
+	fx.rpcStore.EXPECT().AddToFile(gomock.Any(), spaceId, localOnlyId, gomock.Any()).Return(nil).MinTimes(1)
+
+	// when
+	localOnlyIds, err := fx.FindLocalOnly(spaceId)
+	require.NoError(t, err)
+	queuedIds, err := fx.ReconcileSpace(spaceId)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []string{localOnlyId}, localOnlyIds)
+	assert.Equal(t, []string{localOnlyId}, queuedIds)
+	fx.waitEmptyQueue(t, time.Second*5)
+}
@@ -0,0 +1,64 @@
+package filesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/anyproto/any-sync/net"
+	"go.uber.org/zap"
+)
+
+const (
+	retryBaseDelay = 10 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+	// maxUploadAttempts is the number of failed attempts, after which the file is moved to the failed queue
+	maxUploadAttempts = 10
+)
+
+// retryDelay grows exponentially with the number of attempts. Jitter spreads retries of files failed together
+func retryDelay(attempts int, jitter func() float64) time.Duration {
+	delay := retryMaxDelay
+	if shift := attempts - 1; shift < 32 {
+		if d := retryBaseDelay << shift; d > 0 && d < delay {
+			delay = d
+		}
+	}
+	return delay/2 + time.Duration(jitter()*float64(delay/2))
+}
+
+// isConnectionErr reports errors caused by missing connection or shutdown, such files are never moved to the failed queue
+func isConnectionErr(err error) bool {
+	return errors.Is(err, net.ErrUnableToConnect) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
+// postponeUpload returns the failed item to the queue to be retried after a delay or gives it up
+// after too many attempts
+func (f *fileSync) postponeUpload(it *QueueItem, uploadErr error) error {
+	it.Attempts++
+	limited := isLimitReachedErr(uploadErr)
+	if limited {
+		// Limit reached events are sent only on the first attempt
+		it.AddedByUser, it.Imported = false, false
+	}
+	if !limited && !isConnectionErr(uploadErr) && it.Attempts >= maxUploadAttempts {
+		log.Warn("give up file upload", zap.String("fileID", it.FileID), zap.Int("attempts", it.Attempts), zap.Error(uploadErr))
+		return f.queue.QueueFailed(it)
+	}
+	it.RetryAt = time.Now().Add(retryDelay(it.Attempts, rand.Float64)).UnixMilli()
+	return f.queue.QueueRetry(it, limited)
+}
+
+// RetryFailedUploads queues files, which upload has been given up, again. It returns the number of queued files
+func (f *fileSync) RetryFailedUploads() (int, error) {
+	requeued, err := f.queue.RequeueFailed()
+	if err != nil {
+		return 0, fmt.Errorf("requeue failed uploads: %w", err)
+	}
+	if requeued > 0 {
+		f.pingUpload()
+	}
+	return requeued, nil
+}
@@ -0,0 +1,104 @@
+package filesync
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/net"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetryDelay(t *testing.T) {
+	t.Run("delay grows exponentially up to the max", func(t *testing.T) {
+		// given
+		noJitter := func() float64 { return 0 }
+		fullJitter := func() float64 { return 1 }
+
+		// when
+		first := retryDelay(1, noJitter)
+		third := retryDelay(3, fullJitter)
+		last := retryDelay(100, noJitter)
+
+		// then
+		assert.Equal(t, retryBaseDelay/2, first)
+		assert.Equal(t, 4*retryBaseDelay, third)
+		assert.Equal(t, retryMaxDelay/2, last)
+	})
+}
+
+func TestFileSync_PostponeUpload(t *testing.T) {
+	t.Run("failed file is postponed and given up after max attempts", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		fx.pauseUploads(t)
+		fs := fx.FileSync.(*fileSync)
+		fx.fileStoreMock.EXPECT().GetFileSize(gomock.Any()).Return(1000, nil).AnyTimes()
+		require.NoError(t, fs.queue.QueueUpload("space1", "file1", true, false))
+		it, err := fs.queue.GetUpload()
+		require.NoError(t, err)
+
+		// when
+		require.NoError(t, fs.postponeUpload(it, fmt.Errorf("internal error")))
+		_, getErr := fs.queue.GetUpload()
+		it.Attempts = maxUploadAttempts - 1
+		require.NoError(t, fs.postponeUpload(it, fmt.Errorf("internal error")))
+		status, err := fx.SyncStatus()
+		require.NoError(t, err)
+
+		// then
+		assert.ErrorIs(t, getErr, errQueueIsEmpty)
+		assert.Equal(t, 0, status.QueueLen)
+		assert.Equal(t, 1, status.FailedLen)
+		fileStatus, err := fx.FileStatus("space1", "file1")
+		require.NoError(t, err)
+		assert.Equal(t, FileSyncStateFailed, fileStatus.State)
+	})
+	t.Run("file is not given up while offline", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		fx.pauseUploads(t)
+		fs := fx.FileSync.(*fileSync)
+		require.NoError(t, fs.queue.QueueUpload("space1", "file1", true, false))
+		it, err := fs.queue.GetUpload()
+		require.NoError(t, err)
+		it.Attempts = maxUploadAttempts
+
+		// when
+		err = fs.postponeUpload(it, fmt.Errorf("dial: %w", net.ErrUnableToConnect))
+
+		// then
+		require.NoError(t, err)
+		status, err := fx.SyncStatus()
+		require.NoError(t, err)
+		assert.Equal(t, 1, status.QueueLen)
+		assert.Equal(t, 0, status.FailedLen)
+	})
+}
+
+func TestFileSync_RetryFailedUploads(t *testing.T) {
+	// given
+	fx := newFixture(t)
+	defer fx.Finish(t)
+	fx.pauseUploads(t)
+	fs := fx.FileSync.(*fileSync)
+	require.NoError(t, fs.queue.QueueFailed(&QueueItem{SpaceID: "space1", FileID: "file1", Timestamp: time.Now().UnixMilli(), Attempts: maxUploadAttempts}))
+
+	// when
+	requeued, err := fx.RetryFailedUploads()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 1, requeued)
+	it, err := fs.queue.GetUpload()
+	require.NoError(t, err)
+	assert.Equal(t, "file1", it.FileID)
+	assert.Zero(t, it.Attempts)
+	failed, err := fs.queue.FailedLen()
+	require.NoError(t, err)
+	assert.Zero(t, failed)
+}
@@ -0,0 +1,110 @@
+package filesync
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const day = 24 * time.Hour
+
+// UploadWindow is a period of day, during which queued files are uploaded. Start and End are offsets
+// from the midnight in local time. If End is less than Start, the window passes midnight.
+// Empty Days means that the window is applied every day, otherwise Days of the window start are listed
+type UploadWindow struct {
+	Start time.Duration
+	End   time.Duration
+	Days  []time.Weekday
+}
+
+func (w *UploadWindow) validate() error {
+	if w.Start < 0 || w.Start >= day || w.End < 0 || w.End >= day {
+		return fmt.Errorf("start and end of upload window should be within a day")
+	}
+	if w.Start == w.End {
+		return fmt.Errorf("upload window is empty")
+	}
+	return nil
+}
+
+func (w *UploadWindow) contains(t time.Time) bool {
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	weekday := t.Weekday()
+	if w.Start < w.End {
+		if offset < w.Start || offset >= w.End {
+			return false
+		}
+	} else {
+		switch {
+		case offset >= w.Start:
+		case offset < w.End:
+			// window has been started the day before
+			weekday = (weekday + 6) % 7
+		default:
+			return false
+		}
+	}
+	return len(w.Days) == 0 || lo.Contains(w.Days, weekday)
+}
+
+// uploadSchedule holds the upload window
+type uploadSchedule struct {
+	sync.Mutex
+	window *UploadWindow
+}
+
+func newUploadSchedule() *uploadSchedule {
+	return &uploadSchedule{}
+}
+
+func (s *uploadSchedule) setWindow(window *UploadWindow) {
+	s.Lock()
+	defer s.Unlock()
+	s.window = window
+}
+
+func (s *uploadSchedule) isUploadAllowed(t time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.window == nil || s.window.contains(t)
+}
+
+// SetUploadWindow restricts uploads of queued files to the window. Nil window allows uploads at any time
+func (f *fileSync) SetUploadWindow(window *UploadWindow) error {
+	if window != nil {
+		if err := window.validate(); err != nil {
+			return err
+		}
+	}
+	f.schedule.setWindow(window)
+	f.pingUpload()
+	return nil
+}
+
+// Prioritize moves queued file ahead of files with default priority, e.g. when user opens it.
+// Prioritized file is uploaded even outside the upload window, the priority is kept in the queue across restarts
+func (f *fileSync) Prioritize(spaceId, fileId string) error {
+	ok, err := f.queue.SetUploadPriority(spaceId, fileId, userPriority)
+	if err != nil {
+		return fmt.Errorf("set upload priority: %w", err)
+	}
+	if ok {
+		log.Info("boost file upload", zap.String("fileID", fileId))
+		f.pingUpload()
+	}
+	return nil
+}
+
+func (f *fileSync) pingUpload() {
+	select {
+	case f.uploadPingCh <- struct{}{}:
+	default:
+	}
+}
+
+func (f *fileSync) isUploadScheduled(queueLen int) bool {
+	return queueLen > 0 && !f.schedule.isUploadAllowed(time.Now())
+}
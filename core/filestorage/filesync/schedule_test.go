@@ -0,0 +1,104 @@
+package filesync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/anyproto/any-sync/commonspace/syncstatus"
+	"github.com/ipfs/go-cid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+)
+
+func TestFileSync_SetUploadWindow(t *testing.T) {
+	t.Run("outside of window - only prioritized file is uploaded", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		spaceId := "space1"
+		now := time.Now()
+		offset := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute
+		require.NoError(t, fx.SetUploadWindow(&UploadWindow{
+			Start: (offset + 2*time.Hour) % day,
+			End:   (offset + 3*time.Hour) % day,
+		}))
+		regularId := fx.addFileToStore(t)
+		prioritizedId := fx.addFileToStore(t)
+
+		fx.fileStoreMock.EXPECT().GetSyncStatus(gomock.Any()).Return(int(syncstatus.StatusNotSynced), nil).AnyTimes()
+		fx.fileStoreMock.EXPECT().GetFileSize(gomock.Any()).Return(0, fmt.Errorf("not found")).AnyTimes()
+		fx.fileStoreMock.EXPECT().SetFileSize(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		fx.fileStoreMock.EXPECT().ListByTarget(gomock.Any()).Return([]*storage.FileInfo{{}}, nil).AnyTimes()
+		fx.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+			return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+				return &fileproto.BlockAvailability{
+					Cid:    c.Bytes(),
+					Status: fileproto.AvailabilityStatus_NotExists,
+				}
+			}), nil
+		}).AnyTimes()
+		// upload of regular file is unexpected call
+		fx.rpcStore.EXPECT().AddToFile(gomock.Any(), spaceId, prioritizedId, gomock.Any()).AnyTimes()
+
+		// when
+		require.NoError(t, fx.AddFile(spaceId, regularId, false, false))
+		require.NoError(t, fx.AddFile(spaceId, prioritizedId, false, false))
+		require.NoError(t, fx.Prioritize(spaceId, prioritizedId))
+
+		// then
+		require.Eventually(t, func() bool {
+			ok, err := fx.HasUpload(spaceId, prioritizedId)
+			return err == nil && !ok
+		}, time.Second*5, time.Millisecond*10)
+		ok, err := fx.HasUpload(spaceId, regularId)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ss, err := fx.SyncStatus()
+		require.NoError(t, err)
+		assert.Equal(t, 1, ss.QueueLen)
+		assert.True(t, ss.Scheduled)
+	})
+	t.Run("invalid window - return error", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+
+		// when
+		err := fx.SetUploadWindow(&UploadWindow{Start: time.Hour, End: time.Hour})
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func TestUploadWindow_contains(t *testing.T) {
+	monday := func(hour int) time.Time {
+		return time.Date(2023, 10, 2, hour, 0, 0, 0, time.Local)
+	}
+	overnight := &UploadWindow{Start: 22 * time.Hour, End: 6 * time.Hour, Days: []time.Weekday{time.Sunday}}
+
+	assert.True(t, (&UploadWindow{Start: time.Hour, End: 3 * time.Hour}).contains(monday(2)))
+	assert.False(t, (&UploadWindow{Start: time.Hour, End: 3 * time.Hour}).contains(monday(3)))
+	// window has been started on Sunday
+	assert.True(t, overnight.contains(monday(5)))
+	assert.False(t, overnight.contains(monday(23)))
+	assert.False(t, overnight.contains(monday(12)))
+}
+
+func (f *fixture) addFileToStore(t *testing.T) string {
+	var buf = make([]byte, 1024)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	n, err := f.fileService.AddFile(ctx, bytes.NewReader(buf))
+	require.NoError(t, err)
+	return n.Cid().String()
+}
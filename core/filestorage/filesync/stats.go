@@ -225,5 +225,9 @@ func (f *fileSync) DebugQueue(_ *http.Request) (*QueueInfo, error) {
 	if err != nil {
 		return nil, fmt.Errorf("list items from removing queue: %w", err)
 	}
+	info.FailedQueue, err = f.queue.listItemsByPrefix(failedKeyPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("list items from failed queue: %w", err)
+	}
 	return &info, nil
 }
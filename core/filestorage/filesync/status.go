@@ -0,0 +1,227 @@
+package filesync
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+type FileSyncState int
+
+const (
+	// FileSyncStateUnknown means that the file is neither queued nor uploaded from this device
+	FileSyncStateUnknown FileSyncState = iota
+	FileSyncStateQueued
+	FileSyncStateUploading
+	FileSyncStateUploaded
+	// FileSyncStateFailed means that the last upload attempt has failed. The file is retried later or, after
+	// too many attempts, is kept in the failed queue until it is requeued
+	FileSyncStateFailed
+	// FileSyncStateLimited means that the file doesn't fit the space limit and waits for free space
+	FileSyncStateLimited
+)
+
+func (s FileSyncState) String() string {
+	switch s {
+	case FileSyncStateQueued:
+		return "queued"
+	case FileSyncStateUploading:
+		return "uploading"
+	case FileSyncStateUploaded:
+		return "uploaded"
+	case FileSyncStateFailed:
+		return "failed"
+	case FileSyncStateLimited:
+		return "limited"
+	default:
+		return "unknown"
+	}
+}
+
+// FileSyncStatus is the upload state of the file. Chunks and bytes are known after the upload is started
+type FileSyncStatus struct {
+	SpaceId             string
+	FileId              string
+	State               FileSyncState
+	UploadedChunksCount int
+	TotalChunksCount    int
+	BytesRemaining      int
+	// Error of the last upload attempt
+	Error string
+}
+
+// uploadStatuses holds the state of uploads, which is not kept in the queue
+type uploadStatuses struct {
+	sync.Mutex
+	uploading map[string]uploadProgress // fileId -> progress
+	errors    map[string]string         // fileId -> error of the last attempt
+}
+
+func newUploadStatuses() *uploadStatuses {
+	return &uploadStatuses{
+		uploading: map[string]uploadProgress{},
+		errors:    map[string]string{},
+	}
+}
+
+func (s *uploadStatuses) setProgress(fileId string, progress uploadProgress) {
+	s.Lock()
+	defer s.Unlock()
+	s.uploading[fileId] = progress
+}
+
+// done forgets the upload of the file, err is kept to be reported until the next attempt succeeds
+func (s *uploadStatuses) done(fileId string, err error) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.uploading, fileId)
+	if err != nil {
+		s.errors[fileId] = err.Error()
+	} else {
+		delete(s.errors, fileId)
+	}
+}
+
+func (s *uploadStatuses) get(fileId string) (progress uploadProgress, uploading bool, errMsg string) {
+	s.Lock()
+	defer s.Unlock()
+	progress, uploading = s.uploading[fileId]
+	return progress, uploading, s.errors[fileId]
+}
+
+// FileStatus returns the upload state of the file
+func (f *fileSync) FileStatus(spaceId, fileId string) (FileSyncStatus, error) {
+	status := FileSyncStatus{SpaceId: spaceId, FileId: fileId}
+	queued, err := f.queue.HasUpload(spaceId, fileId)
+	if err != nil {
+		return status, fmt.Errorf("check upload queue: %w", err)
+	}
+	if queued {
+		return f.queuedFileStatus(status, FileSyncStateQueued)
+	}
+	limited, err := f.queue.IsFileUploadLimited(spaceId, fileId)
+	if err != nil {
+		return status, fmt.Errorf("check discarded queue: %w", err)
+	}
+	if limited {
+		return f.queuedFileStatus(status, FileSyncStateLimited)
+	}
+	failed, err := f.queue.IsUploadFailed(spaceId, fileId)
+	if err != nil {
+		return status, fmt.Errorf("check failed queue: %w", err)
+	}
+	if failed {
+		return f.queuedFileStatus(status, FileSyncStateFailed)
+	}
+	uploaded, err := f.queue.IsAlreadyUploaded(spaceId, fileId)
+	if err != nil {
+		return status, fmt.Errorf("check uploaded files: %w", err)
+	}
+	if uploaded {
+		status.State = FileSyncStateUploaded
+	}
+	return status, nil
+}
+
+// FileStatuses returns upload states of files of the space, which are queued for upload or failed to upload
+func (f *fileSync) FileStatuses(spaceId string) ([]FileSyncStatus, error) {
+	var statuses []FileSyncStatus
+	for _, queue := range []struct {
+		prefix []byte
+		state  FileSyncState
+	}{
+		{uploadKeyPrefix, FileSyncStateQueued},
+		{discardedKeyPrefix, FileSyncStateLimited},
+		{failedKeyPrefix, FileSyncStateFailed},
+	} {
+		items, err := f.queue.listItemsByPrefix(queue.prefix)
+		if err != nil {
+			return nil, fmt.Errorf("list queue items: %w", err)
+		}
+		for _, it := range items {
+			if it.SpaceID != spaceId {
+				continue
+			}
+			status, err := f.queuedFileStatus(FileSyncStatus{SpaceId: it.SpaceID, FileId: it.FileID}, queue.state)
+			if err != nil {
+				return nil, err
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, nil
+}
+
+// queuedFileStatus fills the status of queued file with progress of current or interrupted upload
+func (f *fileSync) queuedFileStatus(status FileSyncStatus, state FileSyncState) (FileSyncStatus, error) {
+	status.State = state
+	progress, uploading, errMsg := f.statuses.get(status.FileId)
+	if !uploading {
+		var err error
+		progress, err = f.queue.getUploadProgress(status.SpaceId, status.FileId)
+		if err != nil {
+			return status, fmt.Errorf("get upload progress: %w", err)
+		}
+	}
+	switch {
+	case uploading:
+		status.State = FileSyncStateUploading
+	case errMsg != "" && state == FileSyncStateQueued:
+		status.State = FileSyncStateFailed
+	}
+	status.Error = errMsg
+	status.UploadedChunksCount = progress.Blocks
+	status.TotalChunksCount = progress.TotalBlocks
+	if size, err := f.fileStore.GetFileSize(status.FileId); err == nil && size > progress.Bytes {
+		status.BytesRemaining = size - progress.Bytes
+	}
+	return status, nil
+}
+
+// uploadTracker estimates the time left for the upload attempt by its average rate
+type uploadTracker struct {
+	spaceId    string
+	fileId     string
+	totalBytes int
+	startBytes int
+	started    time.Time
+	now        func() time.Time
+}
+
+func newUploadTracker(spaceId, fileId string, totalBytes, startBytes int) *uploadTracker {
+	return &uploadTracker{
+		spaceId:    spaceId,
+		fileId:     fileId,
+		totalBytes: totalBytes,
+		startBytes: startBytes,
+		started:    time.Now(),
+		now:        time.Now,
+	}
+}
+
+// eta returns the estimated time left or zero, if nothing has been uploaded during the attempt yet
+func (t *uploadTracker) eta(bytes int) time.Duration {
+	uploaded := bytes - t.startBytes
+	elapsed := t.now().Sub(t.started)
+	if uploaded <= 0 || elapsed <= 0 || bytes >= t.totalBytes {
+		return 0
+	}
+	return time.Duration(float64(elapsed) / float64(uploaded) * float64(t.totalBytes-bytes))
+}
+
+func (t *uploadTracker) event(state pb.EventFileUploadProgressState, bytes int, err error) *pb.EventFileUploadProgress {
+	event := &pb.EventFileUploadProgress{
+		SpaceId:       t.spaceId,
+		FileId:        t.fileId,
+		State:         state,
+		BytesUploaded: uint64(bytes),
+		BytesTotal:    uint64(t.totalBytes),
+		EtaSeconds:    int64(t.eta(bytes).Round(time.Second) / time.Second),
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
@@ -0,0 +1,113 @@
+package filesync
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestFileSync_FileStatus(t *testing.T) {
+	newQueuedFixture := func(t *testing.T) (*fixture, *fileSync) {
+		fx := newFixture(t)
+		fx.pauseUploads(t)
+		fx.fileStoreMock.EXPECT().GetFileSize(gomock.Any()).Return(1000, nil).AnyTimes()
+		return fx, fx.FileSync.(*fileSync)
+	}
+	t.Run("queued file", func(t *testing.T) {
+		// given
+		fx, fs := newQueuedFixture(t)
+		defer fx.Finish(t)
+		require.NoError(t, fs.queue.QueueUpload("space1", "file1", true, false))
+		require.NoError(t, fs.queue.QueueUpload("space2", "file2", true, false))
+
+		// when
+		status, err := fx.FileStatus("space1", "file1")
+		require.NoError(t, err)
+		statuses, err := fx.FileStatuses("space1")
+		require.NoError(t, err)
+
+		// then
+		expected := FileSyncStatus{SpaceId: "space1", FileId: "file1", State: FileSyncStateQueued, BytesRemaining: 1000}
+		assert.Equal(t, expected, status)
+		assert.Equal(t, []FileSyncStatus{expected}, statuses)
+	})
+	t.Run("uploading and failed file", func(t *testing.T) {
+		// given
+		fx, fs := newQueuedFixture(t)
+		defer fx.Finish(t)
+		require.NoError(t, fs.queue.QueueUpload("space1", "file1", true, false))
+
+		// when
+		fs.statuses.setProgress("file1", uploadProgress{Blocks: 2, Bytes: 400, TotalBlocks: 5})
+		uploading, err := fx.FileStatus("space1", "file1")
+		require.NoError(t, err)
+		fs.statuses.done("file1", fmt.Errorf("connection lost"))
+		failed, err := fx.FileStatus("space1", "file1")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, FileSyncStateUploading, uploading.State)
+		assert.Equal(t, 2, uploading.UploadedChunksCount)
+		assert.Equal(t, 5, uploading.TotalChunksCount)
+		assert.Equal(t, 600, uploading.BytesRemaining)
+		assert.Equal(t, FileSyncStateFailed, failed.State)
+		assert.Equal(t, "connection lost", failed.Error)
+	})
+	t.Run("uploaded and unknown file", func(t *testing.T) {
+		// given
+		fx, fs := newQueuedFixture(t)
+		defer fx.Finish(t)
+		require.NoError(t, fs.queue.QueueUpload("space1", "file1", true, false))
+		require.NoError(t, fs.queue.DoneUpload("space1", "file1"))
+
+		// when
+		uploaded, err := fx.FileStatus("space1", "file1")
+		require.NoError(t, err)
+		unknown, err := fx.FileStatus("space1", "file2")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, FileSyncStateUploaded, uploaded.State)
+		assert.Equal(t, FileSyncStateUnknown, unknown.State)
+	})
+}
+
+func TestUploadTracker(t *testing.T) {
+	t.Run("time left is estimated by rate of the attempt", func(t *testing.T) {
+		// given
+		tracker := newUploadTracker("space1", "file1", 1000, 200)
+		tracker.now = func() time.Time {
+			return tracker.started.Add(10 * time.Second)
+		}
+
+		// when
+		event := tracker.event(pb.EventFileUploadProgress_InProgress, 400, nil)
+
+		// then
+		assert.Equal(t, &pb.EventFileUploadProgress{
+			SpaceId:       "space1",
+			FileId:        "file1",
+			State:         pb.EventFileUploadProgress_InProgress,
+			BytesUploaded: 400,
+			BytesTotal:    1000,
+			EtaSeconds:    30,
+		}, event)
+	})
+	t.Run("nothing uploaded yet - time left is unknown", func(t *testing.T) {
+		// given
+		tracker := newUploadTracker("space1", "file1", 1000, 200)
+
+		// when
+		event := tracker.event(pb.EventFileUploadProgress_Failed, 200, fmt.Errorf("connection lost"))
+
+		// then
+		assert.Zero(t, event.EtaSeconds)
+		assert.Equal(t, "connection lost", event.Error)
+	})
+}
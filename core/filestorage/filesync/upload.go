@@ -39,10 +39,7 @@ func (f *fileSync) AddFile(spaceID, fileID string, uploadedByUser, imported bool
 
 	err = f.queue.QueueUpload(spaceID, fileID, uploadedByUser, imported)
 	if err == nil {
-		select {
-		case f.uploadPingCh <- struct{}{}:
-		default:
-		}
+		f.pingUpload()
 	}
 	return
 }
@@ -88,11 +85,22 @@ func (f *fileSync) addOperation() {
 }
 
 func (f *fileSync) getUpload() (*QueueItem, error) {
+	allowed := f.schedule.isUploadAllowed(time.Now())
 	it, err := f.queue.GetUpload()
 	if err == errQueueIsEmpty {
+		if !allowed {
+			return nil, errQueueIsEmpty
+		}
 		return f.queue.GetDiscardedUpload()
 	}
-	return it, err
+	if err != nil {
+		return nil, err
+	}
+	// Prioritized files go first in the queue, files with default priority are kept until the upload window
+	if it.Priority == 0 && !allowed {
+		return nil, errQueueIsEmpty
+	}
+	return it, nil
 }
 
 func (f *fileSync) tryToUpload() (string, error) {
@@ -109,7 +117,9 @@ func (f *fileSync) tryToUpload() (string, error) {
 		log.Warn("file has been deleted from store, skip upload", zap.String("fileId", fileId))
 		return fileId, f.queue.DoneUpload(spaceId, fileId)
 	}
-	if err = f.uploadFile(f.loopCtx, spaceId, fileId); err != nil {
+	err = f.uploadFile(f.loopCtx, spaceId, fileId)
+	f.statuses.done(fileId, err)
+	if err != nil {
 		if isLimitReachedErr(err) {
 			if it.AddedByUser && !it.Imported {
 				f.sendLimitReachedEvent(spaceId, fileId)
@@ -117,14 +127,10 @@ func (f *fileSync) tryToUpload() (string, error) {
 			if it.Imported {
 				f.addImportEvent(spaceId, fileId)
 			}
-			if qerr := f.queue.QueueDiscarded(spaceId, fileId); qerr != nil {
-				log.Warn("can't push upload task to discarded queue", zap.String("fileId", fileId), zap.Error(qerr))
-			}
-			return fileId, err
 		}
 
-		// Push to the back of the queue
-		if qerr := f.queue.QueueUpload(spaceId, fileId, it.AddedByUser, it.Imported); qerr != nil {
+		// Push to the back of the queue, the file is retried with backoff
+		if qerr := f.postponeUpload(it, err); qerr != nil {
 			log.Warn("can't push upload task back to queue", zap.String("fileId", fileId), zap.Error(qerr))
 		}
 		return fileId, err
@@ -141,7 +147,14 @@ func (f *fileSync) tryToUpload() (string, error) {
 
 	f.updateSpaceUsageInformation(spaceId)
 
-	return fileId, f.queue.DoneUpload(spaceId, fileId)
+	if err = f.queue.DoneUpload(spaceId, fileId); err != nil {
+		return fileId, err
+	}
+	// Blocks of the uploaded file are confirmed by the node, so they can be evicted from local cache from now on
+	if err = f.TouchFile(f.loopCtx, spaceId, fileId); err != nil {
+		log.Warn("can't add uploaded file to local cache", zap.String("fileID", fileId), zap.Error(err))
+	}
+	return fileId, nil
 }
 
 func isLimitReachedErr(err error) bool {
@@ -168,27 +181,115 @@ func (f *fileSync) uploadFile(ctx context.Context, spaceID string, fileID string
 		return errReachedLimit
 	}
 
-	var totalBytesUploaded int
+	progress, err := f.queue.getUploadProgress(spaceID, fileID)
+	if err != nil {
+		log.Warn("can't get upload progress, upload from the beginning", zap.String("fileID", fileID), zap.Error(err))
+		progress = uploadProgress{}
+	}
+	if progress.Blocks > 0 {
+		log.Info("resume upload", zap.String("fileID", fileID), zap.Int("confirmedBlocks", progress.Blocks))
+	}
+	if progress.TotalBlocks == 0 {
+		progress.TotalBlocks, err = f.countFileBlocks(ctx, spaceID, fileID)
+		if err != nil {
+			return fmt.Errorf("count file blocks: %w", err)
+		}
+	}
+	f.statuses.setProgress(fileID, progress)
+	tracker := newUploadTracker(spaceID, fileID, fileSize, progress.Bytes)
+	f.sendUploadProgressEvent(tracker.event(pb.EventFileUploadProgress_Started, progress.Bytes, nil))
+
+	var (
+		totalBytesUploaded int
+		walkedBlocks       int
+	)
 	err = f.walkFileBlocks(ctx, spaceID, fileID, func(fileBlocks []blocks.Block) error {
+		confirmed, err := progress.confirmedBlocks(walkedBlocks, fileBlocks)
+		if err != nil {
+			return err
+		}
+		walkedBlocks += len(fileBlocks)
+		fileBlocks = fileBlocks[confirmed:]
+		if len(fileBlocks) == 0 {
+			return nil
+		}
 		bytesToUpload, blocksToUpload, err := f.selectBlocksToUploadAndBindExisting(ctx, spaceID, fileID, fileBlocks)
 		if err != nil {
 			return fmt.Errorf("select blocks to upload: %w", err)
 		}
+		if err = f.bandwidth.waitUpload(ctx, spaceID, bytesToUpload); err != nil {
+			return err
+		}
 		if err = f.rpcStore.AddToFile(ctx, spaceID, fileID, blocksToUpload); err != nil {
 			return err
 		}
 		totalBytesUploaded += bytesToUpload
+		// All blocks of the batch are either uploaded or bound to the file, so they are skipped on resume
+		progress = uploadProgress{
+			Blocks:      walkedBlocks,
+			LastCid:     fileBlocks[len(fileBlocks)-1].Cid().String(),
+			Bytes:       progress.Bytes + blocksSize(fileBlocks),
+			TotalBlocks: progress.TotalBlocks,
+		}
+		f.statuses.setProgress(fileID, progress)
+		if err = f.queue.setUploadProgress(spaceID, fileID, progress); err != nil {
+			log.Warn("can't save upload progress", zap.String("fileID", fileID), zap.Error(err))
+		}
+		f.sendUploadProgressEvent(tracker.event(pb.EventFileUploadProgress_InProgress, progress.Bytes, nil))
 		return nil
 	})
+	if errors.Is(err, errUploadProgressMismatch) {
+		if resetErr := f.queue.resetUploadProgress(spaceID, fileID); resetErr != nil {
+			log.Warn("can't reset upload progress", zap.String("fileID", fileID), zap.Error(resetErr))
+		}
+	}
 	if err != nil {
-		return fmt.Errorf("walk file blocks: %w", err)
+		err = fmt.Errorf("walk file blocks: %w", err)
+		f.sendUploadProgressEvent(tracker.event(pb.EventFileUploadProgress_Failed, progress.Bytes, err))
+		return err
 	}
+	f.sendUploadProgressEvent(tracker.event(pb.EventFileUploadProgress_Finished, fileSize, nil))
 
 	log.Warn("done upload", zap.String("fileID", fileID), zap.Int("estimatedSize", fileSize), zap.Int("bytesUploaded", totalBytesUploaded))
 
 	return nil
 }
 
+var errUploadProgressMismatch = errors.New("file blocks don't match saved upload progress")
+
+// confirmedBlocks returns the number of blocks of the batch, which are already confirmed by the node.
+// offset is the number of file blocks walked before the batch
+func (p uploadProgress) confirmedBlocks(offset int, batch []blocks.Block) (int, error) {
+	n := p.Blocks - offset
+	if n <= 0 {
+		return 0, nil
+	}
+	if n > len(batch) {
+		return len(batch), nil
+	}
+	if batch[n-1].Cid().String() != p.LastCid {
+		return 0, errUploadProgressMismatch
+	}
+	return n, nil
+}
+
+func blocksSize(bs []blocks.Block) int {
+	var size int
+	for _, b := range bs {
+		size += len(b.RawData())
+	}
+	return size
+}
+
+func (f *fileSync) countFileBlocks(ctx context.Context, spaceId string, fileId string) (int, error) {
+	var count int
+	err := f.walkDAG(ctx, spaceId, fileId, func(_ ipld.Node) error {
+		count++
+		return nil
+	})
+	return count, err
+}
+
 func (f *fileSync) hasFileInStore(fileID string) (bool, error) {
 	roots, err := f.fileStore.ListByTarget(fileID)
 	if err != localstore.ErrNotFound && err != nil {
@@ -212,6 +313,18 @@ func (f *fileSync) sendLimitReachedEvent(spaceID string, fileID string) {
 	})
 }
 
+func (f *fileSync) sendUploadProgressEvent(progress *pb.EventFileUploadProgress) {
+	f.eventSender.Broadcast(&pb.Event{
+		Messages: []*pb.EventMessage{
+			{
+				Value: &pb.EventMessageValueOfFileUploadProgress{
+					FileUploadProgress: progress,
+				},
+			},
+		},
+	})
+}
+
 func (f *fileSync) addImportEvent(spaceID string, fileID string) {
 	f.importEventsMutex.Lock()
 	defer f.importEventsMutex.Unlock()
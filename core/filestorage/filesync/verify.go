@@ -0,0 +1,94 @@
+package filesync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/filestorage"
+)
+
+// VerifyReport groups local files of the space by presence of their blocks in the remote space
+type VerifyReport struct {
+	// Synced contains files, which blocks all exist remotely
+	Synced []string
+	// PartiallySynced contains files, which blocks exist remotely only in part
+	PartiallySynced []string
+	// Missing contains files, which blocks don't exist remotely
+	Missing []string
+	// Skipped contains files, which are not fully stored locally, so their blocks can't be verified
+	Skipped []string
+}
+
+// FilesCount returns the number of checked files
+func (r VerifyReport) FilesCount() int {
+	return len(r.Synced) + len(r.PartiallySynced) + len(r.Missing) + len(r.Skipped)
+}
+
+// VerifyProgress is called after each checked file
+type VerifyProgress func(checked, total int)
+
+// VerifySpace checks presence of blocks of every local file of the space in the remote space.
+// Verification is stopped on cancellation of the context
+func (f *fileSync) VerifySpace(ctx context.Context, spaceId string, progress VerifyProgress) (VerifyReport, error) {
+	var report VerifyReport
+	fileIds, err := f.listSpaceFiles(spaceId)
+	if err != nil {
+		return report, err
+	}
+	ctx = context.WithValue(ctx, filestorage.CtxKeyRemoteLoadDisabled, true)
+	for i, fileId := range fileIds {
+		if err = ctx.Err(); err != nil {
+			return report, err
+		}
+		var fileCids []cid.Cid
+		err = f.walkDAG(ctx, spaceId, fileId, func(node ipld.Node) error {
+			fileCids = append(fileCids, node.Cid())
+			return nil
+		})
+		if err != nil {
+			log.Debug("can't walk local file blocks", zap.String("fileID", fileId), zap.Error(err))
+			report.Skipped = append(report.Skipped, fileId)
+		} else {
+			exists, err := f.remoteExistsCids(ctx, spaceId, fileCids)
+			if err != nil {
+				return report, fmt.Errorf("check remote blocks of file %s: %w", fileId, err)
+			}
+			switch len(exists) {
+			case 0:
+				report.Missing = append(report.Missing, fileId)
+			case len(fileCids):
+				report.Synced = append(report.Synced, fileId)
+			default:
+				report.PartiallySynced = append(report.PartiallySynced, fileId)
+			}
+		}
+		if progress != nil {
+			progress(i+1, len(fileIds))
+		}
+	}
+	return report, nil
+}
+
+// listSpaceFiles returns ids of local files, which belong to the space
+func (f *fileSync) listSpaceFiles(spaceId string) ([]string, error) {
+	fileIds, err := f.fileStore.ListTargets()
+	if err != nil {
+		return nil, fmt.Errorf("list local files: %w", err)
+	}
+	spaceFileIds := make([]string, 0, len(fileIds))
+	for _, fileId := range fileIds {
+		fileSpaceId, err := f.spaceIdResolver.ResolveSpaceID(fileId)
+		if err != nil {
+			log.Debug("can't resolve space of file", zap.String("fileID", fileId), zap.Error(err))
+			continue
+		}
+		if fileSpaceId == spaceId {
+			spaceFileIds = append(spaceFileIds, fileId)
+		}
+	}
+	return spaceFileIds, nil
+}
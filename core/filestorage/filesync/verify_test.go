@@ -0,0 +1,92 @@
+package filesync
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/anyproto/any-sync/commonfile/fileproto"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileSync_VerifySpace(t *testing.T) {
+	t.Run("files are grouped by presence of their blocks", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		spaceId := "space1"
+		synced, partial, missing := fx.addRandomFile(t), fx.addRandomFileWithSize(t, 1024*1024), fx.addRandomFile(t)
+		require.NotEmpty(t, partial.Links())
+		remoteCids := map[cid.Cid]struct{}{
+			synced.Cid():           {},
+			partial.Links()[0].Cid: {},
+		}
+		for _, link := range synced.Links() {
+			remoteCids[link.Cid] = struct{}{}
+		}
+		fileIds := []string{synced.Cid().String(), partial.Cid().String(), missing.Cid().String()}
+		fx.fileStoreMock.EXPECT().ListTargets().Return(fileIds, nil)
+		fx.expectRemoteCids(spaceId, remoteCids)
+		var progress [][2]int
+
+		// when
+		report, err := fx.VerifySpace(context.Background(), spaceId, func(checked, total int) {
+			progress = append(progress, [2]int{checked, total})
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{synced.Cid().String()}, report.Synced)
+		assert.Equal(t, []string{partial.Cid().String()}, report.PartiallySynced)
+		assert.Equal(t, []string{missing.Cid().String()}, report.Missing)
+		assert.Empty(t, report.Skipped)
+		assert.Equal(t, 3, report.FilesCount())
+		assert.Equal(t, [][2]int{{1, 3}, {2, 3}, {3, 3}}, progress)
+	})
+	t.Run("verification is stopped on cancel", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		defer fx.Finish(t)
+		file := fx.addRandomFile(t)
+		fx.fileStoreMock.EXPECT().ListTargets().Return([]string{file.Cid().String()}, nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		report, err := fx.VerifySpace(ctx, "space1", nil)
+
+		// then
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, report.FilesCount())
+	})
+}
+
+func (f *fixture) addRandomFileWithSize(t *testing.T, size int) ipld.Node {
+	var buf = make([]byte, size)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	n, err := f.fileService.AddFile(ctx, bytes.NewReader(buf))
+	require.NoError(t, err)
+	return n
+}
+
+func (f *fixture) expectRemoteCids(spaceId string, remoteCids map[cid.Cid]struct{}) {
+	f.rpcStore.EXPECT().CheckAvailability(gomock.Any(), spaceId, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, cids []cid.Cid) ([]*fileproto.BlockAvailability, error) {
+		return lo.Map(cids, func(c cid.Cid, _ int) *fileproto.BlockAvailability {
+			status := fileproto.AvailabilityStatus_NotExists
+			if _, ok := remoteCids[c]; ok {
+				status = fileproto.AvailabilityStatus_ExistsInSpace
+			}
+			return &fileproto.BlockAvailability{
+				Cid:    c.Bytes(),
+				Status: status,
+			}
+		}), nil
+	}).AnyTimes()
+}
@@ -0,0 +1,182 @@
+package filestorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"go.uber.org/zap"
+)
+
+const (
+	gcInterval = 24 * time.Hour
+	// gcGracePeriod protects blocks of files, which are being added right now and are not indexed yet
+	gcGracePeriod = time.Hour
+
+	flatfsBlockExtension = ".data"
+)
+
+// GCStats describes blocks removed by garbage collection
+type GCStats struct {
+	BlocksRemoved int
+	BytesFreed    uint64
+}
+
+// garbageCollector removes blocks of local store, which don't belong to any file known to the file store
+type garbageCollector struct {
+	store *flatStore
+	// path is the directory of flatfs store, blocks are listed from it with their modification time
+	path      string
+	nodes     format.NodeGetter
+	listFiles func() ([]string, error)
+	now       func() time.Time
+}
+
+type gcCandidate struct {
+	cid  cid.Cid
+	size uint64
+}
+
+func (gc *garbageCollector) run(ctx context.Context) (GCStats, error) {
+	var stats GCStats
+	// Blocks are listed before references, so blocks added during the scan are never removed
+	candidates, err := gc.listBlocks(gc.now().Add(-gcGracePeriod))
+	if err != nil {
+		return stats, fmt.Errorf("list blocks: %w", err)
+	}
+	if len(candidates) == 0 {
+		return stats, nil
+	}
+	referenced, err := gc.referencedBlocks(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("collect referenced blocks: %w", err)
+	}
+	for _, c := range candidates {
+		if _, ok := referenced[c.cid]; ok {
+			continue
+		}
+		if err = ctx.Err(); err != nil {
+			return stats, err
+		}
+		if err = gc.store.Delete(ctx, c.cid); err != nil {
+			log.Warn("gc: can't remove block", zap.String("cid", c.cid.String()), zap.Error(err))
+			continue
+		}
+		stats.BlocksRemoved++
+		stats.BytesFreed += c.size
+	}
+	return stats, nil
+}
+
+// listBlocks returns blocks of the store, which are modified before the given time
+func (gc *garbageCollector) listBlocks(before time.Time) ([]gcCandidate, error) {
+	var candidates []gcCandidate
+	err := filepath.WalkDir(gc.path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() || !strings.HasSuffix(name, flatfsBlockExtension) {
+			return nil
+		}
+		// keys of flatfs store are upper-cased CIDs, which are valid in base32upper. CIDv0 can't be restored
+		// from upper-cased base58, so such blocks are kept
+		c, err := cid.Decode(strings.TrimSuffix(name, flatfsBlockExtension))
+		if err != nil {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(before) {
+			candidates = append(candidates, gcCandidate{cid: c, size: uint64(info.Size())})
+		}
+		return nil
+	})
+	return candidates, err
+}
+
+// referencedBlocks walks DAGs of all files. Blocks, which are not stored locally, are skipped with their links
+func (gc *garbageCollector) referencedBlocks(ctx context.Context) (map[cid.Cid]struct{}, error) {
+	fileIds, err := gc.listFiles()
+	if err != nil {
+		return nil, fmt.Errorf("list files: %w", err)
+	}
+	ctx = context.WithValue(ctx, CtxKeyRemoteLoadDisabled, true)
+	referenced := map[cid.Cid]struct{}{}
+	var visit func(c cid.Cid) error
+	visit = func(c cid.Cid) error {
+		if _, ok := referenced[c]; ok {
+			return nil
+		}
+		referenced[c] = struct{}{}
+		node, err := gc.nodes.Get(ctx, c)
+		if format.IsNotFound(err) || errors.Is(err, ErrRemoteLoadDisabled) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("get node %s: %w", c, err)
+		}
+		for _, link := range node.Links() {
+			if err = visit(link.Cid); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, fileId := range fileIds {
+		fileCid, err := cid.Parse(fileId)
+		if err != nil {
+			log.Warn("gc: can't parse file id", zap.String("fileID", fileId), zap.Error(err))
+			continue
+		}
+		if err = visit(fileCid); err != nil {
+			return nil, err
+		}
+	}
+	return referenced, nil
+}
+
+// RunGC removes local blocks, which don't belong to any file, e.g. blocks of deleted files
+func (f *fileStorage) RunGC(ctx context.Context) (GCStats, error) {
+	f.gcLock.Lock()
+	defer f.gcLock.Unlock()
+	gc := &garbageCollector{
+		store:     f.proxy.localStore,
+		path:      f.flatfsPath,
+		nodes:     f.dagService,
+		listFiles: f.fileStore.ListTargets,
+		now:       time.Now,
+	}
+	stats, err := gc.run(ctx)
+	if err != nil {
+		return stats, err
+	}
+	log.Info("gc: done", zap.Int("blocksRemoved", stats.BlocksRemoved), zap.Uint64("bytesFreed", stats.BytesFreed))
+	return stats, nil
+}
+
+func (f *fileStorage) gcLoop(ctx context.Context) {
+	ticker := time.NewTicker(gcInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := f.RunGC(ctx); err != nil {
+				log.Error("gc failed", zap.Error(err))
+			}
+		}
+	}
+}
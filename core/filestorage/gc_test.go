@@ -0,0 +1,124 @@
+package filestorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ipfs/boxo/ipld/merkledag"
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	format "github.com/ipfs/go-ipld-format"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/event/mock_event"
+)
+
+type testNodeGetter map[cid.Cid]format.Node
+
+func (g testNodeGetter) Get(_ context.Context, c cid.Cid) (format.Node, error) {
+	if n, ok := g[c]; ok {
+		return n, nil
+	}
+	return nil, format.ErrNotFound{Cid: c}
+}
+
+func (g testNodeGetter) GetMany(ctx context.Context, cids []cid.Cid) <-chan *format.NodeOption {
+	ch := make(chan *format.NodeOption, len(cids))
+	for _, c := range cids {
+		n, err := g.Get(ctx, c)
+		ch <- &format.NodeOption{Node: n, Err: err}
+	}
+	close(ch)
+	return ch
+}
+
+type gcFixture struct {
+	*garbageCollector
+	nodes testNodeGetter
+	files []string
+}
+
+func newGCFixture(t *testing.T) *gcFixture {
+	sender := mock_event.NewMockSender(t)
+	sender.EXPECT().Broadcast(mock.Anything).Maybe()
+	path := t.TempDir()
+	store, err := newFlatStore(path, sender, time.Second)
+	require.NoError(t, err)
+	fx := &gcFixture{nodes: testNodeGetter{}}
+	fx.garbageCollector = &garbageCollector{
+		store: store,
+		path:  path,
+		nodes: fx.nodes,
+		listFiles: func() ([]string, error) {
+			return fx.files, nil
+		},
+		now: time.Now,
+	}
+	return fx
+}
+
+// addFile stores file of two blocks and returns cids of its blocks
+func (fx *gcFixture) addFile(t *testing.T, data string) []cid.Cid {
+	leaf := merkledag.NewRawNode([]byte(data))
+	root := merkledag.NodeWithData([]byte("root of " + data))
+	require.NoError(t, root.SetCidBuilder(merkledag.V1CidPrefix()))
+	require.NoError(t, root.AddNodeLink("content", leaf))
+	for _, n := range []format.Node{root, leaf} {
+		fx.nodes[n.Cid()] = n
+		require.NoError(t, fx.store.Add(context.Background(), []blocks.Block{n}))
+	}
+	fx.files = append(fx.files, root.Cid().String())
+	return []cid.Cid{root.Cid(), leaf.Cid()}
+}
+
+func (fx *gcFixture) hasBlock(t *testing.T, c cid.Cid) bool {
+	exist, _, err := fx.store.PartitionByExistence(context.Background(), []cid.Cid{c})
+	require.NoError(t, err)
+	return len(exist) == 1
+}
+
+func TestGarbageCollector_Run(t *testing.T) {
+	t.Run("blocks of deleted files are removed", func(t *testing.T) {
+		// given
+		fx := newGCFixture(t)
+		kept := fx.addFile(t, "kept")
+		deleted := fx.addFile(t, "deleted")
+		fx.files = fx.files[:1]
+		fx.now = func() time.Time {
+			return time.Now().Add(2 * gcGracePeriod)
+		}
+
+		// when
+		stats, err := fx.run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.BlocksRemoved)
+		assert.NotZero(t, stats.BytesFreed)
+		for _, c := range kept {
+			assert.True(t, fx.hasBlock(t, c))
+		}
+		for _, c := range deleted {
+			assert.False(t, fx.hasBlock(t, c))
+		}
+	})
+	t.Run("recently added blocks are kept", func(t *testing.T) {
+		// given
+		fx := newGCFixture(t)
+		added := fx.addFile(t, "added")
+		fx.files = nil
+
+		// when
+		stats, err := fx.run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, stats.BlocksRemoved)
+		for _, c := range added {
+			assert.True(t, fx.hasBlock(t, c))
+		}
+	})
+}
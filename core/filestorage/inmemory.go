@@ -103,3 +103,7 @@ func (i *inMemBlockStore) LocalDiskUsage(ctx context.Context) (uint64, error) {
 	}
 	return size, nil
 }
+
+func (i *inMemBlockStore) RunGC(ctx context.Context) (GCStats, error) {
+	return GCStats{}, nil
+}
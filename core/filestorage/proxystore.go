@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/anyproto/any-sync/commonfile/fileblockstore"
 	"github.com/dgraph-io/badger/v3"
 	dshelp "github.com/ipfs/boxo/datastore/dshelp"
 	blocks "github.com/ipfs/go-block-format"
@@ -24,6 +25,7 @@ type proxyStore struct {
 	origin     rpcstore.RpcStore
 
 	oldStore *badger.DB
+	limiter  DownloadLimiter
 }
 
 func (c *proxyStore) Get(ctx context.Context, k cid.Cid) (b blocks.Block, err error) {
@@ -54,9 +56,20 @@ func (c *proxyStore) Get(ctx context.Context, k cid.Cid) (b blocks.Block, err er
 	if addErr := c.localStore.Add(ctx, []blocks.Block{b}); addErr != nil {
 		log.Error("block fetched from origin but got error for add to localStore", zap.Error(addErr))
 	}
+	if err = c.waitDownload(ctx, b); err != nil {
+		return nil, err
+	}
 	return
 }
 
+// waitDownload delays the next remote read, so downloads of the space don't exceed its bandwidth limit
+func (c *proxyStore) waitDownload(ctx context.Context, b blocks.Block) error {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.WaitDownload(ctx, fileblockstore.CtxGetSpaceId(ctx), len(b.RawData()))
+}
+
 func (c *proxyStore) getFromOldStore(k cid.Cid) (blocks.Block, error) {
 	if c.oldStore == nil {
 		return nil, fmt.Errorf("old store is not used")
@@ -126,6 +139,9 @@ func (c *proxyStore) GetMany(ctx context.Context, ks []cid.Cid) <-chan blocks.Bl
 					if addErr := c.localStore.Add(ctx, []blocks.Block{ob}); addErr != nil {
 						log.Error("add block to localStore error", zap.Error(addErr))
 					}
+					if waitErr := c.waitDownload(ctx, ob); waitErr != nil {
+						return
+					}
 					results <- ob
 				}
 			case <-ctx.Done():
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/findreplace"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) FindReplaceSearch(cctx context.Context, req *pb.RpcFindReplaceSearchRequest) *pb.RpcFindReplaceSearchResponse {
+	response := func(code pb.RpcFindReplaceSearchResponseErrorCode, err error, matches []*pb.RpcFindReplaceMatch, limitReached bool) *pb.RpcFindReplaceSearchResponse {
+		m := &pb.RpcFindReplaceSearchResponse{
+			Error:        &pb.RpcFindReplaceSearchResponseError{Code: code},
+			Matches:      matches,
+			LimitReached: limitReached,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	matches, limitReached, err := getService[findreplace.Service](mw).Search(cctx, req)
+	switch {
+	case err == nil:
+		return response(pb.RpcFindReplaceSearchResponseError_NULL, nil, matches, limitReached)
+	case errors.Is(err, findreplace.ErrBadInput):
+		return response(pb.RpcFindReplaceSearchResponseError_BAD_INPUT, err, nil, false)
+	case errors.Is(err, context.Canceled):
+		return response(pb.RpcFindReplaceSearchResponseError_CANCELED, err, nil, false)
+	default:
+		return response(pb.RpcFindReplaceSearchResponseError_UNKNOWN_ERROR, err, nil, false)
+	}
+}
+
+func (mw *Middleware) FindReplaceApply(cctx context.Context, req *pb.RpcFindReplaceApplyRequest) *pb.RpcFindReplaceApplyResponse {
+	response := func(code pb.RpcFindReplaceApplyResponseErrorCode, err error, result findreplace.ApplyResult) *pb.RpcFindReplaceApplyResponse {
+		m := &pb.RpcFindReplaceApplyResponse{
+			Error:           &pb.RpcFindReplaceApplyResponseError{Code: code},
+			RunId:           result.RunID,
+			ChangedObjects:  result.ChangedObjects,
+			ChangedBlocks:   result.ChangedBlocks,
+			FailedObjectIds: result.FailedObjectIds,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	// result is returned on cancel too, so changes made before cancellation can be undone
+	result, err := getService[findreplace.Service](mw).Apply(cctx, req)
+	switch {
+	case err == nil:
+		return response(pb.RpcFindReplaceApplyResponseError_NULL, nil, result)
+	case errors.Is(err, findreplace.ErrBadInput):
+		return response(pb.RpcFindReplaceApplyResponseError_BAD_INPUT, err, result)
+	case errors.Is(err, context.Canceled):
+		return response(pb.RpcFindReplaceApplyResponseError_CANCELED, err, result)
+	default:
+		return response(pb.RpcFindReplaceApplyResponseError_UNKNOWN_ERROR, err, result)
+	}
+}
+
+func (mw *Middleware) FindReplaceUndo(cctx context.Context, req *pb.RpcFindReplaceUndoRequest) *pb.RpcFindReplaceUndoResponse {
+	response := func(code pb.RpcFindReplaceUndoResponseErrorCode, err error, result findreplace.UndoResult) *pb.RpcFindReplaceUndoResponse {
+		m := &pb.RpcFindReplaceUndoResponse{
+			Error:           &pb.RpcFindReplaceUndoResponseError{Code: code},
+			RestoredBlocks:  result.RestoredBlocks,
+			FailedObjectIds: result.FailedObjectIds,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.RunId == "" {
+		return response(pb.RpcFindReplaceUndoResponseError_BAD_INPUT, errors.New("run id is empty"), findreplace.UndoResult{})
+	}
+	result, err := getService[findreplace.Service](mw).Undo(cctx, req.RunId)
+	switch {
+	case err == nil:
+		return response(pb.RpcFindReplaceUndoResponseError_NULL, nil, result)
+	case errors.Is(err, findreplace.ErrNotFound):
+		return response(pb.RpcFindReplaceUndoResponseError_NOT_FOUND, err, result)
+	default:
+		return response(pb.RpcFindReplaceUndoResponseError_UNKNOWN_ERROR, err, result)
+	}
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) ObjectGraphNeighborhood(cctx context.Context, req *pb.RpcObjectGraphNeighborhoodRequest) *pb.RpcObjectGraphNeighborhoodResponse {
+	response := func(code pb.RpcObjectGraphNeighborhoodResponseErrorCode, nodes []*types.Struct, edges []*pb.RpcObjectGraphEdge, err error) *pb.RpcObjectGraphNeighborhoodResponse {
+		m := &pb.RpcObjectGraphNeighborhoodResponse{
+			Error: &pb.RpcObjectGraphNeighborhoodResponseError{Code: code},
+			Nodes: nodes,
+			Edges: edges,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	nodes, edges, err := getService[objectgraph.Index](mw).Neighborhood(req)
+	switch {
+	case errors.Is(err, objectgraph.ErrBadInput):
+		return response(pb.RpcObjectGraphNeighborhoodResponseError_BAD_INPUT, nil, nil, err)
+	case errors.Is(err, objectgraph.ErrNotFound):
+		return response(pb.RpcObjectGraphNeighborhoodResponseError_NOT_FOUND, nil, nil, err)
+	case err != nil:
+		return response(pb.RpcObjectGraphNeighborhoodResponseError_UNKNOWN_ERROR, nil, nil, err)
+	}
+	return response(pb.RpcObjectGraphNeighborhoodResponseError_NULL, nodes, edges, nil)
+}
+
+func (mw *Middleware) ObjectGraphPath(cctx context.Context, req *pb.RpcObjectGraphPathRequest) *pb.RpcObjectGraphPathResponse {
+	response := func(code pb.RpcObjectGraphPathResponseErrorCode, nodes []*types.Struct, edges []*pb.RpcObjectGraphEdge, err error) *pb.RpcObjectGraphPathResponse {
+		m := &pb.RpcObjectGraphPathResponse{
+			Error: &pb.RpcObjectGraphPathResponseError{Code: code},
+			Nodes: nodes,
+			Edges: edges,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	nodes, edges, err := getService[objectgraph.Index](mw).Path(req)
+	switch {
+	case errors.Is(err, objectgraph.ErrBadInput):
+		return response(pb.RpcObjectGraphPathResponseError_BAD_INPUT, nil, nil, err)
+	case errors.Is(err, objectgraph.ErrNotFound):
+		return response(pb.RpcObjectGraphPathResponseError_NOT_FOUND, nil, nil, err)
+	case errors.Is(err, objectgraph.ErrNoPath):
+		return response(pb.RpcObjectGraphPathResponseError_NO_PATH, nil, nil, err)
+	case err != nil:
+		return response(pb.RpcObjectGraphPathResponseError_UNKNOWN_ERROR, nil, nil, err)
+	}
+	return response(pb.RpcObjectGraphPathResponseError_NULL, nodes, edges, nil)
+}
+
+func (mw *Middleware) ObjectGraphOrphans(cctx context.Context, req *pb.RpcObjectGraphOrphansRequest) *pb.RpcObjectGraphOrphansResponse {
+	response := func(code pb.RpcObjectGraphOrphansResponseErrorCode, records []*types.Struct, err error) *pb.RpcObjectGraphOrphansResponse {
+		m := &pb.RpcObjectGraphOrphansResponse{
+			Error:   &pb.RpcObjectGraphOrphansResponseError{Code: code},
+			Records: records,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	records, err := getService[objectgraph.Index](mw).Orphans(req)
+	switch {
+	case errors.Is(err, objectgraph.ErrBadInput):
+		return response(pb.RpcObjectGraphOrphansResponseError_BAD_INPUT, nil, err)
+	case err != nil:
+		return response(pb.RpcObjectGraphOrphansResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	return response(pb.RpcObjectGraphOrphansResponseError_NULL, records, nil)
+}
@@ -2,9 +2,11 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	history2 "github.com/anyproto/anytype-heart/core/block/history"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/history"
@@ -119,3 +121,39 @@ func (mw *Middleware) HistorySetVersion(cctx context.Context, req *pb.RpcHistory
 		}, req.VersionId)
 	}))
 }
+
+func (mw *Middleware) ObjectHistoryRevert(cctx context.Context, req *pb.RpcObjectHistoryRevertRequest) *pb.RpcObjectHistoryRevertResponse {
+	response := func(code pb.RpcObjectHistoryRevertResponseErrorCode, err error) *pb.RpcObjectHistoryRevertResponse {
+		m := &pb.RpcObjectHistoryRevertResponse{Error: &pb.RpcObjectHistoryRevertResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+	if req.ObjectId == "" || req.VersionId == "" {
+		return response(pb.RpcObjectHistoryRevertResponseError_BAD_INPUT, fmt.Errorf("object id and version id are required"))
+	}
+
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		return hs.Revert(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.VersionId, history2.Scope{
+			BlockIDs:     req.GetScope().GetBlockIds(),
+			RelationKeys: req.GetScope().GetRelationKeys(),
+		})
+	})
+	if errors.Is(err, history2.ErrNotFound) {
+		return response(pb.RpcObjectHistoryRevertResponseError_NOT_FOUND, err)
+	}
+	if err != nil {
+		return response(pb.RpcObjectHistoryRevertResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectHistoryRevertResponseError_NULL, nil)
+}
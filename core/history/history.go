@@ -44,6 +44,7 @@ type History interface {
 	Show(id domain.FullID, versionId string) (bs *model.ObjectView, ver *pb.RpcHistoryVersion, err error)
 	Versions(id domain.FullID, lastVersionId string, limit int) (resp []*pb.RpcHistoryVersion, err error)
 	SetVersion(id domain.FullID, versionId string) (err error)
+	Revert(id domain.FullID, versionId string, scope history2.Scope) (err error)
 	app.Component
 }
 
@@ -188,6 +189,21 @@ func (h *history) SetVersion(id domain.FullID, versionId string) (err error) {
 	})
 }
 
+// Revert restores blocks and relations of the scope from the version as a new change, which can be undone
+func (h *history) Revert(id domain.FullID, versionId string, scope history2.Scope) (err error) {
+	version, _, _, err := h.buildState(id, versionId)
+	if err != nil {
+		return
+	}
+	return block.Do(h.picker, id.ObjectID, func(sb smartblock2.SmartBlock) error {
+		st := sb.NewState()
+		if err := history2.Revert(st, version, scope); err != nil {
+			return err
+		}
+		return sb.Apply(st)
+	})
+}
+
 func (h *history) treeWithId(id domain.FullID, beforeId string, includeBeforeId bool) (ht objecttree.HistoryTree, sbt smartblock.SmartBlockType, err error) {
 	spc, err := h.spaceService.Get(context.Background(), id.SpaceID)
 	if err != nil {
@@ -0,0 +1,81 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/filetext"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+)
+
+const (
+	attachmentWorkers   = 2
+	attachmentQueueSize = 100
+	attachmentTimeout   = time.Minute
+)
+
+// queueAttachment sends the document of the file object to the workers extracting its text. It returns false,
+// if the queue is full, so the object stays in the full-text queue till the next run
+func (i *indexer) queueAttachment(doc ftsearch.SearchDoc) bool {
+	select {
+	case i.attachments <- doc:
+		return true
+	default:
+		return false
+	}
+}
+
+func (i *indexer) attachmentWorker() {
+	for {
+		select {
+		case <-i.quit:
+			return
+		case doc := <-i.attachments:
+			i.indexAttachment(doc)
+		}
+	}
+}
+
+// indexAttachment indexes the file object with the text of the file. Object is indexed without text, if
+// the file can't be read or its format is not supported
+func (i *indexer) indexAttachment(doc ftsearch.SearchDoc) {
+	ctx, cancel := context.WithTimeout(context.Background(), attachmentTimeout)
+	defer cancel()
+
+	text, err := i.extractAttachmentText(ctx, doc)
+	if err != nil && !errors.Is(err, filetext.ErrUnsupported) && !errors.Is(err, filetext.ErrTooLarge) {
+		log.With("id", doc.Id).Warnf("extract text of file: %s", err)
+	}
+	doc.Text = text
+	if err = i.ftsearch.Index(doc); err != nil {
+		log.With("id", doc.Id).Errorf("full-text indexing of file: %s", err)
+		return
+	}
+	if i.semantic != nil {
+		i.semantic.Index(doc)
+	}
+	if i.duplicates != nil {
+		i.duplicates.Index(doc)
+	}
+}
+
+func (i *indexer) extractAttachmentText(ctx context.Context, doc ftsearch.SearchDoc) (string, error) {
+	file, err := i.fileService.FileByHash(ctx, domain.FullID{SpaceID: doc.SpaceID, ObjectID: doc.Id})
+	if err != nil {
+		return "", err
+	}
+	meta := file.Meta()
+	if !filetext.IsSupported(meta.Media, meta.Name) {
+		return "", filetext.ErrUnsupported
+	}
+	if meta.Size > filetext.MaxFileSize {
+		return "", filetext.ErrTooLarge
+	}
+	r, err := file.Reader(ctx)
+	if err != nil {
+		return "", err
+	}
+	return filetext.Extract(r, meta.Size, meta.Media, meta.Name)
+}
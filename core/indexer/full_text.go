@@ -3,13 +3,16 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	smartblock2 "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
@@ -52,14 +55,25 @@ func (i *indexer) runFullTextIndexer() {
 		return
 	}
 
-	var docs []ftsearch.SearchDoc
+	var (
+		docs      []ftsearch.SearchDoc
+		processed = make([]string, 0, len(ids))
+	)
 	for _, id := range ids {
-		doc, err := i.prepareSearchDocument(id)
+		doc, isAttachment, err := i.prepareSearchDocument(id)
 		if err != nil {
 			log.With("id", id).Errorf("prepare document for full-text indexing: %s", err)
 			continue
 		}
+		if isAttachment {
+			// text of files is extracted in background, as it can take a while
+			if i.queueAttachment(doc) {
+				processed = append(processed, id)
+			}
+			continue
+		}
 		docs = append(docs, doc)
+		processed = append(processed, id)
 	}
 
 	err = i.ftsearch.BatchIndex(docs)
@@ -67,11 +81,19 @@ func (i *indexer) runFullTextIndexer() {
 		log.Errorf("full-text indexing: %v", err)
 		return
 	}
+	if i.semantic != nil {
+		i.semantic.Index(docs...)
+	}
+	if i.duplicates != nil {
+		i.duplicates.Index(docs...)
+	}
 
-	i.store.RemoveIDsFromFullTextQueue(ids)
+	i.store.RemoveIDsFromFullTextQueue(processed)
 }
 
-func (i *indexer) prepareSearchDocument(id string) (ftDoc ftsearch.SearchDoc, err error) {
+// prepareSearchDocument returns the document of the object. isAttachment is true for file objects, which text
+// should be extracted from the file
+func (i *indexer) prepareSearchDocument(id string) (ftDoc ftsearch.SearchDoc, isAttachment bool, err error) {
 	// ctx := context.WithValue(context.Background(), ocache.CacheTimeout, cacheTimeout)
 	ctx := context.WithValue(context.Background(), metrics.CtxKeyEntrypoint, "index_fulltext")
 	err = block.DoContext(i.picker, ctx, id, func(sb smartblock2.SmartBlock) error {
@@ -89,12 +111,20 @@ func (i *indexer) prepareSearchDocument(id string) (ftDoc ftsearch.SearchDoc, er
 			title = sb.Snippet()
 		}
 
+		text := sb.SearchText()
+		// text recognized in images is stored in local details
+		if ocrText := pbtypes.GetString(sb.CombinedDetails(), bundle.RelationKeyOcrText.String()); ocrText != "" {
+			text = strings.TrimSpace(text + "\n" + ocrText)
+		}
+
 		ftDoc = ftsearch.SearchDoc{
 			Id:      id,
 			SpaceID: sb.SpaceID(),
 			Title:   title,
-			Text:    sb.SearchText(),
+			Text:    text,
 		}
+		isAttachment = sb.Type() == coresb.SmartBlockTypeFile &&
+			model.ObjectTypeLayout(pbtypes.GetInt64(sb.Details(), bundle.RelationKeyLayout.String())) == model.ObjectType_file
 		return nil
 	})
 
@@ -19,7 +19,9 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/source"
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/duplicate"
 	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/semantic"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
@@ -47,6 +49,7 @@ type Indexer interface {
 	StartFullTextIndex() error
 	ReindexMarketplaceSpace(space space.Space) error
 	ReindexSpace(space space.Space) error
+	ForceReindexSpace(space space.Space) error
 	RemoveIndexes(spaceId string) (err error)
 	Index(ctx context.Context, info smartblock.DocInfo, options ...smartblock.IndexOption) error
 	app.ComponentRunnable
@@ -64,11 +67,19 @@ type indexer struct {
 	ftsearch       ftsearch.FTSearch
 	storageService storage.ClientStorage
 	fileService    files.Service
+	// semantic is the optional service keeping vectors of indexed documents
+	semantic semantic.Service
+	// duplicates is the optional service keeping fingerprints of indexed documents
+	duplicates duplicate.Service
 
 	quit       chan struct{}
 	btHash     Hasher
 	newAccount bool
-	forceFt    chan struct{}
+	// readOnly disables writes to the indexes, when account is opened in read-only mode
+	readOnly bool
+	forceFt  chan struct{}
+	// attachments is the queue of file objects, which text is extracted by background workers
+	attachments chan ftsearch.SearchDoc
 
 	indexedFiles     *sync.Map
 	reindexLogFields []zap.Field
@@ -77,7 +88,9 @@ type indexer struct {
 }
 
 func (i *indexer) Init(a *app.App) (err error) {
-	i.newAccount = a.MustComponent(config.CName).(*config.Config).NewAccount
+	cfg := a.MustComponent(config.CName).(*config.Config)
+	i.newAccount = cfg.NewAccount
+	i.readOnly = cfg.ReadOnly
 	i.store = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
 	i.storageService = a.MustComponent(spacestorage.CName).(storage.ClientStorage)
 	i.source = a.MustComponent(source.CName).(source.Service)
@@ -86,8 +99,11 @@ func (i *indexer) Init(a *app.App) (err error) {
 	i.ftsearch = app.MustComponent[ftsearch.FTSearch](a)
 	i.picker = app.MustComponent[block.ObjectGetter](a)
 	i.fileService = app.MustComponent[files.Service](a)
+	i.semantic, _ = a.Component(semantic.CName).(semantic.Service)
+	i.duplicates, _ = a.Component(duplicate.CName).(duplicate.Service)
 	i.quit = make(chan struct{})
 	i.forceFt = make(chan struct{})
+	i.attachments = make(chan ftsearch.SearchDoc, attachmentQueueSize)
 	return
 }
 
@@ -100,10 +116,16 @@ func (i *indexer) Run(context.Context) (err error) {
 }
 
 func (i *indexer) StartFullTextIndex() (err error) {
+	if i.readOnly {
+		return
+	}
 	if ftErr := i.ftInit(); ftErr != nil {
 		log.Errorf("can't init ft: %v", ftErr)
 	}
 	go i.ftLoop()
+	for n := 0; n < attachmentWorkers; n++ {
+		go i.attachmentWorker()
+	}
 	return
 }
 
@@ -113,6 +135,9 @@ func (i *indexer) Close(ctx context.Context) (err error) {
 }
 
 func (i *indexer) Index(ctx context.Context, info smartblock.DocInfo, options ...smartblock.IndexOption) error {
+	if i.readOnly {
+		return nil
+	}
 	// options are stored in smartblock pkg because of cyclic dependency :(
 	startTime := time.Now()
 	opts := &smartblock.IndexOptions{}
@@ -166,6 +191,10 @@ func (i *indexer) Index(ctx context.Context, info smartblock.DocInfo, options ..
 			hasError = true
 			log.With("objectID", info.Id).Errorf("failed to save object links: %v", err)
 		}
+		if err = i.store.UpdateObjectBacklinks(info.Id, info.LinkSources); err != nil {
+			hasError = true
+			log.With("objectID", info.Id).Errorf("failed to save object backlinks: %v", err)
+		}
 	}
 
 	indexLinksTime := time.Now()
@@ -101,6 +101,48 @@ func (_c *MockIndexer_ForceFTIndex_Call) RunAndReturn(run func()) *MockIndexer_F
 	return _c
 }
 
+// ForceReindexSpace provides a mock function with given fields: _a0
+func (_m *MockIndexer) ForceReindexSpace(_a0 space.Space) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(space.Space) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockIndexer_ForceReindexSpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceReindexSpace'
+type MockIndexer_ForceReindexSpace_Call struct {
+	*mock.Call
+}
+
+// ForceReindexSpace is a helper method to define mock.On call
+//   - _a0 space.Space
+func (_e *MockIndexer_Expecter) ForceReindexSpace(_a0 interface{}) *MockIndexer_ForceReindexSpace_Call {
+	return &MockIndexer_ForceReindexSpace_Call{Call: _e.mock.On("ForceReindexSpace", _a0)}
+}
+
+func (_c *MockIndexer_ForceReindexSpace_Call) Run(run func(_a0 space.Space)) *MockIndexer_ForceReindexSpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(space.Space))
+	})
+	return _c
+}
+
+func (_c *MockIndexer_ForceReindexSpace_Call) Return(_a0 error) *MockIndexer_ForceReindexSpace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockIndexer_ForceReindexSpace_Call) RunAndReturn(run func(space.Space) error) *MockIndexer_ForceReindexSpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Index provides a mock function with given fields: ctx, info, options
 func (_m *MockIndexer) Index(ctx context.Context, info smartblock.DocInfo, options ...smartblock.IndexOption) error {
 	_va := make([]interface{}, len(options))
@@ -26,7 +26,7 @@ import (
 
 const (
 	// ForceObjectsReindexCounter reindex thread-based objects
-	ForceObjectsReindexCounter int32 = 8
+	ForceObjectsReindexCounter int32 = 9
 
 	// ForceFilesReindexCounter reindex ipfs-file-based objects
 	ForceFilesReindexCounter int32 = 11 //
@@ -106,10 +106,25 @@ func (i *indexer) buildFlags(spaceID string) (reindexFlags, error) {
 }
 
 func (i *indexer) ReindexSpace(space space.Space) (err error) {
+	if i.readOnly {
+		return nil
+	}
 	flags, err := i.buildFlags(space.Id())
 	if err != nil {
 		return
 	}
+	return i.reindexSpace(space, flags)
+}
+
+// ForceReindexSpace reindexes objects, files and fulltext of the space regardless of the stored checksums
+func (i *indexer) ForceReindexSpace(space space.Space) error {
+	if i.readOnly {
+		return nil
+	}
+	return i.reindexSpace(space, reindexFlags{objects: true, fileObjects: true, fulltext: true})
+}
+
+func (i *indexer) reindexSpace(space space.Space, flags reindexFlags) (err error) {
 	err = i.removeCommonIndexes(space.Id(), flags)
 	if err != nil {
 		return fmt.Errorf("remove common indexes: %w", err)
@@ -212,6 +227,9 @@ func (i *indexer) ReindexSpace(space space.Space) (err error) {
 }
 
 func (i *indexer) ReindexMarketplaceSpace(space space.Space) error {
+	if i.readOnly {
+		return nil
+	}
 	flags, err := i.buildFlags(space.Id())
 	if err != nil {
 		return err
@@ -0,0 +1,125 @@
+// Package localapi is HTTP gateway to the subset of commands of the middleware, so scripts and third-party tools
+// are integrated without the generated clients. Every endpoint takes POST request with JSON body of the command
+// request and answers with JSON of the command response:
+//
+//	POST /v1/objects/search - ObjectSearch
+//	POST /v1/objects/show   - ObjectShow
+//	POST /v1/objects/create - ObjectCreate
+//	POST /v1/objects/export - ObjectListExport
+//
+// Requests are authorized by the token in the "Authorization: Bearer <token>" header.
+// Errors of commands are returned in the error field of the response, like in gRPC API
+package localapi
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+)
+
+var log = logging.Logger("anytype-localapi")
+
+const bearerPrefix = "Bearer "
+
+// maxRequestSize limits body of the request
+const maxRequestSize = 10 * 1024 * 1024
+
+// ErrNotLoopback is returned for address of the API, which is reachable from other hosts
+var ErrNotLoopback = errors.New("local api address should be loopback ip or localhost")
+
+// CheckAddr checks that the API is listened only on loopback interface, so the token isn't exposed to the network
+func CheckAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrNotLoopback, addr)
+}
+
+type Server struct {
+	token string
+	mux   *http.ServeMux
+}
+
+// New returns the handler of API over commands. Every request should have token
+func New(commands service.ClientCommandsServer, token string) *Server {
+	s := &Server{token: token, mux: http.NewServeMux()}
+	s.mux.Handle("/v1/objects/search", commandHandler(commands.ObjectSearch))
+	s.mux.Handle("/v1/objects/show", commandHandler(commands.ObjectShow))
+	s.mux.Handle("/v1/objects/create", commandHandler(commands.ObjectCreate))
+	s.mux.Handle("/v1/objects/export", commandHandler(commands.ObjectListExport))
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if s.token == "" || !strings.HasPrefix(header, bearerPrefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, bearerPrefix)), []byte(s.token)) == 1
+}
+
+type request[T any] interface {
+	*T
+	proto.Message
+}
+
+// commandHandler decodes request of the command from JSON body and writes response of the command as JSON
+func commandHandler[T any, Req request[T], Resp proto.Message](command func(context.Context, Req) Resp) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		req := Req(new(T))
+		unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+		if err := unmarshaler.Unmarshal(http.MaxBytesReader(w, r.Body, maxRequestSize), req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request: %s", err), http.StatusBadRequest)
+			return
+		}
+		resp, err := call(r.Context(), command, req)
+		if err != nil {
+			log.Errorf("command %s: %s", r.URL.Path, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err = (&jsonpb.Marshaler{}).Marshal(w, resp); err != nil {
+			log.Errorf("write response of %s: %s", r.URL.Path, err)
+		}
+	})
+}
+
+// call runs the command and converts its panic, e.g. when account isn't selected, to error
+func call[Req, Resp any](ctx context.Context, command func(context.Context, Req) Resp, req Req) (resp Resp, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("command failed: %v", r)
+		}
+	}()
+	return command(ctx, req), nil
+}
@@ -0,0 +1,101 @@
+package localapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+)
+
+type fakeCommands struct {
+	service.ClientCommandsServer
+	searchRequest *pb.RpcObjectSearchRequest
+}
+
+func (f *fakeCommands) ObjectSearch(_ context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
+	f.searchRequest = req
+	return &pb.RpcObjectSearchResponse{Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL}}
+}
+
+func (f *fakeCommands) ObjectShow(context.Context, *pb.RpcObjectShowRequest) *pb.RpcObjectShowResponse {
+	panic("not logged in")
+}
+
+func TestServer(t *testing.T) {
+	newRequest := func(path, token, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+
+	t.Run("command is called with request from body", func(t *testing.T) {
+		// given
+		commands := &fakeCommands{}
+		s := New(commands, "token")
+		w := httptest.NewRecorder()
+
+		// when
+		s.ServeHTTP(w, newRequest("/v1/objects/search", "token", `{"fullText":"note","limit":5}`))
+
+		// then
+		require.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, commands.searchRequest)
+		assert.Equal(t, "note", commands.searchRequest.FullText)
+		assert.Equal(t, int32(5), commands.searchRequest.Limit)
+		assert.JSONEq(t, `{"error":{}}`, w.Body.String())
+	})
+	t.Run("request without token is rejected", func(t *testing.T) {
+		// given
+		commands := &fakeCommands{}
+		s := New(commands, "token")
+		w := httptest.NewRecorder()
+
+		// when
+		s.ServeHTTP(w, newRequest("/v1/objects/search", "other", `{}`))
+
+		// then
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Nil(t, commands.searchRequest)
+	})
+	t.Run("invalid body", func(t *testing.T) {
+		// given
+		s := New(&fakeCommands{}, "token")
+		w := httptest.NewRecorder()
+
+		// when
+		s.ServeHTTP(w, newRequest("/v1/objects/search", "token", `{"limit":"many"}`))
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("panic of command", func(t *testing.T) {
+		// given
+		s := New(&fakeCommands{}, "token")
+		w := httptest.NewRecorder()
+
+		// when
+		s.ServeHTTP(w, newRequest("/v1/objects/show", "token", `{"objectId":"id"}`))
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestCheckAddr(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1:31010", "[::1]:31010", "localhost:31010", "127.0.0.2:0"} {
+		assert.NoError(t, CheckAddr(addr), addr)
+	}
+	for _, addr := range []string{"0.0.0.0:31010", ":31010", "192.168.1.2:31010", "[::]:31010", "example.com:31010"} {
+		assert.ErrorIs(t, CheckAddr(addr), ErrNotLoopback, addr)
+	}
+	assert.Error(t, CheckAddr("127.0.0.1"))
+}
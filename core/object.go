@@ -14,8 +14,11 @@ import (
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/anyproto/anytype-heart/core/block"
+	"github.com/anyproto/anytype-heart/core/block/blocktransform"
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/import/converter"
+	"github.com/anyproto/anytype-heart/core/block/import/plugin"
+	"github.com/anyproto/anytype-heart/core/block/import/source"
 	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
 	"github.com/anyproto/anytype-heart/core/block/object/objectgraph"
 	"github.com/anyproto/anytype-heart/core/indexer"
@@ -23,6 +26,7 @@ import (
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space"
@@ -95,8 +99,8 @@ func (mw *Middleware) ObjectListDuplicate(cctx context.Context, req *pb.RpcObjec
 }
 
 func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
-	response := func(code pb.RpcObjectSearchResponseErrorCode, records []*types.Struct, err error) *pb.RpcObjectSearchResponse {
-		m := &pb.RpcObjectSearchResponse{Error: &pb.RpcObjectSearchResponseError{Code: code}, Records: records}
+	response := func(code pb.RpcObjectSearchResponseErrorCode, records []*types.Struct, highlights []*pb.RpcObjectSearchHighlight, scores []*pb.RpcObjectSearchScore, err error) *pb.RpcObjectSearchResponse {
+		m := &pb.RpcObjectSearchResponse{Error: &pb.RpcObjectSearchResponseError{Code: code}, Records: records, Highlights: highlights, Scores: scores}
 		if err != nil {
 			m.Error.Description = err.Error()
 		}
@@ -105,7 +109,7 @@ func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearch
 	}
 
 	if mw.applicationService.GetApp() == nil {
-		return response(pb.RpcObjectSearchResponseError_BAD_INPUT, nil, fmt.Errorf("account must be started"))
+		return response(pb.RpcObjectSearchResponseError_BAD_INPUT, nil, nil, nil, fmt.Errorf("account must be started"))
 	}
 
 	if req.FullText != "" {
@@ -121,14 +125,14 @@ func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearch
 		FullText: req.FullText,
 	})
 	if err != nil {
-		return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, err)
+		return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, nil, nil, err)
 	}
 
 	// Add dates only to the first page of search results
 	if req.Offset == 0 {
 		records, err = mw.enrichWithDateSuggestion(cctx, records, req, ds)
 		if err != nil {
-			return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, err)
+			return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, nil, nil, err)
 		}
 	}
 
@@ -137,7 +141,78 @@ func (mw *Middleware) ObjectSearch(cctx context.Context, req *pb.RpcObjectSearch
 		records2 = append(records2, pbtypes.Map(rec.Details, req.Keys...))
 	}
 
-	return response(pb.RpcObjectSearchResponseError_NULL, records2, nil)
+	// query is parsed like in the object store, so invalid queries are highlighted and scored as plain text
+	qry, _ := ftsearch.ParseFullText(req.FullText, objectstore.IsFullTextField(ds))
+	var highlights []*pb.RpcObjectSearchHighlight
+	if req.WithHighlights && req.FullText != "" {
+		highlights, err = searchHighlights(ds.FTSearch(), qry, records)
+		if err != nil {
+			return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, nil, nil, err)
+		}
+	}
+
+	var scores []*pb.RpcObjectSearchScore
+	if req.WithScores && req.FullText != "" {
+		scores, err = searchScores(ds.FTSearch(), qry, records)
+		if err != nil {
+			return response(pb.RpcObjectSearchResponseError_UNKNOWN_ERROR, nil, nil, nil, err)
+		}
+	}
+
+	return response(pb.RpcObjectSearchResponseError_NULL, records2, highlights, scores, nil)
+}
+
+func searchScores(fts ftsearch.FTSearch, qry *ftsearch.Query, records []database.Record) ([]*pb.RpcObjectSearchScore, error) {
+	if fts == nil {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	found, err := fts.Scores(qry, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get scores: %w", err)
+	}
+	scores := make([]*pb.RpcObjectSearchScore, 0, len(found))
+	// keep order of records
+	for _, id := range ids {
+		if score, ok := found[id]; ok {
+			scores = append(scores, &pb.RpcObjectSearchScore{ObjectId: id, Score: score})
+		}
+	}
+	return scores, nil
+}
+
+func searchHighlights(fts ftsearch.FTSearch, qry *ftsearch.Query, records []database.Record) ([]*pb.RpcObjectSearchHighlight, error) {
+	if fts == nil {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(records))
+	for _, rec := range records {
+		ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+	}
+	found, err := fts.Highlights(qry, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get highlights: %w", err)
+	}
+	var highlights []*pb.RpcObjectSearchHighlight
+	// keep order of records
+	for _, id := range ids {
+		for _, h := range found[id] {
+			ranges := make([]*model.Range, 0, len(h.Ranges))
+			for _, r := range h.Ranges {
+				ranges = append(ranges, &model.Range{From: r.From, To: r.To})
+			}
+			highlights = append(highlights, &pb.RpcObjectSearchHighlight{
+				ObjectId:    id,
+				RelationKey: h.Field,
+				Fragment:    h.Fragment,
+				Ranges:      ranges,
+			})
+		}
+	}
+	return highlights, nil
 }
 
 func (mw *Middleware) enrichWithDateSuggestion(ctx context.Context, records []database.Record, req *pb.RpcObjectSearchRequest, store objectstore.ObjectStore) ([]database.Record, error) {
@@ -507,6 +582,9 @@ func (mw *Middleware) ObjectSetObjectType(cctx context.Context, req *pb.RpcObjec
 	}
 
 	if err := mw.doBlockService(func(bs *block.Service) (err error) {
+		if req.ApplyDefaultTemplate {
+			return bs.SetObjectTypeWithTemplate(ctx, req.ContextId, req.ObjectTypeUniqueKey, req.TemplateStrategy)
+		}
 		return bs.SetObjectTypes(ctx, req.ContextId, []string{req.ObjectTypeUniqueKey})
 	}); err != nil {
 		return response(pb.RpcObjectSetObjectTypeResponseError_UNKNOWN_ERROR, err)
@@ -549,6 +627,64 @@ func (mw *Middleware) ObjectListSetObjectType(cctx context.Context, req *pb.RpcO
 	return response(pb.RpcObjectListSetObjectTypeResponseError_NULL, nil)
 }
 
+func (mw *Middleware) ObjectListSetDetails(cctx context.Context, req *pb.RpcObjectListSetDetailsRequest) *pb.RpcObjectListSetDetailsResponse {
+	ctx := mw.newContext(cctx)
+	var failedObjectIds []string
+	response := func(code pb.RpcObjectListSetDetailsResponseErrorCode, err error) *pb.RpcObjectListSetDetailsResponse {
+		m := &pb.RpcObjectListSetDetailsResponse{
+			Error:           &pb.RpcObjectListSetDetailsResponseError{Code: code},
+			FailedObjectIds: failedObjectIds,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Event = mw.getResponseEvent(ctx)
+		}
+		return m
+	}
+	if len(req.Details) == 0 {
+		return response(pb.RpcObjectListSetDetailsResponseError_BAD_INPUT, fmt.Errorf("details are empty"))
+	}
+	if len(req.ObjectIds) == 0 && (req.SpaceId == "" || len(req.Filters) == 0) {
+		return response(pb.RpcObjectListSetDetailsResponseError_BAD_INPUT, fmt.Errorf("object ids or space id with filters are required"))
+	}
+
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		failedObjectIds, err = bs.SetDetailsList(ctx, req)
+		return err
+	})
+	if err != nil {
+		return response(pb.RpcObjectListSetDetailsResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcObjectListSetDetailsResponseError_NULL, nil)
+}
+
+func (mw *Middleware) ObjectListTransformBlocks(cctx context.Context, req *pb.RpcObjectListTransformBlocksRequest) *pb.RpcObjectListTransformBlocksResponse {
+	response := func(code pb.RpcObjectListTransformBlocksResponseErrorCode, err error, result blocktransform.Result) *pb.RpcObjectListTransformBlocksResponse {
+		m := &pb.RpcObjectListTransformBlocksResponse{
+			Error:          &pb.RpcObjectListTransformBlocksResponseError{Code: code},
+			ChangedObjects: result.ChangedObjects,
+			ChangedBlocks:  result.ChangedBlocks,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	result, err := getService[blocktransform.Service](mw).Transform(cctx, req)
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectListTransformBlocksResponseError_NULL, nil, result)
+	case errors.Is(err, blocktransform.ErrBadInput):
+		return response(pb.RpcObjectListTransformBlocksResponseError_BAD_INPUT, err, result)
+	case errors.Is(err, context.Canceled):
+		return response(pb.RpcObjectListTransformBlocksResponseError_CANCELED, err, result)
+	default:
+		return response(pb.RpcObjectListTransformBlocksResponseError_UNKNOWN_ERROR, err, result)
+	}
+}
+
 func (mw *Middleware) ObjectSetLayout(cctx context.Context, req *pb.RpcObjectSetLayoutRequest) *pb.RpcObjectSetLayoutResponse {
 	ctx := mw.newContext(cctx)
 	response := func(code pb.RpcObjectSetLayoutResponseErrorCode, err error) *pb.RpcObjectSetLayoutResponse {
@@ -808,29 +944,138 @@ func (mw *Middleware) ObjectSetInternalFlags(cctx context.Context, req *pb.RpcOb
 }
 
 func (mw *Middleware) ObjectImport(cctx context.Context, req *pb.RpcObjectImportRequest) *pb.RpcObjectImportResponse {
-	response := func(code pb.RpcObjectImportResponseErrorCode, rootCollectionID string, err error) *pb.RpcObjectImportResponse {
-		m := &pb.RpcObjectImportResponse{Error: &pb.RpcObjectImportResponseError{Code: code}, CollectionId: rootCollectionID}
+	response := func(code pb.RpcObjectImportResponseErrorCode, res *importer.ImportResponse, err error) *pb.RpcObjectImportResponse {
+		m := &pb.RpcObjectImportResponse{Error: &pb.RpcObjectImportResponseError{Code: code}}
+		if res != nil {
+			m.CollectionId = res.RootCollectionID
+			m.ObjectsToOverwrite = res.ObjectsToOverwrite
+			m.ImportRunId = res.ImportRunID
+			m.DryRunSummary = res.DryRunSummary
+			m.ReportId = res.ReportID
+		}
 		if err != nil {
 			m.Error.Description = err.Error()
 		}
 		return m
 	}
 
-	rootCollectionID, err := getService[importer.Importer](mw).Import(cctx, req, model.ObjectOrigin_import)
+	res, err := getService[importer.Importer](mw).Import(cctx, req, model.ObjectOrigin_import)
 
 	if err == nil {
-		return response(pb.RpcObjectImportResponseError_NULL, rootCollectionID, nil)
+		return response(pb.RpcObjectImportResponseError_NULL, res, nil)
 	}
 
 	switch {
 	case errors.Is(err, converter.ErrNoObjectsToImport):
-		return response(pb.RpcObjectImportResponseError_NO_OBJECTS_TO_IMPORT, "", err)
+		return response(pb.RpcObjectImportResponseError_NO_OBJECTS_TO_IMPORT, nil, err)
 	case errors.Is(err, converter.ErrCancel):
-		return response(pb.RpcObjectImportResponseError_IMPORT_IS_CANCELED, "", err)
+		return response(pb.RpcObjectImportResponseError_IMPORT_IS_CANCELED, nil, err)
 	case errors.Is(err, converter.ErrLimitExceeded):
-		return response(pb.RpcObjectImportResponseError_LIMIT_OF_ROWS_OR_RELATIONS_EXCEEDED, "", err)
+		return response(pb.RpcObjectImportResponseError_LIMIT_OF_ROWS_OR_RELATIONS_EXCEEDED, nil, err)
+	case errors.Is(err, converter.ErrOverwriteNotConfirmed):
+		return response(pb.RpcObjectImportResponseError_OVERWRITE_IS_NOT_CONFIRMED, res, err)
 	default:
-		return response(pb.RpcObjectImportResponseError_INTERNAL_ERROR, "", err)
+		// import with partial errors still returns its report
+		return response(pb.RpcObjectImportResponseError_INTERNAL_ERROR, res, err)
+	}
+}
+
+func (mw *Middleware) ObjectImportUndo(cctx context.Context, req *pb.RpcObjectImportUndoRequest) *pb.RpcObjectImportUndoResponse {
+	response := func(code pb.RpcObjectImportUndoResponseErrorCode, ids []string, err error) *pb.RpcObjectImportUndoResponse {
+		m := &pb.RpcObjectImportUndoResponse{ObjectIds: ids, Error: &pb.RpcObjectImportUndoResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.ImportRunId == "" {
+		return response(pb.RpcObjectImportUndoResponseError_BAD_INPUT, nil, fmt.Errorf("import run id is empty"))
+	}
+	ids, err := getService[importer.Importer](mw).UndoImport(req.ImportRunId)
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectImportUndoResponseError_NULL, ids, nil)
+	case errors.Is(err, importer.ErrNoObjectsToUndo):
+		return response(pb.RpcObjectImportUndoResponseError_NO_OBJECTS_TO_UNDO, nil, err)
+	default:
+		return response(pb.RpcObjectImportUndoResponseError_UNKNOWN_ERROR, ids, err)
+	}
+}
+
+func (mw *Middleware) ObjectImportPluginRegister(cctx context.Context, req *pb.RpcObjectImportPluginRegisterRequest) *pb.RpcObjectImportPluginRegisterResponse {
+	response := func(code pb.RpcObjectImportPluginRegisterResponseErrorCode, err error) *pb.RpcObjectImportPluginRegisterResponse {
+		m := &pb.RpcObjectImportPluginRegisterResponse{Error: &pb.RpcObjectImportPluginRegisterResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[importer.Importer](mw).RegisterPlugin(req.Name, req.Executable, req.Args)
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectImportPluginRegisterResponseError_NULL, nil)
+	case errors.Is(err, plugin.ErrEmptyName), errors.Is(err, plugin.ErrInvalidExecutable):
+		return response(pb.RpcObjectImportPluginRegisterResponseError_BAD_INPUT, err)
+	case errors.Is(err, importer.ErrPluginExists):
+		return response(pb.RpcObjectImportPluginRegisterResponseError_ALREADY_EXISTS, err)
+	default:
+		return response(pb.RpcObjectImportPluginRegisterResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) ObjectImportPluginUnregister(cctx context.Context, req *pb.RpcObjectImportPluginUnregisterRequest) *pb.RpcObjectImportPluginUnregisterResponse {
+	response := func(code pb.RpcObjectImportPluginUnregisterResponseErrorCode, err error) *pb.RpcObjectImportPluginUnregisterResponse {
+		m := &pb.RpcObjectImportPluginUnregisterResponse{Error: &pb.RpcObjectImportPluginUnregisterResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.Name == "" {
+		return response(pb.RpcObjectImportPluginUnregisterResponseError_BAD_INPUT, fmt.Errorf("name of plugin is empty"))
+	}
+	err := getService[importer.Importer](mw).UnregisterPlugin(req.Name)
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectImportPluginUnregisterResponseError_NULL, nil)
+	case errors.Is(err, importer.ErrUnknownPlugin):
+		return response(pb.RpcObjectImportPluginUnregisterResponseError_NOT_FOUND, err)
+	default:
+		return response(pb.RpcObjectImportPluginUnregisterResponseError_UNKNOWN_ERROR, err)
+	}
+}
+
+func (mw *Middleware) ObjectImportResume(cctx context.Context, req *pb.RpcObjectImportResumeRequest) *pb.RpcObjectImportResumeResponse {
+	response := func(code pb.RpcObjectImportResumeResponseErrorCode, res *importer.ImportResponse, err error) *pb.RpcObjectImportResumeResponse {
+		m := &pb.RpcObjectImportResumeResponse{Error: &pb.RpcObjectImportResumeResponseError{Code: code}}
+		if res != nil {
+			m.CollectionId = res.RootCollectionID
+			m.ImportRunId = res.ImportRunID
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.ImportRunId == "" {
+		return response(pb.RpcObjectImportResumeResponseError_BAD_INPUT, nil, fmt.Errorf("import run id is empty"))
+	}
+	res, err := getService[importer.Importer](mw).ResumeImport(cctx, req.ImportRunId, model.ObjectOrigin_import)
+	switch {
+	case err == nil:
+		return response(pb.RpcObjectImportResumeResponseError_NULL, res, nil)
+	case errors.Is(err, importer.ErrNoCheckpoint):
+		return response(pb.RpcObjectImportResumeResponseError_NO_CHECKPOINT, nil, err)
+	case errors.Is(err, source.ErrPasswordRequired):
+		return response(pb.RpcObjectImportResumeResponseError_BAD_INPUT, nil, err)
+	case errors.Is(err, converter.ErrCancel):
+		return response(pb.RpcObjectImportResumeResponseError_IMPORT_IS_CANCELED, nil, err)
+	default:
+		return response(pb.RpcObjectImportResumeResponseError_UNKNOWN_ERROR, nil, err)
 	}
 }
 
@@ -852,6 +1097,25 @@ func (mw *Middleware) ObjectImportList(cctx context.Context, req *pb.RpcObjectIm
 	return response(res, pb.RpcObjectImportListResponseError_NULL, nil)
 }
 
+func (mw *Middleware) ObjectImportListEntries(cctx context.Context, req *pb.RpcObjectImportListEntriesRequest) *pb.RpcObjectImportListEntriesResponse {
+	response := func(code pb.RpcObjectImportListEntriesResponseErrorCode, entries []*pb.RpcObjectImportListEntriesEntry, err error) *pb.RpcObjectImportListEntriesResponse {
+		m := &pb.RpcObjectImportListEntriesResponse{Entries: entries, Error: &pb.RpcObjectImportListEntriesResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	if req.Path == "" {
+		return response(pb.RpcObjectImportListEntriesResponseError_BAD_INPUT, nil, fmt.Errorf("path is empty"))
+	}
+	entries, err := getService[importer.Importer](mw).ListEntries(req)
+	if err != nil {
+		return response(pb.RpcObjectImportListEntriesResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	return response(pb.RpcObjectImportListEntriesResponseError_NULL, entries, nil)
+}
+
 func (mw *Middleware) ObjectImportNotionValidateToken(ctx context.Context,
 	request *pb.RpcObjectImportNotionValidateTokenRequest) *pb.RpcObjectImportNotionValidateTokenResponse {
 	// nolint: lll
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/files/ocr"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) OcrSetEnabled(cctx context.Context, req *pb.RpcOcrSetEnabledRequest) *pb.RpcOcrSetEnabledResponse {
+	response := func(code pb.RpcOcrSetEnabledResponseErrorCode, err error) *pb.RpcOcrSetEnabledResponse {
+		m := &pb.RpcOcrSetEnabledResponse{Error: &pb.RpcOcrSetEnabledResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[ocr.Service](mw).SetEnabled(cctx, req.SpaceId, req.Enabled)
+	if errors.Is(err, ocr.ErrBadInput) {
+		return response(pb.RpcOcrSetEnabledResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcOcrSetEnabledResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcOcrSetEnabledResponseError_NULL, nil)
+}
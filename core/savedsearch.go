@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/savedsearch"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) SavedSearchCreate(cctx context.Context, req *pb.RpcSavedSearchCreateRequest) *pb.RpcSavedSearchCreateResponse {
+	response := func(code pb.RpcSavedSearchCreateResponseErrorCode, err error, id string) *pb.RpcSavedSearchCreateResponse {
+		m := &pb.RpcSavedSearchCreateResponse{Error: &pb.RpcSavedSearchCreateResponseError{Code: code}, ObjectId: id}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	id, err := getService[savedsearch.Service](mw).Create(cctx, req.SpaceId, req.Name, req.Query)
+	if errors.Is(err, savedsearch.ErrBadInput) {
+		return response(pb.RpcSavedSearchCreateResponseError_BAD_INPUT, err, "")
+	}
+	if err != nil {
+		return response(pb.RpcSavedSearchCreateResponseError_UNKNOWN_ERROR, err, "")
+	}
+	return response(pb.RpcSavedSearchCreateResponseError_NULL, nil, id)
+}
+
+func (mw *Middleware) SavedSearchUpdate(cctx context.Context, req *pb.RpcSavedSearchUpdateRequest) *pb.RpcSavedSearchUpdateResponse {
+	response := func(code pb.RpcSavedSearchUpdateResponseErrorCode, err error) *pb.RpcSavedSearchUpdateResponse {
+		m := &pb.RpcSavedSearchUpdateResponse{Error: &pb.RpcSavedSearchUpdateResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[savedsearch.Service](mw).Update(req.ObjectId, req.Name, req.Query)
+	switch {
+	case errors.Is(err, savedsearch.ErrBadInput):
+		return response(pb.RpcSavedSearchUpdateResponseError_BAD_INPUT, err)
+	case errors.Is(err, savedsearch.ErrNotFound):
+		return response(pb.RpcSavedSearchUpdateResponseError_NOT_FOUND, err)
+	case err != nil:
+		return response(pb.RpcSavedSearchUpdateResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcSavedSearchUpdateResponseError_NULL, nil)
+}
+
+func (mw *Middleware) SavedSearchSubscribe(cctx context.Context, req *pb.RpcSavedSearchSubscribeRequest) *pb.RpcSavedSearchSubscribeResponse {
+	response := func(code pb.RpcSavedSearchSubscribeResponseErrorCode, err error, resp *pb.RpcObjectSearchSubscribeResponse) *pb.RpcSavedSearchSubscribeResponse {
+		m := &pb.RpcSavedSearchSubscribeResponse{Error: &pb.RpcSavedSearchSubscribeResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		} else {
+			m.Records = resp.Records
+			m.Dependencies = resp.Dependencies
+			m.SubId = resp.SubId
+			m.Counters = resp.Counters
+		}
+		return m
+	}
+
+	resp, err := getService[savedsearch.Service](mw).Subscribe(req)
+	switch {
+	case errors.Is(err, savedsearch.ErrBadInput):
+		return response(pb.RpcSavedSearchSubscribeResponseError_BAD_INPUT, err, nil)
+	case errors.Is(err, savedsearch.ErrNotFound):
+		return response(pb.RpcSavedSearchSubscribeResponseError_NOT_FOUND, err, nil)
+	case err != nil:
+		return response(pb.RpcSavedSearchSubscribeResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcSavedSearchSubscribeResponseError_NULL, nil, resp)
+}
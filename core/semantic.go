@@ -0,0 +1,40 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/semantic"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func (mw *Middleware) ObjectSearchSemantic(cctx context.Context, req *pb.RpcObjectSearchSemanticRequest) *pb.RpcObjectSearchSemanticResponse {
+	response := func(code pb.RpcObjectSearchSemanticResponseErrorCode, matches []semantic.Match, err error) *pb.RpcObjectSearchSemanticResponse {
+		m := &pb.RpcObjectSearchSemanticResponse{Error: &pb.RpcObjectSearchSemanticResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+			return m
+		}
+		m.Records = make([]*types.Struct, 0, len(matches))
+		m.Similarities = make([]float64, 0, len(matches))
+		for _, match := range matches {
+			m.Records = append(m.Records, pbtypes.Map(match.Details, req.Keys...))
+			m.Similarities = append(m.Similarities, match.Similarity)
+		}
+		return m
+	}
+
+	matches, err := getService[semantic.Service](mw).Search(cctx, req.SpaceId, req.Text, int(req.Limit))
+	switch {
+	case errors.Is(err, semantic.ErrBadInput):
+		return response(pb.RpcObjectSearchSemanticResponseError_BAD_INPUT, nil, err)
+	case errors.Is(err, semantic.ErrNotAvailable):
+		return response(pb.RpcObjectSearchSemanticResponseError_NOT_AVAILABLE, nil, err)
+	case err != nil:
+		return response(pb.RpcObjectSearchSemanticResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	return response(pb.RpcObjectSearchSemanticResponseError_NULL, matches, nil)
+}
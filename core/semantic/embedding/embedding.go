@@ -0,0 +1,97 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiTimeout = time.Minute
+
+// Embedder converts texts to vectors, which are close for texts with similar meaning
+type Embedder interface {
+	// Model identifies vectors of the embedder. Vectors of different models are not comparable, so objects
+	// are embedded again, when the model is changed
+	Model() string
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Config configures the embeddings API. It's used, when no Embedder component is registered
+type Config struct {
+	// URL is the endpoint of OpenAI-compatible embeddings API, like http://localhost:11434/v1/embeddings
+	// of the local model server. Semantic search is disabled, if it's empty
+	URL    string `json:",omitempty"`
+	Model  string `json:",omitempty"`
+	APIKey string `json:",omitempty"`
+}
+
+// APIEmbedder calls the embeddings API
+type APIEmbedder struct {
+	config Config
+	client *http.Client
+}
+
+func NewAPIEmbedder(config Config) *APIEmbedder {
+	return &APIEmbedder{config: config, client: &http.Client{Timeout: apiTimeout}}
+}
+
+type embeddingsRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (e *APIEmbedder) Model() string {
+	return e.config.URL + "#" + e.config.Model
+}
+
+func (e *APIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(embeddingsRequest{Model: e.config.Model, Input: texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.config.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.APIKey)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("embeddings api: %s: %s", resp.Status, msg)
+	}
+	var res embeddingsResponse
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	vectors := make([][]float32, len(texts))
+	for _, d := range res.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("embeddings api: unexpected index %d", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	for i, v := range vectors {
+		if len(v) == 0 {
+			return nil, fmt.Errorf("embeddings api: no vector for text %d", i)
+		}
+	}
+	return vectors, nil
+}
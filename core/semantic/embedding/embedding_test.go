@@ -0,0 +1,47 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIEmbedder(t *testing.T) {
+	// given
+	var req embeddingsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		_, _ = w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer server.Close()
+	e := NewAPIEmbedder(Config{URL: server.URL, Model: "small", APIKey: "key"})
+
+	// when
+	vectors, err := e.Embed(context.Background(), []string{"first", "second"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, embeddingsRequest{Model: "small", Input: []string{"first", "second"}}, req)
+	assert.Equal(t, [][]float32{{1, 0}, {0, 1}}, vectors)
+}
+
+func TestAPIEmbedder_Error(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+	e := NewAPIEmbedder(Config{URL: server.URL})
+
+	// when
+	_, err := e.Embed(context.Background(), []string{"text"})
+
+	// then
+	assert.ErrorContains(t, err, "model not found")
+}
@@ -0,0 +1,429 @@
+package semantic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/semantic/embedding"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	CName = "semantic"
+	// EmbedderCName is the name of optional component implementing embedding.Embedder, like the local model.
+	// If it is not registered, the embeddings API from config is used
+	EmbedderCName = "semantic-embedder"
+)
+
+var log = logging.Logger("anytype-mw-semantic")
+
+var (
+	ErrBadInput     = errors.New("bad input")
+	ErrNotAvailable = errors.New("embedding model is not configured")
+)
+
+const (
+	vectorKeyPrefix = "/semantic/vector/"
+	modelKey        = "/semantic/model"
+
+	batchSize    = 16
+	embedTimeout = 2 * time.Minute
+	defaultLimit = 20
+	// maxTextLength limits the length of the text in bytes, as models have limited context
+	maxTextLength = 8 << 10
+)
+
+// Service keeps vectors of texts of objects and finds objects by meaning of the text. Vectors are updated
+// incrementally from documents of the full-text indexer
+type Service interface {
+	// Index queues documents of the full-text index to update their vectors
+	Index(docs ...ftsearch.SearchDoc)
+	// Search returns objects, which texts are nearest to the text. Deleted and archived objects are skipped
+	Search(ctx context.Context, spaceID, text string, limit int) ([]Match, error)
+	app.ComponentRunnable
+}
+
+type configGetter interface {
+	GetSemanticSearch() embedding.Config
+}
+
+type Match struct {
+	Details *types.Struct
+	// Similarity is the cosine similarity of vectors from -1 to 1, higher is more similar
+	Similarity float64
+}
+
+func New() Service {
+	return &service{
+		vectors: map[string]vector{},
+		pending: map[string]*ftsearch.SearchDoc{},
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+type service struct {
+	objectStore objectstore.ObjectStore
+	db          *badger.DB
+	embedder    embedding.Embedder
+	// readOnly keeps vectors unchanged, when account is opened in read-only mode
+	readOnly bool
+
+	mu      sync.RWMutex
+	vectors map[string]vector
+
+	pendingMu sync.Mutex
+	// pending are documents to embed by object id, nil document means removal of the vector
+	pending map[string]*ftsearch.SearchDoc
+	notify  chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	if embedder, ok := a.Component(EmbedderCName).(embedding.Embedder); ok {
+		s.embedder = embedder
+	} else if cfg, ok := a.Component("config").(configGetter); ok && cfg.GetSemanticSearch().URL != "" {
+		s.embedder = embedding.NewAPIEmbedder(cfg.GetSemanticSearch())
+	}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	if s.embedder == nil {
+		log.Infof("semantic search is disabled, embedding model is not configured")
+		return nil
+	}
+	if err := s.loadVectors(); err != nil {
+		return fmt.Errorf("load vectors: %w", err)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	// vectors aren't updated in read-only mode, the loaded ones are still searched
+	if s.readOnly {
+		return nil
+	}
+	s.objectStore.SubscribeForChanges(s.onChange)
+	s.wg.Add(1)
+	go s.loop()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return nil
+}
+
+// loadVectors loads vectors of the current model. If the model is changed, vectors are removed and all objects
+// are queued to the full-text indexer, which passes their documents to the service again. In read-only mode
+// vectors of other model are just not loaded
+func (s *service) loadVectors() error {
+	model, err := badgerhelper.GetValue(s.db, []byte(modelKey), badgerhelper.UnmarshalString)
+	if err != nil && !badgerhelper.IsNotFound(err) {
+		return fmt.Errorf("get model: %w", err)
+	}
+	if model != s.embedder.Model() {
+		if s.readOnly {
+			return nil
+		}
+		return s.resetVectors()
+	}
+	return s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(vectorKeyPrefix), PrefetchValues: true})
+		defer iter.Close()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			v, err := decodeVector(raw)
+			if err != nil {
+				return err
+			}
+			s.vectors[strings.TrimPrefix(string(iter.Item().Key()), vectorKeyPrefix)] = v
+		}
+		return nil
+	})
+}
+
+func (s *service) resetVectors() error {
+	if err := s.db.DropPrefix([]byte(vectorKeyPrefix)); err != nil {
+		return fmt.Errorf("remove vectors: %w", err)
+	}
+	if err := badgerhelper.SetValue(s.db, []byte(modelKey), s.embedder.Model()); err != nil {
+		return fmt.Errorf("save model: %w", err)
+	}
+	ids, err := s.objectStore.ListIds()
+	if err != nil {
+		return fmt.Errorf("list ids: %w", err)
+	}
+	for _, id := range ids {
+		if err = s.objectStore.AddToIndexQueue(id); err != nil {
+			return fmt.Errorf("add to index queue: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *service) Index(docs ...ftsearch.SearchDoc) {
+	if s.embedder == nil || s.readOnly || len(docs) == 0 {
+		return
+	}
+	s.pendingMu.Lock()
+	for i := range docs {
+		s.pending[docs[i].Id] = &docs[i]
+	}
+	s.pendingMu.Unlock()
+	s.wake()
+}
+
+// onChange is called by the object store, so vectors of deleted objects are only queued for removal
+func (s *service) onChange(id string, _, newDetails *types.Struct) {
+	if !pbtypes.GetBool(newDetails, bundle.RelationKeyIsDeleted.String()) {
+		return
+	}
+	s.mu.RLock()
+	_, ok := s.vectors[id]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+	s.pendingMu.Lock()
+	s.pending[id] = nil
+	s.pendingMu.Unlock()
+	s.wake()
+}
+
+func (s *service) wake() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *service) loop() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.notify:
+			for s.ctx.Err() == nil && s.processBatch() {
+			}
+		}
+	}
+}
+
+// processBatch embeds the batch of pending documents and returns false, when there is nothing to do
+func (s *service) processBatch() bool {
+	s.pendingMu.Lock()
+	var (
+		docs    []ftsearch.SearchDoc
+		removed []string
+	)
+	for id, doc := range s.pending {
+		if len(docs) >= batchSize {
+			break
+		}
+		delete(s.pending, id)
+		if doc == nil {
+			removed = append(removed, id)
+			continue
+		}
+		docs = append(docs, *doc)
+	}
+	s.pendingMu.Unlock()
+	if len(docs) == 0 && len(removed) == 0 {
+		return false
+	}
+	if err := s.removeVectors(removed); err != nil {
+		log.Errorf("remove vectors: %s", err)
+	}
+	if err := s.embedDocs(docs); err != nil {
+		log.Errorf("embed documents: %s", err)
+	}
+	return true
+}
+
+func (s *service) embedDocs(docs []ftsearch.SearchDoc) error {
+	var (
+		changed []vector
+		ids     []string
+		texts   []string
+		removed []string
+	)
+	for _, doc := range docs {
+		text := docText(doc)
+		if text == "" {
+			removed = append(removed, doc.Id)
+			continue
+		}
+		hash := textHash(text)
+		s.mu.RLock()
+		current, ok := s.vectors[doc.Id]
+		s.mu.RUnlock()
+		if ok && current.hash == hash {
+			continue
+		}
+		changed = append(changed, vector{spaceID: doc.SpaceID, hash: hash})
+		ids = append(ids, doc.Id)
+		texts = append(texts, text)
+	}
+	if err := s.removeVectors(removed); err != nil {
+		return err
+	}
+	if len(texts) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, embedTimeout)
+	defer cancel()
+	values, err := s.embedder.Embed(ctx, texts)
+	if err != nil {
+		return err
+	}
+	if len(values) != len(texts) {
+		return fmt.Errorf("embedder returned %d vectors for %d texts", len(values), len(texts))
+	}
+	err = s.db.Update(func(txn *badger.Txn) error {
+		for i := range changed {
+			changed[i].values = values[i]
+			if err := txn.Set(vectorKey(ids[i]), changed[i].encode()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("save vectors: %w", err)
+	}
+	s.mu.Lock()
+	for i, id := range ids {
+		s.vectors[id] = changed[i]
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *service) removeVectors(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := s.db.Update(func(txn *badger.Txn) error {
+		for _, id := range ids {
+			if err := txn.Delete(vectorKey(id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	for _, id := range ids {
+		delete(s.vectors, id)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *service) Search(ctx context.Context, spaceID, text string, limit int) ([]Match, error) {
+	if s.embedder == nil {
+		return nil, ErrNotAvailable
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("%w: text is empty", ErrBadInput)
+	}
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	values, err := s.embedder.Embed(ctx, []string{textutil.TruncateBytes(text, maxTextLength)})
+	if err != nil {
+		return nil, fmt.Errorf("embed text: %w", err)
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("embedder returned %d vectors for 1 text", len(values))
+	}
+	s.mu.RLock()
+	neighbors := nearest(s.vectors, values[0], spaceID)
+	s.mu.RUnlock()
+	return s.matches(neighbors, limit)
+}
+
+// matches returns details of nearest objects, which are not deleted or archived
+func (s *service) matches(neighbors []neighbor, limit int) ([]Match, error) {
+	matches := make([]Match, 0, limit)
+	for start := 0; start < len(neighbors) && len(matches) < limit; start += limit {
+		end := start + limit
+		if end > len(neighbors) {
+			end = len(neighbors)
+		}
+		ids := make([]string, 0, end-start)
+		for _, n := range neighbors[start:end] {
+			ids = append(ids, n.id)
+		}
+		records, err := s.objectStore.QueryByID(ids)
+		if err != nil {
+			return nil, fmt.Errorf("query objects: %w", err)
+		}
+		details := make(map[string]*types.Struct, len(records))
+		for _, rec := range records {
+			details[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = rec.Details
+		}
+		for _, n := range neighbors[start:end] {
+			d, ok := details[n.id]
+			if !ok || pbtypes.GetBool(d, bundle.RelationKeyIsDeleted.String()) || pbtypes.GetBool(d, bundle.RelationKeyIsArchived.String()) {
+				continue
+			}
+			matches = append(matches, Match{Details: d, Similarity: n.similarity})
+			if len(matches) == limit {
+				break
+			}
+		}
+	}
+	return matches, nil
+}
+
+func vectorKey(id string) []byte {
+	return []byte(vectorKeyPrefix + id)
+}
+
+// docText returns the text of the document to embed
+func docText(doc ftsearch.SearchDoc) string {
+	text := strings.TrimSpace(doc.Title + "\n" + doc.Text)
+	return textutil.TruncateBytes(text, maxTextLength)
+}
@@ -0,0 +1,170 @@
+package semantic
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// topicEmbedder embeds texts by topics of their words
+type topicEmbedder struct {
+	calls int
+}
+
+var topics = [][]string{
+	{"cat", "dog", "pet", "puppy"},
+	{"tax", "invoice", "salary", "budget"},
+}
+
+func (e *topicEmbedder) Model() string {
+	return "topics"
+}
+
+func (e *topicEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	e.calls++
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		v := make([]float32, len(topics))
+		for _, word := range strings.Fields(strings.ToLower(text)) {
+			for i, topic := range topics {
+				for _, w := range topic {
+					if w == word {
+						v[i]++
+					}
+				}
+			}
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
+
+func newTestService(t *testing.T, store *objectstore.StoreFixture) *service {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	s := New().(*service)
+	s.objectStore = store
+	s.db = db
+	s.embedder = &topicEmbedder{}
+	s.ctx = context.Background()
+	return s
+}
+
+func TestVector(t *testing.T) {
+	// given
+	v := vector{spaceID: "space1", hash: textHash("text"), values: []float32{0.5, -1, 2}}
+
+	// when
+	decoded, err := decodeVector(v.encode())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, v, decoded)
+
+	_, err = decodeVector([]byte{10, 1})
+	assert.Error(t, err)
+}
+
+func TestNearest(t *testing.T) {
+	// given
+	vectors := map[string]vector{
+		"same":     {spaceID: "space1", values: []float32{1, 0}},
+		"close":    {spaceID: "space1", values: []float32{1, 1}},
+		"opposite": {spaceID: "space1", values: []float32{-1, 0}},
+		"other":    {spaceID: "space2", values: []float32{1, 0}},
+	}
+
+	// when
+	neighbors := nearest(vectors, []float32{2, 0}, "space1")
+
+	// then
+	require.Len(t, neighbors, 3)
+	assert.Equal(t, []string{"same", "close", "opposite"}, []string{neighbors[0].id, neighbors[1].id, neighbors[2].id})
+	assert.InDelta(t, 1, neighbors[0].similarity, 1e-9)
+	assert.InDelta(t, -1, neighbors[2].similarity, 1e-9)
+}
+
+func TestService_Search(t *testing.T) {
+	t.Run("objects are found by topic", func(t *testing.T) {
+		// given
+		store := objectstore.NewStoreFixture(t)
+		store.AddObjects(t, []objectstore.TestObject{
+			{bundle.RelationKeyId: pbtypes.String("pets"), bundle.RelationKeyName: pbtypes.String("Pets")},
+			{bundle.RelationKeyId: pbtypes.String("money"), bundle.RelationKeyName: pbtypes.String("Money")},
+			{bundle.RelationKeyId: pbtypes.String("archived"), bundle.RelationKeyIsArchived: pbtypes.Bool(true)},
+		})
+		s := newTestService(t, store)
+		require.NoError(t, s.embedDocs([]ftsearch.SearchDoc{
+			{Id: "pets", SpaceID: "space1", Title: "Pets", Text: "my dog and cat"},
+			{Id: "money", SpaceID: "space1", Title: "Money", Text: "salary and tax budget"},
+			{Id: "archived", SpaceID: "space1", Text: "puppy"},
+		}))
+
+		// when
+		matches, err := s.Search(context.Background(), "space1", "puppy", 1)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		assert.Equal(t, "pets", pbtypes.GetString(matches[0].Details, bundle.RelationKeyId.String()))
+		assert.InDelta(t, 1, matches[0].Similarity, 1e-9)
+	})
+	t.Run("unchanged text is not embedded again", func(t *testing.T) {
+		// given
+		s := newTestService(t, objectstore.NewStoreFixture(t))
+		doc := ftsearch.SearchDoc{Id: "pets", SpaceID: "space1", Text: "dog"}
+		require.NoError(t, s.embedDocs([]ftsearch.SearchDoc{doc}))
+
+		// when
+		require.NoError(t, s.embedDocs([]ftsearch.SearchDoc{doc}))
+
+		// then
+		assert.Equal(t, 1, s.embedder.(*topicEmbedder).calls)
+	})
+	t.Run("vectors are loaded after restart", func(t *testing.T) {
+		// given
+		s := newTestService(t, objectstore.NewStoreFixture(t))
+		require.NoError(t, badgerSetModel(s))
+		require.NoError(t, s.embedDocs([]ftsearch.SearchDoc{{Id: "pets", SpaceID: "space1", Text: "dog"}}))
+		s.vectors = map[string]vector{}
+
+		// when
+		require.NoError(t, s.loadVectors())
+
+		// then
+		assert.Contains(t, s.vectors, "pets")
+	})
+	t.Run("empty text", func(t *testing.T) {
+		// given
+		s := newTestService(t, objectstore.NewStoreFixture(t))
+
+		// when
+		_, err := s.Search(context.Background(), "space1", " ", 0)
+
+		// then
+		assert.ErrorIs(t, err, ErrBadInput)
+	})
+	t.Run("no embedder", func(t *testing.T) {
+		// when
+		_, err := New().Search(context.Background(), "space1", "dog", 0)
+
+		// then
+		assert.ErrorIs(t, err, ErrNotAvailable)
+	})
+}
+
+func badgerSetModel(s *service) error {
+	return s.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(modelKey), []byte(s.embedder.Model()))
+	})
+}
@@ -0,0 +1,94 @@
+package semantic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// vector is the embedding of the object text. hash of the text allows to skip embedding of unchanged objects
+type vector struct {
+	spaceID string
+	hash    uint64
+	values  []float32
+}
+
+// encode writes space id, hash and values of the vector
+func (v vector) encode() []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(v.spaceID)))
+	buf = append(buf, v.spaceID...)
+	buf = binary.LittleEndian.AppendUint64(buf, v.hash)
+	for _, f := range v.values {
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+	}
+	return buf
+}
+
+func decodeVector(raw []byte) (vector, error) {
+	n, size := binary.Uvarint(raw)
+	if size <= 0 || uint64(len(raw)-size) < n+8 {
+		return vector{}, fmt.Errorf("malformed vector")
+	}
+	raw = raw[size:]
+	v := vector{spaceID: string(raw[:n])}
+	raw = raw[n:]
+	v.hash = binary.LittleEndian.Uint64(raw)
+	raw = raw[8:]
+	if len(raw)%4 != 0 {
+		return vector{}, fmt.Errorf("malformed vector values")
+	}
+	v.values = make([]float32, len(raw)/4)
+	for i := range v.values {
+		v.values[i] = math.Float32frombits(binary.LittleEndian.Uint32(raw[i*4:]))
+	}
+	return v, nil
+}
+
+func textHash(text string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(text))
+	return h.Sum64()
+}
+
+// cosine returns the cosine similarity of vectors from -1 to 1. Vectors of different dimensions are not similar
+func cosine(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
+
+type neighbor struct {
+	id         string
+	similarity float64
+}
+
+// nearest returns objects of the space sorted by similarity to the query. All spaces are searched,
+// if space id is empty
+func nearest(vectors map[string]vector, query []float32, spaceID string) []neighbor {
+	neighbors := make([]neighbor, 0, len(vectors))
+	for id, v := range vectors {
+		if spaceID != "" && v.spaceID != spaceID {
+			continue
+		}
+		neighbors = append(neighbors, neighbor{id: id, similarity: cosine(query, v.values)})
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		if neighbors[i].similarity == neighbors[j].similarity {
+			return neighbors[i].id < neighbors[j].id
+		}
+		return neighbors[i].similarity > neighbors[j].similarity
+	})
+	return neighbors
+}
@@ -2,10 +2,12 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/export"
+	"github.com/anyproto/anytype-heart/core/block/recurrence"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
@@ -161,3 +163,60 @@ func (mw *Middleware) WorkspaceExport(cctx context.Context, req *pb.RpcWorkspace
 		},
 	}
 }
+
+func (mw *Middleware) TemplateRecurrenceSet(cctx context.Context, req *pb.RpcTemplateRecurrenceSetRequest) *pb.RpcTemplateRecurrenceSetResponse {
+	response := func(code pb.RpcTemplateRecurrenceSetResponseErrorCode, err error) *pb.RpcTemplateRecurrenceSetResponse {
+		m := &pb.RpcTemplateRecurrenceSetResponse{Error: &pb.RpcTemplateRecurrenceSetResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[recurrence.Service](mw).Set(req.Recurrence)
+	if errors.Is(err, recurrence.ErrBadInput) {
+		return response(pb.RpcTemplateRecurrenceSetResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcTemplateRecurrenceSetResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcTemplateRecurrenceSetResponseError_NULL, nil)
+}
+
+func (mw *Middleware) TemplateRecurrenceRemove(cctx context.Context, req *pb.RpcTemplateRecurrenceRemoveRequest) *pb.RpcTemplateRecurrenceRemoveResponse {
+	response := func(code pb.RpcTemplateRecurrenceRemoveResponseErrorCode, err error) *pb.RpcTemplateRecurrenceRemoveResponse {
+		m := &pb.RpcTemplateRecurrenceRemoveResponse{Error: &pb.RpcTemplateRecurrenceRemoveResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[recurrence.Service](mw).Remove(req.TemplateId)
+	if errors.Is(err, recurrence.ErrNotFound) {
+		return response(pb.RpcTemplateRecurrenceRemoveResponseError_NOT_FOUND, err)
+	}
+	if err != nil {
+		return response(pb.RpcTemplateRecurrenceRemoveResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcTemplateRecurrenceRemoveResponseError_NULL, nil)
+}
+
+func (mw *Middleware) TemplateRecurrenceList(cctx context.Context, req *pb.RpcTemplateRecurrenceListRequest) *pb.RpcTemplateRecurrenceListResponse {
+	response := func(code pb.RpcTemplateRecurrenceListResponseErrorCode, err error, recurrences []*pb.RpcTemplateRecurrence) *pb.RpcTemplateRecurrenceListResponse {
+		m := &pb.RpcTemplateRecurrenceListResponse{
+			Error:       &pb.RpcTemplateRecurrenceListResponseError{Code: code},
+			Recurrences: recurrences,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	recurrences, err := getService[recurrence.Service](mw).List()
+	if err != nil {
+		return response(pb.RpcTemplateRecurrenceListResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcTemplateRecurrenceListResponseError_NULL, nil, recurrences)
+}
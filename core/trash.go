@@ -0,0 +1,47 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/trash"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) TrashRetentionSet(cctx context.Context, req *pb.RpcTrashRetentionSetRequest) *pb.RpcTrashRetentionSetResponse {
+	response := func(code pb.RpcTrashRetentionSetResponseErrorCode, err error) *pb.RpcTrashRetentionSetResponse {
+		m := &pb.RpcTrashRetentionSetResponse{Error: &pb.RpcTrashRetentionSetResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[trash.Service](mw).SetRetention(req.Retention)
+	if errors.Is(err, trash.ErrInvalidRetention) {
+		return response(pb.RpcTrashRetentionSetResponseError_BAD_INPUT, err)
+	}
+	if err != nil {
+		return response(pb.RpcTrashRetentionSetResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcTrashRetentionSetResponseError_NULL, nil)
+}
+
+func (mw *Middleware) TrashRetentionGet(cctx context.Context, req *pb.RpcTrashRetentionGetRequest) *pb.RpcTrashRetentionGetResponse {
+	response := func(code pb.RpcTrashRetentionGetResponseErrorCode, err error, retention *pb.RpcTrashRetention) *pb.RpcTrashRetentionGetResponse {
+		m := &pb.RpcTrashRetentionGetResponse{
+			Error:     &pb.RpcTrashRetentionGetResponseError{Code: code},
+			Retention: retention,
+		}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	retention, err := getService[trash.Service](mw).GetRetention()
+	if err != nil {
+		return response(pb.RpcTrashRetentionGetResponseError_UNKNOWN_ERROR, err, nil)
+	}
+	return response(pb.RpcTrashRetentionGetResponseError_NULL, nil, retention)
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/import/source"
+	"github.com/anyproto/anytype-heart/core/block/userdata"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) UserDataDump(cctx context.Context, req *pb.RpcUserDataDumpRequest) *pb.RpcUserDataDumpResponse {
+	response := func(code pb.RpcUserDataDumpResponseErrorCode, err error, path string) *pb.RpcUserDataDumpResponse {
+		m := &pb.RpcUserDataDumpResponse{Error: &pb.RpcUserDataDumpResponseError{Code: code}, Path: path}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	path, err := getService[userdata.Service](mw).Dump(cctx, req.Path, req.Password)
+	if errors.Is(err, userdata.ErrBadInput) {
+		return response(pb.RpcUserDataDumpResponseError_BAD_INPUT, err, "")
+	}
+	if err != nil {
+		return response(pb.RpcUserDataDumpResponseError_UNKNOWN_ERROR, err, "")
+	}
+	return response(pb.RpcUserDataDumpResponseError_NULL, nil, path)
+}
+
+func (mw *Middleware) UserDataRestore(cctx context.Context, req *pb.RpcUserDataRestoreRequest) *pb.RpcUserDataRestoreResponse {
+	response := func(code pb.RpcUserDataRestoreResponseErrorCode, err error, spaceIDs []string) *pb.RpcUserDataRestoreResponse {
+		m := &pb.RpcUserDataRestoreResponse{Error: &pb.RpcUserDataRestoreResponseError{Code: code}, SpaceIds: spaceIDs}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	spaceIDs, err := getService[userdata.Service](mw).Restore(cctx, req.Path, req.Password)
+	switch {
+	case err == nil:
+		return response(pb.RpcUserDataRestoreResponseError_NULL, nil, spaceIDs)
+	case errors.Is(err, context.Canceled):
+		return response(pb.RpcUserDataRestoreResponseError_CANCELED, err, nil)
+	case errors.Is(err, userdata.ErrAccountMismatch):
+		return response(pb.RpcUserDataRestoreResponseError_ACCOUNT_MISMATCH, err, nil)
+	case errors.Is(err, userdata.ErrNotEnoughSpace):
+		return response(pb.RpcUserDataRestoreResponseError_NOT_ENOUGH_SPACE, err, nil)
+	case errors.Is(err, source.ErrWrongPassword), errors.Is(err, source.ErrPasswordRequired):
+		return response(pb.RpcUserDataRestoreResponseError_WRONG_PASSWORD, err, spaceIDs)
+	case errors.Is(err, userdata.ErrBadInput), errors.Is(err, userdata.ErrInvalidArchive):
+		return response(pb.RpcUserDataRestoreResponseError_BAD_INPUT, err, spaceIDs)
+	default:
+		return response(pb.RpcUserDataRestoreResponseError_UNKNOWN_ERROR, err, spaceIDs)
+	}
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/webhook"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) WebhookRegister(cctx context.Context, req *pb.RpcWebhookRegisterRequest) *pb.RpcWebhookRegisterResponse {
+	response := func(code pb.RpcWebhookRegisterResponseErrorCode, err error, id string) *pb.RpcWebhookRegisterResponse {
+		m := &pb.RpcWebhookRegisterResponse{Error: &pb.RpcWebhookRegisterResponseError{Code: code}, Id: id}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	id, err := getService[webhook.Service](mw).Register(req.Hook)
+	if errors.Is(err, webhook.ErrBadInput) {
+		return response(pb.RpcWebhookRegisterResponseError_BAD_INPUT, err, "")
+	}
+	if err != nil {
+		return response(pb.RpcWebhookRegisterResponseError_UNKNOWN_ERROR, err, "")
+	}
+	return response(pb.RpcWebhookRegisterResponseError_NULL, nil, id)
+}
+
+func (mw *Middleware) WebhookUnregister(cctx context.Context, req *pb.RpcWebhookUnregisterRequest) *pb.RpcWebhookUnregisterResponse {
+	response := func(code pb.RpcWebhookUnregisterResponseErrorCode, err error) *pb.RpcWebhookUnregisterResponse {
+		m := &pb.RpcWebhookUnregisterResponse{Error: &pb.RpcWebhookUnregisterResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = err.Error()
+		}
+		return m
+	}
+
+	err := getService[webhook.Service](mw).Unregister(req.Id)
+	if errors.Is(err, webhook.ErrNotFound) {
+		return response(pb.RpcWebhookUnregisterResponseError_NOT_FOUND, err)
+	}
+	if err != nil {
+		return response(pb.RpcWebhookUnregisterResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcWebhookUnregisterResponseError_NULL, nil)
+}
+
+func (mw *Middleware) WebhookList(cctx context.Context, req *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse {
+	return &pb.RpcWebhookListResponse{
+		Error: &pb.RpcWebhookListResponseError{Code: pb.RpcWebhookListResponseError_NULL},
+		Hooks: getService[webhook.Service](mw).List(),
+	}
+}
@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/exp/slices"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+// ignoredRelations are changed without changes of the object, e.g. when it's opened
+var ignoredRelations = []string{
+	bundle.RelationKeyLastOpenedDate.String(),
+}
+
+// change is the body of request to webhook
+type change struct {
+	HookID           string                  `json:"hookId"`
+	ChangeType       pb.RpcWebhookChangeType `json:"-"`
+	Type             string                  `json:"changeType"`
+	ObjectID         string                  `json:"objectId"`
+	SpaceID          string                  `json:"spaceId"`
+	TypeID           string                  `json:"typeId"`
+	ChangedRelations []string                `json:"changedRelations,omitempty"`
+	Details          json.RawMessage         `json:"details"`
+	Timestamp        int64                   `json:"timestamp"`
+}
+
+// newChange describes change of object details. Deleted object keeps only its id, so space, type and details
+// are taken from the details before deletion. False is returned, if nothing is changed for webhooks
+func newChange(id string, oldDetails, newDetails *types.Struct) (*change, bool) {
+	c := &change{ObjectID: id}
+	details := newDetails
+	wasDeleted := pbtypes.GetBool(oldDetails, bundle.RelationKeyIsDeleted.String())
+	switch {
+	case pbtypes.GetBool(newDetails, bundle.RelationKeyIsDeleted.String()):
+		if oldDetails == nil || wasDeleted {
+			return nil, false
+		}
+		c.ChangeType = pb.RpcWebhook_Deleted
+		details = oldDetails
+	case oldDetails == nil || wasDeleted:
+		c.ChangeType = pb.RpcWebhook_Created
+	default:
+		c.ChangeType = pb.RpcWebhook_Updated
+		c.ChangedRelations = changedRelations(oldDetails, newDetails)
+		if len(c.ChangedRelations) == 0 {
+			return nil, false
+		}
+	}
+	c.Type = changeTypeName(c.ChangeType)
+	c.SpaceID = pbtypes.GetString(details, bundle.RelationKeySpaceId.String())
+	c.TypeID = pbtypes.GetString(details, bundle.RelationKeyType.String())
+	raw, err := (&jsonpb.Marshaler{}).MarshalToString(details)
+	if err != nil {
+		log.Errorf("marshal details of %s: %s", id, err)
+		return nil, false
+	}
+	c.Details = json.RawMessage(raw)
+	return c, true
+}
+
+func changeTypeName(changeType pb.RpcWebhookChangeType) string {
+	switch changeType {
+	case pb.RpcWebhook_Created:
+		return "created"
+	case pb.RpcWebhook_Deleted:
+		return "deleted"
+	default:
+		return "updated"
+	}
+}
+
+// changedRelations returns sorted keys of relations, which are added, removed or changed
+func changedRelations(oldDetails, newDetails *types.Struct) []string {
+	var keys []string
+	for key, value := range newDetails.GetFields() {
+		if !value.Equal(oldDetails.GetFields()[key]) && !slices.Contains(ignoredRelations, key) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range oldDetails.GetFields() {
+		if _, ok := newDetails.GetFields()[key]; !ok && !slices.Contains(ignoredRelations, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (c *change) matches(filter *pb.RpcWebhookFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.SpaceIds) > 0 && !slices.Contains(filter.SpaceIds, c.SpaceID) {
+		return false
+	}
+	if len(filter.TypeIds) > 0 && !slices.Contains(filter.TypeIds, c.TypeID) {
+		return false
+	}
+	if len(filter.ChangeTypes) > 0 && !slices.Contains(filter.ChangeTypes, c.ChangeType) {
+		return false
+	}
+	if len(filter.RelationKeys) > 0 && c.ChangeType == pb.RpcWebhook_Updated {
+		return slices.ContainsFunc(c.ChangedRelations, func(key string) bool {
+			return slices.Contains(filter.RelationKeys, key)
+		})
+	}
+	return true
+}
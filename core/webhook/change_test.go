@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func TestNewChange(t *testing.T) {
+	details := &types.Struct{Fields: map[string]*types.Value{
+		bundle.RelationKeySpaceId.String(): pbtypes.String("space1"),
+		bundle.RelationKeyType.String():    pbtypes.String("type1"),
+		bundle.RelationKeyName.String():    pbtypes.String("name"),
+	}}
+
+	t.Run("created", func(t *testing.T) {
+		// when
+		c, ok := newChange("id", nil, details)
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, pb.RpcWebhook_Created, c.ChangeType)
+		assert.Equal(t, "created", c.Type)
+		assert.Equal(t, "space1", c.SpaceID)
+		assert.Equal(t, "type1", c.TypeID)
+		assert.Empty(t, c.ChangedRelations)
+	})
+	t.Run("updated", func(t *testing.T) {
+		// given
+		newDetails := pbtypes.CopyStruct(details)
+		newDetails.Fields[bundle.RelationKeyName.String()] = pbtypes.String("new name")
+		newDetails.Fields[bundle.RelationKeyDescription.String()] = pbtypes.String("description")
+
+		// when
+		c, ok := newChange("id", details, newDetails)
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, pb.RpcWebhook_Updated, c.ChangeType)
+		assert.Equal(t, []string{bundle.RelationKeyDescription.String(), bundle.RelationKeyName.String()}, c.ChangedRelations)
+	})
+	t.Run("only ignored relations are changed", func(t *testing.T) {
+		// given
+		newDetails := pbtypes.CopyStruct(details)
+		newDetails.Fields[bundle.RelationKeyLastOpenedDate.String()] = pbtypes.Int64(100)
+
+		// when
+		_, ok := newChange("id", details, newDetails)
+
+		// then
+		assert.False(t, ok)
+	})
+	t.Run("deleted", func(t *testing.T) {
+		// given
+		newDetails := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsDeleted.String(): pbtypes.Bool(true),
+		}}
+
+		// when
+		c, ok := newChange("id", details, newDetails)
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, pb.RpcWebhook_Deleted, c.ChangeType)
+		assert.Equal(t, "space1", c.SpaceID)
+		assert.Equal(t, "type1", c.TypeID)
+	})
+	t.Run("already deleted", func(t *testing.T) {
+		// given
+		deleted := &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyIsDeleted.String(): pbtypes.Bool(true),
+		}}
+
+		// when
+		_, ok := newChange("id", deleted, pbtypes.CopyStruct(deleted))
+
+		// then
+		assert.False(t, ok)
+	})
+}
+
+func TestChangeMatches(t *testing.T) {
+	c := &change{
+		ChangeType:       pb.RpcWebhook_Updated,
+		SpaceID:          "space1",
+		TypeID:           "type1",
+		ChangedRelations: []string{"name"},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		filter  *pb.RpcWebhookFilter
+		matches bool
+	}{
+		{name: "no filter", filter: nil, matches: true},
+		{name: "space", filter: &pb.RpcWebhookFilter{SpaceIds: []string{"space1"}}, matches: true},
+		{name: "other space", filter: &pb.RpcWebhookFilter{SpaceIds: []string{"space2"}}, matches: false},
+		{name: "other type", filter: &pb.RpcWebhookFilter{TypeIds: []string{"type2"}}, matches: false},
+		{name: "relation", filter: &pb.RpcWebhookFilter{RelationKeys: []string{"name", "done"}}, matches: true},
+		{name: "other relation", filter: &pb.RpcWebhookFilter{RelationKeys: []string{"done"}}, matches: false},
+		{name: "other change type", filter: &pb.RpcWebhookFilter{ChangeTypes: []pb.RpcWebhookChangeType{pb.RpcWebhook_Created}}, matches: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.matches, c.matches(tc.filter))
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+const (
+	// SignatureHeader contains hex of HMAC-SHA256 of the request body with the secret of webhook
+	SignatureHeader = "X-Anytype-Signature"
+
+	// queueSize is the number of changes queued for each webhook
+	queueSize      = 1024
+	maxAttempts    = 5
+	requestTimeout = 10 * time.Second
+	initialBackoff = time.Second
+)
+
+type request struct {
+	hook   *pb.RpcWebhookHook
+	change change
+}
+
+// sender delivers changes to each webhook by its own worker, so webhook receives changes in order and unavailable
+// webhook doesn't delay other ones. Failed request is retried with backoff
+type sender struct {
+	client  *http.Client
+	backoff time.Duration
+
+	mu      sync.Mutex
+	workers map[string]*worker
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// worker is the queue of changes of one webhook
+type worker struct {
+	hook   *pb.RpcWebhookHook
+	queue  chan change
+	cancel context.CancelFunc
+	// dropped is the number of changes dropped since the queue was full, it is guarded by sender.mu
+	dropped int
+}
+
+func newSender() *sender {
+	return &sender{
+		client:  &http.Client{Timeout: requestTimeout},
+		backoff: initialBackoff,
+		workers: map[string]*worker{},
+	}
+}
+
+func (s *sender) run() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+}
+
+func (s *sender) close() {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.workers = map[string]*worker{}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// send queues the change without blocking, the change is dropped if the queue of webhook is full
+func (s *sender) send(hook *pb.RpcWebhookHook, c *change) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx == nil || s.ctx.Err() != nil {
+		return
+	}
+	w, ok := s.workers[hook.Id]
+	if !ok {
+		w = s.startWorker(hook)
+	}
+	select {
+	case w.queue <- *c:
+		if w.dropped > 0 {
+			log.With("hookId", hook.Id).Warnf("webhook queue is available again, %d changes were dropped", w.dropped)
+			w.dropped = 0
+		}
+	default:
+		w.dropped++
+		if w.dropped == 1 {
+			log.With("hookId", hook.Id).Warnf("webhook queue is full, changes are dropped starting from change of %s", c.ObjectID)
+		}
+	}
+}
+
+// droppedCount returns the number of changes of webhook dropped since its queue was full
+func (s *sender) droppedCount(hookID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w, ok := s.workers[hookID]; ok {
+		return w.dropped
+	}
+	return 0
+}
+
+// remove stops the worker of webhook, queued changes are not delivered
+func (s *sender) remove(hookID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if w, ok := s.workers[hookID]; ok {
+		w.cancel()
+		delete(s.workers, hookID)
+	}
+}
+
+func (s *sender) startWorker(hook *pb.RpcWebhookHook) *worker {
+	ctx, cancel := context.WithCancel(s.ctx)
+	w := &worker{hook: hook, queue: make(chan change, queueSize), cancel: cancel}
+	s.workers[hook.Id] = w
+	s.wg.Add(1)
+	go s.loop(ctx, w)
+	return w
+}
+
+func (s *sender) loop(ctx context.Context, w *worker) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case c := <-w.queue:
+			if err := s.deliver(ctx, request{hook: w.hook, change: c}); err != nil {
+				log.With("hookId", w.hook.Id).Errorf("failed to call webhook: %s", err)
+			}
+		}
+	}
+}
+
+func (s *sender) deliver(ctx context.Context, req request) error {
+	req.change.HookID = req.hook.Id
+	body, err := json.Marshal(req.change)
+	if err != nil {
+		return fmt.Errorf("marshal change: %w", err)
+	}
+	signature := sign(req.hook.Secret, body)
+	backoff := s.backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := s.post(ctx, req.hook.Url, body, signature)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt == maxAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// post sends the body to url. Retry is false, if the request is rejected by webhook and repeating doesn't help
+func (s *sender) post(ctx context.Context, url string, body []byte, signature string) (retry bool, err error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(SignatureHeader, signature)
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return true, err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
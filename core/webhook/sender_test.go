@@ -0,0 +1,157 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+type fakeWebhook struct {
+	mu       sync.Mutex
+	statuses []int
+	calls    int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (f *fakeWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	status := http.StatusOK
+	if f.calls < len(f.statuses) {
+		status = f.statuses[f.calls]
+	}
+	f.calls++
+	f.bodies = append(f.bodies, body)
+	f.headers = append(f.headers, r.Header.Clone())
+	w.WriteHeader(status)
+}
+
+func newTestSender(t *testing.T) *sender {
+	s := newSender()
+	s.backoff = time.Millisecond
+	s.run()
+	t.Cleanup(s.close)
+	return s
+}
+
+func TestSenderDeliver(t *testing.T) {
+	c := change{ChangeType: pb.RpcWebhook_Created, Type: "created", ObjectID: "id", Details: []byte(`{}`)}
+
+	t.Run("request is signed", func(t *testing.T) {
+		// given
+		webhook := &fakeWebhook{}
+		server := httptest.NewServer(webhook)
+		defer server.Close()
+		s := newTestSender(t)
+
+		// when
+		err := s.deliver(context.Background(), request{hook: &pb.RpcWebhookHook{Id: "hook1", Url: server.URL, Secret: "secret"}, change: c})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 1, webhook.calls)
+		assert.Equal(t, sign("secret", webhook.bodies[0]), webhook.headers[0].Get(SignatureHeader))
+		assert.Contains(t, string(webhook.bodies[0]), `"hookId":"hook1"`)
+	})
+	t.Run("server error is retried", func(t *testing.T) {
+		// given
+		webhook := &fakeWebhook{statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests}}
+		server := httptest.NewServer(webhook)
+		defer server.Close()
+		s := newTestSender(t)
+
+		// when
+		err := s.deliver(context.Background(), request{hook: &pb.RpcWebhookHook{Url: server.URL, Secret: "secret"}, change: c})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 3, webhook.calls)
+	})
+	t.Run("rejected request isn't retried", func(t *testing.T) {
+		// given
+		webhook := &fakeWebhook{statuses: []int{http.StatusBadRequest}}
+		server := httptest.NewServer(webhook)
+		defer server.Close()
+		s := newTestSender(t)
+
+		// when
+		err := s.deliver(context.Background(), request{hook: &pb.RpcWebhookHook{Url: server.URL, Secret: "secret"}, change: c})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, 1, webhook.calls)
+	})
+	t.Run("attempts are limited", func(t *testing.T) {
+		// given
+		webhook := &fakeWebhook{statuses: []int{500, 500, 500, 500, 500, 500}}
+		server := httptest.NewServer(webhook)
+		defer server.Close()
+		s := newTestSender(t)
+
+		// when
+		err := s.deliver(context.Background(), request{hook: &pb.RpcWebhookHook{Url: server.URL, Secret: "secret"}, change: c})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, maxAttempts, webhook.calls)
+	})
+}
+
+func TestSenderSend(t *testing.T) {
+	c := &change{ChangeType: pb.RpcWebhook_Created, Type: "created", ObjectID: "id", Details: []byte(`{}`)}
+
+	t.Run("unavailable webhook doesn't delay other webhooks", func(t *testing.T) {
+		// given
+		release := make(chan struct{})
+		blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer blocked.Close()
+		defer close(release)
+		webhook := &fakeWebhook{}
+		server := httptest.NewServer(webhook)
+		defer server.Close()
+		s := newTestSender(t)
+
+		// when
+		s.send(&pb.RpcWebhookHook{Id: "blocked", Url: blocked.URL, Secret: "secret"}, c)
+		s.send(&pb.RpcWebhookHook{Id: "hook", Url: server.URL, Secret: "secret"}, c)
+
+		// then
+		assert.Eventually(t, func() bool {
+			webhook.mu.Lock()
+			defer webhook.mu.Unlock()
+			return webhook.calls == 1
+		}, time.Second, 10*time.Millisecond)
+	})
+	t.Run("changes are dropped and counted, when queue is full", func(t *testing.T) {
+		// given
+		release := make(chan struct{})
+		blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer blocked.Close()
+		defer close(release)
+		s := newTestSender(t)
+		hook := &pb.RpcWebhookHook{Id: "blocked", Url: blocked.URL, Secret: "secret"}
+
+		// when
+		for i := 0; i < queueSize+2; i++ {
+			s.send(hook, c)
+		}
+
+		// then
+		assert.Greater(t, s.droppedCount(hook.Id), 0)
+	})
+}
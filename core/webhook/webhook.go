@@ -0,0 +1,231 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/anytype/config"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+)
+
+const CName = "webhook"
+
+var log = logging.Logger("anytype-mw-webhook")
+
+var (
+	ErrBadInput = errors.New("bad input")
+	ErrNotFound = errors.New("webhook is not found")
+)
+
+const (
+	hookKeyPrefix      = "/webhook/"
+	secretVisibleChars = 4
+)
+
+// Service sends changes of objects to the registered webhooks. Changes are taken from the object store, so webhook
+// is called for changes made on this device and for changes received from other devices
+type Service interface {
+	Register(hook *pb.RpcWebhookHook) (id string, err error)
+	Unregister(id string) error
+	List() []*pb.RpcWebhookHook
+	app.ComponentRunnable
+}
+
+func New() Service {
+	return &service{
+		sender: newSender(),
+		now:    time.Now,
+		hooks:  map[string]*pb.RpcWebhookHook{},
+	}
+}
+
+type service struct {
+	objectStore objectstore.ObjectStore
+	db          *badger.DB
+	sender      *sender
+	now         func() time.Time
+	// readOnly forbids changes of webhooks, when account is opened in read-only mode
+	readOnly bool
+
+	mu    sync.Mutex
+	hooks map[string]*pb.RpcWebhookHook
+}
+
+func (s *service) Init(a *app.App) (err error) {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		s.readOnly = cfg.ReadOnly
+	}
+	return nil
+}
+
+func (s *service) Name() (name string) {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	hooks, err := s.loadHooks()
+	if err != nil {
+		return fmt.Errorf("load webhooks: %w", err)
+	}
+	s.mu.Lock()
+	s.hooks = hooks
+	s.mu.Unlock()
+	// objects aren't changed in read-only mode, so there is nothing to send
+	if s.readOnly {
+		return nil
+	}
+	s.sender.run()
+	s.objectStore.SubscribeForChanges(s.onChange)
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	s.sender.close()
+	return nil
+}
+
+// Register saves the webhook and returns its id
+func (s *service) Register(hook *pb.RpcWebhookHook) (string, error) {
+	if s.readOnly {
+		return "", domain.ErrReadOnlyMode
+	}
+	if err := validateHook(hook); err != nil {
+		return "", err
+	}
+	hook = &pb.RpcWebhookHook{
+		Id:     uuid.New().String(),
+		Url:    hook.Url,
+		Secret: hook.Secret,
+		Filter: hook.Filter,
+	}
+	if err := badgerhelper.SetValue(s.db, hookKey(hook.Id), hook); err != nil {
+		return "", fmt.Errorf("save webhook: %w", err)
+	}
+	s.mu.Lock()
+	s.hooks[hook.Id] = hook
+	s.mu.Unlock()
+	return hook.Id, nil
+}
+
+func (s *service) Unregister(id string) error {
+	if s.readOnly {
+		return domain.ErrReadOnlyMode
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.hooks[id]; !ok {
+		return ErrNotFound
+	}
+	if err := badgerhelper.DeleteValue(s.db, hookKey(id)); err != nil {
+		return fmt.Errorf("remove webhook: %w", err)
+	}
+	delete(s.hooks, id)
+	s.sender.remove(id)
+	return nil
+}
+
+// List returns registered webhooks sorted by id. Secrets are masked, so they can't be read back by clients
+func (s *service) List() []*pb.RpcWebhookHook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hooks := make([]*pb.RpcWebhookHook, 0, len(s.hooks))
+	for _, hook := range s.hooks {
+		hooks = append(hooks, &pb.RpcWebhookHook{
+			Id:     hook.Id,
+			Url:    hook.Url,
+			Secret: maskSecret(hook.Secret),
+			Filter: hook.Filter,
+		})
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		return hooks[i].Id < hooks[j].Id
+	})
+	return hooks
+}
+
+// maskSecret keeps only the last characters of long secrets, so user can tell secrets apart
+func maskSecret(secret string) string {
+	if len(secret) <= secretVisibleChars*2 {
+		return strings.Repeat("*", len(secret))
+	}
+	return strings.Repeat("*", len(secret)-secretVisibleChars) + secret[len(secret)-secretVisibleChars:]
+}
+
+func validateHook(hook *pb.RpcWebhookHook) error {
+	if hook == nil {
+		return fmt.Errorf("%w: webhook is empty", ErrBadInput)
+	}
+	u, err := url.Parse(hook.Url)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrBadInput, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url should be absolute http or https url", ErrBadInput)
+	}
+	if hook.Secret == "" {
+		return fmt.Errorf("%w: secret is required to sign requests", ErrBadInput)
+	}
+	return nil
+}
+
+func hookKey(id string) []byte {
+	return []byte(hookKeyPrefix + id)
+}
+
+func (s *service) loadHooks() (map[string]*pb.RpcWebhookHook, error) {
+	hooks := make(map[string]*pb.RpcWebhookHook)
+	err := s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: []byte(hookKeyPrefix), PrefetchValues: true})
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			hook := &pb.RpcWebhookHook{}
+			if err = hook.Unmarshal(raw); err != nil {
+				return fmt.Errorf("unmarshal webhook: %w", err)
+			}
+			hooks[hook.Id] = hook
+		}
+		return nil
+	})
+	return hooks, err
+}
+
+// onChange is called by the object store, so it only queues requests to the matching webhooks
+func (s *service) onChange(id string, oldDetails, newDetails *types.Struct) {
+	c, ok := newChange(id, oldDetails, newDetails)
+	if !ok {
+		return
+	}
+	c.Timestamp = s.now().Unix()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, hook := range s.hooks {
+		if c.matches(hook.Filter) {
+			s.sender.send(hook, c)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func TestService_List(t *testing.T) {
+	// given
+	s := &service{hooks: map[string]*pb.RpcWebhookHook{
+		"b": {Id: "b", Url: "https://example.com/b", Secret: "short"},
+		"a": {Id: "a", Url: "https://example.com/a", Secret: "very-long-secret"},
+	}}
+
+	// when
+	hooks := s.List()
+
+	// then
+	assert.Len(t, hooks, 2)
+	assert.Equal(t, "a", hooks[0].Id)
+	assert.Equal(t, "************cret", hooks[0].Secret)
+	assert.Equal(t, "*****", hooks[1].Secret)
+	assert.Equal(t, "very-long-secret", s.hooks["a"].Secret)
+}
+
+func TestService_ReadOnly(t *testing.T) {
+	// given
+	s := New().(*service)
+	s.readOnly = true
+
+	// when
+	_, registerErr := s.Register(&pb.RpcWebhookHook{Url: "https://example.com", Secret: "secret"})
+	unregisterErr := s.Unregister("hook")
+
+	// then
+	assert.ErrorIs(t, registerErr, domain.ErrReadOnlyMode)
+	assert.ErrorIs(t, unregisterErr, domain.ErrReadOnlyMode)
+}
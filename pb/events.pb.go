@@ -58,6 +58,31 @@ func (EventBlockDataviewSliceOperation) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 6, 0}
 }
 
+type EventProcessErrorSeverity int32
+
+const (
+	EventProcessError_Error   EventProcessErrorSeverity = 0
+	EventProcessError_Warning EventProcessErrorSeverity = 1
+)
+
+var EventProcessErrorSeverity_name = map[int32]string{
+	0: "Error",
+	1: "Warning",
+}
+
+var EventProcessErrorSeverity_value = map[string]int32{
+	"Error":   0,
+	"Warning": 1,
+}
+
+func (x EventProcessErrorSeverity) String() string {
+	return proto.EnumName(EventProcessErrorSeverity_name, int32(x))
+}
+
+func (EventProcessErrorSeverity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 3, 0}
+}
+
 type EventStatusThreadSyncStatus int32
 
 const (
@@ -95,15 +120,49 @@ func (EventStatusThreadSyncStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a966342d378ae5f5, []int{0, 7, 0, 0}
 }
 
+type EventFileUploadProgressState int32
+
+const (
+	EventFileUploadProgress_Started    EventFileUploadProgressState = 0
+	EventFileUploadProgress_InProgress EventFileUploadProgressState = 1
+	EventFileUploadProgress_Finished   EventFileUploadProgressState = 2
+	EventFileUploadProgress_Failed     EventFileUploadProgressState = 3
+)
+
+var EventFileUploadProgressState_name = map[int32]string{
+	0: "Started",
+	1: "InProgress",
+	2: "Finished",
+	3: "Failed",
+}
+
+var EventFileUploadProgressState_value = map[string]int32{
+	"Started":    0,
+	"InProgress": 1,
+	"Finished":   2,
+	"Failed":     3,
+}
+
+func (x EventFileUploadProgressState) String() string {
+	return proto.EnumName(EventFileUploadProgressState_name, int32(x))
+}
+
+func (EventFileUploadProgressState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 3, 0}
+}
+
 type ModelProcessType int32
 
 const (
-	ModelProcess_DropFiles      ModelProcessType = 0
-	ModelProcess_Import         ModelProcessType = 1
-	ModelProcess_Export         ModelProcessType = 2
-	ModelProcess_SaveFile       ModelProcessType = 3
-	ModelProcess_RecoverAccount ModelProcessType = 4
-	ModelProcess_Migration      ModelProcessType = 5
+	ModelProcess_DropFiles       ModelProcessType = 0
+	ModelProcess_Import          ModelProcessType = 1
+	ModelProcess_Export          ModelProcessType = 2
+	ModelProcess_SaveFile        ModelProcessType = 3
+	ModelProcess_RecoverAccount  ModelProcessType = 4
+	ModelProcess_Migration       ModelProcessType = 5
+	ModelProcess_UserDataRestore ModelProcessType = 6
+	ModelProcess_BlockTransform  ModelProcessType = 7
+	ModelProcess_FindReplace     ModelProcessType = 8
 )
 
 var ModelProcessType_name = map[int32]string{
@@ -113,15 +172,21 @@ var ModelProcessType_name = map[int32]string{
 	3: "SaveFile",
 	4: "RecoverAccount",
 	5: "Migration",
+	6: "UserDataRestore",
+	7: "BlockTransform",
+	8: "FindReplace",
 }
 
 var ModelProcessType_value = map[string]int32{
-	"DropFiles":      0,
-	"Import":         1,
-	"Export":         2,
-	"SaveFile":       3,
-	"RecoverAccount": 4,
-	"Migration":      5,
+	"DropFiles":       0,
+	"Import":          1,
+	"Export":          2,
+	"SaveFile":        3,
+	"RecoverAccount":  4,
+	"Migration":       5,
+	"UserDataRestore": 6,
+	"BlockTransform":  7,
+	"FindReplace":     8,
 }
 
 func (x ModelProcessType) String() string {
@@ -293,10 +358,17 @@ type EventMessage struct {
 	//	*EventMessageValueOfProcessNew
 	//	*EventMessageValueOfProcessUpdate
 	//	*EventMessageValueOfProcessDone
+	//	*EventMessageValueOfProcessError
 	//	*EventMessageValueOfThreadStatus
 	//	*EventMessageValueOfFileLimitReached
 	//	*EventMessageValueOfFileSpaceUsage
 	//	*EventMessageValueOfFileLocalUsage
+	//	*EventMessageValueOfFileUploadProgress
+	//	*EventMessageValueOfBackupDone
+	//	*EventMessageValueOfBackupError
+	//	*EventMessageValueOfAutomationExecuted
+	//	*EventMessageValueOfSavedSearchQueryChanged
+	//	*EventMessageValueOfTrashPurge
 	Value IsEventMessageValue `protobuf_oneof:"value"`
 }
 
@@ -507,6 +579,9 @@ type EventMessageValueOfProcessUpdate struct {
 type EventMessageValueOfProcessDone struct {
 	ProcessDone *EventProcessDone `protobuf:"bytes,103,opt,name=processDone,proto3,oneof" json:"processDone,omitempty"`
 }
+type EventMessageValueOfProcessError struct {
+	ProcessError *EventProcessError `protobuf:"bytes,104,opt,name=processError,proto3,oneof" json:"processError,omitempty"`
+}
 type EventMessageValueOfThreadStatus struct {
 	ThreadStatus *EventStatusThread `protobuf:"bytes,110,opt,name=threadStatus,proto3,oneof" json:"threadStatus,omitempty"`
 }
@@ -519,6 +594,24 @@ type EventMessageValueOfFileSpaceUsage struct {
 type EventMessageValueOfFileLocalUsage struct {
 	FileLocalUsage *EventFileLocalUsage `protobuf:"bytes,113,opt,name=fileLocalUsage,proto3,oneof" json:"fileLocalUsage,omitempty"`
 }
+type EventMessageValueOfFileUploadProgress struct {
+	FileUploadProgress *EventFileUploadProgress `protobuf:"bytes,114,opt,name=fileUploadProgress,proto3,oneof" json:"fileUploadProgress,omitempty"`
+}
+type EventMessageValueOfBackupDone struct {
+	BackupDone *EventBackupDone `protobuf:"bytes,115,opt,name=backupDone,proto3,oneof" json:"backupDone,omitempty"`
+}
+type EventMessageValueOfBackupError struct {
+	BackupError *EventBackupError `protobuf:"bytes,116,opt,name=backupError,proto3,oneof" json:"backupError,omitempty"`
+}
+type EventMessageValueOfAutomationExecuted struct {
+	AutomationExecuted *EventAutomationExecuted `protobuf:"bytes,117,opt,name=automationExecuted,proto3,oneof" json:"automationExecuted,omitempty"`
+}
+type EventMessageValueOfSavedSearchQueryChanged struct {
+	SavedSearchQueryChanged *EventSavedSearchQueryChanged `protobuf:"bytes,118,opt,name=savedSearchQueryChanged,proto3,oneof" json:"savedSearchQueryChanged,omitempty"`
+}
+type EventMessageValueOfTrashPurge struct {
+	TrashPurge *EventTrashPurge `protobuf:"bytes,119,opt,name=trashPurge,proto3,oneof" json:"trashPurge,omitempty"`
+}
 
 func (*EventMessageValueOfAccountShow) IsEventMessageValue()                    {}
 func (*EventMessageValueOfAccountDetails) IsEventMessageValue()                 {}
@@ -576,10 +669,17 @@ func (*EventMessageValueOfPing) IsEventMessageValue()
 func (*EventMessageValueOfProcessNew) IsEventMessageValue()                     {}
 func (*EventMessageValueOfProcessUpdate) IsEventMessageValue()                  {}
 func (*EventMessageValueOfProcessDone) IsEventMessageValue()                    {}
+func (*EventMessageValueOfProcessError) IsEventMessageValue()                   {}
 func (*EventMessageValueOfThreadStatus) IsEventMessageValue()                   {}
 func (*EventMessageValueOfFileLimitReached) IsEventMessageValue()               {}
 func (*EventMessageValueOfFileSpaceUsage) IsEventMessageValue()                 {}
 func (*EventMessageValueOfFileLocalUsage) IsEventMessageValue()                 {}
+func (*EventMessageValueOfFileUploadProgress) IsEventMessageValue()             {}
+func (*EventMessageValueOfBackupDone) IsEventMessageValue()                     {}
+func (*EventMessageValueOfBackupError) IsEventMessageValue()                    {}
+func (*EventMessageValueOfAutomationExecuted) IsEventMessageValue()             {}
+func (*EventMessageValueOfSavedSearchQueryChanged) IsEventMessageValue()        {}
+func (*EventMessageValueOfTrashPurge) IsEventMessageValue()                     {}
 
 func (m *EventMessage) GetValue() IsEventMessageValue {
 	if m != nil {
@@ -980,6 +1080,13 @@ func (m *EventMessage) GetProcessDone() *EventProcessDone {
 	return nil
 }
 
+func (m *EventMessage) GetProcessError() *EventProcessError {
+	if x, ok := m.GetValue().(*EventMessageValueOfProcessError); ok {
+		return x.ProcessError
+	}
+	return nil
+}
+
 func (m *EventMessage) GetThreadStatus() *EventStatusThread {
 	if x, ok := m.GetValue().(*EventMessageValueOfThreadStatus); ok {
 		return x.ThreadStatus
@@ -1008,6 +1115,48 @@ func (m *EventMessage) GetFileLocalUsage() *EventFileLocalUsage {
 	return nil
 }
 
+func (m *EventMessage) GetFileUploadProgress() *EventFileUploadProgress {
+	if x, ok := m.GetValue().(*EventMessageValueOfFileUploadProgress); ok {
+		return x.FileUploadProgress
+	}
+	return nil
+}
+
+func (m *EventMessage) GetBackupDone() *EventBackupDone {
+	if x, ok := m.GetValue().(*EventMessageValueOfBackupDone); ok {
+		return x.BackupDone
+	}
+	return nil
+}
+
+func (m *EventMessage) GetBackupError() *EventBackupError {
+	if x, ok := m.GetValue().(*EventMessageValueOfBackupError); ok {
+		return x.BackupError
+	}
+	return nil
+}
+
+func (m *EventMessage) GetAutomationExecuted() *EventAutomationExecuted {
+	if x, ok := m.GetValue().(*EventMessageValueOfAutomationExecuted); ok {
+		return x.AutomationExecuted
+	}
+	return nil
+}
+
+func (m *EventMessage) GetSavedSearchQueryChanged() *EventSavedSearchQueryChanged {
+	if x, ok := m.GetValue().(*EventMessageValueOfSavedSearchQueryChanged); ok {
+		return x.SavedSearchQueryChanged
+	}
+	return nil
+}
+
+func (m *EventMessage) GetTrashPurge() *EventTrashPurge {
+	if x, ok := m.GetValue().(*EventMessageValueOfTrashPurge); ok {
+		return x.TrashPurge
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1067,10 +1216,17 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfProcessNew)(nil),
 		(*EventMessageValueOfProcessUpdate)(nil),
 		(*EventMessageValueOfProcessDone)(nil),
+		(*EventMessageValueOfProcessError)(nil),
 		(*EventMessageValueOfThreadStatus)(nil),
 		(*EventMessageValueOfFileLimitReached)(nil),
 		(*EventMessageValueOfFileSpaceUsage)(nil),
 		(*EventMessageValueOfFileLocalUsage)(nil),
+		(*EventMessageValueOfFileUploadProgress)(nil),
+		(*EventMessageValueOfBackupDone)(nil),
+		(*EventMessageValueOfBackupError)(nil),
+		(*EventMessageValueOfAutomationExecuted)(nil),
+		(*EventMessageValueOfSavedSearchQueryChanged)(nil),
+		(*EventMessageValueOfTrashPurge)(nil),
 	}
 }
 
@@ -3366,8 +3522,9 @@ func (m *EventBlockSetTextIconImage) GetValue() string {
 }
 
 type EventBlockSetLatex struct {
-	Id   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text *EventBlockSetLatexText `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Id        string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      *EventBlockSetLatexText      `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Processor *EventBlockSetLatexProcessor `protobuf:"bytes,3,opt,name=processor,proto3" json:"processor,omitempty"`
 }
 
 func (m *EventBlockSetLatex) Reset()         { *m = EventBlockSetLatex{} }
@@ -3417,6 +3574,13 @@ func (m *EventBlockSetLatex) GetText() *EventBlockSetLatexText {
 	return nil
 }
 
+func (m *EventBlockSetLatex) GetProcessor() *EventBlockSetLatexProcessor {
+	if m != nil {
+		return m.Processor
+	}
+	return nil
+}
+
 type EventBlockSetLatexText struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -3461,6 +3625,50 @@ func (m *EventBlockSetLatexText) GetValue() string {
 	return ""
 }
 
+type EventBlockSetLatexProcessor struct {
+	Value model.BlockContentLatexProcessor `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentLatexProcessor" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetLatexProcessor) Reset()         { *m = EventBlockSetLatexProcessor{} }
+func (m *EventBlockSetLatexProcessor) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetLatexProcessor) ProtoMessage()    {}
+func (*EventBlockSetLatexProcessor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 8, 1}
+}
+func (m *EventBlockSetLatexProcessor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetLatexProcessor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetLatexProcessor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetLatexProcessor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetLatexProcessor.Merge(m, src)
+}
+func (m *EventBlockSetLatexProcessor) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetLatexProcessor) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetLatexProcessor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetLatexProcessor proto.InternalMessageInfo
+
+func (m *EventBlockSetLatexProcessor) GetValue() model.BlockContentLatexProcessor {
+	if m != nil {
+		return m.Value
+	}
+	return model.BlockContentLatex_Latex
+}
+
 type EventBlockSetDiv struct {
 	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Style *EventBlockSetDivStyle `protobuf:"bytes,2,opt,name=style,proto3" json:"style,omitempty"`
@@ -9474,6 +9682,75 @@ func (m *EventProcessDone) GetProcess() *ModelProcess {
 	return nil
 }
 
+// Error or warning, which occurred while process is running. Process continues, unless it is finished with error
+type EventProcessError struct {
+	ProcessId   string                    `protobuf:"bytes,1,opt,name=processId,proto3" json:"processId,omitempty"`
+	FileName    string                    `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Description string                    `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Severity    EventProcessErrorSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=anytype.EventProcessErrorSeverity" json:"severity,omitempty"`
+}
+
+func (m *EventProcessError) Reset()         { *m = EventProcessError{} }
+func (m *EventProcessError) String() string { return proto.CompactTextString(m) }
+func (*EventProcessError) ProtoMessage()    {}
+func (*EventProcessError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 3}
+}
+func (m *EventProcessError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProcessError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProcessError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProcessError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProcessError.Merge(m, src)
+}
+func (m *EventProcessError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProcessError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProcessError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProcessError proto.InternalMessageInfo
+
+func (m *EventProcessError) GetProcessId() string {
+	if m != nil {
+		return m.ProcessId
+	}
+	return ""
+}
+
+func (m *EventProcessError) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+func (m *EventProcessError) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *EventProcessError) GetSeverity() EventProcessErrorSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return EventProcessError_Error
+}
+
 type EventStatus struct {
 }
 
@@ -10086,6 +10363,548 @@ func (m *EventFileLocalUsage) GetLocalBytesUsage() uint64 {
 	return 0
 }
 
+type EventFileUploadProgress struct {
+	SpaceId       string                       `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	FileId        string                       `protobuf:"bytes,2,opt,name=fileId,proto3" json:"fileId,omitempty"`
+	State         EventFileUploadProgressState `protobuf:"varint,3,opt,name=state,proto3,enum=anytype.EventFileUploadProgressState" json:"state,omitempty"`
+	BytesUploaded uint64                       `protobuf:"varint,4,opt,name=bytesUploaded,proto3" json:"bytesUploaded,omitempty"`
+	BytesTotal    uint64                       `protobuf:"varint,5,opt,name=bytesTotal,proto3" json:"bytesTotal,omitempty"`
+	EtaSeconds    int64                        `protobuf:"varint,6,opt,name=etaSeconds,proto3" json:"etaSeconds,omitempty"`
+	Error         string                       `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventFileUploadProgress) Reset()         { *m = EventFileUploadProgress{} }
+func (m *EventFileUploadProgress) String() string { return proto.CompactTextString(m) }
+func (*EventFileUploadProgress) ProtoMessage()    {}
+func (*EventFileUploadProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 3}
+}
+func (m *EventFileUploadProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFileUploadProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFileUploadProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFileUploadProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFileUploadProgress.Merge(m, src)
+}
+func (m *EventFileUploadProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFileUploadProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFileUploadProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFileUploadProgress proto.InternalMessageInfo
+
+func (m *EventFileUploadProgress) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *EventFileUploadProgress) GetFileId() string {
+	if m != nil {
+		return m.FileId
+	}
+	return ""
+}
+
+func (m *EventFileUploadProgress) GetState() EventFileUploadProgressState {
+	if m != nil {
+		return m.State
+	}
+	return EventFileUploadProgress_Started
+}
+
+func (m *EventFileUploadProgress) GetBytesUploaded() uint64 {
+	if m != nil {
+		return m.BytesUploaded
+	}
+	return 0
+}
+
+func (m *EventFileUploadProgress) GetBytesTotal() uint64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+func (m *EventFileUploadProgress) GetEtaSeconds() int64 {
+	if m != nil {
+		return m.EtaSeconds
+	}
+	return 0
+}
+
+func (m *EventFileUploadProgress) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventBackup struct {
+}
+
+func (m *EventBackup) Reset()         { *m = EventBackup{} }
+func (m *EventBackup) String() string { return proto.CompactTextString(m) }
+func (*EventBackup) ProtoMessage()    {}
+func (*EventBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9}
+}
+func (m *EventBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBackup.Merge(m, src)
+}
+func (m *EventBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBackup proto.InternalMessageInfo
+
+// Done is sent, when scheduled backup of all spaces is written
+type EventBackupDone struct {
+	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SpacesCount int32  `protobuf:"varint,2,opt,name=spacesCount,proto3" json:"spacesCount,omitempty"`
+}
+
+func (m *EventBackupDone) Reset()         { *m = EventBackupDone{} }
+func (m *EventBackupDone) String() string { return proto.CompactTextString(m) }
+func (*EventBackupDone) ProtoMessage()    {}
+func (*EventBackupDone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0}
+}
+func (m *EventBackupDone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBackupDone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBackupDone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBackupDone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBackupDone.Merge(m, src)
+}
+func (m *EventBackupDone) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBackupDone) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBackupDone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBackupDone proto.InternalMessageInfo
+
+func (m *EventBackupDone) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *EventBackupDone) GetSpacesCount() int32 {
+	if m != nil {
+		return m.SpacesCount
+	}
+	return 0
+}
+
+// Error is sent, when scheduled backup is failed. Backup is tried again after the interval of schedule
+type EventBackupError struct {
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventBackupError) Reset()         { *m = EventBackupError{} }
+func (m *EventBackupError) String() string { return proto.CompactTextString(m) }
+func (*EventBackupError) ProtoMessage()    {}
+func (*EventBackupError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 1}
+}
+func (m *EventBackupError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBackupError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBackupError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBackupError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBackupError.Merge(m, src)
+}
+func (m *EventBackupError) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBackupError) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBackupError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBackupError proto.InternalMessageInfo
+
+func (m *EventBackupError) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *EventBackupError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventAutomation struct {
+}
+
+func (m *EventAutomation) Reset()         { *m = EventAutomation{} }
+func (m *EventAutomation) String() string { return proto.CompactTextString(m) }
+func (*EventAutomation) ProtoMessage()    {}
+func (*EventAutomation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10}
+}
+func (m *EventAutomation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutomation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutomation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutomation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutomation.Merge(m, src)
+}
+func (m *EventAutomation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutomation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutomation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutomation proto.InternalMessageInfo
+
+// Executed is sent by SendEvent action of automation rule
+type EventAutomationExecuted struct {
+	RuleId   string `protobuf:"bytes,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	SpaceId  string `protobuf:"bytes,2,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	ObjectId string `protobuf:"bytes,3,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	Message  string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *EventAutomationExecuted) Reset()         { *m = EventAutomationExecuted{} }
+func (m *EventAutomationExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventAutomationExecuted) ProtoMessage()    {}
+func (*EventAutomationExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0}
+}
+func (m *EventAutomationExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutomationExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutomationExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutomationExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutomationExecuted.Merge(m, src)
+}
+func (m *EventAutomationExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutomationExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutomationExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutomationExecuted proto.InternalMessageInfo
+
+func (m *EventAutomationExecuted) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *EventAutomationExecuted) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *EventAutomationExecuted) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *EventAutomationExecuted) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type EventSavedSearch struct {
+}
+
+func (m *EventSavedSearch) Reset()         { *m = EventSavedSearch{} }
+func (m *EventSavedSearch) String() string { return proto.CompactTextString(m) }
+func (*EventSavedSearch) ProtoMessage()    {}
+func (*EventSavedSearch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 11}
+}
+func (m *EventSavedSearch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSavedSearch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSavedSearch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSavedSearch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSavedSearch.Merge(m, src)
+}
+func (m *EventSavedSearch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSavedSearch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSavedSearch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSavedSearch proto.InternalMessageInfo
+
+// QueryChanged is sent to the subscription of saved search, when its query is changed. Records replace
+// records of the subscription
+type EventSavedSearchQueryChanged struct {
+	ObjectId     string                           `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	SubId        string                           `protobuf:"bytes,2,opt,name=subId,proto3" json:"subId,omitempty"`
+	Records      []*types.Struct                  `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	Dependencies []*types.Struct                  `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Counters     *EventObjectSubscriptionCounters `protobuf:"bytes,5,opt,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (m *EventSavedSearchQueryChanged) Reset()         { *m = EventSavedSearchQueryChanged{} }
+func (m *EventSavedSearchQueryChanged) String() string { return proto.CompactTextString(m) }
+func (*EventSavedSearchQueryChanged) ProtoMessage()    {}
+func (*EventSavedSearchQueryChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 11, 0}
+}
+func (m *EventSavedSearchQueryChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSavedSearchQueryChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSavedSearchQueryChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSavedSearchQueryChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSavedSearchQueryChanged.Merge(m, src)
+}
+func (m *EventSavedSearchQueryChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSavedSearchQueryChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSavedSearchQueryChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSavedSearchQueryChanged proto.InternalMessageInfo
+
+func (m *EventSavedSearchQueryChanged) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *EventSavedSearchQueryChanged) GetSubId() string {
+	if m != nil {
+		return m.SubId
+	}
+	return ""
+}
+
+func (m *EventSavedSearchQueryChanged) GetRecords() []*types.Struct {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *EventSavedSearchQueryChanged) GetDependencies() []*types.Struct {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *EventSavedSearchQueryChanged) GetCounters() *EventObjectSubscriptionCounters {
+	if m != nil {
+		return m.Counters
+	}
+	return nil
+}
+
+type EventTrash struct {
+}
+
+func (m *EventTrash) Reset()         { *m = EventTrash{} }
+func (m *EventTrash) String() string { return proto.CompactTextString(m) }
+func (*EventTrash) ProtoMessage()    {}
+func (*EventTrash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 12}
+}
+func (m *EventTrash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTrash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTrash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTrash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTrash.Merge(m, src)
+}
+func (m *EventTrash) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTrash) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTrash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTrash proto.InternalMessageInfo
+
+// Purge is sent before the batch of objects of the space is deleted from the bin by the retention policy
+type EventTrashPurge struct {
+	SpaceId string   `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	Ids     []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (m *EventTrashPurge) Reset()         { *m = EventTrashPurge{} }
+func (m *EventTrashPurge) String() string { return proto.CompactTextString(m) }
+func (*EventTrashPurge) ProtoMessage()    {}
+func (*EventTrashPurge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 12, 0}
+}
+func (m *EventTrashPurge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTrashPurge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTrashPurge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTrashPurge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTrashPurge.Merge(m, src)
+}
+func (m *EventTrashPurge) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTrashPurge) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTrashPurge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTrashPurge proto.InternalMessageInfo
+
+func (m *EventTrashPurge) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *EventTrashPurge) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type ResponseEvent struct {
 	Messages  []*EventMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	ContextId string          `protobuf:"bytes,2,opt,name=contextId,proto3" json:"contextId,omitempty"`
@@ -10312,7 +11131,9 @@ func (m *ModelProcessProgress) GetMessage() string {
 
 func init() {
 	proto.RegisterEnum("anytype.EventBlockDataviewSliceOperation", EventBlockDataviewSliceOperation_name, EventBlockDataviewSliceOperation_value)
+	proto.RegisterEnum("anytype.EventProcessErrorSeverity", EventProcessErrorSeverity_name, EventProcessErrorSeverity_value)
 	proto.RegisterEnum("anytype.EventStatusThreadSyncStatus", EventStatusThreadSyncStatus_name, EventStatusThreadSyncStatus_value)
+	proto.RegisterEnum("anytype.EventFileUploadProgressState", EventFileUploadProgressState_name, EventFileUploadProgressState_value)
 	proto.RegisterEnum("anytype.ModelProcessType", ModelProcessType_name, ModelProcessType_value)
 	proto.RegisterEnum("anytype.ModelProcessState", ModelProcessState_name, ModelProcessState_value)
 	proto.RegisterType((*Event)(nil), "anytype.Event")
@@ -10365,6 +11186,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetTextIconImage)(nil), "anytype.Event.Block.Set.Text.IconImage")
 	proto.RegisterType((*EventBlockSetLatex)(nil), "anytype.Event.Block.Set.Latex")
 	proto.RegisterType((*EventBlockSetLatexText)(nil), "anytype.Event.Block.Set.Latex.Text")
+	proto.RegisterType((*EventBlockSetLatexProcessor)(nil), "anytype.Event.Block.Set.Latex.Processor")
 	proto.RegisterType((*EventBlockSetDiv)(nil), "anytype.Event.Block.Set.Div")
 	proto.RegisterType((*EventBlockSetDivStyle)(nil), "anytype.Event.Block.Set.Div.Style")
 	proto.RegisterType((*EventBlockSetFile)(nil), "anytype.Event.Block.Set.File")
@@ -10478,6 +11300,7 @@ func init() {
 	proto.RegisterType((*EventProcessNew)(nil), "anytype.Event.Process.New")
 	proto.RegisterType((*EventProcessUpdate)(nil), "anytype.Event.Process.Update")
 	proto.RegisterType((*EventProcessDone)(nil), "anytype.Event.Process.Done")
+	proto.RegisterType((*EventProcessError)(nil), "anytype.Event.Process.Error")
 	proto.RegisterType((*EventStatus)(nil), "anytype.Event.Status")
 	proto.RegisterType((*EventStatusThread)(nil), "anytype.Event.Status.Thread")
 	proto.RegisterType((*EventStatusThreadSummary)(nil), "anytype.Event.Status.Thread.Summary")
@@ -10489,6 +11312,16 @@ func init() {
 	proto.RegisterType((*EventFileLimitReached)(nil), "anytype.Event.File.LimitReached")
 	proto.RegisterType((*EventFileSpaceUsage)(nil), "anytype.Event.File.SpaceUsage")
 	proto.RegisterType((*EventFileLocalUsage)(nil), "anytype.Event.File.LocalUsage")
+	proto.RegisterType((*EventFileUploadProgress)(nil), "anytype.Event.File.UploadProgress")
+	proto.RegisterType((*EventBackup)(nil), "anytype.Event.Backup")
+	proto.RegisterType((*EventBackupDone)(nil), "anytype.Event.Backup.Done")
+	proto.RegisterType((*EventBackupError)(nil), "anytype.Event.Backup.Error")
+	proto.RegisterType((*EventAutomation)(nil), "anytype.Event.Automation")
+	proto.RegisterType((*EventAutomationExecuted)(nil), "anytype.Event.Automation.Executed")
+	proto.RegisterType((*EventSavedSearch)(nil), "anytype.Event.SavedSearch")
+	proto.RegisterType((*EventSavedSearchQueryChanged)(nil), "anytype.Event.SavedSearch.QueryChanged")
+	proto.RegisterType((*EventTrash)(nil), "anytype.Event.Trash")
+	proto.RegisterType((*EventTrashPurge)(nil), "anytype.Event.Trash.Purge")
 	proto.RegisterType((*ResponseEvent)(nil), "anytype.ResponseEvent")
 	proto.RegisterType((*Model)(nil), "anytype.Model")
 	proto.RegisterType((*ModelProcess)(nil), "anytype.Model.Process")
@@ -10498,328 +11331,362 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 5123 bytes of a gzipped FileDescriptorProto
+	// 5678 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0xff, 0xce, 0x4c, 0xcf, 0xeb, 0x5b, 0x72, 0x39, 0x2c, 0x52, 0x54, 0xab, 0xb5, 0x5a, 0x51,
-	0xcb, 0xa7, 0x24, 0x6a, 0x28, 0xf1, 0x6d, 0x8a, 0xaf, 0x7d, 0x51, 0x3b, 0x7c, 0x2c, 0xf7, 0x5f,
-	0x4b, 0xd2, 0xb2, 0x6c, 0x18, 0xee, 0x9d, 0xae, 0x9d, 0x6d, 0xef, 0x6c, 0xf7, 0xb8, 0xbb, 0x77,
-	0xc9, 0xb5, 0xff, 0x79, 0xc0, 0xc9, 0x2d, 0x09, 0x90, 0xe4, 0xe0, 0xe4, 0x1a, 0x20, 0x06, 0x72,
-	0x08, 0x0c, 0x03, 0xb9, 0xf8, 0x14, 0x04, 0x08, 0x02, 0xe4, 0x71, 0x71, 0x6e, 0xb9, 0xd9, 0x90,
-	0x2e, 0xb9, 0x18, 0xc8, 0x03, 0xc8, 0x39, 0xa8, 0x47, 0x77, 0x57, 0xf5, 0x63, 0xba, 0xc7, 0x92,
-	0xe1, 0x04, 0xd1, 0x69, 0xa7, 0xaa, 0xbe, 0xdf, 0xef, 0xab, 0xc7, 0x57, 0xf5, 0x55, 0x7d, 0x5d,
-	0xb5, 0x70, 0x62, 0xb4, 0x79, 0x71, 0xe4, 0xb9, 0x81, 0xeb, 0x5f, 0x24, 0xfb, 0xc4, 0x09, 0xfc,
-	0x2e, 0x4b, 0xa1, 0xa6, 0xe9, 0x1c, 0x04, 0x07, 0x23, 0x62, 0x9c, 0x1e, 0xed, 0x0c, 0x2e, 0x0e,
-	0xed, 0xcd, 0x8b, 0xa3, 0xcd, 0x8b, 0xbb, 0xae, 0x45, 0x86, 0xa1, 0x38, 0x4b, 0x08, 0x71, 0x63,
-	0x76, 0xe0, 0xba, 0x83, 0x21, 0xe1, 0x65, 0x9b, 0x7b, 0x5b, 0x17, 0xfd, 0xc0, 0xdb, 0xeb, 0x07,
-	0xbc, 0x74, 0xfe, 0xf7, 0x7e, 0x58, 0x81, 0xfa, 0x0a, 0xa5, 0x47, 0x97, 0xa0, 0xb5, 0x4b, 0x7c,
-	0xdf, 0x1c, 0x10, 0x5f, 0xaf, 0x9c, 0xac, 0x9d, 0x9f, 0xbe, 0x74, 0xa2, 0x2b, 0x54, 0x75, 0x99,
-	0x44, 0xf7, 0x31, 0x2f, 0xc6, 0x91, 0x1c, 0x9a, 0x85, 0x76, 0xdf, 0x75, 0x02, 0xf2, 0x32, 0xe8,
-	0x59, 0x7a, 0xf5, 0x64, 0xe5, 0x7c, 0x1b, 0xc7, 0x19, 0xe8, 0x0a, 0xb4, 0x6d, 0xc7, 0x0e, 0x6c,
-	0x33, 0x70, 0x3d, 0xbd, 0x76, 0xb2, 0xa2, 0x50, 0xb2, 0x4a, 0x76, 0x17, 0xfa, 0x7d, 0x77, 0xcf,
-	0x09, 0x70, 0x2c, 0x88, 0x74, 0x68, 0x06, 0x9e, 0xd9, 0x27, 0x3d, 0x4b, 0xd7, 0x18, 0x63, 0x98,
-	0x34, 0xfe, 0xf8, 0x3c, 0x34, 0x45, 0x1d, 0xd0, 0x5d, 0x98, 0x36, 0x39, 0x76, 0x63, 0xdb, 0x7d,
-	0xa1, 0x57, 0x18, 0xfb, 0xeb, 0x89, 0x0a, 0x0b, 0xf6, 0x2e, 0x15, 0x59, 0x9d, 0xc2, 0x32, 0x02,
-	0xf5, 0x60, 0x46, 0x24, 0x97, 0x49, 0x60, 0xda, 0x43, 0x5f, 0xff, 0x07, 0x4e, 0x32, 0x97, 0x43,
-	0x22, 0xc4, 0x56, 0xa7, 0x70, 0x02, 0x88, 0xbe, 0x06, 0xc7, 0x44, 0xce, 0x92, 0xeb, 0x6c, 0xd9,
-	0x83, 0x67, 0x23, 0xcb, 0x0c, 0x88, 0xfe, 0x8f, 0x9c, 0xef, 0x74, 0x0e, 0x1f, 0x97, 0xed, 0x72,
-	0xe1, 0xd5, 0x29, 0x9c, 0xc5, 0x81, 0xee, 0xc3, 0x61, 0x91, 0x2d, 0x48, 0xff, 0x89, 0x93, 0xbe,
-	0x91, 0x43, 0x1a, 0xb1, 0xa9, 0x30, 0xf4, 0x04, 0x3a, 0xee, 0xe6, 0xb7, 0x49, 0x3f, 0xac, 0xf3,
-	0x06, 0x09, 0xf4, 0x0e, 0x63, 0x7a, 0x2b, 0xc1, 0xf4, 0x84, 0x89, 0x85, 0xad, 0xed, 0x6e, 0x90,
-	0x60, 0x75, 0x0a, 0xa7, 0xc0, 0xe8, 0x19, 0x20, 0x25, 0x6f, 0x61, 0x97, 0x38, 0x96, 0x7e, 0x89,
-	0x51, 0x9e, 0x1a, 0x4f, 0xc9, 0x44, 0x57, 0xa7, 0x70, 0x06, 0x41, 0x8a, 0xf6, 0x99, 0xe3, 0x93,
-	0x40, 0xbf, 0x5c, 0x86, 0x96, 0x89, 0xa6, 0x68, 0x59, 0x2e, 0xfa, 0x3a, 0x1c, 0xe7, 0xb9, 0x98,
-	0x0c, 0xcd, 0xc0, 0x76, 0x1d, 0x51, 0xdf, 0x2b, 0x8c, 0xf8, 0x4c, 0x36, 0x71, 0x24, 0x1b, 0xd5,
-	0x38, 0x93, 0x04, 0x7d, 0x13, 0x5e, 0x49, 0xe4, 0x63, 0xb2, 0xeb, 0xee, 0x13, 0xfd, 0x2a, 0x63,
-	0x3f, 0x5b, 0xc4, 0xce, 0xa5, 0x57, 0xa7, 0x70, 0x36, 0x0d, 0x5a, 0x84, 0x43, 0x61, 0x01, 0xa3,
-	0xbd, 0xc6, 0x68, 0x67, 0xf3, 0x68, 0x05, 0x99, 0x82, 0x91, 0xeb, 0xe8, 0x07, 0x9e, 0xdd, 0x67,
-	0xfc, 0xd4, 0x08, 0xae, 0x8f, 0xaf, 0x63, 0x2c, 0x2c, 0x2c, 0x21, 0x9b, 0x06, 0x61, 0x38, 0xe2,
-	0xef, 0x6d, 0xfa, 0x7d, 0xcf, 0x1e, 0xd1, 0xbc, 0x05, 0xcb, 0xd2, 0x6f, 0x8d, 0x63, 0xde, 0x90,
-	0x84, 0xbb, 0x0b, 0x16, 0xed, 0xdc, 0x24, 0x01, 0xfa, 0x3a, 0x20, 0x39, 0x4b, 0xb4, 0xfe, 0x36,
-	0xa3, 0x7d, 0xbb, 0x04, 0x6d, 0xd4, 0x15, 0x19, 0x34, 0xc8, 0x84, 0xe3, 0x72, 0xee, 0xba, 0xeb,
-	0xdb, 0xf4, 0xaf, 0x7e, 0x87, 0xd1, 0xbf, 0x5b, 0x82, 0x3e, 0x84, 0x50, 0xbb, 0xc8, 0xa2, 0x4a,
-	0xaa, 0x58, 0xa2, 0xd3, 0x91, 0x78, 0xbe, 0x7e, 0xb7, 0xb4, 0x8a, 0x10, 0x92, 0x54, 0x11, 0xe6,
-	0x27, 0xbb, 0xe8, 0x23, 0xcf, 0xdd, 0x1b, 0xf9, 0xfa, 0xbd, 0xd2, 0x5d, 0xc4, 0x01, 0xc9, 0x2e,
-	0xe2, 0xb9, 0xe8, 0x1a, 0xb4, 0x36, 0x87, 0x6e, 0x7f, 0x87, 0x0e, 0x66, 0x95, 0x51, 0xea, 0x09,
-	0xca, 0x45, 0x5a, 0x2c, 0x86, 0x2f, 0x92, 0xa5, 0x4b, 0x33, 0xfb, 0xbd, 0x4c, 0x86, 0x24, 0x20,
-	0x62, 0xe1, 0x7f, 0x3d, 0x13, 0xca, 0x45, 0xe8, 0xd2, 0x2c, 0x21, 0xd0, 0x32, 0x4c, 0x6f, 0xd9,
-	0x43, 0xe2, 0x3f, 0x1b, 0x0d, 0x5d, 0x93, 0x7b, 0x81, 0xe9, 0x4b, 0x27, 0x33, 0x09, 0xee, 0xc7,
-	0x72, 0x94, 0x45, 0x82, 0xa1, 0x3b, 0xd0, 0xde, 0x35, 0xbd, 0x1d, 0xbf, 0xe7, 0x6c, 0xb9, 0x7a,
-	0x3d, 0x73, 0x69, 0xe7, 0x1c, 0x8f, 0x43, 0xa9, 0xd5, 0x29, 0x1c, 0x43, 0xa8, 0x83, 0x60, 0x95,
-	0xda, 0x20, 0xc1, 0x7d, 0x9b, 0x0c, 0x2d, 0x5f, 0x6f, 0x30, 0x92, 0x37, 0x33, 0x49, 0x36, 0x48,
-	0xd0, 0xe5, 0x62, 0xd4, 0x41, 0xa8, 0x40, 0xf4, 0x31, 0x1c, 0x0b, 0x73, 0x96, 0xb6, 0xed, 0xa1,
-	0xe5, 0x11, 0xa7, 0x67, 0xf9, 0x7a, 0x33, 0xd3, 0x3f, 0xc4, 0x7c, 0x92, 0x2c, 0xf5, 0x0f, 0x19,
-	0x14, 0x74, 0x61, 0x0b, 0xb3, 0xe5, 0x29, 0xa9, 0xb7, 0x32, 0x17, 0xb6, 0x98, 0x5a, 0x16, 0xa6,
-	0xd6, 0x95, 0x45, 0x82, 0x2c, 0x78, 0x35, 0xcc, 0x5f, 0x34, 0xfb, 0x3b, 0x03, 0xcf, 0xdd, 0x73,
-	0xac, 0x25, 0x77, 0xe8, 0x7a, 0x7a, 0x9b, 0xf1, 0x9f, 0xcf, 0xe5, 0x4f, 0xc8, 0xaf, 0x4e, 0xe1,
-	0x3c, 0x2a, 0xb4, 0x04, 0x87, 0xc2, 0xa2, 0xa7, 0xe4, 0x65, 0xa0, 0x43, 0xa6, 0x83, 0x8b, 0xa9,
-	0xa9, 0x10, 0x5d, 0xdf, 0x64, 0x90, 0x4c, 0x42, 0x4d, 0x42, 0x9f, 0x2e, 0x20, 0xa1, 0x42, 0x32,
-	0x09, 0x4d, 0xcb, 0x24, 0x8f, 0x6c, 0x67, 0x47, 0x3f, 0x5c, 0x40, 0x42, 0x85, 0x64, 0x12, 0x9a,
-	0xa6, 0x9e, 0x36, 0x6a, 0xa9, 0xeb, 0xee, 0x50, 0x7b, 0xd2, 0x67, 0x32, 0x3d, 0xad, 0xd4, 0x5b,
-	0x42, 0x90, 0x7a, 0xda, 0x24, 0x98, 0x6e, 0x01, 0xc2, 0xbc, 0x85, 0xa1, 0x3d, 0x70, 0xf4, 0x23,
-	0x63, 0x6c, 0x99, 0xb2, 0x31, 0x29, 0xba, 0x05, 0x50, 0x60, 0xe8, 0x9e, 0x98, 0x96, 0x1b, 0x24,
-	0x58, 0xb6, 0xf7, 0xf5, 0xa3, 0x99, 0x5e, 0x24, 0x66, 0x59, 0xb6, 0xf7, 0xa3, 0x79, 0xc9, 0x21,
-	0x72, 0xd3, 0x42, 0x1f, 0xa5, 0xbf, 0x52, 0xd0, 0xb4, 0x50, 0x50, 0x6e, 0x5a, 0x98, 0x27, 0x37,
-	0xed, 0x91, 0x19, 0x90, 0x97, 0xfa, 0x6b, 0x05, 0x4d, 0x63, 0x52, 0x72, 0xd3, 0x58, 0x06, 0xf5,
-	0x6e, 0x61, 0xc6, 0x73, 0xe2, 0x05, 0x76, 0xdf, 0x1c, 0xf2, 0xae, 0x3a, 0x9d, 0xe9, 0x83, 0x62,
-	0x3e, 0x45, 0x9a, 0x7a, 0xb7, 0x4c, 0x1a, 0xb9, 0xe1, 0x4f, 0xcd, 0xcd, 0x21, 0xc1, 0xee, 0x0b,
-	0xfd, 0x4c, 0x41, 0xc3, 0x43, 0x41, 0xb9, 0xe1, 0x61, 0x9e, 0xbc, 0xb6, 0x7c, 0xd5, 0xb6, 0x06,
-	0x24, 0xd0, 0xcf, 0x17, 0xac, 0x2d, 0x5c, 0x4c, 0x5e, 0x5b, 0x78, 0x4e, 0xb4, 0x02, 0x2c, 0x9b,
-	0x81, 0xb9, 0x6f, 0x93, 0x17, 0xcf, 0x6d, 0xf2, 0x82, 0x3a, 0xf6, 0x63, 0x63, 0x56, 0x80, 0x50,
-	0xb6, 0x2b, 0x84, 0xa3, 0x15, 0x20, 0x41, 0x12, 0xad, 0x00, 0x72, 0xbe, 0x58, 0xd6, 0x8f, 0x8f,
-	0x59, 0x01, 0x14, 0xfe, 0x68, 0x8d, 0xcf, 0xa3, 0x42, 0x26, 0x9c, 0x48, 0x15, 0x3d, 0xf1, 0x2c,
-	0xe2, 0xe9, 0x6f, 0x30, 0x25, 0xe7, 0x8a, 0x95, 0x30, 0xf1, 0xd5, 0x29, 0x9c, 0x43, 0x94, 0x52,
-	0xb1, 0xe1, 0xee, 0x79, 0x7d, 0x42, 0xfb, 0xe9, 0x54, 0x19, 0x15, 0x91, 0x78, 0x4a, 0x45, 0x54,
-	0x82, 0xf6, 0xe1, 0x8d, 0xa8, 0x84, 0x2a, 0x66, 0x5e, 0x94, 0x69, 0x17, 0x5b, 0xf7, 0xb3, 0x4c,
-	0x53, 0x77, 0xbc, 0xa6, 0x24, 0x6a, 0x75, 0x0a, 0x8f, 0xa7, 0x45, 0x07, 0x30, 0xa7, 0x08, 0x70,
-	0x3f, 0x2f, 0x2b, 0x3e, 0xc7, 0x14, 0x5f, 0x1c, 0xaf, 0x38, 0x05, 0x5b, 0x9d, 0xc2, 0x05, 0xc4,
-	0x68, 0x04, 0xaf, 0x2b, 0x9d, 0x11, 0x4e, 0x6c, 0x61, 0x22, 0xff, 0x9f, 0xe9, 0xbd, 0x30, 0x5e,
-	0xaf, 0x8a, 0x59, 0x9d, 0xc2, 0xe3, 0x28, 0xd1, 0x00, 0xf4, 0xcc, 0x62, 0x3a, 0x92, 0xdf, 0xcb,
-	0xdc, 0xf6, 0xe4, 0xa8, 0xe3, 0x63, 0x99, 0x4b, 0x96, 0x69, 0xf9, 0xa2, 0x3b, 0x7f, 0xa3, 0xac,
-	0xe5, 0x47, 0xfd, 0x98, 0x47, 0xa5, 0x8c, 0x1d, 0x2d, 0x7a, 0x6a, 0x7a, 0x03, 0x12, 0xf0, 0x8e,
-	0xee, 0x59, 0xb4, 0x51, 0xbf, 0x59, 0x66, 0xec, 0x52, 0x30, 0x65, 0xec, 0x32, 0x89, 0x91, 0x0f,
-	0xb3, 0x8a, 0x44, 0xcf, 0x5f, 0x72, 0x87, 0x43, 0xd2, 0x0f, 0x7b, 0xf3, 0xb7, 0x98, 0xe2, 0xf7,
-	0xc6, 0x2b, 0x4e, 0x80, 0x56, 0xa7, 0xf0, 0x58, 0xd2, 0x54, 0x7b, 0x9f, 0x0c, 0xad, 0x84, 0xcd,
-	0xe8, 0xa5, 0x6c, 0x35, 0x09, 0x4b, 0xb5, 0x37, 0x25, 0x91, 0xb2, 0x55, 0x49, 0x82, 0x36, 0xf7,
-	0xd5, 0x32, 0xb6, 0xaa, 0x62, 0x52, 0xb6, 0xaa, 0x16, 0x53, 0xef, 0xb6, 0xe7, 0x13, 0x8f, 0x71,
-	0x3c, 0x70, 0x6d, 0x47, 0x7f, 0x33, 0xd3, 0xbb, 0x3d, 0xf3, 0x89, 0x27, 0x14, 0x51, 0x29, 0xea,
-	0xdd, 0x14, 0x98, 0xc2, 0xf3, 0x88, 0x6c, 0x05, 0xfa, 0xc9, 0x22, 0x1e, 0x2a, 0xa5, 0xf0, 0xd0,
-	0x0c, 0xea, 0x29, 0xa2, 0x8c, 0x0d, 0x42, 0x47, 0x05, 0x9b, 0xce, 0x80, 0xe8, 0x6f, 0x65, 0x7a,
-	0x0a, 0x89, 0x4e, 0x12, 0xa6, 0x9e, 0x22, 0x8b, 0x84, 0x1e, 0xdc, 0xa3, 0x7c, 0xba, 0x23, 0xe3,
-	0xd4, 0xf3, 0x99, 0x07, 0x77, 0x89, 0x3a, 0x12, 0xa5, 0x67, 0x90, 0x34, 0x01, 0x7a, 0x1b, 0xb4,
-	0x91, 0xed, 0x0c, 0x74, 0x8b, 0x11, 0x1d, 0x4b, 0x10, 0xad, 0xdb, 0xce, 0x60, 0x75, 0x0a, 0x33,
-	0x11, 0x74, 0x0b, 0x60, 0xe4, 0xb9, 0x7d, 0xe2, 0xfb, 0x6b, 0xe4, 0x85, 0x4e, 0x18, 0xc0, 0x48,
-	0x02, 0xb8, 0x40, 0x77, 0x8d, 0x50, 0xbf, 0x2c, 0xc9, 0xa3, 0x15, 0x38, 0x2c, 0x52, 0x62, 0x96,
-	0x6f, 0x65, 0x6e, 0xfe, 0x42, 0x82, 0x38, 0xce, 0xa2, 0xa0, 0xe8, 0xd9, 0x47, 0x64, 0x2c, 0xbb,
-	0x0e, 0xd1, 0x07, 0x99, 0x67, 0x9f, 0x90, 0x84, 0x8a, 0xd0, 0x3d, 0x96, 0x84, 0xa0, 0x87, 0xfd,
-	0x60, 0xdb, 0x23, 0xa6, 0xb5, 0x11, 0x98, 0xc1, 0x9e, 0xaf, 0x3b, 0x99, 0xdb, 0x34, 0x5e, 0xd8,
-	0x7d, 0xca, 0x24, 0xe9, 0x16, 0x54, 0xc6, 0xa0, 0x35, 0xe8, 0xd0, 0x83, 0xd0, 0x23, 0x7b, 0xd7,
-	0x0e, 0x30, 0x31, 0xfb, 0xdb, 0xc4, 0xd2, 0xdd, 0xcc, 0x43, 0x14, 0xdd, 0xf6, 0x76, 0x65, 0x39,
-	0xba, 0x5b, 0x49, 0x62, 0xd1, 0x2a, 0xcc, 0xd0, 0xbc, 0x8d, 0x91, 0xd9, 0x27, 0xcf, 0x7c, 0x73,
-	0x40, 0xf4, 0x51, 0xa6, 0x05, 0x32, 0xb6, 0x58, 0x8a, 0x6e, 0x56, 0x54, 0x5c, 0xc8, 0xf4, 0xc8,
-	0xed, 0x9b, 0x43, 0xce, 0xf4, 0x9d, 0x7c, 0xa6, 0x58, 0x2a, 0x64, 0x8a, 0x73, 0x16, 0x9b, 0x50,
-	0xdf, 0x37, 0x87, 0x7b, 0xc4, 0xf8, 0x71, 0x0d, 0x9a, 0x22, 0xfa, 0x65, 0xac, 0x81, 0xc6, 0x62,
-	0x7b, 0xc7, 0xa1, 0x6e, 0x3b, 0x16, 0x79, 0xc9, 0xc2, 0x82, 0x75, 0xcc, 0x13, 0xe8, 0x7d, 0x68,
-	0x8a, 0xa0, 0x98, 0x38, 0xce, 0xe6, 0x05, 0x23, 0x43, 0x31, 0xe3, 0x13, 0x68, 0x86, 0x31, 0xbe,
-	0x59, 0x68, 0x8f, 0x3c, 0x97, 0x56, 0xa2, 0x67, 0x31, 0xda, 0x36, 0x8e, 0x33, 0xd0, 0x07, 0xd0,
-	0xb4, 0x44, 0x14, 0x91, 0x53, 0xbf, 0xda, 0xe5, 0x61, 0xd7, 0x6e, 0x18, 0x76, 0xed, 0x6e, 0xb0,
-	0xb0, 0x2b, 0x0e, 0xe5, 0x8c, 0xdf, 0xae, 0x40, 0x83, 0x87, 0xfa, 0x8c, 0x7d, 0x68, 0x08, 0xf3,
-	0xb9, 0x0a, 0x8d, 0x3e, 0xcb, 0xd3, 0x93, 0x61, 0x3e, 0xa5, 0x86, 0x22, 0x76, 0x88, 0x85, 0x30,
-	0x85, 0xf9, 0xdc, 0x5c, 0xaa, 0x63, 0x61, 0xdc, 0x3e, 0xb0, 0x10, 0xfe, 0xb5, 0xe9, 0xfd, 0xb7,
-	0x16, 0x34, 0xb8, 0x2b, 0x32, 0xfe, 0xab, 0x1a, 0x75, 0xb1, 0xf1, 0xb7, 0x15, 0xa8, 0xf3, 0x88,
-	0xda, 0x0c, 0x54, 0xed, 0xb0, 0x97, 0xab, 0xb6, 0x85, 0xee, 0xcb, 0xdd, 0x5b, 0xcb, 0x58, 0xa7,
-	0xb3, 0x22, 0x8c, 0xdd, 0x87, 0xe4, 0xe0, 0x39, 0x35, 0x91, 0xa8, 0xcf, 0xd1, 0x09, 0x68, 0xf8,
-	0x7b, 0x9b, 0xf4, 0xe8, 0x5d, 0x3b, 0x59, 0x3b, 0xdf, 0xc6, 0x22, 0x65, 0x3c, 0x80, 0x56, 0x28,
-	0x8c, 0x3a, 0x50, 0xdb, 0x21, 0x07, 0x42, 0x39, 0xfd, 0x89, 0x2e, 0x08, 0x53, 0x8b, 0xac, 0x26,
-	0x39, 0xb4, 0x5c, 0x8b, 0xb0, 0xc7, 0x6f, 0x41, 0x8d, 0x2e, 0xfe, 0xc9, 0x26, 0x4c, 0x6e, 0x21,
-	0xb9, 0xb5, 0x5d, 0x82, 0x3a, 0x8f, 0x6a, 0x26, 0x75, 0x20, 0xd0, 0x76, 0xc8, 0x01, 0xef, 0xa3,
-	0x36, 0x66, 0xbf, 0x73, 0x49, 0xfe, 0xa6, 0x06, 0x87, 0xe4, 0x50, 0x90, 0xb1, 0x02, 0xb5, 0x05,
-	0x2b, 0xdd, 0xf5, 0x3a, 0x34, 0xcd, 0xad, 0x80, 0x78, 0x51, 0x7c, 0x3f, 0x4c, 0xd2, 0x49, 0xc6,
-	0xb8, 0x58, 0x80, 0xa7, 0x8d, 0x79, 0xc2, 0xe8, 0x42, 0x43, 0x44, 0xd8, 0x92, 0x4c, 0x91, 0x7c,
-	0x55, 0x96, 0x7f, 0x00, 0xad, 0x28, 0x60, 0xf6, 0x79, 0x75, 0x7b, 0xd0, 0x8a, 0x22, 0x63, 0xc7,
-	0xa1, 0x1e, 0xb8, 0x81, 0x39, 0x64, 0x74, 0x35, 0xcc, 0x13, 0x74, 0x16, 0x3b, 0xe4, 0x65, 0xb0,
-	0x14, 0x2d, 0x02, 0x35, 0x1c, 0x67, 0xf0, 0x39, 0x4e, 0xf6, 0x79, 0x69, 0x8d, 0x97, 0x46, 0x19,
-	0xb1, 0x4e, 0x4d, 0xd6, 0x79, 0x00, 0x0d, 0x11, 0x2e, 0x8b, 0xca, 0x2b, 0x52, 0x39, 0x5a, 0x80,
-	0xfa, 0x80, 0x96, 0x8b, 0x51, 0x7f, 0x37, 0x31, 0x43, 0xb8, 0x17, 0x5c, 0x72, 0x9d, 0x80, 0x9a,
-	0xb1, 0x7a, 0x0a, 0xc0, 0x1c, 0x49, 0x87, 0xd0, 0xe3, 0xb1, 0x4f, 0x5a, 0xa7, 0x16, 0x16, 0x29,
-	0xe3, 0x87, 0x15, 0x68, 0x47, 0xb1, 0x62, 0xe3, 0x93, 0xbc, 0xc9, 0xb3, 0x00, 0x87, 0x3d, 0x21,
-	0xf5, 0xc8, 0x76, 0x76, 0xc2, 0x29, 0xf4, 0x7a, 0xa2, 0x26, 0x58, 0x92, 0xc1, 0x2a, 0xc2, 0xb8,
-	0x95, 0x3b, 0xa8, 0xf3, 0x70, 0x28, 0x14, 0x7d, 0x18, 0x9b, 0x9e, 0x92, 0x67, 0x18, 0x11, 0xba,
-	0x03, 0x35, 0xdb, 0xe2, 0x5f, 0x97, 0xda, 0x98, 0xfe, 0x34, 0xb6, 0xe0, 0x90, 0x1c, 0x72, 0x32,
-	0x9e, 0x67, 0xcf, 0x9e, 0xbb, 0x54, 0x8d, 0x14, 0xde, 0xaa, 0x26, 0xfc, 0x6a, 0xd8, 0x84, 0x58,
-	0x04, 0x2b, 0x00, 0xe3, 0xfb, 0x26, 0xd4, 0x59, 0x5f, 0x1b, 0x97, 0xb9, 0x9d, 0x5f, 0x80, 0x06,
-	0xdb, 0xbb, 0x85, 0xdf, 0xba, 0x8e, 0x67, 0x0d, 0x0c, 0x16, 0x32, 0xc6, 0x12, 0x4c, 0x4b, 0x91,
-	0x46, 0x6a, 0x98, 0xac, 0x20, 0x1a, 0xec, 0x30, 0x89, 0x0c, 0x68, 0x51, 0x97, 0xb0, 0x6e, 0x06,
-	0xdb, 0xa2, 0x2f, 0xa2, 0xb4, 0x71, 0x1a, 0x1a, 0x62, 0x2f, 0x6a, 0x88, 0xc8, 0x6a, 0x2f, 0xea,
-	0x8c, 0x28, 0x6d, 0x7c, 0x03, 0xda, 0x51, 0x40, 0x12, 0x3d, 0x81, 0x43, 0x22, 0x20, 0xc9, 0xf7,
-	0x53, 0x54, 0x78, 0xa6, 0xc0, 0x88, 0xe8, 0xe6, 0x89, 0xc5, 0x34, 0xbb, 0x4f, 0x0f, 0x46, 0x04,
-	0x2b, 0x04, 0xc6, 0x2f, 0xce, 0xb0, 0x0e, 0x36, 0x46, 0xd0, 0x8a, 0xa2, 0x30, 0xc9, 0xce, 0xbe,
-	0xce, 0x57, 0xc0, 0x6a, 0x61, 0x08, 0x91, 0xe3, 0xe9, 0x3a, 0xcb, 0x16, 0x4a, 0xe3, 0x75, 0xa8,
-	0x3d, 0x24, 0x07, 0x74, 0x22, 0xf0, 0xf5, 0x52, 0x4c, 0x04, 0xbe, 0x2e, 0xf6, 0xa0, 0x21, 0xa2,
-	0xa1, 0x49, 0x7d, 0x17, 0xa1, 0xb1, 0xc5, 0x03, 0xac, 0x05, 0x2b, 0xa3, 0x10, 0x33, 0xee, 0xc2,
-	0xb4, 0x1c, 0x03, 0x4d, 0xf2, 0x9d, 0x84, 0xe9, 0xbe, 0x14, 0x65, 0xe5, 0xc3, 0x20, 0x67, 0x19,
-	0x44, 0xb5, 0xba, 0x14, 0xc3, 0x4a, 0xa6, 0xb9, 0xbd, 0x95, 0xd9, 0xed, 0x63, 0x8c, 0xee, 0x21,
-	0x1c, 0x49, 0x06, 0x3b, 0x93, 0x9a, 0xce, 0xc3, 0x91, 0xcd, 0x44, 0x68, 0x95, 0x2f, 0x75, 0xc9,
-	0x6c, 0xa3, 0x07, 0x75, 0x1e, 0x8c, 0x4a, 0x52, 0xbc, 0x0f, 0x75, 0x93, 0x05, 0xbb, 0x28, 0x70,
-	0x46, 0xda, 0xf2, 0xca, 0xb5, 0x64, 0x50, 0xcc, 0x05, 0x0d, 0x1b, 0x0e, 0xab, 0xf1, 0xad, 0x24,
-	0xe5, 0x2a, 0x1c, 0xde, 0x57, 0xe2, 0x68, 0x9c, 0x7a, 0x3e, 0x93, 0x5a, 0xa1, 0xc2, 0x2a, 0xd0,
-	0xf8, 0x7e, 0x03, 0x34, 0x16, 0xa0, 0x4d, 0xaa, 0xb8, 0x06, 0x5a, 0x40, 0x5e, 0x86, 0x3b, 0xb1,
-	0xf9, 0xb1, 0xd1, 0x5e, 0x7e, 0x4a, 0x60, 0xf2, 0xe8, 0x2b, 0x50, 0xf7, 0x83, 0x83, 0x61, 0xf8,
-	0x59, 0xe1, 0xd4, 0x78, 0xe0, 0x06, 0x15, 0xc5, 0x1c, 0x41, 0xa1, 0x6c, 0x2e, 0x88, 0x0f, 0x0a,
-	0x05, 0x50, 0x36, 0x09, 0x31, 0x47, 0xa0, 0xbb, 0xd0, 0xec, 0x6f, 0x93, 0xfe, 0x0e, 0xb1, 0xc4,
-	0x97, 0x84, 0x33, 0xe3, 0xc1, 0x4b, 0x5c, 0x18, 0x87, 0x28, 0xaa, 0xbb, 0xcf, 0x46, 0xb7, 0x51,
-	0x46, 0x37, 0x1b, 0x71, 0xcc, 0x11, 0x68, 0x05, 0xda, 0x76, 0xdf, 0x75, 0x56, 0x76, 0xdd, 0x6f,
-	0xdb, 0xe2, 0x93, 0xc1, 0xb9, 0xf1, 0xf0, 0x5e, 0x28, 0x8e, 0x63, 0x64, 0x48, 0xd3, 0xdb, 0xa5,
-	0xbb, 0xee, 0x56, 0x59, 0x1a, 0x26, 0x8e, 0x63, 0xa4, 0x31, 0x2b, 0xc6, 0x33, 0x7b, 0x92, 0xdf,
-	0x87, 0x3a, 0xeb, 0x72, 0x74, 0x5b, 0x2e, 0x9e, 0x91, 0x34, 0xe5, 0xae, 0x58, 0x62, 0xa8, 0x22,
-	0x1e, 0xd6, 0xff, 0x2a, 0xcf, 0x74, 0x19, 0x1e, 0x31, 0x6e, 0x9c, 0xe7, 0x4d, 0x68, 0x8a, 0xa1,
-	0x50, 0x2b, 0xdc, 0x0a, 0x05, 0xde, 0x80, 0x3a, 0x9f, 0x98, 0xd9, 0xed, 0x79, 0x0b, 0xda, 0x51,
-	0x67, 0x8e, 0x17, 0x61, 0xbd, 0x93, 0x23, 0xe2, 0x40, 0x9d, 0xc7, 0xa9, 0xd3, 0x2b, 0xad, 0x3c,
-	0x09, 0x4e, 0x8d, 0x0f, 0x7b, 0x4b, 0xb3, 0xa0, 0x60, 0x14, 0x7e, 0x50, 0x81, 0xda, 0xb2, 0xbd,
-	0x9f, 0x52, 0x77, 0x23, 0x9c, 0x3b, 0x45, 0x93, 0x6e, 0xd9, 0xde, 0x57, 0xa6, 0x8e, 0xb1, 0x12,
-	0x8e, 0xeb, 0x2d, 0x75, 0x5c, 0xcf, 0x8e, 0xdf, 0xce, 0xc4, 0x34, 0xbc, 0x62, 0x7f, 0xd4, 0x00,
-	0x8d, 0x7d, 0x69, 0xc9, 0x5a, 0x0d, 0x0e, 0x46, 0xc5, 0x15, 0x63, 0xe7, 0x42, 0xe6, 0xd6, 0x98,
-	0x3c, 0x5f, 0x0d, 0xcc, 0xa0, 0x78, 0x35, 0xe0, 0x47, 0x53, 0x2a, 0x8a, 0x39, 0x82, 0xaa, 0xdc,
-	0xb5, 0x77, 0x89, 0x58, 0x0c, 0x0a, 0x54, 0x3e, 0xb6, 0x77, 0x09, 0x66, 0xf2, 0x14, 0xb7, 0x6d,
-	0xfa, 0xdb, 0x62, 0x1d, 0x28, 0xc0, 0xad, 0x9a, 0xfe, 0x36, 0x66, 0xf2, 0x14, 0xe7, 0x98, 0xbb,
-	0x44, 0x2c, 0x00, 0x05, 0xb8, 0x35, 0x93, 0xea, 0xa3, 0xf2, 0x14, 0xe7, 0xdb, 0xdf, 0x25, 0x62,
-	0xe6, 0x17, 0xe0, 0x36, 0xec, 0xef, 0x12, 0xcc, 0xe4, 0xe3, 0x85, 0xb2, 0x55, 0xae, 0x6b, 0xa4,
-	0xd1, 0x9e, 0x05, 0x8d, 0x56, 0x20, 0xc7, 0xba, 0xde, 0x80, 0xfa, 0x57, 0x6d, 0x2b, 0xd8, 0x56,
-	0x8b, 0xeb, 0xca, 0x12, 0x40, 0x3b, 0x78, 0xa2, 0x25, 0x40, 0x1e, 0x1f, 0xce, 0xb3, 0x0c, 0x1a,
-	0x1d, 0xe8, 0xc9, 0x2c, 0x2e, 0xb6, 0x8f, 0xcf, 0xb5, 0x20, 0xc9, 0x5d, 0xc2, 0x79, 0x66, 0x41,
-	0xa3, 0x63, 0x99, 0xd3, 0x25, 0xb3, 0xa0, 0x51, 0x0b, 0xc9, 0x2f, 0xa5, 0xe3, 0xa2, 0x96, 0xd6,
-	0xc2, 0xd2, 0xbf, 0x6e, 0x82, 0xc6, 0x3e, 0x1c, 0x26, 0xe7, 0xc4, 0xff, 0x83, 0xc3, 0x01, 0x8b,
-	0xda, 0x2e, 0x8a, 0xad, 0x66, 0x35, 0xf3, 0xde, 0x80, 0xfa, 0x39, 0x52, 0x84, 0x82, 0x05, 0x04,
-	0xab, 0x0c, 0xe5, 0x9d, 0x27, 0xa3, 0x52, 0x9c, 0xe7, 0xad, 0x68, 0x93, 0xa6, 0x15, 0x7c, 0xb5,
-	0x66, 0x58, 0xbe, 0xd5, 0x0b, 0x77, 0x6c, 0x68, 0x11, 0x5a, 0xd4, 0x85, 0xd0, 0x6e, 0x10, 0x13,
-	0xe7, 0xec, 0x78, 0x7c, 0x4f, 0x48, 0xe3, 0x08, 0x47, 0x1d, 0x58, 0xdf, 0xf4, 0x2c, 0x56, 0x2b,
-	0x31, 0x8b, 0xce, 0x8d, 0x27, 0x59, 0x0a, 0xc5, 0x71, 0x8c, 0x44, 0x0f, 0x61, 0xda, 0x22, 0xd1,
-	0xb1, 0x57, 0x4c, 0xab, 0xb7, 0xc7, 0x13, 0x2d, 0xc7, 0x00, 0x2c, 0xa3, 0x69, 0x9d, 0xc2, 0xa3,
-	0x8e, 0x5f, 0xe8, 0x54, 0x19, 0x55, 0x7c, 0xb9, 0x27, 0x46, 0x1a, 0x67, 0xe0, 0xb0, 0x32, 0x6e,
-	0x5f, 0xa8, 0x77, 0x95, 0xc7, 0x92, 0xf3, 0x5c, 0x8f, 0xb6, 0xe2, 0xef, 0xa9, 0xee, 0x35, 0x77,
-	0xe7, 0x2d, 0x80, 0x8f, 0xa0, 0x15, 0x0e, 0x0c, 0xba, 0xa7, 0xd6, 0xe1, 0x9d, 0xe2, 0x3a, 0x44,
-	0x63, 0x2a, 0xd8, 0xd6, 0xa0, 0x1d, 0x8d, 0x10, 0x3d, 0x27, 0xcb, 0x74, 0xef, 0x16, 0xd3, 0xc5,
-	0xa3, 0x2b, 0xf8, 0x30, 0x4c, 0x4b, 0x03, 0x85, 0x96, 0x54, 0xc6, 0xf7, 0x8a, 0x19, 0xe5, 0x61,
-	0x8e, 0xbd, 0x7b, 0x34, 0x62, 0xf2, 0xa8, 0xd4, 0xe2, 0x51, 0xf9, 0x71, 0x13, 0x5a, 0xd1, 0xc7,
-	0xfa, 0x8c, 0xb3, 0xd4, 0x9e, 0x37, 0x2c, 0x3c, 0x4b, 0x85, 0xf8, 0xee, 0x33, 0x6f, 0x88, 0x29,
-	0x82, 0x0e, 0x71, 0x60, 0x07, 0xd1, 0x54, 0x3d, 0x57, 0x0c, 0x7d, 0x4a, 0xc5, 0x31, 0x47, 0xa1,
-	0x27, 0xaa, 0x95, 0x6b, 0x63, 0x3e, 0xe6, 0x28, 0x24, 0xb9, 0x96, 0xde, 0x83, 0xb6, 0x4d, 0xb7,
-	0x38, 0xab, 0xb1, 0xef, 0x7b, 0xb7, 0x98, 0xae, 0x17, 0x42, 0x70, 0x8c, 0xa6, 0x75, 0xdb, 0x32,
-	0xf7, 0xe9, 0xbc, 0x66, 0x64, 0x8d, 0xb2, 0x75, 0xbb, 0x1f, 0x83, 0xb0, 0xcc, 0x80, 0x6e, 0x8a,
-	0xdd, 0x43, 0xb3, 0x60, 0x65, 0x89, 0xbb, 0x2a, 0xde, 0x41, 0x7c, 0x0c, 0x33, 0x81, 0xf2, 0x6d,
-	0x4c, 0x4c, 0xe3, 0xf7, 0x4b, 0xb0, 0x28, 0x38, 0x9c, 0xe0, 0xa1, 0x23, 0xc8, 0xf7, 0x26, 0xed,
-	0xb2, 0x23, 0x28, 0xef, 0x4f, 0xe8, 0x61, 0xfa, 0x99, 0x37, 0xcc, 0xf7, 0xc1, 0x6c, 0xb8, 0x73,
-	0x8a, 0x4f, 0xa9, 0x33, 0x21, 0x7f, 0xe3, 0x1a, 0x8d, 0x49, 0x2e, 0x8f, 0xd4, 0xe9, 0x39, 0x42,
-	0xb7, 0x85, 0xa3, 0xbe, 0xaa, 0xce, 0xb7, 0x37, 0x13, 0xf3, 0x8d, 0xce, 0xb0, 0x75, 0x8f, 0xf0,
-	0xef, 0x95, 0x92, 0x87, 0x3e, 0x0b, 0x33, 0x6a, 0x47, 0xe6, 0xa8, 0x79, 0x10, 0xee, 0x2b, 0x26,
-	0x5a, 0x29, 0x92, 0x7d, 0xcb, 0xb9, 0x7e, 0xb7, 0x02, 0xad, 0xe8, 0x2e, 0x46, 0x3a, 0xd8, 0xdc,
-	0xb2, 0xfd, 0x55, 0x62, 0x5a, 0xc4, 0x13, 0xf3, 0xf6, 0x9d, 0xc2, 0x4b, 0x1e, 0xdd, 0x9e, 0x40,
-	0xe0, 0x08, 0x6b, 0x9c, 0x84, 0x56, 0x98, 0x9b, 0x73, 0xf8, 0xf8, 0x79, 0x15, 0x1a, 0xe2, 0x16,
-	0x47, 0xb2, 0x12, 0x77, 0xa0, 0x31, 0x34, 0x0f, 0xdc, 0xbd, 0xf0, 0x6c, 0x70, 0xb6, 0xe0, 0x62,
-	0x48, 0xf7, 0x11, 0x93, 0xc6, 0x02, 0x85, 0x3e, 0x84, 0xfa, 0xd0, 0xde, 0xb5, 0x03, 0xb1, 0x7c,
-	0x9c, 0x29, 0x84, 0xb3, 0xef, 0x3d, 0x1c, 0x43, 0x95, 0xb3, 0x8f, 0xb7, 0xe1, 0xd5, 0xbb, 0x42,
-	0xe5, 0xcf, 0x99, 0x34, 0x16, 0x28, 0xe3, 0x01, 0x34, 0x78, 0x75, 0x26, 0x73, 0x12, 0x6a, 0x4b,
-	0x62, 0x4b, 0x67, 0x75, 0xcb, 0xd9, 0x6d, 0xce, 0x41, 0x83, 0x2b, 0xcf, 0xb1, 0x9a, 0x9f, 0xbd,
-	0xc6, 0x4e, 0x1c, 0x43, 0xe3, 0x51, 0xfc, 0x29, 0xe7, 0xf3, 0x87, 0xe6, 0x8d, 0xa7, 0x70, 0x64,
-	0xd9, 0x0c, 0xcc, 0x4d, 0xd3, 0x27, 0x98, 0xf4, 0x5d, 0xcf, 0xca, 0x64, 0xf5, 0x78, 0x91, 0x08,
-	0xb8, 0xe6, 0xb3, 0x0a, 0xb9, 0x2f, 0x43, 0x64, 0xff, 0x73, 0x42, 0x64, 0x7f, 0xa5, 0xe5, 0xc4,
-	0xad, 0xca, 0x1c, 0xd9, 0xa9, 0xc1, 0xa5, 0x02, 0x57, 0x37, 0xd5, 0xbd, 0xf7, 0xe9, 0x02, 0xa4,
-	0xb2, 0xf9, 0xbe, 0xa9, 0x46, 0xae, 0x8a, 0xb0, 0x4a, 0xe8, 0xea, 0x5e, 0x32, 0x74, 0x75, 0xb6,
-	0x00, 0x9d, 0x8a, 0x5d, 0xdd, 0x54, 0x63, 0x57, 0x45, 0xda, 0xe5, 0xe0, 0xd5, 0xff, 0xb1, 0x70,
-	0xd1, 0x9f, 0xe4, 0x04, 0x5e, 0xbe, 0xa2, 0x06, 0x5e, 0xc6, 0x58, 0xcd, 0xaf, 0x2a, 0xf2, 0xf2,
-	0xa7, 0x79, 0x91, 0x97, 0xeb, 0x4a, 0xe4, 0x65, 0x4c, 0xcd, 0x92, 0xa1, 0x97, 0x9b, 0x6a, 0xe8,
-	0xe5, 0x74, 0x01, 0x52, 0x89, 0xbd, 0x5c, 0x57, 0x62, 0x2f, 0x45, 0x4a, 0xa5, 0xe0, 0xcb, 0x75,
-	0x25, 0xf8, 0x52, 0x04, 0x94, 0xa2, 0x2f, 0xd7, 0x95, 0xe8, 0x4b, 0x11, 0x50, 0x0a, 0xbf, 0x5c,
-	0x57, 0xc2, 0x2f, 0x45, 0x40, 0x29, 0xfe, 0x72, 0x53, 0x8d, 0xbf, 0x14, 0xf7, 0xcf, 0x97, 0x01,
-	0x98, 0x5f, 0x4f, 0x00, 0xe6, 0x0f, 0x6a, 0x39, 0x01, 0x18, 0x9c, 0x1d, 0x80, 0xb9, 0x90, 0x3f,
-	0x92, 0xc5, 0x11, 0x98, 0xf2, 0x5e, 0x20, 0x1d, 0x82, 0xb9, 0x9d, 0x08, 0xc1, 0x9c, 0x29, 0x00,
-	0xab, 0x31, 0x98, 0xff, 0x35, 0x41, 0x86, 0xbf, 0x6c, 0x8c, 0x39, 0x4f, 0xdf, 0x90, 0xcf, 0xd3,
-	0x63, 0x3c, 0x59, 0xfa, 0x40, 0x7d, 0x47, 0x3d, 0x50, 0x9f, 0x2f, 0x81, 0x55, 0x4e, 0xd4, 0xeb,
-	0x59, 0x27, 0xea, 0x6e, 0x09, 0x96, 0xdc, 0x23, 0xf5, 0x83, 0xf4, 0x91, 0xfa, 0x42, 0x09, 0xbe,
-	0xcc, 0x33, 0xf5, 0x7a, 0xd6, 0x99, 0xba, 0x4c, 0xed, 0x72, 0x0f, 0xd5, 0x1f, 0x2a, 0x87, 0xea,
-	0x73, 0x65, 0xba, 0x2b, 0x76, 0x0e, 0x5f, 0xcb, 0x39, 0x55, 0x7f, 0x50, 0x86, 0x66, 0xec, 0xb1,
-	0xfa, 0xcb, 0x73, 0x71, 0x42, 0xcd, 0x5f, 0xbc, 0x09, 0xad, 0xf0, 0xde, 0x88, 0xf1, 0x1d, 0x68,
-	0x86, 0x57, 0xf7, 0x93, 0x33, 0xe7, 0x44, 0x74, 0xa8, 0xe3, 0xbb, 0x67, 0x91, 0x42, 0x77, 0x40,
-	0xa3, 0xbf, 0xc4, 0xb4, 0x78, 0xa7, 0xdc, 0xfd, 0x14, 0xaa, 0x04, 0x33, 0x9c, 0xf1, 0x9f, 0xc7,
-	0x01, 0xa4, 0x1b, 0xcd, 0x65, 0xd5, 0x7e, 0x44, 0x17, 0xb3, 0x61, 0x40, 0x3c, 0x76, 0x2f, 0xa9,
-	0xf0, 0xc6, 0x6f, 0xac, 0x81, 0x5a, 0x4b, 0x40, 0x3c, 0x2c, 0xe0, 0xe8, 0x31, 0xb4, 0xc2, 0x40,
-	0xaa, 0xae, 0x31, 0xaa, 0x0f, 0x4a, 0x53, 0x85, 0xa1, 0x3d, 0x1c, 0x51, 0xa0, 0x05, 0xd0, 0x7c,
-	0xd7, 0x0b, 0xf4, 0x3a, 0xa3, 0x7a, 0xaf, 0x34, 0xd5, 0x86, 0xeb, 0x05, 0x98, 0x41, 0x79, 0xd3,
-	0xa4, 0x07, 0x63, 0x93, 0x34, 0x4d, 0x59, 0xb1, 0xff, 0xa3, 0x16, 0xad, 0xa1, 0x4b, 0x62, 0x36,
-	0x72, 0x1b, 0xba, 0x58, 0x7e, 0x94, 0xe4, 0x59, 0x89, 0xc4, 0x26, 0x88, 0x8f, 0x04, 0xdf, 0xdf,
-	0xbc, 0x03, 0x9d, 0xbe, 0xbb, 0x4f, 0x3c, 0x1c, 0xdf, 0xd8, 0x11, 0x97, 0xaa, 0x52, 0xf9, 0xc8,
-	0x80, 0xd6, 0xb6, 0x6d, 0x91, 0x5e, 0x5f, 0xac, 0x7f, 0x2d, 0x1c, 0xa5, 0xd1, 0x43, 0x68, 0xb1,
-	0x18, 0x7b, 0x18, 0xe1, 0x9f, 0xac, 0x92, 0x3c, 0xd4, 0x1f, 0x12, 0x50, 0x45, 0x4c, 0xf9, 0x7d,
-	0x3b, 0x60, 0x7d, 0xd8, 0xc2, 0x51, 0x9a, 0x56, 0x98, 0x5d, 0x8b, 0x92, 0x2b, 0xdc, 0xe4, 0x15,
-	0x4e, 0xe6, 0xa3, 0x2b, 0xf0, 0x0a, 0xcb, 0x4b, 0x1c, 0x31, 0x79, 0xa8, 0xbe, 0x85, 0xb3, 0x0b,
-	0xd9, 0x35, 0x30, 0x73, 0xc0, 0xaf, 0xc0, 0xb2, 0xe0, 0x5d, 0x1d, 0xc7, 0x19, 0xe8, 0x02, 0x1c,
-	0xb5, 0xc8, 0x96, 0xb9, 0x37, 0x0c, 0x9e, 0x92, 0xdd, 0xd1, 0xd0, 0x0c, 0x48, 0xcf, 0x62, 0x6f,
-	0xd6, 0xda, 0x38, 0x5d, 0x80, 0xde, 0x87, 0x63, 0x22, 0x93, 0x4f, 0x63, 0x3a, 0x1a, 0x3d, 0x8b,
-	0x3d, 0xe1, 0x6a, 0xe3, 0xac, 0x22, 0xe3, 0x67, 0x1a, 0x1d, 0x74, 0x66, 0xda, 0x1f, 0x41, 0xcd,
-	0xb4, 0x2c, 0xe1, 0x36, 0x2f, 0x4f, 0x38, 0x41, 0xc4, 0xb3, 0x4c, 0xca, 0x80, 0xd6, 0xa3, 0x1b,
-	0x64, 0xdc, 0x71, 0x5e, 0x9b, 0x94, 0x2b, 0x7a, 0x4a, 0x2b, 0x78, 0x28, 0xe3, 0x1e, 0xbf, 0x27,
-	0x5d, 0xfb, 0xe5, 0x18, 0xa3, 0x0b, 0xd4, 0x82, 0x07, 0x3d, 0x00, 0x8d, 0xd5, 0x90, 0x3b, 0xd6,
-	0x2b, 0x93, 0xf2, 0x3d, 0xe6, 0xf5, 0x63, 0x1c, 0x46, 0x9f, 0xdf, 0xf1, 0x92, 0xee, 0x0f, 0x56,
-	0xd4, 0xfb, 0x83, 0x8b, 0x50, 0xb7, 0x03, 0xb2, 0x9b, 0xbe, 0x4e, 0x3a, 0xd6, 0x54, 0xc5, 0xca,
-	0xc3, 0xa1, 0x63, 0xaf, 0xb5, 0x7d, 0x12, 0xdd, 0xac, 0x4d, 0xae, 0x87, 0xf7, 0x40, 0xa3, 0xf0,
-	0xd4, 0x5e, 0xb2, 0x8c, 0x62, 0x86, 0x34, 0x2e, 0x81, 0x46, 0x1b, 0x3b, 0xa6, 0x75, 0xa2, 0x3e,
-	0xd5, 0xa8, 0x3e, 0x8b, 0xd3, 0xd0, 0x76, 0x47, 0xc4, 0x63, 0x13, 0xc3, 0xf8, 0x85, 0x26, 0x5d,
-	0xfe, 0xea, 0xc9, 0x36, 0x76, 0x75, 0xe2, 0x95, 0x53, 0xb6, 0x32, 0x9c, 0xb0, 0xb2, 0x1b, 0x93,
-	0xb3, 0xa5, 0xec, 0x0c, 0x27, 0xec, 0xec, 0x97, 0xe0, 0x4c, 0x59, 0xda, 0x23, 0xc5, 0xd2, 0xae,
-	0x4d, 0xce, 0xa8, 0xd8, 0x1a, 0x29, 0xb2, 0xb5, 0x65, 0xd5, 0xd6, 0xba, 0xe5, 0x86, 0x3c, 0x72,
-	0x4d, 0x25, 0xac, 0xed, 0x1b, 0xb9, 0xd6, 0xb6, 0xa8, 0x58, 0xdb, 0xa4, 0xaa, 0xbf, 0x20, 0x7b,
-	0xfb, 0x67, 0x0d, 0x34, 0xea, 0x1e, 0xd1, 0x8a, 0x6c, 0x6b, 0x1f, 0x4c, 0xe4, 0x5a, 0x65, 0x3b,
-	0x5b, 0x4b, 0xd8, 0xd9, 0x95, 0xc9, 0x98, 0x52, 0x36, 0xb6, 0x96, 0xb0, 0xb1, 0x09, 0xf9, 0x52,
-	0xf6, 0xb5, 0xaa, 0xd8, 0xd7, 0xa5, 0xc9, 0xd8, 0x14, 0xdb, 0x32, 0x8b, 0x6c, 0xeb, 0x9e, 0x6a,
-	0x5b, 0x25, 0x77, 0x6f, 0x6c, 0xaf, 0x52, 0xc2, 0xae, 0x3e, 0xce, 0xb5, 0xab, 0x3b, 0x8a, 0x5d,
-	0x4d, 0xa2, 0xf6, 0x0b, 0xb2, 0xa9, 0x2b, 0x7c, 0xd3, 0x29, 0xee, 0xd3, 0x96, 0xdc, 0x74, 0x1a,
-	0x57, 0xa1, 0x1d, 0x3f, 0x09, 0xcd, 0xb8, 0x6d, 0xce, 0xc5, 0x42, 0xad, 0x61, 0xd2, 0xb8, 0x0c,
-	0xed, 0xf8, 0x99, 0x67, 0x86, 0x2e, 0x9f, 0x15, 0x0a, 0x94, 0x48, 0x19, 0x2b, 0x70, 0x34, 0xfd,
-	0x08, 0x2d, 0x23, 0x0e, 0x2f, 0x5d, 0x95, 0x16, 0xb5, 0x95, 0xb3, 0x8c, 0x17, 0x30, 0x93, 0x78,
-	0x56, 0x36, 0x31, 0x07, 0xba, 0x2c, 0x6d, 0x91, 0x6b, 0xe2, 0x0c, 0x9e, 0x7d, 0xf9, 0x3b, 0xde,
-	0x08, 0x1b, 0xcb, 0x30, 0x53, 0x50, 0xf9, 0x32, 0x77, 0xbf, 0xbf, 0x05, 0xd3, 0xe3, 0xea, 0xfe,
-	0x05, 0xdc, 0x4d, 0x0f, 0xa0, 0x93, 0x7a, 0x12, 0x9b, 0x54, 0xb3, 0x0e, 0x30, 0x88, 0x64, 0x84,
-	0xd1, 0xbe, 0x3f, 0xc1, 0x4d, 0x7c, 0x86, 0xc3, 0x12, 0x87, 0xf1, 0xe7, 0x15, 0x38, 0x9a, 0x7e,
-	0x0f, 0x5b, 0xf6, 0xf0, 0xa3, 0x43, 0x93, 0x71, 0x45, 0x0f, 0x18, 0xc2, 0x24, 0x7a, 0x0c, 0x87,
-	0xfc, 0xa1, 0xdd, 0x27, 0x4b, 0xdb, 0xa6, 0x33, 0x20, 0xbe, 0x38, 0xd1, 0x14, 0xbc, 0x69, 0xdd,
-	0x88, 0x11, 0x58, 0x81, 0x1b, 0x2f, 0x60, 0x5a, 0x2a, 0x44, 0xb7, 0xa0, 0xea, 0x8e, 0xc4, 0x19,
-	0xe2, 0x42, 0x09, 0xce, 0x27, 0xe1, 0x7c, 0xc3, 0x55, 0x77, 0x94, 0x9e, 0x92, 0xf2, 0xf4, 0xad,
-	0x29, 0xd3, 0xd7, 0x78, 0x08, 0x47, 0xd3, 0x4f, 0x4e, 0x93, 0xdd, 0x73, 0x36, 0x15, 0x25, 0xe0,
-	0xdd, 0x94, 0x3c, 0xf2, 0x5f, 0x87, 0x23, 0xc9, 0x87, 0xa4, 0x19, 0x8f, 0x4b, 0xe2, 0x37, 0x3a,
-	0x61, 0xb8, 0x7e, 0xfe, 0xf7, 0x2b, 0x30, 0xa3, 0x36, 0x04, 0x9d, 0x00, 0xa4, 0xe6, 0xac, 0xb9,
-	0x0e, 0xe9, 0x4c, 0xa1, 0x57, 0xe0, 0xa8, 0x9a, 0xbf, 0x60, 0x59, 0x9d, 0x4a, 0x5a, 0x9c, 0x2e,
-	0x5b, 0x9d, 0x2a, 0xd2, 0xe1, 0x78, 0xa2, 0x87, 0xd8, 0x22, 0xda, 0xa9, 0xa1, 0xd7, 0xe0, 0x95,
-	0x64, 0xc9, 0x68, 0x68, 0xf6, 0x49, 0x47, 0x33, 0xfe, 0xbd, 0x0a, 0xda, 0x33, 0x9f, 0x78, 0xc6,
-	0xbf, 0x56, 0xc3, 0xd7, 0x08, 0x37, 0x40, 0x63, 0x6f, 0x3c, 0xa5, 0xb7, 0x69, 0x95, 0xc4, 0xdb,
-	0x34, 0xe5, 0x1f, 0x3c, 0xc5, 0x6f, 0xd3, 0x6e, 0x80, 0xc6, 0x5e, 0x75, 0x4e, 0x8e, 0xfc, 0x9d,
-	0x0a, 0xb4, 0xe3, 0x17, 0x96, 0x13, 0xe3, 0xe5, 0xd7, 0x0f, 0x55, 0xf5, 0xf5, 0xc3, 0x3b, 0x50,
-	0xf7, 0xd8, 0x3b, 0x05, 0xbe, 0xca, 0x24, 0xdf, 0x54, 0x30, 0x85, 0x98, 0x8b, 0x18, 0x04, 0xa6,
-	0xe5, 0xf7, 0xa3, 0x93, 0x57, 0xe3, 0xb4, 0xf8, 0xe7, 0x11, 0x3d, 0xcb, 0x5f, 0xf0, 0x3c, 0xf3,
-	0x40, 0x18, 0xa6, 0x9a, 0x69, 0xcc, 0x82, 0xb6, 0x6e, 0x3b, 0x83, 0xec, 0x27, 0x81, 0xc6, 0x4f,
-	0x2a, 0xd0, 0x14, 0xaf, 0x31, 0x8d, 0xeb, 0x50, 0x5b, 0x23, 0x2f, 0x68, 0x45, 0xc4, 0x7b, 0xcc,
-	0x54, 0x45, 0x1e, 0xb3, 0x56, 0x08, 0x79, 0x1c, 0x8a, 0x19, 0x37, 0x23, 0x37, 0x39, 0x39, 0xf6,
-	0x06, 0x68, 0xec, 0xd9, 0xe7, 0xe4, 0xc8, 0x3f, 0x6b, 0x41, 0x83, 0xbf, 0xab, 0x33, 0x7e, 0xd0,
-	0x82, 0x06, 0x7f, 0x0a, 0x8a, 0xee, 0x40, 0xd3, 0xdf, 0xdb, 0xdd, 0x35, 0xbd, 0x03, 0x3d, 0xfb,
-	0xbf, 0x8f, 0x29, 0x2f, 0x47, 0xbb, 0x1b, 0x5c, 0x16, 0x87, 0x20, 0x74, 0x15, 0xb4, 0xbe, 0xb9,
-	0x45, 0x52, 0x9f, 0x73, 0xb3, 0xc0, 0x4b, 0xe6, 0x16, 0xc1, 0x4c, 0x1c, 0xdd, 0x83, 0x96, 0x18,
-	0x16, 0x5f, 0xc4, 0x73, 0xc6, 0xeb, 0x0d, 0x07, 0x33, 0x42, 0x19, 0x0f, 0xa0, 0x29, 0x2a, 0x83,
-	0xee, 0x46, 0xaf, 0x0a, 0x93, 0x91, 0xe7, 0xcc, 0x26, 0x1c, 0x38, 0xfd, 0xc4, 0xfb, 0xc2, 0xbf,
-	0xab, 0x82, 0x46, 0x2b, 0xf7, 0xb9, 0x99, 0xd0, 0x1c, 0xc0, 0xd0, 0xf4, 0x83, 0xf5, 0xbd, 0xe1,
-	0x90, 0x58, 0xe2, 0xc1, 0x98, 0x94, 0x83, 0xce, 0xc3, 0x11, 0x9e, 0xf2, 0xb7, 0x37, 0xf6, 0xfa,
-	0x7d, 0x42, 0x2c, 0xf1, 0x46, 0x2b, 0x99, 0x8d, 0x16, 0xa0, 0xce, 0xfe, 0x39, 0x91, 0xd8, 0x15,
-	0xbe, 0x5b, 0xd8, 0xb3, 0xdd, 0x75, 0xdb, 0x11, 0xb5, 0xe1, 0x48, 0xc3, 0x85, 0x76, 0x94, 0x47,
-	0x27, 0xe1, 0xc8, 0x76, 0x1c, 0xdb, 0x19, 0x08, 0x8b, 0x0e, 0x93, 0xd4, 0xe9, 0xd0, 0x9f, 0xa2,
-	0xbe, 0x75, 0x2c, 0x52, 0x34, 0x7f, 0xcb, 0xb4, 0x87, 0xa2, 0x8a, 0x75, 0x2c, 0x52, 0x94, 0x89,
-	0x6f, 0x5c, 0xf9, 0x75, 0x8f, 0x1a, 0x0e, 0x93, 0xc6, 0xa7, 0x95, 0xe8, 0x69, 0x6d, 0xd6, 0x5b,
-	0xc3, 0x54, 0x2c, 0x69, 0x56, 0x0e, 0x68, 0x73, 0x87, 0x20, 0x85, 0xa8, 0x4f, 0x40, 0xc3, 0x75,
-	0x86, 0xb6, 0x43, 0x44, 0xec, 0x48, 0xa4, 0x12, 0x7d, 0x5c, 0x4f, 0xf5, 0xb1, 0x28, 0x5f, 0xb1,
-	0x6c, 0x5a, 0xc5, 0x46, 0x5c, 0xce, 0x73, 0xd0, 0x6d, 0x68, 0x5a, 0x64, 0xdf, 0xee, 0x13, 0x5f,
-	0x6f, 0x32, 0xd3, 0x3b, 0x35, 0xb6, 0x6f, 0x97, 0x99, 0x2c, 0x0e, 0x31, 0x46, 0x00, 0x0d, 0x9e,
-	0x15, 0x35, 0xa9, 0x22, 0x35, 0x29, 0xae, 0x74, 0x75, 0x4c, 0xa5, 0x6b, 0x05, 0x95, 0xd6, 0x92,
-	0x95, 0x9e, 0xb7, 0x00, 0x62, 0x73, 0x43, 0xd3, 0xd0, 0x7c, 0xe6, 0xec, 0x38, 0xee, 0x0b, 0xa7,
-	0x33, 0x45, 0x13, 0x4f, 0xb6, 0xb6, 0xa8, 0x96, 0x4e, 0x85, 0x26, 0xa8, 0x9c, 0xed, 0x0c, 0x3a,
-	0x55, 0x04, 0xd0, 0xa0, 0x09, 0x62, 0x75, 0x6a, 0xf4, 0xf7, 0x7d, 0x36, 0x7e, 0x1d, 0x0d, 0xbd,
-	0x0a, 0xc7, 0x7a, 0x4e, 0xdf, 0xdd, 0x1d, 0x99, 0x81, 0xbd, 0x39, 0x24, 0xcf, 0x89, 0xe7, 0xdb,
-	0xae, 0xd3, 0xa9, 0x1b, 0x3f, 0xaa, 0xf0, 0xaf, 0xbe, 0xc6, 0x3d, 0x38, 0xa4, 0xbc, 0xe8, 0xd6,
-	0xa1, 0xe9, 0x8f, 0xf8, 0xff, 0x58, 0x14, 0xfb, 0x6e, 0x91, 0x64, 0x56, 0xc2, 0x1f, 0x39, 0x8b,
-	0x2d, 0x0b, 0x4f, 0x19, 0x17, 0x00, 0xa4, 0x77, 0xdc, 0x73, 0x00, 0x9b, 0x07, 0x01, 0xf1, 0xf9,
-	0x1b, 0x6e, 0x4a, 0xa1, 0x61, 0x29, 0xc7, 0xb8, 0x06, 0x10, 0xbf, 0xd5, 0x66, 0xb3, 0x84, 0xa6,
-	0x16, 0x93, 0x90, 0x64, 0xf6, 0xfc, 0xf7, 0xe0, 0x30, 0x26, 0xfe, 0xc8, 0x75, 0x7c, 0xf2, 0xab,
-	0xfa, 0xa7, 0x94, 0xb9, 0xff, 0x5e, 0x72, 0xfe, 0x27, 0x35, 0xa8, 0xb3, 0xc5, 0xd6, 0xf8, 0x51,
-	0x2d, 0x72, 0x0b, 0x19, 0x57, 0x71, 0xe2, 0x0f, 0xe6, 0x33, 0xd2, 0x4e, 0x55, 0x59, 0xa6, 0xe5,
-	0xa8, 0xeb, 0x25, 0xf9, 0x43, 0xf9, 0x8c, 0xf4, 0x94, 0x5f, 0x45, 0x28, 0x1f, 0xc8, 0x3f, 0x84,
-	0xd6, 0xc8, 0x73, 0x07, 0x1e, 0xf5, 0x07, 0x5a, 0xe2, 0x3f, 0x03, 0xa9, 0xb0, 0x75, 0x21, 0x86,
-	0x23, 0x80, 0xb1, 0x06, 0xad, 0x30, 0x37, 0xe7, 0x19, 0x2c, 0x02, 0xcd, 0x72, 0x85, 0x4d, 0xd7,
-	0x30, 0xfb, 0x4d, 0xfb, 0x45, 0xf4, 0x60, 0xb8, 0x97, 0x13, 0xc9, 0xf9, 0x6f, 0x8a, 0x0f, 0x19,
-	0x87, 0xa1, 0xbd, 0xec, 0xb9, 0x23, 0xf6, 0x10, 0xb2, 0x33, 0x45, 0x2d, 0xb0, 0xb7, 0x3b, 0x72,
-	0xbd, 0xa0, 0x53, 0xa1, 0xbf, 0x57, 0x5e, 0xb2, 0xdf, 0x55, 0x74, 0x08, 0x5a, 0x1b, 0xe6, 0x3e,
-	0xa1, 0x62, 0x9d, 0x1a, 0x42, 0xf4, 0x18, 0xc1, 0x82, 0xb7, 0x62, 0x25, 0xe9, 0x68, 0x94, 0xe8,
-	0xb1, 0x3d, 0xe0, 0xbb, 0xa3, 0x4e, 0x7d, 0x7e, 0x21, 0xfc, 0x60, 0xdd, 0x02, 0x4d, 0xec, 0xc6,
-	0xa6, 0xa1, 0x89, 0xf7, 0xd8, 0x72, 0xd6, 0xa9, 0xd0, 0x6c, 0xea, 0x23, 0x39, 0xf5, 0x92, 0xe9,
-	0xf4, 0xc9, 0x90, 0x4d, 0x81, 0x36, 0xd4, 0x57, 0x3c, 0xcf, 0xf5, 0x3a, 0xda, 0xe2, 0xec, 0xdf,
-	0x7f, 0x3a, 0x57, 0xf9, 0xe9, 0xa7, 0x73, 0x95, 0x9f, 0x7f, 0x3a, 0x57, 0xf9, 0xc3, 0xcf, 0xe6,
-	0xa6, 0x7e, 0xfa, 0xd9, 0xdc, 0xd4, 0xbf, 0x7c, 0x36, 0x37, 0xf5, 0x49, 0x75, 0xb4, 0xb9, 0xd9,
-	0x60, 0x5f, 0x1a, 0x2f, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0xee, 0x89, 0x61, 0x52,
-	0x55, 0x00, 0x00,
+	0x79, 0xff, 0xce, 0x7b, 0xe6, 0x5b, 0x72, 0x39, 0x2c, 0x52, 0x54, 0xbb, 0xb5, 0xa2, 0xa8, 0x15,
+	0x45, 0xd2, 0x12, 0x35, 0x94, 0xf8, 0x36, 0x45, 0x91, 0xdc, 0x17, 0xb5, 0xc3, 0xe7, 0xba, 0x96,
+	0xa4, 0x64, 0xd9, 0xf8, 0xc3, 0xbd, 0xd3, 0xb5, 0xbb, 0x6d, 0xce, 0x4e, 0x8f, 0xbb, 0x7b, 0x96,
+	0x5c, 0xfb, 0x9f, 0x07, 0xf2, 0x80, 0x11, 0x20, 0x06, 0x92, 0x8b, 0xe3, 0x43, 0x2e, 0x01, 0x1c,
+	0x20, 0x87, 0x1c, 0x0c, 0xf8, 0x6e, 0x04, 0x08, 0x02, 0x24, 0xce, 0xc5, 0xb9, 0xe5, 0x66, 0x43,
+	0xba, 0x24, 0x87, 0x20, 0x0f, 0x03, 0x01, 0x72, 0x0b, 0xbe, 0xaa, 0xea, 0xee, 0xaa, 0x7e, 0x4c,
+	0xcf, 0x58, 0x32, 0x9c, 0x20, 0xba, 0xec, 0x4e, 0x7d, 0xf5, 0xfd, 0x7e, 0xf5, 0xfa, 0xea, 0xf5,
+	0x55, 0x55, 0xc3, 0xb1, 0xe1, 0xe6, 0xb9, 0xa1, 0xe7, 0x06, 0xae, 0x7f, 0x8e, 0xed, 0xb1, 0x41,
+	0xe0, 0x77, 0x78, 0x88, 0x34, 0xac, 0xc1, 0x7e, 0xb0, 0x3f, 0x64, 0xe6, 0xc9, 0xe1, 0xd3, 0xed,
+	0x73, 0x7d, 0x67, 0xf3, 0xdc, 0x70, 0xf3, 0xdc, 0xae, 0x6b, 0xb3, 0x7e, 0xa8, 0xce, 0x03, 0x52,
+	0xdd, 0x9c, 0xdf, 0x76, 0xdd, 0xed, 0x3e, 0x13, 0x71, 0x9b, 0xa3, 0xad, 0x73, 0x7e, 0xe0, 0x8d,
+	0x7a, 0x81, 0x88, 0x5d, 0xf8, 0xee, 0x8f, 0x4a, 0x50, 0x5b, 0x45, 0x7a, 0x72, 0x1e, 0x9a, 0xbb,
+	0xcc, 0xf7, 0xad, 0x6d, 0xe6, 0x1b, 0xa5, 0x13, 0x95, 0x33, 0xb3, 0xe7, 0x8f, 0x75, 0x64, 0x52,
+	0x1d, 0xae, 0xd1, 0xb9, 0x2f, 0xa2, 0x69, 0xa4, 0x47, 0xe6, 0xa1, 0xd5, 0x73, 0x07, 0x01, 0x7b,
+	0x1e, 0x74, 0x6d, 0xa3, 0x7c, 0xa2, 0x74, 0xa6, 0x45, 0x63, 0x01, 0xb9, 0x08, 0x2d, 0x67, 0xe0,
+	0x04, 0x8e, 0x15, 0xb8, 0x9e, 0x51, 0x39, 0x51, 0xd2, 0x28, 0x79, 0x26, 0x3b, 0x8b, 0xbd, 0x9e,
+	0x3b, 0x1a, 0x04, 0x34, 0x56, 0x24, 0x06, 0x34, 0x02, 0xcf, 0xea, 0xb1, 0xae, 0x6d, 0x54, 0x39,
+	0x63, 0x18, 0x34, 0x7f, 0x70, 0x16, 0x1a, 0x32, 0x0f, 0xe4, 0x26, 0xcc, 0x5a, 0x02, 0xbb, 0xb1,
+	0xe3, 0x3e, 0x33, 0x4a, 0x9c, 0xfd, 0xa5, 0x44, 0x86, 0x25, 0x7b, 0x07, 0x55, 0xd6, 0x66, 0xa8,
+	0x8a, 0x20, 0x5d, 0x98, 0x93, 0xc1, 0x15, 0x16, 0x58, 0x4e, 0xdf, 0x37, 0xfe, 0x4e, 0x90, 0x1c,
+	0xcf, 0x21, 0x91, 0x6a, 0x6b, 0x33, 0x34, 0x01, 0x24, 0x5f, 0x81, 0x23, 0x52, 0xb2, 0xec, 0x0e,
+	0xb6, 0x9c, 0xed, 0xc7, 0x43, 0xdb, 0x0a, 0x98, 0xf1, 0x13, 0xc1, 0x77, 0x32, 0x87, 0x4f, 0xe8,
+	0x76, 0x84, 0xf2, 0xda, 0x0c, 0xcd, 0xe2, 0x20, 0xb7, 0xe1, 0xa0, 0x14, 0x4b, 0xd2, 0xbf, 0x17,
+	0xa4, 0x2f, 0xe7, 0x90, 0x46, 0x6c, 0x3a, 0x8c, 0x3c, 0x84, 0xb6, 0xbb, 0xf9, 0x0d, 0xd6, 0x0b,
+	0xf3, 0xbc, 0xc1, 0x02, 0xa3, 0xcd, 0x99, 0x5e, 0x4d, 0x30, 0x3d, 0xe4, 0x6a, 0x61, 0x69, 0x3b,
+	0x1b, 0x2c, 0x58, 0x9b, 0xa1, 0x29, 0x30, 0x79, 0x0c, 0x44, 0x93, 0x2d, 0xee, 0xb2, 0x81, 0x6d,
+	0x9c, 0xe7, 0x94, 0xaf, 0x8d, 0xa7, 0xe4, 0xaa, 0x6b, 0x33, 0x34, 0x83, 0x20, 0x45, 0xfb, 0x78,
+	0xe0, 0xb3, 0xc0, 0xb8, 0x30, 0x09, 0x2d, 0x57, 0x4d, 0xd1, 0x72, 0x29, 0xf9, 0x2a, 0x1c, 0x15,
+	0x52, 0xca, 0xfa, 0x56, 0xe0, 0xb8, 0x03, 0x99, 0xdf, 0x8b, 0x9c, 0xf8, 0xf5, 0x6c, 0xe2, 0x48,
+	0x37, 0xca, 0x71, 0x26, 0x09, 0xf9, 0x7f, 0xf0, 0x42, 0x42, 0x4e, 0xd9, 0xae, 0xbb, 0xc7, 0x8c,
+	0x4b, 0x9c, 0xfd, 0x54, 0x11, 0xbb, 0xd0, 0x5e, 0x9b, 0xa1, 0xd9, 0x34, 0x64, 0x09, 0x0e, 0x84,
+	0x11, 0x9c, 0xf6, 0x32, 0xa7, 0x9d, 0xcf, 0xa3, 0x95, 0x64, 0x1a, 0x46, 0xcd, 0xa3, 0x1f, 0x78,
+	0x4e, 0x8f, 0xf3, 0xa3, 0x11, 0x5c, 0x19, 0x9f, 0xc7, 0x58, 0x59, 0x5a, 0x42, 0x36, 0x0d, 0xa1,
+	0x70, 0xc8, 0x1f, 0x6d, 0xfa, 0x3d, 0xcf, 0x19, 0xa2, 0x6c, 0xd1, 0xb6, 0x8d, 0xeb, 0xe3, 0x98,
+	0x37, 0x14, 0xe5, 0xce, 0xa2, 0x8d, 0x95, 0x9b, 0x24, 0x20, 0x5f, 0x05, 0xa2, 0x8a, 0x64, 0xe9,
+	0xdf, 0xe3, 0xb4, 0x5f, 0x9c, 0x80, 0x36, 0xaa, 0x8a, 0x0c, 0x1a, 0x62, 0xc1, 0x51, 0x55, 0xba,
+	0xee, 0xfa, 0x0e, 0xfe, 0x37, 0x6e, 0x70, 0xfa, 0x37, 0x27, 0xa0, 0x0f, 0x21, 0x68, 0x17, 0x59,
+	0x54, 0xc9, 0x24, 0x96, 0xb1, 0x3b, 0x32, 0xcf, 0x37, 0x6e, 0x4e, 0x9c, 0x44, 0x08, 0x49, 0x26,
+	0x11, 0xca, 0x93, 0x55, 0xf4, 0xbe, 0xe7, 0x8e, 0x86, 0xbe, 0x71, 0x6b, 0xe2, 0x2a, 0x12, 0x80,
+	0x64, 0x15, 0x09, 0x29, 0xb9, 0x0c, 0xcd, 0xcd, 0xbe, 0xdb, 0x7b, 0xba, 0x68, 0x8b, 0xb1, 0x7d,
+	0xf6, 0xbc, 0x91, 0xa0, 0x5c, 0xc2, 0x68, 0xd9, 0x7c, 0x91, 0x2e, 0x0e, 0xcd, 0xfc, 0xf7, 0x0a,
+	0xeb, 0xb3, 0x80, 0x19, 0x95, 0xcc, 0xa1, 0x59, 0x40, 0x85, 0x0a, 0x0e, 0xcd, 0x0a, 0x82, 0xac,
+	0xc0, 0xec, 0x96, 0xd3, 0x67, 0xfe, 0xe3, 0x61, 0xdf, 0xb5, 0xc4, 0x2c, 0x30, 0x7b, 0xfe, 0x44,
+	0x26, 0xc1, 0xed, 0x58, 0x0f, 0x59, 0x14, 0x18, 0xb9, 0x01, 0xad, 0x5d, 0xcb, 0x7b, 0xea, 0x77,
+	0x07, 0x5b, 0xae, 0x51, 0xcb, 0x1c, 0xda, 0x05, 0xc7, 0xfd, 0x50, 0x6b, 0x6d, 0x86, 0xc6, 0x10,
+	0x9c, 0x20, 0x78, 0xa6, 0x36, 0x58, 0x70, 0xdb, 0x61, 0x7d, 0xdb, 0x37, 0xea, 0x9c, 0xe4, 0x95,
+	0x4c, 0x92, 0x0d, 0x16, 0x74, 0x84, 0x1a, 0x4e, 0x10, 0x3a, 0x90, 0x7c, 0x08, 0x47, 0x42, 0xc9,
+	0xf2, 0x8e, 0xd3, 0xb7, 0x3d, 0x36, 0xe8, 0xda, 0xbe, 0xd1, 0xc8, 0x9c, 0x1f, 0x62, 0x3e, 0x45,
+	0x17, 0xe7, 0x87, 0x0c, 0x0a, 0x1c, 0xd8, 0x42, 0xb1, 0xda, 0x25, 0x8d, 0x66, 0xe6, 0xc0, 0x16,
+	0x53, 0xab, 0xca, 0x68, 0x5d, 0x59, 0x24, 0xc4, 0x86, 0x17, 0x43, 0xf9, 0x92, 0xd5, 0x7b, 0xba,
+	0xed, 0xb9, 0xa3, 0x81, 0xbd, 0xec, 0xf6, 0x5d, 0xcf, 0x68, 0x71, 0xfe, 0x33, 0xb9, 0xfc, 0x09,
+	0xfd, 0xb5, 0x19, 0x9a, 0x47, 0x45, 0x96, 0xe1, 0x40, 0x18, 0xf5, 0x88, 0x3d, 0x0f, 0x0c, 0xc8,
+	0x9c, 0xe0, 0x62, 0x6a, 0x54, 0xc2, 0xf1, 0x4d, 0x05, 0xa9, 0x24, 0x68, 0x12, 0xc6, 0x6c, 0x01,
+	0x09, 0x2a, 0xa9, 0x24, 0x18, 0x56, 0x49, 0xee, 0x39, 0x83, 0xa7, 0xc6, 0xc1, 0x02, 0x12, 0x54,
+	0x52, 0x49, 0x30, 0x8c, 0x33, 0x6d, 0x54, 0x52, 0xd7, 0x7d, 0x8a, 0xf6, 0x64, 0xcc, 0x65, 0xce,
+	0xb4, 0x4a, 0x6d, 0x49, 0x45, 0x9c, 0x69, 0x93, 0x60, 0x5c, 0x02, 0x84, 0xb2, 0xc5, 0xbe, 0xb3,
+	0x3d, 0x30, 0x0e, 0x8d, 0xb1, 0x65, 0x64, 0xe3, 0x5a, 0xb8, 0x04, 0xd0, 0x60, 0xe4, 0x96, 0xec,
+	0x96, 0x1b, 0x2c, 0x58, 0x71, 0xf6, 0x8c, 0xc3, 0x99, 0xb3, 0x48, 0xcc, 0xb2, 0xe2, 0xec, 0x45,
+	0xfd, 0x52, 0x40, 0xd4, 0xa2, 0x85, 0x73, 0x94, 0xf1, 0x42, 0x41, 0xd1, 0x42, 0x45, 0xb5, 0x68,
+	0xa1, 0x4c, 0x2d, 0xda, 0x3d, 0x2b, 0x60, 0xcf, 0x8d, 0x2f, 0x14, 0x14, 0x8d, 0x6b, 0xa9, 0x45,
+	0xe3, 0x02, 0x9c, 0xdd, 0x42, 0xc1, 0x13, 0xe6, 0x05, 0x4e, 0xcf, 0xea, 0x8b, 0xaa, 0x3a, 0x99,
+	0x39, 0x07, 0xc5, 0x7c, 0x9a, 0x36, 0xce, 0x6e, 0x99, 0x34, 0x6a, 0xc1, 0x1f, 0x59, 0x9b, 0x7d,
+	0x46, 0xdd, 0x67, 0xc6, 0xeb, 0x05, 0x05, 0x0f, 0x15, 0xd5, 0x82, 0x87, 0x32, 0x75, 0x6c, 0xf9,
+	0xc0, 0xb1, 0xb7, 0x59, 0x60, 0x9c, 0x29, 0x18, 0x5b, 0x84, 0x9a, 0x3a, 0xb6, 0x08, 0x49, 0x34,
+	0x02, 0xac, 0x58, 0x81, 0xb5, 0xe7, 0xb0, 0x67, 0x4f, 0x1c, 0xf6, 0x0c, 0x27, 0xf6, 0x23, 0x63,
+	0x46, 0x80, 0x50, 0xb7, 0x23, 0x95, 0xa3, 0x11, 0x20, 0x41, 0x12, 0x8d, 0x00, 0xaa, 0x5c, 0x0e,
+	0xeb, 0x47, 0xc7, 0x8c, 0x00, 0x1a, 0x7f, 0x34, 0xc6, 0xe7, 0x51, 0x11, 0x0b, 0x8e, 0xa5, 0xa2,
+	0x1e, 0x7a, 0x36, 0xf3, 0x8c, 0x97, 0x79, 0x22, 0xa7, 0x8b, 0x13, 0xe1, 0xea, 0x6b, 0x33, 0x34,
+	0x87, 0x28, 0x95, 0xc4, 0x86, 0x3b, 0xf2, 0x7a, 0x0c, 0xeb, 0xe9, 0xb5, 0x49, 0x92, 0x88, 0xd4,
+	0x53, 0x49, 0x44, 0x31, 0x64, 0x0f, 0x5e, 0x8e, 0x62, 0x30, 0x61, 0x3e, 0x8b, 0xf2, 0xd4, 0xe5,
+	0xd2, 0xfd, 0x14, 0x4f, 0xa9, 0x33, 0x3e, 0xa5, 0x24, 0x6a, 0x6d, 0x86, 0x8e, 0xa7, 0x25, 0xfb,
+	0x70, 0x5c, 0x53, 0x10, 0xf3, 0xbc, 0x9a, 0xf0, 0x69, 0x9e, 0xf0, 0xb9, 0xf1, 0x09, 0xa7, 0x60,
+	0x6b, 0x33, 0xb4, 0x80, 0x98, 0x0c, 0xe1, 0x25, 0xad, 0x32, 0xc2, 0x8e, 0x2d, 0x4d, 0xe4, 0xff,
+	0xf3, 0x74, 0xcf, 0x8e, 0x4f, 0x57, 0xc7, 0xac, 0xcd, 0xd0, 0x71, 0x94, 0x64, 0x1b, 0x8c, 0xcc,
+	0x68, 0x6c, 0xc9, 0x6f, 0x67, 0x2e, 0x7b, 0x72, 0x92, 0x13, 0x6d, 0x99, 0x4b, 0x96, 0x69, 0xf9,
+	0xb2, 0x3a, 0x7f, 0x63, 0x52, 0xcb, 0x8f, 0xea, 0x31, 0x8f, 0x4a, 0x6b, 0x3b, 0x8c, 0x7a, 0x64,
+	0x79, 0xdb, 0x2c, 0x10, 0x15, 0xdd, 0xb5, 0xb1, 0x50, 0xbf, 0x39, 0x49, 0xdb, 0xa5, 0x60, 0x5a,
+	0xdb, 0x65, 0x12, 0x13, 0x1f, 0xe6, 0x35, 0x8d, 0xae, 0xbf, 0xec, 0xf6, 0xfb, 0xac, 0x17, 0xd6,
+	0xe6, 0x6f, 0xf1, 0x84, 0xdf, 0x1a, 0x9f, 0x70, 0x02, 0xb4, 0x36, 0x43, 0xc7, 0x92, 0xa6, 0xca,
+	0xfb, 0xb0, 0x6f, 0x27, 0x6c, 0xc6, 0x98, 0xc8, 0x56, 0x93, 0xb0, 0x54, 0x79, 0x53, 0x1a, 0x29,
+	0x5b, 0x55, 0x34, 0xb0, 0xb8, 0x2f, 0x4e, 0x62, 0xab, 0x3a, 0x26, 0x65, 0xab, 0x7a, 0x34, 0xce,
+	0x6e, 0x23, 0x9f, 0x79, 0x9c, 0xe3, 0x8e, 0xeb, 0x0c, 0x8c, 0x57, 0x32, 0x67, 0xb7, 0xc7, 0x3e,
+	0xf3, 0x64, 0x42, 0xa8, 0x85, 0xb3, 0x9b, 0x06, 0xd3, 0x78, 0xee, 0xb1, 0xad, 0xc0, 0x38, 0x51,
+	0xc4, 0x83, 0x5a, 0x1a, 0x0f, 0x0a, 0x70, 0xa6, 0x88, 0x04, 0x1b, 0x0c, 0x5b, 0x85, 0x5a, 0x83,
+	0x6d, 0x66, 0xbc, 0x9a, 0x39, 0x53, 0x28, 0x74, 0x8a, 0x32, 0xce, 0x14, 0x59, 0x24, 0xb8, 0x71,
+	0x8f, 0xe4, 0xb8, 0x22, 0x13, 0xd4, 0x0b, 0x99, 0x1b, 0x77, 0x85, 0x3a, 0x52, 0xc5, 0x3d, 0x48,
+	0x9a, 0x80, 0x7c, 0x11, 0xaa, 0x43, 0x67, 0xb0, 0x6d, 0xd8, 0x9c, 0xe8, 0x48, 0x82, 0x68, 0xdd,
+	0x19, 0x6c, 0xaf, 0xcd, 0x50, 0xae, 0x42, 0xae, 0x03, 0x0c, 0x3d, 0xb7, 0xc7, 0x7c, 0xff, 0x01,
+	0x7b, 0x66, 0x30, 0x0e, 0x30, 0x93, 0x00, 0xa1, 0xd0, 0x79, 0xc0, 0x70, 0x5e, 0x56, 0xf4, 0xc9,
+	0x2a, 0x1c, 0x94, 0x21, 0xd9, 0xcb, 0xb7, 0x32, 0x17, 0x7f, 0x21, 0x41, 0xec, 0x67, 0xd1, 0x50,
+	0xb8, 0xf7, 0x91, 0x82, 0x15, 0x77, 0xc0, 0x8c, 0xed, 0xcc, 0xbd, 0x4f, 0x48, 0x82, 0x2a, 0xb8,
+	0xc6, 0x52, 0x10, 0xb8, 0xd9, 0x97, 0xc1, 0x55, 0xcf, 0x73, 0x3d, 0x63, 0x27, 0x73, 0x99, 0x16,
+	0x32, 0x70, 0x1d, 0x5c, 0x82, 0xaa, 0x18, 0xe4, 0x08, 0x76, 0x3c, 0x66, 0xd9, 0x1b, 0x81, 0x15,
+	0x8c, 0x7c, 0x63, 0x90, 0xc9, 0x21, 0x22, 0x3b, 0x8f, 0xb8, 0x26, 0x72, 0xa8, 0x18, 0xf2, 0x00,
+	0xda, 0xb8, 0x99, 0xba, 0xe7, 0xec, 0x3a, 0x01, 0x65, 0x56, 0x6f, 0x87, 0xd9, 0x86, 0x9b, 0xb9,
+	0x11, 0xc3, 0xa5, 0x73, 0x47, 0xd5, 0xc3, 0x15, 0x4f, 0x12, 0x4b, 0xd6, 0x60, 0x0e, 0x65, 0x1b,
+	0x43, 0xab, 0xc7, 0x1e, 0xa3, 0x07, 0xcf, 0x18, 0x66, 0x5a, 0x31, 0x67, 0x8b, 0xb5, 0x70, 0xc1,
+	0xa3, 0xe3, 0x42, 0xa6, 0x7b, 0x6e, 0xcf, 0xea, 0x0b, 0xa6, 0x6f, 0xe6, 0x33, 0xc5, 0x5a, 0x21,
+	0x53, 0x2c, 0x21, 0x8f, 0x80, 0xa0, 0x44, 0xec, 0x17, 0xd7, 0x3d, 0x77, 0xdb, 0x63, 0xbe, 0x6f,
+	0x78, 0x9c, 0x6d, 0x21, 0x8b, 0x4d, 0xd7, 0x44, 0x93, 0x4d, 0xe3, 0xd1, 0x0e, 0x37, 0xad, 0xde,
+	0xd3, 0xd1, 0x90, 0x5b, 0x80, 0x9f, 0x69, 0x87, 0x4b, 0x5c, 0x21, 0x34, 0x00, 0x45, 0x9f, 0x6f,
+	0x9e, 0x79, 0x48, 0x34, 0x7f, 0x90, 0xbd, 0x79, 0x16, 0xf0, 0xb0, 0xf5, 0x55, 0x04, 0x16, 0xca,
+	0x1a, 0x05, 0xee, 0x2e, 0x1f, 0x86, 0x56, 0x9f, 0xb3, 0xde, 0x28, 0x60, 0xb6, 0x31, 0xca, 0x2c,
+	0xd4, 0x62, 0xa4, 0xd8, 0x09, 0x35, 0xb1, 0x50, 0x69, 0x3c, 0xe9, 0xc1, 0x8b, 0xbe, 0xb5, 0xc7,
+	0xec, 0x0d, 0x66, 0x79, 0xbd, 0x9d, 0x2f, 0x8f, 0x98, 0xb7, 0xbf, 0xbc, 0x83, 0x3d, 0xd4, 0x36,
+	0xf6, 0x32, 0x17, 0x50, 0x1b, 0xb1, 0x76, 0x47, 0x55, 0xc7, 0xd9, 0x30, 0x87, 0x09, 0x6b, 0x2e,
+	0xf0, 0x2c, 0x7f, 0x67, 0x7d, 0xe4, 0x6d, 0x33, 0xe3, 0x59, 0x66, 0xcd, 0x3d, 0x42, 0x85, 0x0e,
+	0xd7, 0xc0, 0x9a, 0x8b, 0xf5, 0x97, 0x1a, 0x50, 0xdb, 0xb3, 0xfa, 0x23, 0x66, 0xfe, 0xb0, 0x02,
+	0x0d, 0xe9, 0x0f, 0x35, 0x1f, 0x40, 0x95, 0x7b, 0x7b, 0x8f, 0x42, 0xcd, 0x19, 0xd8, 0xec, 0x39,
+	0x77, 0x14, 0xd7, 0xa8, 0x08, 0x90, 0xb7, 0xa1, 0x21, 0xdd, 0xa4, 0x46, 0x79, 0xac, 0x7b, 0x3a,
+	0x54, 0x33, 0x3f, 0x82, 0x46, 0xe8, 0xf5, 0x9d, 0x87, 0xd6, 0xd0, 0x73, 0xd1, 0x00, 0xba, 0x36,
+	0xa7, 0x6d, 0xd1, 0x58, 0x40, 0xde, 0x81, 0x86, 0x2d, 0x14, 0x25, 0xf5, 0x8b, 0x1d, 0xe1, 0x88,
+	0xef, 0x84, 0x8e, 0xf8, 0xce, 0x06, 0x77, 0xc4, 0xd3, 0x50, 0xcf, 0xfc, 0xed, 0x12, 0xd4, 0x85,
+	0xf3, 0xd7, 0xdc, 0x83, 0xba, 0x1c, 0x50, 0x2e, 0x41, 0xbd, 0xc7, 0x65, 0x46, 0xd2, 0xf1, 0xab,
+	0xe5, 0x50, 0x7a, 0x93, 0xa9, 0x54, 0x46, 0x98, 0x2f, 0x3a, 0x7f, 0x79, 0x2c, 0x4c, 0xf4, 0x76,
+	0x2a, 0x95, 0x7f, 0x6d, 0xe9, 0xfe, 0x5b, 0x13, 0xea, 0x62, 0x71, 0x62, 0xfe, 0x67, 0x39, 0xaa,
+	0x62, 0xf3, 0xaf, 0x4b, 0x50, 0x13, 0x3e, 0xd6, 0x39, 0x28, 0x3b, 0x61, 0x2d, 0x97, 0x1d, 0x9b,
+	0xdc, 0x56, 0xab, 0xb7, 0x92, 0x31, 0x73, 0x67, 0xf9, 0x9c, 0x3b, 0x77, 0xd9, 0xfe, 0x13, 0x34,
+	0x91, 0xa8, 0xce, 0xc9, 0x31, 0xa8, 0xfb, 0xa3, 0x4d, 0x74, 0xc6, 0x54, 0x4e, 0x54, 0xce, 0xb4,
+	0xa8, 0x0c, 0x99, 0x77, 0xa0, 0x19, 0x2a, 0x93, 0x36, 0x54, 0x9e, 0xb2, 0x7d, 0x99, 0x38, 0xfe,
+	0x24, 0x67, 0xa5, 0xa9, 0x45, 0x56, 0x93, 0x6c, 0x5a, 0x91, 0x8a, 0xb4, 0xc7, 0xaf, 0x43, 0x05,
+	0x97, 0x03, 0xc9, 0x22, 0x4c, 0x6f, 0x21, 0xb9, 0xb9, 0x5d, 0x86, 0x9a, 0xf0, 0x73, 0x27, 0xd3,
+	0x20, 0x50, 0x7d, 0xca, 0xf6, 0x45, 0x1d, 0xb5, 0x28, 0xff, 0x9d, 0x4b, 0xf2, 0x57, 0x15, 0x38,
+	0xa0, 0x3a, 0x07, 0xcd, 0x55, 0xa8, 0xa0, 0x3b, 0x2f, 0xc9, 0x69, 0x40, 0xc3, 0xda, 0x0a, 0x98,
+	0x17, 0x9d, 0xf8, 0x84, 0x41, 0xec, 0x64, 0x9c, 0x8b, 0xbb, 0xfc, 0x5a, 0x54, 0x04, 0xcc, 0x0e,
+	0xd4, 0xa5, 0xcf, 0x35, 0xc9, 0x14, 0xe9, 0x97, 0x55, 0xfd, 0x3b, 0xd0, 0x8c, 0x5c, 0xa8, 0x9f,
+	0x36, 0x6d, 0x0f, 0x9a, 0x91, 0xaf, 0xf4, 0x28, 0xd4, 0x02, 0x37, 0xb0, 0xfa, 0x9c, 0xae, 0x42,
+	0x45, 0x00, 0x7b, 0xf1, 0x80, 0x3d, 0x0f, 0x96, 0xa3, 0x41, 0xa0, 0x42, 0x63, 0x81, 0xe8, 0xe3,
+	0x6c, 0x4f, 0xc4, 0x56, 0x44, 0x6c, 0x24, 0x88, 0xd3, 0xac, 0xaa, 0x69, 0xee, 0x43, 0x5d, 0x3a,
+	0x50, 0xa3, 0xf8, 0x92, 0x12, 0x4f, 0x16, 0xa1, 0x86, 0xee, 0xaf, 0xa1, 0x51, 0x4e, 0xf8, 0x81,
+	0x45, 0x0f, 0x11, 0xeb, 0xa2, 0x65, 0x77, 0x10, 0xa0, 0x19, 0xeb, 0xfb, 0x42, 0x2a, 0x90, 0xd8,
+	0x84, 0x9e, 0xf0, 0x86, 0x63, 0x9e, 0x9a, 0x54, 0x86, 0xcc, 0x3f, 0x2f, 0x41, 0x2b, 0x3a, 0x3d,
+	0x30, 0x3f, 0xca, 0xeb, 0x3c, 0x8b, 0x70, 0xd0, 0x93, 0x5a, 0xe8, 0xb2, 0x0a, 0xbb, 0xd0, 0x4b,
+	0x89, 0x9c, 0x50, 0x45, 0x87, 0xea, 0x08, 0xf3, 0x7a, 0x6e, 0xa3, 0x2e, 0xc0, 0x81, 0x50, 0xf5,
+	0x6e, 0x6c, 0x7a, 0x9a, 0xcc, 0x34, 0x23, 0x74, 0x1b, 0x2a, 0x8e, 0x2d, 0xce, 0x1b, 0x5b, 0x14,
+	0x7f, 0x9a, 0x5b, 0x70, 0x40, 0x75, 0x42, 0x9a, 0x4f, 0xb2, 0x7b, 0xcf, 0x4d, 0x4c, 0x26, 0x56,
+	0x93, 0x95, 0x99, 0x2e, 0x42, 0xac, 0x42, 0x35, 0x80, 0xf9, 0xa7, 0x9b, 0x50, 0xe3, 0x75, 0x6d,
+	0x5e, 0x10, 0x76, 0x7e, 0x16, 0xea, 0x7c, 0x35, 0x1f, 0x9e, 0x7e, 0x1e, 0xcd, 0x6a, 0x18, 0x2a,
+	0x75, 0xcc, 0x65, 0x98, 0x55, 0x7c, 0xcf, 0x68, 0x98, 0x3c, 0x22, 0x6a, 0xec, 0x30, 0x48, 0x4c,
+	0x68, 0xe2, 0x94, 0xb0, 0x6e, 0x05, 0x3b, 0xb2, 0x2e, 0xa2, 0xb0, 0x79, 0x12, 0xea, 0x72, 0x77,
+	0x62, 0x4a, 0x5f, 0x7b, 0x37, 0xaa, 0x8c, 0x28, 0x6c, 0x7e, 0x0d, 0x5a, 0x91, 0x8b, 0x9a, 0x3c,
+	0x84, 0x03, 0xd2, 0x45, 0x2d, 0x56, 0xd8, 0xa8, 0x3c, 0x57, 0x60, 0x44, 0xb8, 0x9c, 0xe6, 0x5e,
+	0xee, 0xce, 0xa3, 0xfd, 0x21, 0xa3, 0x1a, 0x81, 0xf9, 0x9d, 0xd3, 0xbc, 0x82, 0xcd, 0x21, 0x34,
+	0x23, 0xbf, 0x5c, 0xb2, 0xb2, 0xaf, 0x88, 0x11, 0xb0, 0x5c, 0xe8, 0x54, 0x16, 0x78, 0x1c, 0x67,
+	0xf9, 0x40, 0x69, 0xbe, 0x04, 0x95, 0xbb, 0x6c, 0x1f, 0x3b, 0x82, 0x18, 0x2f, 0x65, 0x47, 0xe0,
+	0x01, 0xb3, 0x0b, 0x75, 0xe9, 0x1f, 0x4f, 0xa6, 0x77, 0x0e, 0xea, 0x5b, 0x3c, 0xa6, 0x68, 0x64,
+	0x94, 0x6a, 0xe6, 0x4d, 0x98, 0x55, 0xbd, 0xe2, 0x49, 0xbe, 0x13, 0x30, 0xdb, 0x8b, 0xa3, 0x65,
+	0x33, 0xa8, 0x22, 0x93, 0xe9, 0x56, 0x97, 0x62, 0x58, 0xcd, 0x34, 0xb7, 0x57, 0x33, 0xab, 0x7d,
+	0x8c, 0xd1, 0xdd, 0x85, 0x43, 0x49, 0xf7, 0x77, 0x32, 0xa5, 0x33, 0x70, 0x68, 0x53, 0x57, 0x91,
+	0x43, 0x5d, 0x52, 0x6c, 0x76, 0xa1, 0x26, 0xdc, 0x93, 0x49, 0x8a, 0xb7, 0xa1, 0x66, 0x61, 0x04,
+	0x07, 0xce, 0x9d, 0x37, 0x33, 0x73, 0xc9, 0xa1, 0x54, 0x28, 0x9a, 0x0e, 0x1c, 0xd4, 0x3d, 0x9e,
+	0x49, 0xca, 0x35, 0x38, 0xb8, 0xa7, 0x2a, 0x48, 0xea, 0x85, 0x4c, 0x6a, 0x8d, 0x8a, 0xea, 0x40,
+	0xf3, 0x77, 0xea, 0x50, 0xe5, 0x2e, 0xfb, 0x64, 0x12, 0x97, 0xa1, 0x8a, 0xf7, 0x06, 0x64, 0xd5,
+	0x2e, 0x8c, 0xf5, 0xff, 0xf3, 0x3f, 0x94, 0xeb, 0x93, 0x2f, 0x41, 0xcd, 0x0f, 0xf6, 0xfb, 0xe1,
+	0x41, 0xd3, 0x6b, 0xe3, 0x81, 0x1b, 0xa8, 0x4a, 0x05, 0x02, 0xa1, 0xbc, 0x2f, 0x18, 0xd5, 0x49,
+	0xa0, 0xbc, 0x13, 0x52, 0x81, 0x20, 0x37, 0xa1, 0xd1, 0xdb, 0x61, 0xbd, 0xa7, 0xcc, 0x36, 0x6a,
+	0x05, 0xdd, 0x82, 0x83, 0x97, 0x85, 0x32, 0x0d, 0x51, 0x98, 0x76, 0x8f, 0xb7, 0x6e, 0x7d, 0x92,
+	0xb4, 0x79, 0x8b, 0x53, 0x81, 0x20, 0xab, 0xd0, 0x72, 0x7a, 0xee, 0x60, 0x75, 0xd7, 0xfd, 0x86,
+	0x63, 0x34, 0x32, 0x97, 0xdf, 0x09, 0x78, 0x37, 0x54, 0xa7, 0x31, 0x32, 0xa4, 0xe9, 0xee, 0xe2,
+	0x1e, 0xaa, 0x39, 0x29, 0x0d, 0x57, 0xa7, 0x31, 0xd2, 0x9c, 0x97, 0xed, 0x99, 0xdd, 0xc9, 0x6f,
+	0x43, 0x8d, 0x57, 0x39, 0x79, 0x4f, 0x8d, 0x9e, 0x3b, 0x7f, 0x3a, 0xd3, 0x72, 0xb4, 0x11, 0x4b,
+	0x36, 0x55, 0xc4, 0xc3, 0xeb, 0x5f, 0xe7, 0x99, 0x9d, 0x84, 0x47, 0xb6, 0x9b, 0xe0, 0x79, 0x05,
+	0x1a, 0xb2, 0x29, 0xf4, 0x0c, 0x37, 0x43, 0x85, 0x97, 0xa1, 0x26, 0x3a, 0x66, 0x76, 0x79, 0x5e,
+	0x85, 0x56, 0x54, 0x99, 0xe3, 0x55, 0x78, 0xed, 0xe4, 0xa8, 0x7c, 0xa7, 0x0c, 0x35, 0x71, 0x74,
+	0x91, 0x1e, 0x6a, 0xd5, 0x5e, 0xf0, 0xda, 0xf8, 0x93, 0x10, 0xb5, 0x1b, 0xdc, 0x86, 0x96, 0x74,
+	0x02, 0x44, 0x97, 0x6d, 0xce, 0x14, 0xa0, 0xd7, 0x43, 0x7d, 0x1a, 0x43, 0x0b, 0x9a, 0xf3, 0x21,
+	0xb4, 0x22, 0x14, 0x59, 0xd2, 0x9b, 0xf4, 0xec, 0xd8, 0xa6, 0x48, 0x26, 0x29, 0x09, 0xbf, 0x57,
+	0x82, 0x0a, 0x9e, 0x2d, 0x25, 0xeb, 0xe1, 0x6a, 0xd8, 0xab, 0x8b, 0x86, 0x83, 0x15, 0x67, 0x4f,
+	0xeb, 0xd4, 0xe6, 0x6a, 0x68, 0x71, 0xd7, 0xf5, 0xec, 0x9d, 0x1a, 0xbf, 0xd0, 0x8a, 0x69, 0x44,
+	0xc6, 0xfe, 0xb8, 0x0e, 0x55, 0x7e, 0x2a, 0x98, 0x35, 0x4e, 0xed, 0x0f, 0x8b, 0x33, 0x86, 0x60,
+	0x31, 0xe1, 0x72, 0x7d, 0x31, 0x4e, 0x59, 0x41, 0xf1, 0x38, 0xc5, 0x81, 0xb8, 0x41, 0xe2, 0x45,
+	0xc2, 0xcd, 0xd8, 0x65, 0xa8, 0xee, 0x3a, 0xbb, 0xcc, 0xa8, 0x4e, 0x92, 0xe4, 0x7d, 0x67, 0x97,
+	0x51, 0xae, 0x8f, 0xb8, 0x1d, 0xcb, 0xdf, 0x31, 0x6a, 0x93, 0xe0, 0xd6, 0x2c, 0x7f, 0x87, 0x72,
+	0x7d, 0xc4, 0x0d, 0xac, 0x5d, 0x66, 0xd4, 0x27, 0xc1, 0x3d, 0xb0, 0x30, 0x3d, 0xd4, 0x47, 0x9c,
+	0xef, 0x7c, 0x8b, 0x19, 0x8d, 0x49, 0x70, 0x1b, 0xce, 0xb7, 0x18, 0xe5, 0xfa, 0xf1, 0x10, 0xde,
+	0x9c, 0xac, 0x6a, 0x94, 0xd6, 0x9e, 0x87, 0x2a, 0x66, 0x20, 0xc7, 0x5c, 0x5f, 0x86, 0xda, 0x07,
+	0x8e, 0x1d, 0xec, 0xe8, 0xd1, 0x35, 0x6d, 0x70, 0xc2, 0x0a, 0x9e, 0x6a, 0x70, 0x52, 0xdb, 0x47,
+	0xf0, 0xac, 0x40, 0x15, 0x1b, 0x7a, 0x3a, 0x8b, 0x8b, 0xed, 0xe3, 0x53, 0x0d, 0x95, 0x6a, 0x95,
+	0x08, 0x9e, 0x79, 0xa8, 0x62, 0x5b, 0xe6, 0x54, 0xc9, 0x3c, 0x54, 0xd1, 0x42, 0xf2, 0x63, 0xb1,
+	0x5d, 0xf4, 0xd8, 0x4a, 0x18, 0xfb, 0xe3, 0x06, 0x54, 0xf9, 0x21, 0x77, 0xb2, 0x4f, 0x7c, 0x19,
+	0x0e, 0x06, 0xfc, 0x84, 0x61, 0x49, 0x2e, 0x82, 0xcb, 0x99, 0x77, 0x5c, 0xf4, 0xa3, 0x73, 0x79,
+	0x6c, 0x21, 0x21, 0x54, 0x67, 0x98, 0x7c, 0x5a, 0xe7, 0x54, 0xda, 0xb4, 0x7e, 0x3d, 0x5a, 0x3e,
+	0x56, 0x0b, 0x6e, 0x58, 0x70, 0xac, 0x58, 0x84, 0x86, 0x6b, 0x49, 0xb2, 0x04, 0x4d, 0x9c, 0xdc,
+	0xb0, 0x1a, 0x64, 0xc7, 0x39, 0x35, 0x1e, 0xdf, 0x95, 0xda, 0x34, 0xc2, 0xe1, 0xd4, 0xda, 0xb3,
+	0x3c, 0x9b, 0xe7, 0x4a, 0xf6, 0xa2, 0xd3, 0xe3, 0x49, 0x96, 0x43, 0x75, 0x1a, 0x23, 0xc9, 0x5d,
+	0x98, 0xb5, 0x59, 0xb4, 0x21, 0x37, 0x1a, 0x63, 0x0e, 0xb8, 0x22, 0xa2, 0x95, 0x18, 0x40, 0x55,
+	0x34, 0xe6, 0x29, 0xdc, 0x84, 0xf9, 0x85, 0xd3, 0x3d, 0xa7, 0x8a, 0x2f, 0xa2, 0xc5, 0x48, 0xf3,
+	0x75, 0x38, 0xa8, 0xb5, 0xdb, 0x67, 0x3a, 0xef, 0xab, 0x6d, 0x29, 0x78, 0xae, 0x44, 0x9b, 0x84,
+	0xb7, 0xf4, 0x89, 0x3f, 0x77, 0x4f, 0x20, 0x81, 0xf7, 0xa0, 0x19, 0x36, 0x0c, 0xb9, 0xa5, 0xe7,
+	0xe1, 0x8d, 0xe2, 0x3c, 0x44, 0x6d, 0x2a, 0xd9, 0x1e, 0x40, 0x2b, 0x6a, 0x21, 0xdc, 0xc1, 0xab,
+	0x74, 0x6f, 0x16, 0xd3, 0xc5, 0xad, 0x2b, 0xf9, 0x28, 0xcc, 0x2a, 0x0d, 0x45, 0x96, 0x75, 0xc6,
+	0xb7, 0x8a, 0x19, 0xd5, 0x66, 0x8e, 0xd7, 0x1d, 0x51, 0x8b, 0xa9, 0xad, 0x52, 0x89, 0x5b, 0xe5,
+	0x87, 0x0d, 0x68, 0x46, 0x17, 0x4b, 0x32, 0x76, 0x79, 0x23, 0xaf, 0x5f, 0xb8, 0xcb, 0x0b, 0xf1,
+	0x9d, 0xc7, 0x5e, 0x9f, 0x22, 0x02, 0x9b, 0x38, 0x70, 0x82, 0xa8, 0xab, 0x9e, 0x2e, 0x86, 0x3e,
+	0x42, 0x75, 0x2a, 0x50, 0xe4, 0xa1, 0x6e, 0xe5, 0xd5, 0x31, 0x07, 0x8f, 0x1a, 0x49, 0xae, 0xa5,
+	0x77, 0xa1, 0xe5, 0xe0, 0xe2, 0x6b, 0x2d, 0x9e, 0xfb, 0xde, 0x2c, 0xa6, 0xeb, 0x86, 0x10, 0x1a,
+	0xa3, 0x31, 0x6f, 0x5b, 0xd6, 0x1e, 0xf6, 0x6b, 0x4e, 0x56, 0x9f, 0x34, 0x6f, 0xb7, 0x63, 0x10,
+	0x55, 0x19, 0xc8, 0x35, 0xb9, 0x7a, 0x68, 0x14, 0x8c, 0x2c, 0x71, 0x55, 0xc5, 0x2b, 0x88, 0x0f,
+	0x61, 0x2e, 0xd0, 0xce, 0x71, 0x65, 0x37, 0x7e, 0x7b, 0x02, 0x16, 0x0d, 0x47, 0x13, 0x3c, 0xd8,
+	0x82, 0x62, 0x6d, 0xd2, 0x9a, 0xb4, 0x05, 0xd5, 0xf5, 0x09, 0x6e, 0xf3, 0x1f, 0x7b, 0xfd, 0xfc,
+	0x39, 0x98, 0x37, 0x77, 0x4e, 0xf4, 0x6b, 0x7a, 0x4f, 0xc8, 0x5f, 0x52, 0x47, 0x6d, 0x92, 0xcb,
+	0xa3, 0x54, 0x7a, 0x8e, 0xd2, 0x7b, 0x72, 0xa2, 0xbe, 0xa4, 0xf7, 0xb7, 0x57, 0x12, 0xfd, 0x0d,
+	0x7b, 0xd8, 0xba, 0xc7, 0xc4, 0xd9, 0xba, 0x32, 0x43, 0x9f, 0x82, 0x39, 0xbd, 0x22, 0x73, 0x92,
+	0xb9, 0x13, 0xae, 0x2b, 0xa6, 0x1a, 0x29, 0x92, 0x75, 0x2b, 0xb8, 0x7e, 0xaf, 0x04, 0xcd, 0xe8,
+	0xde, 0x50, 0xda, 0x0d, 0xde, 0x74, 0xfc, 0x35, 0x66, 0xe1, 0x5d, 0x19, 0xd1, 0x6f, 0xdf, 0x28,
+	0xbc, 0x90, 0xd4, 0xe9, 0x4a, 0x04, 0x8d, 0xb0, 0xe6, 0x09, 0x68, 0x86, 0xd2, 0x9c, 0x6d, 0xd1,
+	0xcf, 0xcb, 0x50, 0x97, 0x37, 0x8e, 0x92, 0x99, 0xb8, 0x01, 0xf5, 0xbe, 0xb5, 0xef, 0x8e, 0xc2,
+	0x4d, 0xcb, 0xa9, 0x82, 0x4b, 0x4c, 0x9d, 0x7b, 0x5c, 0x9b, 0x4a, 0x14, 0x79, 0x17, 0x6a, 0x7d,
+	0x3c, 0x2a, 0x34, 0x2a, 0x05, 0x23, 0x4f, 0x08, 0x47, 0x65, 0x2a, 0x30, 0x98, 0x38, 0xbf, 0x68,
+	0x10, 0x5e, 0x13, 0x2d, 0x4c, 0xfc, 0x09, 0xd7, 0xa6, 0x12, 0x65, 0xde, 0x81, 0xba, 0xc8, 0xce,
+	0x74, 0x93, 0x84, 0x5e, 0x92, 0xd8, 0xd2, 0x79, 0xde, 0x72, 0x56, 0x9b, 0xc7, 0xa1, 0x2e, 0x12,
+	0xcf, 0xb1, 0x9a, 0x9f, 0x7d, 0x81, 0xef, 0x38, 0xfa, 0xe6, 0xbd, 0xf8, 0x90, 0xe9, 0xd3, 0x1f,
+	0x1a, 0x98, 0x8f, 0xe0, 0x10, 0x7a, 0x91, 0x37, 0x2d, 0x9f, 0x51, 0xd6, 0x73, 0x3d, 0x3b, 0x93,
+	0xd5, 0x13, 0x51, 0xd2, 0x15, 0x9c, 0xcf, 0x2a, 0xf5, 0x3e, 0x77, 0xde, 0xfd, 0xcf, 0x71, 0xde,
+	0xfd, 0xa8, 0x9a, 0xe3, 0x51, 0x9b, 0xc4, 0x97, 0x80, 0x06, 0x97, 0x72, 0xa9, 0x5d, 0xd3, 0xd7,
+	0xde, 0x27, 0x0b, 0x90, 0xda, 0xe2, 0xfb, 0x9a, 0xee, 0x53, 0x2b, 0xc2, 0x6a, 0x4e, 0xb5, 0x5b,
+	0x49, 0xa7, 0xda, 0xa9, 0x02, 0x74, 0xca, 0xab, 0x76, 0x4d, 0xf7, 0xaa, 0x15, 0xa5, 0xae, 0xba,
+	0xd5, 0xfe, 0x8f, 0x39, 0xb2, 0xfe, 0x24, 0xc7, 0xf1, 0xf2, 0x25, 0xdd, 0xf1, 0x32, 0xc6, 0x6a,
+	0x7e, 0x55, 0x9e, 0x97, 0xef, 0xe7, 0x79, 0x5e, 0xae, 0x68, 0x9e, 0x97, 0x31, 0x39, 0x4b, 0xba,
+	0x5e, 0xae, 0xe9, 0xae, 0x97, 0x93, 0x05, 0x48, 0xcd, 0xf7, 0x72, 0x45, 0xf3, 0xbd, 0x14, 0x25,
+	0xaa, 0x38, 0x5f, 0xae, 0x68, 0xce, 0x97, 0x22, 0xa0, 0xe2, 0x7d, 0xb9, 0xa2, 0x79, 0x5f, 0x8a,
+	0x80, 0x8a, 0xfb, 0xe5, 0x8a, 0xe6, 0x7e, 0x29, 0x02, 0x2a, 0xfe, 0x97, 0x6b, 0xba, 0xff, 0xa5,
+	0xb8, 0x7e, 0x3e, 0x77, 0xc0, 0xfc, 0x7a, 0x1c, 0x30, 0xdf, 0xad, 0xe4, 0x38, 0x60, 0x68, 0xb6,
+	0x03, 0xe6, 0x6c, 0x7e, 0x4b, 0x16, 0x7b, 0x60, 0x26, 0x9f, 0x05, 0xd2, 0x2e, 0x98, 0xf7, 0x12,
+	0x2e, 0x98, 0xd7, 0x0b, 0xc0, 0xba, 0x0f, 0xe6, 0x7f, 0x8d, 0x93, 0xe1, 0x2f, 0xeb, 0x63, 0xf6,
+	0xd3, 0x57, 0xd5, 0xfd, 0xf4, 0x98, 0x99, 0x2c, 0xbd, 0xa1, 0xbe, 0xa1, 0x6f, 0xa8, 0xcf, 0x4c,
+	0x80, 0xd5, 0x76, 0xd4, 0xeb, 0x59, 0x3b, 0xea, 0xce, 0x04, 0x2c, 0xb9, 0x5b, 0xea, 0x3b, 0xe9,
+	0x2d, 0xf5, 0xd9, 0x09, 0xf8, 0x32, 0xf7, 0xd4, 0xeb, 0x59, 0x7b, 0xea, 0x49, 0x72, 0x97, 0xbb,
+	0xa9, 0x7e, 0x57, 0xdb, 0x54, 0x9f, 0x9e, 0xa4, 0xba, 0xe2, 0xc9, 0xe1, 0x2b, 0x39, 0xbb, 0xea,
+	0x77, 0x26, 0xa1, 0x19, 0xbb, 0xad, 0xfe, 0x7c, 0x5f, 0x9c, 0x48, 0xe6, 0x2f, 0x5e, 0x81, 0x66,
+	0x78, 0xa3, 0xc5, 0xfc, 0x26, 0x34, 0xc2, 0x67, 0x26, 0xc9, 0x9e, 0x73, 0x2c, 0xda, 0xd4, 0x89,
+	0xd5, 0xb3, 0x0c, 0x91, 0x1b, 0x50, 0xc5, 0x5f, 0xb2, 0x5b, 0xbc, 0x31, 0xd9, 0xcd, 0x19, 0x4c,
+	0x84, 0x72, 0x9c, 0xf9, 0x8b, 0xa3, 0x00, 0xca, 0xed, 0xfb, 0x49, 0x93, 0x7d, 0x1f, 0x07, 0xb3,
+	0x7e, 0xc0, 0x3c, 0x7e, 0x63, 0xaa, 0xf0, 0x76, 0x7a, 0x9c, 0x02, 0x5a, 0x4b, 0xc0, 0x3c, 0x2a,
+	0xe1, 0xe4, 0x3e, 0x34, 0x43, 0x47, 0xaa, 0x51, 0x3d, 0x51, 0xc9, 0x35, 0xb2, 0x2c, 0xaa, 0xd0,
+	0xb5, 0x47, 0x23, 0x0a, 0xb2, 0x08, 0x55, 0xdf, 0xf5, 0x02, 0xa3, 0x76, 0xa2, 0x92, 0xeb, 0x95,
+	0xca, 0xa2, 0xda, 0x70, 0xbd, 0x80, 0x72, 0xa8, 0x28, 0x9a, 0xf2, 0xb8, 0x71, 0x9a, 0xa2, 0x69,
+	0x23, 0xf6, 0x7f, 0x54, 0xa2, 0x31, 0x74, 0x59, 0xf6, 0x46, 0x61, 0x43, 0xe7, 0x26, 0x6f, 0x25,
+	0xb5, 0x57, 0x12, 0xb9, 0x08, 0x12, 0x2d, 0xc1, 0x7f, 0x93, 0x37, 0xa0, 0xdd, 0x73, 0xf7, 0x98,
+	0x47, 0xe3, 0xbb, 0x44, 0xf2, 0xba, 0x57, 0x4a, 0x8e, 0x17, 0x6a, 0x76, 0x1c, 0x9b, 0x75, 0x7b,
+	0x72, 0xfc, 0x6b, 0xd2, 0x28, 0x4c, 0xee, 0x42, 0x93, 0xfb, 0xd8, 0x43, 0x0f, 0xff, 0x74, 0x99,
+	0x14, 0xae, 0xfe, 0x90, 0x00, 0x13, 0xe2, 0x89, 0xdf, 0x76, 0x02, 0x5e, 0x87, 0x4d, 0x1a, 0x85,
+	0x31, 0xc3, 0xfc, 0xc2, 0x96, 0x9a, 0xe1, 0x86, 0xc8, 0x70, 0x52, 0x4e, 0x2e, 0xc2, 0x0b, 0x5c,
+	0x96, 0xd8, 0x62, 0x0a, 0x57, 0x7d, 0x93, 0x66, 0x47, 0xf2, 0x0b, 0x6a, 0xd6, 0xb6, 0xb8, 0x6a,
+	0xcd, 0x9d, 0x77, 0x35, 0x1a, 0x0b, 0xc8, 0x59, 0x38, 0x6c, 0xb3, 0x2d, 0x6b, 0xd4, 0x0f, 0x1e,
+	0xb1, 0xdd, 0x61, 0xdf, 0x0a, 0xf0, 0xaa, 0x2a, 0xf0, 0x0c, 0xa4, 0x23, 0xc8, 0xdb, 0x70, 0x44,
+	0x0a, 0x45, 0x37, 0xc6, 0xd6, 0xe8, 0xda, 0xfc, 0xb9, 0x61, 0x8b, 0x66, 0x45, 0x99, 0x3f, 0xab,
+	0x62, 0xa3, 0x73, 0xd3, 0x7e, 0x1f, 0x2a, 0x96, 0x6d, 0xcb, 0x69, 0xf3, 0xc2, 0x94, 0x1d, 0x44,
+	0x3e, 0x21, 0x46, 0x06, 0xb2, 0x1e, 0xdd, 0x6d, 0x13, 0x13, 0xe7, 0xe5, 0x69, 0xb9, 0xa2, 0x67,
+	0xdf, 0x92, 0x07, 0x19, 0x47, 0x5c, 0xc3, 0xa8, 0xfc, 0x72, 0x8c, 0xd1, 0x65, 0x7f, 0xc9, 0x43,
+	0xee, 0x40, 0x95, 0xe7, 0x50, 0x4c, 0xac, 0x17, 0xa7, 0xe5, 0xbb, 0x2f, 0xf2, 0xc7, 0x39, 0xcc,
+	0x9e, 0xb8, 0x7d, 0xa6, 0xdc, 0x6c, 0x2c, 0xe9, 0x37, 0x1b, 0x97, 0xa0, 0xe6, 0x04, 0x6c, 0x37,
+	0x7d, 0xd1, 0x75, 0xac, 0xa9, 0xca, 0x91, 0x47, 0x40, 0xc7, 0x5e, 0xb8, 0xfb, 0x08, 0xea, 0x39,
+	0xe3, 0xe1, 0x2d, 0xa8, 0x22, 0x3c, 0xb5, 0x96, 0x9c, 0x24, 0x61, 0x8e, 0x34, 0xcf, 0x43, 0x15,
+	0x0b, 0x3b, 0xa6, 0x74, 0x32, 0x3f, 0xe5, 0x28, 0x3f, 0x4b, 0xb3, 0xd0, 0x72, 0x87, 0xcc, 0xe3,
+	0x1d, 0xc3, 0xfc, 0x97, 0xaa, 0x72, 0x2d, 0xad, 0xab, 0xda, 0xd8, 0xa5, 0xa9, 0x47, 0x4e, 0xd5,
+	0xca, 0x68, 0xc2, 0xca, 0xae, 0x4e, 0xcf, 0x96, 0xb2, 0x33, 0x9a, 0xb0, 0xb3, 0x5f, 0x82, 0x33,
+	0x65, 0x69, 0xf7, 0x34, 0x4b, 0xbb, 0x3c, 0x3d, 0xa3, 0x66, 0x6b, 0xac, 0xc8, 0xd6, 0x56, 0x74,
+	0x5b, 0xeb, 0x4c, 0xd6, 0xe4, 0xd1, 0xd4, 0x34, 0x81, 0xb5, 0x7d, 0x2d, 0xd7, 0xda, 0x96, 0x34,
+	0x6b, 0x9b, 0x36, 0xe9, 0xcf, 0xc8, 0xde, 0xfe, 0xa1, 0x0a, 0x55, 0x9c, 0x1e, 0xc9, 0xaa, 0x6a,
+	0x6b, 0xef, 0x4c, 0x35, 0xb5, 0xaa, 0x76, 0xf6, 0x20, 0x61, 0x67, 0x17, 0xa7, 0x63, 0x4a, 0xd9,
+	0xd8, 0x83, 0x84, 0x8d, 0x4d, 0xc9, 0x97, 0xb2, 0xaf, 0x35, 0xcd, 0xbe, 0xce, 0x4f, 0xc7, 0xa6,
+	0xd9, 0x96, 0x55, 0x64, 0x5b, 0xb7, 0x74, 0xdb, 0x9a, 0x70, 0xf5, 0x86, 0x09, 0x4d, 0x62, 0x57,
+	0x1f, 0xe6, 0xda, 0xd5, 0x0d, 0xcd, 0xae, 0xa6, 0x49, 0xf6, 0x33, 0xb2, 0xa9, 0x8b, 0x62, 0xd1,
+	0x29, 0x6f, 0xfa, 0x4e, 0xb8, 0xe8, 0x34, 0x2f, 0x41, 0x2b, 0x7e, 0xbe, 0x9c, 0x71, 0x0f, 0x5e,
+	0xa8, 0x85, 0xa9, 0x86, 0x41, 0xf3, 0x02, 0xb4, 0xe2, 0x27, 0xc9, 0x19, 0x69, 0xf9, 0x3c, 0x52,
+	0xa2, 0x64, 0xc8, 0x5c, 0x85, 0xc3, 0xe9, 0x07, 0x93, 0x19, 0x7e, 0x78, 0xe5, 0x12, 0xb7, 0xcc,
+	0xad, 0x2a, 0x32, 0x9f, 0xc1, 0x5c, 0xe2, 0x09, 0xe4, 0xd4, 0x1c, 0xe4, 0x82, 0xb2, 0x44, 0xae,
+	0xc8, 0x3d, 0x78, 0xf6, 0xb5, 0xf4, 0x78, 0x21, 0x6c, 0xae, 0xc0, 0x5c, 0x41, 0xe6, 0x27, 0xb9,
+	0x95, 0xfe, 0x75, 0x98, 0x1d, 0x97, 0xf7, 0xcf, 0xe0, 0xd6, 0x7c, 0x00, 0xed, 0xd4, 0xf3, 0xed,
+	0x64, 0x32, 0xeb, 0x00, 0xdb, 0x91, 0x8e, 0x51, 0x4e, 0x1c, 0xf0, 0x16, 0xbf, 0x11, 0xe0, 0x38,
+	0xaa, 0x70, 0x98, 0x3f, 0x28, 0xc1, 0xe1, 0xf4, 0xdb, 0xed, 0x49, 0x37, 0x3f, 0x06, 0x34, 0x38,
+	0x57, 0xf4, 0xb4, 0x22, 0x0c, 0x92, 0xfb, 0x70, 0xc0, 0xef, 0x3b, 0x3d, 0x26, 0x9e, 0x6f, 0xf9,
+	0x72, 0x47, 0x53, 0xf0, 0xfe, 0x7a, 0x23, 0x46, 0x50, 0x0d, 0x6e, 0x3e, 0x83, 0x59, 0x25, 0x92,
+	0x5c, 0x87, 0xb2, 0x3b, 0x4c, 0xdd, 0x2c, 0xcc, 0xe7, 0x7c, 0x18, 0xf6, 0x37, 0x5a, 0x76, 0x87,
+	0xe9, 0x2e, 0xa9, 0x76, 0xdf, 0x8a, 0xd6, 0x7d, 0xcd, 0xbb, 0x70, 0x38, 0xfd, 0x3c, 0x3a, 0x59,
+	0x3d, 0xa7, 0x52, 0x5e, 0x02, 0x51, 0x4d, 0x09, 0xa9, 0x79, 0x05, 0x0e, 0x25, 0x1f, 0x3d, 0x67,
+	0x3c, 0x7b, 0x89, 0x5f, 0x0f, 0x85, 0xee, 0xfa, 0x85, 0x3f, 0x2c, 0xc1, 0x9c, 0x5e, 0x10, 0x72,
+	0x0c, 0x88, 0x2e, 0x79, 0xe0, 0x0e, 0x58, 0x7b, 0x86, 0xbc, 0x00, 0x87, 0x75, 0xf9, 0xa2, 0x6d,
+	0xb7, 0x4b, 0x69, 0x75, 0x1c, 0xb6, 0xda, 0x65, 0x62, 0xc0, 0xd1, 0x44, 0x0d, 0xf1, 0x41, 0xb4,
+	0x5d, 0x21, 0x5f, 0x80, 0x17, 0x92, 0x31, 0xc3, 0xbe, 0xd5, 0x63, 0xed, 0xaa, 0xf9, 0xef, 0x65,
+	0xa8, 0xe2, 0x3b, 0x5d, 0xf3, 0x9f, 0xca, 0xe1, 0x3b, 0x89, 0xab, 0x50, 0xe5, 0xef, 0x91, 0x95,
+	0x57, 0x73, 0xa5, 0xc4, 0xab, 0x39, 0xed, 0x63, 0x64, 0xf1, 0xab, 0xb9, 0xab, 0x50, 0xe5, 0x2f,
+	0x90, 0xa7, 0x47, 0xfe, 0x6e, 0x09, 0x5a, 0xf1, 0x6b, 0xe0, 0xa9, 0xf1, 0xea, 0xbb, 0x8c, 0xb2,
+	0xfe, 0x2e, 0xe3, 0x0d, 0xa8, 0x79, 0x48, 0x2a, 0x47, 0x99, 0xe4, 0x6b, 0x0f, 0x9e, 0x20, 0x15,
+	0x2a, 0x26, 0x83, 0x59, 0xf5, 0xad, 0xf3, 0xf4, 0xd9, 0x38, 0x29, 0x3f, 0x74, 0xd2, 0xb5, 0xfd,
+	0x45, 0xcf, 0xb3, 0xf6, 0xa5, 0x61, 0xea, 0x42, 0xf4, 0xfd, 0xe2, 0x8b, 0xe6, 0xec, 0xc7, 0x8a,
+	0xe6, 0xef, 0x57, 0xa0, 0x21, 0xaf, 0xcf, 0x9a, 0x57, 0xa0, 0x82, 0x8f, 0x96, 0xdf, 0x86, 0x86,
+	0xbc, 0xb8, 0x9b, 0xca, 0xc8, 0x7d, 0x5e, 0x0a, 0xa9, 0x4f, 0x43, 0x35, 0xf3, 0x5a, 0x34, 0x4d,
+	0x4e, 0x8f, 0xbd, 0x0a, 0x55, 0xfe, 0x44, 0x75, 0x7a, 0xe4, 0x4f, 0xf0, 0x23, 0x83, 0xfc, 0x75,
+	0xea, 0x7c, 0x74, 0x4b, 0x59, 0x7b, 0x34, 0x29, 0x04, 0xe1, 0x5b, 0x99, 0x07, 0xf1, 0xc6, 0x3f,
+	0x0a, 0xe3, 0xd4, 0xa1, 0xfa, 0x34, 0x45, 0x1f, 0x56, 0x45, 0x64, 0x11, 0x9a, 0x3e, 0xdb, 0x63,
+	0x9e, 0x13, 0xec, 0xf3, 0xf5, 0xcc, 0x5c, 0xca, 0xeb, 0xac, 0x3d, 0x9b, 0xee, 0x6c, 0x48, 0x65,
+	0x1a, 0xc1, 0x16, 0x16, 0xa0, 0x19, 0x4a, 0x49, 0x4b, 0xe6, 0xb9, 0x3d, 0x43, 0x66, 0xa1, 0xf1,
+	0x81, 0xe5, 0x0d, 0x9c, 0xc1, 0x76, 0xbb, 0x64, 0xfe, 0x59, 0x13, 0xea, 0xe2, 0xf9, 0xa2, 0xf9,
+	0xbd, 0x26, 0xd4, 0xc5, 0xfb, 0x69, 0x72, 0x03, 0x1a, 0xfe, 0x68, 0x77, 0xd7, 0xf2, 0xf6, 0x8d,
+	0xec, 0xcf, 0xfe, 0x69, 0xcf, 0xad, 0x3b, 0x1b, 0x42, 0x97, 0x86, 0x20, 0x72, 0x09, 0xaa, 0x3d,
+	0x6b, 0x8b, 0xa5, 0xce, 0xa6, 0xb3, 0xc0, 0xcb, 0xd6, 0x16, 0xa3, 0x5c, 0x9d, 0xdc, 0x82, 0xa6,
+	0xb4, 0x31, 0x5f, 0x3a, 0xa7, 0xc6, 0xa7, 0x1b, 0x5a, 0x66, 0x84, 0x32, 0xef, 0x40, 0x43, 0x66,
+	0x86, 0xdc, 0x8c, 0x1e, 0x6f, 0x26, 0xdd, 0xe8, 0x99, 0x45, 0xd8, 0x1f, 0xf4, 0x12, 0xcf, 0x38,
+	0xff, 0xa6, 0x0c, 0x55, 0xcc, 0xdc, 0xa7, 0x66, 0x22, 0xc7, 0x01, 0xfa, 0x96, 0x1f, 0xac, 0x8f,
+	0xfa, 0x7d, 0x66, 0xcb, 0x77, 0x79, 0x8a, 0x04, 0x0f, 0xda, 0x45, 0xc8, 0xdf, 0xd9, 0x18, 0xf5,
+	0x7a, 0x8c, 0xd9, 0xf2, 0x29, 0x5c, 0x52, 0x8c, 0x57, 0x70, 0xd0, 0x86, 0xc2, 0x83, 0x88, 0x37,
+	0x0b, 0x6b, 0x16, 0xbf, 0x2a, 0x20, 0x73, 0x23, 0x90, 0xa6, 0x0b, 0xad, 0x48, 0x86, 0x23, 0xca,
+	0xd0, 0x19, 0xa0, 0x2d, 0xc8, 0xee, 0x19, 0x06, 0x71, 0x06, 0xc5, 0x9f, 0x32, 0xbf, 0x35, 0x2a,
+	0x43, 0x28, 0xdf, 0xb2, 0x9c, 0xbe, 0xcc, 0x62, 0x8d, 0xca, 0x10, 0x32, 0x89, 0x55, 0xb8, 0xb8,
+	0xbb, 0x52, 0xa1, 0x61, 0xd0, 0xfc, 0xb8, 0x14, 0xbd, 0x60, 0xce, 0x7a, 0xd2, 0x99, 0x72, 0x8c,
+	0xcd, 0xab, 0xde, 0x79, 0xd1, 0x33, 0x62, 0x01, 0xa6, 0xef, 0x0e, 0xfa, 0xce, 0x80, 0x49, 0x47,
+	0x98, 0x0c, 0x25, 0xea, 0xb8, 0x96, 0xaa, 0x63, 0x19, 0xbf, 0x6a, 0x3b, 0x98, 0xc5, 0x7a, 0x1c,
+	0x2f, 0x24, 0xe4, 0x3d, 0xbc, 0x8b, 0xb2, 0xe7, 0xf4, 0x18, 0x7e, 0xc9, 0xac, 0x92, 0x71, 0xe2,
+	0xa8, 0xd7, 0xed, 0x0a, 0xd7, 0xa5, 0x21, 0xc6, 0x0c, 0xf0, 0xf1, 0x1b, 0xfe, 0x8c, 0x8a, 0x54,
+	0x52, 0x8a, 0x14, 0x67, 0xba, 0x3c, 0x26, 0xd3, 0x95, 0x82, 0x4c, 0x57, 0x93, 0x99, 0x5e, 0xb0,
+	0x01, 0x62, 0x73, 0xc3, 0x8e, 0xfd, 0x78, 0xf0, 0x74, 0xe0, 0x3e, 0x1b, 0x88, 0x5e, 0xfe, 0x70,
+	0x6b, 0x0b, 0x53, 0x69, 0x97, 0x30, 0x80, 0x7a, 0xd8, 0xe5, 0xcb, 0x04, 0xa0, 0x8e, 0x01, 0x66,
+	0xb7, 0x2b, 0xf8, 0xfb, 0x36, 0x6f, 0xbf, 0x76, 0x95, 0xbc, 0x08, 0x47, 0xba, 0x83, 0x9e, 0xbb,
+	0x3b, 0xb4, 0x02, 0x67, 0xb3, 0xcf, 0x9e, 0x30, 0xcf, 0x77, 0xdc, 0x41, 0xbb, 0x66, 0xfe, 0x6b,
+	0x45, 0x1c, 0x61, 0x9b, 0xb7, 0xe0, 0x80, 0xf6, 0x19, 0x04, 0x03, 0x1a, 0xfe, 0x50, 0x7c, 0xdc,
+	0x54, 0x6e, 0x22, 0x64, 0x90, 0x5b, 0x89, 0x78, 0x4b, 0x2e, 0xd7, 0x5f, 0x22, 0x64, 0x9e, 0x05,
+	0x50, 0x3e, 0x7e, 0x70, 0x1c, 0x60, 0x73, 0x3f, 0x60, 0x3e, 0x0f, 0x71, 0x8a, 0x2a, 0x55, 0x24,
+	0xe6, 0x65, 0x00, 0xe5, 0x03, 0x07, 0xd8, 0x4b, 0x30, 0xb4, 0x94, 0x84, 0x24, 0xc5, 0xe6, 0x8f,
+	0xcb, 0x30, 0x97, 0xf8, 0x8e, 0xc1, 0xd4, 0x59, 0xc5, 0x63, 0xab, 0xf8, 0x98, 0x7d, 0x2e, 0x75,
+	0x6c, 0x95, 0xf1, 0x09, 0x05, 0xfd, 0xa8, 0x1d, 0x67, 0x49, 0x9e, 0x25, 0xae, 0x23, 0x9b, 0xaf,
+	0x4a, 0x75, 0x61, 0x54, 0x05, 0x8f, 0xf8, 0x63, 0xde, 0x9a, 0x52, 0x05, 0x5c, 0x82, 0xf1, 0x2c,
+	0xb0, 0x36, 0x58, 0xcf, 0x1d, 0x48, 0xb7, 0x76, 0x85, 0x2a, 0x12, 0x9c, 0x5d, 0x19, 0xff, 0xb6,
+	0x82, 0xf0, 0xc4, 0x8a, 0xc0, 0xc2, 0x8d, 0xf0, 0x24, 0x1a, 0x1b, 0x3e, 0xb0, 0xbc, 0x80, 0xd9,
+	0xed, 0x19, 0x32, 0x07, 0xd0, 0x1d, 0x84, 0x99, 0x6d, 0x97, 0xc8, 0x01, 0x68, 0xde, 0x76, 0x06,
+	0x8e, 0xbf, 0xc3, 0xec, 0x76, 0x59, 0x31, 0x85, 0x0a, 0xbe, 0xfa, 0x15, 0x5f, 0x65, 0x30, 0xaf,
+	0xcb, 0x69, 0x92, 0x40, 0x75, 0x88, 0x8f, 0x3e, 0xa5, 0x55, 0xe3, 0x6f, 0x9c, 0xc4, 0x78, 0x25,
+	0xfa, 0xf1, 0x7b, 0xe3, 0x1a, 0x55, 0x45, 0xe6, 0x3b, 0xe1, 0x4c, 0x99, 0x05, 0x8f, 0xb2, 0x5e,
+	0x56, 0xb2, 0x8e, 0xdf, 0x0d, 0x80, 0xf8, 0x4b, 0x0e, 0xf8, 0xe6, 0x39, 0xfa, 0x6c, 0x03, 0x3e,
+	0x14, 0x1e, 0x29, 0x1f, 0x28, 0x90, 0x21, 0xb5, 0x6d, 0xcb, 0x7a, 0xdb, 0x9a, 0xd0, 0x74, 0xc3,
+	0x15, 0xae, 0x18, 0x49, 0xa2, 0x30, 0xa2, 0xe4, 0x97, 0x7f, 0xc3, 0x2f, 0xf3, 0xca, 0xa0, 0xf9,
+	0x07, 0x65, 0x98, 0x55, 0xbe, 0xf8, 0x60, 0xfe, 0x57, 0x09, 0x0e, 0x68, 0x9f, 0x76, 0x50, 0x69,
+	0x4b, 0x09, 0xda, 0xcc, 0x67, 0xe0, 0xea, 0x9d, 0xb4, 0xca, 0x64, 0x77, 0xd2, 0xc8, 0xbb, 0x70,
+	0xc0, 0x66, 0x43, 0x36, 0xb0, 0xd9, 0xa0, 0xe7, 0x44, 0x1b, 0x92, 0x5c, 0x9c, 0xa6, 0x4c, 0xde,
+	0x47, 0x3f, 0xbe, 0xfc, 0x42, 0x67, 0x6d, 0xea, 0x2f, 0x74, 0xd2, 0x08, 0x6c, 0x5e, 0x87, 0x1a,
+	0xff, 0x48, 0x85, 0x79, 0x01, 0x6a, 0xfc, 0xcb, 0x14, 0x63, 0x7a, 0x52, 0x6a, 0x9b, 0xb2, 0xf0,
+	0x6d, 0x38, 0x48, 0x99, 0x3f, 0x74, 0x07, 0x3e, 0xfb, 0x55, 0x7d, 0x96, 0x39, 0xf7, 0x03, 0xcb,
+	0x0b, 0xbf, 0xa8, 0x40, 0x8d, 0x2f, 0xe1, 0xcc, 0x7f, 0x8e, 0x17, 0x9b, 0x19, 0x17, 0xfc, 0xe2,
+	0x6b, 0x38, 0x73, 0xca, 0xfe, 0x57, 0x5b, 0xfc, 0xa9, 0x67, 0x39, 0xe7, 0xf5, 0x71, 0x61, 0x3e,
+	0x07, 0xa1, 0x8d, 0x05, 0xef, 0x42, 0x73, 0x18, 0x7e, 0x91, 0xa5, 0x9a, 0xf8, 0x36, 0x9e, 0x0e,
+	0x0b, 0xbb, 0x27, 0x8d, 0x00, 0xe6, 0x03, 0x68, 0x86, 0xd2, 0x9c, 0x67, 0xff, 0x04, 0xaa, 0xb6,
+	0x2b, 0x27, 0x97, 0x0a, 0xe5, 0xbf, 0x55, 0xf3, 0xae, 0x68, 0xe6, 0xbd, 0xf0, 0xfd, 0x92, 0x3c,
+	0x1f, 0x3d, 0x08, 0xad, 0x15, 0xcf, 0x1d, 0xf2, 0x97, 0xdf, 0xed, 0x19, 0x1c, 0x00, 0xba, 0xbb,
+	0x43, 0xd7, 0x0b, 0xda, 0x25, 0xfc, 0xbd, 0xfa, 0x9c, 0xff, 0x2e, 0xe3, 0x30, 0x81, 0xbd, 0x01,
+	0xd5, 0xda, 0x15, 0x42, 0xd0, 0x3b, 0xc1, 0xcf, 0x84, 0xe4, 0x9c, 0xde, 0xae, 0x22, 0xd1, 0x7d,
+	0x67, 0x5b, 0x6c, 0xba, 0xda, 0x35, 0x72, 0x04, 0x0e, 0xe1, 0x66, 0x0b, 0x77, 0xb4, 0x94, 0xf9,
+	0x81, 0xeb, 0xb1, 0x76, 0x1d, 0x71, 0xe2, 0x6b, 0x48, 0x9e, 0x35, 0xf0, 0xb7, 0x5c, 0x6f, 0xb7,
+	0xdd, 0x20, 0x87, 0xf0, 0xd9, 0xf9, 0xc0, 0x0e, 0xf7, 0x69, 0xcd, 0x85, 0xc5, 0x70, 0xdc, 0x6a,
+	0x42, 0x55, 0x6e, 0x0f, 0x67, 0xa1, 0x41, 0x47, 0x03, 0xb1, 0x5a, 0x25, 0x4d, 0x31, 0x1a, 0x89,
+	0x4c, 0x2d, 0x5b, 0x83, 0x1e, 0xe3, 0xe3, 0x55, 0xbc, 0xba, 0xad, 0x2e, 0xcd, 0xff, 0xed, 0xc7,
+	0xc7, 0x4b, 0x3f, 0xfd, 0xf8, 0x78, 0xe9, 0xe7, 0x1f, 0x1f, 0x2f, 0xfd, 0xd1, 0x27, 0xc7, 0x67,
+	0x7e, 0xfa, 0xc9, 0xf1, 0x99, 0x7f, 0xfc, 0xe4, 0xf8, 0xcc, 0x47, 0xe5, 0xe1, 0xe6, 0x66, 0x9d,
+	0x77, 0x9b, 0x0b, 0xff, 0x3d, 0x00, 0x99, 0x6a, 0x65, 0xd4, 0x8f, 0x5c, 0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -11993,6 +12860,29 @@ func (m *EventMessageValueOfProcessDone) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfProcessError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ProcessError != nil {
+		{
+			size, err := m.ProcessError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfThreadStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -12085,6 +12975,144 @@ func (m *EventMessageValueOfFileLocalUsage) MarshalToSizedBuffer(dAtA []byte) (i
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfFileUploadProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfFileUploadProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FileUploadProgress != nil {
+		{
+			size, err := m.FileUploadProgress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x92
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfBackupDone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfBackupDone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BackupDone != nil {
+		{
+			size, err := m.BackupDone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfBackupError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfBackupError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BackupError != nil {
+		{
+			size, err := m.BackupError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfAutomationExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfAutomationExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AutomationExecuted != nil {
+		{
+			size, err := m.AutomationExecuted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfSavedSearchQueryChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfSavedSearchQueryChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SavedSearchQueryChanged != nil {
+		{
+			size, err := m.SavedSearchQueryChanged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfTrashPurge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfTrashPurge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TrashPurge != nil {
+		{
+			size, err := m.TrashPurge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfBlockDataviewRelationSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -13331,20 +14359,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA73 := make([]byte, len(m.MarksInRange)*10)
-		var j72 int
+		dAtA80 := make([]byte, len(m.MarksInRange)*10)
+		var j79 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j79++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA80[j79] = uint8(num)
+			j79++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintEvents(dAtA, i, uint64(j72))
+		i -= j79
+		copy(dAtA[i:], dAtA80[:j79])
+		i = encodeVarintEvents(dAtA, i, uint64(j79))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -14026,6 +15054,18 @@ func (m *EventBlockSetLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Processor != nil {
+		{
+			size, err := m.Processor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Text != nil {
 		{
 			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
@@ -14078,6 +15118,34 @@ func (m *EventBlockSetLatexText) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetLatexProcessor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetLatexProcessor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetLatexProcessor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetDiv) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -18809,6 +19877,55 @@ func (m *EventProcessDone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProcessError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProcessError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProcessError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProcessId) > 0 {
+		i -= len(m.ProcessId)
+		copy(dAtA[i:], m.ProcessId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProcessId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19263,7 +20380,7 @@ func (m *EventFileLocalUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ResponseEvent) Marshal() (dAtA []byte, err error) {
+func (m *EventFileUploadProgress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -19273,48 +20390,61 @@ func (m *ResponseEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResponseEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFileUploadProgress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResponseEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFileUploadProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TraceId) > 0 {
-		i -= len(m.TraceId)
-		copy(dAtA[i:], m.TraceId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TraceId)))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x3a
 	}
-	if len(m.ContextId) > 0 {
-		i -= len(m.ContextId)
-		copy(dAtA[i:], m.ContextId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContextId)))
+	if m.EtaSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EtaSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BytesTotal != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BytesTotal))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BytesUploaded != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BytesUploaded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FileId) > 0 {
+		i -= len(m.FileId)
+		copy(dAtA[i:], m.FileId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FileId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
+func (m *EventBackup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -19324,12 +20454,12 @@ func (m *Model) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBackup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -19337,7 +20467,7 @@ func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ModelProcess) Marshal() (dAtA []byte, err error) {
+func (m *EventBackupDone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -19347,49 +20477,69 @@ func (m *ModelProcess) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModelProcess) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBackupDone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModelProcess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBackupDone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Progress != nil {
-		{
-			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if m.SpacesCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SpacesCount))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x10
 	}
-	if m.State != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.State))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Path)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if m.Type != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBackupError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBackupError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBackupError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModelProcessProgress) Marshal() (dAtA []byte, err error) {
+func (m *EventAutomation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -19399,12 +20549,35 @@ func (m *ModelProcessProgress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModelProcessProgress) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAutomation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModelProcessProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAutomation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutomationExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutomationExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutomationExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -19414,120 +20587,459 @@ func (m *ModelProcessProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Message)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectId)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Done != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Done))
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Total != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Total))
+	if len(m.RuleId) > 0 {
+		i -= len(m.RuleId)
+		copy(dAtA[i:], m.RuleId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RuleId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventSavedSearch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Event) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventSavedSearch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSavedSearch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSavedSearchQueryChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSavedSearchQueryChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSavedSearchQueryChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.Counters != nil {
+		{
+			size, err := m.Counters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ContextId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Initiator != nil {
-		l = m.Initiator.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.TraceId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SubId) > 0 {
+		i -= len(m.SubId)
+		copy(dAtA[i:], m.SubId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		n += m.Value.Size()
+func (m *EventTrash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMessageValueOfAccountShow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AccountShow != nil {
-		l = m.AccountShow.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventTrash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventMessageValueOfBlockAdd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventTrash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockAdd != nil {
-		l = m.BlockAdd.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessageValueOfBlockDelete) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockDelete != nil {
-		l = m.BlockDelete.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *EventTrashPurge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventMessageValueOfFilesUpload) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventTrashPurge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTrashPurge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FilesUpload != nil {
-		l = m.FilesUpload.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *EventMessageValueOfMarksInfo) Size() (n int) {
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResponseEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContextId) > 0 {
+		i -= len(m.ContextId)
+		copy(dAtA[i:], m.ContextId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContextId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ModelProcess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.State != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModelProcessProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Done != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Done))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.ContextId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Initiator != nil {
+		l = m.Initiator.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *EventMessageValueOfAccountShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccountShow != nil {
+		l = m.AccountShow.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfBlockAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockAdd != nil {
+		l = m.BlockAdd.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfBlockDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockDelete != nil {
+		l = m.BlockDelete.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfFilesUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FilesUpload != nil {
+		l = m.FilesUpload.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfMarksInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -20055,6 +21567,18 @@ func (m *EventMessageValueOfProcessDone) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfProcessError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProcessError != nil {
+		l = m.ProcessError.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfThreadStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20103,6 +21627,78 @@ func (m *EventMessageValueOfFileLocalUsage) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfFileUploadProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FileUploadProgress != nil {
+		l = m.FileUploadProgress.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfBackupDone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BackupDone != nil {
+		l = m.BackupDone.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfBackupError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BackupError != nil {
+		l = m.BackupError.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfAutomationExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AutomationExecuted != nil {
+		l = m.AutomationExecuted.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfSavedSearchQueryChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SavedSearchQueryChanged != nil {
+		l = m.SavedSearchQueryChanged.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfTrashPurge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrashPurge != nil {
+		l = m.TrashPurge.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfBlockDataviewRelationSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20949,6 +22545,10 @@ func (m *EventBlockSetLatex) Size() (n int) {
 		l = m.Text.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Processor != nil {
+		l = m.Processor.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -20965,6 +22565,18 @@ func (m *EventBlockSetLatexText) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetLatexProcessor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovEvents(uint64(m.Value))
+	}
+	return n
+}
+
 func (m *EventBlockSetDiv) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22972,6 +24584,30 @@ func (m *EventProcessDone) Size() (n int) {
 	return n
 }
 
+func (m *EventProcessError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovEvents(uint64(m.Severity))
+	}
+	return n
+}
+
 func (m *EventStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23167,6 +24803,185 @@ func (m *EventFileLocalUsage) Size() (n int) {
 	return n
 }
 
+func (m *EventFileUploadProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FileId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovEvents(uint64(m.State))
+	}
+	if m.BytesUploaded != 0 {
+		n += 1 + sovEvents(uint64(m.BytesUploaded))
+	}
+	if m.BytesTotal != 0 {
+		n += 1 + sovEvents(uint64(m.BytesTotal))
+	}
+	if m.EtaSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.EtaSeconds))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventBackupDone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SpacesCount != 0 {
+		n += 1 + sovEvents(uint64(m.SpacesCount))
+	}
+	return n
+}
+
+func (m *EventBackupError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAutomation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventAutomationExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuleId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSavedSearch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventSavedSearchQueryChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Counters != nil {
+		l = m.Counters.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTrash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventTrashPurge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResponseEvent) Size() (n int) {
 	if m == nil {
 		return 0
@@ -25140,6 +26955,41 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfProcessDone{v}
 			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventProcessError{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfProcessError{v}
+			iNdEx = postIndex
 		case 110:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ThreadStatus", wireType)
@@ -25280,9 +27130,9 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &EventMessageValueOfFileLocalUsage{v}
 			iNdEx = postIndex
-		case 123:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileUploadProgress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25309,15 +27159,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewRelationSet{}
+			v := &EventFileUploadProgress{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewRelationSet{v}
+			m.Value = &EventMessageValueOfFileUploadProgress{v}
 			iNdEx = postIndex
-		case 124:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationDelete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupDone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25344,15 +27194,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewRelationDelete{}
+			v := &EventBackupDone{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewRelationDelete{v}
+			m.Value = &EventMessageValueOfBackupDone{v}
 			iNdEx = postIndex
-		case 125:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewViewUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25379,15 +27229,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewViewUpdate{}
+			v := &EventBackupError{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewViewUpdate{v}
+			m.Value = &EventMessageValueOfBackupError{v}
 			iNdEx = postIndex
-		case 126:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewTargetObjectIdSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutomationExecuted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25414,15 +27264,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewTargetObjectIdSet{}
+			v := &EventAutomationExecuted{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewTargetObjectIdSet{v}
+			m.Value = &EventMessageValueOfAutomationExecuted{v}
 			iNdEx = postIndex
-		case 127:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewIsCollectionSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SavedSearchQueryChanged", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25449,15 +27299,225 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBlockDataviewIsCollectionSet{}
+			v := &EventSavedSearchQueryChanged{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &EventMessageValueOfBlockDataviewIsCollectionSet{v}
+			m.Value = &EventMessageValueOfSavedSearchQueryChanged{v}
 			iNdEx = postIndex
-		case 201:
+		case 119:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrashPurge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventTrashPurge{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfTrashPurge{v}
+			iNdEx = postIndex
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewRelationSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewRelationSet{v}
+			iNdEx = postIndex
+		case 124:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewRelationDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewRelationDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewRelationDelete{v}
+			iNdEx = postIndex
+		case 125:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewViewUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewViewUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewViewUpdate{v}
+			iNdEx = postIndex
+		case 126:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewTargetObjectIdSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewTargetObjectIdSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewTargetObjectIdSet{v}
+			iNdEx = postIndex
+		case 127:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDataviewIsCollectionSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBlockDataviewIsCollectionSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfBlockDataviewIsCollectionSet{v}
+			iNdEx = postIndex
+		case 201:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountDetails", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30422,6 +32482,42 @@ func (m *EventBlockSetLatex) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Processor == nil {
+				m.Processor = &EventBlockSetLatexProcessor{}
+			}
+			if err := m.Processor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -30525,7 +32621,7 @@ func (m *EventBlockSetLatexText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetLatexProcessor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30548,49 +32644,17 @@ func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Div: wiretype end group for non-group")
+			return fmt.Errorf("proto: Processor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Div: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Processor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -30600,28 +32664,11 @@ func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Value |= model.BlockContentLatexProcessor(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Style == nil {
-				m.Style = &EventBlockSetDivStyle{}
-			}
-			if err := m.Style.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -30643,7 +32690,7 @@ func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetDivStyle) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetDiv) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30666,17 +32713,17 @@ func (m *EventBlockSetDivStyle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+			return fmt.Errorf("proto: Div: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Div: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -30686,7 +32733,125 @@ func (m *EventBlockSetDivStyle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= model.BlockContentDivStyle(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Style", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Style == nil {
+				m.Style = &EventBlockSetDivStyle{}
+			}
+			if err := m.Style.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetDivStyle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Style: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Style: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= model.BlockContentDivStyle(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -43072,7 +45237,7 @@ func (m *EventProcessDone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStatus) Unmarshal(dAtA []byte) error {
+func (m *EventProcessError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43095,67 +45260,17 @@ func (m *EventStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Thread: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Thread: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -43165,33 +45280,29 @@ func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Summary == nil {
-				m.Summary = &EventStatusThreadSummary{}
-			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProcessId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cafe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -43201,33 +45312,29 @@ func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cafe == nil {
-				m.Cafe = &EventStatusThreadCafe{}
-			}
-			if err := m.Cafe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -43237,26 +45344,43 @@ func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, &EventStatusThreadAccount{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= EventProcessErrorSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -43278,7 +45402,7 @@ func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStatusThreadSummary) Unmarshal(dAtA []byte) error {
+func (m *EventStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43301,31 +45425,12 @@ func (m *EventStatusThreadSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= EventStatusThreadSyncStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -43347,7 +45452,7 @@ func (m *EventStatusThreadSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStatusThreadCafe) Unmarshal(dAtA []byte) error {
+func (m *EventStatusThread) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -43370,17 +45475,17 @@ func (m *EventStatusThreadCafe) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cafe: wiretype end group for non-group")
+			return fmt.Errorf("proto: Thread: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cafe: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Thread: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -43390,53 +45495,278 @@ func (m *EventStatusThreadCafe) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= EventStatusThreadSyncStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPulled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.LastPulled = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastPulled |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPushSucceed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Summary == nil {
+				m.Summary = &EventStatusThreadSummary{}
 			}
-			m.LastPushSucceed = bool(v != 0)
-		case 4:
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cafe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cafe == nil {
+				m.Cafe = &EventStatusThreadCafe{}
+			}
+			if err := m.Cafe.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &EventStatusThreadAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStatusThreadSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EventStatusThreadSyncStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStatusThreadCafe) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cafe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cafe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EventStatusThreadSyncStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPulled", wireType)
+			}
+			m.LastPulled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPulled |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPushSucceed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LastPushSucceed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -44297,6 +46627,1153 @@ func (m *EventFileLocalUsage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFileUploadProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= EventFileUploadProgressState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesUploaded", wireType)
+			}
+			m.BytesUploaded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesUploaded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesTotal", wireType)
+			}
+			m.BytesTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesTotal |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtaSeconds", wireType)
+			}
+			m.EtaSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EtaSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBackupDone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Done: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Done: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpacesCount", wireType)
+			}
+			m.SpacesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpacesCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBackupError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutomation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Automation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Automation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutomationExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Executed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Executed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSavedSearch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SavedSearch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SavedSearch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSavedSearchQueryChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &types.Struct{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, &types.Struct{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counters == nil {
+				m.Counters = &EventObjectSubscriptionCounters{}
+			}
+			if err := m.Counters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTrash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTrashPurge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponseEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,251 +26,292 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 3905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9c, 0xcd, 0x6f, 0x1c, 0xc7,
-	0x95, 0xc0, 0x3d, 0x97, 0xf5, 0x6e, 0x7b, 0xed, 0xdd, 0x1d, 0xef, 0x6a, 0x6d, 0xad, 0x4d, 0xc9,
-	0xb2, 0x44, 0x52, 0xa2, 0x38, 0xa4, 0x45, 0xf9, 0x63, 0x93, 0x00, 0x01, 0x45, 0x8a, 0x32, 0x61,
-	0x7d, 0x85, 0x43, 0x49, 0x80, 0x81, 0x00, 0x69, 0xf6, 0x94, 0x86, 0x1d, 0xf6, 0x74, 0xb5, 0xbb,
-	0x6b, 0x48, 0x31, 0x41, 0x82, 0x04, 0x09, 0x12, 0x24, 0x48, 0x90, 0x20, 0x1f, 0xa7, 0xdc, 0x72,
-	0xcf, 0x9f, 0x90, 0x7b, 0x8e, 0x3e, 0xe6, 0x18, 0xd8, 0xff, 0x48, 0xd0, 0x55, 0xaf, 0xeb, 0xe3,
-	0x75, 0xbd, 0xea, 0x1e, 0x9f, 0x24, 0xcc, 0xfb, 0xbd, 0x8f, 0xea, 0x7a, 0x55, 0xf5, 0xaa, 0xaa,
-	0x9b, 0xd1, 0xa5, 0xe2, 0x68, 0xa3, 0x28, 0xb9, 0xe0, 0xd5, 0x46, 0xc5, 0xca, 0xd3, 0x34, 0x61,
-	0xcd, 0xbf, 0x23, 0xf9, 0xf3, 0xf0, 0xe5, 0x38, 0x3f, 0x17, 0xe7, 0x05, 0xbb, 0xf8, 0x86, 0x21,
-	0x13, 0x3e, 0x9b, 0xc5, 0xf9, 0xa4, 0x52, 0xc8, 0xc5, 0x0b, 0x46, 0xc2, 0x4e, 0x59, 0x2e, 0xe0,
-	0xf7, 0x5b, 0x7f, 0xfd, 0xcb, 0x20, 0x7a, 0x6d, 0x27, 0x4b, 0x59, 0x2e, 0x76, 0x40, 0x63, 0xf8,
-	0x69, 0xf4, 0xea, 0x76, 0x51, 0xdc, 0x63, 0xe2, 0x29, 0x2b, 0xab, 0x94, 0xe7, 0xc3, 0x77, 0x47,
-	0xe0, 0x60, 0x74, 0x50, 0x24, 0xa3, 0xed, 0xa2, 0x18, 0x19, 0xe1, 0xe8, 0x80, 0x7d, 0x36, 0x67,
-	0x95, 0xb8, 0x78, 0x35, 0x0c, 0x55, 0x05, 0xcf, 0x2b, 0x36, 0x7c, 0x1e, 0xfd, 0xd7, 0x76, 0x51,
-	0x8c, 0x99, 0xd8, 0x65, 0x75, 0x03, 0xc6, 0x22, 0x16, 0x6c, 0xb8, 0xd2, 0x52, 0x75, 0x01, 0xed,
-	0x63, 0xb5, 0x1b, 0x04, 0x3f, 0x87, 0xd1, 0x2b, 0xb5, 0x9f, 0xe3, 0xb9, 0x98, 0xf0, 0xb3, 0x7c,
-	0xf8, 0x4e, 0x5b, 0x11, 0x44, 0xda, 0xf6, 0x95, 0x10, 0x02, 0x56, 0x9f, 0x45, 0xff, 0xfe, 0x2c,
-	0xce, 0x32, 0x26, 0x76, 0x4a, 0x56, 0x07, 0xee, 0xea, 0x28, 0xd1, 0x48, 0xc9, 0xb4, 0xdd, 0x77,
-	0x83, 0x0c, 0x18, 0xfe, 0x34, 0x7a, 0x55, 0x49, 0x0e, 0x58, 0xc2, 0x4f, 0x59, 0x39, 0xf4, 0x6a,
-	0x81, 0x90, 0x78, 0xe4, 0x2d, 0x08, 0xdb, 0xde, 0xe1, 0xf9, 0x29, 0x2b, 0x85, 0xdf, 0x36, 0x08,
-	0xc3, 0xb6, 0x0d, 0x04, 0xb6, 0xb3, 0xe8, 0x75, 0xfb, 0x81, 0x8c, 0x59, 0x25, 0x13, 0xe6, 0x3a,
-	0xdd, 0x66, 0x40, 0xb4, 0x9f, 0x1b, 0x7d, 0x50, 0xf0, 0x96, 0x46, 0x43, 0xf0, 0x96, 0xf1, 0x4a,
-	0x3b, 0x5b, 0xf5, 0x5a, 0xb0, 0x08, 0xed, 0xeb, 0x7a, 0x0f, 0x12, 0x5c, 0x7d, 0x27, 0xfa, 0x8f,
-	0x67, 0xbc, 0x3c, 0xa9, 0x8a, 0x38, 0x61, 0xd0, 0xd9, 0xd7, 0x5c, 0xed, 0x46, 0x8a, 0xfb, 0x7b,
-	0xb9, 0x0b, 0xb3, 0xba, 0xa5, 0x11, 0x3e, 0x2a, 0x18, 0x1e, 0x65, 0x46, 0xb1, 0x16, 0x52, 0xdd,
-	0x82, 0x21, 0xb0, 0x7d, 0x12, 0x0d, 0x8d, 0xed, 0xa3, 0xef, 0xb2, 0x44, 0x6c, 0x4f, 0x26, 0xb8,
-	0x57, 0x8c, 0xae, 0x24, 0x46, 0xdb, 0x93, 0x09, 0xd5, 0x2b, 0x7e, 0x14, 0x9c, 0x9d, 0x45, 0x17,
-	0x90, 0xb3, 0xfb, 0x69, 0x25, 0x1d, 0xae, 0x87, 0xad, 0x00, 0xa6, 0x9d, 0x8e, 0xfa, 0xe2, 0xe0,
-	0xf8, 0x47, 0x83, 0xe8, 0x4d, 0x8f, 0xe7, 0x03, 0x36, 0xe3, 0xa7, 0x6c, 0xb8, 0xd9, 0x6d, 0x4d,
-	0x91, 0xda, 0xff, 0x7b, 0x0b, 0x68, 0x78, 0xd2, 0x64, 0xcc, 0x32, 0x96, 0x08, 0x32, 0x4d, 0x94,
-	0xb8, 0x33, 0x4d, 0x34, 0x66, 0x8d, 0xb0, 0x46, 0x78, 0x8f, 0x89, 0x9d, 0x79, 0x59, 0xb2, 0x5c,
-	0x90, 0x7d, 0x69, 0x90, 0xce, 0xbe, 0x74, 0x50, 0x4f, 0x7b, 0xee, 0x31, 0xb1, 0x9d, 0x65, 0x64,
-	0x7b, 0x94, 0xb8, 0xb3, 0x3d, 0x1a, 0x03, 0x0f, 0x49, 0xf4, 0x9f, 0xd6, 0x13, 0x13, 0xfb, 0xf9,
-	0x73, 0x3e, 0xa4, 0x9f, 0x85, 0x94, 0x6b, 0x1f, 0x2b, 0x9d, 0x9c, 0xa7, 0x19, 0x77, 0x5f, 0x14,
-	0xbc, 0xa4, 0xbb, 0x45, 0x89, 0x3b, 0x9b, 0xa1, 0x31, 0xf0, 0xf0, 0xed, 0xe8, 0xb5, 0xed, 0x24,
-	0xe1, 0xf3, 0x5c, 0xcf, 0xd8, 0x68, 0xfd, 0x53, 0xc2, 0xd6, 0x94, 0x7d, 0xad, 0x83, 0x32, 0x93,
-	0x03, 0xc8, 0x60, 0xf2, 0x79, 0xd7, 0xab, 0x87, 0xa6, 0x9e, 0xab, 0x61, 0xa8, 0x65, 0x7b, 0x97,
-	0x65, 0x8c, 0xb4, 0xad, 0x84, 0x1d, 0xb6, 0x35, 0x04, 0xb6, 0xcb, 0xe8, 0x7f, 0xf4, 0x63, 0xa9,
-	0x57, 0x0a, 0x29, 0xaf, 0x27, 0xe9, 0x35, 0xa2, 0xdd, 0x36, 0xa4, 0x7d, 0xdd, 0xec, 0x07, 0xb7,
-	0xda, 0x03, 0x23, 0xd0, 0xdf, 0x1e, 0x34, 0xfe, 0xae, 0x86, 0x21, 0xb0, 0xfd, 0xcb, 0x41, 0xf4,
-	0x36, 0xc8, 0xee, 0xe6, 0xf1, 0x51, 0xc6, 0xee, 0xf3, 0x24, 0xce, 0x1e, 0x32, 0x71, 0xc6, 0xcb,
-	0x93, 0xf1, 0x79, 0x9e, 0x0c, 0xb7, 0xbc, 0x76, 0xfc, 0xb0, 0x76, 0x7e, 0x7b, 0x31, 0x25, 0xab,
-	0xa6, 0x81, 0x86, 0x0a, 0x5e, 0xe0, 0x9a, 0xa6, 0x69, 0x81, 0xe0, 0x05, 0x55, 0xd3, 0xb8, 0x48,
-	0xcb, 0xea, 0x83, 0x7a, 0xda, 0xf4, 0x5b, 0x7d, 0x60, 0xcf, 0x93, 0x57, 0x42, 0x88, 0x99, 0xb6,
-	0x9a, 0x04, 0xe6, 0xf9, 0xf3, 0x74, 0xfa, 0xa4, 0x98, 0xd4, 0x69, 0x7c, 0xdd, 0x9f, 0xa1, 0x16,
-	0x42, 0x4c, 0x5b, 0x04, 0x0a, 0xde, 0x7e, 0x3d, 0x88, 0x96, 0xdc, 0xe1, 0xb8, 0x57, 0xf2, 0xd9,
-	0x7d, 0x36, 0x8d, 0x93, 0x73, 0x18, 0xff, 0xb7, 0x43, 0x03, 0x0f, 0xd3, 0x3a, 0x88, 0xf7, 0x17,
-	0xd4, 0x32, 0xcf, 0x74, 0x5c, 0x4f, 0x1b, 0x30, 0xc0, 0xdc, 0x67, 0x2a, 0x25, 0x78, 0x78, 0x5d,
-	0x09, 0x21, 0x60, 0xf5, 0x5b, 0x51, 0xa4, 0x96, 0x22, 0x59, 0x2e, 0x5c, 0x76, 0x34, 0x60, 0x8d,
-	0x72, 0x6a, 0x85, 0x77, 0x02, 0x84, 0x09, 0x54, 0xfd, 0x2e, 0xab, 0xa0, 0xa1, 0x57, 0x43, 0x8a,
-	0x88, 0x40, 0x11, 0x82, 0x03, 0x1d, 0x1f, 0xf3, 0x33, 0x7f, 0xa0, 0xb5, 0x24, 0x1c, 0x28, 0x10,
-	0xa6, 0xf2, 0x86, 0x40, 0x7d, 0x95, 0x77, 0x13, 0x46, 0xa8, 0xf2, 0xc6, 0x0c, 0x18, 0xe6, 0xd1,
-	0x7f, 0xdb, 0x86, 0xef, 0x70, 0x7e, 0x32, 0x8b, 0xcb, 0x93, 0xe1, 0x0d, 0x5a, 0xb9, 0x61, 0xb4,
-	0xa3, 0xb5, 0x5e, 0xac, 0x59, 0x9b, 0x6c, 0x87, 0x63, 0x86, 0xd7, 0x26, 0x47, 0x7f, 0xcc, 0xa8,
-	0xb5, 0xc9, 0x83, 0xe1, 0x4e, 0xbd, 0x57, 0xc6, 0xc5, 0xb1, 0xbf, 0x53, 0xa5, 0x28, 0xdc, 0xa9,
-	0x0d, 0x82, 0x7b, 0x60, 0xcc, 0xe2, 0x32, 0x39, 0xf6, 0xf7, 0x80, 0x92, 0x85, 0x7b, 0x40, 0x33,
-	0x66, 0xcd, 0xb0, 0x0d, 0x8f, 0xe7, 0x47, 0x55, 0x52, 0xa6, 0x47, 0x6c, 0xb8, 0x46, 0x6b, 0x6b,
-	0x88, 0x58, 0x33, 0x48, 0xd8, 0xec, 0x24, 0xc0, 0x67, 0x23, 0xdb, 0x9f, 0x54, 0x68, 0x27, 0xd1,
-	0xd8, 0xb0, 0x08, 0x62, 0x27, 0xe1, 0x27, 0x71, 0xf3, 0xee, 0x95, 0x7c, 0x5e, 0x54, 0x1d, 0xcd,
-	0x43, 0x50, 0xb8, 0x79, 0x6d, 0x18, 0x7c, 0xbe, 0x88, 0xfe, 0xd7, 0x7e, 0xa4, 0x4f, 0xf2, 0x4a,
-	0x7b, 0x5d, 0xa7, 0x9f, 0x93, 0x85, 0x11, 0x35, 0x79, 0x00, 0x37, 0xe5, 0x5d, 0xe3, 0x59, 0xec,
-	0x32, 0x11, 0xa7, 0x59, 0x35, 0x5c, 0xf6, 0xdb, 0x68, 0xe4, 0x44, 0x79, 0xe7, 0xe3, 0xf0, 0x10,
-	0xda, 0x9d, 0x17, 0x59, 0x9a, 0xb4, 0x37, 0x67, 0xa0, 0xab, 0xc5, 0xe1, 0x21, 0x64, 0x63, 0x66,
-	0xf9, 0xd2, 0xcd, 0x50, 0xff, 0x39, 0x3c, 0x2f, 0xf0, 0xf2, 0x65, 0x22, 0x34, 0x08, 0xb1, 0x7c,
-	0x11, 0x28, 0x6e, 0xcf, 0x98, 0x89, 0xfb, 0xf1, 0x39, 0x9f, 0x13, 0x53, 0x82, 0x16, 0x87, 0xdb,
-	0x63, 0x63, 0xe0, 0x61, 0x1e, 0x5d, 0xd0, 0x1e, 0xf6, 0x73, 0xc1, 0xca, 0x3c, 0xce, 0xf6, 0xb2,
-	0x78, 0x5a, 0x0d, 0x89, 0x71, 0xe3, 0x52, 0xda, 0xdf, 0x7a, 0x4f, 0xda, 0xf3, 0x18, 0xf7, 0xab,
-	0xbd, 0xf8, 0x94, 0x97, 0xa9, 0xa0, 0x1f, 0xa3, 0x41, 0x3a, 0x1f, 0xa3, 0x83, 0x7a, 0xbd, 0x6d,
-	0x97, 0xc9, 0x71, 0x7a, 0xca, 0x26, 0x01, 0x6f, 0x0d, 0xd2, 0xc3, 0x9b, 0x85, 0x7a, 0x3a, 0x6d,
-	0xcc, 0xe7, 0x65, 0xc2, 0xc8, 0x4e, 0x53, 0xe2, 0xce, 0x4e, 0xd3, 0x18, 0x78, 0xf8, 0xe9, 0x20,
-	0xfa, 0x3f, 0x25, 0xb5, 0x77, 0x4c, 0xbb, 0x71, 0x75, 0x7c, 0xc4, 0xe3, 0x72, 0x32, 0x7c, 0xcf,
-	0x67, 0xc7, 0x8b, 0x6a, 0xd7, 0xb7, 0x16, 0x51, 0xc1, 0x8f, 0xb5, 0xde, 0x00, 0x9b, 0x11, 0xe7,
-	0x7d, 0xac, 0x0e, 0x12, 0x7e, 0xac, 0x18, 0xc5, 0x13, 0x88, 0x94, 0xab, 0xfa, 0x69, 0x99, 0xd4,
-	0x77, 0x8b, 0xa8, 0x95, 0x4e, 0x0e, 0xcf, 0x8f, 0xb5, 0xd0, 0xcd, 0x96, 0x75, 0xca, 0x86, 0x3f,
-	0x63, 0x46, 0x7d, 0x71, 0xd2, 0xb3, 0x1e, 0x15, 0x61, 0xcf, 0xad, 0x91, 0x31, 0xea, 0x8b, 0x13,
-	0x9e, 0xad, 0x69, 0x2d, 0xe4, 0xd9, 0x33, 0xb5, 0x8d, 0xfa, 0xe2, 0x38, 0x81, 0xb6, 0x8b, 0x22,
-	0x3b, 0x3f, 0x64, 0xb3, 0x22, 0x23, 0x13, 0xc8, 0x41, 0xc2, 0x09, 0x84, 0x51, 0x5c, 0xfd, 0x1c,
-	0xf2, 0xba, 0xb6, 0xf2, 0x56, 0x3f, 0x52, 0x14, 0xae, 0x7e, 0x1a, 0x04, 0x17, 0x0c, 0x87, 0x7c,
-	0x87, 0x67, 0xf5, 0x26, 0xb1, 0x7d, 0xf4, 0xa8, 0x35, 0x0d, 0x11, 0x2e, 0x18, 0x10, 0x69, 0x8e,
-	0xc8, 0x9b, 0xea, 0x39, 0x2e, 0xd9, 0x9d, 0xf3, 0xfb, 0x69, 0x7e, 0x32, 0xf4, 0xaf, 0x8d, 0x06,
-	0x20, 0x8e, 0xc8, 0xbd, 0x20, 0xae, 0xd2, 0x9f, 0xe4, 0x13, 0xee, 0xaf, 0xd2, 0x6b, 0x49, 0xb8,
-	0x4a, 0x07, 0x02, 0x9b, 0x3c, 0x60, 0x94, 0xc9, 0x5a, 0x12, 0x36, 0x09, 0x84, 0x6f, 0x3e, 0x80,
-	0xbd, 0x1c, 0x39, 0x1f, 0xa0, 0xdd, 0xdb, 0x4a, 0x27, 0x87, 0x33, 0xb4, 0x29, 0xd7, 0xf7, 0x98,
-	0x48, 0x8e, 0xfd, 0x19, 0xea, 0x20, 0xe1, 0x0c, 0xc5, 0x28, 0x6e, 0xd2, 0x21, 0xd7, 0xdb, 0x8d,
-	0x65, 0x7f, 0x7e, 0xb4, 0xb6, 0x1a, 0x2b, 0x9d, 0x1c, 0x2e, 0xd7, 0xf7, 0x67, 0xf2, 0x99, 0x79,
-	0x93, 0x5c, 0xc9, 0xc2, 0xe5, 0xba, 0x66, 0x70, 0xf4, 0x4a, 0x50, 0x3f, 0x4e, 0x7f, 0xf4, 0x46,
-	0x1e, 0x8e, 0xde, 0xe1, 0xc0, 0xc9, 0x1f, 0x06, 0xd1, 0x25, 0xdb, 0xcb, 0x43, 0x5e, 0x8f, 0x91,
-	0xa7, 0x71, 0x96, 0xd6, 0x1b, 0xff, 0x43, 0x7e, 0xc2, 0xf2, 0xe1, 0x87, 0x81, 0x68, 0x15, 0x3f,
-	0x72, 0x14, 0x74, 0x14, 0x1f, 0x2d, 0xae, 0x88, 0xf3, 0x44, 0xd1, 0x4f, 0x2a, 0xb6, 0x13, 0x57,
-	0xc4, 0x4c, 0xe6, 0x20, 0xe1, 0x3c, 0xc1, 0x28, 0x2e, 0xda, 0x94, 0xfc, 0xee, 0x8b, 0x82, 0x95,
-	0x29, 0xcb, 0x13, 0xe6, 0x2f, 0xda, 0x30, 0x15, 0x2e, 0xda, 0x3c, 0x34, 0x6e, 0xa4, 0x99, 0x9c,
-	0xda, 0xb7, 0x07, 0x98, 0x08, 0xdc, 0x1e, 0x10, 0x28, 0x6e, 0xa4, 0x01, 0xe0, 0x00, 0xff, 0x66,
-	0xd8, 0x0a, 0x3a, 0xbc, 0x5f, 0xef, 0x49, 0xb7, 0xb6, 0xfd, 0x9a, 0x19, 0xd7, 0xc3, 0xa4, 0x23,
-	0xf4, 0xb1, 0x3d, 0x5c, 0xd6, 0x7a, 0xb1, 0xfe, 0x73, 0x86, 0x03, 0x96, 0xc5, 0x72, 0x09, 0x09,
-	0x9c, 0x33, 0x34, 0x4c, 0x9f, 0x73, 0x06, 0x8b, 0x05, 0x87, 0x3f, 0x1e, 0x44, 0x17, 0x7d, 0x1e,
-	0x1f, 0x15, 0xd2, 0xef, 0x66, 0xb7, 0x2d, 0x45, 0x12, 0xd7, 0x23, 0x61, 0x0d, 0x88, 0xe1, 0xfb,
-	0xd1, 0x1b, 0x8d, 0xc8, 0xdc, 0x9e, 0x40, 0x00, 0x6e, 0x15, 0xa1, 0xe3, 0xc7, 0x9c, 0x76, 0xbf,
-	0xd1, 0x9b, 0x37, 0x05, 0xba, 0x1b, 0x57, 0x85, 0x0a, 0x74, 0x6d, 0x03, 0xc4, 0x44, 0x81, 0xee,
-	0xc1, 0xf0, 0x4a, 0xdd, 0x20, 0xf5, 0x38, 0xf1, 0xcd, 0x71, 0xda, 0x84, 0x3d, 0x4a, 0x56, 0xbb,
-	0x41, 0x9c, 0x3b, 0x8d, 0x18, 0xea, 0xe2, 0x1b, 0x21, 0x0b, 0xa8, 0x36, 0x5e, 0xeb, 0xc5, 0x82,
-	0xc3, 0x1f, 0x46, 0x6f, 0xb6, 0x1a, 0xb6, 0xc7, 0x62, 0x31, 0x2f, 0xd9, 0x64, 0xb8, 0xd1, 0x11,
-	0x77, 0x03, 0x6a, 0xd7, 0x9b, 0xfd, 0x15, 0xc0, 0xff, 0xcf, 0x07, 0xd1, 0x5b, 0x2e, 0xa7, 0xba,
-	0x58, 0xc7, 0x70, 0x2b, 0x64, 0xd2, 0x65, 0x75, 0x18, 0x5b, 0x0b, 0xe9, 0xb4, 0xf6, 0x60, 0x76,
-	0x22, 0x6f, 0x9f, 0xc6, 0x69, 0x16, 0x1f, 0x65, 0xcc, 0xbb, 0x07, 0x73, 0x72, 0x53, 0xa3, 0xc1,
-	0x3d, 0x18, 0xa9, 0xd2, 0x9a, 0x25, 0xe5, 0x78, 0xb3, 0x6a, 0xf7, 0x9b, 0xf4, 0xa8, 0xf4, 0x94,
-	0xee, 0xeb, 0x3d, 0x69, 0x70, 0x2b, 0x9a, 0xb3, 0xab, 0xfa, 0x67, 0x3b, 0xc9, 0x7d, 0x5e, 0x41,
-	0xd5, 0x93, 0xe9, 0xeb, 0x3d, 0x69, 0xf0, 0xfa, 0x83, 0xe8, 0x8d, 0xb6, 0x57, 0x58, 0x14, 0x36,
-	0x3a, 0x4d, 0xa1, 0x75, 0x61, 0xb3, 0xbf, 0x82, 0x29, 0xf5, 0x3f, 0x4e, 0x2b, 0xc1, 0xcb, 0xf3,
-	0xf1, 0x31, 0x3f, 0x6b, 0xde, 0x81, 0x71, 0x47, 0x2b, 0x00, 0x23, 0x8b, 0x20, 0x4a, 0x7d, 0x3f,
-	0xd9, 0x72, 0x65, 0xde, 0x95, 0xa9, 0x08, 0x57, 0x16, 0xd1, 0xe1, 0xca, 0x25, 0xcd, 0x5c, 0xd5,
-	0xb4, 0xca, 0xbc, 0xd8, 0xb3, 0xe2, 0x0f, 0xb5, 0xfd, 0x72, 0xcf, 0x6a, 0x37, 0x68, 0xb6, 0x5f,
-	0x7b, 0x69, 0xc6, 0x1e, 0x3d, 0x7f, 0x9e, 0xf1, 0x78, 0x82, 0xb6, 0x5f, 0xb5, 0x64, 0x04, 0x22,
-	0x62, 0xfb, 0x85, 0x10, 0x33, 0x97, 0xd7, 0x82, 0x7a, 0x74, 0x34, 0x96, 0xaf, 0xb5, 0xd5, 0x2c,
-	0x31, 0x31, 0x97, 0x7b, 0x30, 0xb3, 0x75, 0xa9, 0x85, 0x4f, 0x0a, 0x69, 0xfc, 0x72, 0x5b, 0x4b,
-	0x49, 0x88, 0xad, 0x8b, 0x4b, 0x98, 0x12, 0xbc, 0xfe, 0x7d, 0x97, 0x9f, 0xe5, 0xd2, 0xa8, 0xa7,
-	0xa1, 0x8d, 0x8c, 0x28, 0xc1, 0x31, 0x03, 0x86, 0x3f, 0x89, 0xfe, 0x55, 0x1a, 0x2e, 0x79, 0x31,
-	0x5c, 0xf2, 0x28, 0x94, 0xd6, 0x15, 0xe0, 0x25, 0x52, 0x6e, 0x6e, 0xb2, 0xeb, 0x5f, 0xe5, 0x95,
-	0xd3, 0x93, 0x2a, 0x9e, 0x32, 0x74, 0x93, 0x2d, 0x55, 0x8c, 0x94, 0xb8, 0xc9, 0x6e, 0x53, 0xe6,
-	0xf8, 0xfb, 0x61, 0x7c, 0x9a, 0x4e, 0xf5, 0xdc, 0xa9, 0x86, 0x60, 0x85, 0x8e, 0xbf, 0x0d, 0x33,
-	0xb2, 0x20, 0xe2, 0xf8, 0x9b, 0x84, 0xc1, 0xe7, 0xef, 0x07, 0xd1, 0x65, 0xc3, 0xdc, 0x6b, 0x4e,
-	0x25, 0xf6, 0xf3, 0xe7, 0xfc, 0x59, 0x2a, 0x8e, 0xeb, 0x6d, 0x70, 0x35, 0xfc, 0x80, 0x32, 0xe9,
-	0xe7, 0x75, 0x28, 0x1f, 0x2e, 0xac, 0x67, 0x8a, 0xa1, 0xe6, 0xb4, 0x42, 0xcd, 0xb8, 0x7b, 0x25,
-	0x9f, 0x29, 0x0d, 0x54, 0x0c, 0xe9, 0x43, 0x0d, 0xcc, 0x11, 0xc5, 0x50, 0x88, 0xb7, 0x56, 0x54,
-	0xca, 0xbb, 0x5c, 0x47, 0x6e, 0xf5, 0xb3, 0xe8, 0xac, 0x26, 0x5b, 0x0b, 0xe9, 0x98, 0xeb, 0x7a,
-	0x1d, 0x48, 0xc6, 0x73, 0xfc, 0xfa, 0x81, 0xb1, 0x52, 0x0b, 0x89, 0xeb, 0xfa, 0x16, 0x64, 0x26,
-	0xb9, 0x46, 0xa4, 0xb6, 0xf8, 0xdb, 0x59, 0x86, 0x26, 0x39, 0xad, 0xaa, 0x01, 0x62, 0x92, 0xf3,
-	0x82, 0xe0, 0xe7, 0x20, 0x7a, 0xa5, 0xee, 0xdc, 0xc7, 0x25, 0x3b, 0x4d, 0x19, 0xbe, 0xe1, 0xb4,
-	0x24, 0xc4, 0x6c, 0xe1, 0x12, 0x66, 0x1c, 0x3e, 0xc9, 0xab, 0x22, 0x8b, 0xab, 0x63, 0xb8, 0x61,
-	0x73, 0xdb, 0xdc, 0x08, 0xf1, 0x1d, 0xdb, 0xb5, 0x0e, 0xca, 0x6c, 0xdb, 0x1b, 0x99, 0x9e, 0x90,
-	0x96, 0xfd, 0xaa, 0xad, 0x49, 0x69, 0xa5, 0x93, 0x33, 0x93, 0xff, 0x9d, 0x8c, 0x27, 0x27, 0x30,
-	0x8b, 0xba, 0xad, 0x96, 0x12, 0x3c, 0x8d, 0x5e, 0x09, 0x21, 0x66, 0x1e, 0x95, 0x82, 0x03, 0x56,
-	0x64, 0x71, 0x82, 0xef, 0x7e, 0x95, 0x0e, 0xc8, 0x88, 0x79, 0x14, 0x33, 0x28, 0x5c, 0xb8, 0x53,
-	0xf6, 0x85, 0x8b, 0xae, 0x94, 0xaf, 0x84, 0x10, 0xb3, 0x92, 0x48, 0xc1, 0xb8, 0xc8, 0x52, 0x81,
-	0x72, 0x43, 0x69, 0x48, 0x09, 0x91, 0x1b, 0x2e, 0x81, 0x4c, 0x3e, 0x60, 0xe5, 0x94, 0x79, 0x4d,
-	0x4a, 0x49, 0xd0, 0x64, 0x43, 0x80, 0xc9, 0x87, 0xd1, 0xbf, 0xa9, 0xb6, 0xf3, 0xe2, 0x7c, 0x78,
-	0xc9, 0xd7, 0x2c, 0x5e, 0x9c, 0x6b, 0x83, 0x97, 0x69, 0x00, 0x85, 0xf8, 0x38, 0xae, 0x84, 0x3f,
-	0x44, 0x29, 0x09, 0x86, 0xd8, 0x10, 0x66, 0x99, 0x53, 0x21, 0xce, 0x05, 0x5a, 0xe6, 0x20, 0x00,
-	0xeb, 0x22, 0xec, 0x12, 0x29, 0x37, 0xc3, 0x4b, 0xf5, 0x0a, 0x13, 0x7b, 0x29, 0xcb, 0x26, 0x15,
-	0x1a, 0x5e, 0xf0, 0xdc, 0x1b, 0x29, 0x31, 0xbc, 0xda, 0x14, 0x4a, 0x25, 0x38, 0xa1, 0xf4, 0xb5,
-	0x0e, 0x1d, 0x4e, 0x5e, 0x09, 0x21, 0x66, 0xd0, 0x36, 0x41, 0xef, 0xc4, 0x65, 0x99, 0xd6, 0xab,
-	0xf3, 0xb2, 0x3f, 0xa0, 0x46, 0x4e, 0x0c, 0x5a, 0x1f, 0x67, 0x6a, 0x2b, 0x29, 0xb5, 0x2e, 0x5c,
-	0x7c, 0x8d, 0xf6, 0xdc, 0xb7, 0x2c, 0x77, 0x61, 0xd6, 0x5b, 0x54, 0xda, 0xc5, 0x03, 0x7e, 0xca,
-	0x0e, 0xf9, 0xdd, 0x17, 0x69, 0x25, 0xd2, 0x7c, 0x0a, 0xeb, 0xdf, 0x16, 0x61, 0xc9, 0x07, 0x13,
-	0x6f, 0x51, 0x75, 0x2a, 0x99, 0x65, 0x18, 0xc5, 0xf2, 0x90, 0x9d, 0x79, 0x97, 0x61, 0x6c, 0x51,
-	0x73, 0xc4, 0x32, 0x1c, 0xe2, 0xcd, 0xc6, 0x5a, 0x3b, 0x87, 0x97, 0xa9, 0x0f, 0x79, 0x53, 0x11,
-	0x51, 0xd6, 0x30, 0x48, 0xec, 0x6d, 0x82, 0x0a, 0x66, 0xc3, 0xa1, 0xfd, 0x9b, 0x91, 0xb0, 0x4a,
-	0xd8, 0x69, 0x8f, 0x86, 0xeb, 0x3d, 0x48, 0x8f, 0x2b, 0x73, 0x6b, 0x48, 0xb9, 0x6a, 0x5f, 0x1a,
-	0x5e, 0xef, 0x41, 0x5a, 0x9b, 0x74, 0xbb, 0x59, 0x77, 0xe2, 0xe4, 0x64, 0x5a, 0xf2, 0x79, 0x3e,
-	0xd9, 0xe1, 0x19, 0x2f, 0xd1, 0x26, 0xdd, 0x89, 0x1a, 0xa1, 0xc4, 0x26, 0xbd, 0x43, 0xc5, 0x54,
-	0x1f, 0x76, 0x14, 0xdb, 0x59, 0x3a, 0xc5, 0x5b, 0x2c, 0xc7, 0x90, 0x04, 0x88, 0xea, 0xc3, 0x0b,
-	0x7a, 0x92, 0x48, 0x6d, 0xc1, 0x44, 0x9a, 0xc4, 0x99, 0xf2, 0xb7, 0x41, 0x9b, 0x71, 0xc0, 0xce,
-	0x24, 0xf2, 0x28, 0x78, 0xda, 0x79, 0x38, 0x2f, 0xf3, 0xfd, 0x5c, 0x70, 0xb2, 0x9d, 0x0d, 0xd0,
-	0xd9, 0x4e, 0x0b, 0x44, 0xb3, 0xdf, 0x21, 0x7b, 0x51, 0x47, 0x53, 0xff, 0xe3, 0x9b, 0xfd, 0xea,
-	0xdf, 0x47, 0x20, 0x0f, 0xcd, 0x7e, 0x88, 0x43, 0x8d, 0x01, 0x27, 0x2a, 0x61, 0x02, 0xda, 0x6e,
-	0x9a, 0xac, 0x76, 0x83, 0x7e, 0x3f, 0x63, 0x71, 0x9e, 0xb1, 0x90, 0x1f, 0x09, 0xf4, 0xf1, 0xd3,
-	0x80, 0xe6, 0xf4, 0xde, 0x69, 0xcf, 0x31, 0x4b, 0x4e, 0x5a, 0x2f, 0x41, 0xb8, 0x81, 0x2a, 0x84,
-	0x38, 0xbd, 0x27, 0x50, 0x7f, 0x17, 0xed, 0x27, 0x3c, 0x0f, 0x75, 0x51, 0x2d, 0xef, 0xd3, 0x45,
-	0xc0, 0x99, 0x2d, 0xa4, 0x96, 0x42, 0x66, 0xaa, 0x6e, 0x5a, 0x23, 0x2c, 0xd8, 0x10, 0xb1, 0x85,
-	0x24, 0x61, 0x73, 0xe4, 0x8a, 0x7d, 0x3e, 0x68, 0xbf, 0x16, 0xd8, 0xb2, 0xf2, 0x80, 0x7e, 0x2d,
-	0x90, 0x62, 0xe9, 0x46, 0xaa, 0x1c, 0xe9, 0xb0, 0xe2, 0xe6, 0xc9, 0xcd, 0x7e, 0xb0, 0x79, 0x25,
-	0xc0, 0xf1, 0xb9, 0x93, 0xb1, 0xb8, 0x54, 0x5e, 0xd7, 0x03, 0x86, 0x0c, 0x46, 0xbc, 0x12, 0x10,
-	0xc0, 0xd1, 0x14, 0xe6, 0x78, 0xde, 0xe1, 0xb9, 0x60, 0xb9, 0xf0, 0x4d, 0x61, 0xae, 0x31, 0x00,
-	0x43, 0x53, 0x18, 0xa5, 0x80, 0xf2, 0x56, 0x9e, 0x7c, 0x30, 0xf1, 0x30, 0x9e, 0x79, 0x0b, 0x2b,
-	0x75, 0xaa, 0xa1, 0xe4, 0xa1, 0xbc, 0x45, 0x1c, 0x1a, 0xf2, 0xfb, 0xb3, 0x78, 0xaa, 0xbd, 0x78,
-	0xb4, 0xa5, 0xbc, 0xe5, 0x66, 0xb5, 0x1b, 0x44, 0x7e, 0x9e, 0xa6, 0x13, 0xc6, 0x03, 0x7e, 0xa4,
-	0xbc, 0x8f, 0x1f, 0x0c, 0xa2, 0xca, 0xa9, 0x6e, 0xad, 0xda, 0xf4, 0x6c, 0xe7, 0x13, 0xd8, 0xea,
-	0x8d, 0x88, 0x87, 0x82, 0xb8, 0x50, 0xe5, 0x44, 0xf0, 0x68, 0x7c, 0x34, 0xc7, 0x80, 0xa1, 0xf1,
-	0xa1, 0x4f, 0xf9, 0xfa, 0x8c, 0x0f, 0x1f, 0x0c, 0x3e, 0xbf, 0x07, 0xe3, 0x63, 0x37, 0x16, 0x71,
-	0xbd, 0x59, 0x7f, 0x9a, 0xb2, 0x33, 0xd8, 0x2b, 0x7a, 0xda, 0xdb, 0x50, 0xa3, 0x1a, 0xc3, 0x1b,
-	0xc7, 0x8d, 0xde, 0x7c, 0xc0, 0x37, 0x54, 0xe7, 0x9d, 0xbe, 0x51, 0x99, 0xbe, 0xd1, 0x9b, 0x0f,
-	0xf8, 0x86, 0x17, 0xf8, 0x3b, 0x7d, 0xa3, 0xb7, 0xf8, 0x37, 0x7a, 0xf3, 0xe0, 0xfb, 0x27, 0x83,
-	0xe8, 0x62, 0xcb, 0x79, 0x5d, 0x03, 0x25, 0x22, 0x3d, 0x65, 0xbe, 0x52, 0xce, 0xb5, 0xa7, 0xd1,
-	0x50, 0x29, 0x47, 0xab, 0x40, 0x14, 0xbf, 0x18, 0x44, 0x6f, 0xf9, 0xa2, 0x78, 0xcc, 0xab, 0x54,
-	0xde, 0x5e, 0x6e, 0xf5, 0x30, 0xda, 0xc0, 0xa1, 0x0d, 0x4b, 0x48, 0xc9, 0xdc, 0xfd, 0x38, 0xa8,
-	0x79, 0xdf, 0xf0, 0x66, 0xc0, 0x5e, 0xfb, 0xb5, 0xc3, 0xf5, 0x9e, 0xb4, 0xb9, 0x85, 0x71, 0x18,
-	0xfb, 0xfa, 0x27, 0xd4, 0xab, 0xde, 0x1b, 0xa0, 0xcd, 0xfe, 0x0a, 0xe0, 0xfe, 0x67, 0x4d, 0x4d,
-	0x8f, 0xfd, 0xc3, 0x20, 0xb8, 0xd5, 0xc7, 0x22, 0x1a, 0x08, 0x5b, 0x0b, 0xe9, 0x40, 0x20, 0x7f,
-	0x1a, 0x44, 0x57, 0xbc, 0x81, 0xb8, 0x17, 0x81, 0xff, 0xdf, 0xc7, 0xb6, 0xff, 0x42, 0xf0, 0x6b,
-	0x5f, 0x45, 0x15, 0xa2, 0xfb, 0x55, 0xb3, 0xb5, 0x6e, 0x34, 0xe4, 0x3b, 0xe1, 0x8f, 0xca, 0x09,
-	0x2b, 0x61, 0xc4, 0x86, 0x92, 0xce, 0xc0, 0x78, 0xdc, 0xbe, 0xbf, 0xa0, 0x16, 0x84, 0xf3, 0x9b,
-	0x41, 0xb4, 0xe4, 0xc0, 0xf0, 0xc1, 0x8a, 0x15, 0x4f, 0xc8, 0xb2, 0x45, 0xe3, 0x80, 0x3e, 0x58,
-	0x54, 0x8d, 0x1a, 0xc9, 0x16, 0x2c, 0x3f, 0x78, 0xda, 0xea, 0x69, 0xd8, 0xf9, 0x04, 0xea, 0xf6,
-	0x62, 0x4a, 0x10, 0xcb, 0x9f, 0x07, 0xd1, 0x35, 0x87, 0x35, 0x27, 0xe5, 0xe8, 0x3c, 0xe4, 0xeb,
-	0x01, 0xfb, 0x94, 0x92, 0x0e, 0xee, 0x1b, 0x5f, 0x4d, 0xd9, 0x7c, 0xce, 0xeb, 0xa8, 0xec, 0xa5,
-	0x99, 0x60, 0x65, 0xfb, 0x73, 0x5e, 0xd7, 0xae, 0xa2, 0x46, 0xf4, 0xe7, 0xbc, 0x01, 0xdc, 0xfa,
-	0x9c, 0xd7, 0xe3, 0xd9, 0xfb, 0x39, 0xaf, 0xd7, 0x5a, 0xf0, 0x73, 0xde, 0xb0, 0x06, 0xb5, 0xf8,
-	0x34, 0x21, 0xa8, 0x83, 0xe7, 0x5e, 0x16, 0xdd, 0x73, 0xe8, 0x5b, 0x8b, 0xa8, 0x10, 0xcb, 0xaf,
-	0xe2, 0xe4, 0xeb, 0x49, 0x3d, 0x9e, 0xa9, 0xf3, 0x8a, 0xd2, 0x46, 0x6f, 0x1e, 0x7c, 0x7f, 0x06,
-	0xfb, 0x1e, 0xbd, 0xd8, 0xf0, 0x52, 0x7e, 0xca, 0xbd, 0x16, 0x5a, 0x3c, 0x6a, 0x0b, 0x76, 0xcf,
-	0xdf, 0xec, 0x07, 0x13, 0xcd, 0xad, 0x09, 0xe8, 0xf4, 0x51, 0x97, 0x21, 0xd4, 0xe5, 0x1b, 0xbd,
-	0x79, 0x62, 0x91, 0x53, 0xbe, 0x55, 0x6f, 0xf7, 0x30, 0xe6, 0xf6, 0xf5, 0x66, 0x7f, 0x05, 0xf3,
-	0x7e, 0x45, 0xcb, 0xbd, 0xec, 0xe7, 0xce, 0x27, 0xe8, 0xf4, 0xf2, 0x7a, 0x4f, 0x3a, 0x54, 0xdc,
-	0xd8, 0xcb, 0x7b, 0x57, 0x71, 0xe3, 0x5d, 0xe2, 0x6f, 0x2f, 0xa6, 0x04, 0xb1, 0xfc, 0x6e, 0x10,
-	0x5d, 0x22, 0x63, 0x81, 0x2c, 0xf8, 0xa0, 0xaf, 0x65, 0x94, 0x0d, 0x1f, 0x2e, 0xac, 0x07, 0x41,
-	0xfd, 0x71, 0x10, 0x5d, 0x0e, 0x04, 0xa5, 0xd2, 0x63, 0x01, 0xeb, 0x6e, 0x9a, 0x7c, 0xb4, 0xb8,
-	0x22, 0xb5, 0xd8, 0xdb, 0xf8, 0xb8, 0xfd, 0x95, 0x6b, 0xc0, 0xf6, 0x98, 0xfe, 0xca, 0xb5, 0x5b,
-	0x0b, 0x1f, 0xfe, 0xd4, 0x25, 0x09, 0xec, 0x8b, 0x7c, 0x87, 0x3f, 0xb2, 0x62, 0x41, 0xfb, 0xa1,
-	0x95, 0x4e, 0xce, 0xe7, 0xe4, 0xee, 0x8b, 0x22, 0xce, 0x27, 0xb4, 0x13, 0x25, 0xef, 0x76, 0xa2,
-	0x39, 0x7c, 0x68, 0x56, 0x4b, 0x0f, 0x78, 0xb3, 0xc9, 0xbb, 0x4e, 0xe9, 0x6b, 0x24, 0x78, 0x68,
-	0xd6, 0x42, 0x09, 0x6f, 0x50, 0xd1, 0x86, 0xbc, 0xa1, 0x42, 0xf6, 0x46, 0x1f, 0x14, 0x6d, 0x1f,
-	0xb4, 0x37, 0x7d, 0x16, 0x7f, 0x33, 0x64, 0xa5, 0x75, 0x1e, 0xbf, 0xde, 0x93, 0x26, 0xdc, 0x8e,
-	0x99, 0xf8, 0x98, 0xc5, 0x13, 0x56, 0x06, 0xdd, 0x6a, 0xaa, 0x97, 0x5b, 0x9b, 0xf6, 0xb9, 0xdd,
-	0xe1, 0xd9, 0x7c, 0x96, 0x43, 0x67, 0x92, 0x6e, 0x6d, 0xaa, 0xdb, 0x2d, 0xa2, 0xf1, 0x71, 0xa1,
-	0x71, 0x2b, 0x8b, 0xcb, 0x1b, 0x61, 0x33, 0x4e, 0x4d, 0xb9, 0xd6, 0x8b, 0xa5, 0xdb, 0x09, 0x69,
-	0xd4, 0xd1, 0x4e, 0x94, 0x49, 0xeb, 0x3d, 0x69, 0x7c, 0x6e, 0x67, 0xb9, 0xd5, 0xf9, 0xb4, 0xd1,
-	0x61, 0xab, 0x95, 0x52, 0x9b, 0xfd, 0x15, 0xf0, 0x29, 0x29, 0x64, 0x55, 0xbd, 0x2b, 0xda, 0x4b,
-	0xb3, 0x6c, 0xb8, 0x16, 0x48, 0x93, 0x06, 0x0a, 0x9e, 0x92, 0x7a, 0x60, 0x22, 0x93, 0x9b, 0x53,
-	0xc5, 0x7c, 0xd8, 0x65, 0x47, 0x52, 0xbd, 0x32, 0xd9, 0xa6, 0xd1, 0x69, 0x9b, 0xf5, 0xa8, 0x75,
-	0x6b, 0x47, 0xe1, 0x07, 0xd7, 0x6a, 0xf0, 0x46, 0x6f, 0x1e, 0xdd, 0x96, 0x4b, 0x4a, 0xae, 0x2c,
-	0x57, 0x29, 0x13, 0xce, 0x4a, 0x72, 0xad, 0x83, 0x42, 0x27, 0x96, 0x6a, 0x18, 0x3d, 0x4b, 0x27,
-	0x53, 0x26, 0xbc, 0x37, 0x48, 0x36, 0x10, 0xbc, 0x41, 0x42, 0x20, 0xea, 0x3a, 0xf5, 0xfb, 0x98,
-	0x89, 0xc3, 0xb8, 0x9c, 0x32, 0xb1, 0x3f, 0xf1, 0x75, 0x1d, 0x28, 0x5b, 0x54, 0xa8, 0xeb, 0xbc,
-	0x34, 0x9a, 0x0d, 0xb4, 0x5b, 0xf8, 0xa8, 0xf7, 0x46, 0xc8, 0x0c, 0xfa, 0xb2, 0x77, 0xad, 0x17,
-	0x8b, 0x56, 0x14, 0xe3, 0x30, 0x9d, 0xa5, 0xc2, 0xb7, 0xa2, 0x58, 0x36, 0x6a, 0x24, 0xb4, 0xa2,
-	0xb4, 0x51, 0xaa, 0x79, 0x75, 0x8d, 0xb0, 0x3f, 0x09, 0x37, 0x4f, 0x31, 0xfd, 0x9a, 0xa7, 0xd9,
-	0xd6, 0x85, 0x67, 0xae, 0x53, 0x46, 0x1c, 0xc3, 0x56, 0xd9, 0x93, 0xdb, 0xf2, 0x3b, 0x37, 0x0c,
-	0x86, 0x66, 0x1d, 0x4a, 0xc1, 0xfa, 0x94, 0x42, 0x73, 0xcd, 0x9d, 0x6c, 0x51, 0xb0, 0xb8, 0x8c,
-	0xf3, 0xc4, 0xbb, 0x35, 0x95, 0x06, 0x5b, 0x64, 0x68, 0x6b, 0x4a, 0x6a, 0xa0, 0xeb, 0x74, 0xf7,
-	0x0b, 0x35, 0xcf, 0x50, 0xd0, 0x9f, 0x82, 0xb9, 0x1f, 0xa8, 0x5d, 0xef, 0x41, 0xe2, 0xeb, 0xf4,
-	0x06, 0xd0, 0x87, 0xf2, 0xca, 0xe9, 0x7b, 0x01, 0x53, 0x2e, 0x1a, 0xda, 0x06, 0xd3, 0x2a, 0x28,
-	0xa9, 0x75, 0x81, 0xcb, 0xc4, 0x27, 0xec, 0xdc, 0x97, 0xd4, 0xa6, 0x3e, 0x95, 0x48, 0x28, 0xa9,
-	0xdb, 0x28, 0xaa, 0x33, 0xed, 0x7d, 0xd0, 0x72, 0x40, 0xdf, 0xde, 0xfa, 0xac, 0x74, 0x72, 0x68,
-	0xe4, 0xec, 0xa6, 0xa7, 0xce, 0x1d, 0x86, 0x27, 0xd0, 0xdd, 0xf4, 0xd4, 0x7f, 0x85, 0xb1, 0xd6,
-	0x8b, 0xc5, 0x57, 0xf5, 0xb1, 0x60, 0x2f, 0x9a, 0x3b, 0x74, 0x4f, 0xb8, 0x52, 0xde, 0xba, 0x44,
-	0x5f, 0xed, 0x06, 0xcd, 0x4b, 0x9d, 0x8f, 0x4b, 0x9e, 0xb0, 0xaa, 0xda, 0xa9, 0xd3, 0x36, 0x43,
-	0x2f, 0x75, 0x82, 0x6c, 0xa4, 0x84, 0xc4, 0x4b, 0x9d, 0x2d, 0x08, 0x6c, 0x7f, 0x1c, 0xbd, 0x7c,
-	0x9f, 0x4f, 0xc7, 0x2c, 0x9f, 0x0c, 0xdf, 0x76, 0x5f, 0xa3, 0xe4, 0xd3, 0x51, 0xfd, 0xb3, 0xb6,
-	0xb7, 0x44, 0x89, 0xcd, 0x3b, 0x6f, 0xbb, 0xec, 0x68, 0x3e, 0x3d, 0x2c, 0x19, 0x43, 0xef, 0xbc,
-	0xc9, 0xdf, 0x47, 0xb5, 0x80, 0x78, 0xe7, 0xcd, 0x01, 0xcc, 0x2a, 0xa9, 0xed, 0xd5, 0x85, 0x28,
-	0x7e, 0xa7, 0xcc, 0xe8, 0x48, 0x29, 0xb1, 0x4a, 0xb6, 0x29, 0xd3, 0x79, 0x52, 0x26, 0x5f, 0xab,
-	0x1e, 0xcf, 0x67, 0xb3, 0xb8, 0x3c, 0x47, 0x9d, 0xa7, 0x74, 0x6d, 0x80, 0xe8, 0x3c, 0x2f, 0x68,
-	0xb2, 0x52, 0xf9, 0x11, 0x71, 0x72, 0x72, 0x8f, 0x97, 0x7c, 0x2e, 0xd2, 0x9c, 0x55, 0x28, 0x2b,
-	0xc1, 0x82, 0xcb, 0x10, 0x59, 0x49, 0xb1, 0xa6, 0x8a, 0x93, 0x84, 0x7a, 0xdd, 0x4d, 0xfe, 0xc1,
-	0xab, 0x4a, 0xf0, 0x12, 0xdf, 0xe5, 0x29, 0x2b, 0x18, 0x22, 0xaa, 0x38, 0x12, 0x46, 0x7d, 0xff,
-	0x38, 0xcd, 0xa7, 0xde, 0xbe, 0xaf, 0x05, 0xc1, 0xbe, 0x07, 0xc0, 0xcc, 0xc7, 0xea, 0xa1, 0xa9,
-	0xbf, 0x81, 0x02, 0x1f, 0x98, 0x79, 0x1f, 0xba, 0x4d, 0x10, 0xf3, 0xb1, 0x9f, 0x44, 0xae, 0x1e,
-	0x15, 0x2c, 0x67, 0x93, 0xe6, 0x6d, 0x31, 0x9f, 0x2b, 0x87, 0x08, 0xba, 0xc2, 0xa4, 0x49, 0x85,
-	0x07, 0x4c, 0x94, 0x69, 0x52, 0x8d, 0x99, 0x78, 0x1c, 0x97, 0xf1, 0x8c, 0x09, 0x56, 0xe2, 0x54,
-	0x00, 0x64, 0xe4, 0x30, 0x44, 0x2a, 0x50, 0x2c, 0x38, 0xfc, 0x66, 0xf4, 0x7a, 0x3d, 0x73, 0xb1,
-	0x1c, 0xfe, 0x02, 0xe7, 0x5d, 0xf9, 0xc7, 0x69, 0x87, 0x17, 0xb4, 0x8d, 0xb1, 0x28, 0x59, 0x3c,
-	0x6b, 0x6c, 0xbf, 0xa6, 0x7f, 0x97, 0xe0, 0xe6, 0xe0, 0xce, 0x3b, 0x7f, 0xfb, 0x62, 0x69, 0xf0,
-	0xf9, 0x17, 0x4b, 0x83, 0x7f, 0x7c, 0xb1, 0x34, 0xf8, 0xed, 0x97, 0x4b, 0x2f, 0x7d, 0xfe, 0xe5,
-	0xd2, 0x4b, 0x7f, 0xff, 0x72, 0xe9, 0xa5, 0x4f, 0x5f, 0x86, 0x3f, 0x92, 0x7b, 0xf4, 0x2f, 0xf2,
-	0x4f, 0xdd, 0x6e, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x3f, 0xbd, 0x70, 0x48, 0x57, 0x00,
+	// 4561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9d, 0xdd, 0x6f, 0x1d, 0x49,
+	0x56, 0xc0, 0xe7, 0xbe, 0x30, 0xd0, 0xcb, 0x0e, 0x70, 0x77, 0x77, 0x98, 0x0d, 0xbb, 0xce, 0xc7,
+	0x24, 0xb6, 0x13, 0xc7, 0xed, 0x4c, 0x32, 0x3b, 0xb3, 0x7c, 0x48, 0xc8, 0xb1, 0x63, 0x8f, 0xb5,
+	0x49, 0x1c, 0xee, 0xb5, 0x27, 0xd2, 0x48, 0x48, 0xb4, 0xfb, 0x56, 0xee, 0x6d, 0xdc, 0xb7, 0xab,
+	0xb7, 0xbb, 0xae, 0x13, 0x83, 0x40, 0x20, 0x10, 0x68, 0x11, 0x08, 0xc4, 0xc7, 0x13, 0x6f, 0xfc,
+	0x35, 0xbc, 0x20, 0xed, 0x23, 0x8f, 0x68, 0xe6, 0x1f, 0x41, 0x5d, 0x55, 0x5d, 0x1f, 0xa7, 0xcf,
+	0xa9, 0xee, 0x3b, 0x0f, 0xab, 0x59, 0xf9, 0xfc, 0xce, 0x39, 0xf5, 0x75, 0xaa, 0x4e, 0x7d, 0xf4,
+	0x4d, 0x74, 0xb3, 0xbc, 0xd8, 0x2b, 0x2b, 0x2e, 0x78, 0xbd, 0x57, 0xb3, 0xea, 0x2a, 0x4b, 0x59,
+	0xfb, 0xdf, 0x58, 0xfe, 0x79, 0xfc, 0x7e, 0x52, 0x5c, 0x8b, 0xeb, 0x92, 0xdd, 0xf8, 0xc8, 0x92,
+	0x29, 0x5f, 0x2e, 0x93, 0x62, 0x56, 0x2b, 0xe4, 0xc6, 0x87, 0x56, 0xc2, 0xae, 0x58, 0x21, 0xf4,
+	0xdf, 0x1f, 0xff, 0xe2, 0x7f, 0x46, 0xd1, 0x07, 0x07, 0x79, 0xc6, 0x0a, 0x71, 0xa0, 0x35, 0xc6,
+	0x5f, 0x45, 0xdf, 0xdd, 0x2f, 0xcb, 0x63, 0x26, 0xbe, 0x64, 0x55, 0x9d, 0xf1, 0x62, 0xfc, 0x71,
+	0xac, 0x1d, 0xc4, 0x93, 0x32, 0x8d, 0xf7, 0xcb, 0x32, 0xb6, 0xc2, 0x78, 0xc2, 0x7e, 0xbe, 0x62,
+	0xb5, 0xb8, 0x71, 0x37, 0x0c, 0xd5, 0x25, 0x2f, 0x6a, 0x36, 0x7e, 0x13, 0xfd, 0xd6, 0x7e, 0x59,
+	0x4e, 0x99, 0x38, 0x64, 0x4d, 0x05, 0xa6, 0x22, 0x11, 0x6c, 0xbc, 0xd5, 0x51, 0xf5, 0x01, 0xe3,
+	0x63, 0xbb, 0x1f, 0xd4, 0x7e, 0xce, 0xa2, 0xef, 0x34, 0x7e, 0x16, 0x2b, 0x31, 0xe3, 0x6f, 0x8b,
+	0xf1, 0xed, 0xae, 0xa2, 0x16, 0x19, 0xdb, 0x77, 0x42, 0x88, 0xb6, 0xfa, 0x3a, 0xfa, 0xf5, 0xd7,
+	0x49, 0x9e, 0x33, 0x71, 0x50, 0xb1, 0xa6, 0xe0, 0xbe, 0x8e, 0x12, 0xc5, 0x4a, 0x66, 0xec, 0x7e,
+	0x1c, 0x64, 0xb4, 0xe1, 0xaf, 0xa2, 0xef, 0x2a, 0xc9, 0x84, 0xa5, 0xfc, 0x8a, 0x55, 0x63, 0x54,
+	0x4b, 0x0b, 0x89, 0x26, 0xef, 0x40, 0xd0, 0xf6, 0x01, 0x2f, 0xae, 0x58, 0x25, 0x70, 0xdb, 0x5a,
+	0x18, 0xb6, 0x6d, 0x21, 0x6d, 0x3b, 0x8f, 0xbe, 0xe7, 0x36, 0xc8, 0x94, 0xd5, 0x72, 0xc0, 0xdc,
+	0xa7, 0xeb, 0xac, 0x11, 0xe3, 0xe7, 0xc1, 0x10, 0x54, 0x7b, 0xcb, 0xa2, 0xb1, 0xf6, 0x96, 0xf3,
+	0xda, 0x38, 0xdb, 0x46, 0x2d, 0x38, 0x84, 0xf1, 0x75, 0x7f, 0x00, 0xa9, 0x5d, 0xfd, 0x49, 0xf4,
+	0x1b, 0xaf, 0x79, 0x75, 0x59, 0x97, 0x49, 0xca, 0x74, 0x67, 0xdf, 0xf3, 0xb5, 0x5b, 0x29, 0xec,
+	0xef, 0xcd, 0x3e, 0xcc, 0xe9, 0x96, 0x56, 0x78, 0x5a, 0x32, 0x18, 0x65, 0x56, 0xb1, 0x11, 0x52,
+	0xdd, 0x02, 0x21, 0x6d, 0xfb, 0x32, 0x1a, 0x5b, 0xdb, 0x17, 0x7f, 0xca, 0x52, 0xb1, 0x3f, 0x9b,
+	0xc1, 0x5e, 0xb1, 0xba, 0x92, 0x88, 0xf7, 0x67, 0x33, 0xaa, 0x57, 0x70, 0x54, 0x3b, 0x7b, 0x1b,
+	0x7d, 0x08, 0x9c, 0x3d, 0xcf, 0x6a, 0xe9, 0x70, 0x37, 0x6c, 0x45, 0x63, 0xc6, 0x69, 0x3c, 0x14,
+	0xd7, 0x8e, 0xff, 0x6a, 0x14, 0xfd, 0x10, 0xf1, 0x3c, 0x61, 0x4b, 0x7e, 0xc5, 0xc6, 0x8f, 0xfa,
+	0xad, 0x29, 0xd2, 0xf8, 0xff, 0x64, 0x0d, 0x0d, 0x64, 0x98, 0x4c, 0x59, 0xce, 0x52, 0x41, 0x0e,
+	0x13, 0x25, 0xee, 0x1d, 0x26, 0x06, 0x73, 0x22, 0xac, 0x15, 0x1e, 0x33, 0x71, 0xb0, 0xaa, 0x2a,
+	0x56, 0x08, 0xb2, 0x2f, 0x2d, 0xd2, 0xdb, 0x97, 0x1e, 0x8a, 0xd4, 0xe7, 0x98, 0x89, 0xfd, 0x3c,
+	0x27, 0xeb, 0xa3, 0xc4, 0xbd, 0xf5, 0x31, 0x98, 0xf6, 0x90, 0x46, 0xbf, 0xe9, 0xb4, 0x98, 0x38,
+	0x29, 0xde, 0xf0, 0x31, 0xdd, 0x16, 0x52, 0x6e, 0x7c, 0x6c, 0xf5, 0x72, 0x48, 0x35, 0x9e, 0xbd,
+	0x2b, 0x79, 0x45, 0x77, 0x8b, 0x12, 0xf7, 0x56, 0xc3, 0x60, 0xda, 0xc3, 0x1f, 0x47, 0x1f, 0xec,
+	0xa7, 0x29, 0x5f, 0x15, 0x66, 0xc6, 0x06, 0xeb, 0x9f, 0x12, 0x76, 0xa6, 0xec, 0x7b, 0x3d, 0x94,
+	0x9d, 0x1c, 0xb4, 0x4c, 0x4f, 0x3e, 0x1f, 0xa3, 0x7a, 0x60, 0xea, 0xb9, 0x1b, 0x86, 0x3a, 0xb6,
+	0x0f, 0x59, 0xce, 0x48, 0xdb, 0x4a, 0xd8, 0x63, 0xdb, 0x40, 0xda, 0x76, 0x15, 0xfd, 0xc0, 0x34,
+	0x4b, 0xb3, 0x52, 0x48, 0x79, 0x33, 0x49, 0xef, 0x10, 0xf5, 0x76, 0x21, 0xe3, 0xeb, 0xe1, 0x30,
+	0xb8, 0x53, 0x1f, 0x1d, 0x81, 0x78, 0x7d, 0x40, 0xfc, 0xdd, 0x0d, 0x43, 0xda, 0xf6, 0x3f, 0x8c,
+	0xa2, 0x1f, 0x6b, 0xd9, 0xb3, 0x22, 0xb9, 0xc8, 0xd9, 0x73, 0x9e, 0x26, 0xf9, 0x4b, 0x26, 0xde,
+	0xf2, 0xea, 0x72, 0x7a, 0x5d, 0xa4, 0xe3, 0x27, 0xa8, 0x1d, 0x1c, 0x36, 0xce, 0x3f, 0x5d, 0x4f,
+	0xc9, 0xc9, 0x69, 0x74, 0x45, 0x05, 0x2f, 0x61, 0x4e, 0xd3, 0xd6, 0x40, 0xf0, 0x92, 0xca, 0x69,
+	0x7c, 0xa4, 0x63, 0xf5, 0x45, 0x33, 0x6d, 0xe2, 0x56, 0x5f, 0xb8, 0xf3, 0xe4, 0x9d, 0x10, 0x62,
+	0xa7, 0xad, 0x76, 0x00, 0xf3, 0xe2, 0x4d, 0x36, 0x3f, 0x2f, 0x67, 0xcd, 0x30, 0xbe, 0x8f, 0x8f,
+	0x50, 0x07, 0x21, 0xa6, 0x2d, 0x02, 0xd5, 0xde, 0xfe, 0x69, 0x14, 0x6d, 0xf8, 0xe1, 0x78, 0x54,
+	0xf1, 0xe5, 0x73, 0x36, 0x4f, 0xd2, 0x6b, 0x1d, 0xff, 0x9f, 0x86, 0x02, 0x0f, 0xd2, 0xa6, 0x10,
+	0x3f, 0x59, 0x53, 0xcb, 0xb6, 0xe9, 0xb4, 0x4c, 0x52, 0xa6, 0x03, 0xcc, 0x6f, 0x53, 0x29, 0x81,
+	0xe1, 0x75, 0x27, 0x84, 0x68, 0xab, 0x7f, 0x14, 0x45, 0x6a, 0x29, 0x92, 0xe9, 0xc2, 0x2d, 0x4f,
+	0x43, 0x09, 0xfc, 0x5c, 0xe1, 0x76, 0x80, 0xb0, 0x05, 0x55, 0x7f, 0x97, 0x59, 0xd0, 0x18, 0xd5,
+	0x90, 0x22, 0xa2, 0xa0, 0x00, 0x81, 0x05, 0x9d, 0x2e, 0xf8, 0x5b, 0xbc, 0xa0, 0x8d, 0x24, 0x5c,
+	0x50, 0x4d, 0xd8, 0xcc, 0x5b, 0x17, 0x14, 0xcb, 0xbc, 0xdb, 0x62, 0x84, 0x32, 0x6f, 0xc8, 0x68,
+	0xc3, 0x3c, 0xfa, 0xbe, 0x6b, 0xf8, 0x29, 0xe7, 0x97, 0xcb, 0xa4, 0xba, 0x1c, 0x3f, 0xa0, 0x95,
+	0x5b, 0xc6, 0x38, 0xda, 0x19, 0xc4, 0xda, 0xb5, 0xc9, 0x75, 0x38, 0x65, 0x70, 0x6d, 0xf2, 0xf4,
+	0xa7, 0x8c, 0x5a, 0x9b, 0x10, 0x0c, 0x76, 0xea, 0x71, 0x95, 0x94, 0x0b, 0xbc, 0x53, 0xa5, 0x28,
+	0xdc, 0xa9, 0x2d, 0xa2, 0xad, 0xbe, 0x8b, 0x7e, 0xdb, 0xb1, 0xfa, 0x92, 0x65, 0xf3, 0xc5, 0x05,
+	0xaf, 0x16, 0x9c, 0xc3, 0x3c, 0xcf, 0x55, 0x77, 0x31, 0x22, 0xcf, 0x0b, 0xe0, 0xb0, 0xc5, 0x24,
+	0xf3, 0x2a, 0x11, 0x0b, 0xbc, 0xc5, 0x8c, 0x38, 0xdc, 0x62, 0x2e, 0x66, 0x37, 0x16, 0x8e, 0x87,
+	0xd3, 0xaa, 0x5c, 0x24, 0x45, 0x0d, 0x36, 0x16, 0xae, 0xb6, 0x26, 0x88, 0x8d, 0x05, 0x4e, 0xc2,
+	0xf1, 0x76, 0xb8, 0x2a, 0xf3, 0x2c, 0x4d, 0x04, 0xab, 0x9b, 0xc4, 0x12, 0x1f, 0x6f, 0x3e, 0x13,
+	0x1e, 0x6f, 0x1d, 0x16, 0x8e, 0x86, 0x17, 0xac, 0x9a, 0x13, 0x21, 0x2e, 0x45, 0xe1, 0xd1, 0xd0,
+	0x22, 0x76, 0x7e, 0x57, 0x82, 0x2f, 0xb2, 0x5a, 0xf0, 0xea, 0x5a, 0x2d, 0xce, 0x63, 0xb4, 0x21,
+	0x3c, 0x84, 0x98, 0xdf, 0x09, 0x14, 0x46, 0xff, 0x94, 0x25, 0x55, 0xba, 0xc0, 0xa3, 0x5f, 0xc9,
+	0xc2, 0xd1, 0x6f, 0x18, 0xd8, 0x1b, 0x4a, 0x30, 0x65, 0xcb, 0xa4, 0x10, 0x59, 0x8a, 0xf7, 0x86,
+	0xcf, 0x84, 0x7b, 0xa3, 0xc3, 0xc2, 0x76, 0x7b, 0x9a, 0xa4, 0x97, 0x79, 0x56, 0x5c, 0xaa, 0xde,
+	0x47, 0xdb, 0xcd, 0x43, 0xc2, 0xed, 0x06, 0x51, 0x9b, 0x8e, 0x79, 0xd5, 0x5b, 0x5d, 0xd4, 0x69,
+	0x95, 0x5d, 0xb0, 0x71, 0xa8, 0xcc, 0x2d, 0x44, 0xa4, 0x63, 0x24, 0x0c, 0x63, 0xc9, 0xc8, 0x4e,
+	0x66, 0x44, 0x2c, 0xb9, 0x44, 0x38, 0x96, 0x00, 0x09, 0xab, 0x77, 0x5c, 0xf1, 0x55, 0x59, 0xf7,
+	0x54, 0x0f, 0x40, 0xe1, 0xea, 0x75, 0x61, 0x38, 0x0d, 0xaa, 0x06, 0x38, 0x2f, 0x6a, 0xe3, 0x75,
+	0x97, 0x6e, 0x27, 0x07, 0x0b, 0x4f, 0x83, 0x18, 0x6e, 0x77, 0x4e, 0xad, 0x67, 0x71, 0xc8, 0x44,
+	0x92, 0xe5, 0xf5, 0x78, 0x13, 0xb7, 0xd1, 0xca, 0x89, 0x9d, 0x13, 0xc6, 0xc1, 0xb9, 0xd6, 0x4c,
+	0x27, 0xf8, 0x5c, 0x6b, 0xc4, 0xe1, 0xb9, 0xd6, 0xc5, 0x60, 0x04, 0x4c, 0x99, 0x50, 0xff, 0xe7,
+	0xec, 0xba, 0x64, 0x78, 0x04, 0x78, 0x48, 0x38, 0x02, 0x20, 0x0a, 0xeb, 0x33, 0x65, 0xe2, 0x79,
+	0x72, 0xcd, 0x57, 0xc4, 0x6a, 0x6b, 0xc4, 0xe1, 0xfa, 0xb8, 0x98, 0xf6, 0xb0, 0x8a, 0x3e, 0x34,
+	0x1e, 0x4e, 0x0a, 0xc1, 0xaa, 0x22, 0xc9, 0x8f, 0xf2, 0x64, 0x5e, 0x8f, 0x89, 0xb8, 0xf1, 0x29,
+	0xe3, 0x6f, 0x77, 0x20, 0x8d, 0x34, 0xe3, 0x49, 0x7d, 0x94, 0x5c, 0xf1, 0x2a, 0x13, 0x74, 0x33,
+	0x5a, 0xa4, 0xb7, 0x19, 0x3d, 0x14, 0xf5, 0xb6, 0x5f, 0xa5, 0x8b, 0xec, 0x8a, 0xcd, 0x02, 0xde,
+	0x5a, 0x64, 0x80, 0x37, 0x07, 0x45, 0x3a, 0x6d, 0xca, 0x57, 0x55, 0xca, 0xc8, 0x4e, 0x53, 0xe2,
+	0xde, 0x4e, 0x33, 0x98, 0xf6, 0xf0, 0xb7, 0xa3, 0xe8, 0x77, 0x94, 0xd4, 0x3d, 0x8c, 0x38, 0x4c,
+	0xea, 0xc5, 0x05, 0x4f, 0xaa, 0xd9, 0xf8, 0x13, 0xcc, 0x0e, 0x8a, 0x1a, 0xd7, 0x8f, 0xd7, 0x51,
+	0x81, 0xcd, 0xda, 0x4c, 0xdb, 0x36, 0xe2, 0xd0, 0x66, 0xf5, 0x90, 0x70, 0xb3, 0x42, 0x14, 0x4e,
+	0x20, 0x52, 0xae, 0xb6, 0x26, 0x9b, 0xa4, 0xbe, 0xbf, 0x3f, 0xd9, 0xea, 0xe5, 0xe0, 0xfc, 0xd8,
+	0x08, 0xfd, 0xd1, 0xb2, 0x4b, 0xd9, 0xc0, 0x47, 0x4c, 0x3c, 0x14, 0x27, 0x3d, 0x9b, 0xa8, 0x08,
+	0x7b, 0xee, 0x44, 0x46, 0x3c, 0x14, 0x27, 0x3c, 0x3b, 0xd3, 0x5a, 0xc8, 0x33, 0x32, 0xb5, 0xc5,
+	0x43, 0x71, 0x98, 0xbf, 0x68, 0xa6, 0x5d, 0x17, 0x1e, 0x04, 0xec, 0xc0, 0xb5, 0x61, 0x67, 0x10,
+	0xab, 0x1d, 0xfe, 0x65, 0xf4, 0x43, 0xeb, 0xf0, 0xac, 0x4a, 0x8a, 0xfa, 0x0d, 0xaf, 0x96, 0x4f,
+	0x73, 0x9e, 0x5e, 0xd6, 0xe3, 0x3d, 0xca, 0x12, 0x00, 0x8d, 0xeb, 0x47, 0xc3, 0x15, 0x60, 0xc4,
+	0xec, 0x97, 0x65, 0x7e, 0x7d, 0xc6, 0x96, 0x65, 0x4e, 0x46, 0x8c, 0x87, 0x84, 0x23, 0x06, 0xa2,
+	0x30, 0x77, 0x3e, 0xe3, 0xcd, 0x3e, 0x0d, 0xcd, 0x9d, 0xa5, 0x28, 0x9c, 0x3b, 0xb7, 0x08, 0xcc,
+	0x90, 0xce, 0xf8, 0x01, 0xcf, 0x73, 0x96, 0x8a, 0xee, 0x35, 0x86, 0xd1, 0xb4, 0x44, 0x38, 0x43,
+	0x02, 0xa4, 0xbd, 0x6e, 0x6b, 0x77, 0xe2, 0x49, 0xc5, 0x9e, 0x5e, 0x3f, 0xcf, 0x8a, 0xcb, 0x31,
+	0x9e, 0x0c, 0x58, 0x80, 0xb8, 0x6e, 0x43, 0x41, 0xb8, 0xe3, 0x3f, 0x2f, 0x66, 0x1c, 0xdf, 0xf1,
+	0x37, 0x92, 0xf0, 0x8e, 0x5f, 0x13, 0xd0, 0xe4, 0x84, 0x51, 0x26, 0x27, 0xac, 0xcf, 0xe4, 0x84,
+	0xb9, 0x26, 0xbd, 0x09, 0x50, 0x9f, 0x0b, 0x91, 0x13, 0x20, 0x38, 0x09, 0xda, 0xea, 0xe5, 0x3a,
+	0x19, 0xbe, 0xde, 0xfa, 0x1f, 0x31, 0x91, 0x2e, 0x88, 0x0c, 0xdf, 0x45, 0x7a, 0x32, 0x7c, 0x80,
+	0xc2, 0x2a, 0x9d, 0xf1, 0x96, 0xc0, 0xab, 0x64, 0xe5, 0xe1, 0x2a, 0x79, 0x1c, 0xdc, 0x7e, 0x9d,
+	0x2c, 0x65, 0x9b, 0xa1, 0x83, 0x5c, 0xc9, 0xc2, 0xdb, 0x2f, 0xc3, 0xc0, 0xd2, 0x2b, 0x81, 0xdc,
+	0x0a, 0x6d, 0xd2, 0x8a, 0xde, 0x3e, 0x68, 0xab, 0x97, 0xd3, 0x4e, 0xfe, 0x7d, 0x14, 0xdd, 0x74,
+	0xbd, 0xbc, 0xe4, 0x4d, 0x8c, 0x7c, 0x99, 0xe4, 0xd9, 0x2c, 0x11, 0xec, 0x8c, 0x5f, 0xb2, 0x62,
+	0xfc, 0x79, 0xa0, 0xb4, 0x8a, 0x8f, 0x3d, 0x05, 0x53, 0x8a, 0x9f, 0xae, 0xaf, 0x88, 0xd7, 0x5d,
+	0x06, 0x4e, 0xa0, 0xee, 0x5e, 0xf8, 0x6c, 0xf5, 0x72, 0x70, 0xaa, 0x51, 0xc2, 0x09, 0xab, 0x57,
+	0x4b, 0x86, 0x4f, 0x35, 0x2e, 0x11, 0x9e, 0x6a, 0x00, 0xa9, 0x5d, 0xfd, 0xf5, 0x28, 0xba, 0xe1,
+	0xfa, 0x7a, 0x95, 0xaf, 0xe6, 0x59, 0x31, 0x61, 0xf3, 0xac, 0x16, 0xac, 0x1a, 0x3f, 0xa2, 0x2d,
+	0xf9, 0x24, 0x71, 0x1d, 0x17, 0xd6, 0xd0, 0x65, 0xf8, 0xfb, 0x51, 0xf4, 0xa3, 0x6e, 0x19, 0xce,
+	0x8b, 0xaa, 0x2d, 0xc5, 0xe3, 0x3e, 0x9b, 0x96, 0x35, 0xe5, 0x78, 0xb2, 0x96, 0x0e, 0x4c, 0x09,
+	0xec, 0x88, 0x7c, 0x56, 0x88, 0x2a, 0x63, 0x35, 0x9e, 0x12, 0x74, 0xb0, 0x70, 0x4a, 0x80, 0xe1,
+	0x70, 0xfe, 0xd1, 0xe3, 0xa1, 0x66, 0x07, 0x49, 0x4d, 0xac, 0x90, 0x1e, 0x12, 0x9e, 0x7f, 0x20,
+	0x0a, 0x77, 0x3f, 0x4a, 0xfe, 0xec, 0x5d, 0xc9, 0xaa, 0x8c, 0x15, 0x29, 0xc3, 0x77, 0x3f, 0x90,
+	0x0a, 0xef, 0x7e, 0x10, 0x1a, 0x56, 0xd2, 0x2e, 0x7a, 0xdd, 0x1b, 0x6e, 0x48, 0x04, 0x6e, 0xb8,
+	0x09, 0x14, 0x56, 0xd2, 0x02, 0xfa, 0x92, 0xf9, 0x61, 0xd8, 0x0a, 0xb8, 0x60, 0xde, 0x1d, 0x48,
+	0x77, 0x8e, 0xa6, 0x0d, 0x33, 0x6d, 0xa6, 0xdf, 0x9e, 0xa2, 0x4f, 0xdd, 0x69, 0x78, 0x67, 0x10,
+	0x8b, 0x9f, 0x85, 0x4f, 0x58, 0x9e, 0x34, 0x54, 0xe8, 0x2c, 0xbc, 0x65, 0x86, 0x9c, 0x85, 0x3b,
+	0x6c, 0x67, 0xce, 0xf0, 0x89, 0xd3, 0x52, 0xfa, 0x7d, 0xd4, 0x6f, 0xeb, 0xb4, 0xf4, 0xbc, 0x7f,
+	0xb2, 0x86, 0x86, 0x2e, 0xc3, 0x9f, 0x47, 0x1f, 0xb5, 0x22, 0x7b, 0xc3, 0xaf, 0x0b, 0xe0, 0xc7,
+	0x9e, 0x29, 0x3f, 0xe4, 0x8c, 0xfb, 0xbd, 0xc1, 0xbc, 0xdd, 0xe9, 0xfa, 0xe5, 0xaa, 0xc1, 0x4e,
+	0xd7, 0xd8, 0xd0, 0x62, 0x62, 0xa7, 0x8b, 0x60, 0x30, 0x03, 0x6c, 0x91, 0x26, 0x4e, 0xb0, 0xf5,
+	0xc3, 0x98, 0x70, 0xa3, 0x64, 0xbb, 0x1f, 0x84, 0x63, 0xa7, 0x15, 0xeb, 0x0d, 0xe6, 0x83, 0x90,
+	0x05, 0xb0, 0xc9, 0xdc, 0x19, 0xc4, 0xc2, 0x9d, 0x88, 0x53, 0xb1, 0x23, 0x96, 0x88, 0x55, 0xc5,
+	0x66, 0xe8, 0x4e, 0xc4, 0x2d, 0x77, 0x0b, 0x06, 0x77, 0x22, 0x84, 0x42, 0x67, 0xad, 0x69, 0x39,
+	0xd5, 0xc5, 0xa6, 0x0c, 0x8f, 0x43, 0x26, 0x7d, 0x36, 0xb8, 0xd6, 0xd0, 0x3a, 0x9d, 0xc3, 0x0c,
+	0x77, 0x20, 0xef, 0x5f, 0x25, 0x59, 0x9e, 0x5c, 0xe4, 0x0c, 0x3d, 0xcc, 0xf0, 0xc6, 0xa6, 0x41,
+	0x83, 0x87, 0x19, 0xa4, 0x4a, 0x67, 0x96, 0x94, 0xf1, 0xe6, 0x6c, 0x82, 0x1f, 0xd2, 0x51, 0x89,
+	0xec, 0x81, 0x77, 0x07, 0xd2, 0xda, 0xad, 0x88, 0x7e, 0x60, 0xff, 0xec, 0x0e, 0x72, 0xcc, 0xab,
+	0x56, 0x45, 0x46, 0xfa, 0xee, 0x40, 0x5a, 0x7b, 0xfd, 0x8b, 0xe8, 0xa3, 0xae, 0x57, 0xbd, 0x28,
+	0xec, 0xf5, 0x9a, 0x02, 0xeb, 0xc2, 0xa3, 0xe1, 0x0a, 0x36, 0xaf, 0xd3, 0x57, 0x25, 0xcd, 0x35,
+	0x69, 0xfb, 0x4e, 0xd3, 0x8f, 0x56, 0x0d, 0xc4, 0x0e, 0x41, 0xe4, 0x75, 0x38, 0xd9, 0x71, 0x65,
+	0xdf, 0x73, 0xd6, 0x84, 0x2b, 0x87, 0xe8, 0x71, 0xe5, 0x93, 0x76, 0xae, 0x6a, 0x6b, 0x65, 0xc4,
+	0x60, 0xae, 0x32, 0x45, 0xed, 0x3e, 0x40, 0xdd, 0xee, 0x07, 0xed, 0xb6, 0xfe, 0x28, 0xcb, 0xd9,
+	0xe9, 0x9b, 0x37, 0x39, 0x4f, 0x66, 0x60, 0x5b, 0xdf, 0x48, 0x62, 0x2d, 0x22, 0xb6, 0xf5, 0x00,
+	0xb1, 0x73, 0x79, 0x23, 0x68, 0xa2, 0xa3, 0xb5, 0x7c, 0xaf, 0xab, 0xe6, 0x88, 0x89, 0xb9, 0x1c,
+	0xc1, 0xec, 0x96, 0xb8, 0x11, 0x9e, 0x97, 0xd2, 0xf8, 0xad, 0xae, 0xd6, 0x79, 0xe9, 0xd9, 0xbd,
+	0x1d, 0x20, 0xec, 0xd6, 0xae, 0xf9, 0xfb, 0x21, 0x7f, 0x5b, 0x48, 0xa3, 0x48, 0x45, 0x5b, 0x19,
+	0xb1, 0xb5, 0x83, 0x8c, 0x36, 0xfc, 0xb3, 0xe8, 0x57, 0xa5, 0xe1, 0x8a, 0x97, 0xe3, 0x0d, 0x44,
+	0xa1, 0x72, 0x9e, 0xa9, 0xdc, 0x24, 0xe5, 0xf6, 0xb5, 0x55, 0xf3, 0x57, 0xf9, 0x2c, 0xe2, 0xbc,
+	0x4e, 0xe6, 0x0c, 0xbc, 0xb6, 0x92, 0x2a, 0x56, 0x4a, 0xbc, 0xb6, 0xea, 0x52, 0xda, 0xfc, 0xcb,
+	0xe8, 0xd7, 0x1a, 0xd9, 0x64, 0x55, 0x1c, 0x1f, 0x8c, 0x91, 0xc2, 0x48, 0x81, 0x31, 0x7a, 0x8b,
+	0x06, 0xec, 0xbd, 0xd4, 0xcb, 0xe4, 0x2a, 0x9b, 0x9b, 0xb9, 0x58, 0x85, 0x74, 0x0d, 0xee, 0xa5,
+	0x2c, 0x13, 0x3b, 0x10, 0x71, 0x2f, 0x45, 0xc2, 0xda, 0xe7, 0xbf, 0x8d, 0xa2, 0x5b, 0x96, 0x39,
+	0x6e, 0x8f, 0x0b, 0x9b, 0x77, 0x71, 0xaf, 0x33, 0xb1, 0x68, 0x8e, 0x6b, 0xea, 0xf1, 0x67, 0x94,
+	0x49, 0x9c, 0x37, 0x45, 0xf9, 0x7c, 0x6d, 0x3d, 0x9b, 0x5c, 0xb5, 0xa7, 0x6a, 0x6a, 0x06, 0x6f,
+	0xde, 0xcc, 0x28, 0x0d, 0x90, 0x5c, 0xb5, 0x58, 0x0c, 0x39, 0x22, 0xb9, 0x0a, 0xf1, 0xce, 0x0a,
+	0x4d, 0x79, 0x97, 0xeb, 0xd2, 0xe3, 0x61, 0x16, 0xbd, 0xd5, 0xe9, 0xc9, 0x5a, 0x3a, 0xf6, 0x89,
+	0x9a, 0x29, 0x48, 0xce, 0x0b, 0xf8, 0xe4, 0xce, 0x5a, 0x69, 0x84, 0xc4, 0x13, 0xb5, 0x0e, 0x64,
+	0x27, 0xcd, 0x56, 0xa4, 0x8e, 0xa2, 0x9a, 0x47, 0x9b, 0x5b, 0xb8, 0xaa, 0x01, 0x88, 0x49, 0x13,
+	0x05, 0xed, 0xa0, 0x6e, 0xc5, 0x13, 0x96, 0xca, 0x87, 0xa3, 0xf2, 0x5a, 0x03, 0x0c, 0x6a, 0xe7,
+	0x10, 0xd5, 0x81, 0x88, 0x41, 0x4d, 0xc2, 0xdd, 0xe1, 0x63, 0x09, 0xbd, 0xca, 0xc6, 0x7d, 0x96,
+	0xc0, 0x22, 0xbb, 0x37, 0x98, 0xb7, 0xf9, 0x4c, 0xd7, 0xb9, 0x3c, 0xa2, 0xea, 0xad, 0x84, 0x77,
+	0x50, 0xb5, 0x3b, 0x90, 0xb6, 0xfd, 0x79, 0x94, 0x15, 0xb3, 0x09, 0x2b, 0x73, 0x79, 0x6f, 0x24,
+	0x1f, 0x3c, 0x6c, 0x81, 0x39, 0xc7, 0xc8, 0xe1, 0xab, 0x87, 0xed, 0x7e, 0xd0, 0x9e, 0x3f, 0x39,
+	0x62, 0x79, 0x00, 0x3e, 0xde, 0x24, 0xb5, 0xa5, 0x9c, 0x38, 0x7f, 0xc2, 0x38, 0x77, 0x4d, 0x34,
+	0x52, 0x79, 0xc6, 0x75, 0x8f, 0xd4, 0xf5, 0x8e, 0xb8, 0x36, 0xfb, 0x30, 0xed, 0x61, 0x12, 0x7d,
+	0xa7, 0x99, 0x73, 0x5e, 0x55, 0xec, 0x2a, 0x63, 0xf0, 0xb1, 0x99, 0x23, 0x21, 0x16, 0x45, 0x9f,
+	0xb0, 0xcb, 0xcd, 0x79, 0x51, 0x97, 0x79, 0x52, 0x2f, 0x74, 0xfb, 0xfb, 0xa1, 0xd8, 0x0a, 0x61,
+	0xe3, 0xdf, 0xeb, 0xa1, 0x6c, 0xcb, 0xb7, 0x32, 0xb3, 0xee, 0x6e, 0xe2, 0xaa, 0x9d, 0xb5, 0x77,
+	0xab, 0x97, 0xb3, 0x39, 0x8e, 0xbc, 0x3b, 0xd1, 0xc9, 0x82, 0x5f, 0x6b, 0x29, 0x81, 0xd9, 0xc2,
+	0x9d, 0x10, 0x62, 0xd3, 0x05, 0x29, 0xd0, 0x7d, 0x31, 0xc6, 0x74, 0xb4, 0x8c, 0x48, 0x17, 0x20,
+	0x03, 0x8a, 0xab, 0x9f, 0xf7, 0x61, 0xc5, 0x05, 0xaf, 0xfb, 0xee, 0x84, 0x10, 0x9b, 0x30, 0x49,
+	0xc1, 0xb4, 0xcc, 0x33, 0x01, 0xc6, 0x86, 0xd2, 0x90, 0x12, 0x62, 0x6c, 0xf8, 0x04, 0x30, 0xa9,
+	0x5e, 0x53, 0x61, 0x26, 0xfd, 0xc7, 0x54, 0xb7, 0x03, 0x84, 0x4d, 0x3f, 0x54, 0xdd, 0x79, 0x79,
+	0x0d, 0xd2, 0x0f, 0x5d, 0x2d, 0x5e, 0x5e, 0x13, 0xe9, 0x87, 0x07, 0x80, 0x22, 0xbe, 0x4a, 0x6a,
+	0x81, 0x17, 0x51, 0x4a, 0x82, 0x45, 0x6c, 0x09, 0x9b, 0xcd, 0xa9, 0x22, 0xae, 0x04, 0xc8, 0xe6,
+	0x74, 0x01, 0x9c, 0x87, 0x13, 0x37, 0x49, 0xb9, 0x0d, 0x2f, 0xd5, 0x2b, 0x4c, 0x1c, 0x65, 0x2c,
+	0x9f, 0xd5, 0x20, 0xbc, 0x74, 0xbb, 0xb7, 0x52, 0x22, 0xbc, 0xba, 0x14, 0x18, 0x4a, 0xfa, 0x82,
+	0x07, 0xab, 0x1d, 0xb8, 0xdb, 0xb9, 0x13, 0x42, 0x6c, 0xd0, 0xb6, 0x85, 0x3e, 0x48, 0xaa, 0x2a,
+	0x6b, 0x92, 0xd0, 0x4d, 0xbc, 0x40, 0xad, 0x9c, 0x08, 0x5a, 0x8c, 0xb3, 0xd3, 0xa5, 0x94, 0x3a,
+	0x17, 0xf4, 0x58, 0xa5, 0x91, 0xfb, 0xf9, 0xcd, 0x3e, 0xcc, 0x79, 0xd0, 0x6e, 0x5c, 0x34, 0x4f,
+	0xb6, 0xcf, 0xf8, 0xb3, 0x77, 0x59, 0x2d, 0xb2, 0x62, 0xae, 0xd3, 0xb2, 0x27, 0x84, 0x25, 0x0c,
+	0x26, 0x1e, 0xb4, 0xf7, 0x2a, 0xd9, 0xe5, 0x1d, 0x94, 0xe5, 0x25, 0x7b, 0x8b, 0x66, 0x87, 0xd0,
+	0xa2, 0xe1, 0x88, 0xe5, 0x3d, 0xc4, 0xdb, 0xf3, 0x23, 0xe3, 0x5c, 0x7f, 0xd7, 0x76, 0xc6, 0xdb,
+	0x44, 0x9d, 0xb2, 0x06, 0x41, 0x62, 0x0b, 0x1f, 0x54, 0xb0, 0xfb, 0x6a, 0xe3, 0xdf, 0x46, 0xc2,
+	0x36, 0x61, 0xa7, 0x1b, 0x0d, 0xf7, 0x07, 0x90, 0x88, 0x2b, 0xfb, 0xca, 0x84, 0x72, 0xd5, 0x7d,
+	0x64, 0x72, 0x7f, 0x00, 0xe9, 0x9c, 0x45, 0xb9, 0xd5, 0x6a, 0x1e, 0x26, 0xce, 0x2b, 0xbe, 0x2a,
+	0x66, 0x07, 0x3c, 0xe7, 0x15, 0x38, 0x8b, 0xf2, 0x4a, 0x0d, 0x50, 0xe2, 0x2c, 0xaa, 0x47, 0xc5,
+	0x26, 0x51, 0x6e, 0x29, 0xf6, 0xf3, 0x6c, 0x0e, 0x4f, 0x12, 0x3c, 0x43, 0x12, 0x20, 0x92, 0x28,
+	0x14, 0x44, 0x06, 0x91, 0x3a, 0x69, 0x10, 0x59, 0x9a, 0xe4, 0xca, 0xdf, 0x1e, 0x6d, 0xc6, 0x03,
+	0x7b, 0x07, 0x11, 0xa2, 0x80, 0xd4, 0xf3, 0x6c, 0x55, 0x15, 0x27, 0x85, 0xe0, 0x64, 0x3d, 0x5b,
+	0xa0, 0xb7, 0x9e, 0x0e, 0x08, 0x66, 0xbf, 0x33, 0xf6, 0xae, 0x29, 0x4d, 0xf3, 0x1f, 0x6c, 0xf6,
+	0x6b, 0xfe, 0x1e, 0x6b, 0x79, 0x68, 0xf6, 0x03, 0x1c, 0xa8, 0x8c, 0x76, 0xa2, 0x06, 0x4c, 0x40,
+	0xdb, 0x1f, 0x26, 0xdb, 0xfd, 0x20, 0xee, 0x67, 0x2a, 0xae, 0x73, 0x16, 0xf2, 0x23, 0x81, 0x21,
+	0x7e, 0x5a, 0xd0, 0x5e, 0x52, 0x79, 0xf5, 0x59, 0xb0, 0xf4, 0xb2, 0xf3, 0x68, 0xce, 0x2f, 0xa8,
+	0x42, 0x88, 0x4b, 0x2a, 0x02, 0xc5, 0xbb, 0xe8, 0x24, 0xe5, 0x45, 0xa8, 0x8b, 0x1a, 0xf9, 0x90,
+	0x2e, 0xd2, 0x9c, 0xdd, 0x04, 0x1a, 0xa9, 0x1e, 0x99, 0xaa, 0x9b, 0x76, 0x08, 0x0b, 0x2e, 0x44,
+	0x6c, 0x02, 0x49, 0xd8, 0xde, 0x2c, 0x40, 0x9f, 0x2f, 0xba, 0x5f, 0x68, 0x74, 0xac, 0xbc, 0xa0,
+	0xbf, 0xd0, 0xa0, 0x58, 0xba, 0x92, 0x6a, 0x8c, 0xf4, 0x58, 0xf1, 0xc7, 0xc9, 0xc3, 0x61, 0xb0,
+	0xbd, 0x2f, 0xf6, 0x7c, 0x1e, 0xe4, 0x2c, 0xa9, 0x94, 0xd7, 0xdd, 0x80, 0x21, 0x8b, 0x11, 0xf7,
+	0xc5, 0x01, 0x1c, 0x4c, 0x61, 0x9e, 0xe7, 0x03, 0x5e, 0x08, 0x56, 0x08, 0x6c, 0x0a, 0xf3, 0x8d,
+	0x69, 0x30, 0x34, 0x85, 0x51, 0x0a, 0x60, 0xdc, 0xca, 0x03, 0x3e, 0x26, 0x5e, 0x26, 0x4b, 0x34,
+	0xb1, 0x52, 0x87, 0x77, 0x4a, 0x1e, 0x1a, 0xb7, 0x80, 0x03, 0x21, 0x7f, 0xb2, 0x4c, 0xe6, 0xc6,
+	0x0b, 0xa2, 0x2d, 0xe5, 0x1d, 0x37, 0xdb, 0xfd, 0x20, 0xf0, 0xf3, 0x65, 0x36, 0x63, 0x3c, 0xe0,
+	0x47, 0xca, 0x87, 0xf8, 0x81, 0x20, 0xc8, 0x9c, 0x9a, 0xda, 0xaa, 0x4d, 0xcf, 0x7e, 0x31, 0xd3,
+	0x5b, 0xbd, 0x98, 0x68, 0x14, 0xc0, 0x85, 0x32, 0x27, 0x82, 0x07, 0xf1, 0xd1, 0x9e, 0x76, 0x87,
+	0xe2, 0xc3, 0x1c, 0x66, 0x0f, 0x89, 0x0f, 0x0c, 0xd6, 0x3e, 0xff, 0x4c, 0xc7, 0xc7, 0x61, 0x22,
+	0x92, 0x66, 0xb3, 0xfe, 0x65, 0xc6, 0xde, 0xea, 0xbd, 0x22, 0x52, 0xdf, 0x96, 0x8a, 0x1b, 0x0c,
+	0x6e, 0x1c, 0xf7, 0x06, 0xf3, 0x01, 0xdf, 0x3a, 0x3b, 0xef, 0xf5, 0x0d, 0xd2, 0xf4, 0xbd, 0xc1,
+	0x7c, 0xc0, 0xb7, 0xfe, 0x96, 0xb2, 0xd7, 0x37, 0xf8, 0xa0, 0x72, 0x6f, 0x30, 0xaf, 0x7d, 0xff,
+	0xcd, 0x28, 0xba, 0xd1, 0x71, 0xde, 0xe4, 0x40, 0xa9, 0xc8, 0xae, 0x18, 0x96, 0xca, 0xf9, 0xf6,
+	0x0c, 0x1a, 0x4a, 0xe5, 0x68, 0x15, 0x5d, 0x8a, 0x5f, 0x8c, 0xa2, 0x1f, 0x61, 0xa5, 0x78, 0xc5,
+	0xeb, 0x4c, 0x5e, 0xd2, 0x3f, 0x19, 0x60, 0xb4, 0x85, 0x43, 0x1b, 0x96, 0x90, 0x92, 0x3d, 0x12,
+	0xf4, 0x50, 0xfb, 0x3e, 0xfd, 0x61, 0xc0, 0x5e, 0xf7, 0x99, 0xfa, 0xee, 0x40, 0xda, 0x5e, 0x36,
+	0x7a, 0x8c, 0x7b, 0xcb, 0x19, 0xea, 0x55, 0xf4, 0xa2, 0xf3, 0xd1, 0x70, 0x05, 0xed, 0xfe, 0xef,
+	0xda, 0x9c, 0x1e, 0xfa, 0xd7, 0x41, 0xf0, 0x78, 0x88, 0x45, 0x10, 0x08, 0x4f, 0xd6, 0xd2, 0xd1,
+	0x05, 0xf9, 0xcf, 0x51, 0x74, 0x07, 0x2d, 0x88, 0x7f, 0xdf, 0xfd, 0xbb, 0x43, 0x6c, 0xe3, 0xf7,
+	0xde, 0xbf, 0xf7, 0x6d, 0x54, 0x75, 0xe9, 0xfe, 0xb1, 0xdd, 0x5a, 0xb7, 0x1a, 0xf2, 0x1b, 0xa2,
+	0xd3, 0x6a, 0xc6, 0x2a, 0x1d, 0xb1, 0xa1, 0x41, 0x67, 0x61, 0x18, 0xb7, 0x3f, 0x59, 0x53, 0x4b,
+	0x17, 0xe7, 0x9f, 0x47, 0xd1, 0x86, 0x07, 0xeb, 0x6f, 0x87, 0x9d, 0xf2, 0x84, 0x2c, 0x3b, 0x34,
+	0x2c, 0xd0, 0x67, 0xeb, 0xaa, 0x51, 0x91, 0xec, 0xc0, 0xf2, 0xdb, 0xf3, 0x27, 0x03, 0x0d, 0x7b,
+	0x5f, 0xa3, 0x7f, 0xba, 0x9e, 0x92, 0x2e, 0xcb, 0x7f, 0x8d, 0xa2, 0x7b, 0x1e, 0x6b, 0x2f, 0x70,
+	0xc0, 0x79, 0xc8, 0xef, 0x07, 0xec, 0x53, 0x4a, 0xa6, 0x70, 0x7f, 0xf0, 0xed, 0x94, 0xed, 0x2f,
+	0xab, 0x78, 0x2a, 0x47, 0x59, 0x2e, 0x58, 0xd5, 0xfd, 0x65, 0x15, 0xdf, 0xae, 0xa2, 0x62, 0xfa,
+	0x97, 0x55, 0x02, 0xb8, 0xf3, 0xcb, 0x2a, 0x88, 0x67, 0xf4, 0x97, 0x55, 0x50, 0x6b, 0xc1, 0x5f,
+	0x56, 0x09, 0x6b, 0x50, 0x8b, 0x4f, 0x5b, 0x04, 0x75, 0xf0, 0x3c, 0xc8, 0xa2, 0x7f, 0x0e, 0xfd,
+	0x78, 0x1d, 0x15, 0x62, 0xf9, 0x55, 0x9c, 0x7c, 0x85, 0x37, 0xa0, 0x4d, 0xbd, 0x97, 0x78, 0x7b,
+	0x83, 0x79, 0xed, 0xfb, 0xe7, 0xd1, 0xf7, 0x3d, 0xaa, 0x91, 0x36, 0x7d, 0xbf, 0x13, 0x5a, 0x3c,
+	0x1a, 0x0b, 0x6e, 0xcf, 0x3f, 0x1c, 0x06, 0x13, 0xd5, 0x9d, 0xca, 0x77, 0xbe, 0xc8, 0x75, 0x1b,
+	0x62, 0x28, 0x78, 0xdd, 0x16, 0xe2, 0x89, 0x45, 0x4e, 0xf9, 0x56, 0xbd, 0x3d, 0xc0, 0x98, 0xdf,
+	0xd7, 0x8f, 0x86, 0x2b, 0xd8, 0x67, 0x44, 0x1d, 0xf7, 0xcd, 0xff, 0xc6, 0xbd, 0x2d, 0xe8, 0xf5,
+	0xf2, 0xee, 0x40, 0x3a, 0x94, 0xdc, 0xb8, 0xcb, 0x7b, 0x5f, 0x72, 0x83, 0x2e, 0xf1, 0x9f, 0xae,
+	0xa7, 0xa4, 0xcb, 0xf2, 0xaf, 0xa3, 0xe8, 0x26, 0x59, 0x16, 0x3d, 0x0a, 0x3e, 0x1b, 0x6a, 0x19,
+	0x8c, 0x86, 0xcf, 0xd7, 0xd6, 0xd3, 0x85, 0xfa, 0x8f, 0x51, 0x74, 0x2b, 0x50, 0x28, 0x35, 0x3c,
+	0xd6, 0xb0, 0xee, 0x0f, 0x93, 0x9f, 0xae, 0xaf, 0x48, 0x2d, 0xf6, 0x2e, 0x3e, 0xed, 0xfe, 0xe0,
+	0x48, 0xc0, 0xf6, 0x94, 0xfe, 0xc1, 0x91, 0x7e, 0x2d, 0x78, 0xf8, 0xd3, 0xa4, 0x24, 0x7a, 0x5f,
+	0x84, 0x1d, 0xfe, 0x34, 0x62, 0xb8, 0x1f, 0xda, 0xea, 0xe5, 0x30, 0x27, 0xcf, 0xde, 0x95, 0x49,
+	0x31, 0xa3, 0x9d, 0x28, 0x79, 0xbf, 0x13, 0xc3, 0xc1, 0x43, 0xb3, 0x46, 0x3a, 0xe1, 0xed, 0x26,
+	0xef, 0x3e, 0xa5, 0x6f, 0x90, 0xe0, 0xa1, 0x59, 0x07, 0x25, 0xbc, 0xe9, 0x8c, 0x36, 0xe4, 0x0d,
+	0x24, 0xb2, 0x0f, 0x86, 0xa0, 0x60, 0xfb, 0x60, 0xbc, 0x99, 0xb3, 0xf8, 0x87, 0x21, 0x2b, 0x9d,
+	0xf3, 0xf8, 0xdd, 0x81, 0x34, 0xe1, 0x76, 0xca, 0xc4, 0x17, 0x2c, 0x99, 0xb1, 0x2a, 0xe8, 0xd6,
+	0x50, 0x83, 0xdc, 0xba, 0x34, 0xe6, 0xf6, 0x80, 0xe7, 0xab, 0x65, 0xa1, 0x3b, 0x93, 0x74, 0xeb,
+	0x52, 0xfd, 0x6e, 0x01, 0x0d, 0x8f, 0x0b, 0xad, 0x5b, 0x99, 0x5c, 0x3e, 0x08, 0x9b, 0xf1, 0x72,
+	0xca, 0x9d, 0x41, 0x2c, 0x5d, 0x4f, 0x3d, 0x8c, 0x7a, 0xea, 0x09, 0x46, 0xd2, 0xee, 0x40, 0x1a,
+	0x9e, 0xdb, 0x39, 0x6e, 0xcd, 0x78, 0xda, 0xeb, 0xb1, 0xd5, 0x19, 0x52, 0x8f, 0x86, 0x2b, 0xc0,
+	0x53, 0x52, 0x3d, 0xaa, 0x9a, 0x5d, 0xd1, 0x51, 0x96, 0xe7, 0xe3, 0x9d, 0xc0, 0x30, 0x69, 0xa1,
+	0xe0, 0x29, 0x29, 0x02, 0x13, 0x23, 0xb9, 0x3d, 0x55, 0x2c, 0xc6, 0x7d, 0x76, 0x24, 0x35, 0x68,
+	0x24, 0xbb, 0x34, 0x38, 0x6d, 0x73, 0x9a, 0xda, 0xd4, 0x36, 0x0e, 0x37, 0x5c, 0xa7, 0xc2, 0x7b,
+	0x83, 0x79, 0x70, 0x5b, 0x2e, 0x29, 0xb9, 0xb2, 0xdc, 0xa5, 0x4c, 0x78, 0x2b, 0xc9, 0xbd, 0x1e,
+	0x0a, 0x9c, 0x58, 0xaa, 0x30, 0x7a, 0x9d, 0xcd, 0xe6, 0x4c, 0xa0, 0x37, 0x48, 0x2e, 0x10, 0xbc,
+	0x41, 0x02, 0x20, 0xe8, 0x3a, 0xf5, 0xf7, 0xe6, 0xee, 0x27, 0xa9, 0xe6, 0x4c, 0x9c, 0xcc, 0xb0,
+	0xae, 0xd3, 0xca, 0x0e, 0x15, 0xea, 0x3a, 0x94, 0x06, 0xb3, 0x81, 0x71, 0xab, 0x7f, 0x04, 0xe2,
+	0x41, 0xc8, 0x0c, 0xf8, 0x25, 0x88, 0x9d, 0x41, 0x2c, 0x58, 0x51, 0xac, 0xc3, 0x6c, 0x99, 0x09,
+	0x6c, 0x45, 0x71, 0x6c, 0x34, 0x48, 0x68, 0x45, 0xe9, 0xa2, 0x54, 0xf5, 0x9a, 0x1c, 0xe1, 0x64,
+	0x16, 0xae, 0x9e, 0x62, 0x86, 0x55, 0xcf, 0xb0, 0x9d, 0x0b, 0xcf, 0xc2, 0x0c, 0x19, 0xb1, 0xd0,
+	0x5b, 0x65, 0x64, 0x6c, 0x37, 0x5c, 0x0c, 0xc1, 0xd0, 0xac, 0x43, 0x29, 0x38, 0x5f, 0x0c, 0x19,
+	0xae, 0xbd, 0x93, 0x2d, 0x4b, 0x96, 0x54, 0x49, 0x91, 0xa2, 0x5b, 0x53, 0x69, 0xb0, 0x43, 0x86,
+	0xb6, 0xa6, 0xa4, 0x06, 0xb8, 0x4e, 0xf7, 0x3f, 0xf0, 0x45, 0x42, 0xa1, 0x05, 0x62, 0xff, 0xfb,
+	0xde, 0xfb, 0x03, 0x48, 0x78, 0x9d, 0xde, 0x02, 0xe6, 0x50, 0x5e, 0x39, 0xfd, 0x24, 0x60, 0xca,
+	0x47, 0x43, 0xdb, 0x60, 0x5a, 0x05, 0x0c, 0x6a, 0x93, 0xe0, 0x32, 0xf1, 0x33, 0x76, 0x8d, 0x0d,
+	0x6a, 0x9b, 0x9f, 0x4a, 0x24, 0x34, 0xa8, 0xbb, 0x28, 0xc8, 0x33, 0xdd, 0x7d, 0xd0, 0x66, 0x40,
+	0xdf, 0xdd, 0xfa, 0x6c, 0xf5, 0x72, 0x20, 0x72, 0x0e, 0xb3, 0x2b, 0xef, 0x0e, 0x03, 0x29, 0xe8,
+	0x61, 0x76, 0x85, 0x5f, 0x61, 0xec, 0x0c, 0x62, 0xe1, 0x55, 0x7d, 0x22, 0xd8, 0xbb, 0xf6, 0x0e,
+	0x1d, 0x29, 0xae, 0x94, 0x77, 0x2e, 0xd1, 0xb7, 0xfb, 0x41, 0xfb, 0xd6, 0xf8, 0x55, 0xc5, 0x53,
+	0x56, 0xd7, 0x07, 0xcd, 0xb0, 0xcd, 0xc1, 0x5b, 0x63, 0x2d, 0x8b, 0x95, 0x90, 0x78, 0x6b, 0xdc,
+	0x81, 0x9c, 0x3a, 0x24, 0xe9, 0xe5, 0xaa, 0x9c, 0xa6, 0x0b, 0x36, 0x5b, 0xc9, 0x0b, 0x3b, 0x58,
+	0x07, 0x29, 0x8f, 0x1d, 0x80, 0xaa, 0x03, 0x06, 0x52, 0x7e, 0x8e, 0xfb, 0xfc, 0x1c, 0x0f, 0xf5,
+	0x73, 0xcc, 0xfc, 0xdf, 0x15, 0x3b, 0xaf, 0x59, 0xd5, 0xec, 0xb0, 0x0e, 0x57, 0xcb, 0x12, 0x3c,
+	0x67, 0x6c, 0x45, 0x71, 0x23, 0x23, 0x9e, 0x33, 0x42, 0xc6, 0x3e, 0xe4, 0x6a, 0x25, 0x13, 0xd6,
+	0x7c, 0x88, 0x02, 0x1f, 0x72, 0x19, 0x3d, 0x2d, 0x26, 0x1e, 0x72, 0x21, 0x98, 0xf5, 0xf0, 0x9a,
+	0x5d, 0x2c, 0x38, 0xbf, 0x34, 0x9f, 0x58, 0xfb, 0x1e, 0xb4, 0x34, 0xee, 0x7c, 0x57, 0xbd, 0xd9,
+	0x87, 0xd9, 0x4e, 0xd0, 0x42, 0xe7, 0x03, 0xea, 0x2d, 0x54, 0x19, 0xf9, 0x6a, 0x7a, 0xbb, 0x1f,
+	0xb4, 0xef, 0xf5, 0xb4, 0x58, 0x3e, 0xae, 0xbe, 0x8d, 0x2a, 0x7a, 0x2f, 0xaa, 0xef, 0x84, 0x10,
+	0x3b, 0x89, 0xec, 0xaf, 0x04, 0x5f, 0xca, 0xd0, 0x47, 0x77, 0xc4, 0x56, 0x1c, 0xde, 0x11, 0x63,
+	0x1c, 0xe6, 0x44, 0x1f, 0xaa, 0x93, 0x4e, 0xc0, 0x29, 0xfa, 0x56, 0x2f, 0xe7, 0xfc, 0xd4, 0xb0,
+	0x91, 0xca, 0x26, 0xba, 0x4b, 0xa9, 0x7a, 0xad, 0x74, 0xaf, 0x87, 0xb2, 0xdd, 0x3c, 0x4d, 0xae,
+	0xd8, 0x4c, 0xbd, 0x52, 0xd6, 0x2d, 0xe5, 0x17, 0xce, 0x91, 0xc3, 0xa6, 0xda, 0xee, 0x07, 0x51,
+	0x3f, 0xba, 0xb1, 0x68, 0x3f, 0xa0, 0xb5, 0xb6, 0xfb, 0x41, 0x3b, 0xb1, 0x3b, 0x62, 0xfb, 0x9b,
+	0x70, 0x0f, 0x48, 0x0b, 0xdd, 0x9f, 0x84, 0xdb, 0x19, 0xc4, 0xda, 0x09, 0xf7, 0x34, 0xad, 0xa6,
+	0x4c, 0xff, 0x42, 0xf0, 0x0c, 0x4c, 0xb8, 0xa7, 0x69, 0x15, 0x5b, 0x21, 0x31, 0xe1, 0x76, 0x20,
+	0xe7, 0xe3, 0x8e, 0x2a, 0xa9, 0x17, 0x13, 0x26, 0x58, 0xa1, 0x57, 0x5e, 0xf8, 0x71, 0x47, 0x23,
+	0x8f, 0x5d, 0x80, 0xfa, 0xb8, 0x03, 0x03, 0x29, 0x3f, 0xc7, 0x7d, 0x7e, 0x8e, 0x87, 0xfa, 0xf1,
+	0x26, 0xdc, 0x2f, 0xa2, 0xf7, 0x9f, 0xf3, 0xf9, 0x94, 0x15, 0xb3, 0xf1, 0x8f, 0x3d, 0xa5, 0xe7,
+	0x7c, 0x1e, 0x37, 0x7f, 0x36, 0x36, 0x37, 0x28, 0xb1, 0x7d, 0x34, 0x7d, 0xc8, 0x2e, 0x56, 0xf3,
+	0xb3, 0x8a, 0x31, 0xf0, 0x68, 0x5a, 0xfe, 0x3d, 0x6e, 0x04, 0xc4, 0xa3, 0x69, 0x0f, 0xb0, 0x51,
+	0x66, 0xec, 0x35, 0x27, 0x19, 0xf0, 0x51, 0xb2, 0xd5, 0x91, 0x52, 0x22, 0xca, 0xba, 0x94, 0x6d,
+	0x60, 0x29, 0x93, 0x9f, 0x9f, 0x4d, 0x57, 0xcb, 0x65, 0x52, 0x5d, 0x83, 0x06, 0x56, 0xba, 0x2e,
+	0x40, 0x34, 0x30, 0x0a, 0xda, 0xd1, 0xaf, 0xfc, 0x88, 0x24, 0xbd, 0x3c, 0xe6, 0x15, 0x5f, 0x89,
+	0xac, 0x60, 0xf0, 0x07, 0xa1, 0xb4, 0x05, 0x9f, 0x21, 0x46, 0x3f, 0xc5, 0xda, 0x63, 0x00, 0x49,
+	0xa8, 0xf7, 0xd2, 0xf2, 0xc7, 0xab, 0xd5, 0x7a, 0x87, 0x59, 0x81, 0x10, 0x71, 0x0c, 0x40, 0xc2,
+	0xa0, 0xef, 0x5f, 0x65, 0xc5, 0x1c, 0xed, 0xfb, 0x46, 0x10, 0xec, 0x7b, 0x0d, 0xd8, 0x84, 0x5e,
+	0x35, 0x9a, 0x0a, 0x6e, 0xfd, 0x21, 0x3e, 0xda, 0xe8, 0x2e, 0x41, 0x24, 0xf4, 0x38, 0x09, 0x5c,
+	0x9d, 0x96, 0xac, 0x60, 0xb3, 0xf6, 0xb9, 0x31, 0xe6, 0xca, 0x23, 0x82, 0xae, 0x20, 0x69, 0x87,
+	0xc2, 0x0b, 0x26, 0xaa, 0x2c, 0xad, 0x9b, 0xb7, 0x0c, 0x49, 0x95, 0x2c, 0x99, 0x60, 0x15, 0x1c,
+	0x0a, 0x1a, 0x89, 0x3d, 0x86, 0x18, 0x0a, 0x14, 0xab, 0x1d, 0xfe, 0x61, 0xf4, 0xbd, 0x66, 0x69,
+	0x61, 0x85, 0xfe, 0xd7, 0x34, 0x9e, 0xc9, 0x7f, 0x68, 0x66, 0xfc, 0xa1, 0xb1, 0x31, 0x15, 0x15,
+	0x4b, 0x96, 0xad, 0xed, 0x0f, 0xcc, 0xdf, 0x25, 0xf8, 0x68, 0xf4, 0xf4, 0xf6, 0x7f, 0x7f, 0xbd,
+	0x31, 0xfa, 0xe5, 0xd7, 0x1b, 0xa3, 0xff, 0xfb, 0x7a, 0x63, 0xf4, 0x2f, 0xdf, 0x6c, 0xbc, 0xf7,
+	0xcb, 0x6f, 0x36, 0xde, 0xfb, 0xdf, 0x6f, 0x36, 0xde, 0xfb, 0xea, 0x7d, 0xfd, 0x0f, 0xde, 0x5c,
+	0xfc, 0x8a, 0xfc, 0x67, 0x6b, 0x9e, 0xfc, 0xff, 0x00, 0x33, 0x59, 0x1d, 0x7b, 0x14, 0x67, 0x00,
 	0x00,
 }
 
@@ -334,7 +375,15 @@ type ClientCommandsClient interface {
 	// ObjectCreateSet just creates the new set, without adding the link to it from some other page
 	ObjectCreateSet(ctx context.Context, in *pb.RpcObjectCreateSetRequest, opts ...grpc.CallOption) (*pb.RpcObjectCreateSetResponse, error)
 	ObjectGraph(ctx context.Context, in *pb.RpcObjectGraphRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphResponse, error)
+	ObjectGraphNeighborhood(ctx context.Context, in *pb.RpcObjectGraphNeighborhoodRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphNeighborhoodResponse, error)
+	ObjectGraphPath(ctx context.Context, in *pb.RpcObjectGraphPathRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphPathResponse, error)
+	ObjectGraphOrphans(ctx context.Context, in *pb.RpcObjectGraphOrphansRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphOrphansResponse, error)
+	ObjectDuplicatesList(ctx context.Context, in *pb.RpcObjectDuplicatesListRequest, opts ...grpc.CallOption) (*pb.RpcObjectDuplicatesListResponse, error)
+	ObjectMerge(ctx context.Context, in *pb.RpcObjectMergeRequest, opts ...grpc.CallOption) (*pb.RpcObjectMergeResponse, error)
+	ObjectHistoryRevert(ctx context.Context, in *pb.RpcObjectHistoryRevertRequest, opts ...grpc.CallOption) (*pb.RpcObjectHistoryRevertResponse, error)
 	ObjectSearch(ctx context.Context, in *pb.RpcObjectSearchRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchResponse, error)
+	ObjectSearchSemantic(ctx context.Context, in *pb.RpcObjectSearchSemanticRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSemanticResponse, error)
+	ObjectBacklinksList(ctx context.Context, in *pb.RpcObjectBacklinksListRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksListResponse, error)
 	ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error)
 	ObjectSubscribeIds(ctx context.Context, in *pb.RpcObjectSubscribeIdsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSubscribeIdsResponse, error)
 	ObjectGroupsSubscribe(ctx context.Context, in *pb.RpcObjectGroupsSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectGroupsSubscribeResponse, error)
@@ -354,6 +403,8 @@ type ClientCommandsClient interface {
 	ObjectListSetIsArchived(ctx context.Context, in *pb.RpcObjectListSetIsArchivedRequest, opts ...grpc.CallOption) (*pb.RpcObjectListSetIsArchivedResponse, error)
 	ObjectListSetIsFavorite(ctx context.Context, in *pb.RpcObjectListSetIsFavoriteRequest, opts ...grpc.CallOption) (*pb.RpcObjectListSetIsFavoriteResponse, error)
 	ObjectListSetObjectType(ctx context.Context, in *pb.RpcObjectListSetObjectTypeRequest, opts ...grpc.CallOption) (*pb.RpcObjectListSetObjectTypeResponse, error)
+	ObjectListSetDetails(ctx context.Context, in *pb.RpcObjectListSetDetailsRequest, opts ...grpc.CallOption) (*pb.RpcObjectListSetDetailsResponse, error)
+	ObjectListTransformBlocks(ctx context.Context, in *pb.RpcObjectListTransformBlocksRequest, opts ...grpc.CallOption) (*pb.RpcObjectListTransformBlocksResponse, error)
 	ObjectApplyTemplate(ctx context.Context, in *pb.RpcObjectApplyTemplateRequest, opts ...grpc.CallOption) (*pb.RpcObjectApplyTemplateResponse, error)
 	// ObjectToSet creates new set from given object and removes object
 	ObjectToSet(ctx context.Context, in *pb.RpcObjectToSetRequest, opts ...grpc.CallOption) (*pb.RpcObjectToSetResponse, error)
@@ -367,6 +418,11 @@ type ClientCommandsClient interface {
 	ObjectImport(ctx context.Context, in *pb.RpcObjectImportRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportResponse, error)
 	ObjectImportList(ctx context.Context, in *pb.RpcObjectImportListRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportListResponse, error)
 	ObjectImportNotionValidateToken(ctx context.Context, in *pb.RpcObjectImportNotionValidateTokenRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportNotionValidateTokenResponse, error)
+	ObjectImportUndo(ctx context.Context, in *pb.RpcObjectImportUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUndoResponse, error)
+	ObjectImportResume(ctx context.Context, in *pb.RpcObjectImportResumeRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportResumeResponse, error)
+	ObjectImportPluginRegister(ctx context.Context, in *pb.RpcObjectImportPluginRegisterRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportPluginRegisterResponse, error)
+	ObjectImportPluginUnregister(ctx context.Context, in *pb.RpcObjectImportPluginUnregisterRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportPluginUnregisterResponse, error)
+	ObjectImportListEntries(ctx context.Context, in *pb.RpcObjectImportListEntriesRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportListEntriesResponse, error)
 	ObjectImportUseCase(ctx context.Context, in *pb.RpcObjectImportUseCaseRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUseCaseResponse, error)
 	ObjectImportExperience(ctx context.Context, in *pb.RpcObjectImportExperienceRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportExperienceResponse, error)
 	// Collections
@@ -403,12 +459,19 @@ type ClientCommandsClient interface {
 	FileDownload(ctx context.Context, in *pb.RpcFileDownloadRequest, opts ...grpc.CallOption) (*pb.RpcFileDownloadResponse, error)
 	FileDrop(ctx context.Context, in *pb.RpcFileDropRequest, opts ...grpc.CallOption) (*pb.RpcFileDropResponse, error)
 	FileSpaceUsage(ctx context.Context, in *pb.RpcFileSpaceUsageRequest, opts ...grpc.CallOption) (*pb.RpcFileSpaceUsageResponse, error)
+	FileRunGC(ctx context.Context, in *pb.RpcFileRunGCRequest, opts ...grpc.CallOption) (*pb.RpcFileRunGCResponse, error)
 	NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error)
 	NavigationGetObjectInfoWithLinks(ctx context.Context, in *pb.RpcNavigationGetObjectInfoWithLinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetObjectInfoWithLinksResponse, error)
 	TemplateCreateFromObject(ctx context.Context, in *pb.RpcTemplateCreateFromObjectRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectResponse, error)
 	TemplateCreateFromObjectType(ctx context.Context, in *pb.RpcTemplateCreateFromObjectTypeRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectTypeResponse, error)
 	TemplateClone(ctx context.Context, in *pb.RpcTemplateCloneRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCloneResponse, error)
 	TemplateExportAll(ctx context.Context, in *pb.RpcTemplateExportAllRequest, opts ...grpc.CallOption) (*pb.RpcTemplateExportAllResponse, error)
+	TemplateRecurrenceSet(ctx context.Context, in *pb.RpcTemplateRecurrenceSetRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceSetResponse, error)
+	TemplateRecurrenceRemove(ctx context.Context, in *pb.RpcTemplateRecurrenceRemoveRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceRemoveResponse, error)
+	TemplateRecurrenceList(ctx context.Context, in *pb.RpcTemplateRecurrenceListRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceListResponse, error)
+	FindReplaceSearch(ctx context.Context, in *pb.RpcFindReplaceSearchRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceSearchResponse, error)
+	FindReplaceApply(ctx context.Context, in *pb.RpcFindReplaceApplyRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceApplyResponse, error)
+	FindReplaceUndo(ctx context.Context, in *pb.RpcFindReplaceUndoRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceUndoResponse, error)
 	LinkPreview(ctx context.Context, in *pb.RpcLinkPreviewRequest, opts ...grpc.CallOption) (*pb.RpcLinkPreviewResponse, error)
 	UnsplashSearch(ctx context.Context, in *pb.RpcUnsplashSearchRequest, opts ...grpc.CallOption) (*pb.RpcUnsplashSearchResponse, error)
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
@@ -517,6 +580,22 @@ type ClientCommandsClient interface {
 	BlockDivListSetStyle(ctx context.Context, in *pb.RpcBlockDivListSetStyleRequest, opts ...grpc.CallOption) (*pb.RpcBlockDivListSetStyleResponse, error)
 	BlockLatexSetText(ctx context.Context, in *pb.RpcBlockLatexSetTextRequest, opts ...grpc.CallOption) (*pb.RpcBlockLatexSetTextResponse, error)
 	ProcessCancel(ctx context.Context, in *pb.RpcProcessCancelRequest, opts ...grpc.CallOption) (*pb.RpcProcessCancelResponse, error)
+	BackupScheduleSet(ctx context.Context, in *pb.RpcBackupScheduleSetRequest, opts ...grpc.CallOption) (*pb.RpcBackupScheduleSetResponse, error)
+	BackupScheduleGet(ctx context.Context, in *pb.RpcBackupScheduleGetRequest, opts ...grpc.CallOption) (*pb.RpcBackupScheduleGetResponse, error)
+	UserDataDump(ctx context.Context, in *pb.RpcUserDataDumpRequest, opts ...grpc.CallOption) (*pb.RpcUserDataDumpResponse, error)
+	UserDataRestore(ctx context.Context, in *pb.RpcUserDataRestoreRequest, opts ...grpc.CallOption) (*pb.RpcUserDataRestoreResponse, error)
+	WebhookRegister(ctx context.Context, in *pb.RpcWebhookRegisterRequest, opts ...grpc.CallOption) (*pb.RpcWebhookRegisterResponse, error)
+	WebhookUnregister(ctx context.Context, in *pb.RpcWebhookUnregisterRequest, opts ...grpc.CallOption) (*pb.RpcWebhookUnregisterResponse, error)
+	WebhookList(ctx context.Context, in *pb.RpcWebhookListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookListResponse, error)
+	AutomationCreate(ctx context.Context, in *pb.RpcAutomationCreateRequest, opts ...grpc.CallOption) (*pb.RpcAutomationCreateResponse, error)
+	AutomationUpdate(ctx context.Context, in *pb.RpcAutomationUpdateRequest, opts ...grpc.CallOption) (*pb.RpcAutomationUpdateResponse, error)
+	AutomationList(ctx context.Context, in *pb.RpcAutomationListRequest, opts ...grpc.CallOption) (*pb.RpcAutomationListResponse, error)
+	SavedSearchCreate(ctx context.Context, in *pb.RpcSavedSearchCreateRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchCreateResponse, error)
+	SavedSearchUpdate(ctx context.Context, in *pb.RpcSavedSearchUpdateRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchUpdateResponse, error)
+	SavedSearchSubscribe(ctx context.Context, in *pb.RpcSavedSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchSubscribeResponse, error)
+	OcrSetEnabled(ctx context.Context, in *pb.RpcOcrSetEnabledRequest, opts ...grpc.CallOption) (*pb.RpcOcrSetEnabledResponse, error)
+	TrashRetentionSet(ctx context.Context, in *pb.RpcTrashRetentionSetRequest, opts ...grpc.CallOption) (*pb.RpcTrashRetentionSetResponse, error)
+	TrashRetentionGet(ctx context.Context, in *pb.RpcTrashRetentionGetRequest, opts ...grpc.CallOption) (*pb.RpcTrashRetentionGetResponse, error)
 	LogSend(ctx context.Context, in *pb.RpcLogSendRequest, opts ...grpc.CallOption) (*pb.RpcLogSendResponse, error)
 	DebugTree(ctx context.Context, in *pb.RpcDebugTreeRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeResponse, error)
 	DebugTreeHeads(ctx context.Context, in *pb.RpcDebugTreeHeadsRequest, opts ...grpc.CallOption) (*pb.RpcDebugTreeHeadsResponse, error)
@@ -863,6 +942,60 @@ func (c *clientCommandsClient) ObjectGraph(ctx context.Context, in *pb.RpcObject
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectGraphNeighborhood(ctx context.Context, in *pb.RpcObjectGraphNeighborhoodRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphNeighborhoodResponse, error) {
+	out := new(pb.RpcObjectGraphNeighborhoodResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectGraphNeighborhood", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectGraphPath(ctx context.Context, in *pb.RpcObjectGraphPathRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphPathResponse, error) {
+	out := new(pb.RpcObjectGraphPathResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectGraphPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectGraphOrphans(ctx context.Context, in *pb.RpcObjectGraphOrphansRequest, opts ...grpc.CallOption) (*pb.RpcObjectGraphOrphansResponse, error) {
+	out := new(pb.RpcObjectGraphOrphansResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectGraphOrphans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectDuplicatesList(ctx context.Context, in *pb.RpcObjectDuplicatesListRequest, opts ...grpc.CallOption) (*pb.RpcObjectDuplicatesListResponse, error) {
+	out := new(pb.RpcObjectDuplicatesListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectDuplicatesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectMerge(ctx context.Context, in *pb.RpcObjectMergeRequest, opts ...grpc.CallOption) (*pb.RpcObjectMergeResponse, error) {
+	out := new(pb.RpcObjectMergeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectHistoryRevert(ctx context.Context, in *pb.RpcObjectHistoryRevertRequest, opts ...grpc.CallOption) (*pb.RpcObjectHistoryRevertResponse, error) {
+	out := new(pb.RpcObjectHistoryRevertResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectHistoryRevert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSearch(ctx context.Context, in *pb.RpcObjectSearchRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchResponse, error) {
 	out := new(pb.RpcObjectSearchResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSearch", in, out, opts...)
@@ -872,6 +1005,24 @@ func (c *clientCommandsClient) ObjectSearch(ctx context.Context, in *pb.RpcObjec
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectSearchSemantic(ctx context.Context, in *pb.RpcObjectSearchSemanticRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSemanticResponse, error) {
+	out := new(pb.RpcObjectSearchSemanticResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSearchSemantic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectBacklinksList(ctx context.Context, in *pb.RpcObjectBacklinksListRequest, opts ...grpc.CallOption) (*pb.RpcObjectBacklinksListResponse, error) {
+	out := new(pb.RpcObjectBacklinksListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectBacklinksList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error) {
 	out := new(pb.RpcObjectSearchSubscribeResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSearchSubscribe", in, out, opts...)
@@ -1034,6 +1185,24 @@ func (c *clientCommandsClient) ObjectListSetObjectType(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectListSetDetails(ctx context.Context, in *pb.RpcObjectListSetDetailsRequest, opts ...grpc.CallOption) (*pb.RpcObjectListSetDetailsResponse, error) {
+	out := new(pb.RpcObjectListSetDetailsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectListSetDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectListTransformBlocks(ctx context.Context, in *pb.RpcObjectListTransformBlocksRequest, opts ...grpc.CallOption) (*pb.RpcObjectListTransformBlocksResponse, error) {
+	out := new(pb.RpcObjectListTransformBlocksResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectListTransformBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectApplyTemplate(ctx context.Context, in *pb.RpcObjectApplyTemplateRequest, opts ...grpc.CallOption) (*pb.RpcObjectApplyTemplateResponse, error) {
 	out := new(pb.RpcObjectApplyTemplateResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectApplyTemplate", in, out, opts...)
@@ -1142,6 +1311,51 @@ func (c *clientCommandsClient) ObjectImportNotionValidateToken(ctx context.Conte
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectImportUndo(ctx context.Context, in *pb.RpcObjectImportUndoRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUndoResponse, error) {
+	out := new(pb.RpcObjectImportUndoResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportUndo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectImportResume(ctx context.Context, in *pb.RpcObjectImportResumeRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportResumeResponse, error) {
+	out := new(pb.RpcObjectImportResumeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectImportPluginRegister(ctx context.Context, in *pb.RpcObjectImportPluginRegisterRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportPluginRegisterResponse, error) {
+	out := new(pb.RpcObjectImportPluginRegisterResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportPluginRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectImportPluginUnregister(ctx context.Context, in *pb.RpcObjectImportPluginUnregisterRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportPluginUnregisterResponse, error) {
+	out := new(pb.RpcObjectImportPluginUnregisterResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportPluginUnregister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) ObjectImportListEntries(ctx context.Context, in *pb.RpcObjectImportListEntriesRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportListEntriesResponse, error) {
+	out := new(pb.RpcObjectImportListEntriesResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportListEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectImportUseCase(ctx context.Context, in *pb.RpcObjectImportUseCaseRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUseCaseResponse, error) {
 	out := new(pb.RpcObjectImportUseCaseResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectImportUseCase", in, out, opts...)
@@ -1376,6 +1590,15 @@ func (c *clientCommandsClient) FileSpaceUsage(ctx context.Context, in *pb.RpcFil
 	return out, nil
 }
 
+func (c *clientCommandsClient) FileRunGC(ctx context.Context, in *pb.RpcFileRunGCRequest, opts ...grpc.CallOption) (*pb.RpcFileRunGCResponse, error) {
+	out := new(pb.RpcFileRunGCResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FileRunGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error) {
 	out := new(pb.RpcNavigationListObjectsResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/NavigationListObjects", in, out, opts...)
@@ -1430,6 +1653,60 @@ func (c *clientCommandsClient) TemplateExportAll(ctx context.Context, in *pb.Rpc
 	return out, nil
 }
 
+func (c *clientCommandsClient) TemplateRecurrenceSet(ctx context.Context, in *pb.RpcTemplateRecurrenceSetRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceSetResponse, error) {
+	out := new(pb.RpcTemplateRecurrenceSetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateRecurrenceSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TemplateRecurrenceRemove(ctx context.Context, in *pb.RpcTemplateRecurrenceRemoveRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceRemoveResponse, error) {
+	out := new(pb.RpcTemplateRecurrenceRemoveResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateRecurrenceRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TemplateRecurrenceList(ctx context.Context, in *pb.RpcTemplateRecurrenceListRequest, opts ...grpc.CallOption) (*pb.RpcTemplateRecurrenceListResponse, error) {
+	out := new(pb.RpcTemplateRecurrenceListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateRecurrenceList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FindReplaceSearch(ctx context.Context, in *pb.RpcFindReplaceSearchRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceSearchResponse, error) {
+	out := new(pb.RpcFindReplaceSearchResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FindReplaceSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FindReplaceApply(ctx context.Context, in *pb.RpcFindReplaceApplyRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceApplyResponse, error) {
+	out := new(pb.RpcFindReplaceApplyResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FindReplaceApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) FindReplaceUndo(ctx context.Context, in *pb.RpcFindReplaceUndoRequest, opts ...grpc.CallOption) (*pb.RpcFindReplaceUndoResponse, error) {
+	out := new(pb.RpcFindReplaceUndoResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/FindReplaceUndo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) LinkPreview(ctx context.Context, in *pb.RpcLinkPreviewRequest, opts ...grpc.CallOption) (*pb.RpcLinkPreviewResponse, error) {
 	out := new(pb.RpcLinkPreviewResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/LinkPreview", in, out, opts...)
@@ -2258,6 +2535,150 @@ func (c *clientCommandsClient) ProcessCancel(ctx context.Context, in *pb.RpcProc
 	return out, nil
 }
 
+func (c *clientCommandsClient) BackupScheduleSet(ctx context.Context, in *pb.RpcBackupScheduleSetRequest, opts ...grpc.CallOption) (*pb.RpcBackupScheduleSetResponse, error) {
+	out := new(pb.RpcBackupScheduleSetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupScheduleSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BackupScheduleGet(ctx context.Context, in *pb.RpcBackupScheduleGetRequest, opts ...grpc.CallOption) (*pb.RpcBackupScheduleGetResponse, error) {
+	out := new(pb.RpcBackupScheduleGetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupScheduleGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) UserDataDump(ctx context.Context, in *pb.RpcUserDataDumpRequest, opts ...grpc.CallOption) (*pb.RpcUserDataDumpResponse, error) {
+	out := new(pb.RpcUserDataDumpResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/UserDataDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) UserDataRestore(ctx context.Context, in *pb.RpcUserDataRestoreRequest, opts ...grpc.CallOption) (*pb.RpcUserDataRestoreResponse, error) {
+	out := new(pb.RpcUserDataRestoreResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/UserDataRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookRegister(ctx context.Context, in *pb.RpcWebhookRegisterRequest, opts ...grpc.CallOption) (*pb.RpcWebhookRegisterResponse, error) {
+	out := new(pb.RpcWebhookRegisterResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookUnregister(ctx context.Context, in *pb.RpcWebhookUnregisterRequest, opts ...grpc.CallOption) (*pb.RpcWebhookUnregisterResponse, error) {
+	out := new(pb.RpcWebhookUnregisterResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookUnregister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) WebhookList(ctx context.Context, in *pb.RpcWebhookListRequest, opts ...grpc.CallOption) (*pb.RpcWebhookListResponse, error) {
+	out := new(pb.RpcWebhookListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/WebhookList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AutomationCreate(ctx context.Context, in *pb.RpcAutomationCreateRequest, opts ...grpc.CallOption) (*pb.RpcAutomationCreateResponse, error) {
+	out := new(pb.RpcAutomationCreateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AutomationCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AutomationUpdate(ctx context.Context, in *pb.RpcAutomationUpdateRequest, opts ...grpc.CallOption) (*pb.RpcAutomationUpdateResponse, error) {
+	out := new(pb.RpcAutomationUpdateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AutomationUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) AutomationList(ctx context.Context, in *pb.RpcAutomationListRequest, opts ...grpc.CallOption) (*pb.RpcAutomationListResponse, error) {
+	out := new(pb.RpcAutomationListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/AutomationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SavedSearchCreate(ctx context.Context, in *pb.RpcSavedSearchCreateRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchCreateResponse, error) {
+	out := new(pb.RpcSavedSearchCreateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SavedSearchCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SavedSearchUpdate(ctx context.Context, in *pb.RpcSavedSearchUpdateRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchUpdateResponse, error) {
+	out := new(pb.RpcSavedSearchUpdateResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SavedSearchUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) SavedSearchSubscribe(ctx context.Context, in *pb.RpcSavedSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcSavedSearchSubscribeResponse, error) {
+	out := new(pb.RpcSavedSearchSubscribeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/SavedSearchSubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) OcrSetEnabled(ctx context.Context, in *pb.RpcOcrSetEnabledRequest, opts ...grpc.CallOption) (*pb.RpcOcrSetEnabledResponse, error) {
+	out := new(pb.RpcOcrSetEnabledResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/OcrSetEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TrashRetentionSet(ctx context.Context, in *pb.RpcTrashRetentionSetRequest, opts ...grpc.CallOption) (*pb.RpcTrashRetentionSetResponse, error) {
+	out := new(pb.RpcTrashRetentionSetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TrashRetentionSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) TrashRetentionGet(ctx context.Context, in *pb.RpcTrashRetentionGetRequest, opts ...grpc.CallOption) (*pb.RpcTrashRetentionGetResponse, error) {
+	out := new(pb.RpcTrashRetentionGetResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TrashRetentionGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) LogSend(ctx context.Context, in *pb.RpcLogSendRequest, opts ...grpc.CallOption) (*pb.RpcLogSendResponse, error) {
 	out := new(pb.RpcLogSendResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/LogSend", in, out, opts...)
@@ -2430,7 +2851,15 @@ type ClientCommandsServer interface {
 	// ObjectCreateSet just creates the new set, without adding the link to it from some other page
 	ObjectCreateSet(context.Context, *pb.RpcObjectCreateSetRequest) *pb.RpcObjectCreateSetResponse
 	ObjectGraph(context.Context, *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse
+	ObjectGraphNeighborhood(context.Context, *pb.RpcObjectGraphNeighborhoodRequest) *pb.RpcObjectGraphNeighborhoodResponse
+	ObjectGraphPath(context.Context, *pb.RpcObjectGraphPathRequest) *pb.RpcObjectGraphPathResponse
+	ObjectGraphOrphans(context.Context, *pb.RpcObjectGraphOrphansRequest) *pb.RpcObjectGraphOrphansResponse
+	ObjectDuplicatesList(context.Context, *pb.RpcObjectDuplicatesListRequest) *pb.RpcObjectDuplicatesListResponse
+	ObjectMerge(context.Context, *pb.RpcObjectMergeRequest) *pb.RpcObjectMergeResponse
+	ObjectHistoryRevert(context.Context, *pb.RpcObjectHistoryRevertRequest) *pb.RpcObjectHistoryRevertResponse
 	ObjectSearch(context.Context, *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse
+	ObjectSearchSemantic(context.Context, *pb.RpcObjectSearchSemanticRequest) *pb.RpcObjectSearchSemanticResponse
+	ObjectBacklinksList(context.Context, *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse
 	ObjectSearchSubscribe(context.Context, *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse
 	ObjectSubscribeIds(context.Context, *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse
 	ObjectGroupsSubscribe(context.Context, *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse
@@ -2450,6 +2879,8 @@ type ClientCommandsServer interface {
 	ObjectListSetIsArchived(context.Context, *pb.RpcObjectListSetIsArchivedRequest) *pb.RpcObjectListSetIsArchivedResponse
 	ObjectListSetIsFavorite(context.Context, *pb.RpcObjectListSetIsFavoriteRequest) *pb.RpcObjectListSetIsFavoriteResponse
 	ObjectListSetObjectType(context.Context, *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse
+	ObjectListSetDetails(context.Context, *pb.RpcObjectListSetDetailsRequest) *pb.RpcObjectListSetDetailsResponse
+	ObjectListTransformBlocks(context.Context, *pb.RpcObjectListTransformBlocksRequest) *pb.RpcObjectListTransformBlocksResponse
 	ObjectApplyTemplate(context.Context, *pb.RpcObjectApplyTemplateRequest) *pb.RpcObjectApplyTemplateResponse
 	// ObjectToSet creates new set from given object and removes object
 	ObjectToSet(context.Context, *pb.RpcObjectToSetRequest) *pb.RpcObjectToSetResponse
@@ -2463,6 +2894,11 @@ type ClientCommandsServer interface {
 	ObjectImport(context.Context, *pb.RpcObjectImportRequest) *pb.RpcObjectImportResponse
 	ObjectImportList(context.Context, *pb.RpcObjectImportListRequest) *pb.RpcObjectImportListResponse
 	ObjectImportNotionValidateToken(context.Context, *pb.RpcObjectImportNotionValidateTokenRequest) *pb.RpcObjectImportNotionValidateTokenResponse
+	ObjectImportUndo(context.Context, *pb.RpcObjectImportUndoRequest) *pb.RpcObjectImportUndoResponse
+	ObjectImportResume(context.Context, *pb.RpcObjectImportResumeRequest) *pb.RpcObjectImportResumeResponse
+	ObjectImportPluginRegister(context.Context, *pb.RpcObjectImportPluginRegisterRequest) *pb.RpcObjectImportPluginRegisterResponse
+	ObjectImportPluginUnregister(context.Context, *pb.RpcObjectImportPluginUnregisterRequest) *pb.RpcObjectImportPluginUnregisterResponse
+	ObjectImportListEntries(context.Context, *pb.RpcObjectImportListEntriesRequest) *pb.RpcObjectImportListEntriesResponse
 	ObjectImportUseCase(context.Context, *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse
 	ObjectImportExperience(context.Context, *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse
 	// Collections
@@ -2499,12 +2935,19 @@ type ClientCommandsServer interface {
 	FileDownload(context.Context, *pb.RpcFileDownloadRequest) *pb.RpcFileDownloadResponse
 	FileDrop(context.Context, *pb.RpcFileDropRequest) *pb.RpcFileDropResponse
 	FileSpaceUsage(context.Context, *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse
+	FileRunGC(context.Context, *pb.RpcFileRunGCRequest) *pb.RpcFileRunGCResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
 	TemplateCreateFromObjectType(context.Context, *pb.RpcTemplateCreateFromObjectTypeRequest) *pb.RpcTemplateCreateFromObjectTypeResponse
 	TemplateClone(context.Context, *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse
 	TemplateExportAll(context.Context, *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse
+	TemplateRecurrenceSet(context.Context, *pb.RpcTemplateRecurrenceSetRequest) *pb.RpcTemplateRecurrenceSetResponse
+	TemplateRecurrenceRemove(context.Context, *pb.RpcTemplateRecurrenceRemoveRequest) *pb.RpcTemplateRecurrenceRemoveResponse
+	TemplateRecurrenceList(context.Context, *pb.RpcTemplateRecurrenceListRequest) *pb.RpcTemplateRecurrenceListResponse
+	FindReplaceSearch(context.Context, *pb.RpcFindReplaceSearchRequest) *pb.RpcFindReplaceSearchResponse
+	FindReplaceApply(context.Context, *pb.RpcFindReplaceApplyRequest) *pb.RpcFindReplaceApplyResponse
+	FindReplaceUndo(context.Context, *pb.RpcFindReplaceUndoRequest) *pb.RpcFindReplaceUndoResponse
 	LinkPreview(context.Context, *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse
 	UnsplashSearch(context.Context, *pb.RpcUnsplashSearchRequest) *pb.RpcUnsplashSearchResponse
 	// UnsplashDownload downloads picture from unsplash by ID, put it to the IPFS and returns the hash.
@@ -2613,6 +3056,22 @@ type ClientCommandsServer interface {
 	BlockDivListSetStyle(context.Context, *pb.RpcBlockDivListSetStyleRequest) *pb.RpcBlockDivListSetStyleResponse
 	BlockLatexSetText(context.Context, *pb.RpcBlockLatexSetTextRequest) *pb.RpcBlockLatexSetTextResponse
 	ProcessCancel(context.Context, *pb.RpcProcessCancelRequest) *pb.RpcProcessCancelResponse
+	BackupScheduleSet(context.Context, *pb.RpcBackupScheduleSetRequest) *pb.RpcBackupScheduleSetResponse
+	BackupScheduleGet(context.Context, *pb.RpcBackupScheduleGetRequest) *pb.RpcBackupScheduleGetResponse
+	UserDataDump(context.Context, *pb.RpcUserDataDumpRequest) *pb.RpcUserDataDumpResponse
+	UserDataRestore(context.Context, *pb.RpcUserDataRestoreRequest) *pb.RpcUserDataRestoreResponse
+	WebhookRegister(context.Context, *pb.RpcWebhookRegisterRequest) *pb.RpcWebhookRegisterResponse
+	WebhookUnregister(context.Context, *pb.RpcWebhookUnregisterRequest) *pb.RpcWebhookUnregisterResponse
+	WebhookList(context.Context, *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse
+	AutomationCreate(context.Context, *pb.RpcAutomationCreateRequest) *pb.RpcAutomationCreateResponse
+	AutomationUpdate(context.Context, *pb.RpcAutomationUpdateRequest) *pb.RpcAutomationUpdateResponse
+	AutomationList(context.Context, *pb.RpcAutomationListRequest) *pb.RpcAutomationListResponse
+	SavedSearchCreate(context.Context, *pb.RpcSavedSearchCreateRequest) *pb.RpcSavedSearchCreateResponse
+	SavedSearchUpdate(context.Context, *pb.RpcSavedSearchUpdateRequest) *pb.RpcSavedSearchUpdateResponse
+	SavedSearchSubscribe(context.Context, *pb.RpcSavedSearchSubscribeRequest) *pb.RpcSavedSearchSubscribeResponse
+	OcrSetEnabled(context.Context, *pb.RpcOcrSetEnabledRequest) *pb.RpcOcrSetEnabledResponse
+	TrashRetentionSet(context.Context, *pb.RpcTrashRetentionSetRequest) *pb.RpcTrashRetentionSetResponse
+	TrashRetentionGet(context.Context, *pb.RpcTrashRetentionGetRequest) *pb.RpcTrashRetentionGetResponse
 	LogSend(context.Context, *pb.RpcLogSendRequest) *pb.RpcLogSendResponse
 	DebugTree(context.Context, *pb.RpcDebugTreeRequest) *pb.RpcDebugTreeResponse
 	DebugTreeHeads(context.Context, *pb.RpcDebugTreeHeadsRequest) *pb.RpcDebugTreeHeadsResponse
@@ -2739,9 +3198,33 @@ func (*UnimplementedClientCommandsServer) ObjectCreateSet(ctx context.Context, r
 func (*UnimplementedClientCommandsServer) ObjectGraph(ctx context.Context, req *pb.RpcObjectGraphRequest) *pb.RpcObjectGraphResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectGraphNeighborhood(ctx context.Context, req *pb.RpcObjectGraphNeighborhoodRequest) *pb.RpcObjectGraphNeighborhoodResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectGraphPath(ctx context.Context, req *pb.RpcObjectGraphPathRequest) *pb.RpcObjectGraphPathResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectGraphOrphans(ctx context.Context, req *pb.RpcObjectGraphOrphansRequest) *pb.RpcObjectGraphOrphansResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectDuplicatesList(ctx context.Context, req *pb.RpcObjectDuplicatesListRequest) *pb.RpcObjectDuplicatesListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectMerge(ctx context.Context, req *pb.RpcObjectMergeRequest) *pb.RpcObjectMergeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectHistoryRevert(ctx context.Context, req *pb.RpcObjectHistoryRevertRequest) *pb.RpcObjectHistoryRevertResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSearch(ctx context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectSearchSemantic(ctx context.Context, req *pb.RpcObjectSearchSemanticRequest) *pb.RpcObjectSearchSemanticResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectBacklinksList(ctx context.Context, req *pb.RpcObjectBacklinksListRequest) *pb.RpcObjectBacklinksListResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSearchSubscribe(ctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
 	return nil
 }
@@ -2796,6 +3279,12 @@ func (*UnimplementedClientCommandsServer) ObjectListSetIsFavorite(ctx context.Co
 func (*UnimplementedClientCommandsServer) ObjectListSetObjectType(ctx context.Context, req *pb.RpcObjectListSetObjectTypeRequest) *pb.RpcObjectListSetObjectTypeResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectListSetDetails(ctx context.Context, req *pb.RpcObjectListSetDetailsRequest) *pb.RpcObjectListSetDetailsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectListTransformBlocks(ctx context.Context, req *pb.RpcObjectListTransformBlocksRequest) *pb.RpcObjectListTransformBlocksResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectApplyTemplate(ctx context.Context, req *pb.RpcObjectApplyTemplateRequest) *pb.RpcObjectApplyTemplateResponse {
 	return nil
 }
@@ -2832,25 +3321,40 @@ func (*UnimplementedClientCommandsServer) ObjectImportList(ctx context.Context,
 func (*UnimplementedClientCommandsServer) ObjectImportNotionValidateToken(ctx context.Context, req *pb.RpcObjectImportNotionValidateTokenRequest) *pb.RpcObjectImportNotionValidateTokenResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectImportUseCase(ctx context.Context, req *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportUndo(ctx context.Context, req *pb.RpcObjectImportUndoRequest) *pb.RpcObjectImportUndoResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectImportExperience(ctx context.Context, req *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportResume(ctx context.Context, req *pb.RpcObjectImportResumeRequest) *pb.RpcObjectImportResumeResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportPluginRegister(ctx context.Context, req *pb.RpcObjectImportPluginRegisterRequest) *pb.RpcObjectImportPluginRegisterResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectCollectionRemove(ctx context.Context, req *pb.RpcObjectCollectionRemoveRequest) *pb.RpcObjectCollectionRemoveResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportPluginUnregister(ctx context.Context, req *pb.RpcObjectImportPluginUnregisterRequest) *pb.RpcObjectImportPluginUnregisterResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectCollectionSort(ctx context.Context, req *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportListEntries(ctx context.Context, req *pb.RpcObjectImportListEntriesRequest) *pb.RpcObjectImportListEntriesResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectCreateRelation(ctx context.Context, req *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportUseCase(ctx context.Context, req *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse {
 	return nil
 }
-func (*UnimplementedClientCommandsServer) ObjectCreateRelationOption(ctx context.Context, req *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse {
+func (*UnimplementedClientCommandsServer) ObjectImportExperience(ctx context.Context, req *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCollectionRemove(ctx context.Context, req *pb.RpcObjectCollectionRemoveRequest) *pb.RpcObjectCollectionRemoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCollectionSort(ctx context.Context, req *pb.RpcObjectCollectionSortRequest) *pb.RpcObjectCollectionSortResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCreateRelation(ctx context.Context, req *pb.RpcObjectCreateRelationRequest) *pb.RpcObjectCreateRelationResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) ObjectCreateRelationOption(ctx context.Context, req *pb.RpcObjectCreateRelationOptionRequest) *pb.RpcObjectCreateRelationOptionResponse {
 	return nil
 }
 func (*UnimplementedClientCommandsServer) RelationListRemoveOption(ctx context.Context, req *pb.RpcRelationListRemoveOptionRequest) *pb.RpcRelationListRemoveOptionResponse {
@@ -2910,6 +3414,9 @@ func (*UnimplementedClientCommandsServer) FileDrop(ctx context.Context, req *pb.
 func (*UnimplementedClientCommandsServer) FileSpaceUsage(ctx context.Context, req *pb.RpcFileSpaceUsageRequest) *pb.RpcFileSpaceUsageResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) FileRunGC(ctx context.Context, req *pb.RpcFileRunGCRequest) *pb.RpcFileRunGCResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) NavigationListObjects(ctx context.Context, req *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse {
 	return nil
 }
@@ -2928,6 +3435,24 @@ func (*UnimplementedClientCommandsServer) TemplateClone(ctx context.Context, req
 func (*UnimplementedClientCommandsServer) TemplateExportAll(ctx context.Context, req *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) TemplateRecurrenceSet(ctx context.Context, req *pb.RpcTemplateRecurrenceSetRequest) *pb.RpcTemplateRecurrenceSetResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateRecurrenceRemove(ctx context.Context, req *pb.RpcTemplateRecurrenceRemoveRequest) *pb.RpcTemplateRecurrenceRemoveResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TemplateRecurrenceList(ctx context.Context, req *pb.RpcTemplateRecurrenceListRequest) *pb.RpcTemplateRecurrenceListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) FindReplaceSearch(ctx context.Context, req *pb.RpcFindReplaceSearchRequest) *pb.RpcFindReplaceSearchResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) FindReplaceApply(ctx context.Context, req *pb.RpcFindReplaceApplyRequest) *pb.RpcFindReplaceApplyResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) FindReplaceUndo(ctx context.Context, req *pb.RpcFindReplaceUndoRequest) *pb.RpcFindReplaceUndoResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) LinkPreview(ctx context.Context, req *pb.RpcLinkPreviewRequest) *pb.RpcLinkPreviewResponse {
 	return nil
 }
@@ -3204,6 +3729,54 @@ func (*UnimplementedClientCommandsServer) BlockLatexSetText(ctx context.Context,
 func (*UnimplementedClientCommandsServer) ProcessCancel(ctx context.Context, req *pb.RpcProcessCancelRequest) *pb.RpcProcessCancelResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) BackupScheduleSet(ctx context.Context, req *pb.RpcBackupScheduleSetRequest) *pb.RpcBackupScheduleSetResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BackupScheduleGet(ctx context.Context, req *pb.RpcBackupScheduleGetRequest) *pb.RpcBackupScheduleGetResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) UserDataDump(ctx context.Context, req *pb.RpcUserDataDumpRequest) *pb.RpcUserDataDumpResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) UserDataRestore(ctx context.Context, req *pb.RpcUserDataRestoreRequest) *pb.RpcUserDataRestoreResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookRegister(ctx context.Context, req *pb.RpcWebhookRegisterRequest) *pb.RpcWebhookRegisterResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookUnregister(ctx context.Context, req *pb.RpcWebhookUnregisterRequest) *pb.RpcWebhookUnregisterResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) WebhookList(ctx context.Context, req *pb.RpcWebhookListRequest) *pb.RpcWebhookListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AutomationCreate(ctx context.Context, req *pb.RpcAutomationCreateRequest) *pb.RpcAutomationCreateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AutomationUpdate(ctx context.Context, req *pb.RpcAutomationUpdateRequest) *pb.RpcAutomationUpdateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) AutomationList(ctx context.Context, req *pb.RpcAutomationListRequest) *pb.RpcAutomationListResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SavedSearchCreate(ctx context.Context, req *pb.RpcSavedSearchCreateRequest) *pb.RpcSavedSearchCreateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SavedSearchUpdate(ctx context.Context, req *pb.RpcSavedSearchUpdateRequest) *pb.RpcSavedSearchUpdateResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) SavedSearchSubscribe(ctx context.Context, req *pb.RpcSavedSearchSubscribeRequest) *pb.RpcSavedSearchSubscribeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) OcrSetEnabled(ctx context.Context, req *pb.RpcOcrSetEnabledRequest) *pb.RpcOcrSetEnabledResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TrashRetentionSet(ctx context.Context, req *pb.RpcTrashRetentionSetRequest) *pb.RpcTrashRetentionSetResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) TrashRetentionGet(ctx context.Context, req *pb.RpcTrashRetentionGetRequest) *pb.RpcTrashRetentionGetResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) LogSend(ctx context.Context, req *pb.RpcLogSendRequest) *pb.RpcLogSendResponse {
 	return nil
 }
@@ -3890,6 +4463,114 @@ func _ClientCommands_ObjectGraph_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectGraphNeighborhood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectGraphNeighborhoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectGraphNeighborhood(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectGraphNeighborhood",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectGraphNeighborhood(ctx, req.(*pb.RpcObjectGraphNeighborhoodRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectGraphPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectGraphPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectGraphPath(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectGraphPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectGraphPath(ctx, req.(*pb.RpcObjectGraphPathRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectGraphOrphans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectGraphOrphansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectGraphOrphans(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectGraphOrphans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectGraphOrphans(ctx, req.(*pb.RpcObjectGraphOrphansRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectDuplicatesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectDuplicatesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectDuplicatesList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectDuplicatesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectDuplicatesList(ctx, req.(*pb.RpcObjectDuplicatesListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectMerge(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectMerge(ctx, req.(*pb.RpcObjectMergeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectHistoryRevert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectHistoryRevertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectHistoryRevert(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectHistoryRevert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectHistoryRevert(ctx, req.(*pb.RpcObjectHistoryRevertRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSearchRequest)
 	if err := dec(in); err != nil {
@@ -3908,6 +4589,42 @@ func _ClientCommands_ObjectSearch_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectSearchSemantic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectSearchSemanticRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectSearchSemantic(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectSearchSemantic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectSearchSemantic(ctx, req.(*pb.RpcObjectSearchSemanticRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectBacklinksList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectBacklinksListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectBacklinksList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectBacklinksList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectBacklinksList(ctx, req.(*pb.RpcObjectBacklinksListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSearchSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSearchSubscribeRequest)
 	if err := dec(in); err != nil {
@@ -4232,6 +4949,42 @@ func _ClientCommands_ObjectListSetObjectType_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectListSetDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectListSetDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectListSetDetails(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectListSetDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectListSetDetails(ctx, req.(*pb.RpcObjectListSetDetailsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectListTransformBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectListTransformBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectListTransformBlocks(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectListTransformBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectListTransformBlocks(ctx, req.(*pb.RpcObjectListTransformBlocksRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectApplyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectApplyTemplateRequest)
 	if err := dec(in); err != nil {
@@ -4448,164 +5201,254 @@ func _ClientCommands_ObjectImportNotionValidateToken_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectImportUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectImportUseCaseRequest)
+func _ClientCommands_ObjectImportUndo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportUndoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectImportUseCase(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportUndo(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectImportUseCase",
+		FullMethod: "/anytype.ClientCommands/ObjectImportUndo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectImportUseCase(ctx, req.(*pb.RpcObjectImportUseCaseRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportUndo(ctx, req.(*pb.RpcObjectImportUndoRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectImportExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectImportExperienceRequest)
+func _ClientCommands_ObjectImportResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportResumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectImportExperience(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportResume(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectImportExperience",
+		FullMethod: "/anytype.ClientCommands/ObjectImportResume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectImportExperience(ctx, req.(*pb.RpcObjectImportExperienceRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportResume(ctx, req.(*pb.RpcObjectImportResumeRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectCollectionAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectCollectionAddRequest)
+func _ClientCommands_ObjectImportPluginRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportPluginRegisterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectCollectionAdd(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportPluginRegister(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectCollectionAdd",
+		FullMethod: "/anytype.ClientCommands/ObjectImportPluginRegister",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectCollectionAdd(ctx, req.(*pb.RpcObjectCollectionAddRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportPluginRegister(ctx, req.(*pb.RpcObjectImportPluginRegisterRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectCollectionRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectCollectionRemoveRequest)
+func _ClientCommands_ObjectImportPluginUnregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportPluginUnregisterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectCollectionRemove(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportPluginUnregister(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectCollectionRemove",
+		FullMethod: "/anytype.ClientCommands/ObjectImportPluginUnregister",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectCollectionRemove(ctx, req.(*pb.RpcObjectCollectionRemoveRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportPluginUnregister(ctx, req.(*pb.RpcObjectImportPluginUnregisterRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectCollectionSort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectCollectionSortRequest)
+func _ClientCommands_ObjectImportListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportListEntriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectCollectionSort(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportListEntries(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectCollectionSort",
+		FullMethod: "/anytype.ClientCommands/ObjectImportListEntries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectCollectionSort(ctx, req.(*pb.RpcObjectCollectionSortRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportListEntries(ctx, req.(*pb.RpcObjectImportListEntriesRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectCreateRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectCreateRelationRequest)
+func _ClientCommands_ObjectImportUseCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportUseCaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectCreateRelation(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportUseCase(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectCreateRelation",
+		FullMethod: "/anytype.ClientCommands/ObjectImportUseCase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectCreateRelation(ctx, req.(*pb.RpcObjectCreateRelationRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportUseCase(ctx, req.(*pb.RpcObjectImportUseCaseRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_ObjectCreateRelationOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcObjectCreateRelationOptionRequest)
+func _ClientCommands_ObjectImportExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectImportExperienceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).ObjectCreateRelationOption(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectImportExperience(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/ObjectCreateRelationOption",
+		FullMethod: "/anytype.ClientCommands/ObjectImportExperience",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).ObjectCreateRelationOption(ctx, req.(*pb.RpcObjectCreateRelationOptionRequest)), nil
+		return srv.(ClientCommandsServer).ObjectImportExperience(ctx, req.(*pb.RpcObjectImportExperienceRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_RelationListRemoveOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcRelationListRemoveOptionRequest)
+func _ClientCommands_ObjectCollectionAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCollectionAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).RelationListRemoveOption(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectCollectionAdd(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/RelationListRemoveOption",
+		FullMethod: "/anytype.ClientCommands/ObjectCollectionAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).RelationListRemoveOption(ctx, req.(*pb.RpcRelationListRemoveOptionRequest)), nil
+		return srv.(ClientCommandsServer).ObjectCollectionAdd(ctx, req.(*pb.RpcObjectCollectionAddRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClientCommands_RelationOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(pb.RpcRelationOptionsRequest)
+func _ClientCommands_ObjectCollectionRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCollectionRemoveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClientCommandsServer).RelationOptions(ctx, in), nil
+		return srv.(ClientCommandsServer).ObjectCollectionRemove(ctx, in), nil
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/anytype.ClientCommands/RelationOptions",
+		FullMethod: "/anytype.ClientCommands/ObjectCollectionRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClientCommandsServer).RelationOptions(ctx, req.(*pb.RpcRelationOptionsRequest)), nil
+		return srv.(ClientCommandsServer).ObjectCollectionRemove(ctx, req.(*pb.RpcObjectCollectionRemoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectCollectionSort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCollectionSortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCollectionSort(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCollectionSort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCollectionSort(ctx, req.(*pb.RpcObjectCollectionSortRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectCreateRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCreateRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCreateRelation(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCreateRelation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCreateRelation(ctx, req.(*pb.RpcObjectCreateRelationRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_ObjectCreateRelationOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCreateRelationOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCreateRelationOption(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCreateRelationOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCreateRelationOption(ctx, req.(*pb.RpcObjectCreateRelationOptionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_RelationListRemoveOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcRelationListRemoveOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).RelationListRemoveOption(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/RelationListRemoveOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).RelationListRemoveOption(ctx, req.(*pb.RpcRelationListRemoveOptionRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_RelationOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcRelationOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).RelationOptions(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/RelationOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).RelationOptions(ctx, req.(*pb.RpcRelationOptionsRequest)), nil
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4916,6 +5759,24 @@ func _ClientCommands_FileSpaceUsage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_FileRunGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFileRunGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FileRunGC(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FileRunGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FileRunGC(ctx, req.(*pb.RpcFileRunGCRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_NavigationListObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcNavigationListObjectsRequest)
 	if err := dec(in); err != nil {
@@ -5024,6 +5885,114 @@ func _ClientCommands_TemplateExportAll_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_TemplateRecurrenceSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateRecurrenceSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TemplateRecurrenceSet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/TemplateRecurrenceSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).TemplateRecurrenceSet(ctx, req.(*pb.RpcTemplateRecurrenceSetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_TemplateRecurrenceRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateRecurrenceRemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TemplateRecurrenceRemove(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/TemplateRecurrenceRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).TemplateRecurrenceRemove(ctx, req.(*pb.RpcTemplateRecurrenceRemoveRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_TemplateRecurrenceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTemplateRecurrenceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TemplateRecurrenceList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/TemplateRecurrenceList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).TemplateRecurrenceList(ctx, req.(*pb.RpcTemplateRecurrenceListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FindReplaceSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFindReplaceSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FindReplaceSearch(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FindReplaceSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FindReplaceSearch(ctx, req.(*pb.RpcFindReplaceSearchRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FindReplaceApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFindReplaceApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FindReplaceApply(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FindReplaceApply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FindReplaceApply(ctx, req.(*pb.RpcFindReplaceApplyRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_FindReplaceUndo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcFindReplaceUndoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).FindReplaceUndo(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/FindReplaceUndo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).FindReplaceUndo(ctx, req.(*pb.RpcFindReplaceUndoRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_LinkPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcLinkPreviewRequest)
 	if err := dec(in); err != nil {
@@ -6680,6 +7649,294 @@ func _ClientCommands_ProcessCancel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_BackupScheduleSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupScheduleSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupScheduleSet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupScheduleSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupScheduleSet(ctx, req.(*pb.RpcBackupScheduleSetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BackupScheduleGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupScheduleGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupScheduleGet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupScheduleGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupScheduleGet(ctx, req.(*pb.RpcBackupScheduleGetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_UserDataDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcUserDataDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).UserDataDump(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/UserDataDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).UserDataDump(ctx, req.(*pb.RpcUserDataDumpRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_UserDataRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcUserDataRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).UserDataRestore(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/UserDataRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).UserDataRestore(ctx, req.(*pb.RpcUserDataRestoreRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_WebhookRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebhookRegister(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebhookRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebhookRegister(ctx, req.(*pb.RpcWebhookRegisterRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_WebhookUnregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookUnregisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebhookUnregister(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebhookUnregister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebhookUnregister(ctx, req.(*pb.RpcWebhookUnregisterRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_WebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcWebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).WebhookList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/WebhookList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).WebhookList(ctx, req.(*pb.RpcWebhookListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AutomationCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAutomationCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AutomationCreate(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AutomationCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AutomationCreate(ctx, req.(*pb.RpcAutomationCreateRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AutomationUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAutomationUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AutomationUpdate(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AutomationUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AutomationUpdate(ctx, req.(*pb.RpcAutomationUpdateRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_AutomationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcAutomationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).AutomationList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/AutomationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).AutomationList(ctx, req.(*pb.RpcAutomationListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SavedSearchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSavedSearchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SavedSearchCreate(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SavedSearchCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SavedSearchCreate(ctx, req.(*pb.RpcSavedSearchCreateRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SavedSearchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSavedSearchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SavedSearchUpdate(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SavedSearchUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SavedSearchUpdate(ctx, req.(*pb.RpcSavedSearchUpdateRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_SavedSearchSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcSavedSearchSubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).SavedSearchSubscribe(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/SavedSearchSubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).SavedSearchSubscribe(ctx, req.(*pb.RpcSavedSearchSubscribeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_OcrSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcOcrSetEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).OcrSetEnabled(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/OcrSetEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).OcrSetEnabled(ctx, req.(*pb.RpcOcrSetEnabledRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_TrashRetentionSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTrashRetentionSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TrashRetentionSet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/TrashRetentionSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).TrashRetentionSet(ctx, req.(*pb.RpcTrashRetentionSetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_TrashRetentionGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcTrashRetentionGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).TrashRetentionGet(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/TrashRetentionGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).TrashRetentionGet(ctx, req.(*pb.RpcTrashRetentionGetRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_LogSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcLogSendRequest)
 	if err := dec(in); err != nil {
@@ -7030,10 +8287,42 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectGraph",
 			Handler:    _ClientCommands_ObjectGraph_Handler,
 		},
+		{
+			MethodName: "ObjectGraphNeighborhood",
+			Handler:    _ClientCommands_ObjectGraphNeighborhood_Handler,
+		},
+		{
+			MethodName: "ObjectGraphPath",
+			Handler:    _ClientCommands_ObjectGraphPath_Handler,
+		},
+		{
+			MethodName: "ObjectGraphOrphans",
+			Handler:    _ClientCommands_ObjectGraphOrphans_Handler,
+		},
+		{
+			MethodName: "ObjectDuplicatesList",
+			Handler:    _ClientCommands_ObjectDuplicatesList_Handler,
+		},
+		{
+			MethodName: "ObjectMerge",
+			Handler:    _ClientCommands_ObjectMerge_Handler,
+		},
+		{
+			MethodName: "ObjectHistoryRevert",
+			Handler:    _ClientCommands_ObjectHistoryRevert_Handler,
+		},
 		{
 			MethodName: "ObjectSearch",
 			Handler:    _ClientCommands_ObjectSearch_Handler,
 		},
+		{
+			MethodName: "ObjectSearchSemantic",
+			Handler:    _ClientCommands_ObjectSearchSemantic_Handler,
+		},
+		{
+			MethodName: "ObjectBacklinksList",
+			Handler:    _ClientCommands_ObjectBacklinksList_Handler,
+		},
 		{
 			MethodName: "ObjectSearchSubscribe",
 			Handler:    _ClientCommands_ObjectSearchSubscribe_Handler,
@@ -7106,6 +8395,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectListSetObjectType",
 			Handler:    _ClientCommands_ObjectListSetObjectType_Handler,
 		},
+		{
+			MethodName: "ObjectListSetDetails",
+			Handler:    _ClientCommands_ObjectListSetDetails_Handler,
+		},
+		{
+			MethodName: "ObjectListTransformBlocks",
+			Handler:    _ClientCommands_ObjectListTransformBlocks_Handler,
+		},
 		{
 			MethodName: "ObjectApplyTemplate",
 			Handler:    _ClientCommands_ObjectApplyTemplate_Handler,
@@ -7154,6 +8451,26 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectImportNotionValidateToken",
 			Handler:    _ClientCommands_ObjectImportNotionValidateToken_Handler,
 		},
+		{
+			MethodName: "ObjectImportUndo",
+			Handler:    _ClientCommands_ObjectImportUndo_Handler,
+		},
+		{
+			MethodName: "ObjectImportResume",
+			Handler:    _ClientCommands_ObjectImportResume_Handler,
+		},
+		{
+			MethodName: "ObjectImportPluginRegister",
+			Handler:    _ClientCommands_ObjectImportPluginRegister_Handler,
+		},
+		{
+			MethodName: "ObjectImportPluginUnregister",
+			Handler:    _ClientCommands_ObjectImportPluginUnregister_Handler,
+		},
+		{
+			MethodName: "ObjectImportListEntries",
+			Handler:    _ClientCommands_ObjectImportListEntries_Handler,
+		},
 		{
 			MethodName: "ObjectImportUseCase",
 			Handler:    _ClientCommands_ObjectImportUseCase_Handler,
@@ -7258,6 +8575,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FileSpaceUsage",
 			Handler:    _ClientCommands_FileSpaceUsage_Handler,
 		},
+		{
+			MethodName: "FileRunGC",
+			Handler:    _ClientCommands_FileRunGC_Handler,
+		},
 		{
 			MethodName: "NavigationListObjects",
 			Handler:    _ClientCommands_NavigationListObjects_Handler,
@@ -7282,6 +8603,30 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TemplateExportAll",
 			Handler:    _ClientCommands_TemplateExportAll_Handler,
 		},
+		{
+			MethodName: "TemplateRecurrenceSet",
+			Handler:    _ClientCommands_TemplateRecurrenceSet_Handler,
+		},
+		{
+			MethodName: "TemplateRecurrenceRemove",
+			Handler:    _ClientCommands_TemplateRecurrenceRemove_Handler,
+		},
+		{
+			MethodName: "TemplateRecurrenceList",
+			Handler:    _ClientCommands_TemplateRecurrenceList_Handler,
+		},
+		{
+			MethodName: "FindReplaceSearch",
+			Handler:    _ClientCommands_FindReplaceSearch_Handler,
+		},
+		{
+			MethodName: "FindReplaceApply",
+			Handler:    _ClientCommands_FindReplaceApply_Handler,
+		},
+		{
+			MethodName: "FindReplaceUndo",
+			Handler:    _ClientCommands_FindReplaceUndo_Handler,
+		},
 		{
 			MethodName: "LinkPreview",
 			Handler:    _ClientCommands_LinkPreview_Handler,
@@ -7650,6 +8995,70 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessCancel",
 			Handler:    _ClientCommands_ProcessCancel_Handler,
 		},
+		{
+			MethodName: "BackupScheduleSet",
+			Handler:    _ClientCommands_BackupScheduleSet_Handler,
+		},
+		{
+			MethodName: "BackupScheduleGet",
+			Handler:    _ClientCommands_BackupScheduleGet_Handler,
+		},
+		{
+			MethodName: "UserDataDump",
+			Handler:    _ClientCommands_UserDataDump_Handler,
+		},
+		{
+			MethodName: "UserDataRestore",
+			Handler:    _ClientCommands_UserDataRestore_Handler,
+		},
+		{
+			MethodName: "WebhookRegister",
+			Handler:    _ClientCommands_WebhookRegister_Handler,
+		},
+		{
+			MethodName: "WebhookUnregister",
+			Handler:    _ClientCommands_WebhookUnregister_Handler,
+		},
+		{
+			MethodName: "WebhookList",
+			Handler:    _ClientCommands_WebhookList_Handler,
+		},
+		{
+			MethodName: "AutomationCreate",
+			Handler:    _ClientCommands_AutomationCreate_Handler,
+		},
+		{
+			MethodName: "AutomationUpdate",
+			Handler:    _ClientCommands_AutomationUpdate_Handler,
+		},
+		{
+			MethodName: "AutomationList",
+			Handler:    _ClientCommands_AutomationList_Handler,
+		},
+		{
+			MethodName: "SavedSearchCreate",
+			Handler:    _ClientCommands_SavedSearchCreate_Handler,
+		},
+		{
+			MethodName: "SavedSearchUpdate",
+			Handler:    _ClientCommands_SavedSearchUpdate_Handler,
+		},
+		{
+			MethodName: "SavedSearchSubscribe",
+			Handler:    _ClientCommands_SavedSearchSubscribe_Handler,
+		},
+		{
+			MethodName: "OcrSetEnabled",
+			Handler:    _ClientCommands_OcrSetEnabled_Handler,
+		},
+		{
+			MethodName: "TrashRetentionSet",
+			Handler:    _ClientCommands_TrashRetentionSet_Handler,
+		},
+		{
+			MethodName: "TrashRetentionGet",
+			Handler:    _ClientCommands_TrashRetentionGet_Handler,
+		},
 		{
 			MethodName: "LogSend",
 			Handler:    _ClientCommands_LogSend_Handler,
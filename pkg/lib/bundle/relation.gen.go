@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "d9bac6d7e7ac3918a327e29c65c2587817ac04a02098b4440e337d93a7ee5038"
+const RelationChecksum = "82d29afa95c49c1488e8e1d271131faf0bd79d13a84c4cf87f287c9994bedd67"
 const (
 	RelationKeyTag                       domain.RelationKey = "tag"
 	RelationKeyCamera                    domain.RelationKey = "camera"
@@ -163,6 +163,12 @@ const (
 	RelationKeyIdentityProfileLink       domain.RelationKey = "identityProfileLink"
 	RelationKeyProfileOwnerIdentity      domain.RelationKey = "profileOwnerIdentity"
 	RelationKeyTargetSpaceId             domain.RelationKey = "targetSpaceId"
+	RelationKeyImportRunId               domain.RelationKey = "importRunId"
+	RelationKeyImportContentHash         domain.RelationKey = "importContentHash"
+	RelationKeyAutomationRule            domain.RelationKey = "automationRule"
+	RelationKeySavedSearchQuery          domain.RelationKey = "savedSearchQuery"
+	RelationKeyOcrEnabled                domain.RelationKey = "ocrEnabled"
+	RelationKeyOcrText                   domain.RelationKey = "ocrText"
 )
 
 var (
@@ -336,6 +342,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyAutomationRule: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Definition of automation rule in JSON. Objects with it are rules, which are executed by the middleware",
+			Format:           model.RelationFormat_longtext,
+			Hidden:           true,
+			Id:               "_brautomationRule",
+			Key:              "automationRule",
+			MaxCount:         1,
+			Name:             "Automation rule",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyBacklinks: {
 
 			DataSource:       model.Relation_local,
@@ -920,6 +940,34 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyImportContentHash: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Hash of content of the imported object. Used to find objects, which are imported again without changes",
+			Format:           model.RelationFormat_shorttext,
+			Hidden:           true,
+			Id:               "_brimportContentHash",
+			Key:              "importContentHash",
+			MaxCount:         1,
+			Name:             "Import content hash",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyImportRunId: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Identifier of the import run, which created the object. Used to undo the import",
+			Format:           model.RelationFormat_shorttext,
+			Hidden:           true,
+			Id:               "_brimportRunId",
+			Key:              "importRunId",
+			MaxCount:         1,
+			Name:             "Import run id",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyIngredients: {
 
 			DataSource:       model.Relation_details,
@@ -1322,6 +1370,34 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyOcrEnabled: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Text is recognized in images of the space, when it is set on the workspace object",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brocrEnabled",
+			Key:              "ocrEnabled",
+			MaxCount:         1,
+			Name:             "Text recognition",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyOcrText: {
+
+			DataSource:       model.Relation_local,
+			Description:      "Text recognized in the image, it is searched by full-text search",
+			Format:           model.RelationFormat_longtext,
+			Hidden:           true,
+			Id:               "_brocrText",
+			Key:              "ocrText",
+			MaxCount:         1,
+			Name:             "Recognized text",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyOldAnytypeID: {
 
 			DataSource:       model.Relation_details,
@@ -1670,6 +1746,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeySavedSearchQuery: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Query of saved search in JSON. Objects with it are saved searches, which can be subscribed to",
+			Format:           model.RelationFormat_longtext,
+			Hidden:           true,
+			Id:               "_brsavedSearchQuery",
+			Key:              "savedSearchQuery",
+			MaxCount:         1,
+			Name:             "Saved search query",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyScope: {
 
 			DataSource:       model.Relation_details,
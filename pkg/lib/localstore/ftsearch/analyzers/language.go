@@ -0,0 +1,61 @@
+package analyzers
+
+import (
+	"strings"
+
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/lang/da"
+	"github.com/blevesearch/bleve/v2/analysis/lang/de"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/analysis/lang/es"
+	"github.com/blevesearch/bleve/v2/analysis/lang/fi"
+	"github.com/blevesearch/bleve/v2/analysis/lang/fr"
+	"github.com/blevesearch/bleve/v2/analysis/lang/hu"
+	"github.com/blevesearch/bleve/v2/analysis/lang/it"
+	"github.com/blevesearch/bleve/v2/analysis/lang/nl"
+	"github.com/blevesearch/bleve/v2/analysis/lang/no"
+	"github.com/blevesearch/bleve/v2/analysis/lang/pt"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ro"
+	"github.com/blevesearch/bleve/v2/analysis/lang/ru"
+	"github.com/blevesearch/bleve/v2/analysis/lang/sv"
+	"github.com/blevesearch/bleve/v2/analysis/lang/tr"
+)
+
+// languageAnalyzers are analyzers by language of the locale. Chinese, Japanese and Korean texts are split
+// to bigrams, words of European languages are stemmed
+var languageAnalyzers = map[string]string{
+	"zh": cjk.AnalyzerName,
+	"ja": cjk.AnalyzerName,
+	"ko": cjk.AnalyzerName,
+	"da": da.AnalyzerName,
+	"de": de.AnalyzerName,
+	"en": en.AnalyzerName,
+	"es": es.AnalyzerName,
+	"fi": fi.AnalyzerName,
+	"fr": fr.AnalyzerName,
+	"hu": hu.AnalyzerName,
+	"it": it.AnalyzerName,
+	"nl": nl.AnalyzerName,
+	"no": no.AnalyzerName,
+	"nb": no.AnalyzerName,
+	"nn": no.AnalyzerName,
+	"pt": pt.AnalyzerName,
+	"ro": ro.AnalyzerName,
+	"ru": ru.AnalyzerName,
+	"sv": sv.AnalyzerName,
+	"tr": tr.AnalyzerName,
+}
+
+// ForLocale returns the name of the analyzer for the locale like "de", "pt-BR" or "zh_Hans". Standard analyzer
+// is used for other languages
+func ForLocale(locale string) string {
+	lang := strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	if name, ok := languageAnalyzers[lang]; ok {
+		return name
+	}
+	return standard.Name
+}
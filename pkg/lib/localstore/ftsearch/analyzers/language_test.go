@@ -0,0 +1,24 @@
+package analyzers
+
+import (
+	"testing"
+
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/analysis/lang/de"
+	"github.com/blevesearch/bleve/v2/analysis/lang/pt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForLocale(t *testing.T) {
+	for locale, expected := range map[string]string{
+		"zh_Hans": cjk.AnalyzerName,
+		"ja-JP":   cjk.AnalyzerName,
+		"de":      de.AnalyzerName,
+		"pt-BR":   pt.AnalyzerName,
+		"":        standard.Name,
+		"xx":      standard.Name,
+	} {
+		assert.Equal(t, expected, ForLocale(locale), locale)
+	}
+}
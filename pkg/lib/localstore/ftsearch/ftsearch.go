@@ -33,6 +33,10 @@ const (
 	fieldTitleNoTerms = "TitleNoTerms"
 	fieldTextNoTerms  = "TextNoTerms"
 	fieldID           = "Id"
+
+	defaultSearchLimit = 100
+	// termSearchLimit limits results of one term of advanced query, results of terms are combined by operators
+	termSearchLimit = 1000
 )
 
 var log = logging.Logger("ftsearch")
@@ -56,6 +60,13 @@ type FTSearch interface {
 	Index(d SearchDoc) (err error)
 	BatchIndex(docs []SearchDoc) (err error)
 	Search(spaceID, query string) (results []string, err error)
+	// SearchTerm returns ids of objects matching the term of advanced query. Term should have indexed field
+	SearchTerm(spaceID string, term *Query) (results []string, err error)
+	// Highlights returns matches of positive terms of the query in name and text of the objects by their ids
+	Highlights(q *Query, ids []string) (highlights map[string][]Highlight, err error)
+	// Scores returns relevance scores of the objects found by the query, so clients can rank results of search.
+	// Query of plain text is scored like in Search
+	Scores(q *Query, ids []string) (scores map[string]float64, err error)
 	Has(id string) (exists bool, err error)
 	Delete(id string) error
 	DocCount() (uint64, error)
@@ -66,13 +77,29 @@ type ftSearch struct {
 	ftsPath        string
 	index          bleve.Index
 	enStopWordsMap map[string]bool
+	fuzzy          FuzzyConfig
+	// analyzer is the analyzer of title and text selected by the locale of the account
+	analyzer string
+}
+
+type localeGetter interface {
+	GetLocale() string
 }
 
 func (f *ftSearch) Init(a *app.App) (err error) {
 	repoPath := a.MustComponent(wallet.CName).(wallet.Wallet).RepoPath()
 	f.rootPath = filepath.Join(repoPath, ftsDir)
-	f.ftsPath = filepath.Join(repoPath, ftsDir, ftsVer)
 	f.enStopWordsMap, err = en.TokenMapConstructor(nil, nil)
+	if cfg, ok := a.Component("config").(fuzzyConfigGetter); ok {
+		f.fuzzy = cfg.GetFullTextFuzzy()
+	}
+	var locale string
+	if cfg, ok := a.Component("config").(localeGetter); ok {
+		locale = cfg.GetLocale()
+	}
+	f.analyzer = analyzers.ForLocale(locale)
+	f.ftsPath = filepath.Join(f.rootPath, indexDirName(f.analyzer))
+	f.fuzzy = f.fuzzy.withDefaults()
 	return err
 }
 
@@ -83,7 +110,7 @@ func (f *ftSearch) Name() (name string) {
 func (f *ftSearch) Run(context.Context) (err error) {
 	f.index, err = bleve.Open(f.ftsPath)
 	if err == bleve.ErrorIndexPathDoesNotExist || err == bleve.ErrorIndexMetaMissing {
-		if f.index, err = bleve.New(f.ftsPath, makeMapping()); err != nil {
+		if f.index, err = bleve.New(f.ftsPath, makeLanguageMapping(f.analyzer)); err != nil {
 			return
 		}
 		f.cleanUpOldIndexes()
@@ -97,9 +124,10 @@ func (f *ftSearch) cleanUpOldIndexes() {
 	if strings.HasSuffix(f.rootPath, ftsDir) {
 		dirs, err := os.ReadDir(f.rootPath)
 		if err == nil {
-			// cleanup old index versions
+			// cleanup old index versions and indexes of other languages
+			current := filepath.Base(f.ftsPath)
 			for _, dir := range dirs {
-				if dir.Name() != ftsVer {
+				if dir.Name() != current {
 					_ = os.RemoveAll(filepath.Join(f.rootPath, dir.Name()))
 				}
 			}
@@ -141,6 +169,10 @@ func (f *ftSearch) BatchIndex(docs []SearchDoc) (err error) {
 }
 
 func (f *ftSearch) Search(spaceID, qry string) (results []string, err error) {
+	return f.doSearch(spaceID, f.plainQueries(qry), defaultSearchLimit)
+}
+
+func (f *ftSearch) plainQueries(qry string) []query.Query {
 	qry = strings.ToLower(qry)
 	qry = strings.TrimSpace(qry)
 	terms := f.getTerms(qry)
@@ -156,9 +188,139 @@ func (f *ftSearch) Search(spaceID, qry string) (results []string, err error) {
 			getAllWordsFromQueryConsequently(terms, fieldTitleNoTerms),
 			getAllWordsFromQueryConsequently(terms, fieldTextNoTerms),
 		)
+		queries = append(queries, f.fuzzy.fuzzyQueries(terms)...)
+	}
+	return queries
+}
+
+func (f *ftSearch) SearchTerm(spaceID string, term *Query) (results []string, err error) {
+	if !IsIndexedField(term.Field) {
+		return nil, fmt.Errorf("field %s is not indexed", term.Field)
+	}
+	text := strings.ToLower(strings.TrimSpace(term.Text))
+	var queries []query.Query
+	for _, field := range indexFields(term.Field) {
+		queries = append(queries, matchQuery(text, term.Phrase, field))
+		if !term.Phrase {
+			// parts of words are found in fields without terms, like in plain search
+			queries = append(queries, getAllWordsFromQueryConsequently([]string{text}, noTermsFields[field]))
+		}
+	}
+	return f.doSearch(spaceID, queries, termSearchLimit)
+}
+
+func (f *ftSearch) Highlights(q *Query, ids []string) (map[string][]Highlight, error) {
+	var queries []query.Query
+	for _, term := range q.PositiveTerms() {
+		if !IsIndexedField(term.Field) {
+			continue
+		}
+		text := strings.ToLower(strings.TrimSpace(term.Text))
+		for _, field := range indexFields(term.Field) {
+			queries = append(queries, matchQuery(text, term.Phrase, field))
+		}
+	}
+	if len(queries) == 0 || len(ids) == 0 {
+		return nil, nil
+	}
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(
+		bleve.NewDocIDQuery(ids),
+		bleve.NewDisjunctionQuery(queries...),
+	))
+	searchRequest.Size = len(ids)
+	searchRequest.IncludeLocations = true
+	searchRequest.Fields = []string{fieldTitle, fieldText}
+	searchResult, err := f.index.Search(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+	highlights := make(map[string][]Highlight, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		for _, field := range []string{fieldTitle, fieldText} {
+			value, _ := hit.Fields[field].(string)
+			var spans []span
+			for _, locations := range hit.Locations[field] {
+				for _, location := range locations {
+					spans = append(spans, span{start: int(location.Start), end: int(location.End)})
+				}
+			}
+			if h, ok := makeHighlight(highlightFields[field], value, spans); ok {
+				highlights[hit.ID] = append(highlights[hit.ID], h)
+			}
+		}
 	}
+	return highlights, nil
+}
+
+func (f *ftSearch) Scores(q *Query, ids []string) (map[string]float64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var queries []query.Query
+	if q.IsPlainText() {
+		queries = f.plainQueries(q.Text)
+	} else {
+		for _, term := range q.PositiveTerms() {
+			if !IsIndexedField(term.Field) {
+				continue
+			}
+			text := strings.ToLower(strings.TrimSpace(term.Text))
+			for _, field := range indexFields(term.Field) {
+				queries = append(queries, matchQuery(text, term.Phrase, field))
+			}
+		}
+	}
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(
+		bleve.NewDocIDQuery(ids),
+		bleve.NewDisjunctionQuery(queries...),
+	))
+	searchRequest.Size = len(ids)
+	searchResult, err := f.index.Search(searchRequest)
+	if err != nil {
+		return nil, err
+	}
+	scores := make(map[string]float64, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		scores[hit.ID] = hit.Score
+	}
+	return scores, nil
+}
 
-	return f.doSearch(spaceID, queries)
+var (
+	noTermsFields = map[string]string{
+		fieldTitle: fieldTitleNoTerms,
+		fieldText:  fieldTextNoTerms,
+	}
+	highlightFields = map[string]string{
+		fieldTitle: FieldName,
+		fieldText:  FieldText,
+	}
+)
+
+// indexFields returns fields of the index for the field of the term
+func indexFields(field string) []string {
+	switch field {
+	case FieldName:
+		return []string{fieldTitle}
+	case FieldText:
+		return []string{fieldText}
+	default:
+		return []string{fieldTitle, fieldText}
+	}
+}
+
+func matchQuery(text string, phrase bool, field string) query.Query {
+	if phrase {
+		q := bleve.NewMatchPhraseQuery(text)
+		q.SetField(field)
+		return q
+	}
+	q := bleve.NewMatchQuery(text)
+	q.SetField(field)
+	return q
 }
 
 func (f *ftSearch) getTerms(qry string) []string {
@@ -175,7 +337,7 @@ func (f *ftSearch) getTerms(qry string) []string {
 	return terms
 }
 
-func (f *ftSearch) doSearch(spaceID string, queries []query.Query) (results []string, err error) {
+func (f *ftSearch) doSearch(spaceID string, queries []query.Query, limit int) (results []string, err error) {
 	var rootQuery query.Query = bleve.NewDisjunctionQuery(queries...)
 	if spaceID != "" {
 		spaceQuery := bleve.NewMatchQuery(spaceID)
@@ -184,7 +346,7 @@ func (f *ftSearch) doSearch(spaceID string, queries []query.Query) (results []st
 	}
 
 	searchRequest := bleve.NewSearchRequest(rootQuery)
-	searchRequest.Size = 100
+	searchRequest.Size = limit
 	searchRequest.Explain = true
 	searchResult, err := f.index.Search(searchRequest)
 	if err != nil {
@@ -219,22 +381,37 @@ func (f *ftSearch) Close(ctx context.Context) error {
 	return nil
 }
 
+// indexDirName returns the directory of the index. Mapping of the index can't be changed, so the index is
+// rebuilt in a new directory, when the analyzer is changed
+func indexDirName(analyzer string) string {
+	if analyzer == standard.Name {
+		return ftsVer
+	}
+	return ftsVer + "-" + analyzer
+}
+
 func makeMapping() mapping.IndexMapping {
+	return makeLanguageMapping(standard.Name)
+}
+
+func makeLanguageMapping(analyzer string) mapping.IndexMapping {
 	indexMapping := bleve.NewIndexMapping()
+	// query without field is analyzed by default analyzer
+	indexMapping.DefaultAnalyzer = analyzer
 
 	addNoTermsMapping(indexMapping)
-	addDefaultMapping(indexMapping)
+	addDefaultMapping(indexMapping, analyzer)
 
 	return indexMapping
 }
 
-func addDefaultMapping(indexMapping *mapping.IndexMappingImpl) {
+func addDefaultMapping(indexMapping *mapping.IndexMappingImpl, analyzer string) {
 	fields := []string{
 		fieldTitle,
 		fieldText,
 	}
 
-	addMappings(indexMapping, fields, getStandardMapping())
+	addMappings(indexMapping, fields, getTextMapping(analyzer))
 }
 
 func addNoTermsMapping(indexMapping *mapping.IndexMappingImpl) {
@@ -259,10 +436,10 @@ func addMappings(indexMapping *mapping.IndexMappingImpl, fields []string, mappin
 	}
 }
 
-func getStandardMapping() *mapping.FieldMapping {
-	standardMapping := bleve.NewTextFieldMapping()
-	standardMapping.Analyzer = standard.Name
-	return standardMapping
+func getTextMapping(analyzer string) *mapping.FieldMapping {
+	textMapping := bleve.NewTextFieldMapping()
+	textMapping.Analyzer = analyzer
+	return textMapping
 }
 
 func getAllWordsFromQueryConsequently(terms []string, field string) query.Query {
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -21,11 +23,24 @@ type fixture struct {
 	ctrl *gomock.Controller
 }
 
+type localeConfig struct {
+	locale string
+}
+
+func (c *localeConfig) Init(*app.App) error { return nil }
+func (c *localeConfig) Name() string        { return "config" }
+func (c *localeConfig) GetLocale() string   { return c.locale }
+
 func newFixture(path string, t *testing.T) *fixture {
+	return newFixtureWithLocale(path, "", t)
+}
+
+func newFixtureWithLocale(path, locale string, t *testing.T) *fixture {
 	ft := New()
 	ta := new(app.App)
 
 	ta.Register(wallet.NewWithRepoDirAndRandomKeys(path)).
+		Register(&localeConfig{locale: locale}).
 		Register(ft)
 
 	require.NoError(t, ta.Start(context.Background()))
@@ -68,6 +83,14 @@ func TestNewFTSearch(t *testing.T) {
 			name:   "assertMultiSpace",
 			tester: assertMultiSpace,
 		},
+		{
+			name:   "assertFuzzySearch",
+			tester: assertFuzzySearch,
+		},
+		{
+			name:   "assertLanguageAnalyzer",
+			tester: assertLanguageAnalyzer,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -357,3 +380,62 @@ func assertMultiSpace(t *testing.T, tmpDir string) {
 
 	_ = ft.Close(nil)
 }
+
+func assertFuzzySearch(t *testing.T, tmpDir string) {
+	fixture := newFixture(tmpDir, t)
+	ft := fixture.ft
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:    "1",
+		Title: "How to receive payments",
+	}))
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:    "2",
+		Title: "Notes",
+		Text:  "we recieve payments weekly",
+	}))
+
+	validateSearch(t, ft, "", "recieve", 2)
+	validateSearch(t, ft, "", "paymnets", 2)
+
+	qry, _ := ParseFullText("recieve", nil)
+	scores, err := ft.Scores(qry, []string{"1", "2"})
+	require.NoError(t, err)
+	require.Len(t, scores, 2)
+	// exact match is more relevant than the match with typo
+	assert.Greater(t, scores["2"], scores["1"])
+
+	ft.(*ftSearch).fuzzy.Disabled = true
+	validateSearch(t, ft, "", "paymnets", 0)
+
+	_ = ft.Close(nil)
+}
+
+func assertLanguageAnalyzer(t *testing.T, tmpDir string) {
+	t.Run("cjk bigrams", func(t *testing.T) {
+		// given
+		fixture := newFixtureWithLocale(filepath.Join(tmpDir, "zh"), "zh-CN", t)
+		ft := fixture.ft
+		require.NoError(t, ft.Index(SearchDoc{Id: "1", Text: "长江大桥"}))
+		require.NoError(t, ft.Index(SearchDoc{Id: "2", Text: "你好"}))
+
+		// then
+		assert.Equal(t, cjk.AnalyzerName, ft.(*ftSearch).index.Mapping().AnalyzerNameForPath(fieldText))
+		assert.Equal(t, ftsVer+"-"+cjk.AnalyzerName, filepath.Base(ft.(*ftSearch).ftsPath))
+		validateSearch(t, ft, "", "大桥", 1)
+		validateSearch(t, ft, "", "你好世界", 1)
+
+		_ = ft.Close(nil)
+	})
+	t.Run("stemming", func(t *testing.T) {
+		// given
+		fixture := newFixtureWithLocale(filepath.Join(tmpDir, "en"), "en-US", t)
+		ft := fixture.ft
+		ft.(*ftSearch).fuzzy.Disabled = true
+		require.NoError(t, ft.Index(SearchDoc{Id: "1", Title: "Running shoes"}))
+
+		// then
+		validateSearch(t, ft, "", "runs", 1)
+
+		_ = ft.Close(nil)
+	})
+}
@@ -0,0 +1,78 @@
+package ftsearch
+
+import (
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	// maxFuzziness is the max edit distance supported by the index
+	maxFuzziness       = 2
+	defaultPrefixBoost = 2
+)
+
+// FuzzyConfig configures typo-tolerant matching of words of plain queries
+type FuzzyConfig struct {
+	// Disabled turns off fuzzy matching, so only words, their prefixes and parts are found
+	Disabled bool `json:",omitempty"`
+	// MaxEdits limits the edit distance of fuzzy matches, it's 2 at most. Zero means the max distance
+	MaxEdits int `json:",omitempty"`
+	// PrefixBoost is the boost of names starting with the word. Zero means the default boost
+	PrefixBoost float64 `json:",omitempty"`
+}
+
+type fuzzyConfigGetter interface {
+	GetFullTextFuzzy() FuzzyConfig
+}
+
+func (c FuzzyConfig) withDefaults() FuzzyConfig {
+	if c.MaxEdits <= 0 || c.MaxEdits > maxFuzziness {
+		c.MaxEdits = maxFuzziness
+	}
+	if c.PrefixBoost <= 0 {
+		c.PrefixBoost = defaultPrefixBoost
+	}
+	return c
+}
+
+// fuzziness returns the edit distance for the word. Short words aren't matched fuzzily, as almost any
+// word is close to them
+func (c FuzzyConfig) fuzziness(word string) int {
+	if c.Disabled {
+		return 0
+	}
+	var edits int
+	switch length := utf8.RuneCountInString(word); {
+	case length >= 7:
+		edits = 2
+	case length >= 4:
+		edits = 1
+	}
+	if edits > c.MaxEdits {
+		return c.MaxEdits
+	}
+	return edits
+}
+
+// fuzzyQueries returns queries matching words with typos in name and text and boosting names starting
+// with the words
+func (c FuzzyConfig) fuzzyQueries(terms []string) []query.Query {
+	var queries []query.Query
+	for _, term := range terms {
+		if edits := c.fuzziness(term); edits > 0 {
+			for _, field := range []string{fieldTitle, fieldText} {
+				fuzzyQuery := bleve.NewFuzzyQuery(term)
+				fuzzyQuery.SetFuzziness(edits)
+				fuzzyQuery.SetField(field)
+				queries = append(queries, fuzzyQuery)
+			}
+		}
+		prefixQuery := bleve.NewPrefixQuery(term)
+		prefixQuery.SetField(fieldTitle)
+		prefixQuery.SetBoost(c.PrefixBoost)
+		queries = append(queries, prefixQuery)
+	}
+	return queries
+}
@@ -0,0 +1,42 @@
+package ftsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzziness(t *testing.T) {
+	t.Run("edits depend on length of the word", func(t *testing.T) {
+		// given
+		cfg := FuzzyConfig{}.withDefaults()
+
+		// then
+		assert.Equal(t, 0, cfg.fuzziness("the"))
+		assert.Equal(t, 1, cfg.fuzziness("notes"))
+		assert.Equal(t, 2, cfg.fuzziness("recieve"))
+		assert.Equal(t, 1, cfg.fuzziness("пример"))
+	})
+	t.Run("edits are limited by config", func(t *testing.T) {
+		// given
+		cfg := FuzzyConfig{MaxEdits: 1}.withDefaults()
+
+		// then
+		assert.Equal(t, 1, cfg.fuzziness("recieve"))
+	})
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		cfg := FuzzyConfig{Disabled: true}.withDefaults()
+
+		// then
+		assert.Equal(t, 0, cfg.fuzziness("recieve"))
+	})
+	t.Run("defaults", func(t *testing.T) {
+		// when
+		cfg := FuzzyConfig{MaxEdits: 5}.withDefaults()
+
+		// then
+		assert.Equal(t, maxFuzziness, cfg.MaxEdits)
+		assert.Equal(t, float64(defaultPrefixBoost), cfg.PrefixBoost)
+	})
+}
@@ -0,0 +1,102 @@
+package ftsearch
+
+import (
+	"sort"
+
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const (
+	// fragmentContext is the number of characters of the text shown before the first match
+	fragmentContext = 60
+	// fragmentLength limits the length of the fragment of the text
+	fragmentLength = 300
+)
+
+// Highlight is the part of the indexed field of the object with matches of the query
+type Highlight struct {
+	// Field is FieldName or FieldText
+	Field    string
+	Fragment string
+	Ranges   []Range
+}
+
+// Range is the range of the match in the fragment, in UTF-16 code units
+type Range struct {
+	From int32
+	To   int32
+}
+
+// span is the range of the match in the value, in bytes
+type span struct {
+	start, end int
+}
+
+// makeHighlight cuts the fragment of the value around the first match. Name is returned as is
+func makeHighlight(field, value string, spans []span) (Highlight, bool) {
+	spans = mergeSpans(value, spans)
+	if len(spans) == 0 {
+		return Highlight{}, false
+	}
+	start, end := 0, len(value)
+	if field == FieldText {
+		start = runesBefore(value, spans[0].start, fragmentContext)
+		end = runesAfter(value, start, fragmentLength)
+	}
+	h := Highlight{Field: field, Fragment: value[start:end]}
+	for _, s := range spans {
+		if s.start < start || s.end > end {
+			continue
+		}
+		from := int32(textutil.UTF16RuneCountString(value[start:s.start]))
+		h.Ranges = append(h.Ranges, Range{
+			From: from,
+			To:   from + int32(textutil.UTF16RuneCountString(value[s.start:s.end])),
+		})
+	}
+	return h, true
+}
+
+// mergeSpans sorts spans and joins overlapping ones. Spans out of the value are skipped
+func mergeSpans(value string, spans []span) []span {
+	valid := make([]span, 0, len(spans))
+	for _, s := range spans {
+		if s.start >= 0 && s.start < s.end && s.end <= len(value) {
+			valid = append(valid, s)
+		}
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].start < valid[j].start
+	})
+	var merged []span
+	for _, s := range valid {
+		if last := len(merged) - 1; last >= 0 && s.start <= merged[last].end {
+			if s.end > merged[last].end {
+				merged[last].end = s.end
+			}
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// runesBefore returns byte position, which is n runes before pos
+func runesBefore(value string, pos, n int) int {
+	runes := []rune(value[:pos])
+	if len(runes) <= n {
+		return 0
+	}
+	return pos - len(string(runes[len(runes)-n:]))
+}
+
+// runesAfter returns byte position, which is n runes after pos
+func runesAfter(value string, pos, n int) int {
+	for i := range value[pos:] {
+		if n == 0 {
+			return pos + i
+		}
+		n--
+	}
+	return len(value)
+}
@@ -0,0 +1,54 @@
+package ftsearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeHighlight(t *testing.T) {
+	t.Run("name is highlighted as is", func(t *testing.T) {
+		// given
+		value := "Meeting notes about notes"
+
+		// when
+		h, ok := makeHighlight(FieldName, value, []span{{start: 20, end: 25}, {start: 8, end: 13}})
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, value, h.Fragment)
+		assert.Equal(t, []Range{{From: 8, To: 13}, {From: 20, To: 25}}, h.Ranges)
+	})
+	t.Run("fragment of text around the first match", func(t *testing.T) {
+		// given
+		prefix := strings.Repeat("a", fragmentContext+10)
+		value := prefix + "😀 match " + strings.Repeat("b", fragmentLength)
+		start := len(prefix + "😀 ")
+
+		// when
+		h, ok := makeHighlight(FieldText, value, []span{{start: start, end: start + len("match")}})
+
+		// then
+		require.True(t, ok)
+		assert.True(t, strings.HasPrefix(h.Fragment, strings.Repeat("a", fragmentContext-2)+"😀 match"))
+		assert.Len(t, []rune(h.Fragment), fragmentLength)
+		assert.Equal(t, []Range{{From: fragmentContext + 1, To: fragmentContext + 6}}, h.Ranges)
+	})
+	t.Run("overlapping matches are merged", func(t *testing.T) {
+		// when
+		h, ok := makeHighlight(FieldName, "foobar", []span{{start: 0, end: 4}, {start: 3, end: 6}})
+
+		// then
+		require.True(t, ok)
+		assert.Equal(t, []Range{{From: 0, To: 6}}, h.Ranges)
+	})
+	t.Run("no matches", func(t *testing.T) {
+		// when
+		_, ok := makeHighlight(FieldName, "foo", []span{{start: 2, end: 10}})
+
+		// then
+		assert.False(t, ok)
+	})
+}
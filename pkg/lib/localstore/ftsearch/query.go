@@ -0,0 +1,317 @@
+package ftsearch
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// QueryOp is the operation of the node of the query
+type QueryOp int
+
+const (
+	QueryTerm QueryOp = iota
+	QueryAnd
+	QueryOr
+	QueryNot
+)
+
+// Query is the parsed full-text query. Term is matched in the field or in name and text, if field is empty.
+// Other nodes combine results of their children
+type Query struct {
+	Op       QueryOp
+	Field    string
+	Text     string
+	Phrase   bool
+	Children []*Query
+}
+
+// Keys of fields of the full-text index used in scoped terms, other fields are relations of objects
+const (
+	FieldName = "name"
+	FieldText = "text"
+)
+
+// IsIndexedField returns true, if the term with the field is searched in the full-text index
+func IsIndexedField(field string) bool {
+	return field == "" || field == FieldName || field == FieldText
+}
+
+type tokenType int
+
+const (
+	tokenTerm tokenType = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+type token struct {
+	typ    tokenType
+	field  string
+	text   string
+	phrase bool
+}
+
+// IsAdvancedQuery returns true, if the query uses phrases, operators or fields. Other queries are searched
+// as plain text. isField tells, if the word before colon is a field, e.g. key of relation, so text like
+// "todo:buy" isn't taken as scoped term. Only indexed fields are known, if isField is nil
+func IsAdvancedQuery(qry string, isField func(key string) bool) bool {
+	for _, t := range tokenize(qry, isField) {
+		if t.typ != tokenTerm || t.phrase || t.field != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseQuery parses the query. Terms without operator between them are joined with AND, AND binds stronger
+// than OR. Phrase is quoted, "-" before a term is the same as NOT and field is set before the term as field:term
+func ParseQuery(qry string, isField func(key string) bool) (*Query, error) {
+	p := &parser{tokens: tokenize(qry, isField)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("query is empty")
+	}
+	q, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected closing parenthesis")
+	}
+	return q, nil
+}
+
+// ParseFullText returns the query of full-text search and true, if it is an advanced query. Plain queries and
+// queries, which can't be parsed, like "meeting (q3" or "foo OR", are searched as plain text, so they are
+// returned as the single term with the whole text
+func ParseFullText(qry string, isField func(key string) bool) (q *Query, advanced bool) {
+	if IsAdvancedQuery(qry, isField) {
+		if q, err := ParseQuery(qry, isField); err == nil {
+			return q, true
+		}
+	}
+	return &Query{Op: QueryTerm, Text: qry}, false
+}
+
+// IsPlainText returns true for the query of plain text search returned by ParseFullText
+func (q *Query) IsPlainText() bool {
+	return q.Op == QueryTerm && q.Field == "" && !q.Phrase
+}
+
+// PositiveTerms returns terms of the query, which aren't negated
+func (q *Query) PositiveTerms() []*Query {
+	switch q.Op {
+	case QueryTerm:
+		return []*Query{q}
+	case QueryNot:
+		return nil
+	}
+	var terms []*Query
+	for _, child := range q.Children {
+		terms = append(terms, child.PositiveTerms()...)
+	}
+	return terms
+}
+
+func (q *Query) String() string {
+	switch q.Op {
+	case QueryTerm:
+		text := q.Text
+		if q.Phrase {
+			text = `"` + text + `"`
+		}
+		if q.Field != "" {
+			return q.Field + ":" + text
+		}
+		return text
+	case QueryNot:
+		return "NOT " + q.Children[0].String()
+	}
+	op := " AND "
+	if q.Op == QueryOr {
+		op = " OR "
+	}
+	parts := make([]string, 0, len(q.Children))
+	for _, child := range q.Children {
+		parts = append(parts, child.String())
+	}
+	return "(" + strings.Join(parts, op) + ")"
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) parseOr() (*Query, error) {
+	var children []*Query
+	for {
+		child, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		if t, ok := p.peek(); !ok || t.typ != tokenOr {
+			break
+		}
+		p.pos++
+	}
+	return combine(QueryOr, children), nil
+}
+
+func (p *parser) parseAnd() (*Query, error) {
+	var children []*Query
+	for {
+		t, ok := p.peek()
+		if !ok || t.typ == tokenOr || t.typ == tokenClose {
+			break
+		}
+		if t.typ == tokenAnd {
+			p.pos++
+			continue
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return nil, fmt.Errorf("operator without terms")
+	}
+	return combine(QueryAnd, children), nil
+}
+
+func (p *parser) parseUnary() (*Query, error) {
+	t, _ := p.peek()
+	switch t.typ {
+	case tokenNot:
+		p.pos++
+		if next, ok := p.peek(); !ok || next.typ == tokenOr || next.typ == tokenAnd || next.typ == tokenClose {
+			return nil, fmt.Errorf("NOT without term")
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Query{Op: QueryNot, Children: []*Query{child}}, nil
+	case tokenOpen:
+		p.pos++
+		q, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if next, ok := p.peek(); !ok || next.typ != tokenClose {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return q, nil
+	default:
+		p.pos++
+		return &Query{Op: QueryTerm, Field: t.field, Text: t.text, Phrase: t.phrase}, nil
+	}
+}
+
+func combine(op QueryOp, children []*Query) *Query {
+	if len(children) == 1 {
+		return children[0]
+	}
+	return &Query{Op: op, Children: children}
+}
+
+func tokenize(qry string, isField func(key string) bool) []token {
+	var (
+		tokens []token
+		runes  = []rune(qry)
+	)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{typ: tokenOpen})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{typ: tokenClose})
+			i++
+		case r == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) && (i == 0 || unicode.IsSpace(runes[i-1]) || runes[i-1] == '('):
+			tokens = append(tokens, token{typ: tokenNot})
+			i++
+		default:
+			var t token
+			t, i = readTerm(runes, i, isField)
+			if t.text != "" {
+				tokens = append(tokens, t)
+			}
+		}
+	}
+	return tokens
+}
+
+// readTerm reads the term starting at i and returns it with position after the term
+func readTerm(runes []rune, i int, isField func(key string) bool) (token, int) {
+	t := token{typ: tokenTerm}
+	if field, next, ok := readField(runes, i, isField); ok {
+		t.field = field
+		i = next
+	}
+	if i < len(runes) && runes[i] == '"' {
+		end := i + 1
+		for end < len(runes) && runes[end] != '"' {
+			end++
+		}
+		t.text = strings.TrimSpace(string(runes[i+1 : end]))
+		t.phrase = true
+		if end < len(runes) {
+			end++
+		}
+		return t, end
+	}
+	end := i
+	for end < len(runes) && !unicode.IsSpace(runes[end]) && runes[end] != '(' && runes[end] != ')' {
+		end++
+	}
+	t.text = string(runes[i:end])
+	if t.field == "" {
+		switch t.text {
+		case "AND":
+			t.typ = tokenAnd
+		case "OR":
+			t.typ = tokenOr
+		case "NOT":
+			t.typ = tokenNot
+		}
+	}
+	return t, end
+}
+
+// readField reads "field:" prefix of the term. Field is an indexed field or known by isField, so text like url
+// or "re:meeting" isn't taken as field
+func readField(runes []rune, i int, isField func(key string) bool) (field string, next int, ok bool) {
+	end := i
+	for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_') {
+		end++
+	}
+	if end == i || end+1 >= len(runes) || runes[end] != ':' || !unicode.IsLetter(runes[i]) {
+		return "", i, false
+	}
+	if unicode.IsSpace(runes[end+1]) || runes[end+1] == '/' || runes[end+1] == ')' {
+		return "", i, false
+	}
+	field = string(runes[i:end])
+	if field != FieldName && field != FieldText && (isField == nil || !isField(field)) {
+		return "", i, false
+	}
+	return field, end + 1, true
+}
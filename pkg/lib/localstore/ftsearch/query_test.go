@@ -0,0 +1,98 @@
+package ftsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isTestField knows only the tag relation besides indexed fields
+func isTestField(key string) bool {
+	return key == "tag"
+}
+
+func TestParseQuery(t *testing.T) {
+	for _, tc := range []struct {
+		query    string
+		expected string
+	}{
+		{query: "foo", expected: "foo"},
+		{query: "foo bar", expected: "(foo AND bar)"},
+		{query: `"foo bar" baz`, expected: `("foo bar" AND baz)`},
+		{query: "foo OR bar baz", expected: "(foo OR (bar AND baz))"},
+		{query: "foo AND (bar OR baz)", expected: "(foo AND (bar OR baz))"},
+		{query: "foo -bar NOT baz", expected: "(foo AND NOT bar AND NOT baz)"},
+		{query: `name:foo tag:"bar baz"`, expected: `(name:foo AND tag:"bar baz")`},
+		{query: "http://example.com", expected: "http://example.com"},
+		{query: "well-known", expected: "well-known"},
+		{query: "todo:buy re:meeting", expected: "(todo:buy AND re:meeting)"},
+		{query: `"unterminated phrase`, expected: `"unterminated phrase"`},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			// when
+			q, err := ParseQuery(tc.query, isTestField)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, q.String())
+		})
+	}
+
+	t.Run("invalid queries", func(t *testing.T) {
+		for _, query := range []string{"", "foo OR", "(foo", "foo)", "NOT"} {
+			_, err := ParseQuery(query, isTestField)
+			assert.Error(t, err, query)
+		}
+	})
+}
+
+func TestIsAdvancedQuery(t *testing.T) {
+	assert.False(t, IsAdvancedQuery("plain text query", isTestField))
+	assert.False(t, IsAdvancedQuery("http://example.com", isTestField))
+	assert.False(t, IsAdvancedQuery("todo:buy", isTestField))
+	assert.False(t, IsAdvancedQuery("tag:bar", nil))
+	assert.True(t, IsAdvancedQuery(`"exact phrase"`, isTestField))
+	assert.True(t, IsAdvancedQuery("foo OR bar", isTestField))
+	assert.True(t, IsAdvancedQuery("foo -bar", isTestField))
+	assert.True(t, IsAdvancedQuery("tag:bar", isTestField))
+	assert.True(t, IsAdvancedQuery("name:bar", nil))
+}
+
+func TestParseFullText(t *testing.T) {
+	t.Run("advanced query", func(t *testing.T) {
+		// when
+		q, advanced := ParseFullText("foo OR tag:bar", isTestField)
+
+		// then
+		assert.True(t, advanced)
+		assert.Equal(t, "(foo OR tag:bar)", q.String())
+	})
+
+	t.Run("invalid queries are plain text", func(t *testing.T) {
+		for _, query := range []string{"meeting (q3", "foo NOT", "OR", "todo:buy"} {
+			// when
+			q, advanced := ParseFullText(query, isTestField)
+
+			// then
+			assert.False(t, advanced, query)
+			assert.True(t, q.IsPlainText(), query)
+			assert.Equal(t, query, q.Text)
+		}
+	})
+}
+
+func TestPositiveTerms(t *testing.T) {
+	// given
+	q, err := ParseQuery("foo (bar OR name:baz) -qux", isTestField)
+	require.NoError(t, err)
+
+	// when
+	terms := q.PositiveTerms()
+
+	// then
+	require.Len(t, terms, 3)
+	assert.Equal(t, "foo", terms[0].Text)
+	assert.Equal(t, "bar", terms[1].Text)
+	assert.Equal(t, FieldName, terms[2].Field)
+}
@@ -0,0 +1,85 @@
+package ftsearch
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	defaultTextWeight      = 1
+	defaultOpenedWeight    = 0.3
+	defaultModifiedWeight  = 0.2
+	defaultBacklinksWeight = 0.3
+	defaultRecencyHalfLife = 30 * 24 * time.Hour
+	// backlinksHalfSignal is the number of inbound links giving the half of the backlinks signal
+	backlinksHalfSignal = 3
+)
+
+// RankingConfig sets weights of signals combined into the rank of full-text search results, so frequently
+// used and referenced objects surface first. If all weights are zero, default weights are used
+type RankingConfig struct {
+	// Disabled turns off ranking, so results are ordered by text relevance only
+	Disabled bool `json:",omitempty"`
+	// TextWeight is the weight of text relevance
+	TextWeight float64 `json:",omitempty"`
+	// OpenedWeight is the weight of recency of the last opening
+	OpenedWeight float64 `json:",omitempty"`
+	// ModifiedWeight is the weight of recency of the last edit
+	ModifiedWeight float64 `json:",omitempty"`
+	// BacklinksWeight is the weight of the number of inbound links
+	BacklinksWeight float64 `json:",omitempty"`
+	// RecencyHalfLife is the age, at which recency signals are halved. Zero means 30 days
+	RecencyHalfLife time.Duration `json:",omitempty"`
+}
+
+// RankSignals are signals of the object found by full-text search
+type RankSignals struct {
+	// Relevance is text relevance from 0 to 1
+	Relevance    float64
+	LastOpened   time.Time
+	LastModified time.Time
+	Backlinks    int
+}
+
+func (c RankingConfig) WithDefaults() RankingConfig {
+	if c.TextWeight == 0 && c.OpenedWeight == 0 && c.ModifiedWeight == 0 && c.BacklinksWeight == 0 {
+		c.TextWeight = defaultTextWeight
+		c.OpenedWeight = defaultOpenedWeight
+		c.ModifiedWeight = defaultModifiedWeight
+		c.BacklinksWeight = defaultBacklinksWeight
+	}
+	if c.RecencyHalfLife <= 0 {
+		c.RecencyHalfLife = defaultRecencyHalfLife
+	}
+	return c
+}
+
+// Rank returns the weighted sum of signals, each of them is from 0 to 1
+func (c RankingConfig) Rank(s RankSignals, now time.Time) float64 {
+	if c.Disabled {
+		return s.Relevance
+	}
+	return c.TextWeight*s.Relevance +
+		c.OpenedWeight*c.recency(s.LastOpened, now) +
+		c.ModifiedWeight*c.recency(s.LastModified, now) +
+		c.BacklinksWeight*backlinksSignal(s.Backlinks)
+}
+
+// recency decays by half every half-life period
+func (c RankingConfig) recency(t time.Time, now time.Time) float64 {
+	if t.IsZero() {
+		return 0
+	}
+	age := now.Sub(t)
+	if age < 0 {
+		age = 0
+	}
+	return math.Exp2(-float64(age) / float64(c.RecencyHalfLife))
+}
+
+func backlinksSignal(n int) float64 {
+	if n <= 0 {
+		return 0
+	}
+	return float64(n) / float64(n+backlinksHalfSignal)
+}
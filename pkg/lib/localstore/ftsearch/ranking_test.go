@@ -0,0 +1,47 @@
+package ftsearch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankingConfig_Rank(t *testing.T) {
+	now := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	cfg := RankingConfig{}.WithDefaults()
+
+	t.Run("recently opened object is ranked higher", func(t *testing.T) {
+		// given
+		stale := RankSignals{Relevance: 1, LastOpened: now.Add(-365 * 24 * time.Hour)}
+		recent := RankSignals{Relevance: 0.9, LastOpened: now.Add(-time.Hour)}
+
+		// then
+		assert.Greater(t, cfg.Rank(recent, now), cfg.Rank(stale, now))
+	})
+	t.Run("referenced object is ranked higher", func(t *testing.T) {
+		// given
+		orphan := RankSignals{Relevance: 1}
+		referenced := RankSignals{Relevance: 0.9, Backlinks: 10}
+
+		// then
+		assert.Greater(t, cfg.Rank(referenced, now), cfg.Rank(orphan, now))
+	})
+	t.Run("recency is halved every half-life", func(t *testing.T) {
+		// given
+		cfg := RankingConfig{OpenedWeight: 1, RecencyHalfLife: 24 * time.Hour}.WithDefaults()
+
+		// when
+		rank := cfg.Rank(RankSignals{LastOpened: now.Add(-48 * time.Hour)}, now)
+
+		// then
+		assert.InDelta(t, 0.25, rank, 1e-9)
+	})
+	t.Run("disabled ranking keeps text relevance", func(t *testing.T) {
+		// given
+		cfg := RankingConfig{Disabled: true}.WithDefaults()
+
+		// then
+		assert.Equal(t, 0.5, cfg.Rank(RankSignals{Relevance: 0.5, LastOpened: now, Backlinks: 10}, now))
+	})
+}
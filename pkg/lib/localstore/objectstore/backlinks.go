@@ -0,0 +1,105 @@
+package objectstore
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/badger/v3"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	// pagesBacklinksBase keeps blocks and relations of the object linking to another object: /to/from
+	pagesBacklinksBase = ds.NewKey("/" + pagesPrefix + "/backlinks")
+	// pagesBacklinkTargetsBase keeps targets of backlinks of the object, so they are updated with it: /from/to
+	pagesBacklinkTargetsBase = ds.NewKey("/" + pagesPrefix + "/backlinktargets")
+)
+
+// UpdateObjectBacklinks replaces blocks and relations of the object linking to other objects. Backlinks are
+// given by target id
+func (s *dsObjectStore) UpdateObjectBacklinks(id string, backlinks map[string][]*model.Backlink) error {
+	return s.updateTxn(func(txn *badger.Txn) error {
+		targets, err := listIDsByPrefix(txn, pagesBacklinkTargetsBase.ChildString(id).Bytes())
+		if err != nil {
+			return fmt.Errorf("list backlink targets: %w", err)
+		}
+		for _, to := range targets {
+			if _, ok := backlinks[to]; ok {
+				continue
+			}
+			if err = deleteBacklinkKeys(txn, id, to); err != nil {
+				return err
+			}
+		}
+		for to, links := range backlinks {
+			value, err := (&model.Backlinks{Backlinks: links}).Marshal()
+			if err != nil {
+				return fmt.Errorf("marshal backlinks: %w", err)
+			}
+			if err = txn.Set(backlinkKey(id, to).Bytes(), value); err != nil {
+				return fmt.Errorf("set backlinks: %w", err)
+			}
+			if err = txn.Set(backlinkTargetKey(id, to).Bytes(), nil); err != nil {
+				return fmt.Errorf("set backlink target: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetBacklinks returns blocks and relations of other objects linking to the object, ordered by objects
+func (s *dsObjectStore) GetBacklinks(id string) ([]*model.Backlink, error) {
+	var backlinks []*model.Backlink
+	err := s.db.View(func(txn *badger.Txn) error {
+		iter := txn.NewIterator(badger.IteratorOptions{Prefix: pagesBacklinksBase.ChildString(id).Bytes(), PrefetchValues: true})
+		defer iter.Close()
+		for iter.Rewind(); iter.Valid(); iter.Next() {
+			raw, err := iter.Item().ValueCopy(nil)
+			if err != nil {
+				return fmt.Errorf("get value: %w", err)
+			}
+			links := &model.Backlinks{}
+			if err = links.Unmarshal(raw); err != nil {
+				return fmt.Errorf("unmarshal backlinks: %w", err)
+			}
+			backlinks = append(backlinks, links.Backlinks...)
+		}
+		return nil
+	})
+	sort.SliceStable(backlinks, func(i, j int) bool {
+		return backlinks[i].ObjectId < backlinks[j].ObjectId
+	})
+	return backlinks, err
+}
+
+func (s *dsObjectStore) eraseBacklinksForObject(txn *badger.Txn, from string) error {
+	targets, err := listIDsByPrefix(txn, pagesBacklinkTargetsBase.ChildString(from).Bytes())
+	if err != nil {
+		return fmt.Errorf("list backlink targets: %w", err)
+	}
+	for _, to := range targets {
+		if err = deleteBacklinkKeys(txn, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteBacklinkKeys(txn *badger.Txn, from, to string) error {
+	for _, key := range []ds.Key{backlinkKey(from, to), backlinkTargetKey(from, to)} {
+		if err := txn.Delete(key.Bytes()); err != nil {
+			return fmt.Errorf("delete key %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func backlinkKey(from, to string) ds.Key {
+	return pagesBacklinksBase.ChildString(to).ChildString(from)
+}
+
+func backlinkTargetKey(from, to string) ds.Key {
+	return pagesBacklinkTargetsBase.ChildString(from).ChildString(to)
+}
@@ -0,0 +1,72 @@
+package objectstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestDsObjectStore_Backlinks(t *testing.T) {
+	t.Run("backlinks of linking objects", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+		require.NoError(t, s.UpdateObjectBacklinks("id2", map[string][]*model.Backlink{
+			"id1": {{ObjectId: "id2", BlockId: "text", Snippet: "see id1"}},
+		}))
+		require.NoError(t, s.UpdateObjectBacklinks("id3", map[string][]*model.Backlink{
+			"id1": {{ObjectId: "id3", RelationKey: "assignee"}},
+			"id2": {{ObjectId: "id3", BlockId: "link"}},
+		}))
+
+		// when
+		got, err := s.GetBacklinks("id1")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Backlink{
+			{ObjectId: "id2", BlockId: "text", Snippet: "see id1"},
+			{ObjectId: "id3", RelationKey: "assignee"},
+		}, got)
+	})
+
+	t.Run("removed link is removed from backlinks", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+		require.NoError(t, s.UpdateObjectBacklinks("id2", map[string][]*model.Backlink{
+			"id1": {{ObjectId: "id2", BlockId: "text"}},
+			"id3": {{ObjectId: "id2", BlockId: "link"}},
+		}))
+
+		// when
+		require.NoError(t, s.UpdateObjectBacklinks("id2", map[string][]*model.Backlink{
+			"id3": {{ObjectId: "id2", BlockId: "link"}},
+		}))
+
+		// then
+		got, err := s.GetBacklinks("id1")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		got, err = s.GetBacklinks("id3")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Backlink{{ObjectId: "id2", BlockId: "link"}}, got)
+	})
+
+	t.Run("backlinks of deleted object are removed", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+		require.NoError(t, s.UpdateObjectBacklinks("id2", map[string][]*model.Backlink{
+			"id1": {{ObjectId: "id2", BlockId: "text"}},
+		}))
+
+		// when
+		require.NoError(t, s.DeleteObject("id2"))
+
+		// then
+		got, err := s.GetBacklinks("id1")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -73,6 +73,9 @@ func (s *dsObjectStore) DeleteObject(id string) error {
 		if err != nil {
 			return err
 		}
+		if err = s.eraseBacklinksForObject(txn, id); err != nil {
+			return err
+		}
 		err = txn.Commit()
 		if err != nil {
 			return fmt.Errorf("delete object info: %w", err)
@@ -1,6 +1,9 @@
 package objectstore
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 )
@@ -39,3 +42,41 @@ func (f idsFilter) Compare(a, b database.Getter) int {
 func (f idsFilter) String() string {
 	return "idsFilter"
 }
+
+// relationTermFilter matches objects, which value of the relation contains the text. Values of list relations
+// are matched one by one, ids of objects are matched with their names by linkedIDs
+type relationTermFilter struct {
+	key       string
+	text      string
+	linkedIDs map[string]struct{}
+}
+
+func (f relationTermFilter) FilterObject(getter database.Getter) bool {
+	val := getter.Get(f.key)
+	if val == nil {
+		return false
+	}
+	if list := val.GetListValue(); list != nil {
+		for _, v := range list.Values {
+			if f.matchString(v.GetStringValue()) {
+				return true
+			}
+		}
+		return false
+	}
+	return f.matchString(val.GetStringValue())
+}
+
+func (f relationTermFilter) matchString(s string) bool {
+	if s == "" {
+		return false
+	}
+	if _, ok := f.linkedIDs[s]; ok {
+		return true
+	}
+	return strings.Contains(strings.ToLower(s), f.text)
+}
+
+func (f relationTermFilter) String() string {
+	return fmt.Sprintf("%s CONTAINS '%s'", f.key, f.text)
+}
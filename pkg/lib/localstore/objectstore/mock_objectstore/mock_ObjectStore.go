@@ -537,6 +537,60 @@ func (_c *MockObjectStore_GetAccountStatus_Call) RunAndReturn(run func() (*coord
 	return _c
 }
 
+// GetBacklinks provides a mock function with given fields: id
+func (_m *MockObjectStore) GetBacklinks(id string) ([]*model.Backlink, error) {
+	ret := _m.Called(id)
+
+	var r0 []*model.Backlink
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Backlink, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Backlink); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Backlink)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectStore_GetBacklinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBacklinks'
+type MockObjectStore_GetBacklinks_Call struct {
+	*mock.Call
+}
+
+// GetBacklinks is a helper method to define mock.On call
+//   - id string
+func (_e *MockObjectStore_Expecter) GetBacklinks(id interface{}) *MockObjectStore_GetBacklinks_Call {
+	return &MockObjectStore_GetBacklinks_Call{Call: _e.mock.On("GetBacklinks", id)}
+}
+
+func (_c *MockObjectStore_GetBacklinks_Call) Run(run func(id string)) *MockObjectStore_GetBacklinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectStore_GetBacklinks_Call) Return(_a0 []*model.Backlink, _a1 error) *MockObjectStore_GetBacklinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectStore_GetBacklinks_Call) RunAndReturn(run func(string) ([]*model.Backlink, error)) *MockObjectStore_GetBacklinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByIDs provides a mock function with given fields: spaceID, ids
 func (_m *MockObjectStore) GetByIDs(spaceID string, ids []string) ([]*model.ObjectInfo, error) {
 	ret := _m.Called(spaceID, ids)
@@ -2191,6 +2245,82 @@ func (_c *MockObjectStore_SubscribeForAll_Call) RunAndReturn(run func(func(datab
 	return _c
 }
 
+// SubscribeForChanges provides a mock function with given fields: callback
+func (_m *MockObjectStore) SubscribeForChanges(callback func(string, *types.Struct, *types.Struct)) {
+	_m.Called(callback)
+}
+
+// MockObjectStore_SubscribeForChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeForChanges'
+type MockObjectStore_SubscribeForChanges_Call struct {
+	*mock.Call
+}
+
+// SubscribeForChanges is a helper method to define mock.On call
+//   - callback func(string , *types.Struct , *types.Struct)
+func (_e *MockObjectStore_Expecter) SubscribeForChanges(callback interface{}) *MockObjectStore_SubscribeForChanges_Call {
+	return &MockObjectStore_SubscribeForChanges_Call{Call: _e.mock.On("SubscribeForChanges", callback)}
+}
+
+func (_c *MockObjectStore_SubscribeForChanges_Call) Run(run func(callback func(string, *types.Struct, *types.Struct))) *MockObjectStore_SubscribeForChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(string, *types.Struct, *types.Struct)))
+	})
+	return _c
+}
+
+func (_c *MockObjectStore_SubscribeForChanges_Call) Return() *MockObjectStore_SubscribeForChanges_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockObjectStore_SubscribeForChanges_Call) RunAndReturn(run func(func(string, *types.Struct, *types.Struct))) *MockObjectStore_SubscribeForChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateObjectBacklinks provides a mock function with given fields: id, backlinks
+func (_m *MockObjectStore) UpdateObjectBacklinks(id string, backlinks map[string][]*model.Backlink) error {
+	ret := _m.Called(id, backlinks)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string][]*model.Backlink) error); ok {
+		r0 = rf(id, backlinks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockObjectStore_UpdateObjectBacklinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateObjectBacklinks'
+type MockObjectStore_UpdateObjectBacklinks_Call struct {
+	*mock.Call
+}
+
+// UpdateObjectBacklinks is a helper method to define mock.On call
+//   - id string
+//   - backlinks map[string][]*model.Backlink
+func (_e *MockObjectStore_Expecter) UpdateObjectBacklinks(id interface{}, backlinks interface{}) *MockObjectStore_UpdateObjectBacklinks_Call {
+	return &MockObjectStore_UpdateObjectBacklinks_Call{Call: _e.mock.On("UpdateObjectBacklinks", id, backlinks)}
+}
+
+func (_c *MockObjectStore_UpdateObjectBacklinks_Call) Run(run func(id string, backlinks map[string][]*model.Backlink)) *MockObjectStore_UpdateObjectBacklinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string][]*model.Backlink))
+	})
+	return _c
+}
+
+func (_c *MockObjectStore_UpdateObjectBacklinks_Call) Return(_a0 error) *MockObjectStore_UpdateObjectBacklinks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockObjectStore_UpdateObjectBacklinks_Call) RunAndReturn(run func(string, map[string][]*model.Backlink) error) *MockObjectStore_UpdateObjectBacklinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateObjectDetails provides a mock function with given fields: id, details
 func (_m *MockObjectStore) UpdateObjectDetails(id string, details *types.Struct) error {
 	ret := _m.Called(id, details)
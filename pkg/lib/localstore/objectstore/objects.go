@@ -62,6 +62,10 @@ type SourceDetailsFromID interface {
 	DetailsFromIdBasedSource(id string) (*types.Struct, error)
 }
 
+type rankingConfigGetter interface {
+	GetSearchRanking() ftsearch.RankingConfig
+}
+
 func (s *dsObjectStore) Init(a *app.App) (err error) {
 	src := a.Component("source")
 	if src != nil {
@@ -73,6 +77,9 @@ func (s *dsObjectStore) Init(a *app.App) (err error) {
 	} else {
 		s.fts = fts.(ftsearch.FTSearch)
 	}
+	if cfg, ok := a.Component("config").(rankingConfigGetter); ok {
+		s.ranking = cfg.GetSearchRanking()
+	}
 	datastoreService := a.MustComponent(datastore.CName).(datastore.Datastore)
 	s.db, err = datastoreService.LocalStorage()
 	if err != nil {
@@ -106,6 +113,9 @@ type ObjectStore interface {
 	AccountStore
 
 	SubscribeForAll(callback func(rec database.Record))
+	// SubscribeForChanges adds callback, which is called after details of the object are saved.
+	// Old details are nil for the object, which is indexed the first time
+	SubscribeForChanges(callback func(id string, oldDetails, newDetails *types.Struct))
 
 	Query(q database.Query) (records []database.Record, total int, err error)
 	QueryRaw(f *database.Filters, limit int, offset int) (records []database.Record, err error)
@@ -123,6 +133,8 @@ type ObjectStore interface {
 	// set discardLocalDetailsChanges to true in case the caller doesn't have local details in the State
 	UpdateObjectDetails(id string, details *types.Struct) error
 	UpdateObjectLinks(id string, links []string) error
+	// UpdateObjectBacklinks replaces blocks and relations of the object linking to other objects, given by target id
+	UpdateObjectBacklinks(id string, backlinks map[string][]*model.Backlink) error
 	UpdateObjectSnippet(id string, snippet string) error
 	UpdatePendingLocalDetails(id string, proc func(details *types.Struct) (*types.Struct, error)) error
 
@@ -134,6 +146,8 @@ type ObjectStore interface {
 	GetDetails(id string) (*model.ObjectDetails, error)
 	GetObjectByUniqueKey(spaceId string, uniqueKey domain.UniqueKey) (*model.ObjectDetails, error)
 	GetInboundLinksByID(id string) ([]string, error)
+	// GetBacklinks returns blocks and relations of other objects linking to the object
+	GetBacklinks(id string) ([]*model.Backlink, error)
 	GetOutboundLinksByID(id string) ([]string, error)
 
 	GetWithLinksInfoByID(spaceID string, id string) (*model.ObjectInfoWithLinks, error)
@@ -178,10 +192,12 @@ type dsObjectStore struct {
 	cache *ristretto.Cache
 	db    *badger.DB
 
-	fts ftsearch.FTSearch
+	fts     ftsearch.FTSearch
+	ranking ftsearch.RankingConfig
 
 	sync.RWMutex
 	onChangeCallback func(record database.Record)
+	changeCallbacks  []func(id string, oldDetails, newDetails *types.Struct)
 	subscriptions    []database.Subscription
 }
 
@@ -243,6 +259,12 @@ func (s *dsObjectStore) SubscribeForAll(callback func(rec database.Record)) {
 	s.Unlock()
 }
 
+func (s *dsObjectStore) SubscribeForChanges(callback func(id string, oldDetails, newDetails *types.Struct)) {
+	s.Lock()
+	s.changeCallbacks = append(s.changeCallbacks, callback)
+	s.Unlock()
+}
+
 func (s *dsObjectStore) GetWithLinksInfoByID(spaceID string, id string) (*model.ObjectInfoWithLinks, error) {
 	var res *model.ObjectInfoWithLinks
 	err := s.db.View(func(txn *badger.Txn) error {
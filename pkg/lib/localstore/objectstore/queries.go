@@ -3,11 +3,15 @@ package objectstore
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/samber/lo"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -88,16 +92,127 @@ func (s *dsObjectStore) makeFTSQuery(text string, filters *database.Filters) (*d
 	if s.fts == nil {
 		return filters, fmt.Errorf("fullText search not configured")
 	}
-	ids, err := s.fts.Search(getSpaceIDFromFilter(filters.FilterObj), text)
+	spaceID := getSpaceIDFromFilter(filters.FilterObj)
+	if qry, advanced := ftsearch.ParseFullText(text, IsFullTextField(s)); advanced {
+		return s.makeAdvancedFTSQuery(spaceID, qry, filters)
+	}
+	ids, err := s.fts.Search(spaceID, text)
+	if err != nil {
+		return filters, err
+	}
+	ids = s.withAttachmentOwners(ids)
+	filters.FilterObj = database.FiltersAnd{filters.FilterObj, newIdsFilter(ids)}
+	filters.Order = database.SetOrder(append([]database.Order{s.newRankOrder(ids)}, filters.Order))
+	return filters, nil
+}
+
+// IsFullTextField returns checker of fields of scoped terms in full-text query. Field is an indexed field or
+// a key of relation, so other words before colon, like in "todo:buy", are searched as text
+func IsFullTextField(store ObjectStore) func(key string) bool {
+	return func(key string) bool {
+		if ftsearch.IsIndexedField(key) || bundle.HasRelation(key) {
+			return true
+		}
+		_, err := store.GetRelationByKey(key)
+		return err == nil
+	}
+}
+
+// makeAdvancedFTSQuery converts the query with phrases, operators and fields to the tree of filters. Terms
+// of name and text are searched in the full-text index, other terms are matched with values of relations
+func (s *dsObjectStore) makeAdvancedFTSQuery(spaceID string, qry *ftsearch.Query, filters *database.Filters) (*database.Filters, error) {
+	var rankedIDs []string
+	ftsFilter, err := s.makeQueryFilter(spaceID, qry, &rankedIDs)
 	if err != nil {
 		return filters, err
 	}
-	idsQuery := newIdsFilter(ids)
-	filters.FilterObj = database.FiltersAnd{filters.FilterObj, idsQuery}
-	filters.Order = database.SetOrder(append([]database.Order{idsQuery}, filters.Order))
+	filters.FilterObj = database.FiltersAnd{filters.FilterObj, ftsFilter}
+	if len(rankedIDs) > 0 {
+		filters.Order = database.SetOrder(append([]database.Order{s.newRankOrder(lo.Uniq(rankedIDs))}, filters.Order))
+	}
 	return filters, nil
 }
 
+func (s *dsObjectStore) makeQueryFilter(spaceID string, qry *ftsearch.Query, rankedIDs *[]string) (database.Filter, error) {
+	switch qry.Op {
+	case ftsearch.QueryTerm:
+		return s.makeTermFilter(spaceID, qry, rankedIDs)
+	case ftsearch.QueryNot:
+		// negated terms don't affect ranking
+		child, err := s.makeQueryFilter(spaceID, qry.Children[0], new([]string))
+		if err != nil {
+			return nil, err
+		}
+		return database.FilterNot{Filter: child}, nil
+	}
+	children := make([]database.Filter, 0, len(qry.Children))
+	for _, q := range qry.Children {
+		child, err := s.makeQueryFilter(spaceID, q, rankedIDs)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	if qry.Op == ftsearch.QueryOr {
+		return database.FiltersOr(children), nil
+	}
+	return database.FiltersAnd(children), nil
+}
+
+func (s *dsObjectStore) makeTermFilter(spaceID string, term *ftsearch.Query, rankedIDs *[]string) (database.Filter, error) {
+	if ftsearch.IsIndexedField(term.Field) {
+		ids, err := s.fts.SearchTerm(spaceID, term)
+		if err != nil {
+			return nil, fmt.Errorf("search term %s: %w", term, err)
+		}
+		ids = s.withAttachmentOwners(ids)
+		*rankedIDs = append(*rankedIDs, ids...)
+		return newIdsFilter(ids), nil
+	}
+	text := strings.ToLower(term.Text)
+	// objects in relations like tag or status are matched by their names
+	var nameFilter database.Filter = database.FilterLike{Key: bundle.RelationKeyName.String(), Value: pbtypes.String(text)}
+	if spaceID != "" {
+		nameFilter = database.FiltersAnd{
+			database.FilterEq{Key: bundle.RelationKeySpaceId.String(), Cond: model.BlockContentDataviewFilter_Equal, Value: pbtypes.String(spaceID)},
+			nameFilter,
+		}
+	}
+	linked, err := s.QueryRaw(&database.Filters{FilterObj: nameFilter}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("query objects by name: %w", err)
+	}
+	linkedIDs := make(map[string]struct{}, len(linked))
+	for _, rec := range linked {
+		linkedIDs[pbtypes.GetString(rec.Details, bundle.RelationKeyId.String())] = struct{}{}
+	}
+	return relationTermFilter{key: term.Field, text: text, linkedIDs: linkedIDs}, nil
+}
+
+// withAttachmentOwners adds objects with the found files right after them, so objects are found by text
+// of their attachments
+func (s *dsObjectStore) withAttachmentOwners(ids []string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id)
+		details, err := s.GetDetails(id)
+		if err != nil {
+			log.Errorf("get details of %s: %s", id, err)
+			continue
+		}
+		if model.ObjectTypeLayout(pbtypes.GetInt64(details.Details, bundle.RelationKeyLayout.String())) != model.ObjectType_file {
+			continue
+		}
+		owners, err := s.GetInboundLinksByID(id)
+		if err != nil {
+			log.Errorf("get objects with file %s: %s", id, err)
+			continue
+		}
+		result = append(result, owners...)
+	}
+	return lo.Uniq(result)
+}
+
 func getSpaceIDFromFilter(fltr database.Filter) (spaceID string) {
 	switch f := fltr.(type) {
 	case database.FilterEq:
@@ -204,6 +204,120 @@ func TestQuery(t *testing.T) {
 				obj2,
 			}, recs)
 		})
+
+		t.Run("advanced query", func(t *testing.T) {
+			for _, tc := range []struct {
+				query    string
+				expected []TestObject
+			}{
+				{query: `"important note"`, expected: []TestObject{obj2}},
+				{query: "important -note", expected: []TestObject{obj3}},
+				{query: "name:important", expected: []TestObject{obj2}},
+				{query: "important description:foo", expected: []TestObject{obj2}},
+				{query: "description:bar OR name", expected: []TestObject{obj1, obj3}},
+			} {
+				recs, _, err := s.Query(database.Query{
+					FullText: tc.query,
+				})
+				require.NoError(t, err, tc.query)
+
+				assertRecordsMatch(t, tc.expected, recs)
+			}
+		})
+
+		t.Run("malformed advanced query is searched as plain text", func(t *testing.T) {
+			for _, query := range []string{"important OR", "important (note", "important NOT"} {
+				recs, _, err := s.Query(database.Query{
+					FullText: query,
+				})
+				require.NoError(t, err, query)
+
+				var ids []string
+				for _, rec := range recs {
+					ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+				}
+				assert.Contains(t, ids, "id2", query)
+			}
+		})
+
+		t.Run("only relations and indexed fields are fields of query", func(t *testing.T) {
+			isField := IsFullTextField(s)
+
+			assert.True(t, isField(ftsearch.FieldName))
+			assert.True(t, isField(bundle.RelationKeyDescription.String()))
+			assert.False(t, isField("todo"))
+			assert.False(t, isField("re"))
+		})
+	})
+
+	t.Run("full text search in attachments", func(t *testing.T) {
+		// given
+		s := NewStoreFixture(t)
+		file := TestObject{
+			bundle.RelationKeyId:     pbtypes.String("file1"),
+			bundle.RelationKeyName:   pbtypes.String("report.pdf"),
+			bundle.RelationKeyLayout: pbtypes.Int64(int64(model.ObjectType_file)),
+		}
+		page := makeObjectWithName("page1", "Meeting")
+		other := makeObjectWithName("page2", "Other")
+		s.AddObjects(t, []TestObject{file, page, other})
+		require.NoError(t, s.UpdateObjectLinks("page1", []string{"file1"}))
+		require.NoError(t, s.fts.Index(ftsearch.SearchDoc{
+			Id:    "file1",
+			Title: "report.pdf",
+			Text:  "quarterly revenue",
+		}))
+
+		// when
+		recs, _, err := s.Query(database.Query{
+			FullText: "revenue",
+		})
+
+		// then
+		require.NoError(t, err)
+		assertRecordsEqual(t, []TestObject{file, page}, recs)
+	})
+
+	t.Run("full text search ranking", func(t *testing.T) {
+		for _, tc := range []struct {
+			name     string
+			usedID   string
+			expected []string
+		}{
+			{name: "object with title match", usedID: "id1", expected: []string{"id1", "id2"}},
+			{name: "object with text match", usedID: "id2", expected: []string{"id2", "id1"}},
+		} {
+			t.Run("recently used and referenced "+tc.name+" is first", func(t *testing.T) {
+				// given
+				s := NewStoreFixture(t)
+				now := time.Now().Unix()
+				objects := []TestObject{makeObjectWithName("id1", "Roadmap"), makeObjectWithName("id2", "Notes")}
+				for _, obj := range objects {
+					if obj[bundle.RelationKeyId].GetStringValue() == tc.usedID {
+						obj[bundle.RelationKeyLastOpenedDate] = pbtypes.Int64(now)
+						obj[bundle.RelationKeyLastModifiedDate] = pbtypes.Int64(now)
+					}
+				}
+				s.AddObjects(t, append(objects, makeObjectWithName("id3", "Index"), makeObjectWithName("id4", "Plan")))
+				require.NoError(t, s.UpdateObjectLinks("id3", []string{tc.usedID}))
+				require.NoError(t, s.UpdateObjectLinks("id4", []string{tc.usedID}))
+				require.NoError(t, s.fts.Index(ftsearch.SearchDoc{Id: "id1", Title: "Roadmap"}))
+				require.NoError(t, s.fts.Index(ftsearch.SearchDoc{Id: "id2", Title: "Notes", Text: "roadmap review"}))
+
+				// when
+				recs, _, err := s.Query(database.Query{
+					FullText: "roadmap",
+				})
+
+				// then
+				require.NoError(t, err)
+				var ids []string
+				for _, rec := range recs {
+					ids = append(ids, pbtypes.GetString(rec.Details, bundle.RelationKeyId.String()))
+				}
+				assert.Equal(t, tc.expected, ids)
+			})
+		}
 	})
 
 	t.Run("with ascending order and filter", func(t *testing.T) {
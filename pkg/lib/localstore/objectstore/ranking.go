@@ -0,0 +1,85 @@
+package objectstore
+
+import (
+	"time"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+)
+
+// rankOrder orders objects found by full-text search by their rank. Rank combines text relevance, which is
+// given by the position in results of the index, with recency of usage and the number of inbound links
+type rankOrder struct {
+	config    ftsearch.RankingConfig
+	relevance map[string]float64
+	backlinks func(id string) int
+	now       time.Time
+	// ranks are computed once per object, as sorting compares objects many times
+	ranks map[string]float64
+}
+
+func (s *dsObjectStore) newRankOrder(ids []string) database.Order {
+	if s.ranking.Disabled {
+		return newIdsFilter(ids)
+	}
+	relevance := make(map[string]float64, len(ids))
+	for i, id := range ids {
+		relevance[id] = 1 - float64(i)/float64(len(ids))
+	}
+	return &rankOrder{
+		config:    s.ranking.WithDefaults(),
+		relevance: relevance,
+		backlinks: s.countInboundLinks,
+		now:       time.Now(),
+		ranks:     make(map[string]float64, len(ids)),
+	}
+}
+
+func (s *dsObjectStore) countInboundLinks(id string) int {
+	links, err := s.GetInboundLinksByID(id)
+	if err != nil {
+		log.Errorf("get inbound links of %s: %s", id, err)
+		return 0
+	}
+	return len(links)
+}
+
+func (o *rankOrder) Compare(a, b database.Getter) int {
+	rankA, rankB := o.rank(a), o.rank(b)
+	switch {
+	case rankA == rankB:
+		return 0
+	case rankA > rankB:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func (o *rankOrder) rank(getter database.Getter) float64 {
+	id := getter.Get(bundle.RelationKeyId.String()).GetStringValue()
+	if rank, ok := o.ranks[id]; ok {
+		return rank
+	}
+	rank := o.config.Rank(ftsearch.RankSignals{
+		Relevance:    o.relevance[id],
+		LastOpened:   dateValue(getter, bundle.RelationKeyLastOpenedDate.String()),
+		LastModified: dateValue(getter, bundle.RelationKeyLastModifiedDate.String()),
+		Backlinks:    o.backlinks(id),
+	}, o.now)
+	o.ranks[id] = rank
+	return rank
+}
+
+func (o *rankOrder) String() string {
+	return "rankOrder"
+}
+
+func dateValue(getter database.Getter, key string) time.Time {
+	ts := int64(getter.Get(key).GetNumberValue())
+	if ts <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
@@ -27,8 +27,9 @@ func (s *dsObjectStore) UpdateObjectDetails(id string, details *types.Struct) er
 	}
 
 	key := pagesDetailsBase.ChildString(id).Bytes()
-	txErr := s.updateTxn(func(txn *badger.Txn) error {
-		oldDetails, err := s.extractDetailsByKey(txn, key)
+	var oldDetails *model.ObjectDetails
+	txErr := s.updateTxn(func(txn *badger.Txn) (err error) {
+		oldDetails, err = s.extractDetailsByKey(txn, key)
 		if err != nil && !badgerhelper.IsNotFound(err) {
 			return fmt.Errorf("extract details: %w", err)
 		}
@@ -48,9 +49,18 @@ func (s *dsObjectStore) UpdateObjectDetails(id string, details *types.Struct) er
 		return txErr
 	}
 	s.cache.Set(key, newDetails, int64(newDetails.Size()))
+	s.sendChanges(id, oldDetails.GetDetails(), details)
 	return nil
 }
 
+func (s *dsObjectStore) sendChanges(id string, oldDetails, newDetails *types.Struct) {
+	s.RLock()
+	defer s.RUnlock()
+	for _, callback := range s.changeCallbacks {
+		callback(id, oldDetails, newDetails)
+	}
+}
+
 func (s *dsObjectStore) extractDetailsByKey(txn *badger.Txn, key []byte) (*model.ObjectDetails, error) {
 	it, err := txn.Get(key)
 	if err != nil {
@@ -435,6 +435,119 @@ func (m *ObjectInfoWithOutboundLinksIDs) GetOutboundLinks() []string {
 	return nil
 }
 
+// Backlink is the link to the object from the block or relation of another object
+type Backlink struct {
+	ObjectId    string `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	BlockId     string `protobuf:"bytes,2,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	RelationKey string `protobuf:"bytes,3,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Snippet     string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+}
+
+func (m *Backlink) Reset()         { *m = Backlink{} }
+func (m *Backlink) String() string { return proto.CompactTextString(m) }
+func (*Backlink) ProtoMessage()    {}
+func (*Backlink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c35df71910469a5, []int{7}
+}
+func (m *Backlink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backlink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backlink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backlink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backlink.Merge(m, src)
+}
+func (m *Backlink) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backlink) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backlink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backlink proto.InternalMessageInfo
+
+func (m *Backlink) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *Backlink) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *Backlink) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *Backlink) GetSnippet() string {
+	if m != nil {
+		return m.Snippet
+	}
+	return ""
+}
+
+type Backlinks struct {
+	Backlinks []*Backlink `protobuf:"bytes,1,rep,name=backlinks,proto3" json:"backlinks,omitempty"`
+}
+
+func (m *Backlinks) Reset()         { *m = Backlinks{} }
+func (m *Backlinks) String() string { return proto.CompactTextString(m) }
+func (*Backlinks) ProtoMessage()    {}
+func (*Backlinks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c35df71910469a5, []int{8}
+}
+func (m *Backlinks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backlinks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backlinks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backlinks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backlinks.Merge(m, src)
+}
+func (m *Backlinks) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backlinks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backlinks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backlinks proto.InternalMessageInfo
+
+func (m *Backlinks) GetBacklinks() []*Backlink {
+	if m != nil {
+		return m.Backlinks
+	}
+	return nil
+}
+
 type ObjectStoreChecksums struct {
 	BundledObjectTypes               string `protobuf:"bytes,1,opt,name=bundledObjectTypes,proto3" json:"bundledObjectTypes,omitempty"`
 	BundledRelations                 string `protobuf:"bytes,2,opt,name=bundledRelations,proto3" json:"bundledRelations,omitempty"`
@@ -452,7 +565,7 @@ func (m *ObjectStoreChecksums) Reset()         { *m = ObjectStoreChecksums{} }
 func (m *ObjectStoreChecksums) String() string { return proto.CompactTextString(m) }
 func (*ObjectStoreChecksums) ProtoMessage()    {}
 func (*ObjectStoreChecksums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{7}
+	return fileDescriptor_9c35df71910469a5, []int{9}
 }
 func (m *ObjectStoreChecksums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,6 +672,8 @@ func init() {
 	proto.RegisterType((*ObjectInfoWithLinks)(nil), "anytype.model.ObjectInfoWithLinks")
 	proto.RegisterType((*ObjectInfoWithOutboundLinks)(nil), "anytype.model.ObjectInfoWithOutboundLinks")
 	proto.RegisterType((*ObjectInfoWithOutboundLinksIDs)(nil), "anytype.model.ObjectInfoWithOutboundLinksIDs")
+	proto.RegisterType((*Backlink)(nil), "anytype.model.Backlink")
+	proto.RegisterType((*Backlinks)(nil), "anytype.model.Backlinks")
 	proto.RegisterType((*ObjectStoreChecksums)(nil), "anytype.model.ObjectStoreChecksums")
 }
 
@@ -567,48 +682,52 @@ func init() {
 }
 
 var fileDescriptor_9c35df71910469a5 = []byte{
-	// 653 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xad, 0xf3, 0xd1, 0x34, 0x13, 0xf5, 0x83, 0x05, 0x89, 0xa5, 0x20, 0xcb, 0xb2, 0x2a, 0x64,
-	0x55, 0xe0, 0x88, 0x96, 0x5e, 0x38, 0x80, 0xd4, 0x56, 0x48, 0x85, 0x4a, 0x91, 0xb6, 0x45, 0x48,
-	0xdc, 0xec, 0x78, 0xd3, 0x2e, 0xdd, 0x7a, 0x2d, 0xef, 0x5a, 0x6a, 0x0e, 0x1c, 0xb9, 0x70, 0x40,
-	0xfc, 0x01, 0xfe, 0x0f, 0xc7, 0x1e, 0x39, 0xa2, 0xf6, 0x1f, 0xf0, 0x0b, 0x90, 0x77, 0x9d, 0xd4,
-	0x71, 0xd3, 0x14, 0x09, 0x8e, 0x79, 0xf3, 0xe6, 0xf9, 0xcd, 0x1b, 0x7b, 0x02, 0x5e, 0x72, 0x72,
-	0xd4, 0xe5, 0x2c, 0xec, 0x26, 0x61, 0xf7, 0x54, 0x44, 0x94, 0x77, 0x93, 0x54, 0x28, 0x21, 0xbb,
-	0x5c, 0xf4, 0x03, 0x2e, 0x95, 0x48, 0xa9, 0xaf, 0x11, 0xb4, 0x18, 0xc4, 0x43, 0x35, 0x4c, 0xa8,
-	0xaf, 0x69, 0xab, 0x8f, 0x8e, 0x84, 0x38, 0xe2, 0xd4, 0xd0, 0xc3, 0x6c, 0xd0, 0x95, 0x2a, 0xcd,
-	0xfa, 0xca, 0x90, 0x57, 0xd7, 0x6e, 0x92, 0xd5, 0x3f, 0xa4, 0x61, 0xb9, 0xbf, 0x2d, 0x80, 0x5e,
-	0xf8, 0x91, 0xf6, 0xd5, 0x5e, 0x3c, 0x10, 0x68, 0x09, 0x6a, 0x2c, 0xc2, 0x96, 0x63, 0x79, 0x6d,
-	0x52, 0x63, 0x11, 0x7a, 0x0c, 0x4b, 0x42, 0x57, 0x0f, 0x87, 0x09, 0x7d, 0x97, 0x72, 0x89, 0x6b,
-	0x4e, 0xdd, 0x6b, 0x93, 0x0a, 0x8a, 0x9e, 0x41, 0x2b, 0xa2, 0x2a, 0x60, 0x5c, 0xe2, 0xba, 0x63,
-	0x79, 0x9d, 0x8d, 0xfb, 0xbe, 0x31, 0xe7, 0x8f, 0xcc, 0xf9, 0x07, 0xda, 0x1c, 0x19, 0xf1, 0xd0,
-	0x16, 0xb4, 0x53, 0xca, 0x03, 0xc5, 0x44, 0x2c, 0x71, 0xc3, 0xa9, 0xeb, 0xa6, 0x89, 0x01, 0x7d,
-	0x52, 0xd4, 0xc9, 0x15, 0x13, 0x61, 0x68, 0xc9, 0x98, 0x25, 0x09, 0x55, 0xb8, 0xa9, 0x6d, 0x8e,
-	0x7e, 0x22, 0x0f, 0x96, 0x8f, 0x03, 0xb9, 0x17, 0x87, 0x22, 0x8b, 0xa3, 0x7d, 0x16, 0x9f, 0x48,
-	0x3c, 0xef, 0x58, 0xde, 0x02, 0xa9, 0xc2, 0xee, 0x36, 0x2c, 0x9a, 0x99, 0x77, 0x0b, 0x2f, 0x25,
-	0xfb, 0xd6, 0xdf, 0xd9, 0x77, 0x7b, 0xd0, 0x31, 0x1a, 0x5a, 0x12, 0xd9, 0x00, 0xcc, 0x3c, 0x62,
-	0x6f, 0x37, 0x17, 0xc9, 0x43, 0x2a, 0x21, 0xc8, 0x81, 0x8e, 0xc8, 0xd4, 0x98, 0x60, 0x52, 0x2c,
-	0x43, 0xee, 0x27, 0x58, 0x2e, 0x09, 0xea, 0x6d, 0x6c, 0x42, 0xab, 0x90, 0xd0, 0x8a, 0x9d, 0x8d,
-	0x07, 0x95, 0x80, 0xae, 0x36, 0x47, 0x46, 0x4c, 0xb4, 0x05, 0x0b, 0x23, 0x59, 0xfd, 0x98, 0x99,
-	0x5d, 0x63, 0xaa, 0xfb, 0xc5, 0x82, 0xbb, 0x57, 0x85, 0xf7, 0x4c, 0x1d, 0x9b, 0xc1, 0xaa, 0x6f,
-	0xc4, 0x53, 0x68, 0xb0, 0x78, 0x20, 0x70, 0x4d, 0xe7, 0x34, 0x43, 0x5a, 0xd3, 0xd0, 0x73, 0x68,
-	0x72, 0xbd, 0x0a, 0xf3, 0x5a, 0xd8, 0x53, 0xf9, 0xe3, 0x89, 0x89, 0x21, 0xbb, 0xdf, 0x2d, 0x78,
-	0x38, 0x69, 0xa6, 0x57, 0xf8, 0xfc, 0x2f, 0xa6, 0x5e, 0xc1, 0xa2, 0x28, 0xeb, 0xe1, 0xfa, 0x6d,
-	0x39, 0x4d, 0xf2, 0xdd, 0xcf, 0x16, 0xd8, 0x33, 0xfc, 0xe5, 0x0b, 0xff, 0x47, 0x8b, 0x6b, 0xd3,
-	0x2c, 0xb6, 0xab, 0x3e, 0xbe, 0x36, 0xe0, 0x9e, 0x69, 0x3d, 0xc8, 0xcf, 0xc4, 0xce, 0x31, 0xed,
-	0x9f, 0xc8, 0xec, 0x54, 0x22, 0x1f, 0x50, 0x98, 0xc5, 0x11, 0xa7, 0x51, 0x6f, 0xfc, 0xa1, 0xca,
-	0xc2, 0xcd, 0x94, 0x0a, 0x5a, 0x87, 0x95, 0x02, 0x25, 0xe3, 0x6f, 0xb2, 0xa6, 0xd9, 0xd7, 0xf0,
-	0xfc, 0x26, 0x14, 0xd8, 0x7e, 0x30, 0x14, 0x99, 0x32, 0xbb, 0x6d, 0x93, 0x0a, 0x8a, 0x5e, 0xc2,
-	0xaa, 0xb9, 0x12, 0xf2, 0xb5, 0x48, 0xfb, 0x94, 0x50, 0x16, 0x47, 0xf4, 0x6c, 0x47, 0x64, 0xb1,
-	0xa2, 0x29, 0x6e, 0x38, 0x96, 0xd7, 0x24, 0x33, 0x18, 0xe8, 0x05, 0xe0, 0x01, 0xe3, 0x74, 0x6a,
-	0x77, 0x53, 0x77, 0xdf, 0x58, 0x47, 0x4f, 0xe0, 0x0e, 0x8b, 0xce, 0x08, 0x0d, 0x33, 0xc6, 0xa3,
-	0x51, 0xd3, 0xbc, 0x6e, 0xba, 0x5e, 0xc8, 0x2f, 0xc7, 0x20, 0xe3, 0x5c, 0xd1, 0x33, 0x55, 0x54,
-	0x70, 0x4b, 0x73, 0xab, 0x70, 0x29, 0xa7, 0x43, 0x7a, 0x9a, 0xf0, 0x40, 0x51, 0x89, 0x17, 0x26,
-	0x72, 0x1a, 0xe3, 0xa5, 0x9c, 0x4c, 0xd2, 0x12, 0xb7, 0xb5, 0x68, 0x05, 0x45, 0x6f, 0xc0, 0xd1,
-	0x73, 0xe4, 0x1b, 0x7c, 0x4b, 0x87, 0x53, 0xe7, 0x05, 0xdd, 0x79, 0x2b, 0x6f, 0x7b, 0xfd, 0xc7,
-	0x85, 0x6d, 0x9d, 0x5f, 0xd8, 0xd6, 0xaf, 0x0b, 0xdb, 0xfa, 0x76, 0x69, 0xcf, 0x9d, 0x5f, 0xda,
-	0x73, 0x3f, 0x2f, 0xed, 0xb9, 0x0f, 0x2b, 0xd5, 0xbf, 0x83, 0x70, 0x5e, 0xdf, 0xb6, 0xcd, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x2e, 0xf0, 0x8d, 0x80, 0x06, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xae, 0xf3, 0xd3, 0xc4, 0x13, 0xf5, 0x87, 0x05, 0x89, 0x25, 0x20, 0xcb, 0xb2, 0x2a, 0x14,
+	0x55, 0x90, 0x88, 0x96, 0x5e, 0x38, 0x80, 0x94, 0x56, 0x48, 0xa1, 0x95, 0x22, 0x6d, 0x8b, 0x90,
+	0xb8, 0xd9, 0xf1, 0xa6, 0x35, 0xd9, 0x7a, 0xad, 0xec, 0x5a, 0x6a, 0x24, 0x38, 0x72, 0xe1, 0x80,
+	0x78, 0x01, 0xde, 0x87, 0x63, 0x8f, 0x1c, 0x51, 0xfb, 0x06, 0x3c, 0x01, 0xf2, 0xae, 0x9d, 0x38,
+	0x6e, 0xda, 0x22, 0xc1, 0x71, 0xbf, 0xf9, 0x66, 0xf6, 0x9b, 0x6f, 0x3d, 0x63, 0x68, 0x45, 0xa3,
+	0xe3, 0x0e, 0x0b, 0xbc, 0x4e, 0xe4, 0x75, 0x4e, 0xb9, 0x4f, 0x59, 0x27, 0x1a, 0x73, 0xc9, 0x45,
+	0x87, 0xf1, 0x81, 0xcb, 0x84, 0xe4, 0x63, 0xda, 0x56, 0x08, 0x5a, 0x71, 0xc3, 0x89, 0x9c, 0x44,
+	0xb4, 0xad, 0x68, 0xcd, 0x47, 0xc7, 0x9c, 0x1f, 0x33, 0xaa, 0xe9, 0x5e, 0x3c, 0xec, 0x08, 0x39,
+	0x8e, 0x07, 0x52, 0x93, 0x9b, 0x1b, 0xd7, 0x95, 0x55, 0x07, 0xa1, 0x59, 0xce, 0x6f, 0x03, 0xa0,
+	0xef, 0x7d, 0xa0, 0x03, 0xd9, 0x0b, 0x87, 0x1c, 0xad, 0x42, 0x29, 0xf0, 0xb1, 0x61, 0x1b, 0x2d,
+	0x93, 0x94, 0x02, 0x1f, 0x3d, 0x86, 0x55, 0xae, 0xa2, 0x47, 0x93, 0x88, 0xbe, 0x1d, 0x33, 0x81,
+	0x4b, 0x76, 0xb9, 0x65, 0x92, 0x02, 0x8a, 0x9e, 0x41, 0xcd, 0xa7, 0xd2, 0x0d, 0x98, 0xc0, 0x65,
+	0xdb, 0x68, 0x35, 0xb6, 0xee, 0xb7, 0xb5, 0xb8, 0x76, 0x26, 0xae, 0x7d, 0xa8, 0xc4, 0x91, 0x8c,
+	0x87, 0x76, 0xc0, 0x1c, 0x53, 0xe6, 0xca, 0x80, 0x87, 0x02, 0x57, 0xec, 0xb2, 0x4a, 0x9a, 0x6b,
+	0xb0, 0x4d, 0xd2, 0x38, 0x99, 0x31, 0x11, 0x86, 0x9a, 0x08, 0x83, 0x28, 0xa2, 0x12, 0x57, 0x95,
+	0xcc, 0xec, 0x88, 0x5a, 0xb0, 0x76, 0xe2, 0x8a, 0x5e, 0xe8, 0xf1, 0x38, 0xf4, 0x0f, 0x82, 0x70,
+	0x24, 0xf0, 0xb2, 0x6d, 0xb4, 0xea, 0xa4, 0x08, 0x3b, 0x5d, 0x58, 0xd1, 0x3d, 0xef, 0xa5, 0x5a,
+	0x72, 0xf2, 0x8d, 0xbf, 0x93, 0xef, 0xf4, 0xa1, 0xa1, 0x6b, 0xa8, 0x92, 0xc8, 0x02, 0x08, 0xf4,
+	0x15, 0xbd, 0xbd, 0xa4, 0x48, 0x62, 0x52, 0x0e, 0x41, 0x36, 0x34, 0x78, 0x2c, 0xa7, 0x04, 0xed,
+	0x62, 0x1e, 0x72, 0x3e, 0xc1, 0x5a, 0xae, 0xa0, 0x7a, 0x8d, 0x6d, 0xa8, 0xa5, 0x25, 0x54, 0xc5,
+	0xc6, 0xd6, 0x83, 0x82, 0x41, 0xb3, 0x97, 0x23, 0x19, 0x13, 0xed, 0x40, 0x3d, 0x2b, 0x8b, 0x4b,
+	0xb7, 0x65, 0x4d, 0xa9, 0xce, 0x17, 0x03, 0xee, 0xce, 0x02, 0xef, 0x02, 0x79, 0xa2, 0x1b, 0x2b,
+	0x7e, 0x11, 0x4f, 0xa1, 0x12, 0x84, 0x43, 0x8e, 0x4b, 0xb6, 0x71, 0x73, 0x69, 0x45, 0x43, 0xcf,
+	0xa1, 0xca, 0xd4, 0x53, 0xe8, 0xcf, 0xc2, 0x5a, 0xc8, 0x9f, 0x76, 0x4c, 0x34, 0xd9, 0xf9, 0x6e,
+	0xc0, 0xc3, 0x79, 0x31, 0xfd, 0x54, 0xe7, 0x7f, 0x11, 0xf5, 0x0a, 0x56, 0x78, 0xbe, 0x1e, 0x2e,
+	0xdf, 0xe6, 0xd3, 0x3c, 0xdf, 0xf9, 0x6c, 0x80, 0x75, 0x83, 0xbe, 0xde, 0xde, 0x3f, 0x4b, 0xdc,
+	0x58, 0x24, 0xd1, 0x2c, 0xea, 0xf8, 0x08, 0xf5, 0xae, 0x3b, 0x18, 0x25, 0xa6, 0xa1, 0x26, 0xd4,
+	0xf5, 0x50, 0xf6, 0xb2, 0x6b, 0xa7, 0xe7, 0x64, 0x68, 0x3c, 0xc6, 0x07, 0xa3, 0x9e, 0xaf, 0xee,
+	0x37, 0x49, 0x76, 0x4c, 0xbe, 0xcb, 0x6c, 0xb6, 0xf6, 0xe9, 0x44, 0xbd, 0x92, 0x49, 0xf2, 0x50,
+	0x7e, 0xe0, 0x2a, 0x73, 0x03, 0xe7, 0x74, 0xc1, 0xcc, 0x6e, 0x57, 0xe3, 0xec, 0x65, 0x07, 0x6c,
+	0x2c, 0x1c, 0xe7, 0x8c, 0x4c, 0x66, 0x4c, 0xe7, 0x6b, 0x05, 0xee, 0xe9, 0xe6, 0x0f, 0x93, 0x45,
+	0xb7, 0x7b, 0x42, 0x07, 0x23, 0x11, 0x9f, 0x0a, 0xd4, 0x06, 0xe4, 0xc5, 0xa1, 0xcf, 0xa8, 0xdf,
+	0x9f, 0xae, 0x1a, 0x91, 0x36, 0xb6, 0x20, 0x82, 0x36, 0x61, 0x3d, 0x45, 0xc9, 0x74, 0xab, 0xe8,
+	0x5e, 0xaf, 0xe0, 0xc9, 0x56, 0x4b, 0xb1, 0x03, 0x77, 0xc2, 0x63, 0x29, 0xd2, 0xbe, 0x0b, 0x28,
+	0x7a, 0x09, 0x4d, 0x6d, 0xa1, 0x78, 0xcd, 0xc7, 0x03, 0x4a, 0x68, 0x10, 0xfa, 0xf4, 0x6c, 0x97,
+	0xc7, 0xa1, 0xa4, 0x63, 0xe5, 0x46, 0x95, 0xdc, 0xc0, 0x40, 0x2f, 0x00, 0x0f, 0x03, 0x46, 0x17,
+	0x66, 0x57, 0x55, 0xf6, 0xb5, 0x71, 0xf4, 0x04, 0xee, 0x04, 0xfe, 0x19, 0xa1, 0x5e, 0x1c, 0x30,
+	0x3f, 0x4b, 0x5a, 0x56, 0x49, 0x57, 0x03, 0xc9, 0xee, 0x1b, 0xc6, 0x8c, 0x49, 0x7a, 0x26, 0xd3,
+	0x08, 0xae, 0x29, 0x6e, 0x11, 0xce, 0xf9, 0x74, 0x44, 0x4f, 0x23, 0xe6, 0x4a, 0x2a, 0x70, 0x7d,
+	0xce, 0xa7, 0x29, 0x9e, 0xf3, 0x49, 0x3b, 0x2d, 0xb0, 0xa9, 0x8a, 0x16, 0x50, 0xf4, 0x06, 0x6c,
+	0xd5, 0x47, 0xf2, 0x82, 0xfb, 0x74, 0xb2, 0xb0, 0x5f, 0x50, 0x99, 0xb7, 0xf2, 0xba, 0x9b, 0x3f,
+	0x2e, 0x2c, 0xe3, 0xfc, 0xc2, 0x32, 0x7e, 0x5d, 0x58, 0xc6, 0xb7, 0x4b, 0x6b, 0xe9, 0xfc, 0xd2,
+	0x5a, 0xfa, 0x79, 0x69, 0x2d, 0xbd, 0x5f, 0x2f, 0xfe, 0xd0, 0xbc, 0x65, 0xb5, 0x9d, 0xb7, 0xff,
+	0x0c, 0x00, 0xa2, 0xec, 0x50, 0x3f, 0x42, 0x07, 0x00, 0x00,
 }
 
 func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
@@ -981,6 +1100,94 @@ func (m *ObjectInfoWithOutboundLinksIDs) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *Backlink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backlink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snippet) > 0 {
+		i -= len(m.Snippet)
+		copy(dAtA[i:], m.Snippet)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.Snippet)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Backlinks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backlinks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backlinks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Backlinks) > 0 {
+		for iNdEx := len(m.Backlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLocalstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectStoreChecksums) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1231,6 +1438,46 @@ func (m *ObjectInfoWithOutboundLinksIDs) Size() (n int) {
 	return n
 }
 
+func (m *Backlink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.Snippet)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	return n
+}
+
+func (m *Backlinks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Backlinks) > 0 {
+		for _, e := range m.Backlinks {
+			l = e.Size()
+			n += 1 + l + sovLocalstore(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ObjectStoreChecksums) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2290,6 +2537,268 @@ func (m *ObjectInfoWithOutboundLinksIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Backlink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocalstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backlink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backlink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snippet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snippet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocalstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backlinks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocalstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backlinks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backlinks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backlinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backlinks = append(m.Backlinks, &Backlink{})
+			if err := m.Backlinks[len(m.Backlinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocalstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ObjectStoreChecksums) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1086,6 +1086,32 @@ func (BlockContentDataviewFilterQuickOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{1, 1, 9, 3, 2}
 }
 
+// Processor renders text of the block. Latex is formula, other processors render embedded diagrams
+type BlockContentLatexProcessor int32
+
+const (
+	BlockContentLatex_Latex   BlockContentLatexProcessor = 0
+	BlockContentLatex_Mermaid BlockContentLatexProcessor = 1
+)
+
+var BlockContentLatexProcessor_name = map[int32]string{
+	0: "Latex",
+	1: "Mermaid",
+}
+
+var BlockContentLatexProcessor_value = map[string]int32{
+	"Latex":   0,
+	"Mermaid": 1,
+}
+
+func (x BlockContentLatexProcessor) String() string {
+	return proto.EnumName(BlockContentLatexProcessor_name, int32(x))
+}
+
+func (BlockContentLatexProcessor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{1, 1, 11, 0}
+}
+
 type BlockContentWidgetLayout int32
 
 const (
@@ -3754,7 +3780,8 @@ func (m *BlockContentRelation) GetKey() string {
 }
 
 type BlockContentLatex struct {
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Text      string                     `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Processor BlockContentLatexProcessor `protobuf:"varint,2,opt,name=processor,proto3,enum=anytype.model.BlockContentLatexProcessor" json:"processor,omitempty"`
 }
 
 func (m *BlockContentLatex) Reset()         { *m = BlockContentLatex{} }
@@ -3797,6 +3824,13 @@ func (m *BlockContentLatex) GetText() string {
 	return ""
 }
 
+func (m *BlockContentLatex) GetProcessor() BlockContentLatexProcessor {
+	if m != nil {
+		return m.Processor
+	}
+	return BlockContentLatex_Latex
+}
+
 type BlockContentTableOfContents struct {
 }
 
@@ -4432,6 +4466,7 @@ type AccountInfo struct {
 	TimeZone               string `protobuf:"bytes,104,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
 	AnalyticsId            string `protobuf:"bytes,105,opt,name=analyticsId,proto3" json:"analyticsId,omitempty"`
 	NetworkId              string `protobuf:"bytes,106,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	Locale                 string `protobuf:"bytes,107,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (m *AccountInfo) Reset()         { *m = AccountInfo{} }
@@ -4565,6 +4600,13 @@ func (m *AccountInfo) GetNetworkId() string {
 	return ""
 }
 
+func (m *AccountInfo) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
 type LinkPreview struct {
 	Url         string          `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	Title       string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
@@ -6006,6 +6048,7 @@ func init() {
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterOperator", BlockContentDataviewFilterOperator_name, BlockContentDataviewFilterOperator_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterCondition", BlockContentDataviewFilterCondition_name, BlockContentDataviewFilterCondition_value)
 	proto.RegisterEnum("anytype.model.BlockContentDataviewFilterQuickOption", BlockContentDataviewFilterQuickOption_name, BlockContentDataviewFilterQuickOption_value)
+	proto.RegisterEnum("anytype.model.BlockContentLatexProcessor", BlockContentLatexProcessor_name, BlockContentLatexProcessor_value)
 	proto.RegisterEnum("anytype.model.BlockContentWidgetLayout", BlockContentWidgetLayout_name, BlockContentWidgetLayout_value)
 	proto.RegisterEnum("anytype.model.AccountStatusType", AccountStatusType_name, AccountStatusType_value)
 	proto.RegisterEnum("anytype.model.LinkPreviewType", LinkPreviewType_name, LinkPreviewType_value)
@@ -6086,355 +6129,357 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 5556 bytes of a gzipped FileDescriptorProto
+	// 5586 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0x4d, 0x8c, 0x24, 0xc9,
-	0x55, 0x70, 0xd7, 0x7f, 0xd5, 0xab, 0xfe, 0x89, 0x8e, 0xe9, 0x99, 0x2d, 0xe7, 0xae, 0xe7, 0x1b,
-	0xe7, 0xb7, 0x1e, 0x8f, 0xc7, 0xeb, 0x9e, 0xdd, 0x99, 0x1d, 0xef, 0xda, 0xb0, 0xbb, 0xee, 0x9f,
-	0x19, 0x77, 0x6b, 0x67, 0xb6, 0xdb, 0x59, 0xbd, 0x33, 0x78, 0x05, 0x88, 0xa8, 0xca, 0xe8, 0xaa,
-	0xdc, 0xce, 0xca, 0x28, 0x67, 0x46, 0xf5, 0x74, 0x5b, 0x20, 0x19, 0x30, 0xb6, 0xb8, 0x19, 0x0b,
-	0x1f, 0x91, 0xcc, 0x8d, 0x03, 0x37, 0x64, 0x01, 0x12, 0x67, 0x84, 0xe0, 0x60, 0xdf, 0x40, 0x42,
-	0x02, 0x64, 0x73, 0xe3, 0x80, 0x84, 0x84, 0xc4, 0x81, 0x03, 0x7a, 0x2f, 0x22, 0xb3, 0xb2, 0x7e,
-	0xba, 0xa7, 0x66, 0xed, 0x53, 0x65, 0xbc, 0x78, 0xef, 0xc5, 0x8b, 0x88, 0x17, 0xef, 0x2f, 0xa2,
-	0xe0, 0xd5, 0xe1, 0x49, 0xef, 0x4e, 0x18, 0x74, 0xee, 0x0c, 0x3b, 0x77, 0x06, 0xca, 0x97, 0xe1,
-	0x9d, 0x61, 0xac, 0xb4, 0x4a, 0x4c, 0x23, 0xd9, 0xa4, 0x16, 0x5f, 0x11, 0xd1, 0xb9, 0x3e, 0x1f,
-	0xca, 0x4d, 0x82, 0x3a, 0xaf, 0xf4, 0x94, 0xea, 0x85, 0xd2, 0xa0, 0x76, 0x46, 0xc7, 0x77, 0x12,
-	0x1d, 0x8f, 0xba, 0xda, 0x20, 0xbb, 0xff, 0x58, 0x82, 0x6b, 0xed, 0x81, 0x88, 0xf5, 0x76, 0xa8,
-	0xba, 0x27, 0xed, 0x48, 0x0c, 0x93, 0xbe, 0xd2, 0xdb, 0x22, 0x91, 0xfc, 0x35, 0xa8, 0x76, 0x10,
-	0x98, 0xb4, 0x0a, 0x37, 0x4a, 0xb7, 0x9a, 0x77, 0x37, 0x36, 0x27, 0x18, 0x6f, 0x12, 0x85, 0x67,
-	0x71, 0xf8, 0x1b, 0x50, 0xf3, 0xa5, 0x16, 0x41, 0x98, 0xb4, 0x8a, 0x37, 0x0a, 0xb7, 0x9a, 0x77,
-	0x5f, 0xda, 0x34, 0x03, 0x6f, 0xa6, 0x03, 0x6f, 0xb6, 0x69, 0x60, 0x2f, 0xc5, 0xe3, 0xf7, 0xa0,
-	0x7e, 0x1c, 0x84, 0xf2, 0x7d, 0x79, 0x9e, 0xb4, 0x4a, 0x97, 0xd3, 0x64, 0x88, 0xfc, 0x3d, 0x58,
-	0x95, 0x67, 0x3a, 0x16, 0x9e, 0x0c, 0x85, 0x0e, 0x54, 0x94, 0xb4, 0xca, 0x24, 0xdd, 0x4b, 0x53,
-	0xd2, 0xa5, 0xfd, 0xde, 0x14, 0x3a, 0xbf, 0x01, 0x4d, 0xd5, 0xf9, 0x58, 0x76, 0xf5, 0xd1, 0xf9,
-	0x50, 0x26, 0xad, 0xca, 0x8d, 0xd2, 0xad, 0x86, 0x97, 0x07, 0xf1, 0x2f, 0x43, 0xb3, 0xab, 0xc2,
-	0x50, 0x76, 0x0d, 0xff, 0xea, 0xe5, 0xa2, 0xe5, 0x71, 0xf9, 0x9b, 0x70, 0x35, 0x96, 0x03, 0x75,
-	0x2a, 0xfd, 0x9d, 0x0c, 0x4a, 0xf3, 0xab, 0xd3, 0x30, 0xf3, 0x3b, 0xf9, 0x16, 0xac, 0xc4, 0x56,
-	0xbe, 0x47, 0x41, 0x74, 0x92, 0xb4, 0x6a, 0x34, 0xa5, 0x97, 0x2f, 0x98, 0x12, 0xe2, 0x78, 0x93,
-	0x14, 0x9c, 0x41, 0xe9, 0x44, 0x9e, 0xb7, 0x1a, 0x37, 0x0a, 0xb7, 0x1a, 0x1e, 0x7e, 0xba, 0x7f,
-	0xbf, 0x03, 0x15, 0xda, 0x22, 0xbe, 0x0a, 0xc5, 0xc0, 0x6f, 0x15, 0xa8, 0xab, 0x18, 0xf8, 0xfc,
-	0x0e, 0x54, 0x8f, 0x03, 0x19, 0xfa, 0xcf, 0xdd, 0x29, 0x8b, 0xc6, 0x1f, 0xc0, 0x72, 0x2c, 0x13,
-	0x1d, 0x07, 0x76, 0x45, 0xcc, 0x66, 0x7d, 0x66, 0x9e, 0x3e, 0x6c, 0x7a, 0x39, 0x44, 0x6f, 0x82,
-	0x0c, 0x57, 0xbe, 0xdb, 0x0f, 0x42, 0x3f, 0x96, 0xd1, 0xbe, 0x6f, 0xf6, 0xad, 0xe1, 0xe5, 0x41,
-	0xfc, 0x16, 0xac, 0x75, 0x44, 0xf7, 0xa4, 0x17, 0xab, 0x51, 0x84, 0x8b, 0xa4, 0xe2, 0x56, 0x85,
-	0xc4, 0x9e, 0x06, 0xf3, 0xd7, 0xa1, 0x22, 0xc2, 0xa0, 0x17, 0xd1, 0xee, 0xac, 0xde, 0x75, 0xe6,
-	0xca, 0xb2, 0x85, 0x18, 0x9e, 0x41, 0xe4, 0x7b, 0xb0, 0x72, 0x2a, 0x63, 0x1d, 0x74, 0x45, 0x48,
-	0xf0, 0x56, 0x8d, 0x28, 0xdd, 0xb9, 0x94, 0x4f, 0xf2, 0x98, 0xde, 0x24, 0x21, 0xdf, 0x07, 0x48,
-	0xf0, 0xc8, 0x90, 0xe6, 0xb7, 0x9a, 0xb4, 0x18, 0x9f, 0x9b, 0xcb, 0x66, 0x47, 0x45, 0x5a, 0x46,
-	0x7a, 0xb3, 0x9d, 0xa1, 0xef, 0x2d, 0x79, 0x39, 0x62, 0xfe, 0x16, 0x94, 0xb5, 0x3c, 0xd3, 0xad,
-	0xd5, 0x4b, 0x56, 0x34, 0x65, 0x72, 0x24, 0xcf, 0xf4, 0xde, 0x92, 0x47, 0x04, 0x48, 0x88, 0x47,
-	0xa2, 0xb5, 0xb6, 0x00, 0xe1, 0xc3, 0x20, 0x94, 0x48, 0x88, 0x04, 0xfc, 0x1d, 0xa8, 0x86, 0xe2,
-	0x5c, 0x8d, 0x74, 0x8b, 0x11, 0xe9, 0xff, 0xbf, 0x94, 0xf4, 0x11, 0xa1, 0xee, 0x2d, 0x79, 0x96,
-	0x88, 0xbf, 0x09, 0x25, 0x3f, 0x38, 0x6d, 0xad, 0x13, 0xed, 0x8d, 0x4b, 0x69, 0x77, 0x83, 0xd3,
-	0xbd, 0x25, 0x0f, 0xd1, 0xf9, 0x0e, 0xd4, 0x3b, 0x4a, 0x9d, 0x0c, 0x44, 0x7c, 0xd2, 0xe2, 0x44,
-	0xfa, 0xd9, 0x4b, 0x49, 0xb7, 0x2d, 0xf2, 0xde, 0x92, 0x97, 0x11, 0xe2, 0x94, 0x83, 0xae, 0x8a,
-	0x5a, 0x57, 0x16, 0x98, 0xf2, 0x7e, 0x57, 0x45, 0x38, 0x65, 0x24, 0x40, 0xc2, 0x30, 0x88, 0x4e,
-	0x5a, 0x1b, 0x0b, 0x10, 0xe2, 0x69, 0x42, 0x42, 0x24, 0x40, 0xb1, 0x7d, 0xa1, 0xc5, 0x69, 0x20,
-	0x9f, 0xb5, 0xae, 0x2e, 0x20, 0xf6, 0xae, 0x45, 0x46, 0xb1, 0x53, 0x42, 0x64, 0x92, 0x1e, 0xd5,
-	0xd6, 0xb5, 0x05, 0x98, 0xa4, 0xa7, 0x1c, 0x99, 0xa4, 0x84, 0xfc, 0x37, 0x61, 0xfd, 0x58, 0x0a,
-	0x3d, 0x8a, 0xa5, 0x3f, 0x36, 0x7c, 0x2f, 0x11, 0xb7, 0xcd, 0xcb, 0xf7, 0x7e, 0x9a, 0x6a, 0x6f,
-	0xc9, 0x9b, 0x65, 0xc5, 0xbf, 0x02, 0x95, 0x50, 0x68, 0x79, 0xd6, 0x6a, 0x11, 0x4f, 0xf7, 0x39,
-	0x4a, 0xa1, 0xe5, 0xd9, 0xde, 0x92, 0x67, 0x48, 0xf8, 0xaf, 0xc1, 0x9a, 0x16, 0x9d, 0x50, 0x1e,
-	0x1c, 0x5b, 0x84, 0xa4, 0xf5, 0x29, 0xe2, 0xf2, 0xda, 0xe5, 0xea, 0x3c, 0x49, 0xb3, 0xb7, 0xe4,
-	0x4d, 0xb3, 0x41, 0xa9, 0x08, 0xd4, 0x72, 0x16, 0x90, 0x8a, 0xf8, 0xa1, 0x54, 0x44, 0xc2, 0x1f,
-	0x41, 0x93, 0x3e, 0x76, 0x54, 0x38, 0x1a, 0x44, 0xad, 0x97, 0x89, 0xc3, 0xad, 0xe7, 0x73, 0x30,
-	0xf8, 0x7b, 0x4b, 0x5e, 0x9e, 0x1c, 0x37, 0x91, 0x9a, 0x9e, 0x7a, 0xd6, 0x7a, 0x65, 0x81, 0x4d,
-	0x3c, 0xb2, 0xc8, 0xb8, 0x89, 0x29, 0x21, 0x1e, 0xbd, 0x67, 0x81, 0xdf, 0x93, 0xba, 0xf5, 0xe9,
-	0x05, 0x8e, 0xde, 0x53, 0x42, 0xc5, 0xa3, 0x67, 0x88, 0x9c, 0x6f, 0xc1, 0x72, 0xde, 0xb8, 0x72,
-	0x0e, 0xe5, 0x58, 0x0a, 0x63, 0xd8, 0xeb, 0x1e, 0x7d, 0x23, 0x4c, 0xfa, 0x81, 0x26, 0xc3, 0x5e,
-	0xf7, 0xe8, 0x9b, 0x5f, 0x83, 0xaa, 0x71, 0x3b, 0x64, 0xb7, 0xeb, 0x9e, 0x6d, 0x21, 0xae, 0x1f,
-	0x8b, 0x5e, 0xab, 0x6c, 0x70, 0xf1, 0x1b, 0x71, 0xfd, 0x58, 0x0d, 0x0f, 0x22, 0xb2, 0xbb, 0x75,
-	0xcf, 0xb6, 0x9c, 0xff, 0x79, 0x13, 0x6a, 0x56, 0x30, 0xe7, 0x4f, 0x0a, 0x50, 0x35, 0x76, 0x81,
-	0xbf, 0x07, 0x95, 0x44, 0x9f, 0x87, 0x92, 0x64, 0x58, 0xbd, 0xfb, 0xf9, 0x05, 0x6c, 0xc9, 0x66,
-	0x1b, 0x09, 0x3c, 0x43, 0xe7, 0x7a, 0x50, 0xa1, 0x36, 0xaf, 0x41, 0xc9, 0x53, 0xcf, 0xd8, 0x12,
-	0x07, 0xa8, 0x9a, 0x35, 0x67, 0x05, 0x04, 0xee, 0x06, 0xa7, 0xac, 0x88, 0xc0, 0x3d, 0x29, 0x7c,
-	0x19, 0xb3, 0x12, 0x5f, 0x81, 0x46, 0xba, 0xba, 0x09, 0x2b, 0x73, 0x06, 0xcb, 0xb9, 0x7d, 0x4b,
-	0x58, 0xc5, 0xf9, 0xaf, 0x32, 0x94, 0xf1, 0x18, 0xf3, 0x57, 0x61, 0x45, 0x8b, 0xb8, 0x27, 0x4d,
-	0x6c, 0xb3, 0x9f, 0xba, 0xc0, 0x49, 0x20, 0x7f, 0x27, 0x9d, 0x43, 0x91, 0xe6, 0xf0, 0xb9, 0xe7,
-	0x9a, 0x87, 0x89, 0x19, 0xe4, 0x9c, 0x69, 0x69, 0x31, 0x67, 0xfa, 0x10, 0xea, 0x68, 0x95, 0xda,
-	0xc1, 0xb7, 0x24, 0x2d, 0xfd, 0xea, 0xdd, 0xdb, 0xcf, 0x1f, 0x72, 0xdf, 0x52, 0x78, 0x19, 0x2d,
-	0xdf, 0x87, 0x46, 0x57, 0xc4, 0x3e, 0x09, 0x43, 0xbb, 0xb5, 0x7a, 0xf7, 0x0b, 0xcf, 0x67, 0xb4,
-	0x93, 0x92, 0x78, 0x63, 0x6a, 0x7e, 0x00, 0x4d, 0x5f, 0x26, 0xdd, 0x38, 0x18, 0x92, 0x95, 0x32,
-	0x2e, 0xf5, 0x8b, 0xcf, 0x67, 0xb6, 0x3b, 0x26, 0xf2, 0xf2, 0x1c, 0xf8, 0x2b, 0xd0, 0x88, 0x33,
-	0x33, 0x55, 0x23, 0x3f, 0x3f, 0x06, 0xb8, 0x6f, 0x41, 0x3d, 0x9d, 0x0f, 0x5f, 0x86, 0x3a, 0xfe,
-	0x7e, 0xa0, 0x22, 0xc9, 0x96, 0x70, 0x6f, 0xb1, 0xd5, 0x1e, 0x88, 0x30, 0x64, 0x05, 0xbe, 0x0a,
-	0x80, 0xcd, 0xc7, 0xd2, 0x0f, 0x46, 0x03, 0x56, 0x74, 0x7f, 0x25, 0xd5, 0x96, 0x3a, 0x94, 0x0f,
-	0x45, 0x0f, 0x29, 0x96, 0xa1, 0x9e, 0x5a, 0x5d, 0x56, 0x40, 0xfa, 0x5d, 0x91, 0xf4, 0x3b, 0x4a,
-	0xc4, 0x3e, 0x2b, 0xf2, 0x26, 0xd4, 0xb6, 0xe2, 0x6e, 0x3f, 0x38, 0x95, 0xac, 0xe4, 0xde, 0x81,
-	0x66, 0x4e, 0x5e, 0x64, 0x61, 0x07, 0x6d, 0x40, 0x65, 0xcb, 0xf7, 0xa5, 0xcf, 0x0a, 0x48, 0x60,
-	0x27, 0xc8, 0x8a, 0xee, 0x17, 0xa0, 0x91, 0xad, 0x16, 0xa2, 0xa3, 0xff, 0x65, 0x4b, 0xf8, 0x85,
-	0x60, 0x56, 0x40, 0xad, 0xdc, 0x8f, 0xc2, 0x20, 0x92, 0xac, 0xe8, 0xfc, 0x16, 0xa9, 0x2a, 0xff,
-	0xd5, 0xc9, 0x03, 0x71, 0xf3, 0x79, 0x0e, 0x72, 0xf2, 0x34, 0xbc, 0x9c, 0x9b, 0xdf, 0xa3, 0x80,
-	0x84, 0xab, 0x43, 0x79, 0x57, 0xe9, 0x84, 0x15, 0x9c, 0xff, 0x28, 0x42, 0x3d, 0xf5, 0x8b, 0x18,
-	0xee, 0x8d, 0xe2, 0xd0, 0x2a, 0x34, 0x7e, 0xf2, 0x0d, 0xa8, 0xe8, 0x40, 0x5b, 0x35, 0x6e, 0x78,
-	0xa6, 0x81, 0x21, 0x57, 0x7e, 0x67, 0x4b, 0xd4, 0x37, 0xbd, 0x55, 0xc1, 0x40, 0xf4, 0xe4, 0x9e,
-	0x48, 0xfa, 0xa4, 0x8f, 0x0d, 0x6f, 0x0c, 0x40, 0xfa, 0x63, 0x71, 0x8a, 0x3a, 0x47, 0xfd, 0x26,
-	0x18, 0xcb, 0x83, 0xf8, 0x3d, 0x28, 0xe3, 0x04, 0xad, 0xd2, 0xfc, 0xbf, 0xa9, 0x09, 0xa3, 0x9a,
-	0x1c, 0xc6, 0x12, 0xb7, 0x67, 0x13, 0x83, 0x6b, 0x8f, 0x90, 0xf9, 0x4d, 0x58, 0x35, 0x87, 0xf0,
-	0x80, 0xc2, 0xee, 0x7d, 0x9f, 0x82, 0xb1, 0x86, 0x37, 0x05, 0xe5, 0x5b, 0xb8, 0x9c, 0x42, 0xcb,
-	0x56, 0x7d, 0x01, 0xfd, 0x4e, 0x17, 0x67, 0xb3, 0x8d, 0x24, 0x9e, 0xa1, 0x74, 0xef, 0xe3, 0x9a,
-	0x0a, 0x2d, 0x71, 0x9b, 0x1f, 0x0c, 0x86, 0xfa, 0xdc, 0x28, 0xcd, 0x43, 0xa9, 0xbb, 0xfd, 0x20,
-	0xea, 0xb1, 0x82, 0x59, 0x62, 0xdc, 0x44, 0x42, 0x89, 0x63, 0x15, 0xb3, 0x92, 0xe3, 0x40, 0x19,
-	0x75, 0x14, 0x8d, 0x64, 0x24, 0x06, 0xd2, 0xae, 0x34, 0x7d, 0x3b, 0x57, 0x60, 0x7d, 0xc6, 0xad,
-	0x3a, 0x7f, 0x5d, 0x35, 0x1a, 0x82, 0x14, 0x14, 0xd2, 0x59, 0x0a, 0x8a, 0xd6, 0x5e, 0xc8, 0xc6,
-	0x20, 0x97, 0x49, 0x1b, 0xf3, 0x0e, 0x54, 0x70, 0x62, 0xa9, 0x89, 0x59, 0x80, 0xfc, 0x31, 0xa2,
-	0x7b, 0x86, 0x8a, 0xb7, 0xa0, 0xd6, 0xed, 0xcb, 0xee, 0x89, 0xf4, 0xad, 0xad, 0x4f, 0x9b, 0xa8,
-	0x34, 0xdd, 0x5c, 0x94, 0x6d, 0x1a, 0xa4, 0x12, 0x5d, 0x15, 0x3d, 0x18, 0xa8, 0x8f, 0x03, 0xda,
-	0x57, 0x54, 0x89, 0x14, 0x90, 0xf6, 0xee, 0xa3, 0x8e, 0xd8, 0x6d, 0x1b, 0x03, 0x9c, 0x07, 0x50,
-	0xa1, 0xb1, 0xf1, 0x24, 0x18, 0x99, 0x4d, 0xf2, 0x78, 0x73, 0x31, 0x99, 0xad, 0xc8, 0xce, 0x9f,
-	0x17, 0xa1, 0x8c, 0x6d, 0x7e, 0x1b, 0x2a, 0xb1, 0x88, 0x7a, 0x66, 0x03, 0x66, 0x73, 0x50, 0x0f,
-	0xfb, 0x3c, 0x83, 0xc2, 0xdf, 0xb3, 0xaa, 0x58, 0x5c, 0x40, 0x59, 0xb2, 0x11, 0xf3, 0x6a, 0xb9,
-	0x01, 0x95, 0xa1, 0x88, 0xc5, 0xc0, 0x9e, 0x13, 0xd3, 0x70, 0x7f, 0x54, 0x80, 0x32, 0x22, 0xf1,
-	0x75, 0x58, 0x69, 0xeb, 0x38, 0x38, 0x91, 0xba, 0x1f, 0xab, 0x51, 0xaf, 0x6f, 0x34, 0xe9, 0x7d,
-	0x79, 0x6e, 0xec, 0x8d, 0x31, 0x08, 0x5a, 0x84, 0x41, 0x97, 0x15, 0x51, 0xab, 0xb6, 0x55, 0xe8,
-	0xb3, 0x12, 0x5f, 0x83, 0xe6, 0x87, 0x91, 0x2f, 0xe3, 0xa4, 0xab, 0x62, 0xe9, 0xb3, 0xb2, 0x3d,
-	0xdd, 0x27, 0xac, 0x42, 0xbe, 0x4c, 0x9e, 0x69, 0x4a, 0x69, 0x58, 0x95, 0x5f, 0x81, 0xb5, 0xed,
-	0xc9, 0x3c, 0x87, 0xd5, 0xd0, 0x26, 0x3d, 0x96, 0x11, 0x2a, 0x19, 0xab, 0x1b, 0x25, 0x56, 0x1f,
-	0x07, 0xac, 0x81, 0x83, 0x99, 0x73, 0xc2, 0xc0, 0xfd, 0x9b, 0x42, 0x6a, 0x39, 0x56, 0xa0, 0x71,
-	0x28, 0x62, 0xd1, 0x8b, 0xc5, 0x10, 0xe5, 0x6b, 0x42, 0xcd, 0x38, 0xce, 0x37, 0x8c, 0x75, 0x33,
-	0x8d, 0xbb, 0xc6, 0x36, 0x9a, 0xc6, 0x3d, 0x56, 0x1a, 0x37, 0xde, 0x64, 0x65, 0x1c, 0xe3, 0xeb,
-	0x23, 0xa5, 0x25, 0xab, 0x90, 0xad, 0x53, 0xbe, 0x64, 0x55, 0x04, 0x1e, 0xa1, 0x45, 0x61, 0x35,
-	0x9c, 0xf3, 0x0e, 0xea, 0x4f, 0x47, 0x9d, 0xb1, 0x3a, 0x8a, 0x81, 0xcb, 0x28, 0x7d, 0xd6, 0xc0,
-	0x9e, 0x0f, 0x46, 0x83, 0x8e, 0xc4, 0x69, 0x02, 0xf6, 0x1c, 0xa9, 0x5e, 0x2f, 0x94, 0xac, 0x89,
-	0x6b, 0x90, 0x33, 0xbe, 0x6c, 0x99, 0x2c, 0xad, 0x08, 0x43, 0x35, 0xd2, 0x6c, 0xc5, 0xf9, 0x69,
-	0x09, 0xca, 0x98, 0xa4, 0xe0, 0xd9, 0xe9, 0xa3, 0x9d, 0xb1, 0x67, 0x07, 0xbf, 0xb3, 0x13, 0x58,
-	0x1c, 0x9f, 0x40, 0xfe, 0x15, 0xbb, 0xd3, 0xa5, 0x05, 0xac, 0x2c, 0x32, 0xce, 0x6f, 0x32, 0x87,
-	0xf2, 0x20, 0x18, 0x48, 0x6b, 0xeb, 0xe8, 0x1b, 0x61, 0x09, 0xfa, 0x63, 0x3c, 0x06, 0x25, 0x8f,
-	0xbe, 0xf1, 0xd4, 0x08, 0x74, 0x0b, 0x5b, 0x9a, 0xce, 0x40, 0xc9, 0x4b, 0x9b, 0xe6, 0x34, 0xa3,
-	0x55, 0xaa, 0x2d, 0x70, 0x9a, 0x69, 0xf8, 0xbc, 0x45, 0x1a, 0x1b, 0x83, 0xfa, 0xe2, 0xe4, 0x39,
-	0x27, 0xb1, 0x6b, 0xb5, 0x71, 0xec, 0xc0, 0xea, 0x66, 0xf5, 0x58, 0x01, 0x77, 0x89, 0x8e, 0xa1,
-	0xb1, 0x65, 0x4f, 0x02, 0x5f, 0x2a, 0x56, 0x22, 0x07, 0x37, 0xf2, 0x03, 0xc5, 0xca, 0x18, 0x51,
-	0x1d, 0xee, 0x3e, 0x64, 0x15, 0xf7, 0x66, 0xce, 0xd5, 0x6c, 0x8d, 0xb4, 0x32, 0x6c, 0x48, 0x2d,
-	0x0b, 0x46, 0xcb, 0x3a, 0xd2, 0x67, 0x45, 0xf7, 0x4b, 0x73, 0xcc, 0xe7, 0x0a, 0x34, 0x3e, 0x1c,
-	0x86, 0x4a, 0xf8, 0x97, 0xd8, 0xcf, 0x65, 0x80, 0x71, 0xd2, 0xeb, 0xfc, 0xf7, 0xa7, 0xc7, 0x6e,
-	0x1a, 0x63, 0xcc, 0x44, 0x8d, 0xe2, 0xae, 0x24, 0xd3, 0xd0, 0xf0, 0x6c, 0x8b, 0x7f, 0x15, 0x2a,
-	0xd8, 0x9f, 0xb4, 0x8a, 0x64, 0x31, 0x6e, 0x2f, 0x94, 0x6a, 0x6d, 0x3e, 0x09, 0xe4, 0x33, 0xcf,
-	0x10, 0xf2, 0xfb, 0xf9, 0xb0, 0xe3, 0x39, 0x65, 0xa1, 0x31, 0x26, 0xbf, 0x0e, 0x20, 0xba, 0x3a,
-	0x38, 0x95, 0xc8, 0xcb, 0x9e, 0xfd, 0x1c, 0x84, 0x7b, 0xd0, 0xc4, 0x23, 0x39, 0x3c, 0x88, 0xf1,
-	0x14, 0xb7, 0x96, 0x89, 0xf1, 0xeb, 0x8b, 0x89, 0xf7, 0xb5, 0x8c, 0xd0, 0xcb, 0x33, 0xe1, 0x1f,
-	0xc2, 0xb2, 0x29, 0x39, 0x59, 0xa6, 0x2b, 0xc4, 0xf4, 0x8d, 0xc5, 0x98, 0x1e, 0x8c, 0x29, 0xbd,
-	0x09, 0x36, 0xb3, 0x95, 0xa4, 0xca, 0x0b, 0x57, 0x92, 0x6e, 0xc2, 0xea, 0xd1, 0xa4, 0x6f, 0x36,
-	0x2e, 0x60, 0x0a, 0xca, 0x5d, 0x58, 0x0e, 0x92, 0x71, 0x21, 0x8b, 0x4a, 0x18, 0x75, 0x6f, 0x02,
-	0xe6, 0xfc, 0x6d, 0x15, 0xca, 0xb4, 0x84, 0xd3, 0x25, 0xa8, 0x9d, 0x09, 0x53, 0x7d, 0x67, 0xf1,
-	0xad, 0x9e, 0x3a, 0xc9, 0x64, 0x19, 0x4a, 0x39, 0xcb, 0xf0, 0x55, 0xa8, 0x24, 0x2a, 0xd6, 0xe9,
-	0xf6, 0x2f, 0xa8, 0x44, 0x6d, 0x15, 0x6b, 0xcf, 0x10, 0xf2, 0x87, 0x50, 0x3b, 0x0e, 0x42, 0x8d,
-	0x9b, 0x62, 0x16, 0xef, 0xb5, 0xc5, 0x78, 0x3c, 0x24, 0x22, 0x2f, 0x25, 0xe6, 0x8f, 0xf2, 0xca,
-	0x58, 0x25, 0x4e, 0x9b, 0x8b, 0x71, 0x9a, 0xa7, 0xa3, 0xb7, 0x81, 0x75, 0xd5, 0xa9, 0x8c, 0xd3,
-	0xbe, 0xf7, 0xe5, 0xb9, 0x75, 0xbe, 0x33, 0x70, 0xee, 0x40, 0xbd, 0x1f, 0xf8, 0x12, 0xe3, 0x17,
-	0xb2, 0x31, 0x75, 0x2f, 0x6b, 0xf3, 0xf7, 0xa1, 0x4e, 0x71, 0x3f, 0x5a, 0xbb, 0xc6, 0x0b, 0x2f,
-	0xbe, 0x49, 0x41, 0x52, 0x06, 0x38, 0x10, 0x0d, 0xfe, 0x30, 0xd0, 0x2d, 0x30, 0x03, 0xa5, 0x6d,
-	0x14, 0x98, 0xf4, 0x3d, 0x2f, 0x70, 0xd3, 0x08, 0x3c, 0x0d, 0xe7, 0x6f, 0xc2, 0x55, 0x82, 0x4d,
-	0x39, 0x3f, 0x3c, 0x6a, 0xc8, 0x74, 0x7e, 0x27, 0x06, 0x22, 0x43, 0xd1, 0x93, 0x8f, 0x82, 0x41,
-	0xa0, 0x5b, 0x2b, 0x37, 0x0a, 0xb7, 0x2a, 0xde, 0x18, 0xc0, 0x5f, 0x83, 0x75, 0x5f, 0x1e, 0x8b,
-	0x51, 0xa8, 0x8f, 0xe4, 0x60, 0x18, 0x0a, 0x2d, 0xf7, 0x7d, 0xd2, 0xd1, 0x86, 0x37, 0xdb, 0xc1,
-	0x5f, 0x87, 0x2b, 0x16, 0x78, 0x90, 0x15, 0x82, 0xf7, 0x7d, 0xaa, 0xae, 0x35, 0xbc, 0x79, 0x5d,
-	0xee, 0xb6, 0x35, 0xc3, 0xe8, 0x18, 0x31, 0xff, 0x4c, 0x0d, 0x68, 0xa2, 0x8d, 0xa7, 0xfd, 0x9a,
-	0x08, 0x43, 0x19, 0x9f, 0x9b, 0xe4, 0xf5, 0x7d, 0x11, 0x75, 0x44, 0xc4, 0x4a, 0xe4, 0x3b, 0x45,
-	0x28, 0x23, 0x5f, 0xc4, 0xac, 0xec, 0xde, 0x82, 0x32, 0xad, 0x63, 0x03, 0x2a, 0x26, 0xe5, 0xa1,
-	0xf4, 0xd7, 0xa6, 0x3b, 0x64, 0x86, 0x1f, 0xe1, 0x99, 0x63, 0x45, 0xe7, 0xaf, 0x4a, 0x50, 0x4f,
-	0x57, 0x2c, 0xad, 0xf5, 0x16, 0xb2, 0x5a, 0x2f, 0xc5, 0x64, 0xc9, 0x93, 0x20, 0x09, 0x3a, 0x36,
-	0xc6, 0xac, 0x7b, 0x63, 0x00, 0x86, 0x35, 0xcf, 0x02, 0x5f, 0xf7, 0xe9, 0xa0, 0x54, 0x3c, 0xd3,
-	0xe0, 0xb7, 0x60, 0xcd, 0xc7, 0xc9, 0x47, 0xdd, 0x70, 0xe4, 0xcb, 0x23, 0x74, 0x89, 0x26, 0xe7,
-	0x9f, 0x06, 0xf3, 0x6f, 0x00, 0xe8, 0x60, 0x20, 0x1f, 0xaa, 0x78, 0x20, 0xb4, 0x0d, 0xf4, 0xbf,
-	0xfc, 0x62, 0xaa, 0xbc, 0x79, 0x94, 0x31, 0xf0, 0x72, 0xcc, 0x90, 0x35, 0x8e, 0x66, 0x59, 0xd7,
-	0x3e, 0x11, 0xeb, 0xdd, 0x8c, 0x81, 0x97, 0x63, 0xe6, 0xfe, 0x3a, 0xc0, 0xb8, 0x87, 0x5f, 0x03,
-	0xfe, 0x58, 0x45, 0xba, 0xbf, 0xd5, 0xe9, 0xc4, 0xdb, 0xf2, 0x58, 0xc5, 0x72, 0x57, 0xa0, 0x2f,
-	0xbb, 0x0a, 0xeb, 0x19, 0x7c, 0xeb, 0x58, 0xcb, 0x18, 0xc1, 0xb4, 0xf4, 0xed, 0xbe, 0x8a, 0xb5,
-	0x09, 0x94, 0xe8, 0xf3, 0xc3, 0x36, 0x2b, 0xa1, 0xff, 0xdc, 0x6f, 0x1f, 0xd0, 0xd6, 0xc1, 0x78,
-	0x4a, 0x94, 0x50, 0xd0, 0xd7, 0x1b, 0x77, 0x6d, 0x7a, 0x41, 0xad, 0xbb, 0x6f, 0xb2, 0x82, 0xf3,
-	0x97, 0x45, 0x28, 0xa3, 0x7d, 0xb1, 0x36, 0xb0, 0x9a, 0xd9, 0xc0, 0x1b, 0xd0, 0xcc, 0x1f, 0x0e,
-	0xb3, 0x9d, 0x79, 0xd0, 0x27, 0xb3, 0x92, 0x38, 0x56, 0xde, 0x4a, 0xbe, 0x0d, 0xcd, 0xee, 0x28,
-	0xd1, 0x6a, 0x40, 0x2e, 0xa2, 0x55, 0x22, 0x4b, 0x74, 0x6d, 0xa6, 0x4a, 0xf1, 0x44, 0x84, 0x23,
-	0xe9, 0xe5, 0x51, 0xf9, 0x7d, 0xa8, 0x1e, 0x9b, 0x8d, 0x31, 0x75, 0x8a, 0x4f, 0x5f, 0xe0, 0x45,
-	0xec, 0xe2, 0x5b, 0x64, 0x9c, 0x57, 0x30, 0xa3, 0x54, 0x79, 0x90, 0xfb, 0x59, 0x7b, 0x76, 0x6a,
-	0x50, 0xda, 0x4a, 0xba, 0x36, 0xcb, 0x95, 0x49, 0xd7, 0x84, 0xd0, 0x3b, 0x24, 0x02, 0x2b, 0x3a,
-	0x3f, 0xa9, 0x41, 0xd5, 0x58, 0x55, 0xbb, 0x76, 0x8d, 0x6c, 0xed, 0xbe, 0x0e, 0x75, 0x35, 0x94,
-	0xb1, 0xd0, 0x2a, 0xb6, 0xa9, 0xf6, 0xfd, 0x17, 0xb1, 0xd2, 0x9b, 0x07, 0x96, 0xd8, 0xcb, 0xd8,
-	0x4c, 0x6f, 0x47, 0x71, 0x76, 0x3b, 0x6e, 0x03, 0x4b, 0x0d, 0xf2, 0x61, 0x8c, 0x74, 0xfa, 0xdc,
-	0x26, 0x4e, 0x33, 0x70, 0x7e, 0x04, 0x8d, 0xae, 0x8a, 0xfc, 0x20, 0x4b, 0xbb, 0x57, 0xef, 0x7e,
-	0xe9, 0x85, 0x24, 0xdc, 0x49, 0xa9, 0xbd, 0x31, 0x23, 0xfe, 0x1a, 0x54, 0x4e, 0x71, 0x9f, 0x68,
-	0x43, 0x2e, 0xde, 0x45, 0x83, 0xc4, 0x3f, 0x82, 0xe6, 0x37, 0x47, 0x41, 0xf7, 0xe4, 0x20, 0x5f,
-	0xd6, 0x79, 0xfb, 0x85, 0xa4, 0xf8, 0xfa, 0x98, 0xde, 0xcb, 0x33, 0xcb, 0xe9, 0x46, 0xed, 0x17,
-	0xd0, 0x8d, 0xfa, 0xac, 0x6e, 0xbc, 0x0c, 0xf5, 0x74, 0x73, 0x48, 0x3f, 0x22, 0x9f, 0x2d, 0xf1,
-	0x2a, 0x14, 0x0f, 0x62, 0x56, 0x70, 0xff, 0xb3, 0x00, 0x8d, 0x6c, 0x61, 0x26, 0x4b, 0x38, 0x0f,
-	0xbe, 0x39, 0x12, 0x21, 0x2b, 0x50, 0x0e, 0xa2, 0xb4, 0x69, 0xd1, 0xe1, 0xfd, 0x5a, 0x2c, 0x85,
-	0xa6, 0xca, 0x21, 0xda, 0x67, 0x99, 0x24, 0xac, 0xcc, 0x39, 0xac, 0x5a, 0xf0, 0x41, 0x6c, 0x50,
-	0x2b, 0x98, 0xa2, 0x60, 0x6f, 0x0a, 0xa8, 0x1a, 0x73, 0x7e, 0x22, 0x4d, 0x0a, 0xf6, 0x81, 0xd2,
-	0xd4, 0xa8, 0xa3, 0x2c, 0xfb, 0x11, 0x6b, 0xe0, 0x98, 0x1f, 0x28, 0xbd, 0x1f, 0x31, 0x18, 0xc7,
-	0xc6, 0xcd, 0x74, 0x78, 0x6a, 0x2d, 0x53, 0xe4, 0x1d, 0x86, 0xfb, 0x11, 0x5b, 0xb1, 0x1d, 0xa6,
-	0xb5, 0x8a, 0x1c, 0x1f, 0x9c, 0x89, 0x2e, 0x92, 0xaf, 0xf1, 0x55, 0x00, 0xa4, 0xb1, 0x6d, 0x86,
-	0x67, 0xe0, 0xc1, 0x59, 0x90, 0xe8, 0x84, 0xad, 0xbb, 0xff, 0x50, 0x80, 0x66, 0x6e, 0x13, 0x30,
-	0xf6, 0x26, 0x44, 0x34, 0x6d, 0x26, 0x14, 0xff, 0x86, 0x4c, 0xb4, 0x8c, 0xfd, 0xd4, 0x6c, 0x1d,
-	0x29, 0xfc, 0x2c, 0xe2, 0x78, 0x47, 0x6a, 0xa0, 0xe2, 0x58, 0x3d, 0x33, 0x7e, 0xe7, 0x91, 0x48,
-	0xf4, 0x53, 0x29, 0x4f, 0x58, 0x19, 0xa7, 0xba, 0x33, 0x8a, 0x63, 0x19, 0x19, 0x40, 0x85, 0x84,
-	0x93, 0x67, 0xa6, 0x55, 0x45, 0xa6, 0x88, 0x4c, 0x76, 0x91, 0xd5, 0x38, 0x83, 0x65, 0x8b, 0x6d,
-	0x20, 0x75, 0x44, 0x40, 0x74, 0xd3, 0x6c, 0x60, 0xda, 0x6a, 0xd2, 0xbe, 0x83, 0xe3, 0x5d, 0x71,
-	0x9e, 0x6c, 0xf5, 0x14, 0x83, 0x69, 0xe0, 0x07, 0xea, 0x19, 0x6b, 0x3a, 0x23, 0x80, 0x71, 0x40,
-	0x8c, 0x89, 0x00, 0xea, 0x5a, 0x56, 0x98, 0xb5, 0x2d, 0x7e, 0x00, 0x80, 0x5f, 0x84, 0x99, 0x66,
-	0x03, 0x2f, 0x10, 0xa5, 0x10, 0x9d, 0x97, 0x63, 0xe1, 0xfc, 0x0e, 0x34, 0xb2, 0x0e, 0xcc, 0xeb,
-	0x28, 0x9e, 0xc8, 0x86, 0x4d, 0x9b, 0xe8, 0x27, 0x83, 0xc8, 0x97, 0x67, 0x74, 0xf6, 0x2b, 0x9e,
-	0x69, 0xa0, 0x94, 0xfd, 0xc0, 0xf7, 0x65, 0x94, 0x96, 0xcf, 0x4d, 0x6b, 0xde, 0x5d, 0x65, 0x79,
-	0xee, 0x5d, 0xa5, 0xf3, 0x1b, 0xd0, 0xcc, 0x45, 0xec, 0x17, 0x4e, 0x3b, 0x27, 0x58, 0x71, 0x52,
-	0xb0, 0x57, 0xa0, 0xa1, 0x6c, 0xd8, 0x9d, 0x90, 0x01, 0x6f, 0x78, 0x63, 0x00, 0x3a, 0x98, 0x8a,
-	0x99, 0xda, 0x74, 0x94, 0xfd, 0x10, 0xaa, 0x98, 0x72, 0x8e, 0xd2, 0x8b, 0xde, 0x05, 0x23, 0xd9,
-	0x36, 0xd1, 0xec, 0x2d, 0x79, 0x96, 0x9a, 0xbf, 0x03, 0x25, 0x2d, 0x7a, 0xb6, 0xfa, 0xf4, 0xf9,
-	0xc5, 0x98, 0x1c, 0x89, 0xde, 0xde, 0x92, 0x87, 0x74, 0xfc, 0x11, 0xd4, 0xbb, 0xb6, 0x60, 0x60,
-	0x0d, 0xd7, 0x82, 0x81, 0x70, 0x5a, 0x66, 0xd8, 0x5b, 0xf2, 0x32, 0x0e, 0xfc, 0xab, 0x50, 0x46,
-	0x2f, 0x4f, 0x96, 0x77, 0xe1, 0x00, 0x1f, 0x8f, 0xcb, 0xde, 0x92, 0x47, 0x94, 0xdb, 0x35, 0xa8,
-	0x90, 0x9d, 0x74, 0x5a, 0x50, 0x35, 0x73, 0x9d, 0x5e, 0x39, 0xe7, 0x25, 0x28, 0x1d, 0x89, 0x1e,
-	0x46, 0x5a, 0x81, 0x9f, 0xd8, 0x3c, 0x15, 0x3f, 0x9d, 0x57, 0xc7, 0xc5, 0x8f, 0x7c, 0x5d, 0xad,
-	0x30, 0x51, 0x57, 0x73, 0xaa, 0x50, 0xc6, 0x11, 0x9d, 0x57, 0x2e, 0x8b, 0xda, 0x9c, 0x97, 0x31,
-	0xbe, 0xd3, 0xf2, 0x6c, 0x5e, 0xc9, 0xd0, 0x59, 0x87, 0xb5, 0xa9, 0x1b, 0x32, 0xa7, 0x66, 0x43,
-	0x4d, 0x67, 0x05, 0x9a, 0xb9, 0x3b, 0x0f, 0xe7, 0x26, 0xd4, 0xd3, 0x1b, 0x11, 0x0c, 0xc9, 0x83,
-	0xc4, 0xd4, 0x72, 0xac, 0x50, 0x59, 0xdb, 0xf9, 0x8b, 0x02, 0x54, 0xcd, 0xad, 0x12, 0xdf, 0xce,
-	0x6e, 0x81, 0x0b, 0x0b, 0x5c, 0x41, 0x18, 0x22, 0x7b, 0x81, 0x93, 0x5d, 0x05, 0x6f, 0x40, 0x25,
-	0xa4, 0xd8, 0xdb, 0x1e, 0x17, 0x6a, 0xe4, 0xb4, 0xbb, 0x94, 0xd7, 0x6e, 0xf7, 0xad, 0xec, 0xd2,
-	0x28, 0xad, 0x33, 0x90, 0xdb, 0x3f, 0x8a, 0xa5, 0x34, 0x35, 0x04, 0x0a, 0x9d, 0x8b, 0x64, 0x9b,
-	0xd4, 0x60, 0x28, 0xba, 0x9a, 0x00, 0x25, 0xf7, 0x18, 0xea, 0x87, 0x2a, 0x99, 0xb6, 0xf8, 0x35,
-	0x28, 0x1d, 0xa9, 0xa1, 0x09, 0x18, 0xb6, 0x95, 0xa6, 0x80, 0xc1, 0x18, 0xf8, 0x63, 0x6d, 0x4a,
-	0x1e, 0x5e, 0xd0, 0xeb, 0x6b, 0x53, 0xce, 0xda, 0x8f, 0x22, 0x19, 0xb3, 0x0a, 0x5a, 0x5d, 0x4f,
-	0x0e, 0x43, 0xd1, 0x95, 0xac, 0x8a, 0x56, 0x97, 0xe0, 0x0f, 0x83, 0x38, 0xd1, 0xac, 0xe6, 0xbe,
-	0x85, 0xb6, 0x3a, 0xe8, 0x91, 0x89, 0xa5, 0x0f, 0x62, 0xb5, 0x84, 0x02, 0x51, 0x73, 0x47, 0x46,
-	0xe8, 0x46, 0xe8, 0x56, 0xc2, 0x3c, 0x0b, 0xa0, 0x01, 0x8a, 0xee, 0x53, 0x58, 0x99, 0x78, 0x2e,
-	0xc0, 0x37, 0x80, 0x4d, 0x00, 0x50, 0xd0, 0x25, 0xfe, 0x12, 0x5c, 0x99, 0x80, 0x3e, 0x0e, 0x7c,
-	0x9f, 0x8a, 0x36, 0xd3, 0x1d, 0xe9, 0x74, 0xb6, 0x1b, 0x50, 0xeb, 0x9a, 0x1d, 0x70, 0x0f, 0x61,
-	0x85, 0xb6, 0xe4, 0xb1, 0xd4, 0xe2, 0x20, 0x0a, 0xcf, 0x7f, 0xe1, 0x37, 0x1d, 0xee, 0x17, 0xa0,
-	0x42, 0xc5, 0x53, 0x54, 0xbe, 0xe3, 0x58, 0x0d, 0x88, 0x57, 0xc5, 0xa3, 0x6f, 0xe4, 0xae, 0x95,
-	0xdd, 0xd7, 0xa2, 0x56, 0xee, 0x0f, 0x01, 0x6a, 0x5b, 0xdd, 0xae, 0x1a, 0x45, 0x7a, 0x66, 0xe4,
-	0x79, 0xf5, 0xb9, 0xfb, 0x50, 0x15, 0xa7, 0x42, 0x8b, 0xd8, 0xda, 0x8c, 0xe9, 0xe8, 0xc0, 0xf2,
-	0xda, 0xdc, 0x22, 0x24, 0xcf, 0x22, 0x23, 0x59, 0x57, 0x45, 0xc7, 0x41, 0xcf, 0x9a, 0x89, 0x8b,
-	0xc8, 0x76, 0x08, 0xc9, 0xb3, 0xc8, 0x48, 0x66, 0xcd, 0x5c, 0xe5, 0x52, 0x32, 0x73, 0xd6, 0x33,
-	0xab, 0x76, 0x07, 0xca, 0x41, 0x74, 0xac, 0xec, 0xfb, 0x9e, 0x97, 0x2f, 0x20, 0xda, 0x8f, 0x8e,
-	0x95, 0x47, 0x88, 0x8e, 0x84, 0xaa, 0x11, 0x98, 0x7f, 0x19, 0x2a, 0x74, 0x47, 0x62, 0xab, 0xd2,
-	0x0b, 0x3d, 0xbf, 0x30, 0x14, 0xfc, 0x5a, 0x5a, 0x72, 0xa7, 0xf5, 0x42, 0x38, 0x35, 0xb7, 0xeb,
-	0xe9, 0x92, 0x39, 0xff, 0x5a, 0x80, 0xaa, 0x99, 0x21, 0xbf, 0x09, 0xab, 0x32, 0xc2, 0xa3, 0x9d,
-	0x1a, 0x32, 0x7b, 0xa6, 0xa7, 0xa0, 0x18, 0x56, 0x59, 0x88, 0xec, 0x8c, 0x7a, 0x36, 0x03, 0xcc,
-	0x83, 0xf8, 0xdb, 0xf0, 0x92, 0x69, 0x1e, 0xc6, 0x32, 0x96, 0xa1, 0x14, 0x89, 0xdc, 0xe9, 0x8b,
-	0x28, 0x92, 0xa1, 0x75, 0x6b, 0x17, 0x75, 0x73, 0x17, 0x96, 0x4d, 0x57, 0x7b, 0x28, 0xba, 0x32,
-	0xb1, 0x57, 0x08, 0x13, 0x30, 0xfe, 0x45, 0xa8, 0xd0, 0x2b, 0xab, 0x96, 0x7f, 0xb9, 0xf2, 0x19,
-	0x2c, 0x47, 0x65, 0x76, 0x77, 0x0b, 0xc0, 0xec, 0x06, 0xe6, 0x03, 0xd6, 0x16, 0x7d, 0xe6, 0xd2,
-	0xed, 0xa3, 0xcc, 0x26, 0x47, 0x84, 0xf2, 0xf9, 0x32, 0x94, 0x68, 0x1f, 0xd0, 0xe6, 0xd2, 0xe4,
-	0x4b, 0xde, 0x04, 0xcc, 0xf9, 0x4e, 0x19, 0xca, 0xb8, 0x91, 0x88, 0xdc, 0x57, 0x03, 0x99, 0x95,
-	0xb6, 0x8c, 0xd2, 0x4e, 0xc0, 0xd0, 0xb1, 0x0b, 0x73, 0x6b, 0x98, 0xa1, 0x19, 0x53, 0x36, 0x0d,
-	0x46, 0xcc, 0x61, 0xac, 0x8e, 0x83, 0x70, 0x8c, 0x69, 0x43, 0x80, 0x29, 0x30, 0xff, 0x12, 0x5c,
-	0x1b, 0x88, 0xf8, 0x44, 0x6a, 0xb2, 0x3e, 0x4f, 0x55, 0x7c, 0x92, 0xe0, 0xca, 0xed, 0xfb, 0xb6,
-	0x26, 0x72, 0x41, 0x2f, 0x9a, 0x73, 0x5f, 0x9e, 0x06, 0x84, 0x59, 0x27, 0xcc, 0xac, 0x8d, 0xca,
-	0x21, 0xcc, 0xd2, 0xb4, 0x2d, 0x2f, 0x93, 0x1f, 0x4d, 0x41, 0x31, 0x7a, 0x30, 0x2f, 0x08, 0x92,
-	0x7d, 0x9f, 0xca, 0x34, 0x0d, 0x6f, 0x0c, 0x40, 0xd5, 0xa1, 0xc1, 0x9e, 0x18, 0xa3, 0xbd, 0x62,
-	0xd2, 0x9e, 0x1c, 0x08, 0x31, 0xb4, 0xec, 0xf6, 0xd3, 0x41, 0x4c, 0x0d, 0x25, 0x0f, 0xe2, 0xd7,
-	0x01, 0x7a, 0x42, 0xcb, 0x67, 0xe2, 0xfc, 0xc3, 0x38, 0x6c, 0x49, 0x53, 0x40, 0x1d, 0x43, 0x30,
-	0x71, 0x0a, 0x55, 0x57, 0x84, 0x6d, 0xad, 0x62, 0xd1, 0x93, 0x87, 0x42, 0xf7, 0x5b, 0x3d, 0x93,
-	0x38, 0x4d, 0xc3, 0x71, 0xc6, 0x3a, 0x18, 0xc8, 0x8f, 0x54, 0x24, 0x5b, 0x7d, 0x33, 0xe3, 0xb4,
-	0x8d, 0x92, 0x88, 0x48, 0x84, 0xe7, 0x3a, 0xe8, 0xe2, 0x5c, 0x02, 0x23, 0x49, 0x0e, 0x84, 0x73,
-	0x8d, 0xa4, 0x7e, 0xa6, 0xe2, 0x93, 0x7d, 0xbf, 0xf5, 0xb1, 0x99, 0x6b, 0x06, 0x70, 0x0f, 0x00,
-	0xc6, 0x4a, 0x84, 0x9e, 0x63, 0x8b, 0x8a, 0xbc, 0x6c, 0x09, 0xa3, 0xd5, 0x43, 0x19, 0xf9, 0x41,
-	0xd4, 0xdb, 0xb5, 0x7a, 0xc3, 0x0a, 0x08, 0x6c, 0x6b, 0x11, 0x6b, 0xe9, 0x67, 0x40, 0xca, 0x28,
-	0xa8, 0x25, 0x7d, 0x56, 0x72, 0xff, 0xb7, 0x00, 0xcd, 0xdc, 0x15, 0xe7, 0x2f, 0xf1, 0x5a, 0x16,
-	0xfd, 0x38, 0xda, 0x0b, 0x5c, 0x50, 0xa3, 0x53, 0x59, 0x1b, 0x97, 0xdb, 0xde, 0xc0, 0x62, 0xaf,
-	0xc9, 0x40, 0x73, 0x90, 0x4f, 0x74, 0x25, 0xeb, 0xde, 0xb5, 0x39, 0x79, 0x13, 0x6a, 0x1f, 0x46,
-	0x27, 0x91, 0x7a, 0x16, 0x19, 0x07, 0x4d, 0xf7, 0xec, 0x13, 0x37, 0x0b, 0xe9, 0x55, 0x78, 0xc9,
-	0xfd, 0x41, 0x79, 0xea, 0x49, 0xca, 0x03, 0xa8, 0x9a, 0xb8, 0x94, 0x42, 0xa6, 0xd9, 0x37, 0x04,
-	0x79, 0x64, 0x5b, 0xc5, 0xce, 0x81, 0x3c, 0x4b, 0x8c, 0x01, 0x63, 0xf6, 0xee, 0xaa, 0x38, 0xb7,
-	0xda, 0x3e, 0xc1, 0x28, 0x35, 0x83, 0x13, 0x4f, 0x0f, 0x33, 0x0e, 0xce, 0x1f, 0x14, 0x60, 0x63,
-	0x1e, 0x0a, 0xc6, 0x6f, 0x9d, 0x89, 0x97, 0x21, 0x69, 0x93, 0xb7, 0xa7, 0x1e, 0x3c, 0x16, 0x69,
-	0x36, 0x77, 0x5e, 0x50, 0x88, 0xc9, 0xe7, 0x8f, 0xee, 0xf7, 0x0b, 0xb0, 0x3e, 0x33, 0xe7, 0x5c,
-	0x48, 0x03, 0x50, 0x35, 0x9a, 0x65, 0x1e, 0x32, 0x64, 0x57, 0xcb, 0xa6, 0x84, 0x48, 0x3e, 0x25,
-	0x31, 0x77, 0x75, 0xbb, 0xe6, 0x01, 0x2d, 0x2b, 0x63, 0x2c, 0x82, 0xbb, 0x86, 0xb6, 0xba, 0x27,
-	0x59, 0x05, 0x73, 0x35, 0x13, 0x65, 0x59, 0x48, 0x95, 0xf2, 0x40, 0x5b, 0xe7, 0x64, 0x35, 0x7a,
-	0x20, 0x31, 0x1a, 0x86, 0x41, 0x17, 0x9b, 0x75, 0xd7, 0x83, 0x2b, 0x73, 0xe4, 0x26, 0x49, 0x9e,
-	0x58, 0xa9, 0x56, 0x01, 0x76, 0x9f, 0xa4, 0xb2, 0xb0, 0x02, 0xa6, 0xce, 0xbb, 0x4f, 0x76, 0x28,
-	0x79, 0xb6, 0xd7, 0x8f, 0xe6, 0x4c, 0x3c, 0x41, 0x6b, 0x91, 0xb0, 0x92, 0xfb, 0xdd, 0x42, 0x7a,
-	0x31, 0xe9, 0xfc, 0x36, 0xac, 0x18, 0x39, 0x0e, 0xc5, 0x79, 0xa8, 0x84, 0xcf, 0x1f, 0xc0, 0x6a,
-	0x92, 0x3d, 0x36, 0xce, 0x99, 0xfc, 0x69, 0x8f, 0xdd, 0x9e, 0x40, 0xf2, 0xa6, 0x88, 0xd2, 0x50,
-	0xba, 0x38, 0x2e, 0x80, 0x72, 0x4a, 0x0a, 0x04, 0x9d, 0xa4, 0x65, 0x0a, 0xf3, 0x85, 0xfb, 0x45,
-	0x58, 0x27, 0x03, 0x65, 0x84, 0x31, 0x31, 0x30, 0xee, 0xb9, 0xb1, 0xad, 0xbb, 0xe9, 0x9e, 0xdb,
-	0xa6, 0xfb, 0x93, 0x0a, 0xc0, 0xb8, 0xc2, 0x3b, 0xe7, 0x28, 0xcf, 0x0b, 0x74, 0x66, 0xee, 0x5b,
-	0x4a, 0x2f, 0x7c, 0xdf, 0xf2, 0x76, 0x16, 0x8a, 0x9b, 0x2a, 0xdb, 0xf4, 0xa3, 0xca, 0xb1, 0x4c,
-	0xd3, 0x01, 0xf8, 0xc4, 0x3d, 0x7d, 0x65, 0xfa, 0x9e, 0xfe, 0xc6, 0xec, 0xa3, 0x9e, 0x29, 0x1b,
-	0x33, 0xce, 0x6c, 0x6b, 0x13, 0x99, 0xad, 0x03, 0xf5, 0x58, 0x0a, 0x5f, 0x45, 0xe1, 0x79, 0x5a,
-	0xd6, 0x4f, 0xdb, 0xfc, 0x1e, 0x54, 0x34, 0xbd, 0x9b, 0xae, 0xd3, 0x91, 0x78, 0xce, 0xc6, 0x19,
-	0x5c, 0x34, 0x58, 0x41, 0x62, 0x5f, 0xe2, 0x18, 0x2f, 0x55, 0xf7, 0x72, 0x10, 0xbe, 0x09, 0x3c,
-	0x88, 0x12, 0x2d, 0xc2, 0x50, 0xfa, 0xdb, 0xe7, 0xbb, 0xa6, 0xda, 0x4e, 0x9e, 0xb1, 0xee, 0xcd,
-	0xe9, 0x49, 0xf7, 0x7f, 0x79, 0xfc, 0xd8, 0xf9, 0x07, 0xc5, 0x2c, 0xbd, 0x68, 0x40, 0xa5, 0x23,
-	0x92, 0xa0, 0x6b, 0x6e, 0xbf, 0xad, 0x23, 0x36, 0x29, 0x86, 0x56, 0xbe, 0x62, 0x45, 0xcc, 0x1d,
-	0x12, 0x89, 0x59, 0xc2, 0x2a, 0xc0, 0xf8, 0xdd, 0x37, 0x2b, 0xe3, 0x59, 0x49, 0xf7, 0xc6, 0x5c,
-	0x7e, 0x13, 0x29, 0x15, 0x44, 0xfc, 0xec, 0x59, 0x51, 0x0d, 0x47, 0x20, 0x5b, 0xcc, 0xea, 0x88,
-	0x13, 0x29, 0x2d, 0x4d, 0x39, 0x88, 0x34, 0x89, 0x01, 0xb2, 0x49, 0x1f, 0xad, 0xb2, 0x26, 0x86,
-	0xf7, 0x29, 0x53, 0x53, 0xc3, 0x49, 0x28, 0xb1, 0x59, 0xc6, 0x93, 0x34, 0xd9, 0xc1, 0x56, 0x50,
-	0xa2, 0xf1, 0x73, 0x72, 0xb6, 0x8a, 0x5c, 0x05, 0x5d, 0xdd, 0xae, 0xe1, 0xe7, 0x29, 0x5d, 0xe8,
-	0x32, 0x1c, 0x15, 0x13, 0x59, 0xb6, 0x8e, 0x92, 0x65, 0xae, 0x9a, 0x71, 0x1c, 0x19, 0x55, 0xbf,
-	0x23, 0x12, 0xc9, 0x36, 0xdc, 0x1f, 0x8e, 0x1f, 0xea, 0xbd, 0x9e, 0x05, 0xed, 0x8b, 0x28, 0xd8,
-	0x45, 0x61, 0xfd, 0x03, 0x58, 0x8f, 0xe5, 0x37, 0x47, 0xc1, 0xc4, 0x2b, 0xd4, 0xd2, 0xe5, 0xf7,
-	0xac, 0xb3, 0x14, 0xee, 0x29, 0xac, 0xa7, 0x8d, 0xa7, 0x81, 0xee, 0x53, 0x2e, 0xce, 0xef, 0xe5,
-	0x9e, 0xc9, 0x16, 0x6c, 0x14, 0x79, 0x01, 0xcb, 0xf1, 0xb3, 0xd8, 0xac, 0x1e, 0x5a, 0x5c, 0xa0,
-	0x1e, 0xea, 0xfe, 0x4b, 0x35, 0x97, 0x8e, 0x9b, 0x34, 0xc6, 0xcf, 0xd2, 0x98, 0xd9, 0x4b, 0x95,
-	0x71, 0x89, 0xb3, 0xf8, 0x22, 0x25, 0xce, 0x79, 0xb7, 0x92, 0x5f, 0xc1, 0x18, 0x95, 0x74, 0xf7,
-	0xc9, 0x02, 0xe5, 0xdb, 0x09, 0x5c, 0xbe, 0x4d, 0x57, 0x24, 0xa2, 0x6d, 0xae, 0xcc, 0x2b, 0x73,
-	0x1f, 0xad, 0xe7, 0xef, 0x42, 0x2c, 0xa6, 0x97, 0xa3, 0xca, 0x9d, 0xf4, 0xea, 0xbc, 0x93, 0x8e,
-	0x19, 0xa5, 0xb5, 0x01, 0x59, 0xdb, 0x54, 0xbb, 0xcd, 0x77, 0xca, 0x9e, 0xee, 0xc3, 0xea, 0xde,
-	0x0c, 0x1c, 0xa3, 0x9c, 0xc1, 0x28, 0xd4, 0x81, 0x2d, 0xe8, 0x9a, 0xc6, 0xf4, 0x3f, 0x2d, 0x1a,
-	0xb3, 0xff, 0xb4, 0x78, 0x17, 0x20, 0x91, 0xa8, 0xed, 0xbb, 0x41, 0x57, 0xdb, 0x8b, 0xf5, 0xeb,
-	0x17, 0xcd, 0xcd, 0x96, 0xa1, 0x73, 0x14, 0x28, 0xff, 0x40, 0x9c, 0xed, 0x60, 0xb4, 0x6b, 0x6f,
-	0x00, 0xb3, 0xf6, 0xb4, 0xfd, 0x5b, 0x9d, 0xb5, 0x7f, 0xf7, 0xa0, 0x92, 0x74, 0xd5, 0x50, 0xd2,
-	0xc3, 0xf0, 0x8b, 0xf7, 0x77, 0xb3, 0x8d, 0x48, 0x9e, 0xc1, 0xa5, 0xa2, 0x0f, 0x7a, 0x3f, 0x15,
-	0xd3, 0x93, 0xf0, 0x86, 0x97, 0x36, 0x1d, 0x1f, 0xaa, 0xb6, 0x48, 0x3b, 0x27, 0x45, 0xa6, 0xfa,
-	0x4e, 0x31, 0xf7, 0x24, 0x2c, 0x7b, 0x7a, 0x55, 0xca, 0x3f, 0xbd, 0xba, 0x01, 0xcd, 0x38, 0x77,
-	0x09, 0x61, 0xdf, 0xdb, 0xe5, 0x40, 0xee, 0x47, 0x50, 0x21, 0x79, 0xd0, 0x49, 0x9b, 0xa5, 0x34,
-	0x71, 0x1a, 0x0a, 0xce, 0x0a, 0x7c, 0x03, 0x58, 0x22, 0xf5, 0xc1, 0xf1, 0x51, 0x5f, 0xb6, 0xc5,
-	0x40, 0x92, 0x61, 0x2b, 0xf2, 0x16, 0x6c, 0x18, 0xdc, 0x64, 0xb2, 0x87, 0xa2, 0x89, 0x30, 0xe8,
-	0xc4, 0x22, 0x3e, 0x67, 0x65, 0xf7, 0x5d, 0xba, 0x32, 0x4b, 0x95, 0xa6, 0x99, 0xfd, 0xa3, 0xc7,
-	0x98, 0x52, 0x5f, 0xc6, 0x68, 0xad, 0xcd, 0xf5, 0xa6, 0xcd, 0x31, 0xcc, 0xa3, 0x0f, 0x0a, 0xe2,
-	0x59, 0xc9, 0x7d, 0x8a, 0xe1, 0xe0, 0xd8, 0xb7, 0xfd, 0xd2, 0xce, 0x94, 0xbb, 0x9d, 0x0b, 0x87,
-	0x26, 0x5f, 0x79, 0x14, 0x16, 0x7d, 0xe5, 0xe1, 0xbe, 0x0f, 0x6b, 0xde, 0xa4, 0x1d, 0xe6, 0x6f,
-	0x43, 0x4d, 0x0d, 0xf3, 0x7c, 0x9e, 0xa7, 0x7b, 0x29, 0xba, 0xfb, 0xe3, 0x02, 0x2c, 0xef, 0x47,
-	0x5a, 0xc6, 0x91, 0x08, 0x1f, 0x86, 0xa2, 0xc7, 0xdf, 0x4a, 0x2d, 0xd1, 0xfc, 0x1c, 0x36, 0x8f,
-	0x3b, 0x69, 0x94, 0x42, 0x5b, 0x8c, 0xe4, 0x57, 0x61, 0x5d, 0xfa, 0x81, 0x56, 0xb1, 0x09, 0x02,
-	0xd3, 0xc7, 0x36, 0x1b, 0xc0, 0x0c, 0xb8, 0x4d, 0x6a, 0x7f, 0x64, 0xb6, 0xb9, 0x05, 0x1b, 0x13,
-	0xd0, 0x34, 0xc2, 0x2b, 0xf2, 0x57, 0xa0, 0x35, 0xf6, 0x20, 0xbb, 0x2a, 0xd2, 0xfb, 0x91, 0x2f,
-	0xcf, 0x28, 0xd4, 0x60, 0x25, 0xf7, 0x9f, 0xb3, 0x20, 0xe7, 0x89, 0x7d, 0x8a, 0x13, 0x2b, 0xa5,
-	0xc7, 0xa5, 0x68, 0xd3, 0xca, 0xfd, 0xf5, 0xab, 0xb8, 0xc0, 0x5f, 0xbf, 0xde, 0x1d, 0xff, 0xf5,
-	0xcb, 0x38, 0x83, 0x57, 0xe7, 0x7a, 0x18, 0x7a, 0x41, 0x60, 0x43, 0xd7, 0xb6, 0xcc, 0xfd, 0x0f,
-	0xec, 0x0d, 0x9b, 0xaf, 0x94, 0x17, 0x89, 0x05, 0xcd, 0xa5, 0xe6, 0xfd, 0xe9, 0x07, 0xc6, 0x8b,
-	0xbd, 0xf4, 0x99, 0x09, 0xd7, 0xe0, 0x85, 0xc3, 0xb5, 0xf7, 0xa6, 0x52, 0x83, 0xfa, 0xdc, 0xea,
-	0xd1, 0x25, 0xff, 0x82, 0x7a, 0x0f, 0x6a, 0xfd, 0x20, 0xd1, 0x2a, 0x36, 0xff, 0xd6, 0x9a, 0xfd,
-	0x27, 0x41, 0x6e, 0xb5, 0xf6, 0x0c, 0x22, 0x3d, 0xbb, 0x48, 0xa9, 0x9c, 0x1e, 0xc0, 0x78, 0x15,
-	0x67, 0x6c, 0xcd, 0x27, 0xf8, 0x1f, 0xde, 0x35, 0xa8, 0x26, 0xa3, 0xce, 0xf8, 0x6e, 0xc1, 0xb6,
-	0x9c, 0x33, 0x70, 0x66, 0xfc, 0xf4, 0xa1, 0x8c, 0x8d, 0x7c, 0x68, 0x7b, 0xd3, 0x3b, 0x08, 0x3b,
-	0x7c, 0xd6, 0xe6, 0xef, 0xe6, 0xb7, 0xc7, 0xa8, 0xd0, 0x8d, 0x0b, 0xd6, 0x38, 0xe3, 0x9c, 0xdb,
-	0x27, 0xe7, 0x3e, 0x34, 0x73, 0x53, 0x47, 0xfb, 0x39, 0x8a, 0x7c, 0x95, 0x96, 0x28, 0xf1, 0xdb,
-	0xfc, 0xfb, 0xc1, 0x4f, 0x8b, 0x94, 0xf4, 0xed, 0xfe, 0x59, 0x01, 0xea, 0x8f, 0xa5, 0x16, 0xe8,
-	0xf9, 0xf8, 0x63, 0xa8, 0x07, 0xbe, 0x8c, 0x74, 0xa0, 0xcf, 0x6d, 0x40, 0x31, 0x9d, 0xbf, 0xa5,
-	0xa8, 0x9b, 0x36, 0x3d, 0xd9, 0xdc, 0xb7, 0xf8, 0xb6, 0xbd, 0xb7, 0xe4, 0x65, 0x2c, 0x9c, 0x6d,
-	0xa8, 0x59, 0xb0, 0xf3, 0x16, 0xac, 0x4d, 0x61, 0xf2, 0x57, 0x61, 0xc5, 0x46, 0x9a, 0xed, 0xf3,
-	0x41, 0x7a, 0x9b, 0xbf, 0xec, 0x4d, 0x02, 0xb7, 0x1b, 0x50, 0x1b, 0x1a, 0x82, 0xdb, 0xff, 0x5e,
-	0x84, 0xd5, 0x49, 0xcd, 0xa6, 0xba, 0xb2, 0xb1, 0xaa, 0x07, 0xa1, 0x9f, 0x4b, 0xbe, 0x19, 0x5f,
-	0x83, 0xe6, 0xa1, 0x61, 0x44, 0x80, 0x75, 0xec, 0xda, 0x53, 0x03, 0xc9, 0x6e, 0xe4, 0x9f, 0xb8,
-	0xbf, 0x8e, 0x2e, 0xc1, 0x94, 0xea, 0xd9, 0x90, 0x37, 0xec, 0xa3, 0xc0, 0x6f, 0x17, 0xf9, 0x4a,
-	0x2e, 0x05, 0xfc, 0x51, 0x91, 0x6f, 0xc0, 0xda, 0xf6, 0x28, 0xf2, 0x43, 0xe9, 0x67, 0xd0, 0x3f,
-	0xcd, 0x43, 0xb3, 0x64, 0xef, 0xdb, 0x98, 0x5f, 0x36, 0xda, 0xa3, 0x8e, 0x4d, 0xf4, 0x7e, 0xb7,
-	0xcc, 0xaf, 0xc1, 0xba, 0xc5, 0x1a, 0x47, 0x8d, 0xec, 0xf7, 0xca, 0xfc, 0x0a, 0xac, 0x6e, 0x99,
-	0xb5, 0xb5, 0x82, 0xb2, 0xdf, 0x2f, 0xa3, 0x08, 0x74, 0x4d, 0xf9, 0x1d, 0xe2, 0x93, 0x95, 0xb5,
-	0xd8, 0x77, 0xcb, 0x7c, 0x0d, 0xa0, 0x7d, 0x94, 0x0d, 0xf4, 0x87, 0x65, 0xde, 0x84, 0x6a, 0xfb,
-	0x88, 0xb8, 0x7d, 0xbf, 0xcc, 0xaf, 0x02, 0x1b, 0xf7, 0xda, 0xd8, 0xf8, 0x8f, 0x8c, 0x30, 0x59,
-	0xb0, 0xfb, 0x83, 0x32, 0xce, 0x2b, 0xdd, 0x07, 0xf6, 0xc7, 0x65, 0xce, 0x61, 0xe5, 0x71, 0x90,
-	0x24, 0x41, 0xd4, 0xb3, 0xf2, 0x7e, 0xaf, 0x7c, 0xfb, 0xc7, 0x05, 0x58, 0x9d, 0xf4, 0x29, 0x18,
-	0x23, 0x87, 0x2a, 0xea, 0x69, 0xf3, 0x9a, 0x1f, 0x03, 0xe8, 0xbe, 0x8a, 0x35, 0x35, 0xe9, 0x36,
-	0x21, 0xa2, 0x5b, 0x4b, 0x93, 0x74, 0x9b, 0x32, 0xa3, 0x79, 0xf7, 0xa1, 0x45, 0x8f, 0x35, 0xb3,
-	0xd0, 0xbb, 0x9c, 0xa5, 0x07, 0x74, 0x7b, 0x9a, 0xde, 0x4e, 0xb1, 0x2a, 0xa2, 0x8e, 0xe2, 0xd0,
-	0xa4, 0x09, 0x72, 0x20, 0x82, 0xd0, 0x3c, 0xdb, 0x1d, 0xf6, 0x31, 0x9f, 0x6e, 0x18, 0xa8, 0xfa,
-	0x38, 0x30, 0x0f, 0x64, 0xad, 0x07, 0xf7, 0x51, 0x8e, 0x4c, 0xfd, 0x99, 0xbc, 0xfd, 0x9d, 0x02,
-	0x2c, 0xa7, 0x77, 0x86, 0x41, 0x2f, 0x88, 0x4c, 0xa2, 0x91, 0xfe, 0x47, 0xa2, 0x1b, 0x06, 0xc3,
-	0xf4, 0xcd, 0xf1, 0x1a, 0x34, 0xfd, 0x58, 0xf4, 0xb6, 0x22, 0x7f, 0x37, 0x56, 0x43, 0x23, 0x76,
-	0x30, 0x18, 0xaa, 0xd8, 0x26, 0x38, 0xcf, 0x64, 0x07, 0xd1, 0x87, 0x32, 0x66, 0x65, 0x8a, 0x0e,
-	0xfa, 0x22, 0x0e, 0xa2, 0xde, 0x83, 0x33, 0x2d, 0xa3, 0xc4, 0x24, 0x3a, 0x4d, 0xa8, 0x8d, 0x12,
-	0xd9, 0xc5, 0xa4, 0xa1, 0x8a, 0x8d, 0xce, 0x28, 0x08, 0x75, 0x10, 0xb1, 0xda, 0xed, 0xef, 0x15,
-	0xa0, 0x49, 0x4b, 0x6e, 0xcb, 0xb5, 0x13, 0xc5, 0xa1, 0x26, 0xd4, 0x1e, 0x65, 0x8f, 0x40, 0xab,
-	0x50, 0x3c, 0x38, 0x31, 0xa5, 0x00, 0xbb, 0x09, 0xe6, 0x16, 0xc6, 0xbc, 0x07, 0x2d, 0xf3, 0x4f,
-	0xc1, 0x55, 0x4f, 0x0e, 0x94, 0x96, 0x4f, 0x45, 0xa0, 0xf3, 0x65, 0xb6, 0x0a, 0x5f, 0x87, 0x15,
-	0xd3, 0x95, 0xd6, 0xd5, 0xaa, 0x9c, 0xc1, 0x32, 0x0d, 0x9b, 0x42, 0x6a, 0xdb, 0xb7, 0xff, 0xee,
-	0x67, 0xd7, 0x0b, 0x3f, 0xfd, 0xd9, 0xf5, 0xc2, 0xbf, 0xfd, 0xec, 0x7a, 0xe1, 0xfb, 0x3f, 0xbf,
-	0xbe, 0xf4, 0xd3, 0x9f, 0x5f, 0x5f, 0xfa, 0xa7, 0x9f, 0x5f, 0x5f, 0xfa, 0x88, 0x4d, 0xff, 0x29,
-	0xba, 0x53, 0x25, 0x4b, 0x77, 0xef, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xde, 0x25, 0xd3,
-	0x2f, 0x3d, 0x00, 0x00,
+	0x55, 0x70, 0xd7, 0x7f, 0xd5, 0xab, 0xfe, 0x89, 0x8e, 0xe9, 0x9d, 0x2d, 0xe7, 0xae, 0xe7, 0x6b,
+	0x97, 0xd7, 0xe3, 0xf1, 0x78, 0xdd, 0xb3, 0x3b, 0xbb, 0xe3, 0x5d, 0xfb, 0x63, 0x77, 0xdd, 0x3f,
+	0x33, 0xee, 0x66, 0x67, 0xb6, 0xdb, 0x59, 0x3d, 0x3d, 0x78, 0x05, 0x88, 0xa8, 0xca, 0xe8, 0xaa,
+	0xdc, 0xce, 0xca, 0x28, 0x67, 0x46, 0xf5, 0x74, 0x5b, 0x20, 0x19, 0x30, 0xb6, 0x90, 0x38, 0x18,
+	0x0b, 0x6e, 0x20, 0x99, 0x1b, 0x07, 0x38, 0x21, 0x0b, 0x90, 0x7c, 0x46, 0x48, 0x1c, 0xec, 0x1b,
+	0x48, 0x48, 0x80, 0x6c, 0x4e, 0x70, 0x40, 0x42, 0xe2, 0xc6, 0x01, 0xbd, 0x17, 0x91, 0x3f, 0x55,
+	0xd5, 0xd3, 0x5d, 0xb3, 0xf6, 0xa9, 0x32, 0x5e, 0xbc, 0xf7, 0xe2, 0xef, 0xc5, 0x8b, 0xf7, 0x57,
+	0xf0, 0xca, 0xe8, 0xa4, 0x7f, 0x27, 0xf0, 0xbb, 0x77, 0x46, 0xdd, 0x3b, 0x43, 0xe5, 0xc9, 0xe0,
+	0xce, 0x28, 0x52, 0x5a, 0xc5, 0xa6, 0x11, 0x6f, 0x50, 0x8b, 0x2f, 0x89, 0xf0, 0x5c, 0x9f, 0x8f,
+	0xe4, 0x06, 0x41, 0x9d, 0x97, 0xfb, 0x4a, 0xf5, 0x03, 0x69, 0x50, 0xbb, 0xe3, 0xe3, 0x3b, 0xb1,
+	0x8e, 0xc6, 0x3d, 0x6d, 0x90, 0xdb, 0xff, 0x58, 0x82, 0xeb, 0x9d, 0xa1, 0x88, 0xf4, 0x56, 0xa0,
+	0x7a, 0x27, 0x9d, 0x50, 0x8c, 0xe2, 0x81, 0xd2, 0x5b, 0x22, 0x96, 0xfc, 0x55, 0xa8, 0x76, 0x11,
+	0x18, 0xb7, 0x0a, 0xeb, 0xa5, 0x5b, 0xcd, 0xbb, 0x6b, 0x1b, 0x13, 0x8c, 0x37, 0x88, 0xc2, 0xb5,
+	0x38, 0xfc, 0x75, 0xa8, 0x79, 0x52, 0x0b, 0x3f, 0x88, 0x5b, 0xc5, 0xf5, 0xc2, 0xad, 0xe6, 0xdd,
+	0x17, 0x37, 0xcc, 0xc0, 0x1b, 0xc9, 0xc0, 0x1b, 0x1d, 0x1a, 0xd8, 0x4d, 0xf0, 0xf8, 0x1b, 0x50,
+	0x3f, 0xf6, 0x03, 0xf9, 0xbe, 0x3c, 0x8f, 0x5b, 0xa5, 0xcb, 0x69, 0x52, 0x44, 0xfe, 0x1e, 0x2c,
+	0xcb, 0x33, 0x1d, 0x09, 0x57, 0x06, 0x42, 0xfb, 0x2a, 0x8c, 0x5b, 0x65, 0x9a, 0xdd, 0x8b, 0x53,
+	0xb3, 0x4b, 0xfa, 0xdd, 0x29, 0x74, 0xbe, 0x0e, 0x4d, 0xd5, 0xfd, 0x48, 0xf6, 0xf4, 0xe1, 0xf9,
+	0x48, 0xc6, 0xad, 0xca, 0x7a, 0xe9, 0x56, 0xc3, 0xcd, 0x83, 0xf8, 0x97, 0xa0, 0xd9, 0x53, 0x41,
+	0x20, 0x7b, 0x86, 0x7f, 0xf5, 0xf2, 0xa9, 0xe5, 0x71, 0xf9, 0x9b, 0xf0, 0x42, 0x24, 0x87, 0xea,
+	0x54, 0x7a, 0xdb, 0x29, 0x94, 0xd6, 0x57, 0xa7, 0x61, 0x2e, 0xee, 0xe4, 0x9b, 0xb0, 0x14, 0xd9,
+	0xf9, 0x3d, 0xf4, 0xc3, 0x93, 0xb8, 0x55, 0xa3, 0x25, 0xbd, 0xf4, 0x8c, 0x25, 0x21, 0x8e, 0x3b,
+	0x49, 0xc1, 0x19, 0x94, 0x4e, 0xe4, 0x79, 0xab, 0xb1, 0x5e, 0xb8, 0xd5, 0x70, 0xf1, 0xb3, 0xfd,
+	0xa3, 0x1d, 0xa8, 0xd0, 0x11, 0xf1, 0x65, 0x28, 0xfa, 0x5e, 0xab, 0x40, 0x5d, 0x45, 0xdf, 0xe3,
+	0x77, 0xa0, 0x7a, 0xec, 0xcb, 0xc0, 0xbb, 0xf2, 0xa4, 0x2c, 0x1a, 0xbf, 0x0f, 0x8b, 0x91, 0x8c,
+	0x75, 0xe4, 0xdb, 0x1d, 0x31, 0x87, 0xf5, 0xa9, 0x8b, 0xe4, 0x61, 0xc3, 0xcd, 0x21, 0xba, 0x13,
+	0x64, 0xb8, 0xf3, 0xbd, 0x81, 0x1f, 0x78, 0x91, 0x0c, 0xf7, 0x3c, 0x73, 0x6e, 0x0d, 0x37, 0x0f,
+	0xe2, 0xb7, 0x60, 0xa5, 0x2b, 0x7a, 0x27, 0xfd, 0x48, 0x8d, 0x43, 0xdc, 0x24, 0x15, 0xb5, 0x2a,
+	0x34, 0xed, 0x69, 0x30, 0x7f, 0x0d, 0x2a, 0x22, 0xf0, 0xfb, 0x21, 0x9d, 0xce, 0xf2, 0x5d, 0xe7,
+	0xc2, 0xb9, 0x6c, 0x22, 0x86, 0x6b, 0x10, 0xf9, 0x2e, 0x2c, 0x9d, 0xca, 0x48, 0xfb, 0x3d, 0x11,
+	0x10, 0xbc, 0x55, 0x23, 0xca, 0xf6, 0x85, 0x94, 0x47, 0x79, 0x4c, 0x77, 0x92, 0x90, 0xef, 0x01,
+	0xc4, 0x78, 0x65, 0x48, 0xf2, 0x5b, 0x4d, 0xda, 0x8c, 0xcf, 0x5e, 0xc8, 0x66, 0x5b, 0x85, 0x5a,
+	0x86, 0x7a, 0xa3, 0x93, 0xa2, 0xef, 0x2e, 0xb8, 0x39, 0x62, 0xfe, 0x16, 0x94, 0xb5, 0x3c, 0xd3,
+	0xad, 0xe5, 0x4b, 0x76, 0x34, 0x61, 0x72, 0x28, 0xcf, 0xf4, 0xee, 0x82, 0x4b, 0x04, 0x48, 0x88,
+	0x57, 0xa2, 0xb5, 0x32, 0x07, 0xe1, 0x03, 0x3f, 0x90, 0x48, 0x88, 0x04, 0xfc, 0x1d, 0xa8, 0x06,
+	0xe2, 0x5c, 0x8d, 0x75, 0x8b, 0x11, 0xe9, 0xa7, 0x2f, 0x25, 0x7d, 0x48, 0xa8, 0xbb, 0x0b, 0xae,
+	0x25, 0xe2, 0x6f, 0x42, 0xc9, 0xf3, 0x4f, 0x5b, 0xab, 0x44, 0xbb, 0x7e, 0x29, 0xed, 0x8e, 0x7f,
+	0xba, 0xbb, 0xe0, 0x22, 0x3a, 0xdf, 0x86, 0x7a, 0x57, 0xa9, 0x93, 0xa1, 0x88, 0x4e, 0x5a, 0x9c,
+	0x48, 0x3f, 0x73, 0x29, 0xe9, 0x96, 0x45, 0xde, 0x5d, 0x70, 0x53, 0x42, 0x5c, 0xb2, 0xdf, 0x53,
+	0x61, 0xeb, 0xda, 0x1c, 0x4b, 0xde, 0xeb, 0xa9, 0x10, 0x97, 0x8c, 0x04, 0x48, 0x18, 0xf8, 0xe1,
+	0x49, 0x6b, 0x6d, 0x0e, 0x42, 0xbc, 0x4d, 0x48, 0x88, 0x04, 0x38, 0x6d, 0x4f, 0x68, 0x71, 0xea,
+	0xcb, 0xa7, 0xad, 0x17, 0xe6, 0x98, 0xf6, 0x8e, 0x45, 0xc6, 0x69, 0x27, 0x84, 0xc8, 0x24, 0xb9,
+	0xaa, 0xad, 0xeb, 0x73, 0x30, 0x49, 0x6e, 0x39, 0x32, 0x49, 0x08, 0xf9, 0xaf, 0xc3, 0xea, 0xb1,
+	0x14, 0x7a, 0x1c, 0x49, 0x2f, 0x53, 0x7c, 0x2f, 0x12, 0xb7, 0x8d, 0xcb, 0xcf, 0x7e, 0x9a, 0x6a,
+	0x77, 0xc1, 0x9d, 0x65, 0xc5, 0xbf, 0x0c, 0x95, 0x40, 0x68, 0x79, 0xd6, 0x6a, 0x11, 0xcf, 0xf6,
+	0x15, 0x42, 0xa1, 0xe5, 0xd9, 0xee, 0x82, 0x6b, 0x48, 0xf8, 0xaf, 0xc0, 0x8a, 0x16, 0xdd, 0x40,
+	0xee, 0x1f, 0x5b, 0x84, 0xb8, 0xf5, 0x09, 0xe2, 0xf2, 0xea, 0xe5, 0xe2, 0x3c, 0x49, 0xb3, 0xbb,
+	0xe0, 0x4e, 0xb3, 0xc1, 0x59, 0x11, 0xa8, 0xe5, 0xcc, 0x31, 0x2b, 0xe2, 0x87, 0xb3, 0x22, 0x12,
+	0xfe, 0x10, 0x9a, 0xf4, 0xb1, 0xad, 0x82, 0xf1, 0x30, 0x6c, 0xbd, 0x44, 0x1c, 0x6e, 0x5d, 0xcd,
+	0xc1, 0xe0, 0xef, 0x2e, 0xb8, 0x79, 0x72, 0x3c, 0x44, 0x6a, 0xba, 0xea, 0x69, 0xeb, 0xe5, 0x39,
+	0x0e, 0xf1, 0xd0, 0x22, 0xe3, 0x21, 0x26, 0x84, 0x78, 0xf5, 0x9e, 0xfa, 0x5e, 0x5f, 0xea, 0xd6,
+	0x27, 0xe7, 0xb8, 0x7a, 0x4f, 0x08, 0x15, 0xaf, 0x9e, 0x21, 0x72, 0xbe, 0x09, 0x8b, 0x79, 0xe5,
+	0xca, 0x39, 0x94, 0x23, 0x29, 0x8c, 0x62, 0xaf, 0xbb, 0xf4, 0x8d, 0x30, 0xe9, 0xf9, 0x9a, 0x14,
+	0x7b, 0xdd, 0xa5, 0x6f, 0x7e, 0x1d, 0xaa, 0xe6, 0xd9, 0x21, 0xbd, 0x5d, 0x77, 0x6d, 0x0b, 0x71,
+	0xbd, 0x48, 0xf4, 0x5b, 0x65, 0x83, 0x8b, 0xdf, 0x88, 0xeb, 0x45, 0x6a, 0xb4, 0x1f, 0x92, 0xde,
+	0xad, 0xbb, 0xb6, 0xe5, 0xfc, 0xc7, 0x3d, 0xa8, 0xd9, 0x89, 0x39, 0x7f, 0x5a, 0x80, 0xaa, 0xd1,
+	0x0b, 0xfc, 0x3d, 0xa8, 0xc4, 0xfa, 0x3c, 0x90, 0x34, 0x87, 0xe5, 0xbb, 0x9f, 0x9b, 0x43, 0x97,
+	0x6c, 0x74, 0x90, 0xc0, 0x35, 0x74, 0x6d, 0x17, 0x2a, 0xd4, 0xe6, 0x35, 0x28, 0xb9, 0xea, 0x29,
+	0x5b, 0xe0, 0x00, 0x55, 0xb3, 0xe7, 0xac, 0x80, 0xc0, 0x1d, 0xff, 0x94, 0x15, 0x11, 0xb8, 0x2b,
+	0x85, 0x27, 0x23, 0x56, 0xe2, 0x4b, 0xd0, 0x48, 0x76, 0x37, 0x66, 0x65, 0xce, 0x60, 0x31, 0x77,
+	0x6e, 0x31, 0xab, 0x38, 0xff, 0x5d, 0x86, 0x32, 0x5e, 0x63, 0xfe, 0x0a, 0x2c, 0x69, 0x11, 0xf5,
+	0xa5, 0xb1, 0x6d, 0xf6, 0x92, 0x27, 0x70, 0x12, 0xc8, 0xdf, 0x49, 0xd6, 0x50, 0xa4, 0x35, 0x7c,
+	0xf6, 0x4a, 0xf5, 0x30, 0xb1, 0x82, 0xdc, 0x63, 0x5a, 0x9a, 0xef, 0x31, 0x7d, 0x00, 0x75, 0xd4,
+	0x4a, 0x1d, 0xff, 0x9b, 0x92, 0xb6, 0x7e, 0xf9, 0xee, 0xed, 0xab, 0x87, 0xdc, 0xb3, 0x14, 0x6e,
+	0x4a, 0xcb, 0xf7, 0xa0, 0xd1, 0x13, 0x91, 0x47, 0x93, 0xa1, 0xd3, 0x5a, 0xbe, 0xfb, 0xf9, 0xab,
+	0x19, 0x6d, 0x27, 0x24, 0x6e, 0x46, 0xcd, 0xf7, 0xa1, 0xe9, 0xc9, 0xb8, 0x17, 0xf9, 0x23, 0xd2,
+	0x52, 0xe6, 0x49, 0xfd, 0xc2, 0xd5, 0xcc, 0x76, 0x32, 0x22, 0x37, 0xcf, 0x81, 0xbf, 0x0c, 0x8d,
+	0x28, 0x55, 0x53, 0x35, 0x7a, 0xe7, 0x33, 0x40, 0xfb, 0x2d, 0xa8, 0x27, 0xeb, 0xe1, 0x8b, 0x50,
+	0xc7, 0xdf, 0x0f, 0x54, 0x28, 0xd9, 0x02, 0x9e, 0x2d, 0xb6, 0x3a, 0x43, 0x11, 0x04, 0xac, 0xc0,
+	0x97, 0x01, 0xb0, 0xf9, 0x48, 0x7a, 0xfe, 0x78, 0xc8, 0x8a, 0xed, 0xff, 0x9f, 0x48, 0x4b, 0x1d,
+	0xca, 0x07, 0xa2, 0x8f, 0x14, 0x8b, 0x50, 0x4f, 0xb4, 0x2e, 0x2b, 0x20, 0xfd, 0x8e, 0x88, 0x07,
+	0x5d, 0x25, 0x22, 0x8f, 0x15, 0x79, 0x13, 0x6a, 0x9b, 0x51, 0x6f, 0xe0, 0x9f, 0x4a, 0x56, 0x6a,
+	0xdf, 0x81, 0x66, 0x6e, 0xbe, 0xc8, 0xc2, 0x0e, 0xda, 0x80, 0xca, 0xa6, 0xe7, 0x49, 0x8f, 0x15,
+	0x90, 0xc0, 0x2e, 0x90, 0x15, 0xdb, 0x9f, 0x87, 0x46, 0xba, 0x5b, 0x88, 0x8e, 0xef, 0x2f, 0x5b,
+	0xc0, 0x2f, 0x04, 0xb3, 0x02, 0x4a, 0xe5, 0x5e, 0x18, 0xf8, 0xa1, 0x64, 0x45, 0xe7, 0x37, 0x48,
+	0x54, 0xf9, 0x2f, 0x4d, 0x5e, 0x88, 0x9b, 0x57, 0x3d, 0x90, 0x93, 0xb7, 0xe1, 0xa5, 0xdc, 0xfa,
+	0x1e, 0xfa, 0x34, 0xb9, 0x3a, 0x94, 0x77, 0x94, 0x8e, 0x59, 0xc1, 0xf9, 0xcf, 0x22, 0xd4, 0x93,
+	0x77, 0x11, 0xcd, 0xbd, 0x71, 0x14, 0x58, 0x81, 0xc6, 0x4f, 0xbe, 0x06, 0x15, 0xed, 0x6b, 0x2b,
+	0xc6, 0x0d, 0xd7, 0x34, 0xd0, 0xe4, 0xca, 0x9f, 0x6c, 0x89, 0xfa, 0xa6, 0x8f, 0xca, 0x1f, 0x8a,
+	0xbe, 0xdc, 0x15, 0xf1, 0x80, 0xe4, 0xb1, 0xe1, 0x66, 0x00, 0xa4, 0x3f, 0x16, 0xa7, 0x28, 0x73,
+	0xd4, 0x6f, 0x8c, 0xb1, 0x3c, 0x88, 0xbf, 0x01, 0x65, 0x5c, 0xa0, 0x15, 0x9a, 0xff, 0x37, 0xb5,
+	0x60, 0x14, 0x93, 0x83, 0x48, 0xe2, 0xf1, 0x6c, 0xa0, 0x71, 0xed, 0x12, 0x32, 0xbf, 0x09, 0xcb,
+	0xe6, 0x12, 0xee, 0x93, 0xd9, 0xbd, 0xe7, 0x91, 0x31, 0xd6, 0x70, 0xa7, 0xa0, 0x7c, 0x13, 0xb7,
+	0x53, 0x68, 0xd9, 0xaa, 0xcf, 0x21, 0xdf, 0xc9, 0xe6, 0x6c, 0x74, 0x90, 0xc4, 0x35, 0x94, 0xed,
+	0x7b, 0xb8, 0xa7, 0x42, 0x4b, 0x3c, 0xe6, 0xfb, 0xc3, 0x91, 0x3e, 0x37, 0x42, 0xf3, 0x40, 0xea,
+	0xde, 0xc0, 0x0f, 0xfb, 0xac, 0x60, 0xb6, 0x18, 0x0f, 0x91, 0x50, 0xa2, 0x48, 0x45, 0xac, 0xe4,
+	0x38, 0x50, 0x46, 0x19, 0x45, 0x25, 0x19, 0x8a, 0xa1, 0xb4, 0x3b, 0x4d, 0xdf, 0xce, 0x35, 0x58,
+	0x9d, 0x79, 0x56, 0x9d, 0xbf, 0xad, 0x1a, 0x09, 0x41, 0x0a, 0x32, 0xe9, 0x2c, 0x05, 0x7e, 0x3f,
+	0x9f, 0x8e, 0x41, 0x2e, 0x93, 0x3a, 0xe6, 0x1d, 0xa8, 0xe0, 0xc2, 0x12, 0x15, 0x33, 0x07, 0xf9,
+	0x23, 0x44, 0x77, 0x0d, 0x15, 0x6f, 0x41, 0xad, 0x37, 0x90, 0xbd, 0x13, 0xe9, 0x59, 0x5d, 0x9f,
+	0x34, 0x51, 0x68, 0x7a, 0x39, 0x2b, 0xdb, 0x34, 0x48, 0x24, 0x7a, 0x2a, 0xbc, 0x3f, 0x54, 0x1f,
+	0xf9, 0xad, 0xaa, 0x15, 0x89, 0x04, 0x90, 0xf4, 0xee, 0xa1, 0x8c, 0xd8, 0x63, 0xcb, 0x00, 0xce,
+	0x7d, 0xa8, 0xd0, 0xd8, 0x78, 0x13, 0xcc, 0x9c, 0x8d, 0xf3, 0x78, 0x73, 0xbe, 0x39, 0xdb, 0x29,
+	0x3b, 0x7f, 0x51, 0x84, 0x32, 0xb6, 0xf9, 0x6d, 0xa8, 0x44, 0x22, 0xec, 0x9b, 0x03, 0x98, 0xf5,
+	0x41, 0x5d, 0xec, 0x73, 0x0d, 0x0a, 0x7f, 0xcf, 0x8a, 0x62, 0x71, 0x0e, 0x61, 0x49, 0x47, 0xcc,
+	0x8b, 0xe5, 0x1a, 0x54, 0x46, 0x22, 0x12, 0x43, 0x7b, 0x4f, 0x4c, 0xa3, 0xfd, 0x83, 0x02, 0x94,
+	0x11, 0x89, 0xaf, 0xc2, 0x52, 0x47, 0x47, 0xfe, 0x89, 0xd4, 0x83, 0x48, 0x8d, 0xfb, 0x03, 0x23,
+	0x49, 0xef, 0xcb, 0xf3, 0xae, 0xca, 0x14, 0x82, 0x16, 0x81, 0xdf, 0x63, 0x45, 0x94, 0xaa, 0x2d,
+	0x15, 0x78, 0xac, 0xc4, 0x57, 0xa0, 0xf9, 0x38, 0xf4, 0x64, 0x14, 0xf7, 0x54, 0x24, 0x3d, 0x56,
+	0xb6, 0xb7, 0xfb, 0x84, 0x55, 0xe8, 0x2d, 0x93, 0x67, 0x9a, 0x5c, 0x1a, 0x56, 0xe5, 0xd7, 0x60,
+	0x65, 0x6b, 0xd2, 0xcf, 0x61, 0x35, 0xd4, 0x49, 0x8f, 0x64, 0x88, 0x42, 0xc6, 0xea, 0x46, 0x88,
+	0xd5, 0x47, 0x3e, 0x6b, 0xe0, 0x60, 0xe6, 0x9e, 0x30, 0x68, 0xff, 0xa8, 0x90, 0x68, 0x8e, 0x25,
+	0x68, 0x1c, 0x88, 0x48, 0xf4, 0x23, 0x31, 0xc2, 0xf9, 0x35, 0xa1, 0x66, 0x1e, 0xce, 0xd7, 0x59,
+	0x21, 0x6b, 0xdc, 0x65, 0xc5, 0xac, 0xf1, 0x06, 0x2b, 0x65, 0x8d, 0x37, 0x59, 0x19, 0xc7, 0xf8,
+	0xda, 0x58, 0x69, 0xc9, 0x2a, 0xa4, 0xeb, 0x94, 0x27, 0x59, 0x15, 0x81, 0x87, 0xa8, 0x51, 0x58,
+	0x0d, 0xd7, 0xbc, 0x8d, 0xf2, 0xd3, 0x55, 0x67, 0xac, 0x8e, 0xd3, 0xc0, 0x6d, 0x94, 0x1e, 0x6b,
+	0x60, 0xcf, 0x07, 0xe3, 0x61, 0x57, 0xe2, 0x32, 0x01, 0x7b, 0x0e, 0x55, 0xbf, 0x1f, 0x48, 0xd6,
+	0xe4, 0x2b, 0x13, 0xca, 0x97, 0x2d, 0x92, 0xa6, 0x15, 0x41, 0xa0, 0xc6, 0x9a, 0x2d, 0x39, 0x3f,
+	0x29, 0x41, 0x19, 0x9d, 0x14, 0xbc, 0x3b, 0x03, 0xd4, 0x33, 0xf6, 0xee, 0xe0, 0x77, 0x7a, 0x03,
+	0x8b, 0xd9, 0x0d, 0xe4, 0x5f, 0xb6, 0x27, 0x5d, 0x9a, 0x43, 0xcb, 0x22, 0xe3, 0xfc, 0x21, 0x73,
+	0x28, 0x0f, 0xfd, 0xa1, 0xb4, 0xba, 0x8e, 0xbe, 0x11, 0x16, 0xe3, 0x7b, 0x8c, 0xd7, 0xa0, 0xe4,
+	0xd2, 0x37, 0xde, 0x1a, 0x81, 0xcf, 0xc2, 0xa6, 0xa6, 0x3b, 0x50, 0x72, 0x93, 0x26, 0x7f, 0x27,
+	0xd1, 0x4a, 0xb5, 0x39, 0x6e, 0x33, 0x0d, 0x9f, 0xd7, 0x48, 0x99, 0x32, 0xa8, 0xcf, 0x4f, 0x9e,
+	0x7b, 0x24, 0x76, 0xac, 0x34, 0x66, 0x0f, 0x58, 0xdd, 0xec, 0x1e, 0x2b, 0xe0, 0x29, 0xd1, 0x35,
+	0x34, 0xba, 0xec, 0xc8, 0xf7, 0xa4, 0x62, 0x25, 0x7a, 0xe0, 0xc6, 0x9e, 0xaf, 0x58, 0x19, 0x2d,
+	0xaa, 0x83, 0x9d, 0x07, 0xac, 0xd2, 0xbe, 0x99, 0x7b, 0x6a, 0x36, 0xc7, 0x5a, 0xb1, 0x85, 0x54,
+	0x2c, 0x0b, 0x46, 0xca, 0xba, 0xd2, 0x63, 0xc5, 0xf6, 0x17, 0x2f, 0x50, 0x9f, 0x4b, 0xd0, 0x78,
+	0x3c, 0x0a, 0x94, 0xf0, 0x2e, 0xd1, 0x9f, 0x8b, 0x00, 0x99, 0xd3, 0xeb, 0xfc, 0xcf, 0x27, 0xb3,
+	0x67, 0x1a, 0x6d, 0xcc, 0x58, 0x8d, 0xa3, 0x9e, 0x24, 0xd5, 0xd0, 0x70, 0x6d, 0x8b, 0x7f, 0x05,
+	0x2a, 0xd8, 0x8f, 0x51, 0x09, 0xd4, 0x18, 0xb7, 0xe7, 0x72, 0xb5, 0x36, 0x8e, 0x7c, 0xf9, 0xd4,
+	0x35, 0x84, 0xfc, 0x5e, 0xde, 0xec, 0xb8, 0x22, 0x2c, 0x94, 0x61, 0xf2, 0x1b, 0x00, 0xa2, 0xa7,
+	0xfd, 0x53, 0x89, 0xbc, 0xec, 0xdd, 0xcf, 0x41, 0xb8, 0x0b, 0x4d, 0xbc, 0x92, 0xa3, 0xfd, 0x08,
+	0x6f, 0x71, 0x6b, 0x91, 0x18, 0xbf, 0x36, 0xdf, 0xf4, 0xbe, 0x9a, 0x12, 0xba, 0x79, 0x26, 0xfc,
+	0x31, 0x2c, 0x9a, 0x90, 0x93, 0x65, 0xba, 0x44, 0x4c, 0x5f, 0x9f, 0x8f, 0xe9, 0x7e, 0x46, 0xe9,
+	0x4e, 0xb0, 0x99, 0x8d, 0x24, 0x55, 0x9e, 0x3b, 0x92, 0x74, 0x13, 0x96, 0x0f, 0x27, 0xdf, 0x66,
+	0xf3, 0x04, 0x4c, 0x41, 0x79, 0x1b, 0x16, 0xfd, 0x38, 0x0b, 0x64, 0x51, 0x08, 0xa3, 0xee, 0x4e,
+	0xc0, 0x9c, 0xbf, 0xab, 0x42, 0x99, 0xb6, 0x70, 0x3a, 0x04, 0xb5, 0x3d, 0xa1, 0xaa, 0xef, 0xcc,
+	0x7f, 0xd4, 0x53, 0x37, 0x99, 0x34, 0x43, 0x29, 0xa7, 0x19, 0xbe, 0x02, 0x95, 0x58, 0x45, 0x3a,
+	0x39, 0xfe, 0x39, 0x85, 0xa8, 0xa3, 0x22, 0xed, 0x1a, 0x42, 0xfe, 0x00, 0x6a, 0xc7, 0x7e, 0xa0,
+	0x65, 0x94, 0x6c, 0xde, 0xab, 0xf3, 0xf1, 0x78, 0x40, 0x44, 0x6e, 0x42, 0xcc, 0x1f, 0xe6, 0x85,
+	0xb1, 0xba, 0x5e, 0xba, 0xd2, 0x55, 0x4f, 0x39, 0x5d, 0x24, 0xa3, 0xb7, 0x81, 0xf5, 0xd4, 0xa9,
+	0x8c, 0x92, 0xbe, 0xf7, 0xe5, 0xb9, 0x7d, 0x7c, 0x67, 0xe0, 0xdc, 0x81, 0xfa, 0xc0, 0xf7, 0x24,
+	0xda, 0x2f, 0xa4, 0x63, 0xea, 0x6e, 0xda, 0xe6, 0xef, 0x43, 0x9d, 0xec, 0x7e, 0xd4, 0x76, 0x8d,
+	0xe7, 0xde, 0x7c, 0xe3, 0x82, 0x24, 0x0c, 0x70, 0x20, 0x1a, 0xfc, 0x81, 0xaf, 0x5b, 0x60, 0x06,
+	0x4a, 0xda, 0x38, 0x61, 0x92, 0xf7, 0xfc, 0x84, 0x9b, 0x66, 0xc2, 0xd3, 0x70, 0x8c, 0x9a, 0x12,
+	0x6c, 0xea, 0xf1, 0xc3, 0xab, 0x86, 0x4c, 0x2f, 0xee, 0x44, 0x43, 0x64, 0x24, 0xfa, 0xf2, 0xa1,
+	0x3f, 0xf4, 0x75, 0x6b, 0x69, 0xbd, 0x70, 0xab, 0xe2, 0x66, 0x00, 0xfe, 0x2a, 0xac, 0x7a, 0xf2,
+	0x58, 0x8c, 0x03, 0x7d, 0x28, 0x87, 0xa3, 0x40, 0x68, 0xb9, 0xe7, 0x91, 0x8c, 0x36, 0xdc, 0xd9,
+	0x0e, 0xfe, 0x1a, 0x5c, 0xb3, 0xc0, 0xfd, 0x34, 0x10, 0xbc, 0xe7, 0x51, 0x74, 0xad, 0xe1, 0x5e,
+	0xd4, 0xd5, 0xde, 0xb2, 0x6a, 0x18, 0x1f, 0x46, 0xf4, 0x3f, 0x13, 0x05, 0x1a, 0x6b, 0xf3, 0xd2,
+	0x7e, 0x55, 0x04, 0x81, 0x8c, 0xce, 0x8d, 0xf3, 0xfa, 0xbe, 0x08, 0xbb, 0x22, 0x64, 0x25, 0x7a,
+	0x3b, 0x45, 0x20, 0x43, 0x4f, 0x44, 0xac, 0xdc, 0xbe, 0x05, 0x65, 0xda, 0xc7, 0x06, 0x54, 0x8c,
+	0xcb, 0x43, 0xee, 0xaf, 0x75, 0x77, 0x48, 0x0d, 0x3f, 0xc4, 0x3b, 0xc7, 0x8a, 0xce, 0xdf, 0x94,
+	0xa0, 0x9e, 0xec, 0x58, 0x12, 0xeb, 0x2d, 0xa4, 0xb1, 0x5e, 0xb2, 0xc9, 0xe2, 0x23, 0x3f, 0xf6,
+	0xbb, 0xd6, 0xc6, 0xac, 0xbb, 0x19, 0x00, 0xcd, 0x9a, 0xa7, 0xbe, 0xa7, 0x07, 0x74, 0x51, 0x2a,
+	0xae, 0x69, 0x60, 0xac, 0xd5, 0xc3, 0xc5, 0x87, 0xbd, 0x60, 0xec, 0xc9, 0x43, 0x7f, 0x68, 0x9e,
+	0xbf, 0xba, 0x3b, 0x0d, 0xe6, 0x5f, 0x07, 0xd0, 0xfe, 0x50, 0x3e, 0x50, 0xd1, 0x50, 0x68, 0x6b,
+	0xe8, 0x7f, 0xe9, 0xf9, 0x44, 0x79, 0xe3, 0x30, 0x65, 0xe0, 0xe6, 0x98, 0x21, 0x6b, 0x1c, 0xcd,
+	0xb2, 0xae, 0x7d, 0x2c, 0xd6, 0x3b, 0x29, 0x03, 0x37, 0xc7, 0xac, 0xfd, 0xab, 0x00, 0x59, 0x0f,
+	0xbf, 0x0e, 0xfc, 0x91, 0x0a, 0xf5, 0x60, 0xb3, 0xdb, 0x8d, 0xb6, 0xe4, 0xb1, 0x8a, 0xe4, 0x8e,
+	0xc0, 0xb7, 0xec, 0x05, 0x58, 0x4d, 0xe1, 0x9b, 0xc7, 0x5a, 0x46, 0x08, 0xa6, 0xad, 0xef, 0x0c,
+	0x54, 0xa4, 0x8d, 0xa1, 0x44, 0x9f, 0x8f, 0x3b, 0xac, 0x84, 0xef, 0xe7, 0x5e, 0x67, 0x9f, 0x8e,
+	0x0e, 0xb2, 0x25, 0x91, 0x43, 0x41, 0x5f, 0xaf, 0xdf, 0x65, 0x0b, 0x59, 0xeb, 0xee, 0x9b, 0xac,
+	0xe0, 0xfc, 0x75, 0x11, 0xca, 0xa8, 0x5f, 0xac, 0x0e, 0xac, 0xa6, 0x3a, 0x70, 0x1d, 0x9a, 0xf9,
+	0xcb, 0x61, 0x8e, 0x33, 0x0f, 0xfa, 0x78, 0x5a, 0x12, 0xc7, 0xca, 0x6b, 0xc9, 0xb7, 0xa1, 0xd9,
+	0x1b, 0xc7, 0x5a, 0x0d, 0xe9, 0x89, 0x68, 0x95, 0x48, 0x13, 0x5d, 0x9f, 0x89, 0x52, 0x1c, 0x89,
+	0x60, 0x2c, 0xdd, 0x3c, 0x2a, 0xbf, 0x07, 0xd5, 0x63, 0x73, 0x30, 0x26, 0x4e, 0xf1, 0xc9, 0x67,
+	0xbc, 0x22, 0x76, 0xf3, 0x2d, 0x32, 0xae, 0xcb, 0x9f, 0x11, 0xaa, 0x3c, 0xa8, 0xfd, 0x19, 0x7b,
+	0x77, 0x6a, 0x50, 0xda, 0x8c, 0x7b, 0xd6, 0xcb, 0x95, 0x71, 0xcf, 0x98, 0xd0, 0xdb, 0x34, 0x05,
+	0x56, 0x74, 0x7e, 0x5c, 0x83, 0xaa, 0xd1, 0xaa, 0x76, 0xef, 0x1a, 0xe9, 0xde, 0x7d, 0x0d, 0xea,
+	0x6a, 0x24, 0x23, 0xa1, 0x55, 0x64, 0x5d, 0xed, 0x7b, 0xcf, 0xa3, 0xa5, 0x37, 0xf6, 0x2d, 0xb1,
+	0x9b, 0xb2, 0x99, 0x3e, 0x8e, 0xe2, 0xec, 0x71, 0xdc, 0x06, 0x96, 0x28, 0xe4, 0x83, 0x08, 0xe9,
+	0xf4, 0xb9, 0x75, 0x9c, 0x66, 0xe0, 0xfc, 0x10, 0x1a, 0x3d, 0x15, 0x7a, 0x7e, 0xea, 0x76, 0x2f,
+	0xdf, 0xfd, 0xe2, 0x73, 0xcd, 0x70, 0x3b, 0xa1, 0x76, 0x33, 0x46, 0xfc, 0x55, 0xa8, 0x9c, 0xe2,
+	0x39, 0xd1, 0x81, 0x3c, 0xfb, 0x14, 0x0d, 0x12, 0xff, 0x10, 0x9a, 0xdf, 0x18, 0xfb, 0xbd, 0x93,
+	0xfd, 0x7c, 0x58, 0xe7, 0xed, 0xe7, 0x9a, 0xc5, 0xd7, 0x32, 0x7a, 0x37, 0xcf, 0x2c, 0x27, 0x1b,
+	0xb5, 0x9f, 0x43, 0x36, 0xea, 0xb3, 0xb2, 0xf1, 0x12, 0xd4, 0x93, 0xc3, 0x21, 0xf9, 0x08, 0x3d,
+	0xb6, 0xc0, 0xab, 0x50, 0xdc, 0x8f, 0x58, 0xa1, 0xfd, 0x5f, 0x05, 0x68, 0xa4, 0x1b, 0x33, 0x19,
+	0xc2, 0xb9, 0xff, 0x8d, 0xb1, 0xc0, 0x98, 0x11, 0xfa, 0x20, 0x4a, 0x9b, 0x16, 0x5d, 0xde, 0xaf,
+	0x46, 0x52, 0x68, 0x8a, 0x1c, 0xa2, 0x7e, 0x96, 0x31, 0x06, 0x0d, 0x39, 0x2c, 0x5b, 0xf0, 0x7e,
+	0x64, 0x50, 0x2b, 0xe8, 0xa2, 0x60, 0x6f, 0x02, 0xa8, 0x12, 0xba, 0x7f, 0x22, 0x8d, 0x0b, 0xf6,
+	0x81, 0xd2, 0xd4, 0xa8, 0xe3, 0x5c, 0xf6, 0x42, 0xd6, 0xc0, 0x31, 0x3f, 0x50, 0x7a, 0x2f, 0x64,
+	0x90, 0xd9, 0xc6, 0xcd, 0x64, 0x78, 0x6a, 0x2d, 0x92, 0xe5, 0x1d, 0x04, 0x7b, 0x21, 0x5b, 0xb2,
+	0x1d, 0xa6, 0xb5, 0x8c, 0x1c, 0xef, 0x9f, 0x89, 0x1e, 0x92, 0xaf, 0x60, 0x98, 0x0b, 0x69, 0x6c,
+	0x9b, 0xe1, 0x1d, 0xb8, 0x7f, 0xe6, 0xc7, 0x3a, 0x66, 0xab, 0xed, 0x7f, 0x28, 0x40, 0x33, 0x77,
+	0x08, 0x68, 0x7b, 0x13, 0x22, 0xaa, 0x36, 0x63, 0x8a, 0x7f, 0x5d, 0xc6, 0x5a, 0x46, 0x5e, 0xa2,
+	0xb6, 0x0e, 0x15, 0x7e, 0x16, 0x71, 0xbc, 0x43, 0x35, 0x54, 0x51, 0xa4, 0x9e, 0x9a, 0x77, 0xe7,
+	0xa1, 0x88, 0xf5, 0x13, 0x29, 0x4f, 0x58, 0x19, 0x97, 0xba, 0x3d, 0x8e, 0x22, 0x19, 0x1a, 0x40,
+	0x85, 0x26, 0x27, 0xcf, 0x4c, 0xab, 0x8a, 0x4c, 0x11, 0x99, 0xf4, 0x22, 0xab, 0x61, 0x84, 0xd5,
+	0x62, 0x1b, 0x48, 0x1d, 0x11, 0x10, 0xdd, 0x34, 0x1b, 0xe8, 0xb6, 0x1a, 0xb7, 0x6f, 0xff, 0x78,
+	0x47, 0x9c, 0xc7, 0x9b, 0x7d, 0xc5, 0x60, 0x1a, 0xf8, 0x81, 0x7a, 0xca, 0x9a, 0xce, 0x18, 0x20,
+	0x33, 0x88, 0xd1, 0x11, 0x40, 0x59, 0x4b, 0x03, 0xb3, 0xb6, 0xc5, 0xf7, 0x01, 0xf0, 0x8b, 0x30,
+	0x13, 0x6f, 0xe0, 0x39, 0xac, 0x14, 0xa2, 0x73, 0x73, 0x2c, 0x9c, 0xdf, 0x82, 0x46, 0xda, 0x81,
+	0x7e, 0x1d, 0xd9, 0x13, 0xe9, 0xb0, 0x49, 0x13, 0xdf, 0x49, 0x3f, 0xf4, 0xe4, 0x19, 0xdd, 0xfd,
+	0x8a, 0x6b, 0x1a, 0x38, 0xcb, 0x81, 0xef, 0x79, 0x32, 0x4c, 0xc2, 0xe7, 0xa6, 0x75, 0x51, 0xae,
+	0xb2, 0x7c, 0x61, 0xae, 0xd2, 0xf9, 0x35, 0x68, 0xe6, 0x2c, 0xf6, 0x67, 0x2e, 0x3b, 0x37, 0xb1,
+	0xe2, 0xe4, 0xc4, 0x5e, 0x86, 0x86, 0xb2, 0x66, 0x77, 0x4c, 0x0a, 0xbc, 0xe1, 0x66, 0x00, 0x7c,
+	0x60, 0x2a, 0x66, 0x69, 0xd3, 0x56, 0xf6, 0x03, 0xa8, 0xa2, 0xcb, 0x39, 0x4e, 0x12, 0xbd, 0x73,
+	0x5a, 0xb2, 0x1d, 0xa2, 0xc1, 0xcc, 0x83, 0xa1, 0xe6, 0xef, 0x40, 0x49, 0x8b, 0xbe, 0x8d, 0x3e,
+	0x7d, 0x6e, 0x3e, 0x26, 0x87, 0xa2, 0x8f, 0xd9, 0x3f, 0x2d, 0xfa, 0xfc, 0x21, 0xd4, 0x7b, 0x36,
+	0x60, 0x60, 0x15, 0xd7, 0x9c, 0x86, 0x70, 0x12, 0x66, 0xc0, 0x2c, 0x4a, 0xc2, 0x81, 0x7f, 0x05,
+	0xca, 0xf8, 0xca, 0x93, 0xe6, 0x9d, 0xdb, 0xc0, 0xc7, 0xeb, 0x82, 0x69, 0x3d, 0xa4, 0xdc, 0xaa,
+	0x41, 0x85, 0xf4, 0xa4, 0xd3, 0x82, 0xaa, 0x59, 0xeb, 0xf4, 0xce, 0x39, 0x2f, 0x42, 0xe9, 0x50,
+	0xf4, 0xd1, 0xd2, 0xf2, 0xbd, 0xd8, 0xfa, 0xa9, 0xf8, 0xe9, 0xbc, 0x92, 0x05, 0x3f, 0xf2, 0x71,
+	0xb5, 0xc2, 0x44, 0x5c, 0xcd, 0xa9, 0x42, 0x19, 0x47, 0x74, 0x5e, 0xbe, 0xcc, 0x6a, 0x73, 0xfe,
+	0xa0, 0x80, 0x06, 0x1e, 0xa6, 0xd0, 0x2e, 0x8a, 0x19, 0xfe, 0x32, 0x34, 0x46, 0x91, 0xea, 0xc9,
+	0x38, 0x56, 0x91, 0xb5, 0x00, 0x5e, 0xbd, 0x3a, 0x2d, 0xb7, 0x71, 0x90, 0xd0, 0xb8, 0x19, 0x79,
+	0xfb, 0xd3, 0xd0, 0x48, 0xe1, 0xc6, 0xac, 0xd4, 0xf2, 0xcc, 0x84, 0x87, 0x1e, 0xc9, 0x68, 0x28,
+	0x7c, 0x8f, 0x15, 0x9c, 0x55, 0x58, 0x99, 0xca, 0xc9, 0x39, 0x35, 0x6b, 0xdc, 0x3a, 0x4b, 0xd0,
+	0xcc, 0x65, 0x59, 0x9c, 0x9b, 0x50, 0x4f, 0x72, 0x30, 0xe8, 0x04, 0xf8, 0xb1, 0x89, 0x1e, 0xd9,
+	0x6d, 0x48, 0xdb, 0xce, 0x5f, 0x15, 0xa0, 0x6a, 0xf2, 0x58, 0x7c, 0x2b, 0xcd, 0x3b, 0x17, 0xe6,
+	0x48, 0x7a, 0x18, 0x22, 0x9b, 0x32, 0x4a, 0x93, 0xcf, 0x6b, 0x50, 0x09, 0xc8, 0xda, 0xb7, 0x17,
+	0x94, 0x1a, 0xb9, 0xfb, 0x54, 0xca, 0xdf, 0xa7, 0xf6, 0x5b, 0x69, 0x9a, 0x2a, 0x89, 0x6c, 0x90,
+	0xa1, 0x71, 0x18, 0x49, 0xc9, 0x0a, 0xa9, 0xb1, 0x5e, 0x24, 0x6d, 0xa8, 0x86, 0x23, 0xd1, 0xd3,
+	0x04, 0x28, 0xb5, 0x8f, 0xa1, 0x7e, 0xa0, 0xe2, 0xe9, 0x37, 0xa6, 0x06, 0xa5, 0x43, 0x35, 0x32,
+	0x26, 0xca, 0x96, 0xd2, 0x64, 0xa2, 0x10, 0x17, 0x79, 0xac, 0x4d, 0x90, 0xc5, 0xf5, 0xfb, 0x03,
+	0x6d, 0x02, 0x68, 0x7b, 0x61, 0x28, 0x23, 0x56, 0xc1, 0x0d, 0x76, 0xe5, 0x28, 0x10, 0x3d, 0x8c,
+	0xa1, 0x2d, 0x03, 0x10, 0xfc, 0x81, 0x1f, 0xc5, 0x9a, 0xd5, 0xda, 0x6f, 0x41, 0xc5, 0x14, 0x14,
+	0x2c, 0x41, 0x83, 0x3e, 0x88, 0xd5, 0x02, 0x4e, 0x88, 0x9a, 0xdb, 0x32, 0xc4, 0x87, 0x8b, 0xf2,
+	0x20, 0x04, 0x30, 0x03, 0x14, 0xdb, 0x4f, 0x60, 0x69, 0xa2, 0x40, 0x81, 0xaf, 0x01, 0x9b, 0x00,
+	0xe0, 0x44, 0x17, 0xf8, 0x8b, 0x70, 0x6d, 0x02, 0xfa, 0xc8, 0xf7, 0x3c, 0x0a, 0x13, 0x4d, 0x77,
+	0x24, 0xcb, 0xd9, 0x6a, 0x40, 0xad, 0x67, 0x4e, 0xa0, 0x7d, 0x00, 0x4b, 0x74, 0x24, 0x8f, 0xa4,
+	0x16, 0xfb, 0x61, 0x70, 0xfe, 0x73, 0x57, 0x91, 0xb4, 0x3f, 0x0f, 0x15, 0x0a, 0xd7, 0xa2, 0xb4,
+	0x1f, 0x47, 0x6a, 0x48, 0xbc, 0x2a, 0x2e, 0x7d, 0x23, 0x77, 0xad, 0xec, 0xb9, 0x16, 0xb5, 0x6a,
+	0xff, 0x25, 0x40, 0x6d, 0xb3, 0xd7, 0x53, 0xe3, 0x50, 0xcf, 0x8c, 0x7c, 0x51, 0x44, 0xf0, 0x1e,
+	0x54, 0xc5, 0xa9, 0xd0, 0x22, 0xb2, 0x5a, 0x6a, 0xda, 0x1e, 0xb1, 0xbc, 0x36, 0x36, 0x09, 0xc9,
+	0xb5, 0xc8, 0x48, 0xd6, 0x53, 0xe1, 0xb1, 0xdf, 0x6f, 0x95, 0x2f, 0x25, 0xdb, 0x26, 0x24, 0xd7,
+	0x22, 0x23, 0x99, 0x55, 0xac, 0x95, 0x4b, 0xc9, 0x8c, 0x76, 0x49, 0xf5, 0xe8, 0x1d, 0x28, 0xfb,
+	0xe1, 0xb1, 0xb2, 0x15, 0x45, 0x2f, 0x3d, 0x83, 0x68, 0x2f, 0x3c, 0x56, 0x2e, 0x21, 0x3a, 0x12,
+	0xaa, 0x66, 0xc2, 0xfc, 0x4b, 0x50, 0xa1, 0xac, 0x4c, 0xab, 0x30, 0x47, 0x11, 0x83, 0x2d, 0xf8,
+	0x30, 0x14, 0xfc, 0x7a, 0x12, 0xe4, 0xa7, 0xfd, 0x42, 0x38, 0x35, 0xb7, 0xea, 0xc9, 0x96, 0x39,
+	0xff, 0x5a, 0xc0, 0xa4, 0x2b, 0xad, 0xec, 0x26, 0x2c, 0xcb, 0x10, 0xaf, 0x76, 0xa2, 0x3a, 0xed,
+	0x9d, 0x9e, 0x82, 0xa2, 0x21, 0x67, 0x21, 0xb2, 0x3b, 0xee, 0x5b, 0x9f, 0x33, 0x0f, 0xe2, 0x6f,
+	0xc3, 0x8b, 0xa6, 0x79, 0x10, 0xc9, 0x48, 0x06, 0x52, 0xc4, 0x72, 0x7b, 0x20, 0xc2, 0x50, 0x06,
+	0xf6, 0x21, 0x7d, 0x56, 0x37, 0x46, 0x96, 0x4c, 0x57, 0x67, 0x24, 0x7a, 0x32, 0xb6, 0x49, 0x8b,
+	0x09, 0x18, 0xff, 0x02, 0x54, 0xa8, 0xae, 0xab, 0xe5, 0x5d, 0x2e, 0x7c, 0x06, 0xcb, 0x51, 0xa9,
+	0xa6, 0xdf, 0x04, 0x30, 0xa7, 0x81, 0x1e, 0x88, 0xd5, 0x45, 0x9f, 0xba, 0xf4, 0xf8, 0x10, 0xd1,
+	0xcd, 0x11, 0xe1, 0xfc, 0x3c, 0x19, 0x48, 0xd4, 0x0f, 0xa8, 0xe5, 0x69, 0xf1, 0x25, 0x77, 0x02,
+	0xe6, 0xfc, 0x49, 0x19, 0xca, 0x78, 0x90, 0x88, 0x3c, 0x50, 0x43, 0x99, 0x06, 0xd3, 0x8c, 0xd0,
+	0x4e, 0xc0, 0xd0, 0x94, 0x10, 0x26, 0x4f, 0x99, 0xa2, 0x19, 0x55, 0x36, 0x0d, 0x46, 0xcc, 0x51,
+	0xa4, 0xb0, 0x90, 0x27, 0xc5, 0xb4, 0x46, 0xc7, 0x14, 0x98, 0x7f, 0x11, 0xae, 0x63, 0x2a, 0x45,
+	0x6a, 0xd2, 0x3e, 0x4f, 0x54, 0x74, 0x12, 0xe3, 0xce, 0xed, 0x79, 0x36, 0x0a, 0xf3, 0x8c, 0x5e,
+	0x54, 0xe7, 0x9e, 0x3c, 0xf5, 0x09, 0xb3, 0x4e, 0x98, 0x69, 0x1b, 0x85, 0x43, 0x98, 0xad, 0xe9,
+	0x58, 0x5e, 0xc6, 0x23, 0x9b, 0x82, 0xa2, 0xbd, 0x62, 0x6a, 0x16, 0xe2, 0x3d, 0x8f, 0x02, 0x43,
+	0x0d, 0x37, 0x03, 0xa0, 0xe8, 0xd0, 0x60, 0x47, 0x46, 0x69, 0x2f, 0x19, 0x47, 0x2b, 0x07, 0x42,
+	0x0c, 0x2d, 0x7b, 0x83, 0x64, 0x10, 0x13, 0xb5, 0xc9, 0x83, 0x30, 0x64, 0xdb, 0x17, 0x5a, 0x3e,
+	0x15, 0xe7, 0x8f, 0xa3, 0xa0, 0x25, 0x09, 0x21, 0x07, 0x41, 0x57, 0x2d, 0x50, 0x3d, 0x11, 0x74,
+	0xb4, 0x8a, 0x44, 0x5f, 0x1e, 0x08, 0x3d, 0x68, 0xf5, 0x09, 0x6b, 0x06, 0x8e, 0x2b, 0xc6, 0x88,
+	0xc4, 0x87, 0x2a, 0x94, 0xad, 0x81, 0x59, 0x71, 0xd2, 0xc6, 0x99, 0x88, 0x50, 0x04, 0xe7, 0xda,
+	0xef, 0xe1, 0x5a, 0x7c, 0x33, 0x93, 0x1c, 0x08, 0xd7, 0x1a, 0x4a, 0xfd, 0x54, 0x45, 0x58, 0x60,
+	0xf0, 0x91, 0x59, 0x6b, 0x0a, 0xc0, 0xb7, 0x89, 0xc6, 0x93, 0xad, 0x13, 0xf3, 0x36, 0x99, 0x56,
+	0x7b, 0x1f, 0x20, 0x13, 0x2e, 0x7c, 0x51, 0x36, 0x29, 0xdc, 0xcc, 0x16, 0xd0, 0x6e, 0x3e, 0x90,
+	0x21, 0x86, 0xd6, 0x77, 0xac, 0x3c, 0xb1, 0x02, 0x02, 0x3b, 0x5a, 0x44, 0x5a, 0x7a, 0x29, 0x90,
+	0x7c, 0x1b, 0x6a, 0x49, 0x8f, 0x95, 0xda, 0xff, 0x5b, 0x80, 0x66, 0x2e, 0xd9, 0xfa, 0x0b, 0x4c,
+	0x10, 0xe3, 0xfb, 0x8e, 0x7a, 0x04, 0x37, 0xda, 0xc8, 0x5a, 0xda, 0xc6, 0x63, 0xb0, 0xb9, 0x60,
+	0xec, 0x35, 0xbe, 0x70, 0x0e, 0xf2, 0xb1, 0x92, 0xc3, 0xed, 0xbb, 0x36, 0x3a, 0xd0, 0x84, 0xda,
+	0xe3, 0xf0, 0x24, 0x54, 0x4f, 0x43, 0xb6, 0x90, 0x66, 0xfc, 0x27, 0x72, 0x1c, 0x49, 0x52, 0xbe,
+	0xd4, 0xfe, 0x7e, 0x79, 0xaa, 0x38, 0xe6, 0x3e, 0x54, 0x8d, 0x85, 0x4c, 0xc6, 0xdb, 0x6c, 0x35,
+	0x43, 0x1e, 0xd9, 0xc6, 0xd3, 0x73, 0x20, 0xd7, 0x12, 0xa3, 0xe9, 0x9a, 0x56, 0x80, 0x15, 0x2f,
+	0x8c, 0xfb, 0x4f, 0x30, 0x4a, 0xd4, 0x63, 0x1e, 0x98, 0x95, 0x82, 0x39, 0xbf, 0x57, 0x80, 0xb5,
+	0x8b, 0x50, 0xd0, 0x92, 0xec, 0x4e, 0xd4, 0xa8, 0x24, 0x4d, 0xde, 0x99, 0x2a, 0xbd, 0x2c, 0xd2,
+	0x6a, 0xee, 0x3c, 0xe7, 0x24, 0x26, 0x0b, 0x31, 0xdb, 0xdf, 0x2b, 0xc0, 0xea, 0xcc, 0x9a, 0x73,
+	0xa6, 0x0e, 0x40, 0xd5, 0x48, 0x96, 0x29, 0xa9, 0x48, 0x93, 0xdc, 0x26, 0x98, 0x49, 0x6f, 0x4d,
+	0x6c, 0xb2, 0x86, 0x3b, 0xa6, 0x94, 0x97, 0x95, 0xd1, 0x46, 0xc1, 0x53, 0x43, 0x1d, 0xde, 0xc7,
+	0xd4, 0x21, 0x83, 0x45, 0x63, 0x7d, 0x59, 0x48, 0x95, 0x3c, 0x52, 0x1b, 0x71, 0x65, 0x35, 0x2a,
+	0xd5, 0x18, 0x8f, 0x02, 0xbf, 0x87, 0xcd, 0x7a, 0xdb, 0x85, 0x6b, 0x17, 0xcc, 0x9b, 0x66, 0x72,
+	0x64, 0x67, 0xb5, 0x0c, 0xb0, 0x73, 0x94, 0xcc, 0x85, 0x15, 0xd0, 0x89, 0xdf, 0x39, 0xda, 0x26,
+	0x37, 0xde, 0x26, 0x42, 0xcd, 0x9d, 0x38, 0x42, 0x2d, 0x12, 0xb3, 0x52, 0xfb, 0x3b, 0x85, 0x24,
+	0x45, 0xea, 0xfc, 0x26, 0x2c, 0x99, 0x79, 0x1c, 0x88, 0xf3, 0x40, 0x09, 0x8f, 0xdf, 0x87, 0xe5,
+	0x38, 0x2d, 0x7b, 0xce, 0x3d, 0x05, 0xd3, 0x2f, 0x79, 0x67, 0x02, 0xc9, 0x9d, 0x22, 0x4a, 0x8c,
+	0xfa, 0x62, 0x16, 0x8a, 0xe5, 0xe4, 0x9e, 0x08, 0xba, 0x49, 0x8b, 0xe4, 0x70, 0x88, 0xf6, 0x17,
+	0x60, 0x95, 0x14, 0x97, 0x99, 0x8c, 0xb1, 0x8d, 0xf1, 0xcc, 0x8d, 0xce, 0xdd, 0x49, 0xce, 0xdc,
+	0x36, 0xdb, 0x3f, 0xae, 0x00, 0x64, 0xb1, 0xe6, 0x0b, 0xae, 0xf2, 0x45, 0x06, 0xd0, 0x4c, 0xe6,
+	0xa7, 0xf4, 0xdc, 0x99, 0x9f, 0xb7, 0x53, 0x13, 0xdd, 0xc4, 0xfb, 0xa6, 0xcb, 0x3b, 0xb3, 0x39,
+	0x4d, 0x1b, 0xe6, 0x13, 0x15, 0x03, 0x95, 0xe9, 0x8a, 0x81, 0xf5, 0xd9, 0xf2, 0xa2, 0x29, 0x1d,
+	0x93, 0xf9, 0xd8, 0xb5, 0x09, 0x1f, 0xdb, 0xc1, 0xda, 0x49, 0xe1, 0xa9, 0x30, 0x38, 0x4f, 0x12,
+	0x0c, 0x49, 0x9b, 0xbf, 0x01, 0x15, 0x4d, 0x15, 0xdc, 0xf5, 0xf5, 0xd2, 0xd5, 0x07, 0x67, 0x70,
+	0x51, 0x61, 0xf9, 0xb1, 0xad, 0x09, 0x32, 0xaf, 0x57, 0xdd, 0xcd, 0x41, 0xf8, 0x06, 0x70, 0x3f,
+	0x8c, 0xb5, 0x08, 0x02, 0xe9, 0x6d, 0x9d, 0xef, 0x98, 0xb8, 0x3f, 0xbd, 0x98, 0x75, 0xf7, 0x82,
+	0x9e, 0xe4, 0xfc, 0x17, 0xb3, 0xb2, 0xeb, 0xef, 0x17, 0x53, 0xb7, 0xa3, 0x01, 0x95, 0xae, 0x88,
+	0xfd, 0x9e, 0x71, 0xb4, 0xec, 0x03, 0x6d, 0x5c, 0x0f, 0xad, 0x3c, 0xc5, 0x8a, 0xe8, 0x53, 0xc4,
+	0x12, 0xbd, 0x87, 0x65, 0x80, 0xac, 0x02, 0x9d, 0x95, 0xf1, 0xae, 0x24, 0x67, 0x63, 0xd2, 0xf0,
+	0x44, 0x4a, 0xa1, 0x19, 0x2f, 0x2d, 0x70, 0xaa, 0xe1, 0x08, 0xa4, 0x8b, 0x59, 0x1d, 0x71, 0x42,
+	0xa5, 0xa5, 0x09, 0x4c, 0x91, 0x24, 0x31, 0x40, 0x36, 0x49, 0xf9, 0x2c, 0x6b, 0xa2, 0xd9, 0x9f,
+	0x30, 0x35, 0xd1, 0xa4, 0x98, 0x1c, 0x9e, 0x45, 0xbc, 0x49, 0x93, 0x1d, 0x6c, 0x09, 0x67, 0x94,
+	0x15, 0xb6, 0xb3, 0x65, 0xe4, 0x2a, 0x28, 0x89, 0xbc, 0x82, 0x9f, 0xa7, 0x94, 0x5a, 0x66, 0x38,
+	0xaa, 0x87, 0x17, 0x78, 0x15, 0x67, 0x96, 0x3e, 0xe1, 0x8c, 0xe3, 0xc8, 0x28, 0xfa, 0x5d, 0x11,
+	0x4b, 0xb6, 0xd6, 0xfe, 0xe3, 0xac, 0x64, 0xf0, 0xb5, 0xd4, 0x98, 0x9f, 0x47, 0xc0, 0x9e, 0x65,
+	0xee, 0xdf, 0x87, 0xd5, 0x48, 0x7e, 0x63, 0xec, 0x4f, 0xd4, 0xc3, 0x96, 0x2e, 0xcf, 0xf8, 0xce,
+	0x52, 0xb4, 0x4f, 0x61, 0x35, 0x69, 0x3c, 0xf1, 0xf5, 0x80, 0xa2, 0x02, 0xf8, 0xb7, 0x84, 0xb4,
+	0x60, 0xb7, 0xb0, 0x5e, 0xb8, 0x8c, 0x65, 0x8a, 0x98, 0x45, 0x66, 0x8b, 0x73, 0x44, 0x66, 0xdb,
+	0xff, 0x52, 0xcd, 0x05, 0x06, 0x8c, 0x7b, 0xe3, 0xa5, 0xee, 0xcd, 0x6c, 0x7a, 0x27, 0x0b, 0xb6,
+	0x16, 0x9f, 0x27, 0xd8, 0x7a, 0x51, 0x7e, 0xf4, 0xcb, 0x68, 0xbb, 0x92, 0xec, 0x1e, 0xcd, 0x11,
+	0x48, 0x9e, 0xc0, 0xe5, 0x5b, 0x94, 0xac, 0x11, 0x1d, 0x93, 0xbc, 0xaf, 0x5c, 0x58, 0x3e, 0x9f,
+	0xcf, 0xca, 0x58, 0x4c, 0x37, 0x47, 0x95, 0xbb, 0xe9, 0xd5, 0x8b, 0x6e, 0x3a, 0x7a, 0x9a, 0x56,
+	0x07, 0xa4, 0x6d, 0x13, 0x77, 0x37, 0xdf, 0x09, 0x7b, 0xca, 0xcc, 0xd5, 0xdd, 0x19, 0x38, 0x5a,
+	0x39, 0xc3, 0x71, 0xa0, 0x7d, 0x1b, 0x5a, 0x36, 0x8d, 0xe9, 0xff, 0x7c, 0x34, 0x66, 0xff, 0xf3,
+	0xf1, 0x2e, 0x40, 0x2c, 0x51, 0xda, 0x77, 0xfc, 0x9e, 0xb6, 0x29, 0xfe, 0x1b, 0xcf, 0x5a, 0x9b,
+	0x0d, 0x88, 0xe7, 0x28, 0x70, 0xfe, 0x43, 0x71, 0xb6, 0x8d, 0x56, 0xb0, 0xcd, 0x45, 0xa6, 0xed,
+	0x69, 0xfd, 0xb7, 0x3c, 0xab, 0xff, 0xde, 0x80, 0x4a, 0xdc, 0x53, 0x23, 0xd9, 0x5a, 0xbb, 0xf4,
+	0x7c, 0x37, 0x3a, 0x88, 0xe4, 0x1a, 0x5c, 0x0a, 0x3f, 0xe1, 0xeb, 0xa7, 0x22, 0x2a, 0x4e, 0x6f,
+	0xb8, 0x49, 0xd3, 0xf1, 0xa0, 0xba, 0x3f, 0xca, 0xc9, 0xd6, 0x84, 0xeb, 0x4c, 0x81, 0xa6, 0x62,
+	0x2e, 0xd0, 0x94, 0x16, 0x81, 0x95, 0xf2, 0x45, 0x60, 0xeb, 0xd0, 0x8c, 0x72, 0xe9, 0x10, 0x5b,
+	0xf9, 0x97, 0x03, 0xb5, 0x3f, 0x84, 0x0a, 0xcd, 0x07, 0x1f, 0x69, 0xb3, 0x95, 0xc6, 0x4e, 0xc3,
+	0x89, 0xb3, 0x02, 0xc6, 0x24, 0x62, 0xa9, 0xf7, 0x8f, 0x0f, 0x07, 0xb2, 0x23, 0x86, 0x92, 0x14,
+	0x5b, 0x91, 0xb7, 0x60, 0xcd, 0xe0, 0xc6, 0x93, 0x3d, 0x64, 0x4d, 0x04, 0x7e, 0x37, 0x12, 0xd1,
+	0x39, 0x2b, 0xb7, 0xdf, 0xa5, 0xe4, 0x5d, 0x22, 0x34, 0xcd, 0xf4, 0xbf, 0x45, 0x46, 0x95, 0x7a,
+	0x32, 0x42, 0x6d, 0x6d, 0x12, 0xad, 0xd6, 0xf7, 0x30, 0xe5, 0x27, 0x64, 0x5e, 0xb3, 0x52, 0xfb,
+	0x09, 0x9a, 0x83, 0xd9, 0xdb, 0xf6, 0x0b, 0xbb, 0x53, 0xed, 0xad, 0x9c, 0x39, 0x34, 0x59, 0x6f,
+	0x52, 0x98, 0xb7, 0xde, 0xa4, 0xfd, 0x3e, 0xac, 0xb8, 0x93, 0x7a, 0x98, 0xbf, 0x0d, 0x35, 0x35,
+	0xca, 0xf3, 0xb9, 0x4a, 0xf6, 0x12, 0xf4, 0xf6, 0x0f, 0x0b, 0xb0, 0xb8, 0x17, 0x6a, 0x19, 0x85,
+	0x22, 0x78, 0x10, 0x88, 0x3e, 0x7f, 0x2b, 0xd1, 0x44, 0x17, 0xfb, 0xb6, 0x79, 0xdc, 0x49, 0xa5,
+	0x14, 0xd8, 0xb0, 0x28, 0xe6, 0x44, 0xa5, 0xe7, 0x6b, 0x15, 0x19, 0x23, 0x30, 0x29, 0xfb, 0x59,
+	0x03, 0x66, 0xc0, 0x1d, 0x12, 0xfb, 0x43, 0x73, 0xcc, 0x2d, 0x58, 0x9b, 0x80, 0x26, 0x16, 0x5e,
+	0x91, 0xbf, 0x0c, 0xad, 0xec, 0x05, 0xd9, 0x51, 0xa1, 0xde, 0xc3, 0x78, 0x3a, 0x99, 0x1a, 0xac,
+	0xd4, 0xfe, 0xe7, 0xd4, 0xc8, 0x39, 0xb2, 0x45, 0x41, 0x91, 0x52, 0x3a, 0x0b, 0x8a, 0x9b, 0x56,
+	0xee, 0x4f, 0x68, 0xc5, 0x39, 0xfe, 0x84, 0xf6, 0x6e, 0xf6, 0x27, 0x34, 0xf3, 0x18, 0xbc, 0x72,
+	0xe1, 0x0b, 0x73, 0x44, 0x21, 0x61, 0x83, 0xd8, 0x91, 0xb9, 0x7f, 0xa4, 0xbd, 0x6e, 0xfd, 0x95,
+	0xf2, 0x3c, 0xb6, 0x20, 0xa1, 0xf2, 0x7b, 0xd3, 0xa5, 0xce, 0xf3, 0xd5, 0x1c, 0xcd, 0x98, 0x6b,
+	0xf0, 0xdc, 0xe6, 0xda, 0x7b, 0x53, 0xae, 0x41, 0xfd, 0xc2, 0xa8, 0xd2, 0x25, 0xff, 0xc7, 0x7a,
+	0x0f, 0x6a, 0x03, 0x3f, 0xd6, 0x2a, 0x32, 0xff, 0x1b, 0x9b, 0xfd, 0x4f, 0x43, 0x6e, 0xb7, 0x76,
+	0x0d, 0x22, 0x15, 0x80, 0x24, 0x54, 0x4e, 0x1f, 0x20, 0xdb, 0xc5, 0x19, 0x5d, 0xf3, 0x31, 0xfe,
+	0x11, 0x88, 0xa5, 0x61, 0xe3, 0x6e, 0x96, 0xe5, 0xb0, 0x2d, 0xe7, 0x0c, 0x9c, 0x99, 0x77, 0xfa,
+	0x40, 0x46, 0x66, 0x7e, 0xa8, 0x7b, 0x93, 0x6c, 0x88, 0x1d, 0x3e, 0x6d, 0xf3, 0x77, 0xf3, 0xc7,
+	0x63, 0x44, 0x68, 0xfd, 0x19, 0x7b, 0x9c, 0x72, 0xce, 0x9d, 0x93, 0x73, 0x0f, 0x9a, 0xb9, 0xa5,
+	0xa3, 0xfe, 0x1c, 0x87, 0x9e, 0x4a, 0x42, 0x97, 0xf8, 0xcd, 0xe9, 0x7f, 0x18, 0x5e, 0x12, 0xbc,
+	0xa4, 0xef, 0xf6, 0x9f, 0x17, 0xa0, 0x8e, 0x91, 0x53, 0x7c, 0xf9, 0xf8, 0x23, 0xa8, 0xfb, 0x9e,
+	0x0c, 0xb5, 0xaf, 0xcf, 0xad, 0x41, 0x31, 0xed, 0xbf, 0x25, 0xa8, 0x1b, 0xd6, 0x3d, 0xd9, 0xd8,
+	0xb3, 0xf8, 0xb6, 0x8d, 0x09, 0x90, 0x84, 0x85, 0xb3, 0x05, 0x35, 0x0b, 0x76, 0xde, 0x82, 0x95,
+	0x29, 0x4c, 0xfc, 0xd3, 0x83, 0xb5, 0x34, 0x3b, 0xe7, 0xc3, 0xa4, 0xae, 0x60, 0xd1, 0x9d, 0x04,
+	0x62, 0xa0, 0x77, 0x64, 0x08, 0x6e, 0xff, 0x7b, 0x11, 0x96, 0x27, 0x25, 0x9b, 0xe2, 0xcd, 0x46,
+	0xab, 0xee, 0x07, 0x5e, 0xce, 0xf9, 0x66, 0x18, 0x9a, 0x3e, 0x30, 0x8c, 0x08, 0xb0, 0x8a, 0x5d,
+	0xbb, 0x6a, 0x28, 0xd9, 0x7a, 0xbe, 0xd8, 0xfe, 0x35, 0x7c, 0x12, 0x4c, 0x08, 0x9f, 0x8d, 0x78,
+	0xc3, 0x96, 0x27, 0x7e, 0xab, 0xc8, 0x97, 0x72, 0x2e, 0xe0, 0x0f, 0x8a, 0x7c, 0x0d, 0x56, 0xb6,
+	0xc6, 0xa1, 0x17, 0x48, 0x2f, 0x85, 0xfe, 0x59, 0x1e, 0x9a, 0x3a, 0x7b, 0xdf, 0x42, 0xff, 0xb2,
+	0xd1, 0x19, 0x77, 0xad, 0xa3, 0xf7, 0xdb, 0x65, 0x7e, 0x1d, 0x56, 0x2d, 0x56, 0x66, 0x35, 0xb2,
+	0xdf, 0x29, 0xf3, 0x6b, 0xb0, 0xbc, 0x69, 0xf6, 0xd6, 0x4e, 0x94, 0xfd, 0x2e, 0x46, 0xe4, 0x29,
+	0x1f, 0xc3, 0xbe, 0x4d, 0x7c, 0xd2, 0x70, 0x17, 0xfb, 0x0e, 0xe6, 0x42, 0xa1, 0x73, 0x98, 0x0e,
+	0xf4, 0xfb, 0x65, 0xde, 0x84, 0x6a, 0xe7, 0x90, 0xb8, 0x7d, 0xaf, 0xcc, 0x5f, 0x00, 0x96, 0xf5,
+	0x5a, 0xdb, 0xf8, 0x0f, 0xcd, 0x64, 0x52, 0x63, 0xf7, 0xfb, 0x65, 0x5c, 0x57, 0x72, 0x0e, 0xec,
+	0x8f, 0x30, 0xbd, 0xbc, 0xf4, 0xc8, 0x8f, 0x63, 0x3f, 0xec, 0xdb, 0xf9, 0x7e, 0xb7, 0x7c, 0xfb,
+	0x87, 0x05, 0x58, 0x9e, 0x7c, 0x53, 0xd0, 0x46, 0x0e, 0x54, 0xd8, 0xd7, 0xe6, 0x7f, 0x05, 0x68,
+	0x40, 0x63, 0x9d, 0x09, 0x35, 0x29, 0xcb, 0x10, 0x52, 0xfe, 0xd4, 0x38, 0xdd, 0x26, 0xfc, 0x68,
+	0x2a, 0x50, 0xb4, 0xe8, 0xb3, 0x66, 0x6a, 0x7a, 0x97, 0x53, 0xf7, 0x80, 0xf2, 0xb8, 0x49, 0x9e,
+	0x8c, 0x55, 0x11, 0x75, 0x1c, 0x05, 0xc6, 0x4d, 0x90, 0x43, 0xe1, 0x07, 0xa6, 0x80, 0x78, 0x34,
+	0x50, 0xa1, 0xf5, 0x13, 0x24, 0xd5, 0x12, 0x43, 0xee, 0x05, 0xf7, 0x70, 0x1e, 0xa9, 0xf8, 0x33,
+	0x79, 0xfb, 0xdb, 0x05, 0x58, 0x4c, 0xb2, 0x97, 0x7e, 0xdf, 0x0f, 0x8d, 0xa3, 0x91, 0xfc, 0x5b,
+	0xa3, 0x17, 0xf8, 0xa3, 0xa4, 0xfa, 0x79, 0x05, 0x9a, 0xf8, 0x1f, 0xa2, 0xcd, 0xd0, 0xdb, 0x89,
+	0xd4, 0xc8, 0x4c, 0xdb, 0x1f, 0x8e, 0x54, 0x64, 0x1d, 0x9c, 0xa7, 0xb2, 0x8b, 0xe8, 0x23, 0x19,
+	0xb1, 0x32, 0x59, 0x07, 0x03, 0x11, 0xf9, 0x61, 0xff, 0xfe, 0x99, 0x96, 0x61, 0x6c, 0x1c, 0x9d,
+	0x26, 0xd4, 0xc6, 0xb1, 0xec, 0xa1, 0xd3, 0x50, 0xc5, 0x46, 0x77, 0xec, 0x07, 0xda, 0x0f, 0x59,
+	0xed, 0xf6, 0x77, 0x0b, 0xd0, 0xa4, 0x2d, 0xb7, 0x61, 0xdc, 0x89, 0xe0, 0x50, 0x13, 0x6a, 0x0f,
+	0xd3, 0x72, 0x54, 0xac, 0x15, 0x38, 0x31, 0xa1, 0x00, 0x7b, 0x08, 0xac, 0x94, 0x55, 0xa6, 0x96,
+	0xf9, 0x27, 0xe0, 0x05, 0x57, 0x0e, 0x95, 0x96, 0x4f, 0x84, 0xaf, 0xf3, 0x61, 0xb6, 0x0a, 0x16,
+	0x78, 0x9b, 0xae, 0x24, 0xae, 0x56, 0xc5, 0x30, 0x06, 0x0d, 0x9b, 0x40, 0x6a, 0x5b, 0xb7, 0xff,
+	0xfe, 0xa7, 0x37, 0x0a, 0x3f, 0xf9, 0xe9, 0x8d, 0xc2, 0xbf, 0xfd, 0xf4, 0x46, 0xe1, 0x7b, 0x3f,
+	0xbb, 0xb1, 0xf0, 0x93, 0x9f, 0xdd, 0x58, 0xf8, 0xa7, 0x9f, 0xdd, 0x58, 0xf8, 0x90, 0x4d, 0xff,
+	0x3d, 0xbb, 0x5b, 0x25, 0x4d, 0xf7, 0xc6, 0xff, 0x0d, 0x00, 0x87, 0xd0, 0xce, 0xe6, 0xb9, 0x3d,
+	0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -8575,6 +8620,11 @@ func (m *BlockContentLatex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Processor != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Processor))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Text) > 0 {
 		i -= len(m.Text)
 		copy(dAtA[i:], m.Text)
@@ -9084,6 +9134,15 @@ func (m *AccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Locale) > 0 {
+		i -= len(m.Locale)
+		copy(dAtA[i:], m.Locale)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Locale)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xda
+	}
 	if len(m.NetworkId) > 0 {
 		i -= len(m.NetworkId)
 		copy(dAtA[i:], m.NetworkId)
@@ -11387,6 +11446,9 @@ func (m *BlockContentLatex) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	if m.Processor != 0 {
+		n += 1 + sovModels(uint64(m.Processor))
+	}
 	return n
 }
 
@@ -11649,6 +11711,10 @@ func (m *AccountInfo) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovModels(uint64(l))
 	}
+	l = len(m.Locale)
+	if l > 0 {
+		n += 2 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -17645,6 +17711,25 @@ func (m *BlockContentLatex) Unmarshal(dAtA []byte) error {
 			}
 			m.Text = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processor", wireType)
+			}
+			m.Processor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processor |= BlockContentLatexProcessor(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -19318,6 +19403,38 @@ func (m *AccountInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.NetworkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
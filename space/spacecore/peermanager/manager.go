@@ -2,6 +2,7 @@ package peermanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/anyproto/anytype-heart/space/spacecore/peerstore"
 )
 
+var errReadOnly = errors.New("sync is disabled in read-only mode")
+
 type clientPeerManager struct {
 	spaceId            string
 	responsibleNodeIds []string
@@ -26,6 +29,9 @@ type clientPeerManager struct {
 }
 
 func (n *clientPeerManager) GetNodePeers(ctx context.Context) (peers []peer.Peer, err error) {
+	if n.p.readOnly {
+		return nil, errReadOnly
+	}
 	p, err := n.p.pool.GetOneOf(ctx, n.responsibleNodeIds)
 	if err == nil {
 		peers = []peer.Peer{p}
@@ -49,6 +55,9 @@ func (n *clientPeerManager) SendPeer(ctx context.Context, peerId string, msg *sp
 	// explanation:
 	// the context which comes here should not be used. It can be cancelled and thus kill the stream,
 	// because the stream will be opened with this context
+	if n.p.readOnly {
+		return nil
+	}
 	ctx = logger.CtxWithFields(context.Background(), logger.CtxGetFields(ctx)...)
 	return n.p.streamPool.Send(ctx, msg, func(ctx context.Context) (peers []peer.Peer, err error) {
 		return n.getExactPeer(ctx, peerId)
@@ -58,6 +67,9 @@ func (n *clientPeerManager) SendPeer(ctx context.Context, peerId string, msg *sp
 func (n *clientPeerManager) Broadcast(ctx context.Context, msg *spacesyncproto.ObjectSyncMessage) (err error) {
 	// the context which comes here should not be used. It can be cancelled and thus kill the stream,
 	// because the stream can be opened with this context
+	if n.p.readOnly {
+		return nil
+	}
 	ctx = logger.CtxWithFields(context.Background(), logger.CtxGetFields(ctx)...)
 	return n.p.streamPool.Send(ctx, msg, func(ctx context.Context) (peers []peer.Peer, err error) {
 		return n.getStreamResponsiblePeers(ctx)
@@ -65,6 +77,9 @@ func (n *clientPeerManager) Broadcast(ctx context.Context, msg *spacesyncproto.O
 }
 
 func (n *clientPeerManager) GetResponsiblePeers(ctx context.Context) (peers []peer.Peer, err error) {
+	if n.p.readOnly {
+		return nil, errReadOnly
+	}
 	p, err := n.p.pool.GetOneOf(ctx, n.responsibleNodeIds)
 	if err == nil {
 		peers = []peer.Peer{p}
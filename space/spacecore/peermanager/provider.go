@@ -9,6 +9,7 @@ import (
 	"github.com/anyproto/any-sync/net/pool"
 	"github.com/anyproto/any-sync/net/streampool"
 
+	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/space/spacecore"
 	"github.com/anyproto/anytype-heart/space/spacecore/peerstore"
 )
@@ -25,6 +26,8 @@ type provider struct {
 	pool       pool.Pool
 	streamPool streampool.StreamPool
 	peerStore  peerstore.PeerStore
+	// readOnly disables sync with other peers, when account is opened in read-only mode
+	readOnly bool
 }
 
 func (p *provider) Init(a *app.App) (err error) {
@@ -32,6 +35,9 @@ func (p *provider) Init(a *app.App) (err error) {
 	poolService := a.MustComponent(pool.CName).(pool.Service)
 	p.pool = poolService
 	p.streamPool = a.MustComponent(spacecore.CName).(spacecore.SpaceCoreService).StreamPool()
+	if cfg, ok := a.Component(config.CName).(*config.Config); ok {
+		p.readOnly = cfg.ReadOnly
+	}
 	return nil
 }
 
@@ -200,6 +200,21 @@ func (mr *MockObjectStoreMockRecorder) GetAccountStatus() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountStatus", reflect.TypeOf((*MockObjectStore)(nil).GetAccountStatus))
 }
 
+// GetBacklinks mocks base method.
+func (m *MockObjectStore) GetBacklinks(arg0 string) ([]*model.Backlink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBacklinks", arg0)
+	ret0, _ := ret[0].([]*model.Backlink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBacklinks indicates an expected call of GetBacklinks.
+func (mr *MockObjectStoreMockRecorder) GetBacklinks(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBacklinks", reflect.TypeOf((*MockObjectStore)(nil).GetBacklinks), arg0)
+}
+
 // GetByIDs mocks base method.
 func (m *MockObjectStore) GetByIDs(arg0 string, arg1 []string) ([]*model.ObjectInfo, error) {
 	m.ctrl.T.Helper()
@@ -675,6 +690,32 @@ func (mr *MockObjectStoreMockRecorder) SubscribeForAll(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeForAll", reflect.TypeOf((*MockObjectStore)(nil).SubscribeForAll), arg0)
 }
 
+// SubscribeForChanges mocks base method.
+func (m *MockObjectStore) SubscribeForChanges(arg0 func(string, *types.Struct, *types.Struct)) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SubscribeForChanges", arg0)
+}
+
+// SubscribeForChanges indicates an expected call of SubscribeForChanges.
+func (mr *MockObjectStoreMockRecorder) SubscribeForChanges(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeForChanges", reflect.TypeOf((*MockObjectStore)(nil).SubscribeForChanges), arg0)
+}
+
+// UpdateObjectBacklinks mocks base method.
+func (m *MockObjectStore) UpdateObjectBacklinks(arg0 string, arg1 map[string][]*model.Backlink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateObjectBacklinks", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateObjectBacklinks indicates an expected call of UpdateObjectBacklinks.
+func (mr *MockObjectStoreMockRecorder) UpdateObjectBacklinks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectBacklinks", reflect.TypeOf((*MockObjectStore)(nil).UpdateObjectBacklinks), arg0, arg1)
+}
+
 // UpdateObjectDetails mocks base method.
 func (m *MockObjectStore) UpdateObjectDetails(arg0 string, arg1 *types.Struct) error {
 	m.ctrl.T.Helper()
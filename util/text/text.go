@@ -3,6 +3,7 @@ package text
 import (
 	"unicode"
 	"unicode/utf16"
+	"unicode/utf8"
 )
 
 const TruncateEllipsis = " …"
@@ -35,6 +36,18 @@ func Truncate(text string, length int) string {
 	return UTF16ToStr(utf16Text)
 }
 
+// TruncateBytes cuts the text to the max length in bytes, keeping it valid UTF-8
+func TruncateBytes(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	return text[:end]
+}
+
 func UTF16RuneCountString(str string) int {
 	return len(utf16.Encode([]rune(str)))
 }
@@ -0,0 +1,13 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateBytes(t *testing.T) {
+	assert.Equal(t, "ab", TruncateBytes("abc", 2))
+	assert.Equal(t, "a", TruncateBytes("aпр", 2))
+	assert.Equal(t, "abc", TruncateBytes("abc", 5))
+}